@@ -0,0 +1,9 @@
+{{- define "storageclasses.allowedTopologies" -}}
+{{- if . }}
+allowedTopologies:
+- matchLabelExpressions:
+  - key: topology.gke.io/zone
+    values:
+{{ toYaml . | indent 4 }}
+{{- end }}
+{{- end -}}
@@ -0,0 +1,3 @@
+{{- define "csi-driver-filestore-node.provisioner" -}}
+filestore.csi.storage.gke.io
+{{- end -}}
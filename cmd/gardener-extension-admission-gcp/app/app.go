@@ -28,7 +28,10 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/capability"
 	admissioncmd "github.com/gardener/gardener-extension-provider-gcp/pkg/admission/cmd"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/imagescan"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/quota"
 	gcpinstall "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/install"
 	providergcp "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
@@ -65,10 +68,17 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 			webhookSwitches,
 		)
 
+		imageScanOpts  = &admissioncmd.ImageScanOptions{}
+		quotaOpts      = &admissioncmd.QuotaOptions{}
+		capabilityOpts = &admissioncmd.CapabilityOptions{}
+
 		aggOption = controllercmd.NewOptionAggregator(
 			restOpts,
 			mgrOpts,
 			webhookOptions,
+			imageScanOpts,
+			quotaOpts,
+			capabilityOpts,
 		)
 	)
 
@@ -82,6 +92,10 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 				return fmt.Errorf("error completing options: %v", err)
 			}
 
+			imagescan.DefaultOptions = *imageScanOpts.Completed()
+			quota.DefaultOptions = *quotaOpts.Completed()
+			capability.DefaultOptions = *capabilityOpts.Completed()
+
 			util.ApplyClientConnectionConfigurationToRESTConfig(&componentbaseconfig.ClientConnectionConfiguration{
 				QPS:   100.0,
 				Burst: 130,
@@ -111,6 +125,10 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 						&corev1.Secret{}: {Namespaces: map[string]cache.Config{webhookOptions.Server.Completed().Namespace: {}}},
 					},
 				}
+				// The same applies to the config map of the compute quotas, which is read on admission of shoots.
+				if quota.DefaultOptions.Enabled() {
+					managerOptions.Cache.ByObject[&corev1.ConfigMap{}] = cache.ByObject{Namespaces: map[string]cache.Config{quota.DefaultOptions.ConfigMap.Namespace: {}}}
+				}
 			}
 
 			mgr, err := manager.New(restOpts.Completed().Config, managerOptions)
@@ -148,6 +166,13 @@ func NewAdmissionCommand(ctx context.Context) *cobra.Command {
 				return err
 			}
 
+			if collector := quota.NewCollector(mgr, quota.DefaultOptions); collector != nil {
+				log.Info("Publishing compute quotas", "configMap", quota.DefaultOptions.ConfigMap)
+				if err := mgr.Add(collector); err != nil {
+					return fmt.Errorf("could not add compute quota collector: %w", err)
+				}
+			}
+
 			if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
 				return fmt.Errorf("could not add readycheck for informers: %w", err)
 			}
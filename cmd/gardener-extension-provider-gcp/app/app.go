@@ -34,13 +34,20 @@ import (
 	gcpbackupentry "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/backupentry"
 	gcpbastion "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/bastion"
 	gcpcontrolplane "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/controlplane"
+	gcpdeletionprotection "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/deletionprotection"
+	gcpdiskclone "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/diskclone"
+	gcpdnsdelegation "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsdelegation"
 	gcpdnsrecord "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/healthcheck"
 	gcpinfrastructure "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure"
+	gcpinternalloadbalancer "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/internalloadbalancer"
+	gcpmachinedebug "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/machinedebug"
 	gcpworker "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpcontrolplaneexposure "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/controlplaneexposure"
+	gcpinternalloadbalancerwebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/internalloadbalancer"
+	gcplocationwebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/location"
 )
 
 // NewControllerManagerCommand creates a new command for running a GCP provider controller.
@@ -96,12 +103,37 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			MaxConcurrentReconciles: 5,
 		}
 
+		// options for the dnsdelegation controller
+		dnsDelegationCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 1,
+		}
+
 		// options for the infrastructure controller
 		infraCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
 		}
 		reconcileOpts = &controllercmd.ReconcilerOptions{}
 
+		// options for the machine debug controller
+		machineDebugCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		// options for the machine deletion protection controller
+		machineDeletionProtectionCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 5,
+		}
+
+		// options for the internal load balancer controller
+		internalLoadBalancerCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 1,
+		}
+
+		// options for the disk clone controller
+		diskCloneCtrlOpts = &controllercmd.ControllerOptions{
+			MaxConcurrentReconciles: 1,
+		}
+
 		// options for the worker controller
 		workerCtrlOpts = &controllercmd.ControllerOptions{
 			MaxConcurrentReconciles: 5,
@@ -131,8 +163,13 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			controllercmd.PrefixOption("bastion-", bastionCtrlOpts),
 			controllercmd.PrefixOption("controlplane-", controlPlaneCtrlOpts),
 			controllercmd.PrefixOption("dnsrecord-", dnsRecordCtrlOpts),
+			controllercmd.PrefixOption("dnsdelegation-", dnsDelegationCtrlOpts),
 			controllercmd.PrefixOption("infrastructure-", infraCtrlOpts),
 			controllercmd.PrefixOption("worker-", workerCtrlOpts),
+			controllercmd.PrefixOption("machine-debug-", machineDebugCtrlOpts),
+			controllercmd.PrefixOption("machine-deletion-protection-", machineDeletionProtectionCtrlOpts),
+			controllercmd.PrefixOption("internal-load-balancer-", internalLoadBalancerCtrlOpts),
+			controllercmd.PrefixOption("disk-clone-", diskCloneCtrlOpts),
 			controllercmd.PrefixOption("healthcheck-", healthCheckCtrlOpts),
 			controllercmd.PrefixOption("heartbeat-", heartbeatCtrlOpts),
 			configFileOpts,
@@ -212,6 +249,12 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 
 			configFileOpts.Completed().ApplyETCDStorage(&gcpcontrolplaneexposure.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
+			configFileOpts.Completed().ApplyDNS(&gcpdnsrecord.DefaultAddOptions.DNS)
+			configFileOpts.Completed().ApplyDNS(&gcpdnsdelegation.DefaultAddOptions.DNS)
+			configFileOpts.Completed().ApplyAPIServerInternalLoadBalancers(&gcpinternalloadbalancer.DefaultAddOptions.LoadBalancers)
+			configFileOpts.Completed().ApplyAPIServerInternalLoadBalancers(&gcpinternalloadbalancerwebhook.DefaultAddOptions.LoadBalancers)
+			configFileOpts.Completed().ApplyAllowedLocations(&gcplocationwebhook.DefaultAddOptions.AllowedLocations)
+			configFileOpts.Completed().ApplyBastion(&gcpbastion.DefaultAddOptions.Bastion)
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
 			heartbeatCtrlOpts.Completed().Apply(&heartbeat.DefaultAddOptions)
 			backupBucketCtrlOpts.Completed().Apply(&gcpbackupbucket.DefaultAddOptions.Controller)
@@ -219,12 +262,17 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			bastionCtrlOpts.Completed().Apply(&gcpbastion.DefaultAddOptions.Controller)
 			controlPlaneCtrlOpts.Completed().Apply(&gcpcontrolplane.DefaultAddOptions.Controller)
 			dnsRecordCtrlOpts.Completed().Apply(&gcpdnsrecord.DefaultAddOptions.Controller)
+			dnsDelegationCtrlOpts.Completed().Apply(&gcpdnsdelegation.DefaultAddOptions.Controller)
 			infraCtrlOpts.Completed().Apply(&gcpinfrastructure.DefaultAddOptions.Controller)
 			reconcileOpts.Completed().Apply(&gcpinfrastructure.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&gcpcontrolplane.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&gcpworker.DefaultAddOptions.IgnoreOperationAnnotation)
 			reconcileOpts.Completed().Apply(&gcpbastion.DefaultAddOptions.IgnoreOperationAnnotation)
 			workerCtrlOpts.Completed().Apply(&gcpworker.DefaultAddOptions.Controller)
+			machineDebugCtrlOpts.Completed().Apply(&gcpmachinedebug.DefaultAddOptions.Controller)
+			machineDeletionProtectionCtrlOpts.Completed().Apply(&gcpdeletionprotection.DefaultAddOptions.Controller)
+			internalLoadBalancerCtrlOpts.Completed().Apply(&gcpinternalloadbalancer.DefaultAddOptions.Controller)
+			diskCloneCtrlOpts.Completed().Apply(&gcpdiskclone.DefaultAddOptions.Controller)
 			gcpworker.DefaultAddOptions.GardenCluster = gardenCluster
 
 			shootWebhookConfig, err := webhookOptions.Completed().AddToManager(ctx, mgr, nil)
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package capability
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	gcpapihelper "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// AnnotationMinProviderVersion is the annotation on Shoots which holds the minimum version of the provider extension
+	// which supports all capabilities used by the shoot.
+	AnnotationMinProviderVersion = "gcp.provider.extensions.gardener.cloud/min-provider-version"
+	// AnnotationCapabilities is the annotation on Shoots which lists the capabilities used by the shoot which require
+	// the minimum version of the provider extension.
+	AnnotationCapabilities = "gcp.provider.extensions.gardener.cloud/capabilities"
+)
+
+// Options are the options of the enforcement of the minimum versions of the extension.
+type Options struct {
+	// ControllerDeploymentName is the name of the ControllerDeployment of the extension whose downgrades are blocked.
+	ControllerDeploymentName string
+}
+
+// DefaultOptions are the options used by the admission component.
+var DefaultOptions = Options{ControllerDeploymentName: "provider-gcp"}
+
+// Config is the configuration of a shoot which is checked for the use of capabilities.
+type Config struct {
+	// Shoot is the shoot.
+	Shoot *gardencorev1beta1.Shoot
+	// InfrastructureConfig is the InfrastructureConfig of the shoot, if any.
+	InfrastructureConfig *apisgcp.InfrastructureConfig
+	// WorkerConfigs are the WorkerConfigs of the worker pools of the shoot which have one.
+	WorkerConfigs []*apisgcp.WorkerConfig
+}
+
+// Capability is a feature of the provider extension which is only supported as of a version of the extension. Shoots
+// using it cannot be reconciled by older versions, which would ignore the related configuration.
+type Capability struct {
+	// Name is the name of the capability.
+	Name string
+	// MinVersion is the first version of the extension which supports the capability.
+	MinVersion string
+	// UsedBy returns whether the given configuration uses the capability.
+	UsedBy func(*Config) bool
+}
+
+// Registry are the registered capabilities. New provider config fields which are ignored by older versions of the
+// extension must be registered with the version in which they are introduced.
+var Registry = []Capability{
+	{Name: "ipv6-single-stack", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return gcp.IsIPv6SingleStack(c.Shoot.Spec.Networking)
+	}},
+	{Name: "dual-stack", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return gcp.IsDualStack(c.Shoot.Spec.Networking)
+	}},
+	{Name: "existing-subnets", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.ExistingSubnets != nil
+	}},
+	{Name: "nat-ip-count", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.CloudNAT != nil && c.InfrastructureConfig.Networks.CloudNAT.NatIPCount != nil
+	}},
+	{Name: "private-service-connect", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && len(c.InfrastructureConfig.Networks.PrivateServiceConnectEndpoints) > 0
+	}},
+	{Name: "additional-firewall-rules", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && len(c.InfrastructureConfig.Networks.AdditionalFirewallRules) > 0
+	}},
+	{Name: "proxy-only-subnet", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.ProxyOnly != nil
+	}},
+	{Name: "cloud-router-bgp", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.CloudRouterBGP != nil
+	}},
+	{Name: "vpc-peerings", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && len(c.InfrastructureConfig.Networks.Peerings) > 0
+	}},
+	{Name: "vpc-routing-mode", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.RoutingMode != nil
+	}},
+	{Name: "vpc-mtu", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.MTU != nil
+	}},
+	{Name: "firewall-policy", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.FirewallPolicy != nil
+	}},
+	{Name: "packet-mirroring", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.PacketMirroring != nil
+	}},
+	{Name: "private-dns-zone", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.PrivateDNSZone != nil && c.InfrastructureConfig.Networks.PrivateDNSZone.Enabled
+	}},
+	{Name: "ncc-spoke", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && c.InfrastructureConfig.Networks.NCCSpoke != nil
+	}},
+	{Name: "zone-subnets", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && len(c.InfrastructureConfig.Networks.Zones) > 0
+	}},
+	{Name: "resource-labels", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return c.InfrastructureConfig != nil && len(c.InfrastructureConfig.ResourceLabels) > 0
+	}},
+	{Name: "alias-ip-ranges", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return anyWorkerConfig(c, func(w *apisgcp.WorkerConfig) bool { return w.AliasIPRange != nil })
+	}},
+	{Name: "image-streaming", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return anyWorkerConfig(c, func(w *apisgcp.WorkerConfig) bool { return w.ImageStreaming != nil })
+	}},
+	{Name: "deletion-protection", MinVersion: "v1.35.0", UsedBy: func(c *Config) bool {
+		return anyWorkerConfig(c, func(w *apisgcp.WorkerConfig) bool { return w.DeletionProtection != nil && *w.DeletionProtection })
+	}},
+}
+
+func anyWorkerConfig(c *Config, fn func(*apisgcp.WorkerConfig) bool) bool {
+	for _, workerConfig := range c.WorkerConfigs {
+		if fn(workerConfig) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigFromShoot decodes the provider configs of the given shoot.
+func ConfigFromShoot(shoot *gardencorev1beta1.Shoot) (*Config, error) {
+	config := &Config{Shoot: shoot}
+
+	if raw := shoot.Spec.Provider.InfrastructureConfig; raw != nil && raw.Raw != nil {
+		infrastructureConfig, err := gcpapihelper.InfrastructureConfigFromRawExtension(raw)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode infrastructure config: %w", err)
+		}
+		config.InfrastructureConfig = infrastructureConfig
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.ProviderConfig == nil || worker.ProviderConfig.Raw == nil {
+			continue
+		}
+		workerConfig, err := gcpapihelper.WorkerConfigFromRawExtension(worker.ProviderConfig)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode worker config of worker pool %s: %w", worker.Name, err)
+		}
+		config.WorkerConfigs = append(config.WorkerConfigs, workerConfig)
+	}
+
+	return config, nil
+}
+
+// Required returns the minimum version of the extension which supports all capabilities used by the given
+// configuration and the names of the capabilities which require this version. It returns nil if no registered
+// capability is used.
+func Required(config *Config) (*semver.Version, []string) {
+	var (
+		required *semver.Version
+		names    []string
+	)
+
+	for _, capability := range Registry {
+		if !capability.UsedBy(config) {
+			continue
+		}
+
+		version := semver.MustParse(capability.MinVersion)
+		switch {
+		case required == nil || version.GreaterThan(required):
+			required, names = version, []string{capability.Name}
+		case version.Equal(required):
+			names = append(names, capability.Name)
+		}
+	}
+
+	return required, names
+}
+
+// ParseVersion parses the given version of the extension. The pre-release and the metadata are dropped, as development
+// builds contain the capabilities of the version they precede.
+func ParseVersion(version string) (*semver.Version, error) {
+	v, err := semver.NewVersion(strings.TrimSpace(version))
+	if err != nil {
+		return nil, err
+	}
+	return semver.New(v.Major(), v.Minor(), v.Patch(), "", ""), nil
+}
+
+// FormatVersion formats the given version as it is used in the annotations.
+func FormatVersion(version *semver.Version) string {
+	return "v" + version.String()
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package capability_test
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/admission/capability"
+)
+
+var _ = Describe("Capability", func() {
+	var shoot *gardencorev1beta1.Shoot
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					InfrastructureConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16"}}`)},
+					Workers: []gardencorev1beta1.Worker{{
+						Name:           "worker",
+						ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","deletionProtection":true}`)},
+					}},
+				},
+				Networking: &gardencorev1beta1.Networking{Nodes: ptr.To("10.250.0.0/16")},
+			},
+		}
+	})
+
+	Describe("#Required", func() {
+		It("should return the minimum version and the names of the used capabilities", func() {
+			config, err := ConfigFromShoot(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			version, names := Required(config)
+			Expect(FormatVersion(version)).To(Equal("v1.35.0"))
+			Expect(names).To(ConsistOf("deletion-protection"))
+		})
+
+		It("should return nil if no capability is used", func() {
+			shoot.Spec.Provider.Workers[0].ProviderConfig = nil
+
+			config, err := ConfigFromShoot(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			version, names := Required(config)
+			Expect(version).To(BeNil())
+			Expect(names).To(BeEmpty())
+		})
+	})
+
+	Describe("#ConfigFromShoot", func() {
+		It("should fail if a provider config cannot be decoded", func() {
+			shoot.Spec.Provider.InfrastructureConfig.Raw = []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","unknown":true}`)
+
+			_, err := ConfigFromShoot(shoot)
+			Expect(err).To(MatchError(ContainSubstring("could not decode infrastructure config")))
+		})
+	})
+
+	Describe("#ParseVersion", func() {
+		It("should drop the pre-release and the metadata", func() {
+			version, err := ParseVersion("v1.35.0-dev+abcdef")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(FormatVersion(version)).To(Equal("v1.35.0"))
+		})
+
+		It("should fail for invalid versions", func() {
+			_, err := ParseVersion("latest")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -5,9 +5,18 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	webhookcmd "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/capability"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/imagescan"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/mutator"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/quota"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/validator"
 )
 
@@ -16,6 +25,156 @@ func GardenWebhookSwitchOptions() *webhookcmd.SwitchOptions {
 	return webhookcmd.NewSwitchOptions(
 		webhookcmd.Switch(validator.Name, validator.New),
 		webhookcmd.Switch(validator.SecretsValidatorName, validator.NewSecretsWebhook),
+		webhookcmd.Switch(validator.ControllerDeploymentsValidatorName, validator.NewControllerDeploymentsWebhook),
 		webhookcmd.Switch(mutator.Name, mutator.New),
 	)
 }
+
+// ImageScanOptions are command line options for the machine image vulnerability check.
+type ImageScanOptions struct {
+	// URL is the URL from which the machine image policy is fetched.
+	URL string
+	// ConfigMap is the ConfigMap in the form <namespace>/<name> from which the machine image policy is read.
+	ConfigMap string
+	// Mode defines how vulnerable machine images are handled.
+	Mode string
+	// RefreshInterval is the interval in which the machine image policy is refreshed.
+	RefreshInterval time.Duration
+
+	config *imagescan.Options
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *ImageScanOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.URL, "machine-image-policy-url", "", "URL from which the allow/deny list of machine image versions is fetched.")
+	fs.StringVar(&o.ConfigMap, "machine-image-policy-configmap", "", "ConfigMap in the form <namespace>/<name> from which the allow/deny list of machine image versions is read.")
+	fs.StringVar(&o.Mode, "machine-image-policy-mode", string(imagescan.ModeWarn), fmt.Sprintf("How vulnerable machine images are handled, one of %s or %s.", imagescan.ModeWarn, imagescan.ModeBlock))
+	fs.DurationVar(&o.RefreshInterval, "machine-image-policy-refresh-interval", 5*time.Minute, "Interval in which the allow/deny list of machine image versions is refreshed.")
+}
+
+// Complete implements Completer.Complete.
+func (o *ImageScanOptions) Complete() error {
+	if o.URL != "" && o.ConfigMap != "" {
+		return fmt.Errorf("only one of --machine-image-policy-url and --machine-image-policy-configmap may be set")
+	}
+
+	mode := imagescan.Mode(o.Mode)
+	if mode != imagescan.ModeWarn && mode != imagescan.ModeBlock {
+		return fmt.Errorf("unsupported machine image policy mode %q, must be one of %s or %s", o.Mode, imagescan.ModeWarn, imagescan.ModeBlock)
+	}
+
+	var configMap *client.ObjectKey
+	if o.ConfigMap != "" {
+		namespace, name, found := strings.Cut(o.ConfigMap, "/")
+		if !found || namespace == "" || name == "" {
+			return fmt.Errorf("machine image policy config map %q is not of the form <namespace>/<name>", o.ConfigMap)
+		}
+		configMap = &client.ObjectKey{Namespace: namespace, Name: name}
+	}
+
+	o.config = &imagescan.Options{
+		URL:             o.URL,
+		ConfigMap:       configMap,
+		Mode:            mode,
+		RefreshInterval: o.RefreshInterval,
+	}
+	return nil
+}
+
+// Completed returns the completed imagescan.Options. Only call this if `Complete` was successful.
+func (o *ImageScanOptions) Completed() *imagescan.Options {
+	return o.config
+}
+
+// QuotaOptions are command line options for the publication of the compute quotas.
+type QuotaOptions struct {
+	// Secrets are the secrets in the form <namespace>/<name> containing the service accounts of the projects whose
+	// quotas are published.
+	Secrets []string
+	// Regions are the regions whose quotas are published.
+	Regions []string
+	// ConfigMap is the ConfigMap in the form <namespace>/<name> to which the quotas are published.
+	ConfigMap string
+	// RefreshInterval is the interval in which the quotas are refreshed.
+	RefreshInterval time.Duration
+
+	config *quota.Options
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *QuotaOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.Secrets, "compute-quota-secrets", nil, "Secrets in the form <namespace>/<name> containing the service accounts of the projects whose compute quotas are published.")
+	fs.StringSliceVar(&o.Regions, "compute-quota-regions", nil, "Regions whose compute quotas are published.")
+	fs.StringVar(&o.ConfigMap, "compute-quota-configmap", "garden/gcp-compute-quotas", "ConfigMap in the form <namespace>/<name> to which the compute quotas are published.")
+	fs.DurationVar(&o.RefreshInterval, "compute-quota-refresh-interval", time.Hour, "Interval in which the compute quotas are refreshed.")
+}
+
+// Complete implements Completer.Complete.
+func (o *QuotaOptions) Complete() error {
+	configMap, err := parseObjectKey(o.ConfigMap)
+	if err != nil {
+		return fmt.Errorf("compute quota config map %q is not of the form <namespace>/<name>", o.ConfigMap)
+	}
+
+	secrets := make([]client.ObjectKey, 0, len(o.Secrets))
+	for _, secret := range o.Secrets {
+		key, err := parseObjectKey(secret)
+		if err != nil {
+			return fmt.Errorf("compute quota secret %q is not of the form <namespace>/<name>", secret)
+		}
+		secrets = append(secrets, key)
+	}
+
+	if o.RefreshInterval <= 0 {
+		return fmt.Errorf("compute quota refresh interval must be positive")
+	}
+
+	o.config = &quota.Options{
+		Secrets:         secrets,
+		Regions:         o.Regions,
+		ConfigMap:       configMap,
+		RefreshInterval: o.RefreshInterval,
+	}
+	return nil
+}
+
+// Completed returns the completed quota.Options. Only call this if `Complete` was successful.
+func (o *QuotaOptions) Completed() *quota.Options {
+	return o.config
+}
+
+// CapabilityOptions are command line options for the enforcement of the minimum versions of the extension.
+type CapabilityOptions struct {
+	// ControllerDeploymentName is the name of the ControllerDeployment of the extension whose downgrades are blocked.
+	ControllerDeploymentName string
+
+	config *capability.Options
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *CapabilityOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ControllerDeploymentName, "controller-deployment-name", capability.DefaultOptions.ControllerDeploymentName, "Name of the ControllerDeployment of the extension whose downgrades below the minimum version required by shoots are blocked.")
+}
+
+// Complete implements Completer.Complete.
+func (o *CapabilityOptions) Complete() error {
+	if o.ControllerDeploymentName == "" {
+		return fmt.Errorf("controller deployment name must not be empty")
+	}
+
+	o.config = &capability.Options{ControllerDeploymentName: o.ControllerDeploymentName}
+	return nil
+}
+
+// Completed returns the completed capability.Options. Only call this if `Complete` was successful.
+func (o *CapabilityOptions) Completed() *capability.Options {
+	return o.config
+}
+
+func parseObjectKey(value string) (client.ObjectKey, error) {
+	namespace, name, found := strings.Cut(value, "/")
+	if !found || namespace == "" || name == "" {
+		return client.ObjectKey{}, fmt.Errorf("%q is not of the form <namespace>/<name>", value)
+	}
+	return client.ObjectKey{Namespace: namespace, Name: name}, nil
+}
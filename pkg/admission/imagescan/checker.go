@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagescan
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Mode defines how vulnerable machine images are handled.
+type Mode string
+
+const (
+	// ModeWarn only annotates shoots using vulnerable machine images.
+	ModeWarn Mode = "Warn"
+	// ModeBlock additionally rejects shoots which start using vulnerable machine images.
+	ModeBlock Mode = "Block"
+
+	// AnnotationVulnerableMachineImages is the shoot annotation listing the vulnerable machine images used by a shoot.
+	AnnotationVulnerableMachineImages = "gcp.provider.extensions.gardener.cloud/vulnerable-machine-images"
+)
+
+// Options are the options of the machine image vulnerability check.
+type Options struct {
+	// URL is the URL from which the policy is fetched.
+	URL string
+	// ConfigMap is the key of the ConfigMap from which the policy is read.
+	ConfigMap *client.ObjectKey
+	// Mode defines how vulnerable machine images are handled.
+	Mode Mode
+	// RefreshInterval is the interval in which the policy is refreshed.
+	RefreshInterval time.Duration
+}
+
+// DefaultOptions are the options used by the admission webhooks. The check is disabled if no source is configured.
+var DefaultOptions = Options{}
+
+// Enabled returns true if a policy source is configured.
+func (o Options) Enabled() bool {
+	return o.URL != "" || o.ConfigMap != nil
+}
+
+// Checker checks the machine images of shoots against the policy of a Source.
+type Checker struct {
+	source Source
+	mode   Mode
+}
+
+// NewChecker returns a Checker for the given options or nil if no policy source is configured.
+func NewChecker(mgr manager.Manager, opts Options) *Checker {
+	if !opts.Enabled() {
+		return nil
+	}
+
+	if opts.URL != "" {
+		return NewCheckerWithSource(NewHTTPSource(opts.URL, opts.RefreshInterval, clock.RealClock{}), opts.Mode)
+	}
+	return NewCheckerWithSource(NewConfigMapSource(mgr.GetAPIReader(), *opts.ConfigMap, opts.RefreshInterval, clock.RealClock{}), opts.Mode)
+}
+
+// NewCheckerWithSource returns a Checker for the given source and mode.
+func NewCheckerWithSource(source Source, mode Mode) *Checker {
+	return &Checker{source: source, mode: mode}
+}
+
+// Mode returns the mode of the checker.
+func (c *Checker) Mode() Mode {
+	return c.mode
+}
+
+// Check returns the findings for the given machine images.
+func (c *Checker) Check(ctx context.Context, images []Image) ([]Finding, error) {
+	policy, err := c.source.Policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for _, image := range images {
+		if denied, reason := policy.Check(image.Name, image.Version); denied {
+			findings = append(findings, Finding{Image: image, Reason: reason})
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagescan_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/admission/imagescan"
+)
+
+type staticSource struct {
+	policy *Policy
+	err    error
+}
+
+func (s *staticSource) Policy(_ context.Context) (*Policy, error) {
+	return s.policy, s.err
+}
+
+var _ = Describe("Checker", func() {
+	var ctx = context.TODO()
+
+	It("should not be created without a source", func() {
+		Expect(NewChecker(nil, Options{Mode: ModeBlock})).To(BeNil())
+	})
+
+	It("should return the denied images", func() {
+		checker := NewCheckerWithSource(&staticSource{policy: &Policy{
+			Denied: []ImageVersion{{Name: "gardenlinux", Version: "1443.3.0", Reason: "CVE-2024-1234"}},
+		}}, ModeWarn)
+
+		findings, err := checker.Check(ctx, []Image{
+			{Worker: "a", Name: "gardenlinux", Version: "1443.3.0"},
+			{Worker: "b", Name: "gardenlinux", Version: "1443.4.0"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(ConsistOf(Finding{Image: Image{Worker: "a", Name: "gardenlinux", Version: "1443.3.0"}, Reason: "CVE-2024-1234"}))
+		Expect(checker.Mode()).To(Equal(ModeWarn))
+	})
+
+	It("should return the error of the source", func() {
+		checker := NewCheckerWithSource(&staticSource{err: fmt.Errorf("unavailable")}, ModeBlock)
+
+		_, err := checker.Check(ctx, []Image{{Worker: "a", Name: "gardenlinux", Version: "1443.3.0"}})
+		Expect(err).To(MatchError("unavailable"))
+	})
+})
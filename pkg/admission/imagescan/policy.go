@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagescan
+
+import (
+	"fmt"
+)
+
+// Policy is the allow/deny list of machine image versions maintained by a vulnerability scanning pipeline.
+type Policy struct {
+	// Denied are the machine image versions which are known to be vulnerable.
+	Denied []ImageVersion `json:"denied,omitempty"`
+	// Allowed are machine image versions which are exempted from the denied ones, e.g. versions for which a fix was
+	// backported or a vulnerability is not exploitable.
+	Allowed []ImageVersion `json:"allowed,omitempty"`
+}
+
+// ImageVersion is an entry of a Policy.
+type ImageVersion struct {
+	// Name is the name of the machine image.
+	Name string `json:"name"`
+	// Version is the version of the machine image. An empty version matches all versions of the machine image.
+	Version string `json:"version,omitempty"`
+	// Reason describes why the machine image version is listed, e.g. the identifiers of the vulnerabilities.
+	Reason string `json:"reason,omitempty"`
+}
+
+func (i ImageVersion) matches(name, version string) bool {
+	return i.Name == name && (i.Version == "" || i.Version == version)
+}
+
+// Check returns whether the given machine image version is denied by the policy and the reason for it.
+func (p *Policy) Check(name, version string) (bool, string) {
+	for _, allowed := range p.Allowed {
+		if allowed.matches(name, version) {
+			return false, ""
+		}
+	}
+
+	for _, denied := range p.Denied {
+		if denied.matches(name, version) {
+			return true, denied.Reason
+		}
+	}
+
+	return false, ""
+}
+
+// Image is a machine image used by a worker pool of a shoot.
+type Image struct {
+	// Worker is the name of the worker pool.
+	Worker string
+	// Name is the name of the machine image.
+	Name string
+	// Version is the version of the machine image.
+	Version string
+}
+
+// Finding is a machine image which is denied by the policy.
+type Finding struct {
+	Image
+	// Reason is the reason why the machine image version is denied.
+	Reason string
+}
+
+// String returns a human-readable representation of the finding.
+func (f Finding) String() string {
+	s := fmt.Sprintf("worker pool %q uses machine image %s in version %s which is known to be vulnerable", f.Worker, f.Name, f.Version)
+	if f.Reason != "" {
+		s += ": " + f.Reason
+	}
+	return s
+}
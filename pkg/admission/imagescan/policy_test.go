@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagescan_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/admission/imagescan"
+)
+
+var _ = Describe("Policy", func() {
+	var policy *Policy
+
+	BeforeEach(func() {
+		policy = &Policy{
+			Denied: []ImageVersion{
+				{Name: "gardenlinux", Version: "1443.3.0", Reason: "CVE-2024-1234"},
+				{Name: "legacy", Reason: "end of life"},
+			},
+			Allowed: []ImageVersion{
+				{Name: "legacy", Version: "2.0.0"},
+			},
+		}
+	})
+
+	DescribeTable("#Check",
+		func(name, version string, expectedDenied bool, expectedReason string) {
+			denied, reason := policy.Check(name, version)
+			Expect(denied).To(Equal(expectedDenied))
+			Expect(reason).To(Equal(expectedReason))
+		},
+		Entry("should deny a listed version", "gardenlinux", "1443.3.0", true, "CVE-2024-1234"),
+		Entry("should not deny other versions", "gardenlinux", "1443.4.0", false, ""),
+		Entry("should deny all versions if no version is listed", "legacy", "1.0.0", true, "end of life"),
+		Entry("should prefer allowed versions", "legacy", "2.0.0", false, ""),
+		Entry("should not deny unknown images", "ubuntu", "22.04", false, ""),
+	)
+
+	Describe("Finding", func() {
+		It("should include the reason", func() {
+			finding := Finding{Image: Image{Worker: "pool", Name: "gardenlinux", Version: "1443.3.0"}, Reason: "CVE-2024-1234"}
+			Expect(finding.String()).To(Equal(`worker pool "pool" uses machine image gardenlinux in version 1443.3.0 which is known to be vulnerable: CVE-2024-1234`))
+		})
+	})
+})
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagescan
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigMapDataKey is the data key of the policy in a ConfigMap source.
+const ConfigMapDataKey = "policy.yaml"
+
+const (
+	// maxPolicySize is the maximum size of a policy fetched via HTTP.
+	maxPolicySize = 4 * 1024 * 1024
+	// fetchTimeout is the timeout for fetching the policy.
+	fetchTimeout = 10 * time.Second
+	// retryInterval is the interval after which fetching the policy is retried if it was never fetched successfully.
+	retryInterval = 10 * time.Second
+)
+
+// Source provides the current Policy.
+type Source interface {
+	// Policy returns the current policy.
+	Policy(ctx context.Context) (*Policy, error)
+}
+
+type fetchFunc func(ctx context.Context) ([]byte, error)
+
+// cachingSource fetches the policy at most once per refresh interval. The policy is always fetched in the background, so
+// that admission requests never wait for it. If refreshing fails, the last known policy is used so that a temporary
+// outage of the scanning pipeline does not change the admission decisions.
+type cachingSource struct {
+	fetch           fetchFunc
+	refreshInterval time.Duration
+	clock           clock.Clock
+
+	lock       sync.Mutex
+	policy     *Policy
+	err        error
+	fetchedAt  time.Time
+	refreshing bool
+}
+
+func newCachingSource(fetch fetchFunc, refreshInterval time.Duration, clock clock.Clock) *cachingSource {
+	return &cachingSource{
+		fetch:           fetch,
+		refreshInterval: refreshInterval,
+		clock:           clock,
+	}
+}
+
+// Policy implements Source. An error is returned as long as the policy was never fetched successfully.
+func (s *cachingSource) Policy(_ context.Context) (*Policy, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.policy != nil {
+		if s.clock.Since(s.fetchedAt) >= s.refreshInterval && !s.refreshing {
+			s.refreshing = true
+			go s.refresh()
+		}
+		return s.policy, nil
+	}
+
+	// The policy was never fetched successfully. Failed fetches are retried after the retry interval, so that the
+	// scanning pipeline is not flooded with requests during an outage.
+	if !s.refreshing && (s.fetchedAt.IsZero() || s.clock.Since(s.fetchedAt) >= min(retryInterval, s.refreshInterval)) {
+		s.refreshing = true
+		go s.refresh()
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return nil, fmt.Errorf("machine image policy was not fetched yet")
+}
+
+// refresh fetches the policy in the background. The last known policy is kept if fetching fails, and fetching is only
+// retried after the refresh interval, or the retry interval if the policy was never fetched.
+func (s *cachingSource) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+
+	policy, err := s.fetchPolicy(ctx)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err == nil {
+		s.policy = policy
+	}
+	s.err, s.fetchedAt, s.refreshing = err, s.clock.Now(), false
+}
+
+func (s *cachingSource) fetchPolicy(ctx context.Context) (*Policy, error) {
+	data, err := s.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("could not decode machine image policy: %w", err)
+	}
+	return policy, nil
+}
+
+// NewHTTPSource returns a Source which fetches the policy as JSON or YAML document from the given URL.
+func NewHTTPSource(url string, refreshInterval time.Duration, clock clock.Clock) Source {
+	httpClient := &http.Client{Timeout: fetchTimeout}
+
+	return newCachingSource(func(ctx context.Context) ([]byte, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch machine image policy from %q: %w", url, err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("could not fetch machine image policy from %q: unexpected status code %d", url, resp.StatusCode)
+		}
+
+		// Policies exceeding the maximum size are rejected instead of being truncated.
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxPolicySize+1))
+		if err != nil {
+			return nil, fmt.Errorf("could not read machine image policy from %q: %w", url, err)
+		}
+		if len(data) > maxPolicySize {
+			return nil, fmt.Errorf("machine image policy from %q exceeds the maximum size of %d bytes", url, maxPolicySize)
+		}
+		return data, nil
+	}, refreshInterval, clock)
+}
+
+// NewConfigMapSource returns a Source which reads the policy from the ConfigMap with the given key.
+func NewConfigMapSource(reader client.Reader, key client.ObjectKey, refreshInterval time.Duration, clock clock.Clock) Source {
+	return newCachingSource(func(ctx context.Context) ([]byte, error) {
+		configMap := &corev1.ConfigMap{}
+		if err := reader.Get(ctx, key, configMap); err != nil {
+			return nil, fmt.Errorf("could not read machine image policy from config map %s: %w", key, err)
+		}
+
+		data, ok := configMap.Data[ConfigMapDataKey]
+		if !ok {
+			return nil, fmt.Errorf("config map %s does not contain key %q", key, ConfigMapDataKey)
+		}
+		return []byte(data), nil
+	}, refreshInterval, clock)
+}
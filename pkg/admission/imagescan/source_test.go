@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagescan_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/admission/imagescan"
+)
+
+const policyYAML = `denied:
+- name: gardenlinux
+  version: 1443.3.0
+  reason: CVE-2024-1234
+`
+
+var _ = Describe("Source", func() {
+	var (
+		ctx       = context.TODO()
+		fakeClock *testclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now())
+	})
+
+	// fetchedPolicy waits until the given source fetched the policy in the background.
+	fetchedPolicy := func(source Source) *Policy {
+		var policy *Policy
+		EventuallyWithOffset(1, func() error {
+			var err error
+			policy, err = source.Policy(ctx)
+			return err
+		}).Should(Succeed())
+		return policy
+	}
+
+	// fetchError waits until the given source failed to fetch the policy in the background.
+	fetchError := func(source Source) error {
+		var err error
+		EventuallyWithOffset(1, func() error {
+			_, err = source.Policy(ctx)
+			return err
+		}).Should(MatchError(Not(ContainSubstring("not fetched yet"))))
+		return err
+	}
+
+	Describe("#NewHTTPSource", func() {
+		var (
+			server   *httptest.Server
+			requests atomic.Int32
+			status   atomic.Int32
+			body     atomic.Value
+			blocking atomic.Bool
+			release  chan struct{}
+		)
+
+		BeforeEach(func() {
+			requests.Store(0)
+			status.Store(http.StatusOK)
+			body.Store(policyYAML)
+			blocking.Store(false)
+			release = make(chan struct{})
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if blocking.Load() {
+					<-release
+				}
+				requests.Add(1)
+				w.WriteHeader(int(status.Load()))
+				_, _ = w.Write([]byte(body.Load().(string)))
+			}))
+		})
+
+		AfterEach(func() {
+			close(release)
+			server.Close()
+		})
+
+		It("should fetch and cache the policy", func() {
+			source := NewHTTPSource(server.URL, time.Minute, fakeClock)
+
+			policy := fetchedPolicy(source)
+			Expect(policy.Denied).To(ConsistOf(ImageVersion{Name: "gardenlinux", Version: "1443.3.0", Reason: "CVE-2024-1234"}))
+
+			_, err := source.Policy(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests.Load()).To(Equal(int32(1)))
+
+			body.Store("denied: []\n")
+			fakeClock.Step(time.Minute)
+			policy, err = source.Policy(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Denied).To(HaveLen(1))
+			Eventually(func() int32 { return requests.Load() }).Should(Equal(int32(2)))
+
+			Eventually(func(g Gomega) {
+				policy, err := source.Policy(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(policy.Denied).To(BeEmpty())
+			}).Should(Succeed())
+		})
+
+		It("should not wait for the policy if it was never fetched", func() {
+			blocking.Store(true)
+			source := NewHTTPSource(server.URL, time.Minute, fakeClock)
+
+			for i := 0; i < 3; i++ {
+				_, err := source.Policy(ctx)
+				Expect(err).To(MatchError(ContainSubstring("not fetched yet")))
+			}
+
+			release <- struct{}{}
+			Expect(fetchedPolicy(source).Denied).To(HaveLen(1))
+			Expect(requests.Load()).To(Equal(int32(1)))
+		})
+
+		It("should not wait for the refresh of the policy", func() {
+			source := NewHTTPSource(server.URL, time.Minute, fakeClock)
+			fetchedPolicy(source)
+
+			blocking.Store(true)
+			fakeClock.Step(time.Minute)
+
+			for i := 0; i < 3; i++ {
+				policy, err := source.Policy(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policy.Denied).To(HaveLen(1))
+			}
+		})
+
+		It("should keep the last known policy if the refresh fails and retry after the refresh interval", func() {
+			source := NewHTTPSource(server.URL, time.Minute, fakeClock)
+			fetchedPolicy(source)
+
+			status.Store(http.StatusInternalServerError)
+			fakeClock.Step(time.Minute)
+
+			policy, err := source.Policy(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Denied).To(HaveLen(1))
+			Eventually(func() int32 { return requests.Load() }).Should(Equal(int32(2)))
+
+			Consistently(func(g Gomega) {
+				policy, err := source.Policy(ctx)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(policy.Denied).To(HaveLen(1))
+				g.Expect(requests.Load()).To(Equal(int32(2)))
+			}).Should(Succeed())
+		})
+
+		It("should fail if the policy was never fetched and cache the failure", func() {
+			status.Store(http.StatusNotFound)
+			source := NewHTTPSource(server.URL, time.Minute, fakeClock)
+			Expect(fetchError(source)).To(MatchError(ContainSubstring("unexpected status code 404")))
+
+			status.Store(http.StatusOK)
+			_, err := source.Policy(ctx)
+			Expect(err).To(MatchError(ContainSubstring("unexpected status code 404")))
+			Expect(requests.Load()).To(Equal(int32(1)))
+
+			fakeClock.Step(10 * time.Second)
+			_, err = source.Policy(ctx)
+			Expect(err).To(MatchError(ContainSubstring("unexpected status code 404")))
+			Expect(fetchedPolicy(source).Denied).To(HaveLen(1))
+			Expect(requests.Load()).To(Equal(int32(2)))
+		})
+
+		It("should reject policies exceeding the maximum size", func() {
+			body.Store(policyYAML + "#" + strings.Repeat("x", 4*1024*1024))
+			source := NewHTTPSource(server.URL, time.Minute, fakeClock)
+
+			Expect(fetchError(source)).To(MatchError(ContainSubstring("exceeds the maximum size")))
+		})
+	})
+
+	Describe("#NewConfigMapSource", func() {
+		var (
+			c   client.Client
+			key = client.ObjectKey{Namespace: "garden", Name: "machine-image-policy"}
+		)
+
+		BeforeEach(func() {
+			c = fakeclient.NewClientBuilder().Build()
+		})
+
+		It("should read the policy from the config map", func() {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+				Data:       map[string]string{ConfigMapDataKey: policyYAML},
+			})).To(Succeed())
+
+			Expect(fetchedPolicy(NewConfigMapSource(c, key, time.Minute, fakeClock)).Denied).To(HaveLen(1))
+		})
+
+		It("should fail if the config map does not contain the policy", func() {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			})).To(Succeed())
+
+			Expect(fetchError(NewConfigMapSource(c, key, time.Minute, fakeClock))).To(MatchError(ContainSubstring(ConfigMapDataKey)))
+		})
+
+		It("should fail if the config map does not exist", func() {
+			Expect(fetchError(NewConfigMapSource(c, key, time.Minute, fakeClock))).To(MatchError(ContainSubstring("could not read machine image policy")))
+		})
+	})
+})
@@ -9,34 +9,56 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/capability"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/imagescan"
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	gcpapihelper "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	gcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 )
 
 // NewShootMutator returns a new instance of a shoot mutator.
 func NewShootMutator(mgr manager.Manager) extensionswebhook.Mutator {
 	return &shoot{
-		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		decoder:      serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		imageChecker: imagescan.NewChecker(mgr, imagescan.DefaultOptions),
 	}
 }
 
 type shoot struct {
-	decoder runtime.Decoder
+	decoder      runtime.Decoder
+	imageChecker *imagescan.Checker
 }
 
 const (
 	overlayKey = "overlay"
 	enabledKey = "enabled"
+
+	apiVersionKey        = "apiVersion"
+	kindKey              = "kind"
+	volumeKey            = "volume"
+	localSSDInterfaceKey = "interface"
+	serviceAccountKey    = "serviceAccount"
+	scopesKey            = "scopes"
+
+	workerConfigKind = "WorkerConfig"
+	scratchDiskType  = "SCRATCH"
+
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
 )
 
 // Mutate mutates the given shoot object.
-func (s *shoot) Mutate(_ context.Context, new, old client.Object) error {
+func (s *shoot) Mutate(ctx context.Context, new, old client.Object) error {
 
 	shoot, ok := new.(*gardencorev1beta1.Shoot)
 	if !ok {
@@ -75,7 +97,7 @@ func (s *shoot) Mutate(_ context.Context, new, old client.Object) error {
 	}
 
 	if shoot.Spec.Networking != nil {
-		networkConfig, err := s.decodeNetworkConfig(shoot.Spec.Networking.ProviderConfig)
+		networkConfig, err := s.decodeProviderConfig(shoot.Spec.Networking.ProviderConfig)
 		if err != nil {
 			return err
 		}
@@ -85,7 +107,7 @@ func (s *shoot) Mutate(_ context.Context, new, old client.Object) error {
 		}
 
 		if oldShoot != nil && networkConfig[overlayKey] == nil {
-			oldNetworkConfig, err := s.decodeNetworkConfig(oldShoot.Spec.Networking.ProviderConfig)
+			oldNetworkConfig, err := s.decodeProviderConfig(oldShoot.Spec.Networking.ProviderConfig)
 			if err != nil {
 				return err
 			}
@@ -104,18 +126,166 @@ func (s *shoot) Mutate(_ context.Context, new, old client.Object) error {
 		}
 	}
 
+	for i := range shoot.Spec.Provider.Workers {
+		if err := s.mutateWorkerConfig(&shoot.Spec.Provider.Workers[i]); err != nil {
+			return err
+		}
+	}
+
+	s.annotateVulnerableMachineImages(ctx, oldShoot, shoot)
+	annotateMinProviderVersion(shoot)
+
 	return nil
 }
 
-func (s *shoot) decodeNetworkConfig(network *runtime.RawExtension) (map[string]interface{}, error) {
-	var networkConfig map[string]interface{}
-	if network == nil || network.Raw == nil {
+// annotateMinProviderVersion annotates the shoot with the minimum version of the extension which supports all
+// capabilities used by the shoot, so that downgrades of the extension which would ignore their configuration can be
+// blocked. The annotation is kept unchanged if the provider configs cannot be decoded, which is reported by the
+// validation.
+func annotateMinProviderVersion(shoot *gardencorev1beta1.Shoot) {
+	config, err := capability.ConfigFromShoot(shoot)
+	if err != nil {
+		logger.Error(err, "Could not determine the capabilities used by the shoot", "shoot", client.ObjectKeyFromObject(shoot))
+		return
+	}
+
+	version, names := capability.Required(config)
+	if version == nil {
+		delete(shoot.Annotations, capability.AnnotationMinProviderVersion)
+		delete(shoot.Annotations, capability.AnnotationCapabilities)
+		return
+	}
+
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, capability.AnnotationMinProviderVersion, capability.FormatVersion(version))
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, capability.AnnotationCapabilities, strings.Join(names, ","))
+}
+
+// annotateVulnerableMachineImages lists the machine images of the shoot which are known to be vulnerable in an
+// annotation. The machine images are only checked if they changed compared to the old shoot, and the annotation is
+// kept unchanged if the policy of the scanning pipeline is unavailable.
+func (s *shoot) annotateVulnerableMachineImages(ctx context.Context, oldShoot, shoot *gardencorev1beta1.Shoot) {
+	if s.imageChecker == nil {
+		return
+	}
+
+	images := machineImages(shoot)
+	if oldShoot != nil && reflect.DeepEqual(machineImages(oldShoot), images) {
+		return
+	}
+
+	findings, err := s.imageChecker.Check(ctx, images)
+	if err != nil {
+		logger.Error(err, "Could not check machine images for vulnerabilities", "shoot", client.ObjectKeyFromObject(shoot))
+		return
+	}
+
+	if len(findings) == 0 {
+		delete(shoot.Annotations, imagescan.AnnotationVulnerableMachineImages)
+		return
+	}
+
+	messages := make([]string, 0, len(findings))
+	for _, finding := range findings {
+		messages = append(messages, finding.String())
+	}
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, imagescan.AnnotationVulnerableMachineImages, strings.Join(messages, "; "))
+}
+
+func machineImages(shoot *gardencorev1beta1.Shoot) []imagescan.Image {
+	var images []imagescan.Image
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Machine.Image != nil && worker.Machine.Image.Version != nil {
+			images = append(images, imagescan.Image{Worker: worker.Name, Name: worker.Machine.Image.Name, Version: *worker.Machine.Image.Version})
+		}
+	}
+	return images
+}
+
+// mutateWorkerConfig defaults the WorkerConfig of the given worker:
+//   - the local SSD interface is defaulted if the worker uses local SSDs. NVMe is used if the machine family only
+//     supports the NVMe interface, SCSI (the GCP default) otherwise.
+//   - the scopes of a configured service account are defaulted to the cloud-platform scope.
+func (s *shoot) mutateWorkerConfig(worker *gardencorev1beta1.Worker) error {
+	workerConfig, err := s.decodeProviderConfig(worker.ProviderConfig)
+	if err != nil {
+		return err
+	}
+
+	mutated := defaultLocalSSDInterface(workerConfig, worker)
+	mutated = defaultServiceAccountScopes(workerConfig) || mutated
+	if !mutated {
+		return nil
+	}
+
+	if workerConfig[apiVersionKey] == nil {
+		workerConfig[apiVersionKey] = gcpv1alpha1.SchemeGroupVersion.String()
+		workerConfig[kindKey] = workerConfigKind
+	}
+
+	modifiedJSON, err := json.Marshal(workerConfig)
+	if err != nil {
+		return err
+	}
+	worker.ProviderConfig = &runtime.RawExtension{
+		Raw: modifiedJSON,
+	}
+
+	return nil
+}
+
+func defaultLocalSSDInterface(workerConfig map[string]interface{}, worker *gardencorev1beta1.Worker) bool {
+	if !hasLocalSSDDataVolume(worker.DataVolumes) {
+		return false
+	}
+
+	volume, ok := workerConfig[volumeKey].(map[string]interface{})
+	if !ok || volume == nil {
+		volume = map[string]interface{}{}
+	}
+	if volume[localSSDInterfaceKey] != nil {
+		return false
+	}
+
+	volume[localSSDInterfaceKey] = apisgcp.LocalSSDInterfaceSCSI
+	if gcpapihelper.SupportsOnlyNVMeLocalSSD(worker.Machine.Type) {
+		volume[localSSDInterfaceKey] = apisgcp.LocalSSDInterfaceNVME
+	}
+	workerConfig[volumeKey] = volume
+
+	return true
+}
+
+func defaultServiceAccountScopes(workerConfig map[string]interface{}) bool {
+	serviceAccount, ok := workerConfig[serviceAccountKey].(map[string]interface{})
+	if !ok || serviceAccount == nil {
+		return false
+	}
+	if scopes, ok := serviceAccount[scopesKey].([]interface{}); ok && len(scopes) > 0 {
+		return false
+	}
+
+	serviceAccount[scopesKey] = []interface{}{cloudPlatformScope}
+	return true
+}
+
+func hasLocalSSDDataVolume(dataVolumes []gardencorev1beta1.DataVolume) bool {
+	for _, volume := range dataVolumes {
+		if volume.Type != nil && *volume.Type == scratchDiskType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *shoot) decodeProviderConfig(providerConfig *runtime.RawExtension) (map[string]interface{}, error) {
+	var config map[string]interface{}
+	if providerConfig == nil || providerConfig.Raw == nil {
 		return map[string]interface{}{}, nil
 	}
-	if err := json.Unmarshal(network.Raw, &networkConfig); err != nil {
+	if err := json.Unmarshal(providerConfig.Raw, &config); err != nil {
 		return nil, err
 	}
-	return networkConfig, nil
+	return config, nil
 }
 
 // wasShootRescheduledToNewSeed returns true if the shoot.Spec.SeedName has been changed, but the migration operation has not started yet.
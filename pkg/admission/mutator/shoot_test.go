@@ -6,10 +6,13 @@ package mutator_test
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"time"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
@@ -19,6 +22,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/capability"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/imagescan"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/mutator"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
@@ -194,5 +199,148 @@ var _ = Describe("Shoot mutator", func() {
 			})
 
 		})
+
+		Context("Mutate worker providerconfig", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.Workers[0].Machine.Type = "c3-standard-8"
+				shoot.Spec.Provider.Workers[0].DataVolumes = []gardencorev1beta1.DataVolume{
+					{Name: "local-ssd", Type: ptr.To("SCRATCH"), VolumeSize: "375Gi"},
+				}
+			})
+
+			It("should default the local SSD interface to NVME for third generation machine types", func() {
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"interface":"NVME"}}`),
+				}))
+			})
+
+			It("should keep an explicitly configured local SSD interface", func() {
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"interface":"SCSI"}}`),
+				}
+				shootExpected := shoot.DeepCopy()
+
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.Workers).To(DeepEqual(shootExpected.Spec.Provider.Workers))
+			})
+
+			It("should default the local SSD interface to SCSI for older machine types", func() {
+				shoot.Spec.Provider.Workers[0].Machine.Type = "n2-standard-8"
+
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","volume":{"interface":"SCSI"}}`),
+				}))
+			})
+
+			It("should not default the local SSD interface when no local SSDs are used", func() {
+				shoot.Spec.Provider.Workers[0].DataVolumes = nil
+
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(BeNil())
+			})
+
+			It("should default the service account scopes to cloud-platform", func() {
+				shoot.Spec.Provider.Workers[0].DataVolumes = nil
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","serviceAccount":{"email":"foo@bar.iam.gserviceaccount.com"}}`),
+				}
+
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.Workers[0].ProviderConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","serviceAccount":{"email":"foo@bar.iam.gserviceaccount.com","scopes":["https://www.googleapis.com/auth/cloud-platform"]}}`),
+				}))
+			})
+
+			It("should keep explicitly configured service account scopes", func() {
+				shoot.Spec.Provider.Workers[0].DataVolumes = nil
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","serviceAccount":{"email":"foo@bar.iam.gserviceaccount.com","scopes":["https://www.googleapis.com/auth/compute"]}}`),
+				}
+				shootExpected := shoot.DeepCopy()
+
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.Workers).To(DeepEqual(shootExpected.Spec.Provider.Workers))
+			})
+		})
+
+		Context("Minimum provider version", func() {
+			It("should annotate shoots using capabilities of newer provider versions", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16","mtu":1500,"routingMode":"GLOBAL"}}`)}
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Annotations).To(HaveKeyWithValue(capability.AnnotationMinProviderVersion, "v1.35.0"))
+				Expect(shoot.Annotations).To(HaveKeyWithValue(capability.AnnotationCapabilities, "vpc-routing-mode,vpc-mtu"))
+			})
+
+			It("should remove the annotations once no such capability is used anymore", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, capability.AnnotationMinProviderVersion, "v1.35.0")
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, capability.AnnotationCapabilities, "vpc-mtu")
+
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(capability.AnnotationMinProviderVersion))
+				Expect(shoot.Annotations).NotTo(HaveKey(capability.AnnotationCapabilities))
+			})
+		})
+
+		Context("Machine image vulnerability check", func() {
+			var server *httptest.Server
+
+			BeforeEach(func() {
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = w.Write([]byte(`{"denied":[{"name":"gardenlinux","version":"1443.3.0","reason":"CVE-2024-1234"}]}`))
+				}))
+				DeferCleanup(server.Close)
+				DeferCleanup(test.WithVar(&imagescan.DefaultOptions, imagescan.Options{URL: server.URL, Mode: imagescan.ModeWarn, RefreshInterval: time.Minute}))
+
+				mgr.EXPECT().GetScheme().Return(runtime.NewScheme())
+				shootMutator = mutator.NewShootMutator(mgr)
+				shoot.Spec.Provider.Workers[0].Machine.Image = &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1443.3.0")}
+			})
+
+			It("should not wait for the policy if it was never fetched", func() {
+				Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(imagescan.AnnotationVulnerableMachineImages))
+			})
+
+			It("should annotate shoots using vulnerable machine images", func() {
+				Eventually(func(g Gomega) {
+					g.Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+					g.Expect(shoot.Annotations).To(HaveKeyWithValue(imagescan.AnnotationVulnerableMachineImages,
+						`worker pool "worker" uses machine image gardenlinux in version 1443.3.0 which is known to be vulnerable: CVE-2024-1234`))
+				}).Should(Succeed())
+			})
+
+			It("should remove the annotation once no vulnerable machine image is used anymore", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, imagescan.AnnotationVulnerableMachineImages, "outdated")
+				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1443.4.0")
+
+				Eventually(func(g Gomega) {
+					g.Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+					g.Expect(shoot.Annotations).NotTo(HaveKey(imagescan.AnnotationVulnerableMachineImages))
+				}).Should(Succeed())
+			})
+
+			It("should not check the machine images if they did not change", func() {
+				Eventually(func(g Gomega) {
+					g.Expect(shootMutator.Mutate(ctx, shoot, nil)).To(Succeed())
+					g.Expect(shoot.Annotations).To(HaveKey(imagescan.AnnotationVulnerableMachineImages))
+				}).Should(Succeed())
+
+				oldShoot := shoot.DeepCopy()
+				delete(shoot.Annotations, imagescan.AnnotationVulnerableMachineImages)
+				shoot.Spec.Provider.Workers[0].Maximum = 5
+
+				Expect(shootMutator.Mutate(ctx, shoot, oldShoot)).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(imagescan.AnnotationVulnerableMachineImages))
+			})
+		})
 	})
 })
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// ComputeClientFunc returns a compute client for the given service account.
+type ComputeClientFunc func(ctx context.Context, serviceAccount *gcp.ServiceAccount) (gcpclient.ComputeClient, error)
+
+// Collector periodically fetches the compute quotas of the configured projects and regions and publishes them in a
+// ConfigMap, where they can be inspected by operators and are read by the admission checks.
+type Collector struct {
+	reader           client.Reader
+	client           client.Client
+	newComputeClient ComputeClientFunc
+	opts             Options
+	clock            clock.Clock
+	log              logr.Logger
+}
+
+var _ manager.LeaderElectionRunnable = &Collector{}
+
+// NewCollector returns a Collector for the given options or nil if the publication of the quotas is not enabled.
+func NewCollector(mgr manager.Manager, opts Options) *Collector {
+	if !opts.Enabled() {
+		return nil
+	}
+
+	return NewCollectorWithClients(mgr.GetAPIReader(), mgr.GetClient(), func(ctx context.Context, serviceAccount *gcp.ServiceAccount) (gcpclient.ComputeClient, error) {
+		return gcpclient.NewComputeClient(ctx, serviceAccount)
+	}, opts, clock.RealClock{})
+}
+
+// NewCollectorWithClients returns a Collector which reads the secrets with the given reader, fetches the quotas with
+// the compute clients returned by the given function and writes the ConfigMap with the given client.
+func NewCollectorWithClients(reader client.Reader, c client.Client, newComputeClient ComputeClientFunc, opts Options, clock clock.Clock) *Collector {
+	return &Collector{
+		reader:           reader,
+		client:           c,
+		newComputeClient: newComputeClient,
+		opts:             opts,
+		clock:            clock,
+		log:              logf.Log.WithName("compute-quota-collector"),
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, so that only one replica publishes the quotas.
+func (c *Collector) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable. The quotas are collected until the given context is cancelled.
+func (c *Collector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := c.Collect(ctx); err != nil {
+			c.log.Error(err, "Could not collect all compute quotas")
+		}
+	}, c.opts.RefreshInterval)
+	return nil
+}
+
+// Collect fetches the quotas of all configured projects and regions and publishes them. The reports of projects and
+// regions whose quotas cannot be fetched are kept, so that a temporary error does not remove them.
+func (c *Collector) Collect(ctx context.Context) error {
+	var (
+		reports = map[string]string{}
+		errs    []error
+	)
+
+	for _, key := range c.opts.Secrets {
+		projectReports, err := c.collectProject(ctx, key)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		maps.Copy(reports, projectReports)
+	}
+
+	if err := c.publish(ctx, reports); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Collector) collectProject(ctx context.Context, key client.ObjectKey) (map[string]string, error) {
+	// The secrets are read with the API reader to prevent that an informer for all secrets is started.
+	secret := &corev1.Secret{}
+	if err := c.reader.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("could not read secret %s: %w", key, err)
+	}
+	serviceAccount, err := gcp.GetServiceAccountFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	computeClient, err := c.newComputeClient(ctx, serviceAccount)
+	if err != nil {
+		return nil, fmt.Errorf("could not create compute client for project %s: %w", serviceAccount.ProjectID, err)
+	}
+
+	var (
+		reports = map[string]string{}
+		errs    []error
+	)
+
+	for _, region := range c.opts.Regions {
+		gcpRegion, err := computeClient.GetRegion(ctx, region)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not get quotas of project %s in region %s: %w", serviceAccount.ProjectID, region, err))
+			continue
+		}
+
+		report := Report{
+			Project:    serviceAccount.ProjectID,
+			Region:     region,
+			Quotas:     []Quota{},
+			UpdateTime: metav1.NewTime(c.clock.Now()),
+		}
+		for _, quota := range gcpRegion.Quotas {
+			if isPublishedMetric(quota.Metric) {
+				report.Quotas = append(report.Quotas, Quota{Metric: quota.Metric, Limit: quota.Limit, Usage: quota.Usage})
+			}
+		}
+
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return nil, err
+		}
+		reports[ReportKey(serviceAccount.ProjectID, region)] = string(data)
+	}
+
+	return reports, errors.Join(errs...)
+}
+
+func (c *Collector) publish(ctx context.Context, reports map[string]string) error {
+	configMap := &corev1.ConfigMap{}
+	if err := c.reader.Get(ctx, c.opts.ConfigMap, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("could not read config map %s: %w", c.opts.ConfigMap, err)
+		}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: c.opts.ConfigMap.Namespace, Name: c.opts.ConfigMap.Name},
+			Data:       reports,
+		}
+		if err := c.client.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("could not create config map %s: %w", c.opts.ConfigMap, err)
+		}
+		return nil
+	}
+
+	patch := client.MergeFrom(configMap.DeepCopy())
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	maps.Copy(configMap.Data, reports)
+	if err := c.client.Patch(ctx, configMap, patch); err != nil {
+		return fmt.Errorf("could not update config map %s: %w", c.opts.ConfigMap, err)
+	}
+	return nil
+}
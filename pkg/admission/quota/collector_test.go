@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/quota"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Collector", func() {
+	var (
+		ctx           = context.TODO()
+		ctrl          *gomock.Controller
+		c             client.Client
+		computeClient *mockgcpclient.MockComputeClient
+		fakeClock     *testclock.FakeClock
+		opts          quota.Options
+		collector     *quota.Collector
+
+		configMapKey = client.ObjectKey{Namespace: "garden", Name: "gcp-compute-quotas"}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "project-a"},
+			Data: map[string][]byte{
+				gcp.ServiceAccountJSONField: []byte(`{"project_id": "project-a", "type": "service_account"}`),
+			},
+		}).Build()
+
+		opts = quota.Options{
+			Secrets:   []client.ObjectKey{{Namespace: "garden", Name: "project-a"}},
+			Regions:   []string{"europe-west1"},
+			ConfigMap: configMapKey,
+		}
+		collector = quota.NewCollectorWithClients(c, c, func(_ context.Context, serviceAccount *gcp.ServiceAccount) (gcpclient.ComputeClient, error) {
+			Expect(serviceAccount.ProjectID).To(Equal("project-a"))
+			return computeClient, nil
+		}, opts, fakeClock)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	readReport := func() *quota.Report {
+		report, err := quota.NewReaderWithClient(c, configMapKey).Report(ctx, "project-a", "europe-west1")
+		Expect(err).NotTo(HaveOccurred())
+		return report
+	}
+
+	It("should publish the compute quotas of the region", func() {
+		computeClient.EXPECT().GetRegion(ctx, "europe-west1").Return(&compute.Region{Quotas: []*compute.Quota{
+			{Metric: "CPUS", Limit: 2400, Usage: 1200},
+			{Metric: "N2_CPUS", Limit: 1000, Usage: 800},
+			{Metric: "NVIDIA_T4_GPUS", Limit: 16, Usage: 4},
+			{Metric: "IN_USE_ADDRESSES", Limit: 200, Usage: 20},
+			{Metric: "SSD_TOTAL_GB", Limit: 10240, Usage: 500},
+			{Metric: "INSTANCE_GROUPS", Limit: 100, Usage: 10},
+		}}, nil)
+
+		Expect(collector.Collect(ctx)).To(Succeed())
+
+		report := readReport()
+		Expect(report.Project).To(Equal("project-a"))
+		Expect(report.Region).To(Equal("europe-west1"))
+		Expect(report.Quotas).To(Equal([]quota.Quota{
+			{Metric: "CPUS", Limit: 2400, Usage: 1200},
+			{Metric: "N2_CPUS", Limit: 1000, Usage: 800},
+			{Metric: "NVIDIA_T4_GPUS", Limit: 16, Usage: 4},
+			{Metric: "IN_USE_ADDRESSES", Limit: 200, Usage: 20},
+			{Metric: "SSD_TOTAL_GB", Limit: 10240, Usage: 500},
+		}))
+		Expect(report.UpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+	})
+
+	It("should keep the last report if the quotas cannot be fetched", func() {
+		computeClient.EXPECT().GetRegion(ctx, "europe-west1").Return(&compute.Region{Quotas: []*compute.Quota{
+			{Metric: "CPUS", Limit: 2400, Usage: 1200},
+		}}, nil)
+		Expect(collector.Collect(ctx)).To(Succeed())
+
+		fakeClock.Step(time.Hour)
+		computeClient.EXPECT().GetRegion(ctx, "europe-west1").Return(nil, errors.New("fake"))
+		Expect(collector.Collect(ctx)).To(MatchError(ContainSubstring("could not get quotas of project project-a in region europe-west1")))
+
+		report := readReport()
+		Expect(report.Quota("CPUS")).To(Equal(&quota.Quota{Metric: "CPUS", Limit: 2400, Usage: 1200}))
+		Expect(report.UpdateTime.Time).To(BeTemporally("==", fakeClock.Now().Add(-time.Hour)))
+	})
+
+	It("should report missing secrets", func() {
+		collector = quota.NewCollectorWithClients(c, c, nil, quota.Options{
+			Secrets:   []client.ObjectKey{{Namespace: "garden", Name: "missing"}},
+			Regions:   []string{"europe-west1"},
+			ConfigMap: configMapKey,
+		}, fakeClock)
+
+		Expect(collector.Collect(ctx)).To(MatchError(ContainSubstring("could not read secret garden/missing")))
+	})
+})
+
+var _ = Describe("Reader", func() {
+	It("should return no report if the quotas were not published", func() {
+		c := fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+		report, err := quota.NewReaderWithClient(c, client.ObjectKey{Namespace: "garden", Name: "gcp-compute-quotas"}).Report(context.TODO(), "project-a", "europe-west1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report).To(BeNil())
+	})
+})
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
+)
+
+// MetricCPUs is the quota metric of the CPUs of all machine families of a region.
+const MetricCPUs = "CPUS"
+
+// publishedMetricSuffixes are the suffixes of the published quota metrics, i.e. of the CPUs per machine family (e.g.
+// N2_CPUS), the GPUs (e.g. NVIDIA_T4_GPUS), the IP addresses (e.g. IN_USE_ADDRESSES) and the disks (e.g. SSD_TOTAL_GB)
+// of a region.
+var publishedMetricSuffixes = []string{"_CPUS", "_GPUS", "_ADDRESSES", "_TOTAL_GB"}
+
+// Options are the options of the publication of the compute quotas.
+type Options struct {
+	// Secrets are the keys of the secrets containing the service accounts of the projects whose quotas are published.
+	Secrets []client.ObjectKey
+	// Regions are the regions whose quotas are published.
+	Regions []string
+	// ConfigMap is the key of the ConfigMap to which the quotas are published.
+	ConfigMap client.ObjectKey
+	// RefreshInterval is the interval in which the quotas are refreshed.
+	RefreshInterval time.Duration
+}
+
+// DefaultOptions are the options used by the admission component. The publication is disabled if no secrets or
+// regions are configured.
+var DefaultOptions = Options{}
+
+// Enabled returns true if secrets and regions are configured.
+func (o Options) Enabled() bool {
+	return len(o.Secrets) > 0 && len(o.Regions) > 0
+}
+
+// Quota is the limit and usage of a quota metric of a region.
+type Quota struct {
+	// Metric is the name of the quota metric, e.g. CPUS or N2_CPUS.
+	Metric string `json:"metric"`
+	// Limit is the limit of the quota.
+	Limit float64 `json:"limit"`
+	// Usage is the current usage of the quota.
+	Usage float64 `json:"usage"`
+}
+
+// Report are the compute quotas of a region of a project.
+type Report struct {
+	// Project is the ID of the project.
+	Project string `json:"project"`
+	// Region is the name of the region.
+	Region string `json:"region"`
+	// Quotas are the quotas of the region.
+	Quotas []Quota `json:"quotas"`
+	// UpdateTime is the time when the quotas were fetched.
+	UpdateTime metav1.Time `json:"updateTime"`
+}
+
+// Quota returns the quota of the given metric or nil if the report does not contain it.
+func (r *Report) Quota(metric string) *Quota {
+	for i := range r.Quotas {
+		if r.Quotas[i].Metric == metric {
+			return &r.Quotas[i]
+		}
+	}
+	return nil
+}
+
+// ReportKey returns the data key of the report of the given project and region in the ConfigMap.
+func ReportKey(project, region string) string {
+	return fmt.Sprintf("%s.%s.yaml", project, region)
+}
+
+func isPublishedMetric(metric string) bool {
+	if metric == MetricCPUs {
+		return true
+	}
+	for _, suffix := range publishedMetricSuffixes {
+		if strings.HasSuffix(metric, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reader reads the published compute quotas.
+type Reader struct {
+	reader client.Reader
+	key    client.ObjectKey
+}
+
+// NewReader returns a Reader for the given options or nil if the publication of the quotas is not enabled.
+func NewReader(mgr manager.Manager, opts Options) *Reader {
+	if !opts.Enabled() {
+		return nil
+	}
+	// The ConfigMap is read on admission of shoots, hence it is read from the cache.
+	return NewReaderWithClient(mgr.GetClient(), opts.ConfigMap)
+}
+
+// NewReaderWithClient returns a Reader which reads the quotas from the ConfigMap with the given key.
+func NewReaderWithClient(reader client.Reader, key client.ObjectKey) *Reader {
+	return &Reader{reader: reader, key: key}
+}
+
+// Report returns the report of the given project and region or nil if it was not published.
+func (r *Reader) Report(ctx context.Context, project, region string) (*Report, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.reader.Get(ctx, r.key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read compute quotas from config map %s: %w", r.key, err)
+	}
+
+	data, ok := configMap.Data[ReportKey(project, region)]
+	if !ok {
+		return nil, nil
+	}
+
+	report := &Report{}
+	if err := yaml.Unmarshal([]byte(data), report); err != nil {
+		return nil, fmt.Errorf("could not decode compute quotas of project %s in region %s: %w", project, region, err)
+	}
+	return report, nil
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission"
+	gcpvalidation "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+)
+
+type backupBucket struct {
+	decoder runtime.Decoder
+}
+
+// NewBackupBucketValidator returns a new instance of a backup bucket validator.
+func NewBackupBucketValidator(mgr manager.Manager) extensionswebhook.Validator {
+	return &backupBucket{
+		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+	}
+}
+
+var bbProviderConfigPath = specPath.Child("providerConfig")
+
+// Validate validates the provider config of the given backup bucket.
+func (b *backupBucket) Validate(_ context.Context, new, _ client.Object) error {
+	bucket, ok := new.(*core.BackupBucket)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", new)
+	}
+
+	if bucket.Spec.ProviderConfig == nil {
+		return nil
+	}
+
+	config, err := admission.DecodeBackupBucketConfig(b.decoder, bucket.Spec.ProviderConfig)
+	if err != nil {
+		return err
+	}
+
+	return gcpvalidation.ValidateBackupBucketConfig(config, bbProviderConfigPath).ToAggregate()
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/validator"
+	gcpinstall "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/install"
+)
+
+var _ = Describe("BackupBucket validator", func() {
+	Describe("#Validate", func() {
+		var (
+			backupBucketValidator extensionswebhook.Validator
+
+			ctrl *gomock.Controller
+			mgr  *mockmanager.MockManager
+
+			backupBucket *core.BackupBucket
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			scheme := runtime.NewScheme()
+			gcpinstall.Install(scheme)
+
+			mgr = mockmanager.NewMockManager(ctrl)
+			mgr.EXPECT().GetScheme().Return(scheme)
+
+			backupBucketValidator = validator.NewBackupBucketValidator(mgr)
+
+			backupBucket = &core.BackupBucket{
+				Spec: core.BackupBucketSpec{
+					Provider: core.BackupBucketProvider{
+						Type:   "gcp",
+						Region: "europe-west1",
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should return err when obj is not a BackupBucket", func() {
+			err := backupBucketValidator.Validate(context.TODO(), &corev1.Secret{}, nil)
+			Expect(err).To(MatchError("wrong object type *v1.Secret"))
+		})
+
+		It("should allow a backup bucket without provider config", func() {
+			Expect(backupBucketValidator.Validate(context.TODO(), backupBucket, nil)).To(Succeed())
+		})
+
+		It("should allow a valid KMS key", func() {
+			backupBucket.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "BackupBucketConfig",
+"kmsKeyName": "projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"
+}`)}
+
+			Expect(backupBucketValidator.Validate(context.TODO(), backupBucket, nil)).To(Succeed())
+		})
+
+		It("should forbid an invalid KMS key", func() {
+			backupBucket.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "BackupBucketConfig",
+"kmsKeyName": "foo"
+}`)}
+
+			Expect(backupBucketValidator.Validate(context.TODO(), backupBucket, nil)).To(MatchError(ContainSubstring("spec.providerConfig.kmsKeyName")))
+		})
+	})
+})
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/capability"
+)
+
+// maxReportedShoots is the maximum number of shoots which are listed in the error of a blocked downgrade.
+const maxReportedShoots = 5
+
+type controllerDeployment struct {
+	apiReader client.Reader
+}
+
+// NewControllerDeploymentValidator returns a new instance of a controller deployment validator.
+func NewControllerDeploymentValidator(mgr manager.Manager) extensionswebhook.Validator {
+	return &controllerDeployment{
+		apiReader: mgr.GetAPIReader(),
+	}
+}
+
+// Validate blocks downgrades of the extension below the minimum version required by the capabilities used by shoots.
+func (c *controllerDeployment) Validate(ctx context.Context, newObj, oldObj client.Object) error {
+	deployment, ok := newObj.(*gardencorev1beta1.ControllerDeployment)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+	if oldObj == nil || deployment.Name != capability.DefaultOptions.ControllerDeploymentName {
+		return nil
+	}
+	oldDeployment, ok := oldObj.(*gardencorev1beta1.ControllerDeployment)
+	if !ok {
+		return fmt.Errorf("wrong object type %T for old object", oldObj)
+	}
+
+	// Deployments whose version cannot be determined, e.g. as they use a custom image, are not checked.
+	version := imageVersion(deployment)
+	if version == nil {
+		return nil
+	}
+	if oldVersion := imageVersion(oldDeployment); oldVersion != nil && !version.LessThan(oldVersion) {
+		return nil
+	}
+
+	// Only the metadata of the shoots is read, as the annotations are sufficient and the shoots can be large.
+	shoots := &metav1.PartialObjectMetadataList{}
+	shoots.SetGroupVersionKind(gardencorev1beta1.SchemeGroupVersion.WithKind("ShootList"))
+	if err := c.apiReader.List(ctx, shoots); err != nil {
+		return fmt.Errorf("could not list shoots: %w", err)
+	}
+
+	var blocking []string
+	for _, shoot := range shoots.Items {
+		required, err := capability.ParseVersion(shoot.Annotations[capability.AnnotationMinProviderVersion])
+		if err != nil || !version.LessThan(required) {
+			continue
+		}
+		blocking = append(blocking, fmt.Sprintf("%s/%s (requires %s for %s)", shoot.Namespace, shoot.Name,
+			capability.FormatVersion(required), shoot.Annotations[capability.AnnotationCapabilities]))
+	}
+	if len(blocking) == 0 {
+		return nil
+	}
+
+	if len(blocking) > maxReportedShoots {
+		blocking = append(blocking[:maxReportedShoots], fmt.Sprintf("and %d more", len(blocking)-maxReportedShoots))
+	}
+	return fmt.Errorf("the extension must not be downgraded to version %s, as shoots use capabilities of newer versions: %s",
+		capability.FormatVersion(version), strings.Join(blocking, ", "))
+}
+
+// imageVersion returns the version of the image tag of the given ControllerDeployment or nil if it cannot be
+// determined.
+func imageVersion(deployment *gardencorev1beta1.ControllerDeployment) *semver.Version {
+	if deployment.ProviderConfig.Raw == nil {
+		return nil
+	}
+
+	providerConfig := struct {
+		Values struct {
+			Image struct {
+				Tag string `json:"tag"`
+			} `json:"image"`
+		} `json:"values"`
+	}{}
+	if err := json.Unmarshal(deployment.ProviderConfig.Raw, &providerConfig); err != nil {
+		return nil
+	}
+
+	version, err := capability.ParseVersion(providerConfig.Values.Image.Tag)
+	if err != nil {
+		return nil
+	}
+	return version
+}
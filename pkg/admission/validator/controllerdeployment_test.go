@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/capability"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/validator"
+)
+
+var _ = Describe("ControllerDeployment validator", func() {
+	Describe("#Validate", func() {
+		var (
+			ctx = context.TODO()
+
+			ctrl *gomock.Controller
+			mgr  *mockmanager.MockManager
+
+			controllerDeploymentValidator extensionswebhook.Validator
+
+			newDeployment = func(name, tag string) *gardencorev1beta1.ControllerDeployment {
+				return &gardencorev1beta1.ControllerDeployment{
+					ObjectMeta:     metav1.ObjectMeta{Name: name},
+					Type:           "helm",
+					ProviderConfig: runtime.RawExtension{Raw: []byte(`{"chart":"H4sI","values":{"image":{"tag":"` + tag + `"}}}`)},
+				}
+			}
+			newShoot = func(name, minVersion string) *gardencorev1beta1.Shoot {
+				shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: name}}
+				if minVersion != "" {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, capability.AnnotationMinProviderVersion, minVersion)
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, capability.AnnotationCapabilities, "vpc-mtu")
+				}
+				return shoot
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			scheme := runtime.NewScheme()
+			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+			apiReader := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newShoot("foo", "v1.35.0"),
+				newShoot("bar", ""),
+			).Build()
+
+			mgr = mockmanager.NewMockManager(ctrl)
+			mgr.EXPECT().GetAPIReader().Return(apiReader)
+
+			controllerDeploymentValidator = validator.NewControllerDeploymentValidator(mgr)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should block downgrades below the minimum version required by shoots", func() {
+			err := controllerDeploymentValidator.Validate(ctx, newDeployment("provider-gcp", "v1.34.2"), newDeployment("provider-gcp", "v1.35.1"))
+			Expect(err).To(MatchError(ContainSubstring("garden-dev/foo (requires v1.35.0 for vpc-mtu)")))
+			Expect(err).NotTo(MatchError(ContainSubstring("garden-dev/bar")))
+		})
+
+		It("should allow downgrades to versions supporting the capabilities of all shoots", func() {
+			Expect(controllerDeploymentValidator.Validate(ctx, newDeployment("provider-gcp", "v1.35.0"), newDeployment("provider-gcp", "v1.35.1"))).To(Succeed())
+		})
+
+		It("should treat development builds as the version they precede", func() {
+			Expect(controllerDeploymentValidator.Validate(ctx, newDeployment("provider-gcp", "v1.35.0-dev-abcdef"), newDeployment("provider-gcp", "v1.35.1"))).To(Succeed())
+		})
+
+		It("should allow upgrades", func() {
+			Expect(controllerDeploymentValidator.Validate(ctx, newDeployment("provider-gcp", "v1.36.0"), newDeployment("provider-gcp", "v1.34.0"))).To(Succeed())
+		})
+
+		It("should allow the creation and updates of other ControllerDeployments", func() {
+			Expect(controllerDeploymentValidator.Validate(ctx, newDeployment("provider-gcp", "v1.34.0"), nil)).To(Succeed())
+			Expect(controllerDeploymentValidator.Validate(ctx, newDeployment("provider-aws", "v1.34.0"), newDeployment("provider-aws", "v1.35.0"))).To(Succeed())
+		})
+
+		It("should allow deployments whose version cannot be determined", func() {
+			Expect(controllerDeploymentValidator.Validate(ctx, newDeployment("provider-gcp", "latest"), newDeployment("provider-gcp", "v1.35.0"))).To(Succeed())
+		})
+	})
+})
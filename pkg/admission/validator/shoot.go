@@ -7,13 +7,18 @@ package validator
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -22,22 +27,32 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/imagescan"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/quota"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	gcpapihelper "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	gcpvalidation "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
 type shoot struct {
 	client         client.Client
+	apiReader      client.Reader
 	decoder        runtime.Decoder
 	lenientDecoder runtime.Decoder
+	imageChecker   *imagescan.Checker
+	quotaReader    *quota.Reader
 }
 
 // NewShootValidator returns a new instance of a shoot validator.
 func NewShootValidator(mgr manager.Manager) extensionswebhook.Validator {
 	return &shoot{
 		client:         mgr.GetClient(),
+		apiReader:      mgr.GetAPIReader(),
 		decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		lenientDecoder: serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder(),
+		imageChecker:   imagescan.NewChecker(mgr, imagescan.DefaultOptions),
+		quotaReader:    quota.NewReader(mgr, quota.DefaultOptions),
 	}
 }
 
@@ -48,9 +63,9 @@ func (s *shoot) Validate(ctx context.Context, new, old client.Object) error {
 		return fmt.Errorf("wrong object type %T", new)
 	}
 
-	// Skip if it's a workerless Shoot
+	// Workerless Shoots do not need any provider specific infrastructure, hence only ensure that none is configured.
 	if gardencorehelper.IsWorkerless(shoot) {
-		return nil
+		return validateWorkerless(shoot).ToAggregate()
 	}
 
 	if old != nil {
@@ -67,6 +82,7 @@ func (s *shoot) Validate(ctx context.Context, new, old client.Object) error {
 var (
 	specPath = field.NewPath("spec")
 
+	dnsPath      = specPath.Child("dns")
 	networkPath  = specPath.Child("networking")
 	providerPath = specPath.Child("provider")
 
@@ -75,6 +91,20 @@ var (
 	workersPath              = providerPath.Child("workers")
 )
 
+// validateWorkerless validates that no worker specific provider configuration is given for workerless Shoots.
+func validateWorkerless(shoot *core.Shoot) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if shoot.Spec.Provider.InfrastructureConfig != nil {
+		allErrs = append(allErrs, field.Forbidden(infrastructureConfigPath, "infrastructureConfig is not supported for workerless Shoots"))
+	}
+	if shoot.Spec.Provider.ControlPlaneConfig != nil {
+		allErrs = append(allErrs, field.Forbidden(controlPlaneConfigPath, "controlPlaneConfig is not supported for workerless Shoots"))
+	}
+
+	return allErrs
+}
+
 type validationContext struct {
 	shoot                *core.Shoot
 	infrastructureConfig *apisgcp.InfrastructureConfig
@@ -115,32 +145,420 @@ func (s *shoot) validateContext(valContext *validationContext) field.ErrorList {
 	if valContext.shoot.Spec.Networking != nil {
 		allErrors = append(allErrors, gcpvalidation.ValidateNetworking(valContext.shoot.Spec.Networking, networkPath)...)
 		allErrors = append(allErrors, gcpvalidation.ValidateInfrastructureConfig(valContext.infrastructureConfig, valContext.shoot.Spec.Networking.Nodes, valContext.shoot.Spec.Networking.Pods, valContext.shoot.Spec.Networking.Services, infrastructureConfigPath)...)
+		allErrors = append(allErrors, validateIPv6Config(valContext.shoot.Spec.Networking, valContext.infrastructureConfig, infrastructureConfigPath.Child("networks", "ipv6"))...)
 	}
+	allErrors = append(allErrors, validateZones(valContext.shoot, valContext.infrastructureConfig, infrastructureConfigPath.Child("networks", "zones"))...)
+	allErrors = append(allErrors, gcpvalidation.ValidateDNS(valContext.shoot.Spec.DNS, dnsPath)...)
 
 	allErrors = append(allErrors, gcpvalidation.ValidateWorkers(valContext.shoot.Spec.Provider.Workers, workersPath)...)
 	allErrors = append(allErrors, gcpvalidation.ValidateControlPlaneConfig(valContext.controlPlaneConfig, allowedZones, workersZones(valContext.shoot.Spec.Provider.Workers), valContext.shoot.Spec.Kubernetes.Version, controlPlaneConfigPath)...)
 
 	// WorkerConfig
+	workerConfigs := make([]*apisgcp.WorkerConfig, len(valContext.shoot.Spec.Provider.Workers))
 	for i, worker := range valContext.shoot.Spec.Provider.Workers {
 		workerFldPath := workersPath.Index(i)
 		workerConfig, err := admission.DecodeWorkerConfig(s.decoder, worker.ProviderConfig)
 		if err != nil {
 			allErrors = append(allErrors, field.Invalid(workerFldPath.Child("providerConfig"), err, "invalid providerConfig"))
 		} else {
-			allErrors = append(allErrors, gcpvalidation.ValidateWorkerConfig(workerConfig, worker.DataVolumes)...)
+			workerConfigs[i] = workerConfig
+			allErrors = append(allErrors, gcpvalidation.ValidateWorkerConfig(workerConfig, worker.Machine.Type, worker.DataVolumes)...)
+			allErrors = append(allErrors, validateAliasIPRangeReference(workerConfig, valContext.infrastructureConfig, workerFldPath.Child("providerConfig", "aliasIPRange", "subnetworkRangeName"))...)
+			allErrors = append(allErrors, validateAliasIPRangePurpose(workerConfig, valContext.infrastructureConfig, shootNodeCIDRMaskSize(valContext.shoot), workerFldPath.Child("providerConfig", "aliasIPRange"))...)
+			allErrors = append(allErrors, validateAliasIPRangeMaxPods(workerConfig, workerMaxPods(valContext.shoot, worker), workerFldPath.Child("providerConfig", "aliasIPRange", "ipCidrRange"))...)
 		}
 	}
 
+	allErrors = append(allErrors, validatePodCIDRCapacity(valContext.shoot, valContext.infrastructureConfig, workerConfigs)...)
+
 	return allErrors
 }
 
+// validatePodCIDRCapacity checks that the ranges from which the pod CIDRs of the nodes are allocated can accommodate
+// the maximum number of nodes of all worker pools, so that the autoscaler is not stopped by an exhausted range. Worker
+// pools with an alias IP range allocate the pod CIDRs from the referenced secondary range, all other worker pools from
+// the pods CIDR of the shoot.
+func validatePodCIDRCapacity(shoot *core.Shoot, infrastructureConfig *apisgcp.InfrastructureConfig, workerConfigs []*apisgcp.WorkerConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		podsMaxNodes  int64
+		rangeMaxNodes = map[string]int64{}
+		rangeMasks    = map[string]int{}
+	)
+	for i, worker := range shoot.Spec.Provider.Workers {
+		workerConfig := workerConfigs[i]
+		if workerConfig == nil || workerConfig.AliasIPRange == nil {
+			podsMaxNodes += int64(worker.Maximum)
+			continue
+		}
+
+		// Malformed ranges are already reported by the worker config validation.
+		mask, err := strconv.Atoi(strings.TrimPrefix(workerConfig.AliasIPRange.IPCidrRange, "/"))
+		if err != nil {
+			continue
+		}
+		name := workerConfig.AliasIPRange.SubnetworkRangeName
+		rangeMaxNodes[name] += int64(worker.Maximum)
+		// Worker pools which allocate larger alias IP ranges from the same secondary range use up more of its capacity.
+		if current, ok := rangeMasks[name]; !ok || mask < current {
+			rangeMasks[name] = mask
+		}
+	}
+
+	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Pods != nil && podsMaxNodes > 0 {
+		nodeCIDRMaskSize := shootNodeCIDRMaskSize(shoot)
+		if capacity, ok := cidrCapacity(*shoot.Spec.Networking.Pods, nodeCIDRMaskSize); ok && podsMaxNodes > capacity {
+			allErrs = append(allErrs, field.Forbidden(networkPath.Child("pods"), fmt.Sprintf("pods CIDR %s only provides %d node CIDRs of size /%d but the worker pools can scale up to %d nodes", *shoot.Spec.Networking.Pods, capacity, nodeCIDRMaskSize, podsMaxNodes)))
+		}
+	}
+
+	for i, secondaryRange := range infrastructureConfig.Networks.SecondaryRanges {
+		maxNodes, ok := rangeMaxNodes[secondaryRange.Name]
+		if !ok || maxNodes == 0 {
+			continue
+		}
+		mask := rangeMasks[secondaryRange.Name]
+		if capacity, ok := cidrCapacity(secondaryRange.CIDR, mask); ok && maxNodes > capacity {
+			allErrs = append(allErrs, field.Forbidden(infrastructureConfigPath.Child("networks", "secondaryRanges").Index(i).Child("cidr"), fmt.Sprintf("secondary range %s only provides %d alias IP ranges of size /%d but the worker pools using it can scale up to %d nodes", secondaryRange.CIDR, capacity, mask, maxNodes)))
+		}
+	}
+
+	return allErrs
+}
+
+// defaultNodeCIDRMaskSize is the size of the pod CIDR of each node if it is not configured for the kube-controller-manager.
+const defaultNodeCIDRMaskSize = 24
+
+// shootNodeCIDRMaskSize returns the size of the pod CIDR of each node of the given shoot.
+func shootNodeCIDRMaskSize(shoot *core.Shoot) int {
+	if kcm := shoot.Spec.Kubernetes.KubeControllerManager; kcm != nil && kcm.NodeCIDRMaskSize != nil {
+		return int(*kcm.NodeCIDRMaskSize)
+	}
+	return defaultNodeCIDRMaskSize
+}
+
+// cidrCapacity returns the number of ranges with the given mask size which fit into the given IPv4 CIDR. It returns
+// false if the CIDR is not a valid IPv4 CIDR or if the mask size does not fit, as this is reported by other validations.
+func cidrCapacity(cidr string, maskSize int) (int64, bool) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil || ipNet.IP.To4() == nil {
+		return 0, false
+	}
+	prefix, _ := ipNet.Mask.Size()
+	if maskSize < prefix || maskSize > 32 {
+		return 0, false
+	}
+	return int64(1) << (maskSize - prefix), true
+}
+
+// validateAliasIPRangeReference checks that the secondary range referenced by the worker's alias IP range is defined
+// in the infrastructure config.
+func validateAliasIPRangeReference(workerConfig *apisgcp.WorkerConfig, infrastructureConfig *apisgcp.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.AliasIPRange == nil || workerConfig.AliasIPRange.SubnetworkRangeName == "" {
+		return allErrs
+	}
+
+	rangeNames := sets.New[string]()
+	for _, secondaryRange := range infrastructureConfig.Networks.SecondaryRanges {
+		rangeNames.Insert(secondaryRange.Name)
+	}
+
+	if !rangeNames.Has(workerConfig.AliasIPRange.SubnetworkRangeName) {
+		allErrs = append(allErrs, field.NotFound(fldPath, workerConfig.AliasIPRange.SubnetworkRangeName))
+	}
+
+	return allErrs
+}
+
+// validateAliasIPRangePurpose checks that the worker's alias IP range is not allocated from the secondary range of the
+// services and that alias IP ranges allocated from the secondary range of the pods have the size of the pod CIDRs of the
+// nodes, as the nodes use their alias IP range as pod CIDR with VPC-native networking.
+func validateAliasIPRangePurpose(workerConfig *apisgcp.WorkerConfig, infrastructureConfig *apisgcp.InfrastructureConfig, nodeCIDRMaskSize int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.AliasIPRange == nil {
+		return allErrs
+	}
+
+	for _, secondaryRange := range infrastructureConfig.Networks.SecondaryRanges {
+		if secondaryRange.Name != workerConfig.AliasIPRange.SubnetworkRangeName || secondaryRange.Purpose == nil {
+			continue
+		}
+		switch *secondaryRange.Purpose {
+		case apisgcp.SecondaryRangePurposeServices:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnetworkRangeName"), fmt.Sprintf("alias IP ranges cannot be allocated from secondary range %s for services", secondaryRange.Name)))
+		case apisgcp.SecondaryRangePurposePods:
+			if expected := fmt.Sprintf("/%d", nodeCIDRMaskSize); workerConfig.AliasIPRange.IPCidrRange != expected {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("ipCidrRange"), workerConfig.AliasIPRange.IPCidrRange, fmt.Sprintf("alias IP ranges allocated from secondary range %s for pods must have the size %s of the pod CIDRs of the nodes", secondaryRange.Name, expected)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateIPv6Config checks that the IPv6 configuration of the subnets is only set for dual-stack shoots.
+func validateIPv6Config(networking *core.Networking, infrastructureConfig *apisgcp.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if infrastructureConfig.Networks.IPv6 == nil {
+		return allErrs
+	}
+
+	if len(networking.IPFamilies) != 2 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "IPv6 configuration is only supported for dual-stack shoots"))
+	}
+
+	return allErrs
+}
+
+// validateZones checks that the subnets of zones are only used by IPv4 single-stack shoots, that the zones belong to the
+// region of the shoot and that a subnet is configured for each zone of the worker pools.
+func validateZones(shoot *core.Shoot, infrastructureConfig *apisgcp.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(infrastructureConfig.Networks.Zones) == 0 {
+		return allErrs
+	}
+
+	if shoot.Spec.Networking != nil && slices.Contains(shoot.Spec.Networking.IPFamilies, core.IPFamilyIPv6) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "subnets of zones are only supported for IPv4 single-stack shoots"))
+	}
+
+	zones := sets.New[string]()
+	for i, zone := range infrastructureConfig.Networks.Zones {
+		// The subnets are named after the suffix of the zone, which is only unique within the region.
+		if !strings.HasPrefix(zone.Name, shoot.Spec.Region+"-") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), zone.Name, fmt.Sprintf("must be a zone of region %s", shoot.Spec.Region)))
+		}
+		zones.Insert(zone.Name)
+	}
+	for i, worker := range shoot.Spec.Provider.Workers {
+		for j, zone := range worker.Zones {
+			if !zones.Has(zone) {
+				allErrs = append(allErrs, field.Invalid(workersPath.Index(i).Child("zones").Index(j), zone, "no subnet is configured for the zone in the infrastructure config"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateAliasIPRangeMaxPods checks that the alias IP range allocated for each node is large enough for the maximum
+// number of pods per node.
+func validateAliasIPRangeMaxPods(workerConfig *apisgcp.WorkerConfig, maxPods int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || workerConfig.AliasIPRange == nil {
+		return allErrs
+	}
+
+	// Malformed ranges are already reported by the worker config validation.
+	aliasIPRangeMaxPods, err := gcpapihelper.MaxPodsForAliasIPRange(workerConfig.AliasIPRange.IPCidrRange)
+	if err != nil {
+		return allErrs
+	}
+
+	if maxPods > aliasIPRangeMaxPods {
+		allErrs = append(allErrs, field.Invalid(fldPath, workerConfig.AliasIPRange.IPCidrRange, fmt.Sprintf("alias IP range only allows %d pods per node but maxPods is %d", aliasIPRangeMaxPods, maxPods)))
+	}
+
+	return allErrs
+}
+
+// workerMaxPods returns the maximum number of pods per node of the given worker.
+func workerMaxPods(shoot *core.Shoot, worker core.Worker) int32 {
+	if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil && worker.Kubernetes.Kubelet.MaxPods != nil {
+		return *worker.Kubernetes.Kubelet.MaxPods
+	}
+	if shoot.Spec.Kubernetes.Kubelet != nil && shoot.Spec.Kubernetes.Kubelet.MaxPods != nil {
+		return *shoot.Spec.Kubernetes.Kubelet.MaxPods
+	}
+	return gcpapihelper.DefaultMaxPods
+}
+
+// validateMachineImages rejects machine image versions which are known to be vulnerable if the machine image check
+// runs in blocking mode. Only machine images which are newly used by a worker pool are checked, so that shoots which
+// already use a vulnerable machine image can still be updated, e.g. to a fixed version.
+func (s *shoot) validateMachineImages(ctx context.Context, oldShoot, shoot *core.Shoot) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if s.imageChecker == nil || s.imageChecker.Mode() != imagescan.ModeBlock {
+		return allErrs
+	}
+
+	oldImages := map[string]imagescan.Image{}
+	if oldShoot != nil {
+		for _, worker := range oldShoot.Spec.Provider.Workers {
+			if image := machineImage(worker); image != nil {
+				oldImages[worker.Name] = *image
+			}
+		}
+	}
+
+	var (
+		images        []imagescan.Image
+		workerIndices = map[string]int{}
+	)
+	for i, worker := range shoot.Spec.Provider.Workers {
+		image := machineImage(worker)
+		if image == nil || oldImages[worker.Name] == *image {
+			continue
+		}
+		images = append(images, *image)
+		workerIndices[worker.Name] = i
+	}
+	if len(images) == 0 {
+		return allErrs
+	}
+
+	findings, err := s.imageChecker.Check(ctx, images)
+	if err != nil {
+		// An outage of the scanning pipeline must not block shoots.
+		logger.Error(err, "Could not check machine images for vulnerabilities", "shoot", client.ObjectKeyFromObject(shoot))
+		return allErrs
+	}
+
+	for _, finding := range findings {
+		allErrs = append(allErrs, field.Forbidden(workersPath.Index(workerIndices[finding.Worker]).Child("machine", "image", "version"), finding.String()))
+	}
+
+	return allErrs
+}
+
+func machineImage(worker core.Worker) *imagescan.Image {
+	if worker.Machine.Image == nil || worker.Machine.Image.Version == "" {
+		return nil
+	}
+	return &imagescan.Image{Worker: worker.Name, Name: worker.Machine.Image.Name, Version: worker.Machine.Image.Version}
+}
+
+// validateQuotas rejects shoots whose worker pools require more CPUs at their maximum than the CPU quotas of the
+// project of the shoot in its region allow, if the quotas are published. Only increases of the required CPUs are
+// rejected, so that shoots can still be updated after a quota was lowered. Updates are only checked if the machine
+// types or maximums of the workers or the region change, since the project is read with the API reader.
+func (s *shoot) validateQuotas(ctx context.Context, oldShoot, shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if s.quotaReader == nil || shoot.Spec.SecretBindingName == nil {
+		return allErrs
+	}
+	if oldShoot != nil && !quotaRelevantChange(oldShoot, shoot) {
+		return allErrs
+	}
+
+	demand := maximumCPUs(shoot.Spec.Provider.Workers, cloudProfile)
+	if oldShoot != nil && oldShoot.Spec.Region == shoot.Spec.Region {
+		for metric, cpus := range maximumCPUs(oldShoot.Spec.Provider.Workers, cloudProfile) {
+			if demand[metric] <= cpus {
+				delete(demand, metric)
+			}
+		}
+	}
+	if len(demand) == 0 {
+		return allErrs
+	}
+
+	// Missing or outdated quotas must not block shoots.
+	project, err := s.shootProject(ctx, shoot)
+	if err != nil {
+		logger.Error(err, "Could not determine project of shoot", "shoot", client.ObjectKeyFromObject(shoot))
+		return allErrs
+	}
+	report, err := s.quotaReader.Report(ctx, project, shoot.Spec.Region)
+	if err != nil {
+		logger.Error(err, "Could not read compute quotas", "shoot", client.ObjectKeyFromObject(shoot))
+		return allErrs
+	}
+	if report == nil {
+		return allErrs
+	}
+
+	for _, metric := range sets.List(sets.KeySet(demand)) {
+		if q := report.Quota(metric); q != nil && demand[metric] > q.Limit {
+			allErrs = append(allErrs, field.Forbidden(workersPath, fmt.Sprintf("the worker pools require up to %.0f %s at their maximum, but the quota of project %s in region %s is %.0f", demand[metric], metric, project, shoot.Spec.Region, q.Limit)))
+		}
+	}
+
+	return allErrs
+}
+
+// quotaRelevantChange returns whether the region or the machine type or maximum of a worker pool differ between the
+// given shoots.
+func quotaRelevantChange(oldShoot, shoot *core.Shoot) bool {
+	if oldShoot.Spec.Region != shoot.Spec.Region || len(oldShoot.Spec.Provider.Workers) != len(shoot.Spec.Provider.Workers) {
+		return true
+	}
+
+	oldWorkers := make(map[string]core.Worker, len(oldShoot.Spec.Provider.Workers))
+	for _, worker := range oldShoot.Spec.Provider.Workers {
+		oldWorkers[worker.Name] = worker
+	}
+	for _, worker := range shoot.Spec.Provider.Workers {
+		oldWorker, ok := oldWorkers[worker.Name]
+		if !ok || oldWorker.Machine.Type != worker.Machine.Type || oldWorker.Maximum != worker.Maximum {
+			return true
+		}
+	}
+	return false
+}
+
+// maximumCPUs returns the CPUs of the given worker pools at their maximum per quota metric, i.e. in total and per
+// machine family.
+func maximumCPUs(workers []core.Worker, cloudProfile *gardencorev1beta1.CloudProfile) map[string]float64 {
+	cpus := map[string]float64{}
+	for _, worker := range workers {
+		machineCPUs, _, ok := gcpapihelper.CustomMachineTypeResources(worker.Machine.Type)
+		if !ok && cloudProfile != nil {
+			for _, machineType := range cloudProfile.Spec.MachineTypes {
+				if machineType.Name == worker.Machine.Type {
+					machineCPUs = machineType.CPU.Value()
+				}
+			}
+		}
+		if machineCPUs == 0 {
+			continue
+		}
+
+		family, _, _ := strings.Cut(worker.Machine.Type, "-")
+		cpus[quota.MetricCPUs] += float64(machineCPUs) * float64(worker.Maximum)
+		cpus[strings.ToUpper(family)+"_"+quota.MetricCPUs] += float64(machineCPUs) * float64(worker.Maximum)
+	}
+	return cpus
+}
+
+// shootProject returns the ID of the GCP project of the given shoot, which is read from the secret referenced by its
+// secret binding.
+func (s *shoot) shootProject(ctx context.Context, shoot *core.Shoot) (string, error) {
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := s.apiReader.Get(ctx, kutil.Key(shoot.Namespace, *shoot.Spec.SecretBindingName), secretBinding); err != nil {
+		return "", err
+	}
+	secret := &corev1.Secret{}
+	if err := s.apiReader.Get(ctx, kutil.Key(secretBinding.SecretRef.Namespace, secretBinding.SecretRef.Name), secret); err != nil {
+		return "", err
+	}
+	serviceAccount, err := gcp.GetServiceAccountFromSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return serviceAccount.ProjectID, nil
+}
+
 func (s *shoot) validateCreate(ctx context.Context, shoot *core.Shoot) error {
 	validationContext, err := newValidationContext(ctx, s.decoder, s.client, shoot)
 	if err != nil {
 		return err
 	}
 
-	return s.validateContext(validationContext).ToAggregate()
+	allErrors := s.validateContext(validationContext)
+	allErrors = append(allErrors, s.validateMachineImages(ctx, nil, shoot)...)
+	allErrors = append(allErrors, s.validateQuotas(ctx, nil, shoot, validationContext.cloudProfile)...)
+
+	return allErrors.ToAggregate()
 }
 
 func (s *shoot) validateUpdate(ctx context.Context, oldShoot, currentShoot *core.Shoot) error {
@@ -170,6 +588,8 @@ func (s *shoot) validateUpdate(ctx context.Context, oldShoot, currentShoot *core
 
 	allErrors = append(allErrors, gcpvalidation.ValidateWorkersUpdate(oldValContext.shoot.Spec.Provider.Workers, currentValContext.shoot.Spec.Provider.Workers, workersPath)...)
 	allErrors = append(allErrors, s.validateContext(currentValContext)...)
+	allErrors = append(allErrors, s.validateMachineImages(ctx, oldShoot, currentShoot)...)
+	allErrors = append(allErrors, s.validateQuotas(ctx, oldShoot, currentShoot, currentValContext.cloudProfile)...)
 
 	return allErrors.ToAggregate()
 
@@ -10,16 +10,24 @@ import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/test"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/quota"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/admission/validator"
+	gcpinstall "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/install"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
 var _ = Describe("Shoot validator", func() {
@@ -29,10 +37,12 @@ var _ = Describe("Shoot validator", func() {
 		var (
 			shootValidator extensionswebhook.Validator
 
-			ctrl  *gomock.Controller
-			c     *mockclient.MockClient
-			mgr   *mockmanager.MockManager
-			shoot *core.Shoot
+			ctrl      *gomock.Controller
+			c         *mockclient.MockClient
+			apiReader client.Client
+			mgr       *mockmanager.MockManager
+			scheme    *runtime.Scheme
+			shoot     *core.Shoot
 
 			ctx = context.TODO()
 		)
@@ -40,14 +50,18 @@ var _ = Describe("Shoot validator", func() {
 		BeforeEach(func() {
 			ctrl = gomock.NewController(GinkgoT())
 
-			scheme := runtime.NewScheme()
+			scheme = runtime.NewScheme()
 			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			gcpinstall.Install(scheme)
 
 			c = mockclient.NewMockClient(ctrl)
+			apiReader = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
 
 			mgr = mockmanager.NewMockManager(ctrl)
 			mgr.EXPECT().GetScheme().Return(scheme).Times(2)
 			mgr.EXPECT().GetClient().Return(c)
+			mgr.EXPECT().GetAPIReader().Return(apiReader)
 			shootValidator = validator.NewShootValidator(mgr)
 
 			shoot = &core.Shoot{
@@ -78,6 +92,292 @@ var _ = Describe("Shoot validator", func() {
 				err := shootValidator.Validate(ctx, shoot, nil)
 				Expect(err).NotTo(HaveOccurred())
 			})
+
+			It("should forbid worker specific provider configuration", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{}`)}
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: []byte(`{}`)}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(And(
+					ContainSubstring("spec.provider.infrastructureConfig: Forbidden"),
+					ContainSubstring("spec.provider.controlPlaneConfig: Forbidden"),
+				)))
+			})
+		})
+
+		Context("Shoot with alias IP ranges", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16","secondaryRanges":[{"name":"pods","cidr":"100.96.0.0/11"}]}}`)}
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-a"}`)}
+				shoot.Spec.Provider.Workers = []core.Worker{{
+					Name:    "worker",
+					Machine: core.Machine{Type: "n1-standard-4"},
+					Volume:  &core.Volume{Type: ptr.To("pd-standard"), VolumeSize: "50Gi"},
+					Zones:   []string{"us-west1-a"},
+				}}
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, gomock.AssignableToTypeOf(&gardencorev1beta1.CloudProfile{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, cloudProfile *gardencorev1beta1.CloudProfile, _ ...client.GetOption) error {
+						cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig"}`)}
+						return nil
+					})
+			})
+
+			It("should forbid alias IP ranges which are too small for the default maximum number of pods", func() {
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","aliasIPRange":{"subnetworkRangeName":"pods","ipCidrRange":"/25"}}`)}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.workers[0].providerConfig.aliasIPRange.ipCidrRange: Invalid value: \"/25\": alias IP range only allows 64 pods per node but maxPods is 110")))
+			})
+
+			It("should consider the maximum number of pods of the worker", func() {
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","aliasIPRange":{"subnetworkRangeName":"pods","ipCidrRange":"/25"}}`)}
+				shoot.Spec.Provider.Workers[0].Kubernetes = &core.WorkerKubernetes{Kubelet: &core.KubeletConfig{MaxPods: ptr.To[int32](64)}}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				if err != nil {
+					Expect(err.Error()).NotTo(ContainSubstring("aliasIPRange.ipCidrRange"))
+				}
+			})
+
+			It("should forbid alias IP ranges from the secondary range for pods which differ from the pod CIDRs of the nodes", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16","secondaryRanges":[{"name":"pods","cidr":"100.96.0.0/11","purpose":"pods"}]}}`)}
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","aliasIPRange":{"subnetworkRangeName":"pods","ipCidrRange":"/23"}}`)}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.workers[0].providerConfig.aliasIPRange.ipCidrRange: Invalid value: \"/23\": alias IP ranges allocated from secondary range pods for pods must have the size /24 of the pod CIDRs of the nodes")))
+			})
+
+			It("should forbid alias IP ranges from the secondary range for services", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16","secondaryRanges":[{"name":"pods","cidr":"100.96.0.0/11","purpose":"services"}]}}`)}
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","aliasIPRange":{"subnetworkRangeName":"pods","ipCidrRange":"/24"}}`)}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.workers[0].providerConfig.aliasIPRange.subnetworkRangeName: Forbidden: alias IP ranges cannot be allocated from secondary range pods for services")))
+			})
+		})
+
+		Context("Shoot with limited pod CIDR capacity", func() {
+			BeforeEach(func() {
+				shoot.Spec.Networking.Pods = ptr.To("100.96.0.0/16")
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16","secondaryRanges":[{"name":"pods","cidr":"100.64.0.0/16"}]}}`)}
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-a"}`)}
+				shoot.Spec.Provider.Workers = []core.Worker{{
+					Name:    "worker",
+					Machine: core.Machine{Type: "n1-standard-4"},
+					Volume:  &core.Volume{Type: ptr.To("pd-standard"), VolumeSize: "50Gi"},
+					Zones:   []string{"us-west1-a"},
+					Maximum: 200,
+				}}
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, gomock.AssignableToTypeOf(&gardencorev1beta1.CloudProfile{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, cloudProfile *gardencorev1beta1.CloudProfile, _ ...client.GetOption) error {
+						cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig"}`)}
+						return nil
+					})
+			})
+
+			It("should allow worker pools which fit into the pods CIDR", func() {
+				err := shootValidator.Validate(ctx, shoot, nil)
+				if err != nil {
+					Expect(err.Error()).NotTo(ContainSubstring("spec.networking.pods"))
+				}
+			})
+
+			It("should forbid worker pools which can scale beyond the capacity of the pods CIDR", func() {
+				worker := shoot.Spec.Provider.Workers[0]
+				worker.Name = "worker2"
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, worker)
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.networking.pods: Forbidden: pods CIDR 100.96.0.0/16 only provides 256 node CIDRs of size /24 but the worker pools can scale up to 400 nodes")))
+			})
+
+			It("should consider the node CIDR mask size of the kube-controller-manager", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager = &core.KubeControllerManagerConfig{NodeCIDRMaskSize: ptr.To[int32](23)}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.networking.pods: Forbidden: pods CIDR 100.96.0.0/16 only provides 128 node CIDRs of size /23 but the worker pools can scale up to 200 nodes")))
+			})
+
+			It("should forbid worker pools which can scale beyond the capacity of their secondary range", func() {
+				shoot.Spec.Provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","aliasIPRange":{"subnetworkRangeName":"pods","ipCidrRange":"/23"}}`)}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.infrastructureConfig.networks.secondaryRanges[0].cidr: Forbidden: secondary range 100.64.0.0/16 only provides 128 alias IP ranges of size /23 but the worker pools using it can scale up to 200 nodes")))
+				Expect(err.Error()).NotTo(ContainSubstring("spec.networking.pods"))
+			})
+		})
+
+		Context("Shoot with IPv6 configuration", func() {
+			BeforeEach(func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16","ipv6":{"workersAccessType":"EXTERNAL"}}}`)}
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-a"}`)}
+				shoot.Spec.Provider.Workers = []core.Worker{{
+					Name:    "worker",
+					Machine: core.Machine{Type: "n1-standard-4"},
+					Volume:  &core.Volume{Type: ptr.To("pd-standard"), VolumeSize: "50Gi"},
+					Zones:   []string{"us-west1-a"},
+				}}
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, gomock.AssignableToTypeOf(&gardencorev1beta1.CloudProfile{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, cloudProfile *gardencorev1beta1.CloudProfile, _ ...client.GetOption) error {
+						cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig"}`)}
+						return nil
+					})
+			})
+
+			It("should forbid the IPv6 configuration for single-stack shoots", func() {
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.infrastructureConfig.networks.ipv6: Forbidden")))
+			})
+
+			It("should allow the IPv6 configuration for dual-stack shoots", func() {
+				shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				if err != nil {
+					Expect(err.Error()).NotTo(ContainSubstring("networks.ipv6"))
+				}
+			})
+		})
+
+		Context("Shoot with subnets of zones", func() {
+			BeforeEach(func() {
+				shoot.Spec.Region = "us-west1"
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"zones":[{"name":"us-west1-a","workers":"10.250.0.0/18"}]}}`)}
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-a"}`)}
+				shoot.Spec.Provider.Workers = []core.Worker{{
+					Name:    "worker",
+					Machine: core.Machine{Type: "n1-standard-4"},
+					Volume:  &core.Volume{Type: ptr.To("pd-standard"), VolumeSize: "50Gi"},
+					Zones:   []string{"us-west1-a", "us-west1-b"},
+				}}
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, gomock.AssignableToTypeOf(&gardencorev1beta1.CloudProfile{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, cloudProfile *gardencorev1beta1.CloudProfile, _ ...client.GetOption) error {
+						cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig"}`)}
+						return nil
+					})
+			})
+
+			It("should forbid worker zones without a subnet", func() {
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.workers[0].zones[1]: Invalid value: \"us-west1-b\"")))
+			})
+
+			It("should forbid zones of other regions", func() {
+				shoot.Spec.Region = "us-east1"
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.infrastructureConfig.networks.zones[0].name: Invalid value")))
+			})
+
+			It("should forbid subnets of zones for dual-stack shoots", func() {
+				shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.infrastructureConfig.networks.zones: Forbidden")))
+			})
+		})
+
+		Context("Shoot with published compute quotas", func() {
+			BeforeEach(func() {
+				shoot.Spec.SecretBindingName = ptr.To("secret-binding")
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16"}}`)}
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","zone":"us-west1-a"}`)}
+				shoot.Spec.Provider.Workers = []core.Worker{{
+					Name:    "worker",
+					Machine: core.Machine{Type: "n2-standard-4"},
+					Volume:  &core.Volume{Type: ptr.To("pd-standard"), VolumeSize: "50Gi"},
+					Zones:   []string{"us-west1-a"},
+					Maximum: 10,
+				}}
+
+				Expect(apiReader.Create(ctx, &gardencorev1beta1.SecretBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "secret-binding", Namespace: namespace},
+					SecretRef:  corev1.SecretReference{Name: "secret", Namespace: namespace},
+				})).To(Succeed())
+				Expect(apiReader.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+					Data: map[string][]byte{
+						gcp.ServiceAccountJSONField: []byte(`{"project_id": "project", "type": "service_account"}`),
+					},
+				})).To(Succeed())
+
+				DeferCleanup(test.WithVar(&quota.DefaultOptions, quota.Options{
+					Secrets:   []client.ObjectKey{{Namespace: "garden", Name: "quota-project"}},
+					Regions:   []string{"us-west"},
+					ConfigMap: client.ObjectKey{Namespace: "garden", Name: "gcp-compute-quotas"},
+				}))
+				mgr.EXPECT().GetScheme().Return(scheme).Times(2)
+				mgr.EXPECT().GetClient().Return(c).Times(2)
+				mgr.EXPECT().GetAPIReader().Return(apiReader)
+				shootValidator = validator.NewShootValidator(mgr)
+
+				c.EXPECT().Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, gomock.AssignableToTypeOf(&gardencorev1beta1.CloudProfile{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, cloudProfile *gardencorev1beta1.CloudProfile, _ ...client.GetOption) error {
+						cloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig"}`)}
+						cloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{Name: "n2-standard-4", CPU: resource.MustParse("4")}}
+						return nil
+					}).AnyTimes()
+			})
+
+			// expectQuotas expects that the published quotas are read from the cache.
+			expectQuotas := func() {
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: "garden", Name: "gcp-compute-quotas"}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, configMap *corev1.ConfigMap, _ ...client.GetOption) error {
+						configMap.Data = map[string]string{
+							quota.ReportKey("project", "us-west"): "project: project\nregion: us-west\nquotas:\n- metric: CPUS\n  limit: 100\n  usage: 0\n- metric: N2_CPUS\n  limit: 24\n  usage: 0\n",
+						}
+						return nil
+					})
+			}
+
+			It("should forbid worker pools which exceed the CPU quota of their machine family", func() {
+				expectQuotas()
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				Expect(err).To(MatchError(ContainSubstring("spec.provider.workers: Forbidden: the worker pools require up to 40 N2_CPUS at their maximum, but the quota of project project in region us-west is 24")))
+			})
+
+			It("should allow worker pools which fit into the CPU quotas", func() {
+				shoot.Spec.Provider.Workers[0].Maximum = 6
+				expectQuotas()
+
+				err := shootValidator.Validate(ctx, shoot, nil)
+				if err != nil {
+					Expect(err.Error()).NotTo(ContainSubstring("quota"))
+				}
+			})
+
+			It("should allow updates which do not increase the required CPUs", func() {
+				oldShoot := shoot.DeepCopy()
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				if err != nil {
+					Expect(err.Error()).NotTo(ContainSubstring("quota"))
+				}
+			})
+
+			It("should not read the quotas if neither the machines of the workers nor the region change", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Minimum = 2
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				if err != nil {
+					Expect(err.Error()).NotTo(ContainSubstring("quota"))
+				}
+			})
+
+			It("should check all required CPUs against the quotas of a new region", func() {
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.Region = "us-east"
+				expectQuotas()
+
+				err := shootValidator.Validate(ctx, shoot, oldShoot)
+				Expect(err).To(MatchError(ContainSubstring("the worker pools require up to 40 N2_CPUS at their maximum, but the quota of project project in region us-west is 24")))
+			})
 		})
 	})
 })
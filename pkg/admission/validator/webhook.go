@@ -8,6 +8,7 @@ import (
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -22,6 +23,8 @@ const (
 	Name = "validator"
 	// SecretsValidatorName is the name of the secrets validator.
 	SecretsValidatorName = "secrets." + Name
+	// ControllerDeploymentsValidatorName is the name of the controller deployments validator.
+	ControllerDeploymentsValidatorName = "controllerdeployments." + Name
 )
 
 var logger = log.Log.WithName("gcp-validator-webhook")
@@ -39,6 +42,7 @@ func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 			NewShootValidator(mgr):         {{Obj: &core.Shoot{}}},
 			NewCloudProfileValidator(mgr):  {{Obj: &core.CloudProfile{}}},
 			NewSecretBindingValidator(mgr): {{Obj: &core.SecretBinding{}}},
+			NewBackupBucketValidator(mgr):  {{Obj: &core.BackupBucket{}}},
 		},
 		Target: extensionswebhook.TargetSeed,
 		ObjectSelector: &metav1.LabelSelector{
@@ -64,3 +68,18 @@ func NewSecretsWebhook(mgr manager.Manager) (*extensionswebhook.Webhook, error)
 		},
 	})
 }
+
+// NewControllerDeploymentsWebhook creates a new validation webhook for ControllerDeployments.
+func NewControllerDeploymentsWebhook(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Setting up webhook", "name", ControllerDeploymentsValidatorName)
+
+	return extensionswebhook.New(mgr, extensionswebhook.Args{
+		Provider: gcp.Type,
+		Name:     ControllerDeploymentsValidatorName,
+		Path:     "/webhooks/validate/controllerdeployments",
+		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
+			NewControllerDeploymentValidator(mgr): {{Obj: &gardencorev1beta1.ControllerDeployment{}}},
+		},
+		Target: extensionswebhook.TargetSeed,
+	})
+}
@@ -6,6 +6,7 @@ package config
 
 import (
 	healthcheckconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -28,6 +29,70 @@ type ControllerConfiguration struct {
 	// or disable alpha/experimental features.
 	// Default: nil
 	FeatureGates map[string]bool
+	// DNS is the configuration for the dnsrecord controller.
+	DNS *DNS
+	// APIServerInternalLoadBalancers is a list of internal load balancers exposing kube-apiservers whose IP addresses
+	// are pinned.
+	APIServerInternalLoadBalancers []APIServerInternalLoadBalancer
+	// AllowedLocations restricts the regions and zones in which this extension instance provisions shoots.
+	AllowedLocations *AllowedLocations
+	// Bastion is the configuration for the bastion controller.
+	Bastion *Bastion
+}
+
+// Bastion is the configuration for the bastion controller.
+type Bastion struct {
+	// AllowedIngressCIDRs is a list of CIDRs which must contain the ingress CIDRs of bastions. If it is empty, all
+	// ingress CIDRs are allowed.
+	AllowedIngressCIDRs []string
+	// DeniedIngressCIDRs is a list of CIDRs which must not be contained in the ingress CIDRs of bastions, e.g.
+	// `0.0.0.0/0` to forbid bastions which are reachable from the whole internet.
+	DeniedIngressCIDRs []string
+}
+
+// AllowedLocations restricts the regions and zones in which shoots are provisioned.
+type AllowedLocations struct {
+	// Regions is the list of allowed regions. If it is empty, all regions are allowed.
+	Regions []string
+	// Zones is the list of allowed zones. If it is empty, all zones of the allowed regions are allowed.
+	Zones []string
+}
+
+// APIServerInternalLoadBalancer is an internal load balancer exposing kube-apiservers.
+type APIServerInternalLoadBalancer struct {
+	// Namespace is the namespace of the istio ingress gateway whose service is exposed via the internal load balancer.
+	Namespace string
+	// IP is a reserved internal IP address used for the load balancer. If it is not set, the IP address allocated for
+	// the load balancer is persisted and reused if the load balancer is recreated.
+	IP *string
+}
+
+// DNS is the configuration for the dnsrecord controller.
+type DNS struct {
+	// Credentials is a list of credentials used for DNS records of specific domains instead of the credentials
+	// referenced by the DNSRecord.
+	Credentials []DNSCredentials
+	// Delegations is a list of parent domains in which the subdomain zones of the DNS records, e.g. per-shoot zones
+	// hosted in other GCP projects, are delegated by the dnsdelegation controller.
+	Delegations []DNSDelegation
+}
+
+// DNSCredentials are credentials used for the DNS records of a domain.
+type DNSCredentials struct {
+	// Domain is the domain whose DNS records (including those of all subdomains) are managed with the credentials.
+	Domain string
+	// SecretRef is a reference to the secret containing the service account of the GCP project hosting the DNS
+	// managed zone of the domain.
+	SecretRef corev1.SecretReference
+}
+
+// DNSDelegation is a parent domain in which subdomain zones are delegated.
+type DNSDelegation struct {
+	// Domain is the domain of the parent DNS managed zone in which the NS records of the subdomain zones are created.
+	Domain string
+	// SecretRef is a reference to the secret containing the service account of the GCP project hosting the parent DNS
+	// managed zone.
+	SecretRef corev1.SecretReference
 }
 
 // ETCD is an etcd configuration.
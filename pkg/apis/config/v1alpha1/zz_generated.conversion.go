@@ -29,6 +29,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIServerInternalLoadBalancer)(nil), (*config.APIServerInternalLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_APIServerInternalLoadBalancer_To_config_APIServerInternalLoadBalancer(a.(*APIServerInternalLoadBalancer), b.(*config.APIServerInternalLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.APIServerInternalLoadBalancer)(nil), (*APIServerInternalLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_APIServerInternalLoadBalancer_To_v1alpha1_APIServerInternalLoadBalancer(a.(*config.APIServerInternalLoadBalancer), b.(*APIServerInternalLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AllowedLocations)(nil), (*config.AllowedLocations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AllowedLocations_To_config_AllowedLocations(a.(*AllowedLocations), b.(*config.AllowedLocations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AllowedLocations)(nil), (*AllowedLocations)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AllowedLocations_To_v1alpha1_AllowedLocations(a.(*config.AllowedLocations), b.(*AllowedLocations), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Bastion)(nil), (*config.Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Bastion_To_config_Bastion(a.(*Bastion), b.(*config.Bastion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Bastion)(nil), (*Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Bastion_To_v1alpha1_Bastion(a.(*config.Bastion), b.(*Bastion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerConfiguration)(nil), (*config.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(a.(*ControllerConfiguration), b.(*config.ControllerConfiguration), scope)
 	}); err != nil {
@@ -39,6 +69,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*config.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNS_To_config_DNS(a.(*DNS), b.(*config.DNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DNS)(nil), (*DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DNS_To_v1alpha1_DNS(a.(*config.DNS), b.(*DNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSCredentials)(nil), (*config.DNSCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSCredentials_To_config_DNSCredentials(a.(*DNSCredentials), b.(*config.DNSCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DNSCredentials)(nil), (*DNSCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DNSCredentials_To_v1alpha1_DNSCredentials(a.(*config.DNSCredentials), b.(*DNSCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSDelegation)(nil), (*config.DNSDelegation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSDelegation_To_config_DNSDelegation(a.(*DNSDelegation), b.(*config.DNSDelegation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DNSDelegation)(nil), (*DNSDelegation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DNSDelegation_To_v1alpha1_DNSDelegation(a.(*config.DNSDelegation), b.(*DNSDelegation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ETCD)(nil), (*config.ETCD)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ETCD_To_config_ETCD(a.(*ETCD), b.(*config.ETCD), scope)
 	}); err != nil {
@@ -72,6 +132,72 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_APIServerInternalLoadBalancer_To_config_APIServerInternalLoadBalancer(in *APIServerInternalLoadBalancer, out *config.APIServerInternalLoadBalancer, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.IP = (*string)(unsafe.Pointer(in.IP))
+	return nil
+}
+
+// Convert_v1alpha1_APIServerInternalLoadBalancer_To_config_APIServerInternalLoadBalancer is an autogenerated conversion function.
+func Convert_v1alpha1_APIServerInternalLoadBalancer_To_config_APIServerInternalLoadBalancer(in *APIServerInternalLoadBalancer, out *config.APIServerInternalLoadBalancer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_APIServerInternalLoadBalancer_To_config_APIServerInternalLoadBalancer(in, out, s)
+}
+
+func autoConvert_config_APIServerInternalLoadBalancer_To_v1alpha1_APIServerInternalLoadBalancer(in *config.APIServerInternalLoadBalancer, out *APIServerInternalLoadBalancer, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.IP = (*string)(unsafe.Pointer(in.IP))
+	return nil
+}
+
+// Convert_config_APIServerInternalLoadBalancer_To_v1alpha1_APIServerInternalLoadBalancer is an autogenerated conversion function.
+func Convert_config_APIServerInternalLoadBalancer_To_v1alpha1_APIServerInternalLoadBalancer(in *config.APIServerInternalLoadBalancer, out *APIServerInternalLoadBalancer, s conversion.Scope) error {
+	return autoConvert_config_APIServerInternalLoadBalancer_To_v1alpha1_APIServerInternalLoadBalancer(in, out, s)
+}
+
+func autoConvert_v1alpha1_AllowedLocations_To_config_AllowedLocations(in *AllowedLocations, out *config.AllowedLocations, s conversion.Scope) error {
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_v1alpha1_AllowedLocations_To_config_AllowedLocations is an autogenerated conversion function.
+func Convert_v1alpha1_AllowedLocations_To_config_AllowedLocations(in *AllowedLocations, out *config.AllowedLocations, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AllowedLocations_To_config_AllowedLocations(in, out, s)
+}
+
+func autoConvert_config_AllowedLocations_To_v1alpha1_AllowedLocations(in *config.AllowedLocations, out *AllowedLocations, s conversion.Scope) error {
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_config_AllowedLocations_To_v1alpha1_AllowedLocations is an autogenerated conversion function.
+func Convert_config_AllowedLocations_To_v1alpha1_AllowedLocations(in *config.AllowedLocations, out *AllowedLocations, s conversion.Scope) error {
+	return autoConvert_config_AllowedLocations_To_v1alpha1_AllowedLocations(in, out, s)
+}
+
+func autoConvert_v1alpha1_Bastion_To_config_Bastion(in *Bastion, out *config.Bastion, s conversion.Scope) error {
+	out.AllowedIngressCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedIngressCIDRs))
+	out.DeniedIngressCIDRs = *(*[]string)(unsafe.Pointer(&in.DeniedIngressCIDRs))
+	return nil
+}
+
+// Convert_v1alpha1_Bastion_To_config_Bastion is an autogenerated conversion function.
+func Convert_v1alpha1_Bastion_To_config_Bastion(in *Bastion, out *config.Bastion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Bastion_To_config_Bastion(in, out, s)
+}
+
+func autoConvert_config_Bastion_To_v1alpha1_Bastion(in *config.Bastion, out *Bastion, s conversion.Scope) error {
+	out.AllowedIngressCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedIngressCIDRs))
+	out.DeniedIngressCIDRs = *(*[]string)(unsafe.Pointer(&in.DeniedIngressCIDRs))
+	return nil
+}
+
+// Convert_config_Bastion_To_v1alpha1_Bastion is an autogenerated conversion function.
+func Convert_config_Bastion_To_v1alpha1_Bastion(in *config.Bastion, out *Bastion, s conversion.Scope) error {
+	return autoConvert_config_Bastion_To_v1alpha1_Bastion(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(in *ControllerConfiguration, out *config.ControllerConfiguration, s conversion.Scope) error {
 	out.ClientConnection = (*componentbaseconfig.ClientConnectionConfiguration)(unsafe.Pointer(in.ClientConnection))
 	if err := Convert_v1alpha1_ETCD_To_config_ETCD(&in.ETCD, &out.ETCD, s); err != nil {
@@ -79,6 +205,10 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_config_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfig.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.DNS = (*config.DNS)(unsafe.Pointer(in.DNS))
+	out.APIServerInternalLoadBalancers = *(*[]config.APIServerInternalLoadBalancer)(unsafe.Pointer(&in.APIServerInternalLoadBalancers))
+	out.AllowedLocations = (*config.AllowedLocations)(unsafe.Pointer(in.AllowedLocations))
+	out.Bastion = (*config.Bastion)(unsafe.Pointer(in.Bastion))
 	return nil
 }
 
@@ -94,6 +224,10 @@ func autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	}
 	out.HealthCheckConfig = (*apisconfigv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.DNS = (*DNS)(unsafe.Pointer(in.DNS))
+	out.APIServerInternalLoadBalancers = *(*[]APIServerInternalLoadBalancer)(unsafe.Pointer(&in.APIServerInternalLoadBalancers))
+	out.AllowedLocations = (*AllowedLocations)(unsafe.Pointer(in.AllowedLocations))
+	out.Bastion = (*Bastion)(unsafe.Pointer(in.Bastion))
 	return nil
 }
 
@@ -102,6 +236,72 @@ func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_DNS_To_config_DNS(in *DNS, out *config.DNS, s conversion.Scope) error {
+	out.Credentials = *(*[]config.DNSCredentials)(unsafe.Pointer(&in.Credentials))
+	out.Delegations = *(*[]config.DNSDelegation)(unsafe.Pointer(&in.Delegations))
+	return nil
+}
+
+// Convert_v1alpha1_DNS_To_config_DNS is an autogenerated conversion function.
+func Convert_v1alpha1_DNS_To_config_DNS(in *DNS, out *config.DNS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNS_To_config_DNS(in, out, s)
+}
+
+func autoConvert_config_DNS_To_v1alpha1_DNS(in *config.DNS, out *DNS, s conversion.Scope) error {
+	out.Credentials = *(*[]DNSCredentials)(unsafe.Pointer(&in.Credentials))
+	out.Delegations = *(*[]DNSDelegation)(unsafe.Pointer(&in.Delegations))
+	return nil
+}
+
+// Convert_config_DNS_To_v1alpha1_DNS is an autogenerated conversion function.
+func Convert_config_DNS_To_v1alpha1_DNS(in *config.DNS, out *DNS, s conversion.Scope) error {
+	return autoConvert_config_DNS_To_v1alpha1_DNS(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSCredentials_To_config_DNSCredentials(in *DNSCredentials, out *config.DNSCredentials, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1alpha1_DNSCredentials_To_config_DNSCredentials is an autogenerated conversion function.
+func Convert_v1alpha1_DNSCredentials_To_config_DNSCredentials(in *DNSCredentials, out *config.DNSCredentials, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSCredentials_To_config_DNSCredentials(in, out, s)
+}
+
+func autoConvert_config_DNSCredentials_To_v1alpha1_DNSCredentials(in *config.DNSCredentials, out *DNSCredentials, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_config_DNSCredentials_To_v1alpha1_DNSCredentials is an autogenerated conversion function.
+func Convert_config_DNSCredentials_To_v1alpha1_DNSCredentials(in *config.DNSCredentials, out *DNSCredentials, s conversion.Scope) error {
+	return autoConvert_config_DNSCredentials_To_v1alpha1_DNSCredentials(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSDelegation_To_config_DNSDelegation(in *DNSDelegation, out *config.DNSDelegation, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_v1alpha1_DNSDelegation_To_config_DNSDelegation is an autogenerated conversion function.
+func Convert_v1alpha1_DNSDelegation_To_config_DNSDelegation(in *DNSDelegation, out *config.DNSDelegation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSDelegation_To_config_DNSDelegation(in, out, s)
+}
+
+func autoConvert_config_DNSDelegation_To_v1alpha1_DNSDelegation(in *config.DNSDelegation, out *DNSDelegation, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.SecretRef = in.SecretRef
+	return nil
+}
+
+// Convert_config_DNSDelegation_To_v1alpha1_DNSDelegation is an autogenerated conversion function.
+func Convert_config_DNSDelegation_To_v1alpha1_DNSDelegation(in *config.DNSDelegation, out *DNSDelegation, s conversion.Scope) error {
+	return autoConvert_config_DNSDelegation_To_v1alpha1_DNSDelegation(in, out, s)
+}
+
 func autoConvert_v1alpha1_ETCD_To_config_ETCD(in *ETCD, out *config.ETCD, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ETCDStorage_To_config_ETCDStorage(&in.Storage, &out.Storage, s); err != nil {
 		return err
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"slices"
+
+	"github.com/gardener/gardener/extensions/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+const (
+	// MaxErrorHistoryLength is the maximum number of errors kept in the error history of a provider status.
+	MaxErrorHistoryLength = 10
+	// maxErrorMessageLength is the maximum length of a stored error message to keep the provider status small.
+	maxErrorMessageLength = 1024
+)
+
+// RecordError adds the given error which occurred during the given operation to the error history. If the newest
+// record describes the same error, only its count and time are updated. The oldest records are dropped if the
+// history exceeds MaxErrorHistoryLength.
+func RecordError(history []api.ErrorRecord, operation string, err error, now metav1.Time) []api.ErrorRecord {
+	if err == nil {
+		return history
+	}
+
+	message := err.Error()
+	if len(message) > maxErrorMessageLength {
+		message = message[:maxErrorMessageLength]
+	}
+
+	var codes []string
+	for _, code := range util.DetermineErrorCodes(err, KnownCodes) {
+		codes = append(codes, string(code))
+	}
+
+	if n := len(history); n > 0 {
+		last := &history[n-1]
+		if last.Operation == operation && last.Message == message && slices.Equal(last.Codes, codes) {
+			last.Count++
+			last.LastObservedTime = now
+			return history
+		}
+	}
+
+	history = append(history, api.ErrorRecord{
+		Operation:        operation,
+		Codes:            codes,
+		Message:          message,
+		Count:            1,
+		LastObservedTime: now,
+	})
+	if len(history) > MaxErrorHistoryLength {
+		history = history[len(history)-MaxErrorHistoryLength:]
+	}
+
+	return history
+}
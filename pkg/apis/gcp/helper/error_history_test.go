@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+)
+
+var _ = Describe("#RecordError", func() {
+	var (
+		now   = metav1.NewTime(time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+		later = metav1.NewTime(now.Add(time.Minute))
+	)
+
+	It("should not change the history if there is no error", func() {
+		Expect(RecordError(nil, "Reconcile", nil, now)).To(BeNil())
+	})
+
+	It("should append the error with the determined error codes", func() {
+		history := RecordError(nil, "Reconcile", fmt.Errorf("googleapi: Error 403: QUOTA_EXCEEDED"), now)
+
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].Operation).To(Equal("Reconcile"))
+		Expect(history[0].Codes).To(ConsistOf("ERR_INFRA_UNAUTHORIZED", "ERR_INFRA_QUOTA_EXCEEDED"))
+		Expect(history[0].Message).To(Equal("googleapi: Error 403: QUOTA_EXCEEDED"))
+		Expect(history[0].Count).To(Equal(int32(1)))
+		Expect(history[0].LastObservedTime).To(Equal(now))
+	})
+
+	It("should count consecutive occurrences of the same error", func() {
+		history := RecordError(nil, "Reconcile", fmt.Errorf("some error"), now)
+		history = RecordError(history, "Reconcile", fmt.Errorf("some error"), later)
+
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].Count).To(Equal(int32(2)))
+		Expect(history[0].LastObservedTime).To(Equal(later))
+
+		history = RecordError(history, "Delete", fmt.Errorf("some error"), later)
+		Expect(history).To(HaveLen(2))
+	})
+
+	It("should truncate long messages and drop the oldest errors", func() {
+		var history []api.ErrorRecord
+		for i := 0; i < MaxErrorHistoryLength+2; i++ {
+			history = RecordError(history, "Reconcile", fmt.Errorf("error %d", i), now)
+		}
+		Expect(history).To(HaveLen(MaxErrorHistoryLength))
+		Expect(history[0].Message).To(Equal("error 2"))
+
+		history = RecordError(history, "Reconcile", fmt.Errorf("%s", strings.Repeat("x", 2000)), now)
+		Expect(history[MaxErrorHistoryLength-1].Message).To(HaveLen(1024))
+	})
+})
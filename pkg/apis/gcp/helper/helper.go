@@ -6,8 +6,12 @@ package helper
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
@@ -25,6 +29,26 @@ func FindSubnetByPurpose(subnets []api.Subnet, purpose api.SubnetPurpose) (*api.
 	return nil, fmt.Errorf("cannot find subnet with purpose %q", purpose)
 }
 
+// FindSubnetByPurposeAndZone takes a list of subnets and tries to find the first entry whose purpose matches with the
+// given purpose and which belongs to the given zone. If there are no subnets of zones with the given purpose, the first
+// regional subnet with the given purpose is returned. If no such entry is found then an error will be returned.
+func FindSubnetByPurposeAndZone(subnets []api.Subnet, purpose api.SubnetPurpose, zone string) (*api.Subnet, error) {
+	var hasZoneSubnets bool
+	for _, subnet := range subnets {
+		if subnet.Purpose != purpose || subnet.Zone == nil {
+			continue
+		}
+		if *subnet.Zone == zone {
+			return &subnet, nil
+		}
+		hasZoneSubnets = true
+	}
+	if hasZoneSubnets {
+		return nil, fmt.Errorf("cannot find subnet with purpose %q in zone %q", purpose, zone)
+	}
+	return FindSubnetByPurpose(subnets, purpose)
+}
+
 // FindMachineImage takes a list of machine images and tries to find the first entry
 // whose name, version, architecture and zone matches with the given name, version, and zone. If no such entry is
 // found then an error will be returned.
@@ -59,3 +83,222 @@ func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, image
 
 	return "", fmt.Errorf("could not find an image for name %q and architecture %q in version %q", imageName, *architecture, imageVersion)
 }
+
+var machineFamilyRegex = regexp.MustCompile(`^[a-z]+([0-9]+)[a-z]*-`)
+
+// MachineTypeGeneration returns the generation of the machine family of the given machine type, e.g. `3` for
+// `c3-standard-4`. If the generation cannot be determined, `0` is returned.
+func MachineTypeGeneration(machineType string) int {
+	match := machineFamilyRegex.FindStringSubmatch(machineType)
+	if len(match) != 2 {
+		return 0
+	}
+	generation, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+	return generation
+}
+
+// SupportsOnlyNVMeLocalSSD returns true if local SSDs of the given machine type can only be attached via the NVMe
+// interface. This is the case for all machine families starting with the third generation.
+func SupportsOnlyNVMeLocalSSD(machineType string) bool {
+	return MachineTypeGeneration(machineType) >= 3
+}
+
+// acceleratorOptimizedMachineFamilies are the machine families which come with attached GPUs.
+var acceleratorOptimizedMachineFamilies = []string{"a2", "a3", "g2"}
+
+// HasAttachedGPUs returns true if VMs of the given machine type come with attached GPUs, either configured explicitly or
+// as part of an accelerator-optimized machine family.
+func HasAttachedGPUs(machineType string, gpu *api.GPU) bool {
+	if gpu != nil {
+		return true
+	}
+	for _, family := range acceleratorOptimizedMachineFamilies {
+		if strings.HasPrefix(machineType, family+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// acceleratorOptimizedGPUMachineTypeRegex matches the A2 and A3 machine types whose name ends with the number of
+	// attached GPUs, e.g. `a2-highgpu-4g`.
+	acceleratorOptimizedGPUMachineTypeRegex = regexp.MustCompile(`^a[0-9]+-[a-z]+gpu-([0-9]+)g$`)
+	// g2MachineTypeGPUs maps the G2 machine types to the number of attached GPUs.
+	g2MachineTypeGPUs = map[string]int32{
+		"g2-standard-4":  1,
+		"g2-standard-8":  1,
+		"g2-standard-12": 1,
+		"g2-standard-16": 1,
+		"g2-standard-24": 2,
+		"g2-standard-32": 1,
+		"g2-standard-48": 4,
+		"g2-standard-96": 8,
+	}
+	// customMachineTypeRegex matches custom machine types, e.g. `n2-custom-4-16384` or `custom-2-20480-ext`.
+	customMachineTypeRegex = regexp.MustCompile(`^(?:[a-z0-9]+-)?custom-([0-9]+)-([0-9]+)(?:-ext)?$`)
+)
+
+// AttachedGPUCount returns the number of GPUs attached to VMs of the given machine type, either configured explicitly
+// or as part of an accelerator-optimized machine type. It returns 0 if the number is unknown.
+func AttachedGPUCount(machineType string, gpu *api.GPU) int32 {
+	if gpu != nil {
+		return gpu.Count
+	}
+	if count, ok := g2MachineTypeGPUs[machineType]; ok {
+		return count
+	}
+	if match := acceleratorOptimizedGPUMachineTypeRegex.FindStringSubmatch(machineType); len(match) == 2 {
+		if count, err := strconv.ParseInt(match[1], 10, 32); err == nil {
+			return int32(count)
+		}
+	}
+	return 0
+}
+
+// CustomMachineTypeResources returns the number of vCPUs and the memory in MiB (including extended memory) of the
+// given custom machine type. The last return value is false if the machine type is not a custom machine type.
+func CustomMachineTypeResources(machineType string) (int64, int64, bool) {
+	match := customMachineTypeRegex.FindStringSubmatch(machineType)
+	if len(match) != 3 {
+		return 0, 0, false
+	}
+	cpus, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	memory, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return cpus, memory, true
+}
+
+// hyperdiskMLMachineFamilies are the machine families which support Hyperdisk ML volumes.
+// See https://cloud.google.com/compute/docs/disks/hyperdisks#machine-type-support.
+var hyperdiskMLMachineFamilies = []string{"a2", "a3", "c3", "c3d", "g2"}
+
+// SupportsHyperdiskML returns true if Hyperdisk ML volumes can be attached to VMs of the given machine type.
+func SupportsHyperdiskML(machineType string) bool {
+	for _, family := range hyperdiskMLMachineFamilies {
+		if strings.HasPrefix(machineType, family+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// sharedCoreMachineTypes are the machine types which only get a fraction of a physical CPU core.
+var sharedCoreMachineTypes = []string{"e2-micro", "e2-small", "e2-medium", "f1-micro", "g1-small"}
+
+// PersistentDiskLimits returns the maximum number of persistent disks, including the boot disk, and their maximum
+// total size which can be attached to VMs of the given machine type.
+// See https://cloud.google.com/compute/docs/disks#pdnumberlimits.
+func PersistentDiskLimits(machineType string) (int, resource.Quantity) {
+	for _, sharedCore := range sharedCoreMachineTypes {
+		if machineType == sharedCore {
+			return 16, resource.MustParse("3Ti")
+		}
+	}
+	return 128, resource.MustParse("257Ti")
+}
+
+// SupportsLiveMigration returns false if VMs of the given machine type cannot be live migrated during host
+// maintenance events. This is the case for all VMs with attached GPUs.
+func SupportsLiveMigration(machineType string, gpu *api.GPU) bool {
+	return !HasAttachedGPUs(machineType, gpu)
+}
+
+// DefaultMaxPods is the maximum number of pods per node if it is not configured in the kubelet configuration.
+const DefaultMaxPods int32 = 110
+
+// MaxPodsForAliasIPRange returns the maximum number of pods per node which fit into an alias IP range of the given
+// size, e.g. `/24`. Like GKE, only half of the addresses are used at the same time so that the IP addresses of
+// terminated pods are not reused immediately.
+func MaxPodsForAliasIPRange(ipCidrRange string) (int32, error) {
+	prefix, ok := strings.CutPrefix(ipCidrRange, "/")
+	if !ok {
+		return 0, fmt.Errorf("alias IP range %q is not a prefix length in the format '/N'", ipCidrRange)
+	}
+	size, err := strconv.Atoi(prefix)
+	if err != nil || size < 1 || size > 32 {
+		return 0, fmt.Errorf("alias IP range %q has an invalid prefix length", ipCidrRange)
+	}
+	return int32((uint64(1) << (32 - size)) / 2), nil
+}
+
+// DefaultNodeServiceAccountRoles are the IAM roles granted to a gardener-managed node service account if no roles
+// are configured explicitly. They only allow the nodes to write logs and metrics.
+var DefaultNodeServiceAccountRoles = []string{
+	"roles/logging.logWriter",
+	"roles/monitoring.metricWriter",
+	"roles/monitoring.viewer",
+	"roles/stackdriver.resourceMetadata.writer",
+}
+
+// NodeServiceAccountRoles returns the IAM roles which shall be granted to the node service account.
+func NodeServiceAccountRoles(sa *api.NodeServiceAccount) []string {
+	if sa == nil {
+		return nil
+	}
+	if len(sa.Roles) == 0 {
+		return DefaultNodeServiceAccountRoles
+	}
+	return sa.Roles
+}
+
+// WorkerPoolServiceAccountRoles returns the IAM roles which shall be granted to the service account of the given worker
+// pool. They default to the roles of the node service account of the shoot.
+func WorkerPoolServiceAccountRoles(sa *api.NodeServiceAccount, pool api.WorkerPoolServiceAccount) []string {
+	if len(pool.Roles) == 0 {
+		return NodeServiceAccountRoles(sa)
+	}
+	return pool.Roles
+}
+
+// NodeServiceAccountEmail returns the email of the service account created for the nodes of the given worker pool. If
+// no dedicated service account was created for the worker pool, the one of the shoot is returned.
+func NodeServiceAccountEmail(status *api.InfrastructureStatus, pool string) string {
+	for _, sa := range status.WorkerPoolServiceAccounts {
+		if sa.Name == pool {
+			return sa.Email
+		}
+	}
+	return status.ServiceAccountEmail
+}
+
+// WorkersIPv6AccessType returns the IPv6 access type of the worker subnet of dual-stack shoots. It defaults to EXTERNAL.
+func WorkersIPv6AccessType(config *api.IPv6Config) api.IPv6AccessType {
+	if config == nil || config.WorkersAccessType == nil {
+		return api.IPv6AccessTypeExternal
+	}
+	return *config.WorkersAccessType
+}
+
+// InternalIPv6AccessType returns the IPv6 access type of the internal subnet of dual-stack shoots. It defaults to
+// INTERNAL.
+func InternalIPv6AccessType(config *api.IPv6Config) api.IPv6AccessType {
+	if config == nil || config.InternalAccessType == nil {
+		return api.IPv6AccessTypeInternal
+	}
+	return *config.InternalAccessType
+}
+
+// VPCRoutingMode returns the dynamic routing mode of the VPC created by the extension. It defaults to REGIONAL.
+func VPCRoutingMode(routingMode *string) string {
+	if routingMode == nil {
+		return "REGIONAL"
+	}
+	return *routingMode
+}
+
+// VPCMTU returns the maximum transmission unit of the VPC created by the extension. It defaults to 1460.
+func VPCMTU(mtu *int32) int32 {
+	if mtu == nil {
+		return 1460
+	}
+	return *mtu
+}
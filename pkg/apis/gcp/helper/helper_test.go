@@ -7,6 +7,7 @@ package helper_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
@@ -33,6 +34,18 @@ var _ = Describe("Helper", func() {
 		Entry("entry exists", []api.Subnet{{Name: "bar", Purpose: purpose}}, purpose, &api.Subnet{Name: "bar", Purpose: purpose}, false),
 	)
 
+	DescribeTable("#FindSubnetByPurposeAndZone",
+		func(subnets []api.Subnet, purpose api.SubnetPurpose, zone string, expectedSubnet *api.Subnet, expectErr bool) {
+			subnet, err := FindSubnetByPurposeAndZone(subnets, purpose, zone)
+			expectResults(subnet, expectedSubnet, err, expectErr)
+		},
+
+		Entry("list is nil", nil, purpose, "zone-a", nil, true),
+		Entry("regional entry exists", []api.Subnet{{Name: "bar", Purpose: purpose}}, purpose, "zone-a", &api.Subnet{Name: "bar", Purpose: purpose}, false),
+		Entry("entry of zone exists", []api.Subnet{{Name: "bar", Purpose: purpose, Zone: ptr.To("zone-b")}, {Name: "foo", Purpose: purpose, Zone: ptr.To("zone-a")}}, purpose, "zone-a", &api.Subnet{Name: "foo", Purpose: purpose, Zone: ptr.To("zone-a")}, false),
+		Entry("entry of zone not found", []api.Subnet{{Name: "bar", Purpose: purpose, Zone: ptr.To("zone-b")}}, purpose, "zone-a", nil, true),
+	)
+
 	DescribeTable("#FindMachineImage",
 		func(machineImages []api.MachineImage, name, version string, architecture *string, expectedMachineImage *api.MachineImage, expectErr bool) {
 			machineImage, err := FindMachineImage(machineImages, name, version, architecture)
@@ -70,6 +83,195 @@ var _ = Describe("Helper", func() {
 		Entry("profile entry not found (no architecture)", makeProfileMachineImages("ubuntu", "2", ptr.To("bar")), "ubuntu", "1", ptr.To("foo"), ""),
 		Entry("profile entry", makeProfileMachineImages("ubuntu", "1", ptr.To("foo")), "ubuntu", "1", ptr.To("foo"), profileImage),
 	)
+
+	DescribeTable("#MachineTypeGeneration",
+		func(machineType string, expectedGeneration int) {
+			Expect(MachineTypeGeneration(machineType)).To(Equal(expectedGeneration))
+		},
+
+		Entry("first generation", "n1-standard-4", 1),
+		Entry("second generation with suffix", "n2d-standard-4", 2),
+		Entry("third generation", "c3-standard-8", 3),
+		Entry("fourth generation with suffix", "c4a-standard-8", 4),
+		Entry("custom machine type", "custom-4-16384", 0),
+		Entry("empty machine type", "", 0),
+	)
+
+	DescribeTable("#SupportsOnlyNVMeLocalSSD",
+		func(machineType string, expected bool) {
+			Expect(SupportsOnlyNVMeLocalSSD(machineType)).To(Equal(expected))
+		},
+
+		Entry("n1", "n1-standard-4", false),
+		Entry("n2", "n2-standard-4", false),
+		Entry("c3", "c3-standard-8", true),
+		Entry("z3", "z3-highmem-88", true),
+		Entry("unknown", "foo", false),
+	)
+
+	DescribeTable("#HasAttachedGPUs",
+		func(machineType string, gpu *api.GPU, expected bool) {
+			Expect(HasAttachedGPUs(machineType, gpu)).To(Equal(expected))
+		},
+
+		Entry("general purpose", "n2-standard-4", nil, false),
+		Entry("attached gpu", "n1-standard-4", &api.GPU{AcceleratorType: "nvidia-tesla-t4", Count: 1}, true),
+		Entry("a3", "a3-highgpu-8g", nil, true),
+	)
+
+	DescribeTable("#SupportsHyperdiskML",
+		func(machineType string, expected bool) {
+			Expect(SupportsHyperdiskML(machineType)).To(Equal(expected))
+		},
+
+		Entry("a3", "a3-highgpu-8g", true),
+		Entry("c3", "c3-standard-8", true),
+		Entry("c3d", "c3d-standard-8", true),
+		Entry("n2", "n2-standard-8", false),
+		Entry("unknown", "foo", false),
+	)
+
+	DescribeTable("#AttachedGPUCount",
+		func(machineType string, gpu *api.GPU, expected int32) {
+			Expect(AttachedGPUCount(machineType, gpu)).To(Equal(expected))
+		},
+
+		Entry("general purpose", "n2-standard-4", nil, int32(0)),
+		Entry("attached gpu", "n1-standard-4", &api.GPU{AcceleratorType: "nvidia-tesla-t4", Count: 2}, int32(2)),
+		Entry("a2", "a2-highgpu-4g", nil, int32(4)),
+		Entry("a2 mega", "a2-megagpu-16g", nil, int32(16)),
+		Entry("a3", "a3-highgpu-8g", nil, int32(8)),
+		Entry("g2", "g2-standard-24", nil, int32(2)),
+		Entry("unknown g2", "g2-standard-1000", nil, int32(0)),
+	)
+
+	DescribeTable("#CustomMachineTypeResources",
+		func(machineType string, expectedCPUs, expectedMemory int64, expectedOK bool) {
+			cpus, memory, ok := CustomMachineTypeResources(machineType)
+			Expect(ok).To(Equal(expectedOK))
+			Expect(cpus).To(Equal(expectedCPUs))
+			Expect(memory).To(Equal(expectedMemory))
+		},
+
+		Entry("predefined", "n2-standard-4", int64(0), int64(0), false),
+		Entry("n1 custom", "custom-4-16384", int64(4), int64(16384), true),
+		Entry("n2 custom", "n2-custom-8-32768", int64(8), int64(32768), true),
+		Entry("extended memory", "n2d-custom-2-20480-ext", int64(2), int64(20480), true),
+	)
+
+	DescribeTable("#PersistentDiskLimits",
+		func(machineType string, expectedCount int, expectedSize string) {
+			count, size := PersistentDiskLimits(machineType)
+			Expect(count).To(Equal(expectedCount))
+			Expect(size.Cmp(resource.MustParse(expectedSize))).To(BeZero())
+		},
+
+		Entry("shared-core machine type", "e2-small", 16, "3Ti"),
+		Entry("standard machine type", "n2-standard-4", 128, "257Ti"),
+		Entry("custom machine type", "e2-custom-2-4096", 128, "257Ti"),
+	)
+
+	DescribeTable("#SupportsLiveMigration",
+		func(machineType string, gpu *api.GPU, expected bool) {
+			Expect(SupportsLiveMigration(machineType, gpu)).To(Equal(expected))
+		},
+
+		Entry("general purpose", "n2-standard-4", nil, true),
+		Entry("attached gpu", "n1-standard-4", &api.GPU{AcceleratorType: "nvidia-tesla-t4", Count: 1}, false),
+		Entry("a2", "a2-highgpu-1g", nil, false),
+		Entry("g2", "g2-standard-4", nil, false),
+	)
+
+	DescribeTable("#MaxPodsForAliasIPRange",
+		func(ipCidrRange string, expected int32, expectErr bool) {
+			maxPods, err := MaxPodsForAliasIPRange(ipCidrRange)
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(maxPods).To(Equal(expected))
+		},
+
+		Entry("/24", "/24", int32(128), false),
+		Entry("/26", "/26", int32(32), false),
+		Entry("/32", "/32", int32(0), false),
+		Entry("missing slash", "24", int32(0), true),
+		Entry("invalid prefix length", "/33", int32(0), true),
+	)
+
+	DescribeTable("#NodeServiceAccountRoles",
+		func(sa *api.NodeServiceAccount, expected []string) {
+			Expect(NodeServiceAccountRoles(sa)).To(Equal(expected))
+		},
+
+		Entry("no node service account", nil, nil),
+		Entry("default roles", &api.NodeServiceAccount{}, DefaultNodeServiceAccountRoles),
+		Entry("configured roles", &api.NodeServiceAccount{Roles: []string{"roles/foo"}}, []string{"roles/foo"}),
+	)
+
+	DescribeTable("#WorkerPoolServiceAccountRoles",
+		func(sa *api.NodeServiceAccount, pool api.WorkerPoolServiceAccount, expected []string) {
+			Expect(WorkerPoolServiceAccountRoles(sa, pool)).To(Equal(expected))
+		},
+
+		Entry("default roles", &api.NodeServiceAccount{}, api.WorkerPoolServiceAccount{Name: "pool"}, DefaultNodeServiceAccountRoles),
+		Entry("roles of the shoot", &api.NodeServiceAccount{Roles: []string{"roles/foo"}}, api.WorkerPoolServiceAccount{Name: "pool"}, []string{"roles/foo"}),
+		Entry("configured roles", &api.NodeServiceAccount{Roles: []string{"roles/foo"}}, api.WorkerPoolServiceAccount{Name: "pool", Roles: []string{"roles/bar"}}, []string{"roles/bar"}),
+	)
+
+	DescribeTable("#NodeServiceAccountEmail",
+		func(pool, expected string) {
+			status := &api.InfrastructureStatus{
+				ServiceAccountEmail: "shoot@project.iam.gserviceaccount.com",
+				WorkerPoolServiceAccounts: []api.WorkerPoolServiceAccountStatus{
+					{Name: "pool", Email: "pool@project.iam.gserviceaccount.com"},
+				},
+			}
+			Expect(NodeServiceAccountEmail(status, pool)).To(Equal(expected))
+		},
+
+		Entry("worker pool with dedicated service account", "pool", "pool@project.iam.gserviceaccount.com"),
+		Entry("worker pool without dedicated service account", "other", "shoot@project.iam.gserviceaccount.com"),
+	)
+
+	DescribeTable("#WorkersIPv6AccessType",
+		func(config *api.IPv6Config, expected api.IPv6AccessType) {
+			Expect(WorkersIPv6AccessType(config)).To(Equal(expected))
+		},
+
+		Entry("no IPv6 config", nil, api.IPv6AccessTypeExternal),
+		Entry("no access type", &api.IPv6Config{}, api.IPv6AccessTypeExternal),
+		Entry("configured access type", &api.IPv6Config{WorkersAccessType: ptr.To(api.IPv6AccessTypeInternal)}, api.IPv6AccessTypeInternal),
+	)
+
+	DescribeTable("#InternalIPv6AccessType",
+		func(config *api.IPv6Config, expected api.IPv6AccessType) {
+			Expect(InternalIPv6AccessType(config)).To(Equal(expected))
+		},
+
+		Entry("no IPv6 config", nil, api.IPv6AccessTypeInternal),
+		Entry("no access type", &api.IPv6Config{}, api.IPv6AccessTypeInternal),
+		Entry("configured access type", &api.IPv6Config{InternalAccessType: ptr.To(api.IPv6AccessTypeExternal)}, api.IPv6AccessTypeExternal),
+	)
+
+	DescribeTable("#VPCRoutingMode",
+		func(routingMode *string, expected string) {
+			Expect(VPCRoutingMode(routingMode)).To(Equal(expected))
+		},
+
+		Entry("no routing mode", nil, "REGIONAL"),
+		Entry("configured routing mode", ptr.To("GLOBAL"), "GLOBAL"),
+	)
+
+	DescribeTable("#VPCMTU",
+		func(mtu *int32, expected int32) {
+			Expect(VPCMTU(mtu)).To(Equal(expected))
+		},
+
+		Entry("no MTU", nil, int32(1460)),
+		Entry("configured MTU", ptr.To[int32](8896), int32(8896)),
+	)
 })
 
 func makeProfileMachineImages(name, version string, architecture *string) []api.MachineImages {
@@ -49,6 +49,17 @@ func InfrastructureConfigFromInfrastructure(infra *extensionsv1alpha1.Infrastruc
 	return nil, fmt.Errorf("provider config is not set on the infrastructure resource")
 }
 
+// InfrastructureConfigFromRawExtension extracts the InfrastructureConfig from the given provider config of a shoot.
+func InfrastructureConfigFromRawExtension(raw *runtime.RawExtension) (*api.InfrastructureConfig, error) {
+	config := &api.InfrastructureConfig{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := decoder.Decode(raw.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
 // InfrastructureStatusFromRaw extracts the InfrastructureStatus from the
 // ProviderStatus section of the given Infrastructure.
 func InfrastructureStatusFromRaw(raw *runtime.RawExtension) (*api.InfrastructureStatus, error) {
@@ -73,3 +84,48 @@ func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfi
 	}
 	return cloudProfileConfig, nil
 }
+
+// ControlPlaneConfigFromCluster decodes the provider specific control plane configuration of the shoot of a cluster.
+func ControlPlaneConfigFromCluster(cluster *controller.Cluster) (*api.ControlPlaneConfig, error) {
+	var controlPlaneConfig *api.ControlPlaneConfig
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw != nil {
+		controlPlaneConfig = &api.ControlPlaneConfig{}
+		if _, _, err := decoder.Decode(cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw, nil, controlPlaneConfig); err != nil {
+			return nil, fmt.Errorf("could not decode controlPlaneConfig of shoot '%s': %w", kutil.ObjectName(cluster.Shoot), err)
+		}
+	}
+	return controlPlaneConfig, nil
+}
+
+// WorkerConfigFromRawExtension extracts the WorkerConfig from the given provider config of a worker pool.
+func WorkerConfigFromRawExtension(raw *runtime.RawExtension) (*api.WorkerConfig, error) {
+	config := &api.WorkerConfig{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := decoder.Decode(raw.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// BackupBucketConfigFromRawExtension extracts the BackupBucketConfig from the given provider config of a backup bucket.
+func BackupBucketConfigFromRawExtension(raw *runtime.RawExtension) (*api.BackupBucketConfig, error) {
+	config := &api.BackupBucketConfig{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := decoder.Decode(raw.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// DNSRecordConfigFromRawExtension extracts the DNSRecordConfig from the given provider config of a DNS record.
+func DNSRecordConfigFromRawExtension(raw *runtime.RawExtension) (*api.DNSRecordConfig, error) {
+	config := &api.DNSRecordConfig{}
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := decoder.Decode(raw.Raw, nil, config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
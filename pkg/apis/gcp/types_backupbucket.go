@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig is the provider-specific configuration of backup buckets.
+type BackupBucketConfig struct {
+	metav1.TypeMeta
+
+	// Location is the multi-region (e.g. `EU`) or predefined dual-region (e.g. `EUR4`) location of the bucket, which
+	// defaults to the region of the backup. The location of existing buckets cannot be changed.
+	Location *string
+	// TurboReplication specifies whether the turbo replication is enabled for the bucket, which replicates new objects
+	// to the second region of a dual-region location within 15 minutes.
+	TurboReplication bool
+
+	// StorageClass is the default storage class of the objects of the bucket, i.e. `STANDARD` (default), `NEARLINE`,
+	// `COLDLINE` or `ARCHIVE`.
+	StorageClass *string
+	// KmsKeyName is the resource name of the customer-managed Cloud KMS key with which the objects of the bucket are
+	// encrypted. The Cloud Storage service agent of the project must be allowed to use the key.
+	KmsKeyName *string
+
+	// RetentionPeriod is the period for which the objects of the bucket are retained, i.e. they cannot be deleted or
+	// overwritten before they reached this age.
+	RetentionPeriod *metav1.Duration
+	// Locked specifies whether the retention policy of the bucket is locked. A locked retention policy cannot be removed
+	// and its retention period cannot be reduced anymore.
+	Locked bool
+	// EventBasedHold specifies whether an event-based hold is placed on all new objects of the bucket. Held objects cannot
+	// be deleted or overwritten until the hold is released, which is done when the BackupEntry of the objects is deleted.
+	EventBasedHold bool
+	// Versioning specifies whether the object versioning is enabled for the bucket, i.e. whether overwritten and deleted
+	// objects are kept as noncurrent versions.
+	Versioning bool
+	// Lifecycle configures the lifecycle management of the objects of the bucket. If it is not set, the lifecycle rules
+	// of the bucket are not managed.
+	Lifecycle *BackupBucketLifecycle
+}
+
+// BackupBucketLifecycle configures the lifecycle management of the objects of a backup bucket.
+type BackupBucketLifecycle struct {
+	// DeleteAfterDays is the age in days after which objects are deleted.
+	DeleteAfterDays *int32
+	// DeleteNoncurrentAfterDays is the number of days after which noncurrent versions of objects are deleted.
+	DeleteNoncurrentAfterDays *int32
+	// Transitions are the transitions of objects to other storage classes once they reached a certain age.
+	Transitions []LifecycleTransition
+}
+
+// LifecycleTransition is a transition of objects to another storage class.
+type LifecycleTransition struct {
+	// StorageClass is the storage class to which the objects are transitioned, i.e. `NEARLINE`, `COLDLINE` or `ARCHIVE`.
+	StorageClass string
+	// AfterDays is the age in days after which the objects are transitioned.
+	AfterDays int32
+}
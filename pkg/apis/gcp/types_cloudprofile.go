@@ -18,6 +18,35 @@ type CloudProfileConfig struct {
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages
+	// OpsAgent contains the configuration of the Ops Agent which is installed on worker nodes if requested.
+	OpsAgent *OpsAgentConfig
+	// CSIDriver contains the configuration of the image of the persistent disk CSI driver.
+	CSIDriver *CSIDriverConfig
+}
+
+// OpsAgentConfig contains the configuration of the Ops Agent.
+type OpsAgentConfig struct {
+	// Version is the version of the Ops Agent to install, e.g. `2.46.0` or `2.*.*`. Defaults to `latest`.
+	Version *string
+}
+
+// CSIDriverChannel is a channel of the image of the persistent disk CSI driver.
+type CSIDriverChannel string
+
+const (
+	// CSIDriverChannelStable is the channel of the image of the CSI driver released with the extension.
+	CSIDriverChannelStable CSIDriverChannel = "stable"
+	// CSIDriverChannelLatest is the channel of the newest image of the CSI driver shipped with the extension.
+	CSIDriverChannelLatest CSIDriverChannel = "latest"
+)
+
+// CSIDriverConfig contains the configuration of the image of the persistent disk CSI driver.
+type CSIDriverConfig struct {
+	// Channel is the channel of the image of the CSI driver. Defaults to `stable`.
+	Channel *CSIDriverChannel
+	// Image pins the image of the CSI driver, e.g. to roll back a regression of the driver. It takes precedence over
+	// the channel.
+	Image *string
 }
 
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
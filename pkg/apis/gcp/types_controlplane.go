@@ -5,6 +5,7 @@
 package gcp
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -23,12 +24,59 @@ type ControlPlaneConfig struct {
 
 	// Storage contains configuration for the storage in the cluster.
 	Storage *Storage
+
+	// InternalLoadBalancer contains the defaults of the internal load balancers of Services of type LoadBalancer.
+	InternalLoadBalancer *InternalLoadBalancerConfig
+
+	// AuditLogExport configures the export of the audit logs of the kube-apiserver to Cloud Logging.
+	AuditLogExport *AuditLogExport
+}
+
+// AuditLogExport configures the export of the audit logs of the kube-apiserver to Cloud Logging. The audit logs are
+// written to a log in the GCP project of the shoot and routed to the destination by a Cloud Logging sink.
+type AuditLogExport struct {
+	// Destination is the destination of the Cloud Logging sink, i.e. a log bucket
+	// (logging.googleapis.com/projects/<project>/locations/<location>/buckets/<bucket>) or a Pub/Sub topic
+	// (pubsub.googleapis.com/projects/<project>/topics/<topic>).
+	Destination string
+}
+
+// InternalLoadBalancerConfig contains the defaults of internal load balancers, i.e. of the load balancers of Services of
+// type LoadBalancer with the 'networking.gke.io/load-balancer-type: Internal' annotation.
+type InternalLoadBalancerConfig struct {
+	// Subnet is the name of the subnet in the VPC of the shoot in which internal load balancers are created.
+	// Defaults to the internal subnet of the infrastructure.
+	Subnet *string
+	// GlobalAccess controls if internal load balancers can be accessed from all regions. Services can override it with
+	// the 'networking.gke.io/internal-load-balancer-allow-global-access' annotation.
+	// Defaults to false.
+	GlobalAccess *bool
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
+	// Flags contains additional command line flags of the cloud-controller-manager, e.g. `concurrent-service-syncs`.
+	// Only flags which are not managed by the extension are allowed.
+	Flags map[string]string
+	// Resources contains the resource requirements of the cloud-controller-manager, which override the defaults.
+	Resources *corev1.ResourceRequirements
+	// CloudConfig contains settings of the cloud-config of the cloud-controller-manager.
+	CloudConfig *CloudConfig
+}
+
+// CloudConfig contains settings of the GCE cloud-config of the cloud-controller-manager, which are otherwise determined
+// by the extension.
+type CloudConfig struct {
+	// NodeTags are the network tags of the VMs of the nodes, which are targeted by the firewall rules of load balancers.
+	// Defaults to the technical ID of the shoot, which is added to all VMs of the shoot.
+	NodeTags []string
+	// NodeInstancePrefix is the prefix of the names of the VMs of the nodes.
+	NodeInstancePrefix *string
+	// SecondaryRangeName is the name of the secondary range of the worker subnet from which the alias IP ranges of the
+	// nodes are allocated.
+	SecondaryRangeName *string
 }
 
 // Storage contains settings for the default StorageClass and VolumeSnapshotClass
@@ -43,4 +91,92 @@ type Storage struct {
 	// not managed by Gardener to be set as default by the user.
 	// Defaults to true.
 	ManagedDefaultVolumeSnapshotClass *bool
+	// Filestore contains the configuration of the GCP Filestore CSI driver.
+	Filestore *Filestore
+	// VolumeSnapshotClass contains the parameters of the 'default' VolumeSnapshotClass.
+	VolumeSnapshotClass *VolumeSnapshotClassConfig
+	// StorageClasses are the StorageClasses of the persistent disk CSI driver managed in the cluster. If set, they
+	// replace the 'default', 'gce-sc-hdd', 'gce-sc-fast' and 'gce-sc-hyperdisk-ml' StorageClasses.
+	StorageClasses []StorageClass
+	// CSIDriverResources contains the resource requirements of the persistent disk CSI driver, which override the defaults.
+	CSIDriverResources *CSIDriverResources
+}
+
+// Filestore contains the configuration of the GCP Filestore CSI driver, which provisions volumes backed by Filestore
+// instances that can be mounted by multiple nodes (ReadWriteMany).
+type Filestore struct {
+	// Enabled controls if the Filestore CSI driver and its StorageClass are deployed.
+	Enabled bool
+	// Resources contains the resource requirements of the Filestore CSI driver, which override the defaults.
+	Resources *CSIDriverResources
+}
+
+// VolumeSnapshotClassConfig contains the parameters of the 'default' VolumeSnapshotClass.
+type VolumeSnapshotClassConfig struct {
+	// StorageLocation is the Cloud Storage location of the snapshots, i.e. a region or a multi-region like 'eu'.
+	// Defaults to the multi-region of the region of the disk.
+	StorageLocation *string
+	// Labels are added to the snapshots in addition to the labels of the shoot.
+	Labels map[string]string
+}
+
+// StorageClass contains the settings of a StorageClass of the persistent disk CSI driver.
+type StorageClass struct {
+	// Name is the name of the StorageClass.
+	Name string
+	// Default controls if the StorageClass is marked as default.
+	Default bool
+	// Type is the type of the disks, e.g. 'pd-balanced' or 'hyperdisk-balanced'.
+	Type string
+	// ReplicationType is the replication type of the disks, i.e. 'none' or 'regional-pd'.
+	// Defaults to 'none'.
+	ReplicationType *string
+	// ProvisionedIOPS is the number of I/O operations per second provisioned for the disks.
+	ProvisionedIOPS *int64
+	// ProvisionedThroughput is the throughput in MiB per second provisioned for the disks.
+	ProvisionedThroughput *int64
+	// KmsKeyName is the customer-managed encryption key (CMEK) used for the encryption of the disks.
+	KmsKeyName *string
+}
+
+// CSIDriverResources contains the resource requirements of the containers of a CSI driver, keyed by the name of the
+// container. Only the given resources override the defaults, e.g. the default memory request is kept if only the CPU
+// request of a container is given.
+type CSIDriverResources struct {
+	// Controller contains the resource requirements of the containers of the controller in the control plane, i.e.
+	// 'driver', 'provisioner', 'attacher', 'snapshotter', 'resizer' and 'livenessProbe'. The Filestore CSI driver has
+	// no 'attacher' and 'snapshotter' containers.
+	Controller map[string]corev1.ResourceRequirements
+	// Node contains the resource requirements of the containers of the node plugin in the shoot, i.e. 'driver',
+	// 'nodeDriverRegistrar' and 'livenessProbe'.
+	Node map[string]corev1.ResourceRequirements
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControlPlaneStatus contains information about the control plane of a shoot.
+type ControlPlaneStatus struct {
+	metav1.TypeMeta
+
+	// InternalLoadBalancer contains the defaults applied to internal load balancers.
+	InternalLoadBalancer *InternalLoadBalancerStatus
+	// AuditLogExport contains the Cloud Logging sink exporting the audit logs of the kube-apiserver, if configured.
+	AuditLogExport *AuditLogExportStatus
+}
+
+// InternalLoadBalancerStatus contains the defaults applied to internal load balancers.
+type InternalLoadBalancerStatus struct {
+	// Subnet is the name of the subnet in which internal load balancers are created, if any is configured.
+	Subnet string
+	// GlobalAccess is true if internal load balancers can be accessed from all regions by default.
+	GlobalAccess bool
+}
+
+// AuditLogExportStatus contains the Cloud Logging sink exporting the audit logs of the kube-apiserver.
+type AuditLogExportStatus struct {
+	// SinkName is the name of the Cloud Logging sink.
+	SinkName string
+	// WriterIdentity is the identity with which the sink writes to its destination. It must be granted the permission to
+	// write to destinations which are not log buckets of the project of the shoot, e.g. to publish to a Pub/Sub topic.
+	WriterIdentity string
 }
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DiskClone clones the persistent disks of volumes of a source shoot into a target shoot via disk snapshots. It is
+// created in the namespace of the target shoot in the seed.
+type DiskClone struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Spec is the specification of the clone.
+	Spec DiskCloneSpec
+	// Status is the status of the clone.
+	Status DiskCloneStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DiskCloneList is a list of DiskClones.
+type DiskCloneList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// Items is the list of DiskClones.
+	Items []DiskClone
+}
+
+// DiskCloneSpec is the specification of a DiskClone.
+type DiskCloneSpec struct {
+	// SourceNamespace is the namespace of the source shoot in the seed.
+	SourceNamespace string
+	// Claims are the persistent volume claims of the source shoot whose disks are cloned. The clones are provided as
+	// persistent volume claims with the same namespace and name in the target shoot.
+	Claims []DiskCloneClaim
+}
+
+// DiskCloneClaim is a persistent volume claim whose disk is cloned.
+type DiskCloneClaim struct {
+	// Namespace is the namespace of the persistent volume claim.
+	Namespace string
+	// Name is the name of the persistent volume claim.
+	Name string
+	// StorageClassName is the name of the storage class of the persistent volume claim in the target shoot. Defaults
+	// to the storage class of the persistent volume claim in the source shoot.
+	StorageClassName *string
+}
+
+// DiskClonePhase is the phase of the clone of a persistent volume claim.
+type DiskClonePhase string
+
+const (
+	// DiskClonePhasePending means that the disk snapshot is not ready yet.
+	DiskClonePhasePending DiskClonePhase = "Pending"
+	// DiskClonePhaseSucceeded means that the persistent volume claim was created in the target shoot.
+	DiskClonePhaseSucceeded DiskClonePhase = "Succeeded"
+	// DiskClonePhaseFailed means that the persistent volume claim cannot be cloned.
+	DiskClonePhaseFailed DiskClonePhase = "Failed"
+)
+
+// DiskCloneStatus is the status of a DiskClone.
+type DiskCloneStatus struct {
+	// ObservedGeneration is the most recent generation observed for the DiskClone.
+	ObservedGeneration int64
+	// Claims is the status of the clones of the persistent volume claims.
+	Claims []DiskCloneClaimStatus
+}
+
+// DiskCloneClaimStatus is the status of the clone of a persistent volume claim.
+type DiskCloneClaimStatus struct {
+	// Namespace is the namespace of the persistent volume claim.
+	Namespace string
+	// Name is the name of the persistent volume claim.
+	Name string
+	// Snapshot is the name of the disk snapshot of the persistent volume.
+	Snapshot string
+	// Phase is the phase of the clone.
+	Phase DiskClonePhase
+	// Message describes why the clone is pending or failed.
+	Message *string
+}
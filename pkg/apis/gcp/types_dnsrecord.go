@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DNSRecordConfig is the provider-specific configuration of DNS records.
+type DNSRecordConfig struct {
+	metav1.TypeMeta
+
+	// RoutingPolicy is the Cloud DNS routing policy of the record set. If it is set, the record set answers queries with
+	// the values of the targets of the policy instead of the values of the DNSRecord.
+	RoutingPolicy *DNSRoutingPolicy
+}
+
+// DNSRoutingPolicy is a Cloud DNS routing policy. Exactly one of the policies must be set.
+type DNSRoutingPolicy struct {
+	// Weighted are the targets of a weighted round robin policy, which answers queries with the values of a target
+	// chosen randomly in proportion to its weight.
+	Weighted []DNSWeightedTarget
+	// Geo are the targets of a geolocation policy, which answers queries with the values of the target whose location
+	// is closest to the origin of the query.
+	Geo []DNSGeoTarget
+	// Failover is a failover policy, which answers queries with the primary targets as long as one of them is healthy
+	// and with the backup targets otherwise.
+	Failover *DNSFailoverPolicy
+}
+
+// DNSWeightedTarget is a target of a weighted round robin policy.
+type DNSWeightedTarget struct {
+	// Weight is the weight of the target. Targets with a weight of zero do not receive traffic.
+	Weight int32
+	// Values are the values of the record set which are answered for the target.
+	Values []string
+}
+
+// DNSGeoTarget is a target of a geolocation policy.
+type DNSGeoTarget struct {
+	// Location is the GCP region of the target, e.g. `europe-west1`.
+	Location string
+	// Values are the values of the record set which are answered for the target.
+	Values []string
+}
+
+// DNSFailoverPolicy is a failover policy whose primary targets are health checked internal load balancers.
+type DNSFailoverPolicy struct {
+	// Primary are the internal load balancers which are answered as long as at least one of them is healthy.
+	Primary []DNSLoadBalancerTarget
+	// Backup are the targets of the geolocation policy which is used if all primary targets are unhealthy.
+	Backup []DNSGeoTarget
+	// TrickleTrafficPercent is the percentage of the traffic which is sent to the backup targets even if the primary
+	// targets are healthy.
+	TrickleTrafficPercent *int32
+}
+
+// DNSLoadBalancerTarget is an internal load balancer whose health is checked by Cloud DNS.
+type DNSLoadBalancerTarget struct {
+	// Type is the type of the load balancer, i.e. `regionalL4ilb` (default), `regionalL7ilb` or `globalL7ilb`.
+	Type *string
+	// IPAddress is the frontend IP address of the load balancer.
+	IPAddress string
+	// Port is the frontend port of the load balancer.
+	Port string
+	// Protocol is the protocol of the load balancer, i.e. `tcp` (default) or `udp`.
+	Protocol *string
+	// Network is the URL of the VPC network of the load balancer.
+	Network string
+	// Project is the GCP project of the load balancer.
+	Project string
+	// Region is the region of the load balancer, which is required for regional load balancers.
+	Region string
+}
@@ -17,6 +17,33 @@ type InfrastructureConfig struct {
 
 	// Networks is the network configuration (VPC, subnets, etc.)
 	Networks NetworkConfig
+
+	// NodeServiceAccount contains the configuration of the dedicated service account which is created for the
+	// worker nodes of the shoot.
+	NodeServiceAccount *NodeServiceAccount
+
+	// ResourceLabels are labels which are added to the GCP resources created for the shoot which support labels, i.e.
+	// the addresses and forwarding rules of the infrastructure and the VMs and disks of the workers, e.g. to attribute
+	// their costs.
+	ResourceLabels map[string]string
+}
+
+// NodeServiceAccount contains the configuration of the service account created for the worker nodes.
+type NodeServiceAccount struct {
+	// Roles are the IAM roles granted to the service account in the project of the shoot.
+	// If not specified, a least-privileged set of roles sufficient for logging and monitoring is granted.
+	Roles []string
+	// WorkerPools are the worker pools whose nodes use a dedicated service account instead of the one of the shoot.
+	WorkerPools []WorkerPoolServiceAccount
+}
+
+// WorkerPoolServiceAccount contains the configuration of the service account created for the nodes of a worker pool.
+type WorkerPoolServiceAccount struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Roles are the IAM roles granted to the service account of the worker pool in the project of the shoot.
+	// If not specified, the roles of the service account of the shoot are granted.
+	Roles []string
 }
 
 // NetworkConfig holds information about the Kubernetes and infrastructure networks.
@@ -25,17 +52,247 @@ type NetworkConfig struct {
 	VPC *VPC
 	// CloudNAT contains configuration about the the CloudNAT resource
 	CloudNAT *CloudNAT
+	// CloudRouterBGP contains the BGP configuration of the CloudRouter created by the extension, e.g. to connect the VPC
+	// to other networks via Cloud Interconnect or Cloud VPN.
+	CloudRouterBGP *CloudRouterBGP
+	// RoutingMode is the dynamic routing mode of the VPC created by the extension, either `REGIONAL` or `GLOBAL`. Routes
+	// learned by the CloudRouter are only programmed in its region in the `REGIONAL` mode and in all regions in the
+	// `GLOBAL` mode. Defaults to `REGIONAL`.
+	RoutingMode *string
+	// MTU is the maximum transmission unit of the VPC created by the extension in bytes, between 1300 and 8896. Defaults
+	// to 1460.
+	MTU *int32
 	// Internal is a private subnet (used for internal load balancers).
 	Internal *string
+	// ProxyOnly is the range of a proxy-only subnet to create (used for the proxies of internal Envoy-based application
+	// load balancers).
+	ProxyOnly *string
 	// Worker is the worker subnet range to create (used for the VMs).
 	// Deprecated - use `workers` instead.
 	Worker string
 	// Workers is the worker subnet range to create (used for the VMs).
 	Workers string
+	// Zones contains the subnets of the workers per zone, which are created instead of the regional worker and internal
+	// subnets, e.g. to apply zone-specific routing or firewall policies.
+	Zones []Zone
 	// FlowLogs contains the flow log configuration for the subnet.
 	FlowLogs *FlowLogs
+	// PrivateGoogleAccess controls if Private Google Access is enabled on the worker subnet, so that nodes reach Google
+	// APIs and services via their internal IP addresses instead of the CloudNAT. Defaults to false.
+	PrivateGoogleAccess *bool
+	// SecondaryRanges are additional named IP ranges of the worker subnet which can be used for alias IP ranges.
+	SecondaryRanges []SecondaryRange
+	// IPv6 contains the IPv6 configuration of the subnets of dual-stack shoots.
+	IPv6 *IPv6Config
+	// ExistingSubnets references existing subnets of the VPC which are used instead of creating the subnets.
+	ExistingSubnets *ExistingSubnets
+	// PrivateServiceConnectEndpoints are endpoints in the worker subnet for services published via Private Service
+	// Connect.
+	PrivateServiceConnectEndpoints []PrivateServiceConnectEndpoint
+	// AdditionalFirewallRules are firewall rules of the VPC which are managed in addition to the rules created by the
+	// extension.
+	AdditionalFirewallRules []FirewallRule
+	// Peerings are peerings of the VPC with other networks, which are created and deleted together with the VPC.
+	Peerings []VPCPeering
+	// FirewallPolicy is the network firewall policy which is associated with the VPC.
+	FirewallPolicy *FirewallPolicy
+	// PacketMirroring mirrors the traffic of the worker subnet to a collector, e.g. to be inspected by IDS appliances.
+	PacketMirroring *PacketMirroring
+	// PrivateDNSZone is a private Cloud DNS zone for the internal domain of the shoot, which is bound to the VPC.
+	PrivateDNSZone *PrivateDNSZone
+	// NCCSpoke registers the VPC as a spoke of a Network Connectivity Center hub.
+	NCCSpoke *NCCSpoke
+}
+
+// Zone contains the subnets of a zone of the region. GCP subnets are regional, the subnets of a zone are used by the
+// VMs of the zone only.
+type Zone struct {
+	// Name is the name of the zone, e.g. `europe-west1-b`.
+	Name string
+	// Workers is the range of the worker subnet of the zone (used for the VMs). The subnet is named
+	// `<technical-id>-nodes-<suffix>`, where the suffix is the name of the zone without the region, e.g. `b`.
+	Workers string
+	// Internal is the range of a private subnet of the zone (used for internal load balancers). The subnet is named
+	// `<technical-id>-internal-<suffix>`.
+	Internal *string
+}
+
+// PrivateServiceConnectEndpoint is an endpoint for a service published via Private Service Connect.
+type PrivateServiceConnectEndpoint struct {
+	// Name is the name of the endpoint.
+	Name string
+	// ServiceAttachment is the URI of the service attachment of the published service, e.g.
+	// `projects/<project>/regions/<region>/serviceAttachments/<name>`.
+	ServiceAttachment string
+	// IP is the internal IP address of the endpoint in the worker subnet. An address is allocated if it is not set.
+	IP *string
+}
+
+// VPCPeering is a peering of the VPC with another network.
+type VPCPeering struct {
+	// Name is the name of the peering. The peering is named `<technical-id>-<name>`.
+	Name string
+	// Network is the self-link of the peer network, e.g. `projects/<project>/global/networks/<name>`.
+	Network string
+	// ExportCustomRoutes controls whether the custom routes of the VPC are exported to the peer network. Defaults to
+	// false.
+	ExportCustomRoutes *bool
+	// ImportCustomRoutes controls whether the custom routes of the peer network are imported into the VPC. Defaults to
+	// false.
+	ImportCustomRoutes *bool
+}
+
+// FirewallRule is a user-defined firewall rule of the VPC.
+type FirewallRule struct {
+	// Name is the name of the rule. The firewall rule is named `<technical-id>-<name>`.
+	Name string
+	// Direction is the direction of the traffic the rule applies to, either `INGRESS` or `EGRESS`. Defaults to
+	// `INGRESS`.
+	Direction *string
+	// Priority is the priority of the rule between 0 and 65535, where lower values take precedence. Defaults to
+	// 1000.
+	Priority *int32
+	// Ranges are the CIDRs of the sources of ingress traffic or of the destinations of egress traffic.
+	Ranges []string
+	// Allowed are the protocols and ports which are allowed by the rule.
+	Allowed []FirewallRuleProtocol
+	// TargetTags are the network tags of the instances the rule applies to. Defaults to the nodes of the shoot.
+	TargetTags []string
+}
+
+// FirewallPolicy is a global network firewall policy which is associated with the VPC. Exactly one of Name and Rules
+// must be set.
+type FirewallPolicy struct {
+	// Name is the name of an existing global network firewall policy of the project, which is associated with the VPC.
+	// The policy is shared and hence neither modified nor deleted by the extension.
+	Name *string
+	// Rules are the rules of a global network firewall policy which is created for the shoot and associated with the
+	// VPC. The policy is named `<technical-id>` and deleted together with the infrastructure.
+	Rules []FirewallPolicyRule
+}
+
+// FirewallPolicyRule is a rule of a network firewall policy.
+type FirewallPolicyRule struct {
+	// Priority is the priority of the rule between 0 and 2147483643, where lower values take precedence. The priority
+	// identifies the rule and hence must be unique within the policy.
+	Priority int32
+	// Action is the action of the rule, one of `allow`, `deny` or `goto_next`.
+	Action string
+	// Direction is the direction of the traffic the rule applies to, either `INGRESS` or `EGRESS`. Defaults to
+	// `INGRESS`.
+	Direction *string
+	// Ranges are the CIDRs of the sources of ingress traffic or of the destinations of egress traffic.
+	Ranges []string
+	// Protocols are the protocols and ports the rule applies to.
+	Protocols []FirewallRuleProtocol
+	// Description is the description of the rule.
+	Description *string
 }
 
+// PacketMirroring is a packet mirroring policy which mirrors the traffic of the worker subnet. The policy is named
+// `<technical-id>` and deleted together with the infrastructure.
+type PacketMirroring struct {
+	// Collector is the URI of the forwarding rule of the internal load balancer which receives the mirrored packets,
+	// e.g. `projects/<project>/regions/<region>/forwardingRules/<name>`. The forwarding rule must be a packet mirroring
+	// collector in the region of the shoot and in the VPC or a peered network.
+	Collector string
+	// Filter restricts the mirrored traffic. All traffic of the worker subnet is mirrored if it is not set.
+	Filter *PacketMirroringFilter
+	// Priority is the priority of the policy between 0 and 65535, which decides which policy mirrors the traffic if
+	// several policies apply, where lower values take precedence. Defaults to 1000.
+	Priority *int32
+}
+
+// PrivateDNSZone is a private Cloud DNS zone for the internal domain of the shoot. The zone is bound to the VPC and
+// contains the record of the internal domain of the kube-apiserver, so that it is resolved within the VPC without
+// public DNS. The zone is named `<technical-id>` and deleted together with the infrastructure.
+type PrivateDNSZone struct {
+	// Enabled enables the private Cloud DNS zone.
+	Enabled bool
+}
+
+// NCCSpoke is a VPC spoke of a Network Connectivity Center hub. The spoke is named `<technical-id>`, it is attached to
+// the hub when the infrastructure is reconciled and detached when the infrastructure is deleted.
+type NCCSpoke struct {
+	// Hub is the resource name of the hub, i.e. `projects/<project>/locations/global/hubs/<name>`. The hub may belong
+	// to another project.
+	Hub string
+	// ExcludeExportRanges are the IP ranges of the VPC which are not exported to the hub.
+	ExcludeExportRanges []string
+}
+
+// PacketMirroringFilter restricts the traffic mirrored by a packet mirroring policy.
+type PacketMirroringFilter struct {
+	// CIDRRanges are the CIDRs of the sources or destinations of the mirrored traffic. Traffic of all sources and
+	// destinations is mirrored if no ranges are specified.
+	CIDRRanges []string
+	// Protocols are the protocols of the mirrored traffic, e.g. `tcp`, `udp` or `icmp`. Traffic of all protocols is
+	// mirrored if no protocols are specified.
+	Protocols []string
+	// Direction is the direction of the mirrored traffic, one of `INGRESS`, `EGRESS` or `BOTH`. Defaults to `BOTH`.
+	Direction *string
+}
+
+// FirewallRuleProtocol is a protocol and the ports allowed by a firewall rule.
+type FirewallRuleProtocol struct {
+	// Protocol is the name of a protocol, e.g. `tcp`, `udp` or `icmp`, or an IP protocol number.
+	Protocol string
+	// Ports are the ports or port ranges, e.g. `8080` or `30000-32767`, which are allowed. All ports are allowed
+	// if no ports are specified. Only applicable to `tcp`, `udp` and `sctp`.
+	Ports []string
+}
+
+// ExistingSubnets contains the names of existing subnets of a user-managed VPC. The subnets are neither modified nor
+// deleted by the extension.
+type ExistingSubnets struct {
+	// Workers is the name of the existing subnet used for the VMs.
+	Workers *string
+	// Internal is the name of the existing subnet used for internal load balancers.
+	Internal *string
+}
+
+// SecondaryRange is a named secondary IP range of the worker subnet.
+type SecondaryRange struct {
+	// Name is the name of the secondary range.
+	Name string
+	// CIDR is the IP range of the secondary range.
+	CIDR string
+	// Purpose is the purpose of the secondary range. The range with purpose `pods` must be equal to the pods CIDR and
+	// the range with purpose `services` to the services CIDR of the shoot, so that pods and services use VPC-native
+	// (alias IP) networking.
+	Purpose *SecondaryRangePurpose
+}
+
+// SecondaryRangePurpose is a purpose of a secondary range.
+type SecondaryRangePurpose string
+
+const (
+	// SecondaryRangePurposePods is a SecondaryRangePurpose for the IP addresses of pods.
+	SecondaryRangePurposePods SecondaryRangePurpose = "pods"
+	// SecondaryRangePurposeServices is a SecondaryRangePurpose for the cluster IP addresses of services.
+	SecondaryRangePurposeServices SecondaryRangePurpose = "services"
+)
+
+// IPv6Config contains the IPv6 configuration of the subnets of dual-stack shoots.
+type IPv6Config struct {
+	// WorkersAccessType is the IPv6 access type of the worker subnet. Defaults to EXTERNAL, i.e. the nodes get
+	// IPv6 addresses which are reachable from the internet.
+	WorkersAccessType *IPv6AccessType
+	// InternalAccessType is the IPv6 access type of the internal subnet. Defaults to INTERNAL, i.e. internal load
+	// balancers get IPv6 addresses which are only reachable from within the VPC.
+	InternalAccessType *IPv6AccessType
+}
+
+// IPv6AccessType is the IPv6 access type of a subnet.
+type IPv6AccessType string
+
+const (
+	// IPv6AccessTypeExternal is the access type of subnets with IPv6 ranges which are reachable from the internet.
+	IPv6AccessTypeExternal IPv6AccessType = "EXTERNAL"
+	// IPv6AccessTypeInternal is the access type of subnets with IPv6 ranges which are only reachable from within the VPC.
+	IPv6AccessTypeInternal IPv6AccessType = "INTERNAL"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // InfrastructureStatus contains information about created infrastructure resources.
@@ -47,6 +304,57 @@ type InfrastructureStatus struct {
 
 	// ServiceAccountEmail is the email address of the service account.
 	ServiceAccountEmail string
+
+	// WorkerPoolServiceAccounts are the service accounts created for individual worker pools.
+	WorkerPoolServiceAccounts []WorkerPoolServiceAccountStatus
+
+	// ErrorHistory contains the last errors which occurred while reconciling or deleting the infrastructure, the
+	// newest one last.
+	ErrorHistory []ErrorRecord
+
+	// Plan contains the operations which the last reconciliation in plan mode would have performed. It is removed by
+	// the next reconciliation which is not in plan mode.
+	Plan *InfrastructurePlan
+}
+
+// WorkerPoolServiceAccountStatus is the status of the service account created for a worker pool.
+type WorkerPoolServiceAccountStatus struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Email is the email address of the service account.
+	Email string
+}
+
+// InfrastructurePlan contains the operations which a reconciliation of the infrastructure would perform.
+type InfrastructurePlan struct {
+	// Time is the time when the plan was computed.
+	Time metav1.Time
+	// Operations are the planned operations in the order in which they would be performed.
+	Operations []PlannedOperation
+}
+
+// PlannedOperation is an operation on a GCP resource which a reconciliation of the infrastructure would perform.
+type PlannedOperation struct {
+	// Action is the action which would be performed, i.e. Create, Update or Delete.
+	Action string
+	// Resource is the kind of the resource, e.g. FirewallRule.
+	Resource string
+	// Name is the name of the resource.
+	Name string
+}
+
+// ErrorRecord is an error which occurred during an operation on an extension resource.
+type ErrorRecord struct {
+	// Operation is the operation during which the error occurred, e.g. Reconcile or Delete.
+	Operation string
+	// Codes are the Gardener error codes determined for the error.
+	Codes []string
+	// Message is the (possibly truncated) error message.
+	Message string
+	// Count is the number of consecutive occurrences of the error.
+	Count int32
+	// LastObservedTime is the time when the error occurred the last time.
+	LastObservedTime metav1.Time
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
@@ -54,11 +362,84 @@ type NetworkStatus struct {
 	// VPC states the name of the infrastructure VPC.
 	VPC VPC
 
+	// MTU is the maximum transmission unit of the VPC in bytes.
+	MTU *int32
+
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet
 
-	// NatIPs is a list of all user provided external premium ips which can be used by the nat gateway
+	// CloudNAT is the status of the CloudNAT of the VPC.
+	CloudNAT *CloudNATStatus
+
+	// NatIPs are the external IPs used by the CloudNAT, i.e. the user provided ones or the ones reserved by the extension.
 	NatIPs []NatIP
+
+	// NatIPRotation is the status of the last requested rotation of the NAT IPs.
+	NatIPRotation *NatIPRotationStatus
+
+	// PrivateServiceConnectEndpoints is the status of the Private Service Connect endpoints.
+	PrivateServiceConnectEndpoints []PrivateServiceConnectEndpointStatus
+
+	// Peerings is the status of the peerings of the VPC.
+	Peerings []VPCPeeringStatus
+
+	// FirewallPolicy is the name of the network firewall policy which is associated with the VPC.
+	FirewallPolicy *string
+
+	// PacketMirroring is the name of the packet mirroring policy of the worker subnet.
+	PacketMirroring *string
+
+	// PrivateDNSZone is the name of the private Cloud DNS zone of the internal domain of the shoot.
+	PrivateDNSZone *string
+
+	// NCCSpoke is the status of the Network Connectivity Center spoke of the VPC.
+	NCCSpoke *NCCSpokeStatus
+}
+
+// NCCSpokeStatus is the status of a Network Connectivity Center spoke.
+type NCCSpokeStatus struct {
+	// Name is the name of the spoke.
+	Name string
+	// State is the state of the spoke, e.g. ACTIVE or, if the hub belongs to another project, PENDING_REVIEW until the
+	// owner of the hub accepted the spoke.
+	State string
+}
+
+// PrivateServiceConnectEndpointStatus is the status of a Private Service Connect endpoint.
+type PrivateServiceConnectEndpointStatus struct {
+	// Name is the name of the endpoint.
+	Name string
+	// IP is the internal IP address of the endpoint.
+	IP string
+	// ConnectionStatus is the status of the connection to the service attachment, e.g. ACCEPTED or PENDING.
+	ConnectionStatus string
+}
+
+// VPCPeeringStatus is the status of a peering of the VPC.
+type VPCPeeringStatus struct {
+	// Name is the name of the peering.
+	Name string
+	// State is the state of the peering, i.e. ACTIVE if the peer network has a matching peering and INACTIVE otherwise.
+	State string
+}
+
+// CloudNATStatus is the status of the CloudNAT of the VPC.
+type CloudNATStatus struct {
+	// Name is the name of the CloudNAT.
+	Name string
+}
+
+// NatIPRotationStatus is the status of a rotation of the NAT IPs.
+type NatIPRotationStatus struct {
+	// ID is the value of the annotation which requested the rotation.
+	ID string
+	// Completed indicates whether all NAT IPs are replaced and the replaced IPs are drained.
+	Completed bool
+	// Draining is the replaced NAT IP which is currently drained, i.e. it is still used by established connections but
+	// not for new ones.
+	Draining *NatIP
+	// DrainingSince is the time when draining of the replaced NAT IP started.
+	DrainingSince *metav1.Time
 }
 
 // SubnetPurpose is a purpose of a subnet.
@@ -69,6 +450,8 @@ const (
 	PurposeNodes SubnetPurpose = "nodes"
 	// PurposeInternal is a SubnetPurpose for internal use.
 	PurposeInternal SubnetPurpose = "internal"
+	// PurposeProxyOnly is a SubnetPurpose for the proxies of internal application load balancers.
+	PurposeProxyOnly SubnetPurpose = "proxy-only"
 )
 
 // Subnet is a subnet that was created.
@@ -77,6 +460,16 @@ type Subnet struct {
 	Name string
 	// Purpose is the purpose for which the subnet was created.
 	Purpose SubnetPurpose
+	// SelfLink is the URL of the subnet.
+	SelfLink string
+	// Zone is the zone of the subnet if it is only used by the VMs of this zone.
+	Zone *string
+	// IPv6CIDRRange is the IPv6 range allocated for the subnet of dual-stack or IPv6 single-stack shoots.
+	IPv6CIDRRange *string
+	// IPv4CIDRRange is the IPv4 range of the subnet.
+	IPv4CIDRRange *string
+	// SecondaryRanges are the secondary ranges of the subnet.
+	SecondaryRanges []SecondaryRange
 }
 
 // VPC contains information about the VPC and some related resources.
@@ -93,8 +486,36 @@ type CloudRouter struct {
 	Name string
 }
 
+// CloudRouterBGP contains the BGP configuration of a CloudRouter.
+type CloudRouterBGP struct {
+	// ASN is the private autonomous system number of the CloudRouter, i.e. between 64512 and 65534 or between
+	// 4200000000 and 4294967294.
+	ASN int64
+	// AdvertiseMode is the mode of the route advertisements, either `DEFAULT` to advertise all subnets of the VPC or
+	// `CUSTOM` to advertise the configured groups and IP ranges. Defaults to `DEFAULT`.
+	AdvertiseMode *string
+	// AdvertisedGroups are the groups of routes advertised in the `CUSTOM` mode. The only supported group is
+	// `ALL_SUBNETS`.
+	AdvertisedGroups []string
+	// AdvertisedIPRanges are the IP ranges advertised in the `CUSTOM` mode.
+	AdvertisedIPRanges []AdvertisedIPRange
+}
+
+// AdvertisedIPRange is an IP range advertised by a CloudRouter.
+type AdvertisedIPRange struct {
+	// Range is the CIDR of the advertised IP range.
+	Range string
+	// Description is an optional description of the IP range.
+	Description *string
+}
+
 // CloudNAT contains configuration about the the CloudNAT resource
 type CloudNAT struct {
+	// Name is the name of the CloudNAT. Defaults to `<cluster-name>-cloud-nat`.
+	Name *string
+	// AdoptExisting controls if a CloudNAT with the given name which already exists on the router but was not created by
+	// the extension is taken over and reconciled to the desired configuration.
+	AdoptExisting bool
 	// EndpointIndependentMapping controls if endpoint independent mapping is enabled.
 	EndpointIndependentMapping *EndpointIndependentMapping
 	// MinPortsPerVM is the minimum number of ports allocated to a VM in the NAT config.
@@ -111,6 +532,9 @@ type CloudNAT struct {
 	// +optional
 	// NatIPNames is a list of all names of user provided external premium ips which can be used by the nat gateway
 	NatIPNames []NatIPName
+	// NatIPCount is the number of static external premium ips reserved by the extension for the nat gateway.
+	// It cannot be combined with NatIPNames.
+	NatIPCount *int32
 	// IcmpIdleTimeoutSec is the timeout (in seconds) for ICMP connections. Defaults to 30.
 	// +optional
 	IcmpIdleTimeoutSec *int32
@@ -126,6 +550,19 @@ type CloudNAT struct {
 	// UDPIdleTimeoutSec is the timeout (in seconds) for UDP connections. Defaults to 30.
 	// +optional
 	UdpIdleTimeoutSec *int32
+	// NatLogging contains the logging configuration of the CloudNAT. By default, errors are logged.
+	// +optional
+	NatLogging *NatLogging
+}
+
+// NatLogging contains the logging configuration of the CloudNAT.
+type NatLogging struct {
+	// Enabled controls if the CloudNAT logs are exported to Cloud Logging. Default is true.
+	// +optional
+	Enabled *bool
+	// Filter specifies the logged events, one of ERRORS_ONLY, TRANSLATIONS_ONLY or ALL. Default is ERRORS_ONLY.
+	// +optional
+	Filter *string
 }
 
 // EndpointIndependentMapping contains endpoint independent mapping options.
@@ -138,6 +575,8 @@ type EndpointIndependentMapping struct {
 type NatIP struct {
 	// IP is the external premium IP address used in GCP
 	IP string
+	// Name is the name of the address of the IP.
+	Name string
 }
 
 // NatIPName is the name of a user provided external ip address which can be used by the nat gateway
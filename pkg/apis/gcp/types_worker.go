@@ -31,6 +31,114 @@ type WorkerConfig struct {
 	// instance.
 	// This service account should be created in advance.
 	ServiceAccount *ServiceAccount
+
+	// AliasIPRange is the alias IP range assigned to the network interface of the worker nodes.
+	AliasIPRange *AliasIPRange
+
+	// CanaryRollout configures a staged rollout of changes to the machines of the worker pool. If set, changes are
+	// first rolled out to a number of canary machines and only rolled out to all machines after a soak period.
+	CanaryRollout *CanaryRollout
+
+	// Scheduling contains the scheduling options of the VMs.
+	Scheduling *Scheduling
+
+	// DeletionProtection enables the deletion protection of the VMs. Protected VMs cannot be deleted manually, they are
+	// only deleted by Gardener which removes the protection before.
+	DeletionProtection *bool
+
+	// PropagateShootLabels specifies whether the labels of the Shoot are added as labels to the VMs and their disks.
+	// Labels of the worker pool take precedence over labels of the Shoot.
+	PropagateShootLabels *bool
+
+	// SerialPortLoggingEnable specifies whether the output of the serial console of the VMs is exported to Cloud
+	// Logging, e.g. to debug boot failures.
+	SerialPortLoggingEnable *bool
+
+	// InstallOpsAgent specifies whether the Ops Agent is installed on the VMs during boot so that node metrics and
+	// logs are exported to Cloud Monitoring and Cloud Logging. The version is configured in the CloudProfileConfig.
+	InstallOpsAgent *bool
+
+	// EnableOSLogin specifies whether OS Login is enabled for the VMs so that users can connect via SSH with
+	// short-lived keys based on their IAM permissions. If enabled for any worker pool, it is also enabled for bastions.
+	EnableOSLogin *bool
+
+	// QuotaAwareRollingUpdate specifies whether the surge of rolling updates of the worker pool is limited to the
+	// machines fitting into the free CPU and GPU quotas of the region, so that machine creation does not fail midway.
+	QuotaAwareRollingUpdate *bool
+
+	// ZoneCircuitBreaker specifies whether the creation of machines is suspended for some time in zones of the worker
+	// pool in which machines could not be created, e.g. because of stockouts, and the machines are created in the other
+	// zones of the worker pool instead.
+	ZoneCircuitBreaker *bool
+
+	// ImageStreaming configures the container runtime of the machines to start containers before their images are
+	// pulled completely, e.g. for worker pools with very large images.
+	ImageStreaming *ImageStreaming
+
+	// MountHyperdiskML specifies whether the machines of the worker pool mount Hyperdisk ML volumes, which are only
+	// supported by some machine types. It is used to validate the machine type of the worker pool.
+	MountHyperdiskML *bool
+}
+
+// ImageStreaming contains the configuration of the container runtime for streaming container images.
+type ImageStreaming struct {
+	// LazyPulling specifies whether containerd uses the stargz snapshotter, which fetches the contents of images in
+	// the eStargz format on demand. The machine image must provide the stargz snapshotter.
+	LazyPulling bool
+
+	// Mirrors are Artifact Registry remote repositories which are used as pull-through caches for upstream registries.
+	Mirrors []RegistryMirror
+}
+
+// RegistryMirror is an Artifact Registry remote repository which is used as pull-through cache for an upstream
+// registry.
+type RegistryMirror struct {
+	// Upstream is the host of the upstream registry, e.g. `docker.io`.
+	Upstream string
+
+	// Repository is the URL of the Artifact Registry remote repository, e.g.
+	// `https://europe-docker.pkg.dev/v2/my-project/docker-hub`.
+	Repository string
+}
+
+// Scheduling contains the scheduling options of the VMs.
+type Scheduling struct {
+	// OnHostMaintenance defines the maintenance behavior of the VMs. Possible values are `MIGRATE` and `TERMINATE`.
+	OnHostMaintenance *string
+
+	// AutomaticRestart specifies whether the VMs are automatically restarted if they are terminated by Compute
+	// Engine.
+	AutomaticRestart *bool
+
+	// LocalSSDRecoveryTimeout is the maximum duration for which the VMs wait for the recovery of the data of their
+	// local SSDs after a host error.
+	LocalSSDRecoveryTimeout *metav1.Duration
+
+	// ProvisioningModel is the provisioning model of the VMs. Possible values are `STANDARD` and `FLEX_START`.
+	ProvisioningModel *string
+
+	// MaxRunDuration is the duration after which VMs provisioned with the `FLEX_START` provisioning model are deleted.
+	MaxRunDuration *metav1.Duration
+}
+
+// CanaryRollout contains the configuration of a staged rollout with canary machines.
+type CanaryRollout struct {
+	// Machines is the number of canary machines per zone of the worker pool.
+	Machines int32
+
+	// SoakDuration is the minimum duration the canary machines have to be ready before the change is rolled out to
+	// all machines of the worker pool.
+	SoakDuration *metav1.Duration
+}
+
+// AliasIPRange contains the configuration of an alias IP range assigned to the network interface of the VMs.
+type AliasIPRange struct {
+	// SubnetworkRangeName is the name of the secondary range of the worker subnet from which the alias IP range
+	// is allocated.
+	SubnetworkRangeName string
+
+	// IPCidrRange is the size of the alias IP range which is allocated for each VM, e.g. `/24`.
+	IPCidrRange string
 }
 
 // Volume contains configuration for the additional disks attached to VMs.
@@ -42,6 +150,28 @@ type Volume struct {
 	Encryption *DiskEncryption
 }
 
+const (
+	// LocalSSDInterfaceNVME is the NVMe interface for local SSDs.
+	LocalSSDInterfaceNVME = "NVME"
+	// LocalSSDInterfaceSCSI is the SCSI interface for local SSDs.
+	LocalSSDInterfaceSCSI = "SCSI"
+)
+
+const (
+	// OnHostMaintenanceMigrate live migrates VMs during host maintenance events.
+	OnHostMaintenanceMigrate = "MIGRATE"
+	// OnHostMaintenanceTerminate terminates VMs during host maintenance events.
+	OnHostMaintenanceTerminate = "TERMINATE"
+)
+
+const (
+	// ProvisioningModelStandard provisions VMs immediately if capacity is available.
+	ProvisioningModelStandard = "STANDARD"
+	// ProvisioningModelFlexStart provisions VMs via the Dynamic Workload Scheduler once the requested capacity is
+	// available.
+	ProvisioningModelFlexStart = "FLEX_START"
+)
+
 // DiskEncryption encapsulates the encryption configuration for a disk.
 type DiskEncryption struct {
 	// KmsKeyName specifies the customer-managed encryption key (CMEK) used for encryption of the volume.
@@ -74,6 +204,71 @@ type WorkerStatus struct {
 	// resources that are still using this version. Hence, it stores the used versions in the provider status to ensure
 	// reconciliation is possible.
 	MachineImages []MachineImage
+
+	// CanaryRollouts contains the state of the worker pools whose changes are currently rolled out to canary machines.
+	CanaryRollouts []CanaryRolloutStatus
+
+	// Pools contains the effective settings of the worker pools.
+	Pools []WorkerPoolStatus
+
+	// ZoneMigrations contains the zones removed from worker pools whose machines are not yet removed.
+	ZoneMigrations []ZoneMigrationStatus
+
+	// ZoneCircuitBreakers contains the zones of worker pools in which machines could not be created.
+	ZoneCircuitBreakers []ZoneCircuitBreakerStatus
+
+	// ErrorHistory contains the last errors which occurred while reconciling or deleting the worker, the newest one
+	// last.
+	ErrorHistory []ErrorRecord
+}
+
+// WorkerPoolStatus contains the effective settings of a worker pool.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string
+	// MaxPods is the effective maximum number of pods per node, i.e. the configured maximum limited by the size of the
+	// alias IP range allocated for each node.
+	MaxPods *int32
+}
+
+// ZoneMigrationStatus contains the state of the removal of a zone from a worker pool.
+type ZoneMigrationStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string
+	// Zone is the removed zone.
+	Zone string
+	// MachineDeployment is the name of the machine deployment of the removed zone which is kept until the machines of
+	// the remaining zones are available.
+	MachineDeployment string
+	// Disks are the names of the persistent disks of the cluster in the removed zone. The machines of the zone are not
+	// removed as long as such disks exist.
+	Disks []string
+}
+
+// ZoneCircuitBreakerStatus contains the state of the circuit breaker of a zone of a worker pool in which machines could
+// not be created.
+type ZoneCircuitBreakerStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string
+	// Zone is the zone in which machines could not be created.
+	Zone string
+	// Failures is the number of consecutive failures to create machines in the zone.
+	Failures int32
+	// SuspendedUntil is the time until which no machines are created in the zone. The suspension doubles with every
+	// consecutive failure.
+	SuspendedUntil metav1.Time
+	// LastError is the error of the last failed machine creation in the zone.
+	LastError string
+}
+
+// CanaryRolloutStatus contains the state of a staged rollout of a worker pool.
+type CanaryRolloutStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string
+	// Hash is the hash of the worker pool configuration which is rolled out to the canary machines.
+	Hash string
+	// StartTime is the time when the rollout to the canary machines was started.
+	StartTime metav1.Time
 }
 
 // GPU is the configuration of the GPU to be attached
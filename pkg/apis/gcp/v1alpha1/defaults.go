@@ -5,7 +5,10 @@
 package v1alpha1
 
 import (
+	"time"
+
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 )
@@ -30,3 +33,10 @@ func SetDefaults_Storage(obj *Storage) {
 		obj.ManagedDefaultVolumeSnapshotClass = ptr.To(true)
 	}
 }
+
+// SetDefaults_CanaryRollout sets the defaults for the staged rollout with canary machines.
+func SetDefaults_CanaryRollout(obj *CanaryRollout) {
+	if obj.SoakDuration == nil {
+		obj.SoakDuration = &metav1.Duration{Duration: time.Hour}
+	}
+}
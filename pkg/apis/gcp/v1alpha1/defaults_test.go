@@ -5,9 +5,12 @@
 package v1alpha1_test
 
 import (
+	"time"
+
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 )
@@ -32,4 +35,13 @@ var _ = Describe("Defaults", func() {
 			Expect(*obj.ManagedDefaultVolumeSnapshotClass).To(Equal(true))
 		})
 	})
+	Describe("#SetDefaults_CanaryRollout", func() {
+		It("should default the soak duration to one hour", func() {
+			obj := &CanaryRollout{}
+
+			SetDefaults_CanaryRollout(obj)
+
+			Expect(obj.SoakDuration).To(Equal(&metav1.Duration{Duration: time.Hour}))
+		})
+	})
 })
@@ -29,12 +29,17 @@ var (
 // Adds the list of known types to api.Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&BackupBucketConfig{},
+		&DNSRecordConfig{},
 		&CloudProfileConfig{},
 		&InfrastructureConfig{},
 		&InfrastructureStatus{},
 		&ControlPlaneConfig{},
+		&ControlPlaneStatus{},
 		&WorkerStatus{},
 		&WorkerConfig{},
+		&DiskClone{},
+		&DiskCloneList{},
 	)
 	return nil
 }
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupBucketConfig is the provider-specific configuration of backup buckets.
+type BackupBucketConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Location is the multi-region (e.g. `EU`) or predefined dual-region (e.g. `EUR4`) location of the bucket, which
+	// defaults to the region of the backup. The location of existing buckets cannot be changed.
+	// +optional
+	Location *string `json:"location,omitempty"`
+	// TurboReplication specifies whether the turbo replication is enabled for the bucket, which replicates new objects
+	// to the second region of a dual-region location within 15 minutes.
+	// +optional
+	TurboReplication bool `json:"turboReplication,omitempty"`
+
+	// StorageClass is the default storage class of the objects of the bucket, i.e. `STANDARD` (default), `NEARLINE`,
+	// `COLDLINE` or `ARCHIVE`.
+	// +optional
+	StorageClass *string `json:"storageClass,omitempty"`
+	// KmsKeyName is the resource name of the customer-managed Cloud KMS key with which the objects of the bucket are
+	// encrypted. The Cloud Storage service agent of the project must be allowed to use the key.
+	// +optional
+	KmsKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// RetentionPeriod is the period for which the objects of the bucket are retained, i.e. they cannot be deleted or
+	// overwritten before they reached this age.
+	// +optional
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
+	// Locked specifies whether the retention policy of the bucket is locked. A locked retention policy cannot be removed
+	// and its retention period cannot be reduced anymore.
+	// +optional
+	Locked bool `json:"locked,omitempty"`
+	// EventBasedHold specifies whether an event-based hold is placed on all new objects of the bucket. Held objects cannot
+	// be deleted or overwritten until the hold is released, which is done when the BackupEntry of the objects is deleted.
+	// +optional
+	EventBasedHold bool `json:"eventBasedHold,omitempty"`
+	// Versioning specifies whether the object versioning is enabled for the bucket, i.e. whether overwritten and deleted
+	// objects are kept as noncurrent versions.
+	// +optional
+	Versioning bool `json:"versioning,omitempty"`
+	// Lifecycle configures the lifecycle management of the objects of the bucket. If it is not set, the lifecycle rules
+	// of the bucket are not managed.
+	// +optional
+	Lifecycle *BackupBucketLifecycle `json:"lifecycle,omitempty"`
+}
+
+// BackupBucketLifecycle configures the lifecycle management of the objects of a backup bucket.
+type BackupBucketLifecycle struct {
+	// DeleteAfterDays is the age in days after which objects are deleted.
+	// +optional
+	DeleteAfterDays *int32 `json:"deleteAfterDays,omitempty"`
+	// DeleteNoncurrentAfterDays is the number of days after which noncurrent versions of objects are deleted.
+	// +optional
+	DeleteNoncurrentAfterDays *int32 `json:"deleteNoncurrentAfterDays,omitempty"`
+	// Transitions are the transitions of objects to other storage classes once they reached a certain age.
+	// +optional
+	Transitions []LifecycleTransition `json:"transitions,omitempty"`
+}
+
+// LifecycleTransition is a transition of objects to another storage class.
+type LifecycleTransition struct {
+	// StorageClass is the storage class to which the objects are transitioned, i.e. `NEARLINE`, `COLDLINE` or `ARCHIVE`.
+	StorageClass string `json:"storageClass"`
+	// AfterDays is the age in days after which the objects are transitioned.
+	AfterDays int32 `json:"afterDays"`
+}
@@ -17,6 +17,38 @@ type InfrastructureConfig struct {
 
 	// Networks is the network configuration (VPC, subnets, etc.)
 	Networks NetworkConfig `json:"networks"`
+
+	// NodeServiceAccount contains the configuration of the dedicated service account which is created for the
+	// worker nodes of the shoot.
+	// +optional
+	NodeServiceAccount *NodeServiceAccount `json:"nodeServiceAccount,omitempty"`
+
+	// ResourceLabels are labels which are added to the GCP resources created for the shoot which support labels, i.e.
+	// the addresses and forwarding rules of the infrastructure and the VMs and disks of the workers, e.g. to attribute
+	// their costs.
+	// +optional
+	ResourceLabels map[string]string `json:"resourceLabels,omitempty"`
+}
+
+// NodeServiceAccount contains the configuration of the service account created for the worker nodes.
+type NodeServiceAccount struct {
+	// Roles are the IAM roles granted to the service account in the project of the shoot.
+	// If not specified, a least-privileged set of roles sufficient for logging and monitoring is granted.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+	// WorkerPools are the worker pools whose nodes use a dedicated service account instead of the one of the shoot.
+	// +optional
+	WorkerPools []WorkerPoolServiceAccount `json:"workerPools,omitempty"`
+}
+
+// WorkerPoolServiceAccount contains the configuration of the service account created for the nodes of a worker pool.
+type WorkerPoolServiceAccount struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// Roles are the IAM roles granted to the service account of the worker pool in the project of the shoot.
+	// If not specified, the roles of the service account of the shoot are granted.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
 }
 
 // NetworkConfig holds information about the Kubernetes and infrastructure networks.
@@ -27,19 +59,288 @@ type NetworkConfig struct {
 	// CloudNAT contains configuration about the the CloudNAT resource
 	// +optional
 	CloudNAT *CloudNAT `json:"cloudNAT,omitempty"`
+	// CloudRouterBGP contains the BGP configuration of the CloudRouter created by the extension, e.g. to connect the VPC
+	// to other networks via Cloud Interconnect or Cloud VPN.
+	// +optional
+	CloudRouterBGP *CloudRouterBGP `json:"cloudRouterBGP,omitempty"`
+	// RoutingMode is the dynamic routing mode of the VPC created by the extension, either `REGIONAL` or `GLOBAL`. Routes
+	// learned by the CloudRouter are only programmed in its region in the `REGIONAL` mode and in all regions in the
+	// `GLOBAL` mode. Defaults to `REGIONAL`.
+	// +optional
+	RoutingMode *string `json:"routingMode,omitempty"`
+	// MTU is the maximum transmission unit of the VPC created by the extension in bytes, between 1300 and 8896. Defaults
+	// to 1460.
+	// +optional
+	MTU *int32 `json:"mtu,omitempty"`
 	// Internal is a private subnet (used for internal load balancers).
 	// +optional
 	Internal *string `json:"internal,omitempty"`
+	// ProxyOnly is the range of a proxy-only subnet to create (used for the proxies of internal Envoy-based application
+	// load balancers).
+	// +optional
+	ProxyOnly *string `json:"proxyOnly,omitempty"`
 	// Worker is the worker subnet range to create (used for the VMs).
 	// Deprecated - use `workers` instead.
 	Worker string `json:"worker"`
 	// Workers is the worker subnet range to create (used for the VMs).
 	Workers string `json:"workers"`
+	// Zones contains the subnets of the workers per zone, which are created instead of the regional worker and internal
+	// subnets, e.g. to apply zone-specific routing or firewall policies.
+	// +optional
+	Zones []Zone `json:"zones,omitempty"`
 	// FlowLogs contains the flow log configuration for the subnet.
 	// +optional
 	FlowLogs *FlowLogs `json:"flowLogs,omitempty"`
+	// PrivateGoogleAccess controls if Private Google Access is enabled on the worker subnet, so that nodes reach Google
+	// APIs and services via their internal IP addresses instead of the CloudNAT. Defaults to false.
+	// +optional
+	PrivateGoogleAccess *bool `json:"privateGoogleAccess,omitempty"`
+	// SecondaryRanges are additional named IP ranges of the worker subnet which can be used for alias IP ranges.
+	// +optional
+	SecondaryRanges []SecondaryRange `json:"secondaryRanges,omitempty"`
+	// IPv6 contains the IPv6 configuration of the subnets of dual-stack shoots.
+	// +optional
+	IPv6 *IPv6Config `json:"ipv6,omitempty"`
+	// ExistingSubnets references existing subnets of the VPC which are used instead of creating the subnets.
+	// +optional
+	ExistingSubnets *ExistingSubnets `json:"existingSubnets,omitempty"`
+	// PrivateServiceConnectEndpoints are endpoints in the worker subnet for services published via Private Service
+	// Connect.
+	// +optional
+	PrivateServiceConnectEndpoints []PrivateServiceConnectEndpoint `json:"privateServiceConnectEndpoints,omitempty"`
+	// AdditionalFirewallRules are firewall rules of the VPC which are managed in addition to the rules created by the
+	// extension.
+	// +optional
+	AdditionalFirewallRules []FirewallRule `json:"additionalFirewallRules,omitempty"`
+	// Peerings are peerings of the VPC with other networks, which are created and deleted together with the VPC.
+	// +optional
+	Peerings []VPCPeering `json:"peerings,omitempty"`
+	// FirewallPolicy is the network firewall policy which is associated with the VPC.
+	// +optional
+	FirewallPolicy *FirewallPolicy `json:"firewallPolicy,omitempty"`
+	// PacketMirroring mirrors the traffic of the worker subnet to a collector, e.g. to be inspected by IDS appliances.
+	// +optional
+	PacketMirroring *PacketMirroring `json:"packetMirroring,omitempty"`
+	// PrivateDNSZone is a private Cloud DNS zone for the internal domain of the shoot, which is bound to the VPC.
+	// +optional
+	PrivateDNSZone *PrivateDNSZone `json:"privateDNSZone,omitempty"`
+	// NCCSpoke registers the VPC as a spoke of a Network Connectivity Center hub.
+	// +optional
+	NCCSpoke *NCCSpoke `json:"nccSpoke,omitempty"`
+}
+
+// Zone contains the subnets of a zone of the region. GCP subnets are regional, the subnets of a zone are used by the
+// VMs of the zone only.
+type Zone struct {
+	// Name is the name of the zone, e.g. `europe-west1-b`.
+	Name string `json:"name"`
+	// Workers is the range of the worker subnet of the zone (used for the VMs). The subnet is named
+	// `<technical-id>-nodes-<suffix>`, where the suffix is the name of the zone without the region, e.g. `b`.
+	Workers string `json:"workers"`
+	// Internal is the range of a private subnet of the zone (used for internal load balancers). The subnet is named
+	// `<technical-id>-internal-<suffix>`.
+	// +optional
+	Internal *string `json:"internal,omitempty"`
+}
+
+// PrivateServiceConnectEndpoint is an endpoint for a service published via Private Service Connect.
+type PrivateServiceConnectEndpoint struct {
+	// Name is the name of the endpoint.
+	Name string `json:"name"`
+	// ServiceAttachment is the URI of the service attachment of the published service, e.g.
+	// `projects/<project>/regions/<region>/serviceAttachments/<name>`.
+	ServiceAttachment string `json:"serviceAttachment"`
+	// IP is the internal IP address of the endpoint in the worker subnet. An address is allocated if it is not set.
+	// +optional
+	IP *string `json:"ip,omitempty"`
 }
 
+// VPCPeering is a peering of the VPC with another network.
+type VPCPeering struct {
+	// Name is the name of the peering. The peering is named `<technical-id>-<name>`.
+	Name string `json:"name"`
+	// Network is the self-link of the peer network, e.g. `projects/<project>/global/networks/<name>`.
+	Network string `json:"network"`
+	// ExportCustomRoutes controls whether the custom routes of the VPC are exported to the peer network. Defaults to
+	// false.
+	// +optional
+	ExportCustomRoutes *bool `json:"exportCustomRoutes,omitempty"`
+	// ImportCustomRoutes controls whether the custom routes of the peer network are imported into the VPC. Defaults to
+	// false.
+	// +optional
+	ImportCustomRoutes *bool `json:"importCustomRoutes,omitempty"`
+}
+
+// FirewallRule is a user-defined firewall rule of the VPC.
+type FirewallRule struct {
+	// Name is the name of the rule. The firewall rule is named `<technical-id>-<name>`.
+	Name string `json:"name"`
+	// Direction is the direction of the traffic the rule applies to, either `INGRESS` or `EGRESS`. Defaults to
+	// `INGRESS`.
+	// +optional
+	Direction *string `json:"direction,omitempty"`
+	// Priority is the priority of the rule between 0 and 65535, where lower values take precedence. Defaults to
+	// 1000.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+	// Ranges are the CIDRs of the sources of ingress traffic or of the destinations of egress traffic.
+	Ranges []string `json:"ranges"`
+	// Allowed are the protocols and ports which are allowed by the rule.
+	Allowed []FirewallRuleProtocol `json:"allowed"`
+	// TargetTags are the network tags of the instances the rule applies to. Defaults to the nodes of the shoot.
+	// +optional
+	TargetTags []string `json:"targetTags,omitempty"`
+}
+
+// FirewallPolicy is a global network firewall policy which is associated with the VPC. Exactly one of Name and Rules
+// must be set.
+type FirewallPolicy struct {
+	// Name is the name of an existing global network firewall policy of the project, which is associated with the VPC.
+	// The policy is shared and hence neither modified nor deleted by the extension.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Rules are the rules of a global network firewall policy which is created for the shoot and associated with the
+	// VPC. The policy is named `<technical-id>` and deleted together with the infrastructure.
+	// +optional
+	Rules []FirewallPolicyRule `json:"rules,omitempty"`
+}
+
+// FirewallPolicyRule is a rule of a network firewall policy.
+type FirewallPolicyRule struct {
+	// Priority is the priority of the rule between 0 and 2147483643, where lower values take precedence. The priority
+	// identifies the rule and hence must be unique within the policy.
+	Priority int32 `json:"priority"`
+	// Action is the action of the rule, one of `allow`, `deny` or `goto_next`.
+	Action string `json:"action"`
+	// Direction is the direction of the traffic the rule applies to, either `INGRESS` or `EGRESS`. Defaults to
+	// `INGRESS`.
+	// +optional
+	Direction *string `json:"direction,omitempty"`
+	// Ranges are the CIDRs of the sources of ingress traffic or of the destinations of egress traffic.
+	Ranges []string `json:"ranges"`
+	// Protocols are the protocols and ports the rule applies to.
+	Protocols []FirewallRuleProtocol `json:"protocols"`
+	// Description is the description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// PacketMirroring is a packet mirroring policy which mirrors the traffic of the worker subnet. The policy is named
+// `<technical-id>` and deleted together with the infrastructure.
+type PacketMirroring struct {
+	// Collector is the URI of the forwarding rule of the internal load balancer which receives the mirrored packets,
+	// e.g. `projects/<project>/regions/<region>/forwardingRules/<name>`. The forwarding rule must be a packet mirroring
+	// collector in the region of the shoot and in the VPC or a peered network.
+	Collector string `json:"collector"`
+	// Filter restricts the mirrored traffic. All traffic of the worker subnet is mirrored if it is not set.
+	// +optional
+	Filter *PacketMirroringFilter `json:"filter,omitempty"`
+	// Priority is the priority of the policy between 0 and 65535, which decides which policy mirrors the traffic if
+	// several policies apply, where lower values take precedence. Defaults to 1000.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// PrivateDNSZone is a private Cloud DNS zone for the internal domain of the shoot. The zone is bound to the VPC and
+// contains the record of the internal domain of the kube-apiserver, so that it is resolved within the VPC without
+// public DNS. The zone is named `<technical-id>` and deleted together with the infrastructure.
+type PrivateDNSZone struct {
+	// Enabled enables the private Cloud DNS zone.
+	Enabled bool `json:"enabled"`
+}
+
+// NCCSpoke is a VPC spoke of a Network Connectivity Center hub. The spoke is named `<technical-id>`, it is attached to
+// the hub when the infrastructure is reconciled and detached when the infrastructure is deleted.
+type NCCSpoke struct {
+	// Hub is the resource name of the hub, i.e. `projects/<project>/locations/global/hubs/<name>`. The hub may belong
+	// to another project.
+	Hub string `json:"hub"`
+	// ExcludeExportRanges are the IP ranges of the VPC which are not exported to the hub.
+	// +optional
+	ExcludeExportRanges []string `json:"excludeExportRanges,omitempty"`
+}
+
+// PacketMirroringFilter restricts the traffic mirrored by a packet mirroring policy.
+type PacketMirroringFilter struct {
+	// CIDRRanges are the CIDRs of the sources or destinations of the mirrored traffic. Traffic of all sources and
+	// destinations is mirrored if no ranges are specified.
+	// +optional
+	CIDRRanges []string `json:"cidrRanges,omitempty"`
+	// Protocols are the protocols of the mirrored traffic, e.g. `tcp`, `udp` or `icmp`. Traffic of all protocols is
+	// mirrored if no protocols are specified.
+	// +optional
+	Protocols []string `json:"protocols,omitempty"`
+	// Direction is the direction of the mirrored traffic, one of `INGRESS`, `EGRESS` or `BOTH`. Defaults to `BOTH`.
+	// +optional
+	Direction *string `json:"direction,omitempty"`
+}
+
+// FirewallRuleProtocol is a protocol and the ports allowed by a firewall rule.
+type FirewallRuleProtocol struct {
+	// Protocol is the name of a protocol, e.g. `tcp`, `udp` or `icmp`, or an IP protocol number.
+	Protocol string `json:"protocol"`
+	// Ports are the ports or port ranges, e.g. `8080` or `30000-32767`, which are allowed. All ports are allowed
+	// if no ports are specified. Only applicable to `tcp`, `udp` and `sctp`.
+	// +optional
+	Ports []string `json:"ports,omitempty"`
+}
+
+// ExistingSubnets contains the names of existing subnets of a user-managed VPC. The subnets are neither modified nor
+// deleted by the extension.
+type ExistingSubnets struct {
+	// Workers is the name of the existing subnet used for the VMs.
+	// +optional
+	Workers *string `json:"workers,omitempty"`
+	// Internal is the name of the existing subnet used for internal load balancers.
+	// +optional
+	Internal *string `json:"internal,omitempty"`
+}
+
+// SecondaryRange is a named secondary IP range of the worker subnet.
+type SecondaryRange struct {
+	// Name is the name of the secondary range.
+	Name string `json:"name"`
+	// CIDR is the IP range of the secondary range.
+	CIDR string `json:"cidr"`
+	// Purpose is the purpose of the secondary range. The range with purpose `pods` must be equal to the pods CIDR and
+	// the range with purpose `services` to the services CIDR of the shoot, so that pods and services use VPC-native
+	// (alias IP) networking.
+	// +optional
+	Purpose *SecondaryRangePurpose `json:"purpose,omitempty"`
+}
+
+// SecondaryRangePurpose is a purpose of a secondary range.
+type SecondaryRangePurpose string
+
+const (
+	// SecondaryRangePurposePods is a SecondaryRangePurpose for the IP addresses of pods.
+	SecondaryRangePurposePods SecondaryRangePurpose = "pods"
+	// SecondaryRangePurposeServices is a SecondaryRangePurpose for the cluster IP addresses of services.
+	SecondaryRangePurposeServices SecondaryRangePurpose = "services"
+)
+
+// IPv6Config contains the IPv6 configuration of the subnets of dual-stack shoots.
+type IPv6Config struct {
+	// WorkersAccessType is the IPv6 access type of the worker subnet. Defaults to EXTERNAL, i.e. the nodes get
+	// IPv6 addresses which are reachable from the internet.
+	// +optional
+	WorkersAccessType *IPv6AccessType `json:"workersAccessType,omitempty"`
+	// InternalAccessType is the IPv6 access type of the internal subnet. Defaults to INTERNAL, i.e. internal load
+	// balancers get IPv6 addresses which are only reachable from within the VPC.
+	// +optional
+	InternalAccessType *IPv6AccessType `json:"internalAccessType,omitempty"`
+}
+
+// IPv6AccessType is the IPv6 access type of a subnet.
+type IPv6AccessType string
+
+const (
+	// IPv6AccessTypeExternal is the access type of subnets with IPv6 ranges which are reachable from the internet.
+	IPv6AccessTypeExternal IPv6AccessType = "EXTERNAL"
+	// IPv6AccessTypeInternal is the access type of subnets with IPv6 ranges which are only reachable from within the VPC.
+	IPv6AccessTypeInternal IPv6AccessType = "INTERNAL"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // InfrastructureStatus contains information about created infrastructure resources.
@@ -51,6 +352,62 @@ type InfrastructureStatus struct {
 
 	// ServiceAccountEmail is the email address of the service account.
 	ServiceAccountEmail string `json:"serviceAccountEmail"`
+
+	// WorkerPoolServiceAccounts are the service accounts created for individual worker pools.
+	// +optional
+	WorkerPoolServiceAccounts []WorkerPoolServiceAccountStatus `json:"workerPoolServiceAccounts,omitempty"`
+
+	// ErrorHistory contains the last errors which occurred while reconciling or deleting the infrastructure, the
+	// newest one last.
+	// +optional
+	ErrorHistory []ErrorRecord `json:"errorHistory,omitempty"`
+
+	// Plan contains the operations which the last reconciliation in plan mode would have performed. It is removed by
+	// the next reconciliation which is not in plan mode.
+	// +optional
+	Plan *InfrastructurePlan `json:"plan,omitempty"`
+}
+
+// WorkerPoolServiceAccountStatus is the status of the service account created for a worker pool.
+type WorkerPoolServiceAccountStatus struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// Email is the email address of the service account.
+	Email string `json:"email"`
+}
+
+// InfrastructurePlan contains the operations which a reconciliation of the infrastructure would perform.
+type InfrastructurePlan struct {
+	// Time is the time when the plan was computed.
+	Time metav1.Time `json:"time"`
+	// Operations are the planned operations in the order in which they would be performed.
+	// +optional
+	Operations []PlannedOperation `json:"operations,omitempty"`
+}
+
+// PlannedOperation is an operation on a GCP resource which a reconciliation of the infrastructure would perform.
+type PlannedOperation struct {
+	// Action is the action which would be performed, i.e. Create, Update or Delete.
+	Action string `json:"action"`
+	// Resource is the kind of the resource, e.g. FirewallRule.
+	Resource string `json:"resource"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+}
+
+// ErrorRecord is an error which occurred during an operation on an extension resource.
+type ErrorRecord struct {
+	// Operation is the operation during which the error occurred, e.g. Reconcile or Delete.
+	Operation string `json:"operation"`
+	// Codes are the Gardener error codes determined for the error.
+	// +optional
+	Codes []string `json:"codes,omitempty"`
+	// Message is the (possibly truncated) error message.
+	Message string `json:"message"`
+	// Count is the number of consecutive occurrences of the error.
+	Count int32 `json:"count"`
+	// LastObservedTime is the time when the error occurred the last time.
+	LastObservedTime metav1.Time `json:"lastObservedTime"`
 }
 
 // NetworkStatus is the current status of the infrastructure networks.
@@ -58,12 +415,97 @@ type NetworkStatus struct {
 	// VPC states the name of the infrastructure VPC.
 	VPC VPC `json:"vpc"`
 
+	// MTU is the maximum transmission unit of the VPC in bytes.
+	// +optional
+	MTU *int32 `json:"mtu,omitempty"`
+
 	// Subnets are the subnets that have been created.
 	Subnets []Subnet `json:"subnets"`
 
-	// NatIPs is a list of all user provided external premium ips which can be used by the nat gateway
+	// CloudNAT is the status of the CloudNAT of the VPC.
+	// +optional
+	CloudNAT *CloudNATStatus `json:"cloudNAT,omitempty"`
+
+	// NatIPs are the external IPs used by the CloudNAT, i.e. the user provided ones or the ones reserved by the extension.
 	// +optional
 	NatIPs []NatIP `json:"natIPs,omitempty"`
+
+	// NatIPRotation is the status of the last requested rotation of the NAT IPs.
+	// +optional
+	NatIPRotation *NatIPRotationStatus `json:"natIPRotation,omitempty"`
+
+	// PrivateServiceConnectEndpoints is the status of the Private Service Connect endpoints.
+	// +optional
+	PrivateServiceConnectEndpoints []PrivateServiceConnectEndpointStatus `json:"privateServiceConnectEndpoints,omitempty"`
+
+	// Peerings is the status of the peerings of the VPC.
+	// +optional
+	Peerings []VPCPeeringStatus `json:"peerings,omitempty"`
+
+	// FirewallPolicy is the name of the network firewall policy which is associated with the VPC.
+	// +optional
+	FirewallPolicy *string `json:"firewallPolicy,omitempty"`
+
+	// PacketMirroring is the name of the packet mirroring policy of the worker subnet.
+	// +optional
+	PacketMirroring *string `json:"packetMirroring,omitempty"`
+
+	// PrivateDNSZone is the name of the private Cloud DNS zone of the internal domain of the shoot.
+	// +optional
+	PrivateDNSZone *string `json:"privateDNSZone,omitempty"`
+
+	// NCCSpoke is the status of the Network Connectivity Center spoke of the VPC.
+	// +optional
+	NCCSpoke *NCCSpokeStatus `json:"nccSpoke,omitempty"`
+}
+
+// NCCSpokeStatus is the status of a Network Connectivity Center spoke.
+type NCCSpokeStatus struct {
+	// Name is the name of the spoke.
+	Name string `json:"name"`
+	// State is the state of the spoke, e.g. ACTIVE or, if the hub belongs to another project, PENDING_REVIEW until the
+	// owner of the hub accepted the spoke.
+	State string `json:"state"`
+}
+
+// PrivateServiceConnectEndpointStatus is the status of a Private Service Connect endpoint.
+type PrivateServiceConnectEndpointStatus struct {
+	// Name is the name of the endpoint.
+	Name string `json:"name"`
+	// IP is the internal IP address of the endpoint.
+	IP string `json:"ip"`
+	// ConnectionStatus is the status of the connection to the service attachment, e.g. ACCEPTED or PENDING.
+	// +optional
+	ConnectionStatus string `json:"connectionStatus,omitempty"`
+}
+
+// VPCPeeringStatus is the status of a peering of the VPC.
+type VPCPeeringStatus struct {
+	// Name is the name of the peering.
+	Name string `json:"name"`
+	// State is the state of the peering, i.e. ACTIVE if the peer network has a matching peering and INACTIVE otherwise.
+	State string `json:"state"`
+}
+
+// CloudNATStatus is the status of the CloudNAT of the VPC.
+type CloudNATStatus struct {
+	// Name is the name of the CloudNAT.
+	Name string `json:"name"`
+}
+
+// NatIPRotationStatus is the status of a rotation of the NAT IPs.
+type NatIPRotationStatus struct {
+	// ID is the value of the annotation which requested the rotation.
+	ID string `json:"id"`
+	// Completed indicates whether all NAT IPs are replaced and the replaced IPs are drained.
+	Completed bool `json:"completed"`
+	// Draining is the replaced NAT IP which is currently drained, i.e. it is still used by established connections but
+	// not for new ones.
+	// +optional
+	Draining *NatIP `json:"draining,omitempty"`
+	// DrainingSince is the time when draining of the replaced NAT IP started.
+	// +optional
+	DrainingSince *metav1.Time `json:"drainingSince,omitempty"`
 }
 
 // SubnetPurpose is a purpose of a subnet.
@@ -74,6 +516,8 @@ const (
 	PurposeNodes SubnetPurpose = "nodes"
 	// PurposeInternal is a SubnetPurpose for internal use.
 	PurposeInternal SubnetPurpose = "internal"
+	// PurposeProxyOnly is a SubnetPurpose for the proxies of internal application load balancers.
+	PurposeProxyOnly SubnetPurpose = "proxy-only"
 )
 
 // Subnet is a subnet that was created.
@@ -82,6 +526,21 @@ type Subnet struct {
 	Name string `json:"name"`
 	// Purpose is the purpose for which the subnet was created.
 	Purpose SubnetPurpose `json:"purpose"`
+	// SelfLink is the URL of the subnet.
+	// +optional
+	SelfLink string `json:"selfLink,omitempty"`
+	// Zone is the zone of the subnet if it is only used by the VMs of this zone.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+	// IPv6CIDRRange is the IPv6 range allocated for the subnet of dual-stack or IPv6 single-stack shoots.
+	// +optional
+	IPv6CIDRRange *string `json:"ipv6CIDRRange,omitempty"`
+	// IPv4CIDRRange is the IPv4 range of the subnet.
+	// +optional
+	IPv4CIDRRange *string `json:"ipv4CIDRRange,omitempty"`
+	// SecondaryRanges are the secondary ranges of the subnet.
+	// +optional
+	SecondaryRanges []SecondaryRange `json:"secondaryRanges,omitempty"`
 }
 
 // VPC contains information about the VPC and some related resources.
@@ -99,8 +558,42 @@ type CloudRouter struct {
 	Name string `json:"name,omitempty"`
 }
 
+// CloudRouterBGP contains the BGP configuration of a CloudRouter.
+type CloudRouterBGP struct {
+	// ASN is the private autonomous system number of the CloudRouter, i.e. between 64512 and 65534 or between
+	// 4200000000 and 4294967294.
+	ASN int64 `json:"asn"`
+	// AdvertiseMode is the mode of the route advertisements, either `DEFAULT` to advertise all subnets of the VPC or
+	// `CUSTOM` to advertise the configured groups and IP ranges. Defaults to `DEFAULT`.
+	// +optional
+	AdvertiseMode *string `json:"advertiseMode,omitempty"`
+	// AdvertisedGroups are the groups of routes advertised in the `CUSTOM` mode. The only supported group is
+	// `ALL_SUBNETS`.
+	// +optional
+	AdvertisedGroups []string `json:"advertisedGroups,omitempty"`
+	// AdvertisedIPRanges are the IP ranges advertised in the `CUSTOM` mode.
+	// +optional
+	AdvertisedIPRanges []AdvertisedIPRange `json:"advertisedIPRanges,omitempty"`
+}
+
+// AdvertisedIPRange is an IP range advertised by a CloudRouter.
+type AdvertisedIPRange struct {
+	// Range is the CIDR of the advertised IP range.
+	Range string `json:"range"`
+	// Description is an optional description of the IP range.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
 // CloudNAT contains configuration about the CloudNAT resource
 type CloudNAT struct {
+	// Name is the name of the CloudNAT. Defaults to `<cluster-name>-cloud-nat`.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// AdoptExisting controls if a CloudNAT with the given name which already exists on the router but was not created by
+	// the extension is taken over and reconciled to the desired configuration.
+	// +optional
+	AdoptExisting bool `json:"adoptExisting,omitempty"`
 	// EndpointIndependentMapping controls if endpoint independent mapping is enabled.
 	EndpointIndependentMapping *EndpointIndependentMapping `json:"endpointIndependentMapping,omitempty"`
 	// MinPortsPerVM is the minimum number of ports allocated to a VM in the NAT config.
@@ -117,6 +610,10 @@ type CloudNAT struct {
 	// NatIPNames is a list of all user provided external premium ips which can be used by the nat gateway
 	// +optional
 	NatIPNames []NatIPName `json:"natIPNames,omitempty"`
+	// NatIPCount is the number of static external premium ips reserved by the extension for the nat gateway.
+	// It cannot be combined with NatIPNames.
+	// +optional
+	NatIPCount *int32 `json:"natIPCount,omitempty"`
 	// IcmpIdleTimeoutSec is the timeout (in seconds) for ICMP connections. Defaults to 30.
 	// +optional
 	IcmpIdleTimeoutSec *int32 `json:"icmpIdleTimeoutSec,omitempty"`
@@ -132,6 +629,19 @@ type CloudNAT struct {
 	// UdpIdleTimeoutSec is the timeout (in seconds) for UDP connections. Defaults to 30.
 	// +optional
 	UdpIdleTimeoutSec *int32 `json:"udpIdleTimeoutSec,omitempty"`
+	// NatLogging contains the logging configuration of the CloudNAT. By default, errors are logged.
+	// +optional
+	NatLogging *NatLogging `json:"natLogging,omitempty"`
+}
+
+// NatLogging contains the logging configuration of the CloudNAT.
+type NatLogging struct {
+	// Enabled controls if the CloudNAT logs are exported to Cloud Logging. Default is true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Filter specifies the logged events, one of ERRORS_ONLY, TRANSLATIONS_ONLY or ALL. Default is ERRORS_ONLY.
+	// +optional
+	Filter *string `json:"filter,omitempty"`
 }
 
 // EndpointIndependentMapping contains endpoint independent mapping options.
@@ -144,6 +654,9 @@ type EndpointIndependentMapping struct {
 type NatIP struct {
 	// IP is the external premium IP address used in GCP
 	IP string `json:"ip"`
+	// Name is the name of the address of the IP.
+	// +optional
+	Name string `json:"name,omitempty"`
 }
 
 // NatIPName is the name of a user provided external ip address which can be used by the nat gateway
@@ -34,6 +34,139 @@ type WorkerConfig struct {
 	// This service account should be created in advance.
 	// +optional
 	ServiceAccount *ServiceAccount `json:"serviceAccount,omitempty"`
+
+	// AliasIPRange is the alias IP range assigned to the network interface of the worker nodes.
+	// +optional
+	AliasIPRange *AliasIPRange `json:"aliasIPRange,omitempty"`
+
+	// CanaryRollout configures a staged rollout of changes to the machines of the worker pool. If set, changes are
+	// first rolled out to a number of canary machines and only rolled out to all machines after a soak period.
+	// +optional
+	CanaryRollout *CanaryRollout `json:"canaryRollout,omitempty"`
+
+	// Scheduling contains the scheduling options of the VMs.
+	// +optional
+	Scheduling *Scheduling `json:"scheduling,omitempty"`
+
+	// DeletionProtection enables the deletion protection of the VMs. Protected VMs cannot be deleted manually, they are
+	// only deleted by Gardener which removes the protection before.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// PropagateShootLabels specifies whether the labels of the Shoot are added as labels to the VMs and their disks.
+	// Labels of the worker pool take precedence over labels of the Shoot.
+	// +optional
+	PropagateShootLabels *bool `json:"propagateShootLabels,omitempty"`
+
+	// SerialPortLoggingEnable specifies whether the output of the serial console of the VMs is exported to Cloud
+	// Logging, e.g. to debug boot failures.
+	// +optional
+	SerialPortLoggingEnable *bool `json:"serialPortLoggingEnable,omitempty"`
+
+	// InstallOpsAgent specifies whether the Ops Agent is installed on the VMs during boot so that node metrics and
+	// logs are exported to Cloud Monitoring and Cloud Logging. The version is configured in the CloudProfileConfig.
+	// +optional
+	InstallOpsAgent *bool `json:"installOpsAgent,omitempty"`
+
+	// EnableOSLogin specifies whether OS Login is enabled for the VMs so that users can connect via SSH with
+	// short-lived keys based on their IAM permissions. If enabled for any worker pool, it is also enabled for bastions.
+	// +optional
+	EnableOSLogin *bool `json:"enableOSLogin,omitempty"`
+
+	// QuotaAwareRollingUpdate specifies whether the surge of rolling updates of the worker pool is limited to the
+	// machines fitting into the free CPU and GPU quotas of the region, so that machine creation does not fail midway.
+	// +optional
+	QuotaAwareRollingUpdate *bool `json:"quotaAwareRollingUpdate,omitempty"`
+
+	// ZoneCircuitBreaker specifies whether the creation of machines is suspended for some time in zones of the worker
+	// pool in which machines could not be created, e.g. because of stockouts, and the machines are created in the other
+	// zones of the worker pool instead.
+	// +optional
+	ZoneCircuitBreaker *bool `json:"zoneCircuitBreaker,omitempty"`
+
+	// ImageStreaming configures the container runtime of the machines to start containers before their images are
+	// pulled completely, e.g. for worker pools with very large images.
+	// +optional
+	ImageStreaming *ImageStreaming `json:"imageStreaming,omitempty"`
+
+	// MountHyperdiskML specifies whether the machines of the worker pool mount Hyperdisk ML volumes, which are only
+	// supported by some machine types. It is used to validate the machine type of the worker pool.
+	// +optional
+	MountHyperdiskML *bool `json:"mountHyperdiskML,omitempty"`
+}
+
+// ImageStreaming contains the configuration of the container runtime for streaming container images.
+type ImageStreaming struct {
+	// LazyPulling specifies whether containerd uses the stargz snapshotter, which fetches the contents of images in
+	// the eStargz format on demand. The machine image must provide the stargz snapshotter.
+	// +optional
+	LazyPulling bool `json:"lazyPulling,omitempty"`
+
+	// Mirrors are Artifact Registry remote repositories which are used as pull-through caches for upstream registries.
+	// +optional
+	Mirrors []RegistryMirror `json:"mirrors,omitempty"`
+}
+
+// RegistryMirror is an Artifact Registry remote repository which is used as pull-through cache for an upstream
+// registry.
+type RegistryMirror struct {
+	// Upstream is the host of the upstream registry, e.g. `docker.io`.
+	Upstream string `json:"upstream"`
+
+	// Repository is the URL of the Artifact Registry remote repository, e.g.
+	// `https://europe-docker.pkg.dev/v2/my-project/docker-hub`.
+	Repository string `json:"repository"`
+}
+
+// Scheduling contains the scheduling options of the VMs.
+type Scheduling struct {
+	// OnHostMaintenance defines the maintenance behavior of the VMs. Possible values are `MIGRATE` and `TERMINATE`.
+	// Defaults to `MIGRATE` if live migration is supported by the machine type, otherwise to `TERMINATE`.
+	// +optional
+	OnHostMaintenance *string `json:"onHostMaintenance,omitempty"`
+
+	// AutomaticRestart specifies whether the VMs are automatically restarted if they are terminated by Compute
+	// Engine. Defaults to true.
+	// +optional
+	AutomaticRestart *bool `json:"automaticRestart,omitempty"`
+
+	// LocalSSDRecoveryTimeout is the maximum duration for which the VMs wait for the recovery of the data of their
+	// local SSDs after a host error. It must be a multiple of one hour between 0 and 168 hours and can only be set for
+	// worker pools with local SSDs (`SCRATCH` data volumes).
+	// +optional
+	LocalSSDRecoveryTimeout *metav1.Duration `json:"localSsdRecoveryTimeout,omitempty"`
+
+	// ProvisioningModel is the provisioning model of the VMs. Possible values are `STANDARD` and `FLEX_START`. With
+	// `FLEX_START`, the VMs of GPU worker pools are provisioned via the Dynamic Workload Scheduler once the requested
+	// capacity is available. Defaults to `STANDARD`.
+	// +optional
+	ProvisioningModel *string `json:"provisioningModel,omitempty"`
+
+	// MaxRunDuration is the duration after which VMs provisioned with the `FLEX_START` provisioning model are deleted.
+	// It is required for and can only be set with the `FLEX_START` provisioning model.
+	// +optional
+	MaxRunDuration *metav1.Duration `json:"maxRunDuration,omitempty"`
+}
+
+// CanaryRollout contains the configuration of a staged rollout with canary machines.
+type CanaryRollout struct {
+	// Machines is the number of canary machines per zone of the worker pool.
+	Machines int32 `json:"machines"`
+
+	// SoakDuration is the minimum duration the canary machines have to be ready before the change is rolled out to
+	// all machines of the worker pool. Defaults to 1h.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty"`
+}
+
+// AliasIPRange contains the configuration of an alias IP range assigned to the network interface of the VMs.
+type AliasIPRange struct {
+	// SubnetworkRangeName is the name of the secondary range of the worker subnet from which the alias IP range
+	// is allocated.
+	SubnetworkRangeName string `json:"subnetworkRangeName"`
+
+	// IPCidrRange is the size of the alias IP range which is allocated for each VM, e.g. `/24`.
+	IPCidrRange string `json:"ipCidrRange"`
 }
 
 // Volume contains configuration for the disks attached to VMs.
@@ -81,6 +214,79 @@ type WorkerStatus struct {
 	// reconciliation is possible.
 	// +optional
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
+
+	// CanaryRollouts contains the state of the worker pools whose changes are currently rolled out to canary machines.
+	// +optional
+	CanaryRollouts []CanaryRolloutStatus `json:"canaryRollouts,omitempty"`
+
+	// Pools contains the effective settings of the worker pools.
+	// +optional
+	Pools []WorkerPoolStatus `json:"pools,omitempty"`
+
+	// ZoneMigrations contains the zones removed from worker pools whose machines are not yet removed.
+	// +optional
+	ZoneMigrations []ZoneMigrationStatus `json:"zoneMigrations,omitempty"`
+
+	// ZoneCircuitBreakers contains the zones of worker pools in which machines could not be created.
+	// +optional
+	ZoneCircuitBreakers []ZoneCircuitBreakerStatus `json:"zoneCircuitBreakers,omitempty"`
+
+	// ErrorHistory contains the last errors which occurred while reconciling or deleting the worker, the newest one
+	// last.
+	// +optional
+	ErrorHistory []ErrorRecord `json:"errorHistory,omitempty"`
+}
+
+// WorkerPoolStatus contains the effective settings of a worker pool.
+type WorkerPoolStatus struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// MaxPods is the effective maximum number of pods per node, i.e. the configured maximum limited by the size of the
+	// alias IP range allocated for each node.
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+}
+
+// ZoneMigrationStatus contains the state of the removal of a zone from a worker pool.
+type ZoneMigrationStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// Zone is the removed zone.
+	Zone string `json:"zone"`
+	// MachineDeployment is the name of the machine deployment of the removed zone which is kept until the machines of
+	// the remaining zones are available.
+	MachineDeployment string `json:"machineDeployment"`
+	// Disks are the names of the persistent disks of the cluster in the removed zone. The machines of the zone are not
+	// removed as long as such disks exist.
+	// +optional
+	Disks []string `json:"disks,omitempty"`
+}
+
+// ZoneCircuitBreakerStatus contains the state of the circuit breaker of a zone of a worker pool in which machines could
+// not be created.
+type ZoneCircuitBreakerStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// Zone is the zone in which machines could not be created.
+	Zone string `json:"zone"`
+	// Failures is the number of consecutive failures to create machines in the zone.
+	Failures int32 `json:"failures"`
+	// SuspendedUntil is the time until which no machines are created in the zone. The suspension doubles with every
+	// consecutive failure.
+	SuspendedUntil metav1.Time `json:"suspendedUntil"`
+	// LastError is the error of the last failed machine creation in the zone.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+}
+
+// CanaryRolloutStatus contains the state of a staged rollout of a worker pool.
+type CanaryRolloutStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// Hash is the hash of the worker pool configuration which is rolled out to the canary machines.
+	Hash string `json:"hash"`
+	// StartTime is the time when the rollout to the canary machines was started.
+	StartTime metav1.Time `json:"startTime"`
 }
 
 // GPU is the configuration of the GPU to be attached
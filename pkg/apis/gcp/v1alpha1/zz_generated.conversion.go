@@ -13,6 +13,8 @@ import (
 	unsafe "unsafe"
 
 	gcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -24,6 +26,116 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdvertisedIPRange)(nil), (*gcp.AdvertisedIPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdvertisedIPRange_To_gcp_AdvertisedIPRange(a.(*AdvertisedIPRange), b.(*gcp.AdvertisedIPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.AdvertisedIPRange)(nil), (*AdvertisedIPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_AdvertisedIPRange_To_v1alpha1_AdvertisedIPRange(a.(*gcp.AdvertisedIPRange), b.(*AdvertisedIPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AliasIPRange)(nil), (*gcp.AliasIPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AliasIPRange_To_gcp_AliasIPRange(a.(*AliasIPRange), b.(*gcp.AliasIPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.AliasIPRange)(nil), (*AliasIPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_AliasIPRange_To_v1alpha1_AliasIPRange(a.(*gcp.AliasIPRange), b.(*AliasIPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditLogExport)(nil), (*gcp.AuditLogExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditLogExport_To_gcp_AuditLogExport(a.(*AuditLogExport), b.(*gcp.AuditLogExport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.AuditLogExport)(nil), (*AuditLogExport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_AuditLogExport_To_v1alpha1_AuditLogExport(a.(*gcp.AuditLogExport), b.(*AuditLogExport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditLogExportStatus)(nil), (*gcp.AuditLogExportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditLogExportStatus_To_gcp_AuditLogExportStatus(a.(*AuditLogExportStatus), b.(*gcp.AuditLogExportStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.AuditLogExportStatus)(nil), (*AuditLogExportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_AuditLogExportStatus_To_v1alpha1_AuditLogExportStatus(a.(*gcp.AuditLogExportStatus), b.(*AuditLogExportStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketConfig)(nil), (*gcp.BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(a.(*BackupBucketConfig), b.(*gcp.BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.BackupBucketConfig)(nil), (*BackupBucketConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(a.(*gcp.BackupBucketConfig), b.(*BackupBucketConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketLifecycle)(nil), (*gcp.BackupBucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupBucketLifecycle_To_gcp_BackupBucketLifecycle(a.(*BackupBucketLifecycle), b.(*gcp.BackupBucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.BackupBucketLifecycle)(nil), (*BackupBucketLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle(a.(*gcp.BackupBucketLifecycle), b.(*BackupBucketLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSIDriverConfig)(nil), (*gcp.CSIDriverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIDriverConfig_To_gcp_CSIDriverConfig(a.(*CSIDriverConfig), b.(*gcp.CSIDriverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CSIDriverConfig)(nil), (*CSIDriverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CSIDriverConfig_To_v1alpha1_CSIDriverConfig(a.(*gcp.CSIDriverConfig), b.(*CSIDriverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSIDriverResources)(nil), (*gcp.CSIDriverResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIDriverResources_To_gcp_CSIDriverResources(a.(*CSIDriverResources), b.(*gcp.CSIDriverResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CSIDriverResources)(nil), (*CSIDriverResources)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CSIDriverResources_To_v1alpha1_CSIDriverResources(a.(*gcp.CSIDriverResources), b.(*CSIDriverResources), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryRollout)(nil), (*gcp.CanaryRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryRollout_To_gcp_CanaryRollout(a.(*CanaryRollout), b.(*gcp.CanaryRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CanaryRollout)(nil), (*CanaryRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CanaryRollout_To_v1alpha1_CanaryRollout(a.(*gcp.CanaryRollout), b.(*CanaryRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryRolloutStatus)(nil), (*gcp.CanaryRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryRolloutStatus_To_gcp_CanaryRolloutStatus(a.(*CanaryRolloutStatus), b.(*gcp.CanaryRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CanaryRolloutStatus)(nil), (*CanaryRolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CanaryRolloutStatus_To_v1alpha1_CanaryRolloutStatus(a.(*gcp.CanaryRolloutStatus), b.(*CanaryRolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudConfig)(nil), (*gcp.CloudConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudConfig_To_gcp_CloudConfig(a.(*CloudConfig), b.(*gcp.CloudConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudConfig)(nil), (*CloudConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudConfig_To_v1alpha1_CloudConfig(a.(*gcp.CloudConfig), b.(*CloudConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*gcp.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*gcp.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -44,6 +156,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudNATStatus)(nil), (*gcp.CloudNATStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus(a.(*CloudNATStatus), b.(*gcp.CloudNATStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudNATStatus)(nil), (*CloudNATStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus(a.(*gcp.CloudNATStatus), b.(*CloudNATStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfileConfig)(nil), (*gcp.CloudProfileConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(a.(*CloudProfileConfig), b.(*gcp.CloudProfileConfig), scope)
 	}); err != nil {
@@ -64,6 +186,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudRouterBGP)(nil), (*gcp.CloudRouterBGP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudRouterBGP_To_gcp_CloudRouterBGP(a.(*CloudRouterBGP), b.(*gcp.CloudRouterBGP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.CloudRouterBGP)(nil), (*CloudRouterBGP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_CloudRouterBGP_To_v1alpha1_CloudRouterBGP(a.(*gcp.CloudRouterBGP), b.(*CloudRouterBGP), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControlPlaneConfig)(nil), (*gcp.ControlPlaneConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(a.(*ControlPlaneConfig), b.(*gcp.ControlPlaneConfig), scope)
 	}); err != nil {
@@ -74,6 +206,136 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneStatus)(nil), (*gcp.ControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus(a.(*ControlPlaneStatus), b.(*gcp.ControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.ControlPlaneStatus)(nil), (*ControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(a.(*gcp.ControlPlaneStatus), b.(*ControlPlaneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSFailoverPolicy)(nil), (*gcp.DNSFailoverPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSFailoverPolicy_To_gcp_DNSFailoverPolicy(a.(*DNSFailoverPolicy), b.(*gcp.DNSFailoverPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSFailoverPolicy)(nil), (*DNSFailoverPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSFailoverPolicy_To_v1alpha1_DNSFailoverPolicy(a.(*gcp.DNSFailoverPolicy), b.(*DNSFailoverPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSGeoTarget)(nil), (*gcp.DNSGeoTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget(a.(*DNSGeoTarget), b.(*gcp.DNSGeoTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSGeoTarget)(nil), (*DNSGeoTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget(a.(*gcp.DNSGeoTarget), b.(*DNSGeoTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSLoadBalancerTarget)(nil), (*gcp.DNSLoadBalancerTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSLoadBalancerTarget_To_gcp_DNSLoadBalancerTarget(a.(*DNSLoadBalancerTarget), b.(*gcp.DNSLoadBalancerTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSLoadBalancerTarget)(nil), (*DNSLoadBalancerTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSLoadBalancerTarget_To_v1alpha1_DNSLoadBalancerTarget(a.(*gcp.DNSLoadBalancerTarget), b.(*DNSLoadBalancerTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRecordConfig)(nil), (*gcp.DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(a.(*DNSRecordConfig), b.(*gcp.DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSRecordConfig)(nil), (*DNSRecordConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(a.(*gcp.DNSRecordConfig), b.(*DNSRecordConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSRoutingPolicy)(nil), (*gcp.DNSRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(a.(*DNSRoutingPolicy), b.(*gcp.DNSRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSRoutingPolicy)(nil), (*DNSRoutingPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(a.(*gcp.DNSRoutingPolicy), b.(*DNSRoutingPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSWeightedTarget)(nil), (*gcp.DNSWeightedTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget(a.(*DNSWeightedTarget), b.(*gcp.DNSWeightedTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DNSWeightedTarget)(nil), (*DNSWeightedTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget(a.(*gcp.DNSWeightedTarget), b.(*DNSWeightedTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskClone)(nil), (*gcp.DiskClone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskClone_To_gcp_DiskClone(a.(*DiskClone), b.(*gcp.DiskClone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DiskClone)(nil), (*DiskClone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DiskClone_To_v1alpha1_DiskClone(a.(*gcp.DiskClone), b.(*DiskClone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskCloneClaim)(nil), (*gcp.DiskCloneClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskCloneClaim_To_gcp_DiskCloneClaim(a.(*DiskCloneClaim), b.(*gcp.DiskCloneClaim), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DiskCloneClaim)(nil), (*DiskCloneClaim)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DiskCloneClaim_To_v1alpha1_DiskCloneClaim(a.(*gcp.DiskCloneClaim), b.(*DiskCloneClaim), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskCloneClaimStatus)(nil), (*gcp.DiskCloneClaimStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskCloneClaimStatus_To_gcp_DiskCloneClaimStatus(a.(*DiskCloneClaimStatus), b.(*gcp.DiskCloneClaimStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DiskCloneClaimStatus)(nil), (*DiskCloneClaimStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DiskCloneClaimStatus_To_v1alpha1_DiskCloneClaimStatus(a.(*gcp.DiskCloneClaimStatus), b.(*DiskCloneClaimStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskCloneList)(nil), (*gcp.DiskCloneList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskCloneList_To_gcp_DiskCloneList(a.(*DiskCloneList), b.(*gcp.DiskCloneList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DiskCloneList)(nil), (*DiskCloneList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DiskCloneList_To_v1alpha1_DiskCloneList(a.(*gcp.DiskCloneList), b.(*DiskCloneList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskCloneSpec)(nil), (*gcp.DiskCloneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskCloneSpec_To_gcp_DiskCloneSpec(a.(*DiskCloneSpec), b.(*gcp.DiskCloneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DiskCloneSpec)(nil), (*DiskCloneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DiskCloneSpec_To_v1alpha1_DiskCloneSpec(a.(*gcp.DiskCloneSpec), b.(*DiskCloneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskCloneStatus)(nil), (*gcp.DiskCloneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskCloneStatus_To_gcp_DiskCloneStatus(a.(*DiskCloneStatus), b.(*gcp.DiskCloneStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.DiskCloneStatus)(nil), (*DiskCloneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_DiskCloneStatus_To_v1alpha1_DiskCloneStatus(a.(*gcp.DiskCloneStatus), b.(*DiskCloneStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DiskEncryption)(nil), (*gcp.DiskEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption(a.(*DiskEncryption), b.(*gcp.DiskEncryption), scope)
 	}); err != nil {
@@ -94,645 +356,2387 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*FlowLogs)(nil), (*gcp.FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs(a.(*FlowLogs), b.(*gcp.FlowLogs), scope)
+	if err := s.AddGeneratedConversionFunc((*ErrorRecord)(nil), (*gcp.ErrorRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ErrorRecord_To_gcp_ErrorRecord(a.(*ErrorRecord), b.(*gcp.ErrorRecord), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.FlowLogs)(nil), (*FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(a.(*gcp.FlowLogs), b.(*FlowLogs), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.ErrorRecord)(nil), (*ErrorRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ErrorRecord_To_v1alpha1_ErrorRecord(a.(*gcp.ErrorRecord), b.(*ErrorRecord), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*GPU)(nil), (*gcp.GPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_GPU_To_gcp_GPU(a.(*GPU), b.(*gcp.GPU), scope)
+	if err := s.AddGeneratedConversionFunc((*ExistingSubnets)(nil), (*gcp.ExistingSubnets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExistingSubnets_To_gcp_ExistingSubnets(a.(*ExistingSubnets), b.(*gcp.ExistingSubnets), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.GPU)(nil), (*GPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_GPU_To_v1alpha1_GPU(a.(*gcp.GPU), b.(*GPU), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.ExistingSubnets)(nil), (*ExistingSubnets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ExistingSubnets_To_v1alpha1_ExistingSubnets(a.(*gcp.ExistingSubnets), b.(*ExistingSubnets), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*gcp.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(a.(*InfrastructureConfig), b.(*gcp.InfrastructureConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*Filestore)(nil), (*gcp.Filestore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Filestore_To_gcp_Filestore(a.(*Filestore), b.(*gcp.Filestore), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.InfrastructureConfig)(nil), (*InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(a.(*gcp.InfrastructureConfig), b.(*InfrastructureConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.Filestore)(nil), (*Filestore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_Filestore_To_v1alpha1_Filestore(a.(*gcp.Filestore), b.(*Filestore), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*InfrastructureStatus)(nil), (*gcp.InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus(a.(*InfrastructureStatus), b.(*gcp.InfrastructureStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*FirewallPolicy)(nil), (*gcp.FirewallPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FirewallPolicy_To_gcp_FirewallPolicy(a.(*FirewallPolicy), b.(*gcp.FirewallPolicy), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.InfrastructureStatus)(nil), (*InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(a.(*gcp.InfrastructureStatus), b.(*InfrastructureStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.FirewallPolicy)(nil), (*FirewallPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_FirewallPolicy_To_v1alpha1_FirewallPolicy(a.(*gcp.FirewallPolicy), b.(*FirewallPolicy), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*gcp.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_MachineImage_To_gcp_MachineImage(a.(*MachineImage), b.(*gcp.MachineImage), scope)
+	if err := s.AddGeneratedConversionFunc((*FirewallPolicyRule)(nil), (*gcp.FirewallPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FirewallPolicyRule_To_gcp_FirewallPolicyRule(a.(*FirewallPolicyRule), b.(*gcp.FirewallPolicyRule), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.MachineImage)(nil), (*MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_MachineImage_To_v1alpha1_MachineImage(a.(*gcp.MachineImage), b.(*MachineImage), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.FirewallPolicyRule)(nil), (*FirewallPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_FirewallPolicyRule_To_v1alpha1_FirewallPolicyRule(a.(*gcp.FirewallPolicyRule), b.(*FirewallPolicyRule), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*gcp.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(a.(*MachineImageVersion), b.(*gcp.MachineImageVersion), scope)
+	if err := s.AddGeneratedConversionFunc((*FirewallRule)(nil), (*gcp.FirewallRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FirewallRule_To_gcp_FirewallRule(a.(*FirewallRule), b.(*gcp.FirewallRule), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.MachineImageVersion)(nil), (*MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(a.(*gcp.MachineImageVersion), b.(*MachineImageVersion), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.FirewallRule)(nil), (*FirewallRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_FirewallRule_To_v1alpha1_FirewallRule(a.(*gcp.FirewallRule), b.(*FirewallRule), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineImages)(nil), (*gcp.MachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_MachineImages_To_gcp_MachineImages(a.(*MachineImages), b.(*gcp.MachineImages), scope)
+	if err := s.AddGeneratedConversionFunc((*FirewallRuleProtocol)(nil), (*gcp.FirewallRuleProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FirewallRuleProtocol_To_gcp_FirewallRuleProtocol(a.(*FirewallRuleProtocol), b.(*gcp.FirewallRuleProtocol), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.MachineImages)(nil), (*MachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_MachineImages_To_v1alpha1_MachineImages(a.(*gcp.MachineImages), b.(*MachineImages), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.FirewallRuleProtocol)(nil), (*FirewallRuleProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_FirewallRuleProtocol_To_v1alpha1_FirewallRuleProtocol(a.(*gcp.FirewallRuleProtocol), b.(*FirewallRuleProtocol), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*NatIP)(nil), (*gcp.NatIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_NatIP_To_gcp_NatIP(a.(*NatIP), b.(*gcp.NatIP), scope)
+	if err := s.AddGeneratedConversionFunc((*FlowLogs)(nil), (*gcp.FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs(a.(*FlowLogs), b.(*gcp.FlowLogs), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.NatIP)(nil), (*NatIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_NatIP_To_v1alpha1_NatIP(a.(*gcp.NatIP), b.(*NatIP), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.FlowLogs)(nil), (*FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(a.(*gcp.FlowLogs), b.(*FlowLogs), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*NatIPName)(nil), (*gcp.NatIPName)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_NatIPName_To_gcp_NatIPName(a.(*NatIPName), b.(*gcp.NatIPName), scope)
+	if err := s.AddGeneratedConversionFunc((*GPU)(nil), (*gcp.GPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GPU_To_gcp_GPU(a.(*GPU), b.(*gcp.GPU), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.NatIPName)(nil), (*NatIPName)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_NatIPName_To_v1alpha1_NatIPName(a.(*gcp.NatIPName), b.(*NatIPName), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.GPU)(nil), (*GPU)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_GPU_To_v1alpha1_GPU(a.(*gcp.GPU), b.(*GPU), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*gcp.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(a.(*NetworkConfig), b.(*gcp.NetworkConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*IPv6Config)(nil), (*gcp.IPv6Config)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPv6Config_To_gcp_IPv6Config(a.(*IPv6Config), b.(*gcp.IPv6Config), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.NetworkConfig)(nil), (*NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(a.(*gcp.NetworkConfig), b.(*NetworkConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.IPv6Config)(nil), (*IPv6Config)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_IPv6Config_To_v1alpha1_IPv6Config(a.(*gcp.IPv6Config), b.(*IPv6Config), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*gcp.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(a.(*NetworkStatus), b.(*gcp.NetworkStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*ImageStreaming)(nil), (*gcp.ImageStreaming)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageStreaming_To_gcp_ImageStreaming(a.(*ImageStreaming), b.(*gcp.ImageStreaming), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.NetworkStatus)(nil), (*NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(a.(*gcp.NetworkStatus), b.(*NetworkStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.ImageStreaming)(nil), (*ImageStreaming)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ImageStreaming_To_v1alpha1_ImageStreaming(a.(*gcp.ImageStreaming), b.(*ImageStreaming), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ServiceAccount)(nil), (*gcp.ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_ServiceAccount_To_gcp_ServiceAccount(a.(*ServiceAccount), b.(*gcp.ServiceAccount), scope)
+	if err := s.AddGeneratedConversionFunc((*InfrastructureConfig)(nil), (*gcp.InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(a.(*InfrastructureConfig), b.(*gcp.InfrastructureConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.ServiceAccount)(nil), (*ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_ServiceAccount_To_v1alpha1_ServiceAccount(a.(*gcp.ServiceAccount), b.(*ServiceAccount), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.InfrastructureConfig)(nil), (*InfrastructureConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(a.(*gcp.InfrastructureConfig), b.(*InfrastructureConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*gcp.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Storage_To_gcp_Storage(a.(*Storage), b.(*gcp.Storage), scope)
+	if err := s.AddGeneratedConversionFunc((*InfrastructurePlan)(nil), (*gcp.InfrastructurePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructurePlan_To_gcp_InfrastructurePlan(a.(*InfrastructurePlan), b.(*gcp.InfrastructurePlan), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_Storage_To_v1alpha1_Storage(a.(*gcp.Storage), b.(*Storage), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.InfrastructurePlan)(nil), (*InfrastructurePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_InfrastructurePlan_To_v1alpha1_InfrastructurePlan(a.(*gcp.InfrastructurePlan), b.(*InfrastructurePlan), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*gcp.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Subnet_To_gcp_Subnet(a.(*Subnet), b.(*gcp.Subnet), scope)
+	if err := s.AddGeneratedConversionFunc((*InfrastructureStatus)(nil), (*gcp.InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus(a.(*InfrastructureStatus), b.(*gcp.InfrastructureStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_Subnet_To_v1alpha1_Subnet(a.(*gcp.Subnet), b.(*Subnet), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.InfrastructureStatus)(nil), (*InfrastructureStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(a.(*gcp.InfrastructureStatus), b.(*InfrastructureStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VPC)(nil), (*gcp.VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VPC_To_gcp_VPC(a.(*VPC), b.(*gcp.VPC), scope)
+	if err := s.AddGeneratedConversionFunc((*InternalLoadBalancerConfig)(nil), (*gcp.InternalLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InternalLoadBalancerConfig_To_gcp_InternalLoadBalancerConfig(a.(*InternalLoadBalancerConfig), b.(*gcp.InternalLoadBalancerConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.VPC)(nil), (*VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_VPC_To_v1alpha1_VPC(a.(*gcp.VPC), b.(*VPC), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.InternalLoadBalancerConfig)(nil), (*InternalLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig(a.(*gcp.InternalLoadBalancerConfig), b.(*InternalLoadBalancerConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*gcp.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Volume_To_gcp_Volume(a.(*Volume), b.(*gcp.Volume), scope)
+	if err := s.AddGeneratedConversionFunc((*InternalLoadBalancerStatus)(nil), (*gcp.InternalLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InternalLoadBalancerStatus_To_gcp_InternalLoadBalancerStatus(a.(*InternalLoadBalancerStatus), b.(*gcp.InternalLoadBalancerStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_Volume_To_v1alpha1_Volume(a.(*gcp.Volume), b.(*Volume), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.InternalLoadBalancerStatus)(nil), (*InternalLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_InternalLoadBalancerStatus_To_v1alpha1_InternalLoadBalancerStatus(a.(*gcp.InternalLoadBalancerStatus), b.(*InternalLoadBalancerStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*gcp.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(a.(*WorkerConfig), b.(*gcp.WorkerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*LifecycleTransition)(nil), (*gcp.LifecycleTransition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LifecycleTransition_To_gcp_LifecycleTransition(a.(*LifecycleTransition), b.(*gcp.LifecycleTransition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*gcp.WorkerConfig), b.(*WorkerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.LifecycleTransition)(nil), (*LifecycleTransition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_LifecycleTransition_To_v1alpha1_LifecycleTransition(a.(*gcp.LifecycleTransition), b.(*LifecycleTransition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*gcp.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_WorkerStatus_To_gcp_WorkerStatus(a.(*WorkerStatus), b.(*gcp.WorkerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*gcp.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineImage_To_gcp_MachineImage(a.(*MachineImage), b.(*gcp.MachineImage), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*gcp.WorkerStatus)(nil), (*WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_gcp_WorkerStatus_To_v1alpha1_WorkerStatus(a.(*gcp.WorkerStatus), b.(*WorkerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*gcp.MachineImage)(nil), (*MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_MachineImage_To_v1alpha1_MachineImage(a.(*gcp.MachineImage), b.(*MachineImage), scope)
 	}); err != nil {
 		return err
 	}
-	return nil
-}
-
-func autoConvert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *gcp.CloudControllerManagerConfig, s conversion.Scope) error {
-	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
-	return nil
-}
-
+	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*gcp.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(a.(*MachineImageVersion), b.(*gcp.MachineImageVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.MachineImageVersion)(nil), (*MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(a.(*gcp.MachineImageVersion), b.(*MachineImageVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineImages)(nil), (*gcp.MachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineImages_To_gcp_MachineImages(a.(*MachineImages), b.(*gcp.MachineImages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.MachineImages)(nil), (*MachineImages)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_MachineImages_To_v1alpha1_MachineImages(a.(*gcp.MachineImages), b.(*MachineImages), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NCCSpoke)(nil), (*gcp.NCCSpoke)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NCCSpoke_To_gcp_NCCSpoke(a.(*NCCSpoke), b.(*gcp.NCCSpoke), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NCCSpoke)(nil), (*NCCSpoke)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NCCSpoke_To_v1alpha1_NCCSpoke(a.(*gcp.NCCSpoke), b.(*NCCSpoke), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NCCSpokeStatus)(nil), (*gcp.NCCSpokeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NCCSpokeStatus_To_gcp_NCCSpokeStatus(a.(*NCCSpokeStatus), b.(*gcp.NCCSpokeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NCCSpokeStatus)(nil), (*NCCSpokeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NCCSpokeStatus_To_v1alpha1_NCCSpokeStatus(a.(*gcp.NCCSpokeStatus), b.(*NCCSpokeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatIP)(nil), (*gcp.NatIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatIP_To_gcp_NatIP(a.(*NatIP), b.(*gcp.NatIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NatIP)(nil), (*NatIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NatIP_To_v1alpha1_NatIP(a.(*gcp.NatIP), b.(*NatIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatIPName)(nil), (*gcp.NatIPName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatIPName_To_gcp_NatIPName(a.(*NatIPName), b.(*gcp.NatIPName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NatIPName)(nil), (*NatIPName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NatIPName_To_v1alpha1_NatIPName(a.(*gcp.NatIPName), b.(*NatIPName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatIPRotationStatus)(nil), (*gcp.NatIPRotationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatIPRotationStatus_To_gcp_NatIPRotationStatus(a.(*NatIPRotationStatus), b.(*gcp.NatIPRotationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NatIPRotationStatus)(nil), (*NatIPRotationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NatIPRotationStatus_To_v1alpha1_NatIPRotationStatus(a.(*gcp.NatIPRotationStatus), b.(*NatIPRotationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NatLogging)(nil), (*gcp.NatLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NatLogging_To_gcp_NatLogging(a.(*NatLogging), b.(*gcp.NatLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NatLogging)(nil), (*NatLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NatLogging_To_v1alpha1_NatLogging(a.(*gcp.NatLogging), b.(*NatLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkConfig)(nil), (*gcp.NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(a.(*NetworkConfig), b.(*gcp.NetworkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NetworkConfig)(nil), (*NetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(a.(*gcp.NetworkConfig), b.(*NetworkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*gcp.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(a.(*NetworkStatus), b.(*gcp.NetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NetworkStatus)(nil), (*NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(a.(*gcp.NetworkStatus), b.(*NetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeServiceAccount)(nil), (*gcp.NodeServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeServiceAccount_To_gcp_NodeServiceAccount(a.(*NodeServiceAccount), b.(*gcp.NodeServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.NodeServiceAccount)(nil), (*NodeServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_NodeServiceAccount_To_v1alpha1_NodeServiceAccount(a.(*gcp.NodeServiceAccount), b.(*NodeServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OpsAgentConfig)(nil), (*gcp.OpsAgentConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OpsAgentConfig_To_gcp_OpsAgentConfig(a.(*OpsAgentConfig), b.(*gcp.OpsAgentConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.OpsAgentConfig)(nil), (*OpsAgentConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_OpsAgentConfig_To_v1alpha1_OpsAgentConfig(a.(*gcp.OpsAgentConfig), b.(*OpsAgentConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PacketMirroring)(nil), (*gcp.PacketMirroring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PacketMirroring_To_gcp_PacketMirroring(a.(*PacketMirroring), b.(*gcp.PacketMirroring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.PacketMirroring)(nil), (*PacketMirroring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_PacketMirroring_To_v1alpha1_PacketMirroring(a.(*gcp.PacketMirroring), b.(*PacketMirroring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PacketMirroringFilter)(nil), (*gcp.PacketMirroringFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PacketMirroringFilter_To_gcp_PacketMirroringFilter(a.(*PacketMirroringFilter), b.(*gcp.PacketMirroringFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.PacketMirroringFilter)(nil), (*PacketMirroringFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_PacketMirroringFilter_To_v1alpha1_PacketMirroringFilter(a.(*gcp.PacketMirroringFilter), b.(*PacketMirroringFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlannedOperation)(nil), (*gcp.PlannedOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlannedOperation_To_gcp_PlannedOperation(a.(*PlannedOperation), b.(*gcp.PlannedOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.PlannedOperation)(nil), (*PlannedOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_PlannedOperation_To_v1alpha1_PlannedOperation(a.(*gcp.PlannedOperation), b.(*PlannedOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateDNSZone)(nil), (*gcp.PrivateDNSZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateDNSZone_To_gcp_PrivateDNSZone(a.(*PrivateDNSZone), b.(*gcp.PrivateDNSZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.PrivateDNSZone)(nil), (*PrivateDNSZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(a.(*gcp.PrivateDNSZone), b.(*PrivateDNSZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateServiceConnectEndpoint)(nil), (*gcp.PrivateServiceConnectEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateServiceConnectEndpoint_To_gcp_PrivateServiceConnectEndpoint(a.(*PrivateServiceConnectEndpoint), b.(*gcp.PrivateServiceConnectEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.PrivateServiceConnectEndpoint)(nil), (*PrivateServiceConnectEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_PrivateServiceConnectEndpoint_To_v1alpha1_PrivateServiceConnectEndpoint(a.(*gcp.PrivateServiceConnectEndpoint), b.(*PrivateServiceConnectEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateServiceConnectEndpointStatus)(nil), (*gcp.PrivateServiceConnectEndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrivateServiceConnectEndpointStatus_To_gcp_PrivateServiceConnectEndpointStatus(a.(*PrivateServiceConnectEndpointStatus), b.(*gcp.PrivateServiceConnectEndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.PrivateServiceConnectEndpointStatus)(nil), (*PrivateServiceConnectEndpointStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_PrivateServiceConnectEndpointStatus_To_v1alpha1_PrivateServiceConnectEndpointStatus(a.(*gcp.PrivateServiceConnectEndpointStatus), b.(*PrivateServiceConnectEndpointStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryMirror)(nil), (*gcp.RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegistryMirror_To_gcp_RegistryMirror(a.(*RegistryMirror), b.(*gcp.RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.RegistryMirror)(nil), (*RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_RegistryMirror_To_v1alpha1_RegistryMirror(a.(*gcp.RegistryMirror), b.(*RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Scheduling)(nil), (*gcp.Scheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Scheduling_To_gcp_Scheduling(a.(*Scheduling), b.(*gcp.Scheduling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.Scheduling)(nil), (*Scheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_Scheduling_To_v1alpha1_Scheduling(a.(*gcp.Scheduling), b.(*Scheduling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecondaryRange)(nil), (*gcp.SecondaryRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecondaryRange_To_gcp_SecondaryRange(a.(*SecondaryRange), b.(*gcp.SecondaryRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.SecondaryRange)(nil), (*SecondaryRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_SecondaryRange_To_v1alpha1_SecondaryRange(a.(*gcp.SecondaryRange), b.(*SecondaryRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceAccount)(nil), (*gcp.ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ServiceAccount_To_gcp_ServiceAccount(a.(*ServiceAccount), b.(*gcp.ServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.ServiceAccount)(nil), (*ServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ServiceAccount_To_v1alpha1_ServiceAccount(a.(*gcp.ServiceAccount), b.(*ServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*gcp.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_gcp_Storage(a.(*Storage), b.(*gcp.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_Storage_To_v1alpha1_Storage(a.(*gcp.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*gcp.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_gcp_StorageClass(a.(*StorageClass), b.(*gcp.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_StorageClass_To_v1alpha1_StorageClass(a.(*gcp.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*gcp.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Subnet_To_gcp_Subnet(a.(*Subnet), b.(*gcp.Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_Subnet_To_v1alpha1_Subnet(a.(*gcp.Subnet), b.(*Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPC)(nil), (*gcp.VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPC_To_gcp_VPC(a.(*VPC), b.(*gcp.VPC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.VPC)(nil), (*VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_VPC_To_v1alpha1_VPC(a.(*gcp.VPC), b.(*VPC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCPeering)(nil), (*gcp.VPCPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCPeering_To_gcp_VPCPeering(a.(*VPCPeering), b.(*gcp.VPCPeering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.VPCPeering)(nil), (*VPCPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_VPCPeering_To_v1alpha1_VPCPeering(a.(*gcp.VPCPeering), b.(*VPCPeering), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCPeeringStatus)(nil), (*gcp.VPCPeeringStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCPeeringStatus_To_gcp_VPCPeeringStatus(a.(*VPCPeeringStatus), b.(*gcp.VPCPeeringStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.VPCPeeringStatus)(nil), (*VPCPeeringStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus(a.(*gcp.VPCPeeringStatus), b.(*VPCPeeringStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Volume)(nil), (*gcp.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Volume_To_gcp_Volume(a.(*Volume), b.(*gcp.Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_Volume_To_v1alpha1_Volume(a.(*gcp.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshotClassConfig)(nil), (*gcp.VolumeSnapshotClassConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotClassConfig_To_gcp_VolumeSnapshotClassConfig(a.(*VolumeSnapshotClassConfig), b.(*gcp.VolumeSnapshotClassConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.VolumeSnapshotClassConfig)(nil), (*VolumeSnapshotClassConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig(a.(*gcp.VolumeSnapshotClassConfig), b.(*VolumeSnapshotClassConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*gcp.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(a.(*WorkerConfig), b.(*gcp.WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.WorkerConfig)(nil), (*WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(a.(*gcp.WorkerConfig), b.(*WorkerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolServiceAccount)(nil), (*gcp.WorkerPoolServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPoolServiceAccount_To_gcp_WorkerPoolServiceAccount(a.(*WorkerPoolServiceAccount), b.(*gcp.WorkerPoolServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.WorkerPoolServiceAccount)(nil), (*WorkerPoolServiceAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_WorkerPoolServiceAccount_To_v1alpha1_WorkerPoolServiceAccount(a.(*gcp.WorkerPoolServiceAccount), b.(*WorkerPoolServiceAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolServiceAccountStatus)(nil), (*gcp.WorkerPoolServiceAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPoolServiceAccountStatus_To_gcp_WorkerPoolServiceAccountStatus(a.(*WorkerPoolServiceAccountStatus), b.(*gcp.WorkerPoolServiceAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.WorkerPoolServiceAccountStatus)(nil), (*WorkerPoolServiceAccountStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_WorkerPoolServiceAccountStatus_To_v1alpha1_WorkerPoolServiceAccountStatus(a.(*gcp.WorkerPoolServiceAccountStatus), b.(*WorkerPoolServiceAccountStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolStatus)(nil), (*gcp.WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPoolStatus_To_gcp_WorkerPoolStatus(a.(*WorkerPoolStatus), b.(*gcp.WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.WorkerPoolStatus)(nil), (*WorkerPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus(a.(*gcp.WorkerPoolStatus), b.(*WorkerPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*gcp.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerStatus_To_gcp_WorkerStatus(a.(*WorkerStatus), b.(*gcp.WorkerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.WorkerStatus)(nil), (*WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_WorkerStatus_To_v1alpha1_WorkerStatus(a.(*gcp.WorkerStatus), b.(*WorkerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*gcp.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Zone_To_gcp_Zone(a.(*Zone), b.(*gcp.Zone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.Zone)(nil), (*Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_Zone_To_v1alpha1_Zone(a.(*gcp.Zone), b.(*Zone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneCircuitBreakerStatus)(nil), (*gcp.ZoneCircuitBreakerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneCircuitBreakerStatus_To_gcp_ZoneCircuitBreakerStatus(a.(*ZoneCircuitBreakerStatus), b.(*gcp.ZoneCircuitBreakerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.ZoneCircuitBreakerStatus)(nil), (*ZoneCircuitBreakerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ZoneCircuitBreakerStatus_To_v1alpha1_ZoneCircuitBreakerStatus(a.(*gcp.ZoneCircuitBreakerStatus), b.(*ZoneCircuitBreakerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ZoneMigrationStatus)(nil), (*gcp.ZoneMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneMigrationStatus_To_gcp_ZoneMigrationStatus(a.(*ZoneMigrationStatus), b.(*gcp.ZoneMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*gcp.ZoneMigrationStatus)(nil), (*ZoneMigrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_gcp_ZoneMigrationStatus_To_v1alpha1_ZoneMigrationStatus(a.(*gcp.ZoneMigrationStatus), b.(*ZoneMigrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AdvertisedIPRange_To_gcp_AdvertisedIPRange(in *AdvertisedIPRange, out *gcp.AdvertisedIPRange, s conversion.Scope) error {
+	out.Range = in.Range
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_v1alpha1_AdvertisedIPRange_To_gcp_AdvertisedIPRange is an autogenerated conversion function.
+func Convert_v1alpha1_AdvertisedIPRange_To_gcp_AdvertisedIPRange(in *AdvertisedIPRange, out *gcp.AdvertisedIPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdvertisedIPRange_To_gcp_AdvertisedIPRange(in, out, s)
+}
+
+func autoConvert_gcp_AdvertisedIPRange_To_v1alpha1_AdvertisedIPRange(in *gcp.AdvertisedIPRange, out *AdvertisedIPRange, s conversion.Scope) error {
+	out.Range = in.Range
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_gcp_AdvertisedIPRange_To_v1alpha1_AdvertisedIPRange is an autogenerated conversion function.
+func Convert_gcp_AdvertisedIPRange_To_v1alpha1_AdvertisedIPRange(in *gcp.AdvertisedIPRange, out *AdvertisedIPRange, s conversion.Scope) error {
+	return autoConvert_gcp_AdvertisedIPRange_To_v1alpha1_AdvertisedIPRange(in, out, s)
+}
+
+func autoConvert_v1alpha1_AliasIPRange_To_gcp_AliasIPRange(in *AliasIPRange, out *gcp.AliasIPRange, s conversion.Scope) error {
+	out.SubnetworkRangeName = in.SubnetworkRangeName
+	out.IPCidrRange = in.IPCidrRange
+	return nil
+}
+
+// Convert_v1alpha1_AliasIPRange_To_gcp_AliasIPRange is an autogenerated conversion function.
+func Convert_v1alpha1_AliasIPRange_To_gcp_AliasIPRange(in *AliasIPRange, out *gcp.AliasIPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AliasIPRange_To_gcp_AliasIPRange(in, out, s)
+}
+
+func autoConvert_gcp_AliasIPRange_To_v1alpha1_AliasIPRange(in *gcp.AliasIPRange, out *AliasIPRange, s conversion.Scope) error {
+	out.SubnetworkRangeName = in.SubnetworkRangeName
+	out.IPCidrRange = in.IPCidrRange
+	return nil
+}
+
+// Convert_gcp_AliasIPRange_To_v1alpha1_AliasIPRange is an autogenerated conversion function.
+func Convert_gcp_AliasIPRange_To_v1alpha1_AliasIPRange(in *gcp.AliasIPRange, out *AliasIPRange, s conversion.Scope) error {
+	return autoConvert_gcp_AliasIPRange_To_v1alpha1_AliasIPRange(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditLogExport_To_gcp_AuditLogExport(in *AuditLogExport, out *gcp.AuditLogExport, s conversion.Scope) error {
+	out.Destination = in.Destination
+	return nil
+}
+
+// Convert_v1alpha1_AuditLogExport_To_gcp_AuditLogExport is an autogenerated conversion function.
+func Convert_v1alpha1_AuditLogExport_To_gcp_AuditLogExport(in *AuditLogExport, out *gcp.AuditLogExport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditLogExport_To_gcp_AuditLogExport(in, out, s)
+}
+
+func autoConvert_gcp_AuditLogExport_To_v1alpha1_AuditLogExport(in *gcp.AuditLogExport, out *AuditLogExport, s conversion.Scope) error {
+	out.Destination = in.Destination
+	return nil
+}
+
+// Convert_gcp_AuditLogExport_To_v1alpha1_AuditLogExport is an autogenerated conversion function.
+func Convert_gcp_AuditLogExport_To_v1alpha1_AuditLogExport(in *gcp.AuditLogExport, out *AuditLogExport, s conversion.Scope) error {
+	return autoConvert_gcp_AuditLogExport_To_v1alpha1_AuditLogExport(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditLogExportStatus_To_gcp_AuditLogExportStatus(in *AuditLogExportStatus, out *gcp.AuditLogExportStatus, s conversion.Scope) error {
+	out.SinkName = in.SinkName
+	out.WriterIdentity = in.WriterIdentity
+	return nil
+}
+
+// Convert_v1alpha1_AuditLogExportStatus_To_gcp_AuditLogExportStatus is an autogenerated conversion function.
+func Convert_v1alpha1_AuditLogExportStatus_To_gcp_AuditLogExportStatus(in *AuditLogExportStatus, out *gcp.AuditLogExportStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditLogExportStatus_To_gcp_AuditLogExportStatus(in, out, s)
+}
+
+func autoConvert_gcp_AuditLogExportStatus_To_v1alpha1_AuditLogExportStatus(in *gcp.AuditLogExportStatus, out *AuditLogExportStatus, s conversion.Scope) error {
+	out.SinkName = in.SinkName
+	out.WriterIdentity = in.WriterIdentity
+	return nil
+}
+
+// Convert_gcp_AuditLogExportStatus_To_v1alpha1_AuditLogExportStatus is an autogenerated conversion function.
+func Convert_gcp_AuditLogExportStatus_To_v1alpha1_AuditLogExportStatus(in *gcp.AuditLogExportStatus, out *AuditLogExportStatus, s conversion.Scope) error {
+	return autoConvert_gcp_AuditLogExportStatus_To_v1alpha1_AuditLogExportStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBucketConfig, out *gcp.BackupBucketConfig, s conversion.Scope) error {
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.TurboReplication = in.TurboReplication
+	out.StorageClass = (*string)(unsafe.Pointer(in.StorageClass))
+	out.KmsKeyName = (*string)(unsafe.Pointer(in.KmsKeyName))
+	out.RetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	out.Locked = in.Locked
+	out.EventBasedHold = in.EventBasedHold
+	out.Versioning = in.Versioning
+	out.Lifecycle = (*gcp.BackupBucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in *BackupBucketConfig, out *gcp.BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketConfig_To_gcp_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.TurboReplication = in.TurboReplication
+	out.StorageClass = (*string)(unsafe.Pointer(in.StorageClass))
+	out.KmsKeyName = (*string)(unsafe.Pointer(in.KmsKeyName))
+	out.RetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	out.Locked = in.Locked
+	out.EventBasedHold = in.EventBasedHold
+	out.Versioning = in.Versioning
+	out.Lifecycle = (*BackupBucketLifecycle)(unsafe.Pointer(in.Lifecycle))
+	return nil
+}
+
+// Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig is an autogenerated conversion function.
+func Convert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *gcp.BackupBucketConfig, out *BackupBucketConfig, s conversion.Scope) error {
+	return autoConvert_gcp_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BackupBucketLifecycle_To_gcp_BackupBucketLifecycle(in *BackupBucketLifecycle, out *gcp.BackupBucketLifecycle, s conversion.Scope) error {
+	out.DeleteAfterDays = (*int32)(unsafe.Pointer(in.DeleteAfterDays))
+	out.DeleteNoncurrentAfterDays = (*int32)(unsafe.Pointer(in.DeleteNoncurrentAfterDays))
+	out.Transitions = *(*[]gcp.LifecycleTransition)(unsafe.Pointer(&in.Transitions))
+	return nil
+}
+
+// Convert_v1alpha1_BackupBucketLifecycle_To_gcp_BackupBucketLifecycle is an autogenerated conversion function.
+func Convert_v1alpha1_BackupBucketLifecycle_To_gcp_BackupBucketLifecycle(in *BackupBucketLifecycle, out *gcp.BackupBucketLifecycle, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupBucketLifecycle_To_gcp_BackupBucketLifecycle(in, out, s)
+}
+
+func autoConvert_gcp_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle(in *gcp.BackupBucketLifecycle, out *BackupBucketLifecycle, s conversion.Scope) error {
+	out.DeleteAfterDays = (*int32)(unsafe.Pointer(in.DeleteAfterDays))
+	out.DeleteNoncurrentAfterDays = (*int32)(unsafe.Pointer(in.DeleteNoncurrentAfterDays))
+	out.Transitions = *(*[]LifecycleTransition)(unsafe.Pointer(&in.Transitions))
+	return nil
+}
+
+// Convert_gcp_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle is an autogenerated conversion function.
+func Convert_gcp_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle(in *gcp.BackupBucketLifecycle, out *BackupBucketLifecycle, s conversion.Scope) error {
+	return autoConvert_gcp_BackupBucketLifecycle_To_v1alpha1_BackupBucketLifecycle(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSIDriverConfig_To_gcp_CSIDriverConfig(in *CSIDriverConfig, out *gcp.CSIDriverConfig, s conversion.Scope) error {
+	out.Channel = (*gcp.CSIDriverChannel)(unsafe.Pointer(in.Channel))
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	return nil
+}
+
+// Convert_v1alpha1_CSIDriverConfig_To_gcp_CSIDriverConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CSIDriverConfig_To_gcp_CSIDriverConfig(in *CSIDriverConfig, out *gcp.CSIDriverConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIDriverConfig_To_gcp_CSIDriverConfig(in, out, s)
+}
+
+func autoConvert_gcp_CSIDriverConfig_To_v1alpha1_CSIDriverConfig(in *gcp.CSIDriverConfig, out *CSIDriverConfig, s conversion.Scope) error {
+	out.Channel = (*CSIDriverChannel)(unsafe.Pointer(in.Channel))
+	out.Image = (*string)(unsafe.Pointer(in.Image))
+	return nil
+}
+
+// Convert_gcp_CSIDriverConfig_To_v1alpha1_CSIDriverConfig is an autogenerated conversion function.
+func Convert_gcp_CSIDriverConfig_To_v1alpha1_CSIDriverConfig(in *gcp.CSIDriverConfig, out *CSIDriverConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CSIDriverConfig_To_v1alpha1_CSIDriverConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSIDriverResources_To_gcp_CSIDriverResources(in *CSIDriverResources, out *gcp.CSIDriverResources, s conversion.Scope) error {
+	out.Controller = *(*map[string]corev1.ResourceRequirements)(unsafe.Pointer(&in.Controller))
+	out.Node = *(*map[string]corev1.ResourceRequirements)(unsafe.Pointer(&in.Node))
+	return nil
+}
+
+// Convert_v1alpha1_CSIDriverResources_To_gcp_CSIDriverResources is an autogenerated conversion function.
+func Convert_v1alpha1_CSIDriverResources_To_gcp_CSIDriverResources(in *CSIDriverResources, out *gcp.CSIDriverResources, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIDriverResources_To_gcp_CSIDriverResources(in, out, s)
+}
+
+func autoConvert_gcp_CSIDriverResources_To_v1alpha1_CSIDriverResources(in *gcp.CSIDriverResources, out *CSIDriverResources, s conversion.Scope) error {
+	out.Controller = *(*map[string]corev1.ResourceRequirements)(unsafe.Pointer(&in.Controller))
+	out.Node = *(*map[string]corev1.ResourceRequirements)(unsafe.Pointer(&in.Node))
+	return nil
+}
+
+// Convert_gcp_CSIDriverResources_To_v1alpha1_CSIDriverResources is an autogenerated conversion function.
+func Convert_gcp_CSIDriverResources_To_v1alpha1_CSIDriverResources(in *gcp.CSIDriverResources, out *CSIDriverResources, s conversion.Scope) error {
+	return autoConvert_gcp_CSIDriverResources_To_v1alpha1_CSIDriverResources(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryRollout_To_gcp_CanaryRollout(in *CanaryRollout, out *gcp.CanaryRollout, s conversion.Scope) error {
+	out.Machines = in.Machines
+	out.SoakDuration = (*v1.Duration)(unsafe.Pointer(in.SoakDuration))
+	return nil
+}
+
+// Convert_v1alpha1_CanaryRollout_To_gcp_CanaryRollout is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryRollout_To_gcp_CanaryRollout(in *CanaryRollout, out *gcp.CanaryRollout, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryRollout_To_gcp_CanaryRollout(in, out, s)
+}
+
+func autoConvert_gcp_CanaryRollout_To_v1alpha1_CanaryRollout(in *gcp.CanaryRollout, out *CanaryRollout, s conversion.Scope) error {
+	out.Machines = in.Machines
+	out.SoakDuration = (*v1.Duration)(unsafe.Pointer(in.SoakDuration))
+	return nil
+}
+
+// Convert_gcp_CanaryRollout_To_v1alpha1_CanaryRollout is an autogenerated conversion function.
+func Convert_gcp_CanaryRollout_To_v1alpha1_CanaryRollout(in *gcp.CanaryRollout, out *CanaryRollout, s conversion.Scope) error {
+	return autoConvert_gcp_CanaryRollout_To_v1alpha1_CanaryRollout(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryRolloutStatus_To_gcp_CanaryRolloutStatus(in *CanaryRolloutStatus, out *gcp.CanaryRolloutStatus, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Hash = in.Hash
+	out.StartTime = in.StartTime
+	return nil
+}
+
+// Convert_v1alpha1_CanaryRolloutStatus_To_gcp_CanaryRolloutStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryRolloutStatus_To_gcp_CanaryRolloutStatus(in *CanaryRolloutStatus, out *gcp.CanaryRolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryRolloutStatus_To_gcp_CanaryRolloutStatus(in, out, s)
+}
+
+func autoConvert_gcp_CanaryRolloutStatus_To_v1alpha1_CanaryRolloutStatus(in *gcp.CanaryRolloutStatus, out *CanaryRolloutStatus, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Hash = in.Hash
+	out.StartTime = in.StartTime
+	return nil
+}
+
+// Convert_gcp_CanaryRolloutStatus_To_v1alpha1_CanaryRolloutStatus is an autogenerated conversion function.
+func Convert_gcp_CanaryRolloutStatus_To_v1alpha1_CanaryRolloutStatus(in *gcp.CanaryRolloutStatus, out *CanaryRolloutStatus, s conversion.Scope) error {
+	return autoConvert_gcp_CanaryRolloutStatus_To_v1alpha1_CanaryRolloutStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudConfig_To_gcp_CloudConfig(in *CloudConfig, out *gcp.CloudConfig, s conversion.Scope) error {
+	out.NodeTags = *(*[]string)(unsafe.Pointer(&in.NodeTags))
+	out.NodeInstancePrefix = (*string)(unsafe.Pointer(in.NodeInstancePrefix))
+	out.SecondaryRangeName = (*string)(unsafe.Pointer(in.SecondaryRangeName))
+	return nil
+}
+
+// Convert_v1alpha1_CloudConfig_To_gcp_CloudConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudConfig_To_gcp_CloudConfig(in *CloudConfig, out *gcp.CloudConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudConfig_To_gcp_CloudConfig(in, out, s)
+}
+
+func autoConvert_gcp_CloudConfig_To_v1alpha1_CloudConfig(in *gcp.CloudConfig, out *CloudConfig, s conversion.Scope) error {
+	out.NodeTags = *(*[]string)(unsafe.Pointer(&in.NodeTags))
+	out.NodeInstancePrefix = (*string)(unsafe.Pointer(in.NodeInstancePrefix))
+	out.SecondaryRangeName = (*string)(unsafe.Pointer(in.SecondaryRangeName))
+	return nil
+}
+
+// Convert_gcp_CloudConfig_To_v1alpha1_CloudConfig is an autogenerated conversion function.
+func Convert_gcp_CloudConfig_To_v1alpha1_CloudConfig(in *gcp.CloudConfig, out *CloudConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CloudConfig_To_v1alpha1_CloudConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *gcp.CloudControllerManagerConfig, s conversion.Scope) error {
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Flags = *(*map[string]string)(unsafe.Pointer(&in.Flags))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.CloudConfig = (*gcp.CloudConfig)(unsafe.Pointer(in.CloudConfig))
+	return nil
+}
+
 // Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig is an autogenerated conversion function.
 func Convert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *gcp.CloudControllerManagerConfig, s conversion.Scope) error {
 	return autoConvert_v1alpha1_CloudControllerManagerConfig_To_gcp_CloudControllerManagerConfig(in, out, s)
 }
 
-func autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *gcp.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
-	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+func autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *gcp.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
+	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.Flags = *(*map[string]string)(unsafe.Pointer(&in.Flags))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.CloudConfig = (*CloudConfig)(unsafe.Pointer(in.CloudConfig))
+	return nil
+}
+
+// Convert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig is an autogenerated conversion function.
+func Convert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *gcp.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in *CloudNAT, out *gcp.CloudNAT, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.AdoptExisting = in.AdoptExisting
+	out.EndpointIndependentMapping = (*gcp.EndpointIndependentMapping)(unsafe.Pointer(in.EndpointIndependentMapping))
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.MaxPortsPerVM = (*int32)(unsafe.Pointer(in.MaxPortsPerVM))
+	out.EnableDynamicPortAllocation = in.EnableDynamicPortAllocation
+	out.NatIPNames = *(*[]gcp.NatIPName)(unsafe.Pointer(&in.NatIPNames))
+	out.NatIPCount = (*int32)(unsafe.Pointer(in.NatIPCount))
+	out.IcmpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.IcmpIdleTimeoutSec))
+	out.TcpEstablishedIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TcpEstablishedIdleTimeoutSec))
+	out.TcpTimeWaitTimeoutSec = (*int32)(unsafe.Pointer(in.TcpTimeWaitTimeoutSec))
+	out.TcpTransitoryIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TcpTransitoryIdleTimeoutSec))
+	out.UdpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.UdpIdleTimeoutSec))
+	out.NatLogging = (*gcp.NatLogging)(unsafe.Pointer(in.NatLogging))
+	return nil
+}
+
+// Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT is an autogenerated conversion function.
+func Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in *CloudNAT, out *gcp.CloudNAT, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in, out, s)
+}
+
+func autoConvert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.AdoptExisting = in.AdoptExisting
+	out.EndpointIndependentMapping = (*EndpointIndependentMapping)(unsafe.Pointer(in.EndpointIndependentMapping))
+	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
+	out.MaxPortsPerVM = (*int32)(unsafe.Pointer(in.MaxPortsPerVM))
+	out.EnableDynamicPortAllocation = in.EnableDynamicPortAllocation
+	out.NatIPNames = *(*[]NatIPName)(unsafe.Pointer(&in.NatIPNames))
+	out.NatIPCount = (*int32)(unsafe.Pointer(in.NatIPCount))
+	out.IcmpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.IcmpIdleTimeoutSec))
+	out.TcpEstablishedIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TcpEstablishedIdleTimeoutSec))
+	out.TcpTimeWaitTimeoutSec = (*int32)(unsafe.Pointer(in.TcpTimeWaitTimeoutSec))
+	out.TcpTransitoryIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TcpTransitoryIdleTimeoutSec))
+	out.UdpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.UdpIdleTimeoutSec))
+	out.NatLogging = (*NatLogging)(unsafe.Pointer(in.NatLogging))
+	return nil
+}
+
+// Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT is an autogenerated conversion function.
+func Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT, s conversion.Scope) error {
+	return autoConvert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus(in *CloudNATStatus, out *gcp.CloudNATStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus(in *CloudNATStatus, out *gcp.CloudNATStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudNATStatus_To_gcp_CloudNATStatus(in, out, s)
+}
+
+func autoConvert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus(in *gcp.CloudNATStatus, out *CloudNATStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus is an autogenerated conversion function.
+func Convert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus(in *gcp.CloudNATStatus, out *CloudNATStatus, s conversion.Scope) error {
+	return autoConvert_gcp_CloudNATStatus_To_v1alpha1_CloudNATStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in *CloudProfileConfig, out *gcp.CloudProfileConfig, s conversion.Scope) error {
+	out.MachineImages = *(*[]gcp.MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.OpsAgent = (*gcp.OpsAgentConfig)(unsafe.Pointer(in.OpsAgent))
+	out.CSIDriver = (*gcp.CSIDriverConfig)(unsafe.Pointer(in.CSIDriver))
+	return nil
+}
+
+// Convert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in *CloudProfileConfig, out *gcp.CloudProfileConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in, out, s)
+}
+
+func autoConvert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *gcp.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
+	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.OpsAgent = (*OpsAgentConfig)(unsafe.Pointer(in.OpsAgent))
+	out.CSIDriver = (*CSIDriverConfig)(unsafe.Pointer(in.CSIDriver))
+	return nil
+}
+
+// Convert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig is an autogenerated conversion function.
+func Convert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *gcp.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
+	return autoConvert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in *CloudRouter, out *gcp.CloudRouter, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter is an autogenerated conversion function.
+func Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in *CloudRouter, out *gcp.CloudRouter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in, out, s)
+}
+
+func autoConvert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in *gcp.CloudRouter, out *CloudRouter, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter is an autogenerated conversion function.
+func Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in *gcp.CloudRouter, out *CloudRouter, s conversion.Scope) error {
+	return autoConvert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudRouterBGP_To_gcp_CloudRouterBGP(in *CloudRouterBGP, out *gcp.CloudRouterBGP, s conversion.Scope) error {
+	out.ASN = in.ASN
+	out.AdvertiseMode = (*string)(unsafe.Pointer(in.AdvertiseMode))
+	out.AdvertisedGroups = *(*[]string)(unsafe.Pointer(&in.AdvertisedGroups))
+	out.AdvertisedIPRanges = *(*[]gcp.AdvertisedIPRange)(unsafe.Pointer(&in.AdvertisedIPRanges))
+	return nil
+}
+
+// Convert_v1alpha1_CloudRouterBGP_To_gcp_CloudRouterBGP is an autogenerated conversion function.
+func Convert_v1alpha1_CloudRouterBGP_To_gcp_CloudRouterBGP(in *CloudRouterBGP, out *gcp.CloudRouterBGP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudRouterBGP_To_gcp_CloudRouterBGP(in, out, s)
+}
+
+func autoConvert_gcp_CloudRouterBGP_To_v1alpha1_CloudRouterBGP(in *gcp.CloudRouterBGP, out *CloudRouterBGP, s conversion.Scope) error {
+	out.ASN = in.ASN
+	out.AdvertiseMode = (*string)(unsafe.Pointer(in.AdvertiseMode))
+	out.AdvertisedGroups = *(*[]string)(unsafe.Pointer(&in.AdvertisedGroups))
+	out.AdvertisedIPRanges = *(*[]AdvertisedIPRange)(unsafe.Pointer(&in.AdvertisedIPRanges))
+	return nil
+}
+
+// Convert_gcp_CloudRouterBGP_To_v1alpha1_CloudRouterBGP is an autogenerated conversion function.
+func Convert_gcp_CloudRouterBGP_To_v1alpha1_CloudRouterBGP(in *gcp.CloudRouterBGP, out *CloudRouterBGP, s conversion.Scope) error {
+	return autoConvert_gcp_CloudRouterBGP_To_v1alpha1_CloudRouterBGP(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPlaneConfig, out *gcp.ControlPlaneConfig, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.CloudControllerManager = (*gcp.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*gcp.Storage)(unsafe.Pointer(in.Storage))
+	out.InternalLoadBalancer = (*gcp.InternalLoadBalancerConfig)(unsafe.Pointer(in.InternalLoadBalancer))
+	out.AuditLogExport = (*gcp.AuditLogExport)(unsafe.Pointer(in.AuditLogExport))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPlaneConfig, out *gcp.ControlPlaneConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in, out, s)
+}
+
+func autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.InternalLoadBalancer = (*InternalLoadBalancerConfig)(unsafe.Pointer(in.InternalLoadBalancer))
+	out.AuditLogExport = (*AuditLogExport)(unsafe.Pointer(in.AuditLogExport))
+	return nil
+}
+
+// Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig is an autogenerated conversion function.
+func Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
+	return autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus(in *ControlPlaneStatus, out *gcp.ControlPlaneStatus, s conversion.Scope) error {
+	out.InternalLoadBalancer = (*gcp.InternalLoadBalancerStatus)(unsafe.Pointer(in.InternalLoadBalancer))
+	out.AuditLogExport = (*gcp.AuditLogExportStatus)(unsafe.Pointer(in.AuditLogExport))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus(in *ControlPlaneStatus, out *gcp.ControlPlaneStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneStatus_To_gcp_ControlPlaneStatus(in, out, s)
+}
+
+func autoConvert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in *gcp.ControlPlaneStatus, out *ControlPlaneStatus, s conversion.Scope) error {
+	out.InternalLoadBalancer = (*InternalLoadBalancerStatus)(unsafe.Pointer(in.InternalLoadBalancer))
+	out.AuditLogExport = (*AuditLogExportStatus)(unsafe.Pointer(in.AuditLogExport))
+	return nil
+}
+
+// Convert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus is an autogenerated conversion function.
+func Convert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in *gcp.ControlPlaneStatus, out *ControlPlaneStatus, s conversion.Scope) error {
+	return autoConvert_gcp_ControlPlaneStatus_To_v1alpha1_ControlPlaneStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSFailoverPolicy_To_gcp_DNSFailoverPolicy(in *DNSFailoverPolicy, out *gcp.DNSFailoverPolicy, s conversion.Scope) error {
+	out.Primary = *(*[]gcp.DNSLoadBalancerTarget)(unsafe.Pointer(&in.Primary))
+	out.Backup = *(*[]gcp.DNSGeoTarget)(unsafe.Pointer(&in.Backup))
+	out.TrickleTrafficPercent = (*int32)(unsafe.Pointer(in.TrickleTrafficPercent))
+	return nil
+}
+
+// Convert_v1alpha1_DNSFailoverPolicy_To_gcp_DNSFailoverPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_DNSFailoverPolicy_To_gcp_DNSFailoverPolicy(in *DNSFailoverPolicy, out *gcp.DNSFailoverPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSFailoverPolicy_To_gcp_DNSFailoverPolicy(in, out, s)
+}
+
+func autoConvert_gcp_DNSFailoverPolicy_To_v1alpha1_DNSFailoverPolicy(in *gcp.DNSFailoverPolicy, out *DNSFailoverPolicy, s conversion.Scope) error {
+	out.Primary = *(*[]DNSLoadBalancerTarget)(unsafe.Pointer(&in.Primary))
+	out.Backup = *(*[]DNSGeoTarget)(unsafe.Pointer(&in.Backup))
+	out.TrickleTrafficPercent = (*int32)(unsafe.Pointer(in.TrickleTrafficPercent))
+	return nil
+}
+
+// Convert_gcp_DNSFailoverPolicy_To_v1alpha1_DNSFailoverPolicy is an autogenerated conversion function.
+func Convert_gcp_DNSFailoverPolicy_To_v1alpha1_DNSFailoverPolicy(in *gcp.DNSFailoverPolicy, out *DNSFailoverPolicy, s conversion.Scope) error {
+	return autoConvert_gcp_DNSFailoverPolicy_To_v1alpha1_DNSFailoverPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget(in *DNSGeoTarget, out *gcp.DNSGeoTarget, s conversion.Scope) error {
+	out.Location = in.Location
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget is an autogenerated conversion function.
+func Convert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget(in *DNSGeoTarget, out *gcp.DNSGeoTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSGeoTarget_To_gcp_DNSGeoTarget(in, out, s)
+}
+
+func autoConvert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget(in *gcp.DNSGeoTarget, out *DNSGeoTarget, s conversion.Scope) error {
+	out.Location = in.Location
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget is an autogenerated conversion function.
+func Convert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget(in *gcp.DNSGeoTarget, out *DNSGeoTarget, s conversion.Scope) error {
+	return autoConvert_gcp_DNSGeoTarget_To_v1alpha1_DNSGeoTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSLoadBalancerTarget_To_gcp_DNSLoadBalancerTarget(in *DNSLoadBalancerTarget, out *gcp.DNSLoadBalancerTarget, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.IPAddress = in.IPAddress
+	out.Port = in.Port
+	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
+	out.Network = in.Network
+	out.Project = in.Project
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1alpha1_DNSLoadBalancerTarget_To_gcp_DNSLoadBalancerTarget is an autogenerated conversion function.
+func Convert_v1alpha1_DNSLoadBalancerTarget_To_gcp_DNSLoadBalancerTarget(in *DNSLoadBalancerTarget, out *gcp.DNSLoadBalancerTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSLoadBalancerTarget_To_gcp_DNSLoadBalancerTarget(in, out, s)
+}
+
+func autoConvert_gcp_DNSLoadBalancerTarget_To_v1alpha1_DNSLoadBalancerTarget(in *gcp.DNSLoadBalancerTarget, out *DNSLoadBalancerTarget, s conversion.Scope) error {
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.IPAddress = in.IPAddress
+	out.Port = in.Port
+	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
+	out.Network = in.Network
+	out.Project = in.Project
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_gcp_DNSLoadBalancerTarget_To_v1alpha1_DNSLoadBalancerTarget is an autogenerated conversion function.
+func Convert_gcp_DNSLoadBalancerTarget_To_v1alpha1_DNSLoadBalancerTarget(in *gcp.DNSLoadBalancerTarget, out *DNSLoadBalancerTarget, s conversion.Scope) error {
+	return autoConvert_gcp_DNSLoadBalancerTarget_To_v1alpha1_DNSLoadBalancerTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in *DNSRecordConfig, out *gcp.DNSRecordConfig, s conversion.Scope) error {
+	out.RoutingPolicy = (*gcp.DNSRoutingPolicy)(unsafe.Pointer(in.RoutingPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in *DNSRecordConfig, out *gcp.DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRecordConfig_To_gcp_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *gcp.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	out.RoutingPolicy = (*DNSRoutingPolicy)(unsafe.Pointer(in.RoutingPolicy))
+	return nil
+}
+
+// Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig is an autogenerated conversion function.
+func Convert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in *gcp.DNSRecordConfig, out *DNSRecordConfig, s conversion.Scope) error {
+	return autoConvert_gcp_DNSRecordConfig_To_v1alpha1_DNSRecordConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in *DNSRoutingPolicy, out *gcp.DNSRoutingPolicy, s conversion.Scope) error {
+	out.Weighted = *(*[]gcp.DNSWeightedTarget)(unsafe.Pointer(&in.Weighted))
+	out.Geo = *(*[]gcp.DNSGeoTarget)(unsafe.Pointer(&in.Geo))
+	out.Failover = (*gcp.DNSFailoverPolicy)(unsafe.Pointer(in.Failover))
+	return nil
+}
+
+// Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in *DNSRoutingPolicy, out *gcp.DNSRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSRoutingPolicy_To_gcp_DNSRoutingPolicy(in, out, s)
+}
+
+func autoConvert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in *gcp.DNSRoutingPolicy, out *DNSRoutingPolicy, s conversion.Scope) error {
+	out.Weighted = *(*[]DNSWeightedTarget)(unsafe.Pointer(&in.Weighted))
+	out.Geo = *(*[]DNSGeoTarget)(unsafe.Pointer(&in.Geo))
+	out.Failover = (*DNSFailoverPolicy)(unsafe.Pointer(in.Failover))
+	return nil
+}
+
+// Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy is an autogenerated conversion function.
+func Convert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in *gcp.DNSRoutingPolicy, out *DNSRoutingPolicy, s conversion.Scope) error {
+	return autoConvert_gcp_DNSRoutingPolicy_To_v1alpha1_DNSRoutingPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget(in *DNSWeightedTarget, out *gcp.DNSWeightedTarget, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget is an autogenerated conversion function.
+func Convert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget(in *DNSWeightedTarget, out *gcp.DNSWeightedTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSWeightedTarget_To_gcp_DNSWeightedTarget(in, out, s)
+}
+
+func autoConvert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget(in *gcp.DNSWeightedTarget, out *DNSWeightedTarget, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget is an autogenerated conversion function.
+func Convert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget(in *gcp.DNSWeightedTarget, out *DNSWeightedTarget, s conversion.Scope) error {
+	return autoConvert_gcp_DNSWeightedTarget_To_v1alpha1_DNSWeightedTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskClone_To_gcp_DiskClone(in *DiskClone, out *gcp.DiskClone, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_DiskCloneSpec_To_gcp_DiskCloneSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_DiskCloneStatus_To_gcp_DiskCloneStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DiskClone_To_gcp_DiskClone is an autogenerated conversion function.
+func Convert_v1alpha1_DiskClone_To_gcp_DiskClone(in *DiskClone, out *gcp.DiskClone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskClone_To_gcp_DiskClone(in, out, s)
+}
+
+func autoConvert_gcp_DiskClone_To_v1alpha1_DiskClone(in *gcp.DiskClone, out *DiskClone, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_gcp_DiskCloneSpec_To_v1alpha1_DiskCloneSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_gcp_DiskCloneStatus_To_v1alpha1_DiskCloneStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_gcp_DiskClone_To_v1alpha1_DiskClone is an autogenerated conversion function.
+func Convert_gcp_DiskClone_To_v1alpha1_DiskClone(in *gcp.DiskClone, out *DiskClone, s conversion.Scope) error {
+	return autoConvert_gcp_DiskClone_To_v1alpha1_DiskClone(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskCloneClaim_To_gcp_DiskCloneClaim(in *DiskCloneClaim, out *gcp.DiskCloneClaim, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.StorageClassName = (*string)(unsafe.Pointer(in.StorageClassName))
+	return nil
+}
+
+// Convert_v1alpha1_DiskCloneClaim_To_gcp_DiskCloneClaim is an autogenerated conversion function.
+func Convert_v1alpha1_DiskCloneClaim_To_gcp_DiskCloneClaim(in *DiskCloneClaim, out *gcp.DiskCloneClaim, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskCloneClaim_To_gcp_DiskCloneClaim(in, out, s)
+}
+
+func autoConvert_gcp_DiskCloneClaim_To_v1alpha1_DiskCloneClaim(in *gcp.DiskCloneClaim, out *DiskCloneClaim, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.StorageClassName = (*string)(unsafe.Pointer(in.StorageClassName))
+	return nil
+}
+
+// Convert_gcp_DiskCloneClaim_To_v1alpha1_DiskCloneClaim is an autogenerated conversion function.
+func Convert_gcp_DiskCloneClaim_To_v1alpha1_DiskCloneClaim(in *gcp.DiskCloneClaim, out *DiskCloneClaim, s conversion.Scope) error {
+	return autoConvert_gcp_DiskCloneClaim_To_v1alpha1_DiskCloneClaim(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskCloneClaimStatus_To_gcp_DiskCloneClaimStatus(in *DiskCloneClaimStatus, out *gcp.DiskCloneClaimStatus, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Snapshot = in.Snapshot
+	out.Phase = gcp.DiskClonePhase(in.Phase)
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1alpha1_DiskCloneClaimStatus_To_gcp_DiskCloneClaimStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DiskCloneClaimStatus_To_gcp_DiskCloneClaimStatus(in *DiskCloneClaimStatus, out *gcp.DiskCloneClaimStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskCloneClaimStatus_To_gcp_DiskCloneClaimStatus(in, out, s)
+}
+
+func autoConvert_gcp_DiskCloneClaimStatus_To_v1alpha1_DiskCloneClaimStatus(in *gcp.DiskCloneClaimStatus, out *DiskCloneClaimStatus, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Snapshot = in.Snapshot
+	out.Phase = DiskClonePhase(in.Phase)
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_gcp_DiskCloneClaimStatus_To_v1alpha1_DiskCloneClaimStatus is an autogenerated conversion function.
+func Convert_gcp_DiskCloneClaimStatus_To_v1alpha1_DiskCloneClaimStatus(in *gcp.DiskCloneClaimStatus, out *DiskCloneClaimStatus, s conversion.Scope) error {
+	return autoConvert_gcp_DiskCloneClaimStatus_To_v1alpha1_DiskCloneClaimStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskCloneList_To_gcp_DiskCloneList(in *DiskCloneList, out *gcp.DiskCloneList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]gcp.DiskClone)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_DiskCloneList_To_gcp_DiskCloneList is an autogenerated conversion function.
+func Convert_v1alpha1_DiskCloneList_To_gcp_DiskCloneList(in *DiskCloneList, out *gcp.DiskCloneList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskCloneList_To_gcp_DiskCloneList(in, out, s)
+}
+
+func autoConvert_gcp_DiskCloneList_To_v1alpha1_DiskCloneList(in *gcp.DiskCloneList, out *DiskCloneList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]DiskClone)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_gcp_DiskCloneList_To_v1alpha1_DiskCloneList is an autogenerated conversion function.
+func Convert_gcp_DiskCloneList_To_v1alpha1_DiskCloneList(in *gcp.DiskCloneList, out *DiskCloneList, s conversion.Scope) error {
+	return autoConvert_gcp_DiskCloneList_To_v1alpha1_DiskCloneList(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskCloneSpec_To_gcp_DiskCloneSpec(in *DiskCloneSpec, out *gcp.DiskCloneSpec, s conversion.Scope) error {
+	out.SourceNamespace = in.SourceNamespace
+	out.Claims = *(*[]gcp.DiskCloneClaim)(unsafe.Pointer(&in.Claims))
+	return nil
+}
+
+// Convert_v1alpha1_DiskCloneSpec_To_gcp_DiskCloneSpec is an autogenerated conversion function.
+func Convert_v1alpha1_DiskCloneSpec_To_gcp_DiskCloneSpec(in *DiskCloneSpec, out *gcp.DiskCloneSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskCloneSpec_To_gcp_DiskCloneSpec(in, out, s)
+}
+
+func autoConvert_gcp_DiskCloneSpec_To_v1alpha1_DiskCloneSpec(in *gcp.DiskCloneSpec, out *DiskCloneSpec, s conversion.Scope) error {
+	out.SourceNamespace = in.SourceNamespace
+	out.Claims = *(*[]DiskCloneClaim)(unsafe.Pointer(&in.Claims))
+	return nil
+}
+
+// Convert_gcp_DiskCloneSpec_To_v1alpha1_DiskCloneSpec is an autogenerated conversion function.
+func Convert_gcp_DiskCloneSpec_To_v1alpha1_DiskCloneSpec(in *gcp.DiskCloneSpec, out *DiskCloneSpec, s conversion.Scope) error {
+	return autoConvert_gcp_DiskCloneSpec_To_v1alpha1_DiskCloneSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskCloneStatus_To_gcp_DiskCloneStatus(in *DiskCloneStatus, out *gcp.DiskCloneStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Claims = *(*[]gcp.DiskCloneClaimStatus)(unsafe.Pointer(&in.Claims))
+	return nil
+}
+
+// Convert_v1alpha1_DiskCloneStatus_To_gcp_DiskCloneStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DiskCloneStatus_To_gcp_DiskCloneStatus(in *DiskCloneStatus, out *gcp.DiskCloneStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskCloneStatus_To_gcp_DiskCloneStatus(in, out, s)
+}
+
+func autoConvert_gcp_DiskCloneStatus_To_v1alpha1_DiskCloneStatus(in *gcp.DiskCloneStatus, out *DiskCloneStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Claims = *(*[]DiskCloneClaimStatus)(unsafe.Pointer(&in.Claims))
+	return nil
+}
+
+// Convert_gcp_DiskCloneStatus_To_v1alpha1_DiskCloneStatus is an autogenerated conversion function.
+func Convert_gcp_DiskCloneStatus_To_v1alpha1_DiskCloneStatus(in *gcp.DiskCloneStatus, out *DiskCloneStatus, s conversion.Scope) error {
+	return autoConvert_gcp_DiskCloneStatus_To_v1alpha1_DiskCloneStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption(in *DiskEncryption, out *gcp.DiskEncryption, s conversion.Scope) error {
+	out.KmsKeyName = (*string)(unsafe.Pointer(in.KmsKeyName))
+	out.KmsKeyServiceAccount = (*string)(unsafe.Pointer(in.KmsKeyServiceAccount))
+	return nil
+}
+
+// Convert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption(in *DiskEncryption, out *gcp.DiskEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption(in, out, s)
+}
+
+func autoConvert_gcp_DiskEncryption_To_v1alpha1_DiskEncryption(in *gcp.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
+	out.KmsKeyName = (*string)(unsafe.Pointer(in.KmsKeyName))
+	out.KmsKeyServiceAccount = (*string)(unsafe.Pointer(in.KmsKeyServiceAccount))
+	return nil
+}
+
+// Convert_gcp_DiskEncryption_To_v1alpha1_DiskEncryption is an autogenerated conversion function.
+func Convert_gcp_DiskEncryption_To_v1alpha1_DiskEncryption(in *gcp.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
+	return autoConvert_gcp_DiskEncryption_To_v1alpha1_DiskEncryption(in, out, s)
+}
+
+func autoConvert_v1alpha1_EndpointIndependentMapping_To_gcp_EndpointIndependentMapping(in *EndpointIndependentMapping, out *gcp.EndpointIndependentMapping, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_EndpointIndependentMapping_To_gcp_EndpointIndependentMapping is an autogenerated conversion function.
+func Convert_v1alpha1_EndpointIndependentMapping_To_gcp_EndpointIndependentMapping(in *EndpointIndependentMapping, out *gcp.EndpointIndependentMapping, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EndpointIndependentMapping_To_gcp_EndpointIndependentMapping(in, out, s)
+}
+
+func autoConvert_gcp_EndpointIndependentMapping_To_v1alpha1_EndpointIndependentMapping(in *gcp.EndpointIndependentMapping, out *EndpointIndependentMapping, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_gcp_EndpointIndependentMapping_To_v1alpha1_EndpointIndependentMapping is an autogenerated conversion function.
+func Convert_gcp_EndpointIndependentMapping_To_v1alpha1_EndpointIndependentMapping(in *gcp.EndpointIndependentMapping, out *EndpointIndependentMapping, s conversion.Scope) error {
+	return autoConvert_gcp_EndpointIndependentMapping_To_v1alpha1_EndpointIndependentMapping(in, out, s)
+}
+
+func autoConvert_v1alpha1_ErrorRecord_To_gcp_ErrorRecord(in *ErrorRecord, out *gcp.ErrorRecord, s conversion.Scope) error {
+	out.Operation = in.Operation
+	out.Codes = *(*[]string)(unsafe.Pointer(&in.Codes))
+	out.Message = in.Message
+	out.Count = in.Count
+	out.LastObservedTime = in.LastObservedTime
+	return nil
+}
+
+// Convert_v1alpha1_ErrorRecord_To_gcp_ErrorRecord is an autogenerated conversion function.
+func Convert_v1alpha1_ErrorRecord_To_gcp_ErrorRecord(in *ErrorRecord, out *gcp.ErrorRecord, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ErrorRecord_To_gcp_ErrorRecord(in, out, s)
+}
+
+func autoConvert_gcp_ErrorRecord_To_v1alpha1_ErrorRecord(in *gcp.ErrorRecord, out *ErrorRecord, s conversion.Scope) error {
+	out.Operation = in.Operation
+	out.Codes = *(*[]string)(unsafe.Pointer(&in.Codes))
+	out.Message = in.Message
+	out.Count = in.Count
+	out.LastObservedTime = in.LastObservedTime
+	return nil
+}
+
+// Convert_gcp_ErrorRecord_To_v1alpha1_ErrorRecord is an autogenerated conversion function.
+func Convert_gcp_ErrorRecord_To_v1alpha1_ErrorRecord(in *gcp.ErrorRecord, out *ErrorRecord, s conversion.Scope) error {
+	return autoConvert_gcp_ErrorRecord_To_v1alpha1_ErrorRecord(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExistingSubnets_To_gcp_ExistingSubnets(in *ExistingSubnets, out *gcp.ExistingSubnets, s conversion.Scope) error {
+	out.Workers = (*string)(unsafe.Pointer(in.Workers))
+	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	return nil
+}
+
+// Convert_v1alpha1_ExistingSubnets_To_gcp_ExistingSubnets is an autogenerated conversion function.
+func Convert_v1alpha1_ExistingSubnets_To_gcp_ExistingSubnets(in *ExistingSubnets, out *gcp.ExistingSubnets, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExistingSubnets_To_gcp_ExistingSubnets(in, out, s)
+}
+
+func autoConvert_gcp_ExistingSubnets_To_v1alpha1_ExistingSubnets(in *gcp.ExistingSubnets, out *ExistingSubnets, s conversion.Scope) error {
+	out.Workers = (*string)(unsafe.Pointer(in.Workers))
+	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	return nil
+}
+
+// Convert_gcp_ExistingSubnets_To_v1alpha1_ExistingSubnets is an autogenerated conversion function.
+func Convert_gcp_ExistingSubnets_To_v1alpha1_ExistingSubnets(in *gcp.ExistingSubnets, out *ExistingSubnets, s conversion.Scope) error {
+	return autoConvert_gcp_ExistingSubnets_To_v1alpha1_ExistingSubnets(in, out, s)
+}
+
+func autoConvert_v1alpha1_Filestore_To_gcp_Filestore(in *Filestore, out *gcp.Filestore, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Resources = (*gcp.CSIDriverResources)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_Filestore_To_gcp_Filestore is an autogenerated conversion function.
+func Convert_v1alpha1_Filestore_To_gcp_Filestore(in *Filestore, out *gcp.Filestore, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Filestore_To_gcp_Filestore(in, out, s)
+}
+
+func autoConvert_gcp_Filestore_To_v1alpha1_Filestore(in *gcp.Filestore, out *Filestore, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Resources = (*CSIDriverResources)(unsafe.Pointer(in.Resources))
+	return nil
+}
+
+// Convert_gcp_Filestore_To_v1alpha1_Filestore is an autogenerated conversion function.
+func Convert_gcp_Filestore_To_v1alpha1_Filestore(in *gcp.Filestore, out *Filestore, s conversion.Scope) error {
+	return autoConvert_gcp_Filestore_To_v1alpha1_Filestore(in, out, s)
+}
+
+func autoConvert_v1alpha1_FirewallPolicy_To_gcp_FirewallPolicy(in *FirewallPolicy, out *gcp.FirewallPolicy, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Rules = *(*[]gcp.FirewallPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_FirewallPolicy_To_gcp_FirewallPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_FirewallPolicy_To_gcp_FirewallPolicy(in *FirewallPolicy, out *gcp.FirewallPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FirewallPolicy_To_gcp_FirewallPolicy(in, out, s)
+}
+
+func autoConvert_gcp_FirewallPolicy_To_v1alpha1_FirewallPolicy(in *gcp.FirewallPolicy, out *FirewallPolicy, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Rules = *(*[]FirewallPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_gcp_FirewallPolicy_To_v1alpha1_FirewallPolicy is an autogenerated conversion function.
+func Convert_gcp_FirewallPolicy_To_v1alpha1_FirewallPolicy(in *gcp.FirewallPolicy, out *FirewallPolicy, s conversion.Scope) error {
+	return autoConvert_gcp_FirewallPolicy_To_v1alpha1_FirewallPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_FirewallPolicyRule_To_gcp_FirewallPolicyRule(in *FirewallPolicyRule, out *gcp.FirewallPolicyRule, s conversion.Scope) error {
+	out.Priority = in.Priority
+	out.Action = in.Action
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
+	out.Ranges = *(*[]string)(unsafe.Pointer(&in.Ranges))
+	out.Protocols = *(*[]gcp.FirewallRuleProtocol)(unsafe.Pointer(&in.Protocols))
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_v1alpha1_FirewallPolicyRule_To_gcp_FirewallPolicyRule is an autogenerated conversion function.
+func Convert_v1alpha1_FirewallPolicyRule_To_gcp_FirewallPolicyRule(in *FirewallPolicyRule, out *gcp.FirewallPolicyRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FirewallPolicyRule_To_gcp_FirewallPolicyRule(in, out, s)
+}
+
+func autoConvert_gcp_FirewallPolicyRule_To_v1alpha1_FirewallPolicyRule(in *gcp.FirewallPolicyRule, out *FirewallPolicyRule, s conversion.Scope) error {
+	out.Priority = in.Priority
+	out.Action = in.Action
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
+	out.Ranges = *(*[]string)(unsafe.Pointer(&in.Ranges))
+	out.Protocols = *(*[]FirewallRuleProtocol)(unsafe.Pointer(&in.Protocols))
+	out.Description = (*string)(unsafe.Pointer(in.Description))
+	return nil
+}
+
+// Convert_gcp_FirewallPolicyRule_To_v1alpha1_FirewallPolicyRule is an autogenerated conversion function.
+func Convert_gcp_FirewallPolicyRule_To_v1alpha1_FirewallPolicyRule(in *gcp.FirewallPolicyRule, out *FirewallPolicyRule, s conversion.Scope) error {
+	return autoConvert_gcp_FirewallPolicyRule_To_v1alpha1_FirewallPolicyRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_FirewallRule_To_gcp_FirewallRule(in *FirewallRule, out *gcp.FirewallRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	out.Ranges = *(*[]string)(unsafe.Pointer(&in.Ranges))
+	out.Allowed = *(*[]gcp.FirewallRuleProtocol)(unsafe.Pointer(&in.Allowed))
+	out.TargetTags = *(*[]string)(unsafe.Pointer(&in.TargetTags))
+	return nil
+}
+
+// Convert_v1alpha1_FirewallRule_To_gcp_FirewallRule is an autogenerated conversion function.
+func Convert_v1alpha1_FirewallRule_To_gcp_FirewallRule(in *FirewallRule, out *gcp.FirewallRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FirewallRule_To_gcp_FirewallRule(in, out, s)
+}
+
+func autoConvert_gcp_FirewallRule_To_v1alpha1_FirewallRule(in *gcp.FirewallRule, out *FirewallRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	out.Ranges = *(*[]string)(unsafe.Pointer(&in.Ranges))
+	out.Allowed = *(*[]FirewallRuleProtocol)(unsafe.Pointer(&in.Allowed))
+	out.TargetTags = *(*[]string)(unsafe.Pointer(&in.TargetTags))
+	return nil
+}
+
+// Convert_gcp_FirewallRule_To_v1alpha1_FirewallRule is an autogenerated conversion function.
+func Convert_gcp_FirewallRule_To_v1alpha1_FirewallRule(in *gcp.FirewallRule, out *FirewallRule, s conversion.Scope) error {
+	return autoConvert_gcp_FirewallRule_To_v1alpha1_FirewallRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_FirewallRuleProtocol_To_gcp_FirewallRuleProtocol(in *FirewallRuleProtocol, out *gcp.FirewallRuleProtocol, s conversion.Scope) error {
+	out.Protocol = in.Protocol
+	out.Ports = *(*[]string)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_v1alpha1_FirewallRuleProtocol_To_gcp_FirewallRuleProtocol is an autogenerated conversion function.
+func Convert_v1alpha1_FirewallRuleProtocol_To_gcp_FirewallRuleProtocol(in *FirewallRuleProtocol, out *gcp.FirewallRuleProtocol, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FirewallRuleProtocol_To_gcp_FirewallRuleProtocol(in, out, s)
+}
+
+func autoConvert_gcp_FirewallRuleProtocol_To_v1alpha1_FirewallRuleProtocol(in *gcp.FirewallRuleProtocol, out *FirewallRuleProtocol, s conversion.Scope) error {
+	out.Protocol = in.Protocol
+	out.Ports = *(*[]string)(unsafe.Pointer(&in.Ports))
+	return nil
+}
+
+// Convert_gcp_FirewallRuleProtocol_To_v1alpha1_FirewallRuleProtocol is an autogenerated conversion function.
+func Convert_gcp_FirewallRuleProtocol_To_v1alpha1_FirewallRuleProtocol(in *gcp.FirewallRuleProtocol, out *FirewallRuleProtocol, s conversion.Scope) error {
+	return autoConvert_gcp_FirewallRuleProtocol_To_v1alpha1_FirewallRuleProtocol(in, out, s)
+}
+
+func autoConvert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in *FlowLogs, out *gcp.FlowLogs, s conversion.Scope) error {
+	out.AggregationInterval = (*string)(unsafe.Pointer(in.AggregationInterval))
+	if in.FlowSampling != nil {
+		in, out := &in.FlowSampling, &out.FlowSampling
+		*out = new(float64)
+		**out = float64(**in)
+	} else {
+		out.FlowSampling = nil
+	}
+	out.Metadata = (*string)(unsafe.Pointer(in.Metadata))
+	return nil
+}
+
+// Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs is an autogenerated conversion function.
+func Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in *FlowLogs, out *gcp.FlowLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in, out, s)
+}
+
+func autoConvert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in *gcp.FlowLogs, out *FlowLogs, s conversion.Scope) error {
+	out.AggregationInterval = (*string)(unsafe.Pointer(in.AggregationInterval))
+	if in.FlowSampling != nil {
+		in, out := &in.FlowSampling, &out.FlowSampling
+		*out = new(float32)
+		**out = float32(**in)
+	} else {
+		out.FlowSampling = nil
+	}
+	out.Metadata = (*string)(unsafe.Pointer(in.Metadata))
+	return nil
+}
+
+// Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs is an autogenerated conversion function.
+func Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in *gcp.FlowLogs, out *FlowLogs, s conversion.Scope) error {
+	return autoConvert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in, out, s)
+}
+
+func autoConvert_v1alpha1_GPU_To_gcp_GPU(in *GPU, out *gcp.GPU, s conversion.Scope) error {
+	out.AcceleratorType = in.AcceleratorType
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha1_GPU_To_gcp_GPU is an autogenerated conversion function.
+func Convert_v1alpha1_GPU_To_gcp_GPU(in *GPU, out *gcp.GPU, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GPU_To_gcp_GPU(in, out, s)
+}
+
+func autoConvert_gcp_GPU_To_v1alpha1_GPU(in *gcp.GPU, out *GPU, s conversion.Scope) error {
+	out.AcceleratorType = in.AcceleratorType
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_gcp_GPU_To_v1alpha1_GPU is an autogenerated conversion function.
+func Convert_gcp_GPU_To_v1alpha1_GPU(in *gcp.GPU, out *GPU, s conversion.Scope) error {
+	return autoConvert_gcp_GPU_To_v1alpha1_GPU(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPv6Config_To_gcp_IPv6Config(in *IPv6Config, out *gcp.IPv6Config, s conversion.Scope) error {
+	out.WorkersAccessType = (*gcp.IPv6AccessType)(unsafe.Pointer(in.WorkersAccessType))
+	out.InternalAccessType = (*gcp.IPv6AccessType)(unsafe.Pointer(in.InternalAccessType))
+	return nil
+}
+
+// Convert_v1alpha1_IPv6Config_To_gcp_IPv6Config is an autogenerated conversion function.
+func Convert_v1alpha1_IPv6Config_To_gcp_IPv6Config(in *IPv6Config, out *gcp.IPv6Config, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPv6Config_To_gcp_IPv6Config(in, out, s)
+}
+
+func autoConvert_gcp_IPv6Config_To_v1alpha1_IPv6Config(in *gcp.IPv6Config, out *IPv6Config, s conversion.Scope) error {
+	out.WorkersAccessType = (*IPv6AccessType)(unsafe.Pointer(in.WorkersAccessType))
+	out.InternalAccessType = (*IPv6AccessType)(unsafe.Pointer(in.InternalAccessType))
+	return nil
+}
+
+// Convert_gcp_IPv6Config_To_v1alpha1_IPv6Config is an autogenerated conversion function.
+func Convert_gcp_IPv6Config_To_v1alpha1_IPv6Config(in *gcp.IPv6Config, out *IPv6Config, s conversion.Scope) error {
+	return autoConvert_gcp_IPv6Config_To_v1alpha1_IPv6Config(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImageStreaming_To_gcp_ImageStreaming(in *ImageStreaming, out *gcp.ImageStreaming, s conversion.Scope) error {
+	out.LazyPulling = in.LazyPulling
+	out.Mirrors = *(*[]gcp.RegistryMirror)(unsafe.Pointer(&in.Mirrors))
+	return nil
+}
+
+// Convert_v1alpha1_ImageStreaming_To_gcp_ImageStreaming is an autogenerated conversion function.
+func Convert_v1alpha1_ImageStreaming_To_gcp_ImageStreaming(in *ImageStreaming, out *gcp.ImageStreaming, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageStreaming_To_gcp_ImageStreaming(in, out, s)
+}
+
+func autoConvert_gcp_ImageStreaming_To_v1alpha1_ImageStreaming(in *gcp.ImageStreaming, out *ImageStreaming, s conversion.Scope) error {
+	out.LazyPulling = in.LazyPulling
+	out.Mirrors = *(*[]RegistryMirror)(unsafe.Pointer(&in.Mirrors))
+	return nil
+}
+
+// Convert_gcp_ImageStreaming_To_v1alpha1_ImageStreaming is an autogenerated conversion function.
+func Convert_gcp_ImageStreaming_To_v1alpha1_ImageStreaming(in *gcp.ImageStreaming, out *ImageStreaming, s conversion.Scope) error {
+	return autoConvert_gcp_ImageStreaming_To_v1alpha1_ImageStreaming(in, out, s)
+}
+
+func autoConvert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in *InfrastructureConfig, out *gcp.InfrastructureConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.NodeServiceAccount = (*gcp.NodeServiceAccount)(unsafe.Pointer(in.NodeServiceAccount))
+	out.ResourceLabels = *(*map[string]string)(unsafe.Pointer(&in.ResourceLabels))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in *InfrastructureConfig, out *gcp.InfrastructureConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in, out, s)
+}
+
+func autoConvert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *gcp.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
+	if err := Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.NodeServiceAccount = (*NodeServiceAccount)(unsafe.Pointer(in.NodeServiceAccount))
+	out.ResourceLabels = *(*map[string]string)(unsafe.Pointer(&in.ResourceLabels))
+	return nil
+}
+
+// Convert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig is an autogenerated conversion function.
+func Convert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *gcp.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
+	return autoConvert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_InfrastructurePlan_To_gcp_InfrastructurePlan(in *InfrastructurePlan, out *gcp.InfrastructurePlan, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Operations = *(*[]gcp.PlannedOperation)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructurePlan_To_gcp_InfrastructurePlan is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructurePlan_To_gcp_InfrastructurePlan(in *InfrastructurePlan, out *gcp.InfrastructurePlan, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructurePlan_To_gcp_InfrastructurePlan(in, out, s)
+}
+
+func autoConvert_gcp_InfrastructurePlan_To_v1alpha1_InfrastructurePlan(in *gcp.InfrastructurePlan, out *InfrastructurePlan, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Operations = *(*[]PlannedOperation)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_gcp_InfrastructurePlan_To_v1alpha1_InfrastructurePlan is an autogenerated conversion function.
+func Convert_gcp_InfrastructurePlan_To_v1alpha1_InfrastructurePlan(in *gcp.InfrastructurePlan, out *InfrastructurePlan, s conversion.Scope) error {
+	return autoConvert_gcp_InfrastructurePlan_To_v1alpha1_InfrastructurePlan(in, out, s)
+}
+
+func autoConvert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus(in *InfrastructureStatus, out *gcp.InfrastructureStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.ServiceAccountEmail = in.ServiceAccountEmail
+	out.WorkerPoolServiceAccounts = *(*[]gcp.WorkerPoolServiceAccountStatus)(unsafe.Pointer(&in.WorkerPoolServiceAccounts))
+	out.ErrorHistory = *(*[]gcp.ErrorRecord)(unsafe.Pointer(&in.ErrorHistory))
+	out.Plan = (*gcp.InfrastructurePlan)(unsafe.Pointer(in.Plan))
+	return nil
+}
+
+// Convert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus is an autogenerated conversion function.
+func Convert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus(in *InfrastructureStatus, out *gcp.InfrastructureStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus(in, out, s)
+}
+
+func autoConvert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *gcp.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
+	if err := Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.ServiceAccountEmail = in.ServiceAccountEmail
+	out.WorkerPoolServiceAccounts = *(*[]WorkerPoolServiceAccountStatus)(unsafe.Pointer(&in.WorkerPoolServiceAccounts))
+	out.ErrorHistory = *(*[]ErrorRecord)(unsafe.Pointer(&in.ErrorHistory))
+	out.Plan = (*InfrastructurePlan)(unsafe.Pointer(in.Plan))
+	return nil
+}
+
+// Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus is an autogenerated conversion function.
+func Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *gcp.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
+	return autoConvert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_InternalLoadBalancerConfig_To_gcp_InternalLoadBalancerConfig(in *InternalLoadBalancerConfig, out *gcp.InternalLoadBalancerConfig, s conversion.Scope) error {
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.GlobalAccess = (*bool)(unsafe.Pointer(in.GlobalAccess))
+	return nil
+}
+
+// Convert_v1alpha1_InternalLoadBalancerConfig_To_gcp_InternalLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_InternalLoadBalancerConfig_To_gcp_InternalLoadBalancerConfig(in *InternalLoadBalancerConfig, out *gcp.InternalLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InternalLoadBalancerConfig_To_gcp_InternalLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_gcp_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig(in *gcp.InternalLoadBalancerConfig, out *InternalLoadBalancerConfig, s conversion.Scope) error {
+	out.Subnet = (*string)(unsafe.Pointer(in.Subnet))
+	out.GlobalAccess = (*bool)(unsafe.Pointer(in.GlobalAccess))
+	return nil
+}
+
+// Convert_gcp_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig is an autogenerated conversion function.
+func Convert_gcp_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig(in *gcp.InternalLoadBalancerConfig, out *InternalLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_gcp_InternalLoadBalancerConfig_To_v1alpha1_InternalLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_InternalLoadBalancerStatus_To_gcp_InternalLoadBalancerStatus(in *InternalLoadBalancerStatus, out *gcp.InternalLoadBalancerStatus, s conversion.Scope) error {
+	out.Subnet = in.Subnet
+	out.GlobalAccess = in.GlobalAccess
+	return nil
+}
+
+// Convert_v1alpha1_InternalLoadBalancerStatus_To_gcp_InternalLoadBalancerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_InternalLoadBalancerStatus_To_gcp_InternalLoadBalancerStatus(in *InternalLoadBalancerStatus, out *gcp.InternalLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InternalLoadBalancerStatus_To_gcp_InternalLoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_gcp_InternalLoadBalancerStatus_To_v1alpha1_InternalLoadBalancerStatus(in *gcp.InternalLoadBalancerStatus, out *InternalLoadBalancerStatus, s conversion.Scope) error {
+	out.Subnet = in.Subnet
+	out.GlobalAccess = in.GlobalAccess
+	return nil
+}
+
+// Convert_gcp_InternalLoadBalancerStatus_To_v1alpha1_InternalLoadBalancerStatus is an autogenerated conversion function.
+func Convert_gcp_InternalLoadBalancerStatus_To_v1alpha1_InternalLoadBalancerStatus(in *gcp.InternalLoadBalancerStatus, out *InternalLoadBalancerStatus, s conversion.Scope) error {
+	return autoConvert_gcp_InternalLoadBalancerStatus_To_v1alpha1_InternalLoadBalancerStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_LifecycleTransition_To_gcp_LifecycleTransition(in *LifecycleTransition, out *gcp.LifecycleTransition, s conversion.Scope) error {
+	out.StorageClass = in.StorageClass
+	out.AfterDays = in.AfterDays
+	return nil
+}
+
+// Convert_v1alpha1_LifecycleTransition_To_gcp_LifecycleTransition is an autogenerated conversion function.
+func Convert_v1alpha1_LifecycleTransition_To_gcp_LifecycleTransition(in *LifecycleTransition, out *gcp.LifecycleTransition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LifecycleTransition_To_gcp_LifecycleTransition(in, out, s)
+}
+
+func autoConvert_gcp_LifecycleTransition_To_v1alpha1_LifecycleTransition(in *gcp.LifecycleTransition, out *LifecycleTransition, s conversion.Scope) error {
+	out.StorageClass = in.StorageClass
+	out.AfterDays = in.AfterDays
+	return nil
+}
+
+// Convert_gcp_LifecycleTransition_To_v1alpha1_LifecycleTransition is an autogenerated conversion function.
+func Convert_gcp_LifecycleTransition_To_v1alpha1_LifecycleTransition(in *gcp.LifecycleTransition, out *LifecycleTransition, s conversion.Scope) error {
+	return autoConvert_gcp_LifecycleTransition_To_v1alpha1_LifecycleTransition(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImage_To_gcp_MachineImage(in *MachineImage, out *gcp.MachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Image = in.Image
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImage_To_gcp_MachineImage is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImage_To_gcp_MachineImage(in *MachineImage, out *gcp.MachineImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImage_To_gcp_MachineImage(in, out, s)
+}
+
+func autoConvert_gcp_MachineImage_To_v1alpha1_MachineImage(in *gcp.MachineImage, out *MachineImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Image = in.Image
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_gcp_MachineImage_To_v1alpha1_MachineImage is an autogenerated conversion function.
+func Convert_gcp_MachineImage_To_v1alpha1_MachineImage(in *gcp.MachineImage, out *MachineImage, s conversion.Scope) error {
+	return autoConvert_gcp_MachineImage_To_v1alpha1_MachineImage(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(in *MachineImageVersion, out *gcp.MachineImageVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Image = in.Image
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(in *MachineImageVersion, out *gcp.MachineImageVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(in, out, s)
+}
+
+func autoConvert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *gcp.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Image = in.Image
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion is an autogenerated conversion function.
+func Convert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *gcp.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
+	return autoConvert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineImages_To_gcp_MachineImages(in *MachineImages, out *gcp.MachineImages, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]gcp.MachineImageVersion)(unsafe.Pointer(&in.Versions))
 	return nil
 }
 
-// Convert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig is an autogenerated conversion function.
-func Convert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in *gcp.CloudControllerManagerConfig, out *CloudControllerManagerConfig, s conversion.Scope) error {
-	return autoConvert_gcp_CloudControllerManagerConfig_To_v1alpha1_CloudControllerManagerConfig(in, out, s)
+// Convert_v1alpha1_MachineImages_To_gcp_MachineImages is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImages_To_gcp_MachineImages(in *MachineImages, out *gcp.MachineImages, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImages_To_gcp_MachineImages(in, out, s)
 }
 
-func autoConvert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in *CloudNAT, out *gcp.CloudNAT, s conversion.Scope) error {
-	out.EndpointIndependentMapping = (*gcp.EndpointIndependentMapping)(unsafe.Pointer(in.EndpointIndependentMapping))
-	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
-	out.MaxPortsPerVM = (*int32)(unsafe.Pointer(in.MaxPortsPerVM))
-	out.EnableDynamicPortAllocation = in.EnableDynamicPortAllocation
-	out.NatIPNames = *(*[]gcp.NatIPName)(unsafe.Pointer(&in.NatIPNames))
-	out.IcmpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.IcmpIdleTimeoutSec))
-	out.TcpEstablishedIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TcpEstablishedIdleTimeoutSec))
-	out.TcpTimeWaitTimeoutSec = (*int32)(unsafe.Pointer(in.TcpTimeWaitTimeoutSec))
-	out.TcpTransitoryIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TcpTransitoryIdleTimeoutSec))
-	out.UdpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.UdpIdleTimeoutSec))
+func autoConvert_gcp_MachineImages_To_v1alpha1_MachineImages(in *gcp.MachineImages, out *MachineImages, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]MachineImageVersion)(unsafe.Pointer(&in.Versions))
 	return nil
 }
 
-// Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT is an autogenerated conversion function.
-func Convert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in *CloudNAT, out *gcp.CloudNAT, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CloudNAT_To_gcp_CloudNAT(in, out, s)
+// Convert_gcp_MachineImages_To_v1alpha1_MachineImages is an autogenerated conversion function.
+func Convert_gcp_MachineImages_To_v1alpha1_MachineImages(in *gcp.MachineImages, out *MachineImages, s conversion.Scope) error {
+	return autoConvert_gcp_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
-func autoConvert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT, s conversion.Scope) error {
-	out.EndpointIndependentMapping = (*EndpointIndependentMapping)(unsafe.Pointer(in.EndpointIndependentMapping))
-	out.MinPortsPerVM = (*int32)(unsafe.Pointer(in.MinPortsPerVM))
-	out.MaxPortsPerVM = (*int32)(unsafe.Pointer(in.MaxPortsPerVM))
-	out.EnableDynamicPortAllocation = in.EnableDynamicPortAllocation
-	out.NatIPNames = *(*[]NatIPName)(unsafe.Pointer(&in.NatIPNames))
-	out.IcmpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.IcmpIdleTimeoutSec))
-	out.TcpEstablishedIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TcpEstablishedIdleTimeoutSec))
-	out.TcpTimeWaitTimeoutSec = (*int32)(unsafe.Pointer(in.TcpTimeWaitTimeoutSec))
-	out.TcpTransitoryIdleTimeoutSec = (*int32)(unsafe.Pointer(in.TcpTransitoryIdleTimeoutSec))
-	out.UdpIdleTimeoutSec = (*int32)(unsafe.Pointer(in.UdpIdleTimeoutSec))
+func autoConvert_v1alpha1_NCCSpoke_To_gcp_NCCSpoke(in *NCCSpoke, out *gcp.NCCSpoke, s conversion.Scope) error {
+	out.Hub = in.Hub
+	out.ExcludeExportRanges = *(*[]string)(unsafe.Pointer(&in.ExcludeExportRanges))
 	return nil
 }
 
-// Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT is an autogenerated conversion function.
-func Convert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in *gcp.CloudNAT, out *CloudNAT, s conversion.Scope) error {
-	return autoConvert_gcp_CloudNAT_To_v1alpha1_CloudNAT(in, out, s)
+// Convert_v1alpha1_NCCSpoke_To_gcp_NCCSpoke is an autogenerated conversion function.
+func Convert_v1alpha1_NCCSpoke_To_gcp_NCCSpoke(in *NCCSpoke, out *gcp.NCCSpoke, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NCCSpoke_To_gcp_NCCSpoke(in, out, s)
 }
 
-func autoConvert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in *CloudProfileConfig, out *gcp.CloudProfileConfig, s conversion.Scope) error {
-	out.MachineImages = *(*[]gcp.MachineImages)(unsafe.Pointer(&in.MachineImages))
+func autoConvert_gcp_NCCSpoke_To_v1alpha1_NCCSpoke(in *gcp.NCCSpoke, out *NCCSpoke, s conversion.Scope) error {
+	out.Hub = in.Hub
+	out.ExcludeExportRanges = *(*[]string)(unsafe.Pointer(&in.ExcludeExportRanges))
 	return nil
 }
 
-// Convert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig is an autogenerated conversion function.
-func Convert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in *CloudProfileConfig, out *gcp.CloudProfileConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CloudProfileConfig_To_gcp_CloudProfileConfig(in, out, s)
+// Convert_gcp_NCCSpoke_To_v1alpha1_NCCSpoke is an autogenerated conversion function.
+func Convert_gcp_NCCSpoke_To_v1alpha1_NCCSpoke(in *gcp.NCCSpoke, out *NCCSpoke, s conversion.Scope) error {
+	return autoConvert_gcp_NCCSpoke_To_v1alpha1_NCCSpoke(in, out, s)
 }
 
-func autoConvert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *gcp.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
-	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+func autoConvert_v1alpha1_NCCSpokeStatus_To_gcp_NCCSpokeStatus(in *NCCSpokeStatus, out *gcp.NCCSpokeStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = in.State
 	return nil
 }
 
-// Convert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig is an autogenerated conversion function.
-func Convert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *gcp.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
-	return autoConvert_gcp_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in, out, s)
+// Convert_v1alpha1_NCCSpokeStatus_To_gcp_NCCSpokeStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NCCSpokeStatus_To_gcp_NCCSpokeStatus(in *NCCSpokeStatus, out *gcp.NCCSpokeStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NCCSpokeStatus_To_gcp_NCCSpokeStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in *CloudRouter, out *gcp.CloudRouter, s conversion.Scope) error {
+func autoConvert_gcp_NCCSpokeStatus_To_v1alpha1_NCCSpokeStatus(in *gcp.NCCSpokeStatus, out *NCCSpokeStatus, s conversion.Scope) error {
 	out.Name = in.Name
+	out.State = in.State
 	return nil
 }
 
-// Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter is an autogenerated conversion function.
-func Convert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in *CloudRouter, out *gcp.CloudRouter, s conversion.Scope) error {
-	return autoConvert_v1alpha1_CloudRouter_To_gcp_CloudRouter(in, out, s)
+// Convert_gcp_NCCSpokeStatus_To_v1alpha1_NCCSpokeStatus is an autogenerated conversion function.
+func Convert_gcp_NCCSpokeStatus_To_v1alpha1_NCCSpokeStatus(in *gcp.NCCSpokeStatus, out *NCCSpokeStatus, s conversion.Scope) error {
+	return autoConvert_gcp_NCCSpokeStatus_To_v1alpha1_NCCSpokeStatus(in, out, s)
 }
 
-func autoConvert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in *gcp.CloudRouter, out *CloudRouter, s conversion.Scope) error {
+func autoConvert_v1alpha1_NatIP_To_gcp_NatIP(in *NatIP, out *gcp.NatIP, s conversion.Scope) error {
+	out.IP = in.IP
 	out.Name = in.Name
 	return nil
 }
 
-// Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter is an autogenerated conversion function.
-func Convert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in *gcp.CloudRouter, out *CloudRouter, s conversion.Scope) error {
-	return autoConvert_gcp_CloudRouter_To_v1alpha1_CloudRouter(in, out, s)
+// Convert_v1alpha1_NatIP_To_gcp_NatIP is an autogenerated conversion function.
+func Convert_v1alpha1_NatIP_To_gcp_NatIP(in *NatIP, out *gcp.NatIP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatIP_To_gcp_NatIP(in, out, s)
 }
 
-func autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPlaneConfig, out *gcp.ControlPlaneConfig, s conversion.Scope) error {
-	out.Zone = in.Zone
-	out.CloudControllerManager = (*gcp.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
-	out.Storage = (*gcp.Storage)(unsafe.Pointer(in.Storage))
+func autoConvert_gcp_NatIP_To_v1alpha1_NatIP(in *gcp.NatIP, out *NatIP, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig is an autogenerated conversion function.
-func Convert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in *ControlPlaneConfig, out *gcp.ControlPlaneConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_ControlPlaneConfig_To_gcp_ControlPlaneConfig(in, out, s)
+// Convert_gcp_NatIP_To_v1alpha1_NatIP is an autogenerated conversion function.
+func Convert_gcp_NatIP_To_v1alpha1_NatIP(in *gcp.NatIP, out *NatIP, s conversion.Scope) error {
+	return autoConvert_gcp_NatIP_To_v1alpha1_NatIP(in, out, s)
 }
 
-func autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
-	out.Zone = in.Zone
-	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
-	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+func autoConvert_v1alpha1_NatIPName_To_gcp_NatIPName(in *NatIPName, out *gcp.NatIPName, s conversion.Scope) error {
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig is an autogenerated conversion function.
-func Convert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *gcp.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
-	return autoConvert_gcp_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
+// Convert_v1alpha1_NatIPName_To_gcp_NatIPName is an autogenerated conversion function.
+func Convert_v1alpha1_NatIPName_To_gcp_NatIPName(in *NatIPName, out *gcp.NatIPName, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatIPName_To_gcp_NatIPName(in, out, s)
 }
 
-func autoConvert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption(in *DiskEncryption, out *gcp.DiskEncryption, s conversion.Scope) error {
-	out.KmsKeyName = (*string)(unsafe.Pointer(in.KmsKeyName))
-	out.KmsKeyServiceAccount = (*string)(unsafe.Pointer(in.KmsKeyServiceAccount))
+func autoConvert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPName, s conversion.Scope) error {
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption is an autogenerated conversion function.
-func Convert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption(in *DiskEncryption, out *gcp.DiskEncryption, s conversion.Scope) error {
-	return autoConvert_v1alpha1_DiskEncryption_To_gcp_DiskEncryption(in, out, s)
+// Convert_gcp_NatIPName_To_v1alpha1_NatIPName is an autogenerated conversion function.
+func Convert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPName, s conversion.Scope) error {
+	return autoConvert_gcp_NatIPName_To_v1alpha1_NatIPName(in, out, s)
 }
 
-func autoConvert_gcp_DiskEncryption_To_v1alpha1_DiskEncryption(in *gcp.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
-	out.KmsKeyName = (*string)(unsafe.Pointer(in.KmsKeyName))
-	out.KmsKeyServiceAccount = (*string)(unsafe.Pointer(in.KmsKeyServiceAccount))
+func autoConvert_v1alpha1_NatIPRotationStatus_To_gcp_NatIPRotationStatus(in *NatIPRotationStatus, out *gcp.NatIPRotationStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Completed = in.Completed
+	out.Draining = (*gcp.NatIP)(unsafe.Pointer(in.Draining))
+	out.DrainingSince = (*v1.Time)(unsafe.Pointer(in.DrainingSince))
 	return nil
 }
 
-// Convert_gcp_DiskEncryption_To_v1alpha1_DiskEncryption is an autogenerated conversion function.
-func Convert_gcp_DiskEncryption_To_v1alpha1_DiskEncryption(in *gcp.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
-	return autoConvert_gcp_DiskEncryption_To_v1alpha1_DiskEncryption(in, out, s)
+// Convert_v1alpha1_NatIPRotationStatus_To_gcp_NatIPRotationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NatIPRotationStatus_To_gcp_NatIPRotationStatus(in *NatIPRotationStatus, out *gcp.NatIPRotationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatIPRotationStatus_To_gcp_NatIPRotationStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_EndpointIndependentMapping_To_gcp_EndpointIndependentMapping(in *EndpointIndependentMapping, out *gcp.EndpointIndependentMapping, s conversion.Scope) error {
-	out.Enabled = in.Enabled
+func autoConvert_gcp_NatIPRotationStatus_To_v1alpha1_NatIPRotationStatus(in *gcp.NatIPRotationStatus, out *NatIPRotationStatus, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Completed = in.Completed
+	out.Draining = (*NatIP)(unsafe.Pointer(in.Draining))
+	out.DrainingSince = (*v1.Time)(unsafe.Pointer(in.DrainingSince))
 	return nil
 }
 
-// Convert_v1alpha1_EndpointIndependentMapping_To_gcp_EndpointIndependentMapping is an autogenerated conversion function.
-func Convert_v1alpha1_EndpointIndependentMapping_To_gcp_EndpointIndependentMapping(in *EndpointIndependentMapping, out *gcp.EndpointIndependentMapping, s conversion.Scope) error {
-	return autoConvert_v1alpha1_EndpointIndependentMapping_To_gcp_EndpointIndependentMapping(in, out, s)
+// Convert_gcp_NatIPRotationStatus_To_v1alpha1_NatIPRotationStatus is an autogenerated conversion function.
+func Convert_gcp_NatIPRotationStatus_To_v1alpha1_NatIPRotationStatus(in *gcp.NatIPRotationStatus, out *NatIPRotationStatus, s conversion.Scope) error {
+	return autoConvert_gcp_NatIPRotationStatus_To_v1alpha1_NatIPRotationStatus(in, out, s)
 }
 
-func autoConvert_gcp_EndpointIndependentMapping_To_v1alpha1_EndpointIndependentMapping(in *gcp.EndpointIndependentMapping, out *EndpointIndependentMapping, s conversion.Scope) error {
-	out.Enabled = in.Enabled
+func autoConvert_v1alpha1_NatLogging_To_gcp_NatLogging(in *NatLogging, out *gcp.NatLogging, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Filter = (*string)(unsafe.Pointer(in.Filter))
 	return nil
 }
 
-// Convert_gcp_EndpointIndependentMapping_To_v1alpha1_EndpointIndependentMapping is an autogenerated conversion function.
-func Convert_gcp_EndpointIndependentMapping_To_v1alpha1_EndpointIndependentMapping(in *gcp.EndpointIndependentMapping, out *EndpointIndependentMapping, s conversion.Scope) error {
-	return autoConvert_gcp_EndpointIndependentMapping_To_v1alpha1_EndpointIndependentMapping(in, out, s)
+// Convert_v1alpha1_NatLogging_To_gcp_NatLogging is an autogenerated conversion function.
+func Convert_v1alpha1_NatLogging_To_gcp_NatLogging(in *NatLogging, out *gcp.NatLogging, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NatLogging_To_gcp_NatLogging(in, out, s)
 }
 
-func autoConvert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in *FlowLogs, out *gcp.FlowLogs, s conversion.Scope) error {
-	out.AggregationInterval = (*string)(unsafe.Pointer(in.AggregationInterval))
-	if in.FlowSampling != nil {
-		in, out := &in.FlowSampling, &out.FlowSampling
-		*out = new(float64)
-		**out = float64(**in)
-	} else {
-		out.FlowSampling = nil
-	}
-	out.Metadata = (*string)(unsafe.Pointer(in.Metadata))
+func autoConvert_gcp_NatLogging_To_v1alpha1_NatLogging(in *gcp.NatLogging, out *NatLogging, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Filter = (*string)(unsafe.Pointer(in.Filter))
 	return nil
 }
 
-// Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs is an autogenerated conversion function.
-func Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in *FlowLogs, out *gcp.FlowLogs, s conversion.Scope) error {
-	return autoConvert_v1alpha1_FlowLogs_To_gcp_FlowLogs(in, out, s)
+// Convert_gcp_NatLogging_To_v1alpha1_NatLogging is an autogenerated conversion function.
+func Convert_gcp_NatLogging_To_v1alpha1_NatLogging(in *gcp.NatLogging, out *NatLogging, s conversion.Scope) error {
+	return autoConvert_gcp_NatLogging_To_v1alpha1_NatLogging(in, out, s)
 }
 
-func autoConvert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in *gcp.FlowLogs, out *FlowLogs, s conversion.Scope) error {
-	out.AggregationInterval = (*string)(unsafe.Pointer(in.AggregationInterval))
-	if in.FlowSampling != nil {
-		in, out := &in.FlowSampling, &out.FlowSampling
-		*out = new(float32)
-		**out = float32(**in)
+func autoConvert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out *gcp.NetworkConfig, s conversion.Scope) error {
+	out.VPC = (*gcp.VPC)(unsafe.Pointer(in.VPC))
+	out.CloudNAT = (*gcp.CloudNAT)(unsafe.Pointer(in.CloudNAT))
+	out.CloudRouterBGP = (*gcp.CloudRouterBGP)(unsafe.Pointer(in.CloudRouterBGP))
+	out.RoutingMode = (*string)(unsafe.Pointer(in.RoutingMode))
+	out.MTU = (*int32)(unsafe.Pointer(in.MTU))
+	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	out.ProxyOnly = (*string)(unsafe.Pointer(in.ProxyOnly))
+	out.Worker = in.Worker
+	out.Workers = in.Workers
+	out.Zones = *(*[]gcp.Zone)(unsafe.Pointer(&in.Zones))
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(gcp.FlowLogs)
+		if err := Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs(*in, *out, s); err != nil {
+			return err
+		}
 	} else {
-		out.FlowSampling = nil
+		out.FlowLogs = nil
 	}
-	out.Metadata = (*string)(unsafe.Pointer(in.Metadata))
+	out.PrivateGoogleAccess = (*bool)(unsafe.Pointer(in.PrivateGoogleAccess))
+	out.SecondaryRanges = *(*[]gcp.SecondaryRange)(unsafe.Pointer(&in.SecondaryRanges))
+	out.IPv6 = (*gcp.IPv6Config)(unsafe.Pointer(in.IPv6))
+	out.ExistingSubnets = (*gcp.ExistingSubnets)(unsafe.Pointer(in.ExistingSubnets))
+	out.PrivateServiceConnectEndpoints = *(*[]gcp.PrivateServiceConnectEndpoint)(unsafe.Pointer(&in.PrivateServiceConnectEndpoints))
+	out.AdditionalFirewallRules = *(*[]gcp.FirewallRule)(unsafe.Pointer(&in.AdditionalFirewallRules))
+	out.Peerings = *(*[]gcp.VPCPeering)(unsafe.Pointer(&in.Peerings))
+	out.FirewallPolicy = (*gcp.FirewallPolicy)(unsafe.Pointer(in.FirewallPolicy))
+	out.PacketMirroring = (*gcp.PacketMirroring)(unsafe.Pointer(in.PacketMirroring))
+	out.PrivateDNSZone = (*gcp.PrivateDNSZone)(unsafe.Pointer(in.PrivateDNSZone))
+	out.NCCSpoke = (*gcp.NCCSpoke)(unsafe.Pointer(in.NCCSpoke))
 	return nil
 }
 
-// Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs is an autogenerated conversion function.
-func Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in *gcp.FlowLogs, out *FlowLogs, s conversion.Scope) error {
-	return autoConvert_gcp_FlowLogs_To_v1alpha1_FlowLogs(in, out, s)
+// Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out *gcp.NetworkConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in, out, s)
 }
 
-func autoConvert_v1alpha1_GPU_To_gcp_GPU(in *GPU, out *gcp.GPU, s conversion.Scope) error {
-	out.AcceleratorType = in.AcceleratorType
-	out.Count = in.Count
+func autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
+	out.VPC = (*VPC)(unsafe.Pointer(in.VPC))
+	out.CloudNAT = (*CloudNAT)(unsafe.Pointer(in.CloudNAT))
+	out.CloudRouterBGP = (*CloudRouterBGP)(unsafe.Pointer(in.CloudRouterBGP))
+	out.RoutingMode = (*string)(unsafe.Pointer(in.RoutingMode))
+	out.MTU = (*int32)(unsafe.Pointer(in.MTU))
+	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	out.ProxyOnly = (*string)(unsafe.Pointer(in.ProxyOnly))
+	out.Worker = in.Worker
+	out.Workers = in.Workers
+	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(FlowLogs)
+		if err := Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.FlowLogs = nil
+	}
+	out.PrivateGoogleAccess = (*bool)(unsafe.Pointer(in.PrivateGoogleAccess))
+	out.SecondaryRanges = *(*[]SecondaryRange)(unsafe.Pointer(&in.SecondaryRanges))
+	out.IPv6 = (*IPv6Config)(unsafe.Pointer(in.IPv6))
+	out.ExistingSubnets = (*ExistingSubnets)(unsafe.Pointer(in.ExistingSubnets))
+	out.PrivateServiceConnectEndpoints = *(*[]PrivateServiceConnectEndpoint)(unsafe.Pointer(&in.PrivateServiceConnectEndpoints))
+	out.AdditionalFirewallRules = *(*[]FirewallRule)(unsafe.Pointer(&in.AdditionalFirewallRules))
+	out.Peerings = *(*[]VPCPeering)(unsafe.Pointer(&in.Peerings))
+	out.FirewallPolicy = (*FirewallPolicy)(unsafe.Pointer(in.FirewallPolicy))
+	out.PacketMirroring = (*PacketMirroring)(unsafe.Pointer(in.PacketMirroring))
+	out.PrivateDNSZone = (*PrivateDNSZone)(unsafe.Pointer(in.PrivateDNSZone))
+	out.NCCSpoke = (*NCCSpoke)(unsafe.Pointer(in.NCCSpoke))
 	return nil
 }
 
-// Convert_v1alpha1_GPU_To_gcp_GPU is an autogenerated conversion function.
-func Convert_v1alpha1_GPU_To_gcp_GPU(in *GPU, out *gcp.GPU, s conversion.Scope) error {
-	return autoConvert_v1alpha1_GPU_To_gcp_GPU(in, out, s)
+// Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig is an autogenerated conversion function.
+func Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
+	return autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in, out, s)
 }
 
-func autoConvert_gcp_GPU_To_v1alpha1_GPU(in *gcp.GPU, out *GPU, s conversion.Scope) error {
-	out.AcceleratorType = in.AcceleratorType
-	out.Count = in.Count
+func autoConvert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in *NetworkStatus, out *gcp.NetworkStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_VPC_To_gcp_VPC(&in.VPC, &out.VPC, s); err != nil {
+		return err
+	}
+	out.MTU = (*int32)(unsafe.Pointer(in.MTU))
+	out.Subnets = *(*[]gcp.Subnet)(unsafe.Pointer(&in.Subnets))
+	out.CloudNAT = (*gcp.CloudNATStatus)(unsafe.Pointer(in.CloudNAT))
+	out.NatIPs = *(*[]gcp.NatIP)(unsafe.Pointer(&in.NatIPs))
+	out.NatIPRotation = (*gcp.NatIPRotationStatus)(unsafe.Pointer(in.NatIPRotation))
+	out.PrivateServiceConnectEndpoints = *(*[]gcp.PrivateServiceConnectEndpointStatus)(unsafe.Pointer(&in.PrivateServiceConnectEndpoints))
+	out.Peerings = *(*[]gcp.VPCPeeringStatus)(unsafe.Pointer(&in.Peerings))
+	out.FirewallPolicy = (*string)(unsafe.Pointer(in.FirewallPolicy))
+	out.PacketMirroring = (*string)(unsafe.Pointer(in.PacketMirroring))
+	out.PrivateDNSZone = (*string)(unsafe.Pointer(in.PrivateDNSZone))
+	out.NCCSpoke = (*gcp.NCCSpokeStatus)(unsafe.Pointer(in.NCCSpoke))
 	return nil
 }
 
-// Convert_gcp_GPU_To_v1alpha1_GPU is an autogenerated conversion function.
-func Convert_gcp_GPU_To_v1alpha1_GPU(in *gcp.GPU, out *GPU, s conversion.Scope) error {
-	return autoConvert_gcp_GPU_To_v1alpha1_GPU(in, out, s)
+// Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in *NetworkStatus, out *gcp.NetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in *InfrastructureConfig, out *gcp.InfrastructureConfig, s conversion.Scope) error {
-	if err := Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
+func autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	if err := Convert_gcp_VPC_To_v1alpha1_VPC(&in.VPC, &out.VPC, s); err != nil {
 		return err
 	}
+	out.MTU = (*int32)(unsafe.Pointer(in.MTU))
+	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
+	out.CloudNAT = (*CloudNATStatus)(unsafe.Pointer(in.CloudNAT))
+	out.NatIPs = *(*[]NatIP)(unsafe.Pointer(&in.NatIPs))
+	out.NatIPRotation = (*NatIPRotationStatus)(unsafe.Pointer(in.NatIPRotation))
+	out.PrivateServiceConnectEndpoints = *(*[]PrivateServiceConnectEndpointStatus)(unsafe.Pointer(&in.PrivateServiceConnectEndpoints))
+	out.Peerings = *(*[]VPCPeeringStatus)(unsafe.Pointer(&in.Peerings))
+	out.FirewallPolicy = (*string)(unsafe.Pointer(in.FirewallPolicy))
+	out.PacketMirroring = (*string)(unsafe.Pointer(in.PacketMirroring))
+	out.PrivateDNSZone = (*string)(unsafe.Pointer(in.PrivateDNSZone))
+	out.NCCSpoke = (*NCCSpokeStatus)(unsafe.Pointer(in.NCCSpoke))
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in *InfrastructureConfig, out *gcp.InfrastructureConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureConfig_To_gcp_InfrastructureConfig(in, out, s)
+// Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus is an autogenerated conversion function.
+func Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	return autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
-func autoConvert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *gcp.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
-	if err := Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
+func autoConvert_v1alpha1_NodeServiceAccount_To_gcp_NodeServiceAccount(in *NodeServiceAccount, out *gcp.NodeServiceAccount, s conversion.Scope) error {
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	out.WorkerPools = *(*[]gcp.WorkerPoolServiceAccount)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
-// Convert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig is an autogenerated conversion function.
-func Convert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *gcp.InfrastructureConfig, out *InfrastructureConfig, s conversion.Scope) error {
-	return autoConvert_gcp_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in, out, s)
+// Convert_v1alpha1_NodeServiceAccount_To_gcp_NodeServiceAccount is an autogenerated conversion function.
+func Convert_v1alpha1_NodeServiceAccount_To_gcp_NodeServiceAccount(in *NodeServiceAccount, out *gcp.NodeServiceAccount, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeServiceAccount_To_gcp_NodeServiceAccount(in, out, s)
 }
 
-func autoConvert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus(in *InfrastructureStatus, out *gcp.InfrastructureStatus, s conversion.Scope) error {
-	if err := Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
-	out.ServiceAccountEmail = in.ServiceAccountEmail
+func autoConvert_gcp_NodeServiceAccount_To_v1alpha1_NodeServiceAccount(in *gcp.NodeServiceAccount, out *NodeServiceAccount, s conversion.Scope) error {
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	out.WorkerPools = *(*[]WorkerPoolServiceAccount)(unsafe.Pointer(&in.WorkerPools))
+	return nil
+}
+
+// Convert_gcp_NodeServiceAccount_To_v1alpha1_NodeServiceAccount is an autogenerated conversion function.
+func Convert_gcp_NodeServiceAccount_To_v1alpha1_NodeServiceAccount(in *gcp.NodeServiceAccount, out *NodeServiceAccount, s conversion.Scope) error {
+	return autoConvert_gcp_NodeServiceAccount_To_v1alpha1_NodeServiceAccount(in, out, s)
+}
+
+func autoConvert_v1alpha1_OpsAgentConfig_To_gcp_OpsAgentConfig(in *OpsAgentConfig, out *gcp.OpsAgentConfig, s conversion.Scope) error {
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	return nil
+}
+
+// Convert_v1alpha1_OpsAgentConfig_To_gcp_OpsAgentConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OpsAgentConfig_To_gcp_OpsAgentConfig(in *OpsAgentConfig, out *gcp.OpsAgentConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OpsAgentConfig_To_gcp_OpsAgentConfig(in, out, s)
+}
+
+func autoConvert_gcp_OpsAgentConfig_To_v1alpha1_OpsAgentConfig(in *gcp.OpsAgentConfig, out *OpsAgentConfig, s conversion.Scope) error {
+	out.Version = (*string)(unsafe.Pointer(in.Version))
+	return nil
+}
+
+// Convert_gcp_OpsAgentConfig_To_v1alpha1_OpsAgentConfig is an autogenerated conversion function.
+func Convert_gcp_OpsAgentConfig_To_v1alpha1_OpsAgentConfig(in *gcp.OpsAgentConfig, out *OpsAgentConfig, s conversion.Scope) error {
+	return autoConvert_gcp_OpsAgentConfig_To_v1alpha1_OpsAgentConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_PacketMirroring_To_gcp_PacketMirroring(in *PacketMirroring, out *gcp.PacketMirroring, s conversion.Scope) error {
+	out.Collector = in.Collector
+	out.Filter = (*gcp.PacketMirroringFilter)(unsafe.Pointer(in.Filter))
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	return nil
+}
+
+// Convert_v1alpha1_PacketMirroring_To_gcp_PacketMirroring is an autogenerated conversion function.
+func Convert_v1alpha1_PacketMirroring_To_gcp_PacketMirroring(in *PacketMirroring, out *gcp.PacketMirroring, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PacketMirroring_To_gcp_PacketMirroring(in, out, s)
+}
+
+func autoConvert_gcp_PacketMirroring_To_v1alpha1_PacketMirroring(in *gcp.PacketMirroring, out *PacketMirroring, s conversion.Scope) error {
+	out.Collector = in.Collector
+	out.Filter = (*PacketMirroringFilter)(unsafe.Pointer(in.Filter))
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
+	return nil
+}
+
+// Convert_gcp_PacketMirroring_To_v1alpha1_PacketMirroring is an autogenerated conversion function.
+func Convert_gcp_PacketMirroring_To_v1alpha1_PacketMirroring(in *gcp.PacketMirroring, out *PacketMirroring, s conversion.Scope) error {
+	return autoConvert_gcp_PacketMirroring_To_v1alpha1_PacketMirroring(in, out, s)
+}
+
+func autoConvert_v1alpha1_PacketMirroringFilter_To_gcp_PacketMirroringFilter(in *PacketMirroringFilter, out *gcp.PacketMirroringFilter, s conversion.Scope) error {
+	out.CIDRRanges = *(*[]string)(unsafe.Pointer(&in.CIDRRanges))
+	out.Protocols = *(*[]string)(unsafe.Pointer(&in.Protocols))
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
 	return nil
 }
 
-// Convert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus is an autogenerated conversion function.
-func Convert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus(in *InfrastructureStatus, out *gcp.InfrastructureStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_InfrastructureStatus_To_gcp_InfrastructureStatus(in, out, s)
+// Convert_v1alpha1_PacketMirroringFilter_To_gcp_PacketMirroringFilter is an autogenerated conversion function.
+func Convert_v1alpha1_PacketMirroringFilter_To_gcp_PacketMirroringFilter(in *PacketMirroringFilter, out *gcp.PacketMirroringFilter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PacketMirroringFilter_To_gcp_PacketMirroringFilter(in, out, s)
 }
 
-func autoConvert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *gcp.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
-	if err := Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(&in.Networks, &out.Networks, s); err != nil {
-		return err
-	}
-	out.ServiceAccountEmail = in.ServiceAccountEmail
+func autoConvert_gcp_PacketMirroringFilter_To_v1alpha1_PacketMirroringFilter(in *gcp.PacketMirroringFilter, out *PacketMirroringFilter, s conversion.Scope) error {
+	out.CIDRRanges = *(*[]string)(unsafe.Pointer(&in.CIDRRanges))
+	out.Protocols = *(*[]string)(unsafe.Pointer(&in.Protocols))
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
 	return nil
 }
 
-// Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus is an autogenerated conversion function.
-func Convert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *gcp.InfrastructureStatus, out *InfrastructureStatus, s conversion.Scope) error {
-	return autoConvert_gcp_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
+// Convert_gcp_PacketMirroringFilter_To_v1alpha1_PacketMirroringFilter is an autogenerated conversion function.
+func Convert_gcp_PacketMirroringFilter_To_v1alpha1_PacketMirroringFilter(in *gcp.PacketMirroringFilter, out *PacketMirroringFilter, s conversion.Scope) error {
+	return autoConvert_gcp_PacketMirroringFilter_To_v1alpha1_PacketMirroringFilter(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImage_To_gcp_MachineImage(in *MachineImage, out *gcp.MachineImage, s conversion.Scope) error {
+func autoConvert_v1alpha1_PlannedOperation_To_gcp_PlannedOperation(in *PlannedOperation, out *gcp.PlannedOperation, s conversion.Scope) error {
+	out.Action = in.Action
+	out.Resource = in.Resource
 	out.Name = in.Name
-	out.Version = in.Version
-	out.Image = in.Image
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
-// Convert_v1alpha1_MachineImage_To_gcp_MachineImage is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImage_To_gcp_MachineImage(in *MachineImage, out *gcp.MachineImage, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImage_To_gcp_MachineImage(in, out, s)
+// Convert_v1alpha1_PlannedOperation_To_gcp_PlannedOperation is an autogenerated conversion function.
+func Convert_v1alpha1_PlannedOperation_To_gcp_PlannedOperation(in *PlannedOperation, out *gcp.PlannedOperation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlannedOperation_To_gcp_PlannedOperation(in, out, s)
 }
 
-func autoConvert_gcp_MachineImage_To_v1alpha1_MachineImage(in *gcp.MachineImage, out *MachineImage, s conversion.Scope) error {
+func autoConvert_gcp_PlannedOperation_To_v1alpha1_PlannedOperation(in *gcp.PlannedOperation, out *PlannedOperation, s conversion.Scope) error {
+	out.Action = in.Action
+	out.Resource = in.Resource
 	out.Name = in.Name
-	out.Version = in.Version
-	out.Image = in.Image
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
-// Convert_gcp_MachineImage_To_v1alpha1_MachineImage is an autogenerated conversion function.
-func Convert_gcp_MachineImage_To_v1alpha1_MachineImage(in *gcp.MachineImage, out *MachineImage, s conversion.Scope) error {
-	return autoConvert_gcp_MachineImage_To_v1alpha1_MachineImage(in, out, s)
+// Convert_gcp_PlannedOperation_To_v1alpha1_PlannedOperation is an autogenerated conversion function.
+func Convert_gcp_PlannedOperation_To_v1alpha1_PlannedOperation(in *gcp.PlannedOperation, out *PlannedOperation, s conversion.Scope) error {
+	return autoConvert_gcp_PlannedOperation_To_v1alpha1_PlannedOperation(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(in *MachineImageVersion, out *gcp.MachineImageVersion, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Image = in.Image
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+func autoConvert_v1alpha1_PrivateDNSZone_To_gcp_PrivateDNSZone(in *PrivateDNSZone, out *gcp.PrivateDNSZone, s conversion.Scope) error {
+	out.Enabled = in.Enabled
 	return nil
 }
 
-// Convert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(in *MachineImageVersion, out *gcp.MachineImageVersion, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImageVersion_To_gcp_MachineImageVersion(in, out, s)
+// Convert_v1alpha1_PrivateDNSZone_To_gcp_PrivateDNSZone is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateDNSZone_To_gcp_PrivateDNSZone(in *PrivateDNSZone, out *gcp.PrivateDNSZone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateDNSZone_To_gcp_PrivateDNSZone(in, out, s)
 }
 
-func autoConvert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *gcp.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Image = in.Image
-	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+func autoConvert_gcp_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(in *gcp.PrivateDNSZone, out *PrivateDNSZone, s conversion.Scope) error {
+	out.Enabled = in.Enabled
 	return nil
 }
 
-// Convert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion is an autogenerated conversion function.
-func Convert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *gcp.MachineImageVersion, out *MachineImageVersion, s conversion.Scope) error {
-	return autoConvert_gcp_MachineImageVersion_To_v1alpha1_MachineImageVersion(in, out, s)
+// Convert_gcp_PrivateDNSZone_To_v1alpha1_PrivateDNSZone is an autogenerated conversion function.
+func Convert_gcp_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(in *gcp.PrivateDNSZone, out *PrivateDNSZone, s conversion.Scope) error {
+	return autoConvert_gcp_PrivateDNSZone_To_v1alpha1_PrivateDNSZone(in, out, s)
 }
 
-func autoConvert_v1alpha1_MachineImages_To_gcp_MachineImages(in *MachineImages, out *gcp.MachineImages, s conversion.Scope) error {
+func autoConvert_v1alpha1_PrivateServiceConnectEndpoint_To_gcp_PrivateServiceConnectEndpoint(in *PrivateServiceConnectEndpoint, out *gcp.PrivateServiceConnectEndpoint, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Versions = *(*[]gcp.MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	out.ServiceAttachment = in.ServiceAttachment
+	out.IP = (*string)(unsafe.Pointer(in.IP))
 	return nil
 }
 
-// Convert_v1alpha1_MachineImages_To_gcp_MachineImages is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImages_To_gcp_MachineImages(in *MachineImages, out *gcp.MachineImages, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImages_To_gcp_MachineImages(in, out, s)
+// Convert_v1alpha1_PrivateServiceConnectEndpoint_To_gcp_PrivateServiceConnectEndpoint is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateServiceConnectEndpoint_To_gcp_PrivateServiceConnectEndpoint(in *PrivateServiceConnectEndpoint, out *gcp.PrivateServiceConnectEndpoint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateServiceConnectEndpoint_To_gcp_PrivateServiceConnectEndpoint(in, out, s)
 }
 
-func autoConvert_gcp_MachineImages_To_v1alpha1_MachineImages(in *gcp.MachineImages, out *MachineImages, s conversion.Scope) error {
+func autoConvert_gcp_PrivateServiceConnectEndpoint_To_v1alpha1_PrivateServiceConnectEndpoint(in *gcp.PrivateServiceConnectEndpoint, out *PrivateServiceConnectEndpoint, s conversion.Scope) error {
 	out.Name = in.Name
-	out.Versions = *(*[]MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	out.ServiceAttachment = in.ServiceAttachment
+	out.IP = (*string)(unsafe.Pointer(in.IP))
 	return nil
 }
 
-// Convert_gcp_MachineImages_To_v1alpha1_MachineImages is an autogenerated conversion function.
-func Convert_gcp_MachineImages_To_v1alpha1_MachineImages(in *gcp.MachineImages, out *MachineImages, s conversion.Scope) error {
-	return autoConvert_gcp_MachineImages_To_v1alpha1_MachineImages(in, out, s)
+// Convert_gcp_PrivateServiceConnectEndpoint_To_v1alpha1_PrivateServiceConnectEndpoint is an autogenerated conversion function.
+func Convert_gcp_PrivateServiceConnectEndpoint_To_v1alpha1_PrivateServiceConnectEndpoint(in *gcp.PrivateServiceConnectEndpoint, out *PrivateServiceConnectEndpoint, s conversion.Scope) error {
+	return autoConvert_gcp_PrivateServiceConnectEndpoint_To_v1alpha1_PrivateServiceConnectEndpoint(in, out, s)
 }
 
-func autoConvert_v1alpha1_NatIP_To_gcp_NatIP(in *NatIP, out *gcp.NatIP, s conversion.Scope) error {
+func autoConvert_v1alpha1_PrivateServiceConnectEndpointStatus_To_gcp_PrivateServiceConnectEndpointStatus(in *PrivateServiceConnectEndpointStatus, out *gcp.PrivateServiceConnectEndpointStatus, s conversion.Scope) error {
+	out.Name = in.Name
 	out.IP = in.IP
+	out.ConnectionStatus = in.ConnectionStatus
 	return nil
 }
 
-// Convert_v1alpha1_NatIP_To_gcp_NatIP is an autogenerated conversion function.
-func Convert_v1alpha1_NatIP_To_gcp_NatIP(in *NatIP, out *gcp.NatIP, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NatIP_To_gcp_NatIP(in, out, s)
+// Convert_v1alpha1_PrivateServiceConnectEndpointStatus_To_gcp_PrivateServiceConnectEndpointStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PrivateServiceConnectEndpointStatus_To_gcp_PrivateServiceConnectEndpointStatus(in *PrivateServiceConnectEndpointStatus, out *gcp.PrivateServiceConnectEndpointStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrivateServiceConnectEndpointStatus_To_gcp_PrivateServiceConnectEndpointStatus(in, out, s)
 }
 
-func autoConvert_gcp_NatIP_To_v1alpha1_NatIP(in *gcp.NatIP, out *NatIP, s conversion.Scope) error {
+func autoConvert_gcp_PrivateServiceConnectEndpointStatus_To_v1alpha1_PrivateServiceConnectEndpointStatus(in *gcp.PrivateServiceConnectEndpointStatus, out *PrivateServiceConnectEndpointStatus, s conversion.Scope) error {
+	out.Name = in.Name
 	out.IP = in.IP
+	out.ConnectionStatus = in.ConnectionStatus
 	return nil
 }
 
-// Convert_gcp_NatIP_To_v1alpha1_NatIP is an autogenerated conversion function.
-func Convert_gcp_NatIP_To_v1alpha1_NatIP(in *gcp.NatIP, out *NatIP, s conversion.Scope) error {
-	return autoConvert_gcp_NatIP_To_v1alpha1_NatIP(in, out, s)
+// Convert_gcp_PrivateServiceConnectEndpointStatus_To_v1alpha1_PrivateServiceConnectEndpointStatus is an autogenerated conversion function.
+func Convert_gcp_PrivateServiceConnectEndpointStatus_To_v1alpha1_PrivateServiceConnectEndpointStatus(in *gcp.PrivateServiceConnectEndpointStatus, out *PrivateServiceConnectEndpointStatus, s conversion.Scope) error {
+	return autoConvert_gcp_PrivateServiceConnectEndpointStatus_To_v1alpha1_PrivateServiceConnectEndpointStatus(in, out, s)
 }
 
-func autoConvert_v1alpha1_NatIPName_To_gcp_NatIPName(in *NatIPName, out *gcp.NatIPName, s conversion.Scope) error {
-	out.Name = in.Name
+func autoConvert_v1alpha1_RegistryMirror_To_gcp_RegistryMirror(in *RegistryMirror, out *gcp.RegistryMirror, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Repository = in.Repository
 	return nil
 }
 
-// Convert_v1alpha1_NatIPName_To_gcp_NatIPName is an autogenerated conversion function.
-func Convert_v1alpha1_NatIPName_To_gcp_NatIPName(in *NatIPName, out *gcp.NatIPName, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NatIPName_To_gcp_NatIPName(in, out, s)
+// Convert_v1alpha1_RegistryMirror_To_gcp_RegistryMirror is an autogenerated conversion function.
+func Convert_v1alpha1_RegistryMirror_To_gcp_RegistryMirror(in *RegistryMirror, out *gcp.RegistryMirror, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegistryMirror_To_gcp_RegistryMirror(in, out, s)
 }
 
-func autoConvert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPName, s conversion.Scope) error {
-	out.Name = in.Name
+func autoConvert_gcp_RegistryMirror_To_v1alpha1_RegistryMirror(in *gcp.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	out.Upstream = in.Upstream
+	out.Repository = in.Repository
 	return nil
 }
 
-// Convert_gcp_NatIPName_To_v1alpha1_NatIPName is an autogenerated conversion function.
-func Convert_gcp_NatIPName_To_v1alpha1_NatIPName(in *gcp.NatIPName, out *NatIPName, s conversion.Scope) error {
-	return autoConvert_gcp_NatIPName_To_v1alpha1_NatIPName(in, out, s)
+// Convert_gcp_RegistryMirror_To_v1alpha1_RegistryMirror is an autogenerated conversion function.
+func Convert_gcp_RegistryMirror_To_v1alpha1_RegistryMirror(in *gcp.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	return autoConvert_gcp_RegistryMirror_To_v1alpha1_RegistryMirror(in, out, s)
 }
 
-func autoConvert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out *gcp.NetworkConfig, s conversion.Scope) error {
-	out.VPC = (*gcp.VPC)(unsafe.Pointer(in.VPC))
-	out.CloudNAT = (*gcp.CloudNAT)(unsafe.Pointer(in.CloudNAT))
-	out.Internal = (*string)(unsafe.Pointer(in.Internal))
-	out.Worker = in.Worker
-	out.Workers = in.Workers
-	if in.FlowLogs != nil {
-		in, out := &in.FlowLogs, &out.FlowLogs
-		*out = new(gcp.FlowLogs)
-		if err := Convert_v1alpha1_FlowLogs_To_gcp_FlowLogs(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.FlowLogs = nil
-	}
+func autoConvert_v1alpha1_Scheduling_To_gcp_Scheduling(in *Scheduling, out *gcp.Scheduling, s conversion.Scope) error {
+	out.OnHostMaintenance = (*string)(unsafe.Pointer(in.OnHostMaintenance))
+	out.AutomaticRestart = (*bool)(unsafe.Pointer(in.AutomaticRestart))
+	out.LocalSSDRecoveryTimeout = (*v1.Duration)(unsafe.Pointer(in.LocalSSDRecoveryTimeout))
+	out.ProvisioningModel = (*string)(unsafe.Pointer(in.ProvisioningModel))
+	out.MaxRunDuration = (*v1.Duration)(unsafe.Pointer(in.MaxRunDuration))
 	return nil
 }
 
-// Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig is an autogenerated conversion function.
-func Convert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in *NetworkConfig, out *gcp.NetworkConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NetworkConfig_To_gcp_NetworkConfig(in, out, s)
+// Convert_v1alpha1_Scheduling_To_gcp_Scheduling is an autogenerated conversion function.
+func Convert_v1alpha1_Scheduling_To_gcp_Scheduling(in *Scheduling, out *gcp.Scheduling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Scheduling_To_gcp_Scheduling(in, out, s)
 }
 
-func autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
-	out.VPC = (*VPC)(unsafe.Pointer(in.VPC))
-	out.CloudNAT = (*CloudNAT)(unsafe.Pointer(in.CloudNAT))
-	out.Internal = (*string)(unsafe.Pointer(in.Internal))
-	out.Worker = in.Worker
-	out.Workers = in.Workers
-	if in.FlowLogs != nil {
-		in, out := &in.FlowLogs, &out.FlowLogs
-		*out = new(FlowLogs)
-		if err := Convert_gcp_FlowLogs_To_v1alpha1_FlowLogs(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.FlowLogs = nil
-	}
+func autoConvert_gcp_Scheduling_To_v1alpha1_Scheduling(in *gcp.Scheduling, out *Scheduling, s conversion.Scope) error {
+	out.OnHostMaintenance = (*string)(unsafe.Pointer(in.OnHostMaintenance))
+	out.AutomaticRestart = (*bool)(unsafe.Pointer(in.AutomaticRestart))
+	out.LocalSSDRecoveryTimeout = (*v1.Duration)(unsafe.Pointer(in.LocalSSDRecoveryTimeout))
+	out.ProvisioningModel = (*string)(unsafe.Pointer(in.ProvisioningModel))
+	out.MaxRunDuration = (*v1.Duration)(unsafe.Pointer(in.MaxRunDuration))
 	return nil
 }
 
-// Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig is an autogenerated conversion function.
-func Convert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in *gcp.NetworkConfig, out *NetworkConfig, s conversion.Scope) error {
-	return autoConvert_gcp_NetworkConfig_To_v1alpha1_NetworkConfig(in, out, s)
+// Convert_gcp_Scheduling_To_v1alpha1_Scheduling is an autogenerated conversion function.
+func Convert_gcp_Scheduling_To_v1alpha1_Scheduling(in *gcp.Scheduling, out *Scheduling, s conversion.Scope) error {
+	return autoConvert_gcp_Scheduling_To_v1alpha1_Scheduling(in, out, s)
 }
 
-func autoConvert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in *NetworkStatus, out *gcp.NetworkStatus, s conversion.Scope) error {
-	if err := Convert_v1alpha1_VPC_To_gcp_VPC(&in.VPC, &out.VPC, s); err != nil {
-		return err
-	}
-	out.Subnets = *(*[]gcp.Subnet)(unsafe.Pointer(&in.Subnets))
-	out.NatIPs = *(*[]gcp.NatIP)(unsafe.Pointer(&in.NatIPs))
+func autoConvert_v1alpha1_SecondaryRange_To_gcp_SecondaryRange(in *SecondaryRange, out *gcp.SecondaryRange, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
+	out.Purpose = (*gcp.SecondaryRangePurpose)(unsafe.Pointer(in.Purpose))
 	return nil
 }
 
-// Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus is an autogenerated conversion function.
-func Convert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in *NetworkStatus, out *gcp.NetworkStatus, s conversion.Scope) error {
-	return autoConvert_v1alpha1_NetworkStatus_To_gcp_NetworkStatus(in, out, s)
+// Convert_v1alpha1_SecondaryRange_To_gcp_SecondaryRange is an autogenerated conversion function.
+func Convert_v1alpha1_SecondaryRange_To_gcp_SecondaryRange(in *SecondaryRange, out *gcp.SecondaryRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecondaryRange_To_gcp_SecondaryRange(in, out, s)
 }
 
-func autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
-	if err := Convert_gcp_VPC_To_v1alpha1_VPC(&in.VPC, &out.VPC, s); err != nil {
-		return err
-	}
-	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
-	out.NatIPs = *(*[]NatIP)(unsafe.Pointer(&in.NatIPs))
+func autoConvert_gcp_SecondaryRange_To_v1alpha1_SecondaryRange(in *gcp.SecondaryRange, out *SecondaryRange, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
+	out.Purpose = (*SecondaryRangePurpose)(unsafe.Pointer(in.Purpose))
 	return nil
 }
 
-// Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus is an autogenerated conversion function.
-func Convert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in *gcp.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
-	return autoConvert_gcp_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
+// Convert_gcp_SecondaryRange_To_v1alpha1_SecondaryRange is an autogenerated conversion function.
+func Convert_gcp_SecondaryRange_To_v1alpha1_SecondaryRange(in *gcp.SecondaryRange, out *SecondaryRange, s conversion.Scope) error {
+	return autoConvert_gcp_SecondaryRange_To_v1alpha1_SecondaryRange(in, out, s)
 }
 
 func autoConvert_v1alpha1_ServiceAccount_To_gcp_ServiceAccount(in *ServiceAccount, out *gcp.ServiceAccount, s conversion.Scope) error {
@@ -760,6 +2764,10 @@ func Convert_gcp_ServiceAccount_To_v1alpha1_ServiceAccount(in *gcp.ServiceAccoun
 func autoConvert_v1alpha1_Storage_To_gcp_Storage(in *Storage, out *gcp.Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.Filestore = (*gcp.Filestore)(unsafe.Pointer(in.Filestore))
+	out.VolumeSnapshotClass = (*gcp.VolumeSnapshotClassConfig)(unsafe.Pointer(in.VolumeSnapshotClass))
+	out.StorageClasses = *(*[]gcp.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.CSIDriverResources = (*gcp.CSIDriverResources)(unsafe.Pointer(in.CSIDriverResources))
 	return nil
 }
 
@@ -771,6 +2779,10 @@ func Convert_v1alpha1_Storage_To_gcp_Storage(in *Storage, out *gcp.Storage, s co
 func autoConvert_gcp_Storage_To_v1alpha1_Storage(in *gcp.Storage, out *Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
+	out.Filestore = (*Filestore)(unsafe.Pointer(in.Filestore))
+	out.VolumeSnapshotClass = (*VolumeSnapshotClassConfig)(unsafe.Pointer(in.VolumeSnapshotClass))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.CSIDriverResources = (*CSIDriverResources)(unsafe.Pointer(in.CSIDriverResources))
 	return nil
 }
 
@@ -779,9 +2791,46 @@ func Convert_gcp_Storage_To_v1alpha1_Storage(in *gcp.Storage, out *Storage, s co
 	return autoConvert_gcp_Storage_To_v1alpha1_Storage(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClass_To_gcp_StorageClass(in *StorageClass, out *gcp.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = in.Default
+	out.Type = in.Type
+	out.ReplicationType = (*string)(unsafe.Pointer(in.ReplicationType))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
+	out.KmsKeyName = (*string)(unsafe.Pointer(in.KmsKeyName))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_gcp_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_gcp_StorageClass(in *StorageClass, out *gcp.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_gcp_StorageClass(in, out, s)
+}
+
+func autoConvert_gcp_StorageClass_To_v1alpha1_StorageClass(in *gcp.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = in.Default
+	out.Type = in.Type
+	out.ReplicationType = (*string)(unsafe.Pointer(in.ReplicationType))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.ProvisionedThroughput = (*int64)(unsafe.Pointer(in.ProvisionedThroughput))
+	out.KmsKeyName = (*string)(unsafe.Pointer(in.KmsKeyName))
+	return nil
+}
+
+// Convert_gcp_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_gcp_StorageClass_To_v1alpha1_StorageClass(in *gcp.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_gcp_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_gcp_Subnet(in *Subnet, out *gcp.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = gcp.SubnetPurpose(in.Purpose)
+	out.SelfLink = in.SelfLink
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.IPv6CIDRRange = (*string)(unsafe.Pointer(in.IPv6CIDRRange))
+	out.IPv4CIDRRange = (*string)(unsafe.Pointer(in.IPv4CIDRRange))
+	out.SecondaryRanges = *(*[]gcp.SecondaryRange)(unsafe.Pointer(&in.SecondaryRanges))
 	return nil
 }
 
@@ -793,6 +2842,11 @@ func Convert_v1alpha1_Subnet_To_gcp_Subnet(in *Subnet, out *gcp.Subnet, s conver
 func autoConvert_gcp_Subnet_To_v1alpha1_Subnet(in *gcp.Subnet, out *Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = SubnetPurpose(in.Purpose)
+	out.SelfLink = in.SelfLink
+	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	out.IPv6CIDRRange = (*string)(unsafe.Pointer(in.IPv6CIDRRange))
+	out.IPv4CIDRRange = (*string)(unsafe.Pointer(in.IPv4CIDRRange))
+	out.SecondaryRanges = *(*[]SecondaryRange)(unsafe.Pointer(&in.SecondaryRanges))
 	return nil
 }
 
@@ -823,6 +2877,54 @@ func Convert_gcp_VPC_To_v1alpha1_VPC(in *gcp.VPC, out *VPC, s conversion.Scope)
 	return autoConvert_gcp_VPC_To_v1alpha1_VPC(in, out, s)
 }
 
+func autoConvert_v1alpha1_VPCPeering_To_gcp_VPCPeering(in *VPCPeering, out *gcp.VPCPeering, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Network = in.Network
+	out.ExportCustomRoutes = (*bool)(unsafe.Pointer(in.ExportCustomRoutes))
+	out.ImportCustomRoutes = (*bool)(unsafe.Pointer(in.ImportCustomRoutes))
+	return nil
+}
+
+// Convert_v1alpha1_VPCPeering_To_gcp_VPCPeering is an autogenerated conversion function.
+func Convert_v1alpha1_VPCPeering_To_gcp_VPCPeering(in *VPCPeering, out *gcp.VPCPeering, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCPeering_To_gcp_VPCPeering(in, out, s)
+}
+
+func autoConvert_gcp_VPCPeering_To_v1alpha1_VPCPeering(in *gcp.VPCPeering, out *VPCPeering, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Network = in.Network
+	out.ExportCustomRoutes = (*bool)(unsafe.Pointer(in.ExportCustomRoutes))
+	out.ImportCustomRoutes = (*bool)(unsafe.Pointer(in.ImportCustomRoutes))
+	return nil
+}
+
+// Convert_gcp_VPCPeering_To_v1alpha1_VPCPeering is an autogenerated conversion function.
+func Convert_gcp_VPCPeering_To_v1alpha1_VPCPeering(in *gcp.VPCPeering, out *VPCPeering, s conversion.Scope) error {
+	return autoConvert_gcp_VPCPeering_To_v1alpha1_VPCPeering(in, out, s)
+}
+
+func autoConvert_v1alpha1_VPCPeeringStatus_To_gcp_VPCPeeringStatus(in *VPCPeeringStatus, out *gcp.VPCPeeringStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = in.State
+	return nil
+}
+
+// Convert_v1alpha1_VPCPeeringStatus_To_gcp_VPCPeeringStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VPCPeeringStatus_To_gcp_VPCPeeringStatus(in *VPCPeeringStatus, out *gcp.VPCPeeringStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCPeeringStatus_To_gcp_VPCPeeringStatus(in, out, s)
+}
+
+func autoConvert_gcp_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus(in *gcp.VPCPeeringStatus, out *VPCPeeringStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = in.State
+	return nil
+}
+
+// Convert_gcp_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus is an autogenerated conversion function.
+func Convert_gcp_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus(in *gcp.VPCPeeringStatus, out *VPCPeeringStatus, s conversion.Scope) error {
+	return autoConvert_gcp_VPCPeeringStatus_To_v1alpha1_VPCPeeringStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Volume_To_gcp_Volume(in *Volume, out *gcp.Volume, s conversion.Scope) error {
 	out.LocalSSDInterface = (*string)(unsafe.Pointer(in.LocalSSDInterface))
 	out.Encryption = (*gcp.DiskEncryption)(unsafe.Pointer(in.Encryption))
@@ -845,11 +2947,45 @@ func Convert_gcp_Volume_To_v1alpha1_Volume(in *gcp.Volume, out *Volume, s conver
 	return autoConvert_gcp_Volume_To_v1alpha1_Volume(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeSnapshotClassConfig_To_gcp_VolumeSnapshotClassConfig(in *VolumeSnapshotClassConfig, out *gcp.VolumeSnapshotClassConfig, s conversion.Scope) error {
+	out.StorageLocation = (*string)(unsafe.Pointer(in.StorageLocation))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotClassConfig_To_gcp_VolumeSnapshotClassConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotClassConfig_To_gcp_VolumeSnapshotClassConfig(in *VolumeSnapshotClassConfig, out *gcp.VolumeSnapshotClassConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotClassConfig_To_gcp_VolumeSnapshotClassConfig(in, out, s)
+}
+
+func autoConvert_gcp_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig(in *gcp.VolumeSnapshotClassConfig, out *VolumeSnapshotClassConfig, s conversion.Scope) error {
+	out.StorageLocation = (*string)(unsafe.Pointer(in.StorageLocation))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_gcp_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig is an autogenerated conversion function.
+func Convert_gcp_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig(in *gcp.VolumeSnapshotClassConfig, out *VolumeSnapshotClassConfig, s conversion.Scope) error {
+	return autoConvert_gcp_VolumeSnapshotClassConfig_To_v1alpha1_VolumeSnapshotClassConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerConfig_To_gcp_WorkerConfig(in *WorkerConfig, out *gcp.WorkerConfig, s conversion.Scope) error {
 	out.GPU = (*gcp.GPU)(unsafe.Pointer(in.GPU))
 	out.Volume = (*gcp.Volume)(unsafe.Pointer(in.Volume))
 	out.MinCpuPlatform = (*string)(unsafe.Pointer(in.MinCpuPlatform))
 	out.ServiceAccount = (*gcp.ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
+	out.AliasIPRange = (*gcp.AliasIPRange)(unsafe.Pointer(in.AliasIPRange))
+	out.CanaryRollout = (*gcp.CanaryRollout)(unsafe.Pointer(in.CanaryRollout))
+	out.Scheduling = (*gcp.Scheduling)(unsafe.Pointer(in.Scheduling))
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
+	out.PropagateShootLabels = (*bool)(unsafe.Pointer(in.PropagateShootLabels))
+	out.SerialPortLoggingEnable = (*bool)(unsafe.Pointer(in.SerialPortLoggingEnable))
+	out.InstallOpsAgent = (*bool)(unsafe.Pointer(in.InstallOpsAgent))
+	out.EnableOSLogin = (*bool)(unsafe.Pointer(in.EnableOSLogin))
+	out.QuotaAwareRollingUpdate = (*bool)(unsafe.Pointer(in.QuotaAwareRollingUpdate))
+	out.ZoneCircuitBreaker = (*bool)(unsafe.Pointer(in.ZoneCircuitBreaker))
+	out.ImageStreaming = (*gcp.ImageStreaming)(unsafe.Pointer(in.ImageStreaming))
+	out.MountHyperdiskML = (*bool)(unsafe.Pointer(in.MountHyperdiskML))
 	return nil
 }
 
@@ -863,6 +2999,18 @@ func autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig,
 	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
 	out.MinCpuPlatform = (*string)(unsafe.Pointer(in.MinCpuPlatform))
 	out.ServiceAccount = (*ServiceAccount)(unsafe.Pointer(in.ServiceAccount))
+	out.AliasIPRange = (*AliasIPRange)(unsafe.Pointer(in.AliasIPRange))
+	out.CanaryRollout = (*CanaryRollout)(unsafe.Pointer(in.CanaryRollout))
+	out.Scheduling = (*Scheduling)(unsafe.Pointer(in.Scheduling))
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
+	out.PropagateShootLabels = (*bool)(unsafe.Pointer(in.PropagateShootLabels))
+	out.SerialPortLoggingEnable = (*bool)(unsafe.Pointer(in.SerialPortLoggingEnable))
+	out.InstallOpsAgent = (*bool)(unsafe.Pointer(in.InstallOpsAgent))
+	out.EnableOSLogin = (*bool)(unsafe.Pointer(in.EnableOSLogin))
+	out.QuotaAwareRollingUpdate = (*bool)(unsafe.Pointer(in.QuotaAwareRollingUpdate))
+	out.ZoneCircuitBreaker = (*bool)(unsafe.Pointer(in.ZoneCircuitBreaker))
+	out.ImageStreaming = (*ImageStreaming)(unsafe.Pointer(in.ImageStreaming))
+	out.MountHyperdiskML = (*bool)(unsafe.Pointer(in.MountHyperdiskML))
 	return nil
 }
 
@@ -871,8 +3019,79 @@ func Convert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in *gcp.WorkerConfig, out
 	return autoConvert_gcp_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerPoolServiceAccount_To_gcp_WorkerPoolServiceAccount(in *WorkerPoolServiceAccount, out *gcp.WorkerPoolServiceAccount, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPoolServiceAccount_To_gcp_WorkerPoolServiceAccount is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPoolServiceAccount_To_gcp_WorkerPoolServiceAccount(in *WorkerPoolServiceAccount, out *gcp.WorkerPoolServiceAccount, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPoolServiceAccount_To_gcp_WorkerPoolServiceAccount(in, out, s)
+}
+
+func autoConvert_gcp_WorkerPoolServiceAccount_To_v1alpha1_WorkerPoolServiceAccount(in *gcp.WorkerPoolServiceAccount, out *WorkerPoolServiceAccount, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_gcp_WorkerPoolServiceAccount_To_v1alpha1_WorkerPoolServiceAccount is an autogenerated conversion function.
+func Convert_gcp_WorkerPoolServiceAccount_To_v1alpha1_WorkerPoolServiceAccount(in *gcp.WorkerPoolServiceAccount, out *WorkerPoolServiceAccount, s conversion.Scope) error {
+	return autoConvert_gcp_WorkerPoolServiceAccount_To_v1alpha1_WorkerPoolServiceAccount(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerPoolServiceAccountStatus_To_gcp_WorkerPoolServiceAccountStatus(in *WorkerPoolServiceAccountStatus, out *gcp.WorkerPoolServiceAccountStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Email = in.Email
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPoolServiceAccountStatus_To_gcp_WorkerPoolServiceAccountStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPoolServiceAccountStatus_To_gcp_WorkerPoolServiceAccountStatus(in *WorkerPoolServiceAccountStatus, out *gcp.WorkerPoolServiceAccountStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPoolServiceAccountStatus_To_gcp_WorkerPoolServiceAccountStatus(in, out, s)
+}
+
+func autoConvert_gcp_WorkerPoolServiceAccountStatus_To_v1alpha1_WorkerPoolServiceAccountStatus(in *gcp.WorkerPoolServiceAccountStatus, out *WorkerPoolServiceAccountStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Email = in.Email
+	return nil
+}
+
+// Convert_gcp_WorkerPoolServiceAccountStatus_To_v1alpha1_WorkerPoolServiceAccountStatus is an autogenerated conversion function.
+func Convert_gcp_WorkerPoolServiceAccountStatus_To_v1alpha1_WorkerPoolServiceAccountStatus(in *gcp.WorkerPoolServiceAccountStatus, out *WorkerPoolServiceAccountStatus, s conversion.Scope) error {
+	return autoConvert_gcp_WorkerPoolServiceAccountStatus_To_v1alpha1_WorkerPoolServiceAccountStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerPoolStatus_To_gcp_WorkerPoolStatus(in *WorkerPoolStatus, out *gcp.WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPoolStatus_To_gcp_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPoolStatus_To_gcp_WorkerPoolStatus(in *WorkerPoolStatus, out *gcp.WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPoolStatus_To_gcp_WorkerPoolStatus(in, out, s)
+}
+
+func autoConvert_gcp_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus(in *gcp.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	return nil
+}
+
+// Convert_gcp_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus is an autogenerated conversion function.
+func Convert_gcp_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus(in *gcp.WorkerPoolStatus, out *WorkerPoolStatus, s conversion.Scope) error {
+	return autoConvert_gcp_WorkerPoolStatus_To_v1alpha1_WorkerPoolStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_gcp_WorkerStatus(in *WorkerStatus, out *gcp.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]gcp.MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.CanaryRollouts = *(*[]gcp.CanaryRolloutStatus)(unsafe.Pointer(&in.CanaryRollouts))
+	out.Pools = *(*[]gcp.WorkerPoolStatus)(unsafe.Pointer(&in.Pools))
+	out.ZoneMigrations = *(*[]gcp.ZoneMigrationStatus)(unsafe.Pointer(&in.ZoneMigrations))
+	out.ZoneCircuitBreakers = *(*[]gcp.ZoneCircuitBreakerStatus)(unsafe.Pointer(&in.ZoneCircuitBreakers))
+	out.ErrorHistory = *(*[]gcp.ErrorRecord)(unsafe.Pointer(&in.ErrorHistory))
 	return nil
 }
 
@@ -883,6 +3102,11 @@ func Convert_v1alpha1_WorkerStatus_To_gcp_WorkerStatus(in *WorkerStatus, out *gc
 
 func autoConvert_gcp_WorkerStatus_To_v1alpha1_WorkerStatus(in *gcp.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.CanaryRollouts = *(*[]CanaryRolloutStatus)(unsafe.Pointer(&in.CanaryRollouts))
+	out.Pools = *(*[]WorkerPoolStatus)(unsafe.Pointer(&in.Pools))
+	out.ZoneMigrations = *(*[]ZoneMigrationStatus)(unsafe.Pointer(&in.ZoneMigrations))
+	out.ZoneCircuitBreakers = *(*[]ZoneCircuitBreakerStatus)(unsafe.Pointer(&in.ZoneCircuitBreakers))
+	out.ErrorHistory = *(*[]ErrorRecord)(unsafe.Pointer(&in.ErrorHistory))
 	return nil
 }
 
@@ -890,3 +3114,81 @@ func autoConvert_gcp_WorkerStatus_To_v1alpha1_WorkerStatus(in *gcp.WorkerStatus,
 func Convert_gcp_WorkerStatus_To_v1alpha1_WorkerStatus(in *gcp.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	return autoConvert_gcp_WorkerStatus_To_v1alpha1_WorkerStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_Zone_To_gcp_Zone(in *Zone, out *gcp.Zone, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Workers = in.Workers
+	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	return nil
+}
+
+// Convert_v1alpha1_Zone_To_gcp_Zone is an autogenerated conversion function.
+func Convert_v1alpha1_Zone_To_gcp_Zone(in *Zone, out *gcp.Zone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Zone_To_gcp_Zone(in, out, s)
+}
+
+func autoConvert_gcp_Zone_To_v1alpha1_Zone(in *gcp.Zone, out *Zone, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Workers = in.Workers
+	out.Internal = (*string)(unsafe.Pointer(in.Internal))
+	return nil
+}
+
+// Convert_gcp_Zone_To_v1alpha1_Zone is an autogenerated conversion function.
+func Convert_gcp_Zone_To_v1alpha1_Zone(in *gcp.Zone, out *Zone, s conversion.Scope) error {
+	return autoConvert_gcp_Zone_To_v1alpha1_Zone(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneCircuitBreakerStatus_To_gcp_ZoneCircuitBreakerStatus(in *ZoneCircuitBreakerStatus, out *gcp.ZoneCircuitBreakerStatus, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.Failures = in.Failures
+	out.SuspendedUntil = in.SuspendedUntil
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_v1alpha1_ZoneCircuitBreakerStatus_To_gcp_ZoneCircuitBreakerStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneCircuitBreakerStatus_To_gcp_ZoneCircuitBreakerStatus(in *ZoneCircuitBreakerStatus, out *gcp.ZoneCircuitBreakerStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneCircuitBreakerStatus_To_gcp_ZoneCircuitBreakerStatus(in, out, s)
+}
+
+func autoConvert_gcp_ZoneCircuitBreakerStatus_To_v1alpha1_ZoneCircuitBreakerStatus(in *gcp.ZoneCircuitBreakerStatus, out *ZoneCircuitBreakerStatus, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.Failures = in.Failures
+	out.SuspendedUntil = in.SuspendedUntil
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_gcp_ZoneCircuitBreakerStatus_To_v1alpha1_ZoneCircuitBreakerStatus is an autogenerated conversion function.
+func Convert_gcp_ZoneCircuitBreakerStatus_To_v1alpha1_ZoneCircuitBreakerStatus(in *gcp.ZoneCircuitBreakerStatus, out *ZoneCircuitBreakerStatus, s conversion.Scope) error {
+	return autoConvert_gcp_ZoneCircuitBreakerStatus_To_v1alpha1_ZoneCircuitBreakerStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ZoneMigrationStatus_To_gcp_ZoneMigrationStatus(in *ZoneMigrationStatus, out *gcp.ZoneMigrationStatus, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.MachineDeployment = in.MachineDeployment
+	out.Disks = *(*[]string)(unsafe.Pointer(&in.Disks))
+	return nil
+}
+
+// Convert_v1alpha1_ZoneMigrationStatus_To_gcp_ZoneMigrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneMigrationStatus_To_gcp_ZoneMigrationStatus(in *ZoneMigrationStatus, out *gcp.ZoneMigrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneMigrationStatus_To_gcp_ZoneMigrationStatus(in, out, s)
+}
+
+func autoConvert_gcp_ZoneMigrationStatus_To_v1alpha1_ZoneMigrationStatus(in *gcp.ZoneMigrationStatus, out *ZoneMigrationStatus, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.MachineDeployment = in.MachineDeployment
+	out.Disks = *(*[]string)(unsafe.Pointer(&in.Disks))
+	return nil
+}
+
+// Convert_gcp_ZoneMigrationStatus_To_v1alpha1_ZoneMigrationStatus is an autogenerated conversion function.
+func Convert_gcp_ZoneMigrationStatus_To_v1alpha1_ZoneMigrationStatus(in *gcp.ZoneMigrationStatus, out *ZoneMigrationStatus, s conversion.Scope) error {
+	return autoConvert_gcp_ZoneMigrationStatus_To_v1alpha1_ZoneMigrationStatus(in, out, s)
+}
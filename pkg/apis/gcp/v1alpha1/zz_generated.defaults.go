@@ -19,6 +19,7 @@ import (
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&CloudProfileConfig{}, func(obj interface{}) { SetObjectDefaults_CloudProfileConfig(obj.(*CloudProfileConfig)) })
 	scheme.AddTypeDefaultingFunc(&ControlPlaneConfig{}, func(obj interface{}) { SetObjectDefaults_ControlPlaneConfig(obj.(*ControlPlaneConfig)) })
+	scheme.AddTypeDefaultingFunc(&WorkerConfig{}, func(obj interface{}) { SetObjectDefaults_WorkerConfig(obj.(*WorkerConfig)) })
 	return nil
 }
 
@@ -37,3 +38,9 @@ func SetObjectDefaults_ControlPlaneConfig(in *ControlPlaneConfig) {
 		SetDefaults_Storage(in.Storage)
 	}
 }
+
+func SetObjectDefaults_WorkerConfig(in *WorkerConfig) {
+	if in.CanaryRollout != nil {
+		SetDefaults_CanaryRollout(in.CanaryRollout)
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+// maxRetentionPeriod is the maximum retention period of buckets supported by GCS, i.e. 100 years.
+const maxRetentionPeriod = 3155760000 * time.Second
+
+var (
+	// multiRegionLocations are the multi-region locations of GCS.
+	multiRegionLocations = sets.New("ASIA", "EU", "US")
+	// dualRegionLocations are the predefined dual-region locations of GCS.
+	dualRegionLocations = sets.New("ASIA1", "EUR4", "EUR5", "EUR7", "EUR8", "NAM4")
+	// transitionStorageClasses are the storage classes to which objects can be transitioned by lifecycle rules.
+	transitionStorageClasses = sets.New("NEARLINE", "COLDLINE", "ARCHIVE")
+	// bucketStorageClasses are the supported default storage classes of buckets.
+	bucketStorageClasses = transitionStorageClasses.Union(sets.New("STANDARD"))
+)
+
+// ValidateBackupBucketConfig validates a BackupBucketConfig object.
+func ValidateBackupBucketConfig(config *apisgcp.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.Location != nil && !multiRegionLocations.Has(*config.Location) && !dualRegionLocations.Has(*config.Location) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("location"), *config.Location, sets.List(multiRegionLocations.Union(dualRegionLocations))))
+	}
+	if config.TurboReplication && (config.Location == nil || !dualRegionLocations.Has(*config.Location)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("turboReplication"), "can only be enabled for dual-region locations"))
+	}
+
+	if config.StorageClass != nil && !bucketStorageClasses.Has(*config.StorageClass) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("storageClass"), *config.StorageClass, sets.List(bucketStorageClasses)))
+	}
+
+	if config.KmsKeyName != nil && !kmsKeyNameRegex.MatchString(*config.KmsKeyName) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("kmsKeyName"), *config.KmsKeyName, "must be the resource name of a Cloud KMS key, i.e. projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>"))
+	}
+
+	allErrs = append(allErrs, validateRetentionPolicy(config, fldPath)...)
+
+	if config.Lifecycle != nil {
+		allErrs = append(allErrs, validateBackupBucketLifecycle(config.Lifecycle, fldPath.Child("lifecycle"))...)
+	}
+
+	return allErrs
+}
+
+func validateRetentionPolicy(config *apisgcp.BackupBucketConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.RetentionPeriod == nil {
+		if config.Locked {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("locked"), "can only be set if a retention period is configured"))
+		}
+		return allErrs
+	}
+
+	retentionPeriodPath := fldPath.Child("retentionPeriod")
+	if d := config.RetentionPeriod.Duration; d < time.Second || d > maxRetentionPeriod {
+		allErrs = append(allErrs, field.Invalid(retentionPeriodPath, d.String(), fmt.Sprintf("must be between 1s and %s", maxRetentionPeriod)))
+	} else if d%time.Second != 0 {
+		allErrs = append(allErrs, field.Invalid(retentionPeriodPath, d.String(), "must be a multiple of one second"))
+	}
+
+	return allErrs
+}
+
+func validateBackupBucketLifecycle(lifecycle *apisgcp.BackupBucketLifecycle, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if lifecycle.DeleteAfterDays != nil && *lifecycle.DeleteAfterDays < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deleteAfterDays"), *lifecycle.DeleteAfterDays, "must be at least 1"))
+	}
+	if lifecycle.DeleteNoncurrentAfterDays != nil && *lifecycle.DeleteNoncurrentAfterDays < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deleteNoncurrentAfterDays"), *lifecycle.DeleteNoncurrentAfterDays, "must be at least 1"))
+	}
+
+	storageClasses := sets.New[string]()
+	for i, transition := range lifecycle.Transitions {
+		idxPath := fldPath.Child("transitions").Index(i)
+
+		if !transitionStorageClasses.Has(transition.StorageClass) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("storageClass"), transition.StorageClass, sets.List(transitionStorageClasses)))
+		} else if storageClasses.Has(transition.StorageClass) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("storageClass"), transition.StorageClass))
+		}
+		storageClasses.Insert(transition.StorageClass)
+
+		if transition.AfterDays < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("afterDays"), transition.AfterDays, "must be at least 1"))
+		} else if lifecycle.DeleteAfterDays != nil && transition.AfterDays >= *lifecycle.DeleteAfterDays {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("afterDays"), transition.AfterDays, "must be less than deleteAfterDays"))
+		}
+	}
+
+	return allErrs
+}
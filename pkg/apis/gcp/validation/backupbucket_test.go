@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+)
+
+var _ = Describe("BackupBucketConfig validation", func() {
+	var fldPath = field.NewPath("providerConfig")
+
+	It("should allow an empty configuration", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow a locked retention policy", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{
+			RetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+			Locked:          true,
+		}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid to lock a bucket without retention period", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{Locked: true}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.locked"),
+			})),
+		))
+	})
+
+	It("should allow multi-region and dual-region locations", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{Location: ptr.To("EU")}, fldPath)).To(BeEmpty())
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{Location: ptr.To("EUR4"), TurboReplication: true}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid unknown locations", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{Location: ptr.To("europe-west1")}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.location"),
+			})),
+		))
+	})
+
+	It("should forbid the turbo replication for locations other than dual-regions", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{TurboReplication: true}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.turboReplication"),
+			})),
+		))
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{Location: ptr.To("US"), TurboReplication: true}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("providerConfig.turboReplication"),
+			})),
+		))
+	})
+
+	It("should allow a supported storage class", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{StorageClass: ptr.To("COLDLINE")}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an unknown storage class", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{StorageClass: ptr.To("REGIONAL")}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.storageClass"),
+			})),
+		))
+	})
+
+	It("should allow a valid KMS key", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{
+			KmsKeyName: ptr.To("projects/foo/locations/europe/keyRings/bar/cryptoKeys/baz"),
+		}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid an invalid KMS key", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{
+			KmsKeyName: ptr.To("projects/foo/keyRings/bar"),
+		}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.kmsKeyName"),
+			})),
+		))
+	})
+
+	It("should allow valid lifecycle rules", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{
+			Lifecycle: &apisgcp.BackupBucketLifecycle{
+				DeleteAfterDays:           ptr.To[int32](365),
+				DeleteNoncurrentAfterDays: ptr.To[int32](7),
+				Transitions: []apisgcp.LifecycleTransition{
+					{StorageClass: "NEARLINE", AfterDays: 30},
+					{StorageClass: "COLDLINE", AfterDays: 90},
+				},
+			},
+		}, fldPath)).To(BeEmpty())
+	})
+
+	It("should forbid invalid lifecycle rules", func() {
+		Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{
+			Lifecycle: &apisgcp.BackupBucketLifecycle{
+				DeleteAfterDays:           ptr.To[int32](30),
+				DeleteNoncurrentAfterDays: ptr.To[int32](0),
+				Transitions: []apisgcp.LifecycleTransition{
+					{StorageClass: "STANDARD", AfterDays: 10},
+					{StorageClass: "NEARLINE", AfterDays: 0},
+					{StorageClass: "NEARLINE", AfterDays: 30},
+				},
+			},
+		}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.lifecycle.deleteNoncurrentAfterDays"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.lifecycle.transitions[0].storageClass"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.lifecycle.transitions[1].afterDays"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.lifecycle.transitions[2].storageClass"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.lifecycle.transitions[2].afterDays"),
+			})),
+		))
+	})
+
+	DescribeTable("should forbid invalid retention periods",
+		func(retentionPeriod time.Duration) {
+			Expect(ValidateBackupBucketConfig(&apisgcp.BackupBucketConfig{
+				RetentionPeriod: &metav1.Duration{Duration: retentionPeriod},
+			}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.retentionPeriod"),
+				})),
+			))
+		},
+		Entry("zero", time.Duration(0)),
+		Entry("negative", -time.Hour),
+		Entry("more than 100 years", 3155760001*time.Second),
+		Entry("fractions of seconds", 1500*time.Millisecond),
+	)
+})
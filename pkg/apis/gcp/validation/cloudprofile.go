@@ -6,6 +6,7 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/helper"
@@ -16,6 +17,15 @@ import (
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 )
 
+var (
+	// opsAgentVersionRegex matches the versions accepted by the Ops Agent installation script.
+	opsAgentVersionRegex = regexp.MustCompile(`^(latest|[0-9]+\.\*\.\*|[0-9]+\.[0-9]+\.[0-9]+)$`)
+	// imageRegex matches references of images with a tag or a digest.
+	imageRegex = regexp.MustCompile(`^[a-z0-9]+([._/:-][a-z0-9]+)*(:[\w][\w.-]{0,127}|@sha256:[a-f0-9]{64})$`)
+
+	supportedCSIDriverChannels = []string{string(apisgcp.CSIDriverChannelStable), string(apisgcp.CSIDriverChannelLatest)}
+)
+
 // ValidateCloudProfileConfig validates a CloudProfileConfig object.
 func ValidateCloudProfileConfig(cpConfig *apisgcp.CloudProfileConfig, machineImages []core.MachineImage, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -35,6 +45,20 @@ func ValidateCloudProfileConfig(cpConfig *apisgcp.CloudProfileConfig, machineIma
 		}
 	}
 
+	if cpConfig.OpsAgent != nil && cpConfig.OpsAgent.Version != nil && !opsAgentVersionRegex.MatchString(*cpConfig.OpsAgent.Version) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("opsAgent", "version"), *cpConfig.OpsAgent.Version, "must be 'latest', a major version like '2.*.*' or a full version like '2.46.0'"))
+	}
+
+	if cpConfig.CSIDriver != nil {
+		csiDriverPath := fldPath.Child("csiDriver")
+		if channel := cpConfig.CSIDriver.Channel; channel != nil && !slices.Contains(supportedCSIDriverChannels, string(*channel)) {
+			allErrs = append(allErrs, field.NotSupported(csiDriverPath.Child("channel"), *channel, supportedCSIDriverChannels))
+		}
+		if image := cpConfig.CSIDriver.Image; image != nil && !imageRegex.MatchString(*image) {
+			allErrs = append(allErrs, field.Invalid(csiDriverPath.Child("image"), *image, "must be a reference of an image with a tag or a digest"))
+		}
+	}
+
 	return allErrs
 }
 
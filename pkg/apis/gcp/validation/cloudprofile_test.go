@@ -115,5 +115,54 @@ var _ = Describe("CloudProfileConfig validation", func() {
 				))
 			})
 		})
+
+		Context("ops agent validation", func() {
+			It("should allow supported versions", func() {
+				for _, version := range []string{"latest", "2.*.*", "2.46.0"} {
+					cloudProfileConfig.OpsAgent = &apisgcp.OpsAgentConfig{Version: ptr.To(version)}
+					Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)).To(BeEmpty())
+				}
+			})
+
+			It("should forbid invalid versions", func() {
+				cloudProfileConfig.OpsAgent = &apisgcp.OpsAgentConfig{Version: ptr.To("2.46; rm -rf /")}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("opsAgent.version"),
+					})),
+				))
+			})
+		})
+
+		Context("CSI driver validation", func() {
+			It("should allow channels and pinned images", func() {
+				cloudProfileConfig.CSIDriver = &apisgcp.CSIDriverConfig{
+					Channel: ptr.To(apisgcp.CSIDriverChannelLatest),
+					Image:   ptr.To("registry.k8s.io/cloud-provider-gcp/gcp-compute-persistent-disk-csi-driver:v1.14.2"),
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)).To(BeEmpty())
+			})
+
+			It("should forbid unknown channels and invalid images", func() {
+				cloudProfileConfig.CSIDriver = &apisgcp.CSIDriverConfig{
+					Channel: ptr.To(apisgcp.CSIDriverChannel("beta")),
+					Image:   ptr.To("registry.k8s.io/cloud-provider-gcp/gcp-compute-persistent-disk-csi-driver"),
+				}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, machineImages, nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("csiDriver.channel"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("csiDriver.image"),
+					})),
+				))
+			})
+		})
 	})
 })
@@ -5,14 +5,75 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 )
 
+// maxNetworkTags is the maximum number of network tags of a VM.
+const maxNetworkTags = 64
+
+var (
+	// storageLocationRegex matches the names of regions and multi-regions of Cloud Storage.
+	storageLocationRegex = regexp.MustCompile(`^[a-z]+(-[a-z]+[0-9]+)?$`)
+	// kmsKeyNameRegex matches the resource name of a Cloud KMS key.
+	kmsKeyNameRegex = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+$`)
+	// auditLogExportDestinationRegex matches the destinations of Cloud Logging sinks supported for the export of audit
+	// logs, i.e. log buckets and Pub/Sub topics.
+	auditLogExportDestinationRegex = regexp.MustCompile(`^(logging\.googleapis\.com/projects/[^/]+/locations/[^/]+/buckets/[^/]+|pubsub\.googleapis\.com/projects/[^/]+/topics/[^/]+)$`)
+	// nodeInstancePrefixRegex matches the prefixes of the names of VMs.
+	nodeInstancePrefixRegex = regexp.MustCompile(`^[a-z][-a-z0-9]{0,62}$`)
+
+	// supportedDiskTypes are the disk types supported by the StorageClasses of the persistent disk CSI driver.
+	supportedDiskTypes = []string{"pd-standard", "pd-balanced", "pd-ssd", "pd-extreme", "hyperdisk-balanced", "hyperdisk-throughput", "hyperdisk-extreme", "hyperdisk-ml"}
+	// diskTypesWithProvisionedIOPS and diskTypesWithProvisionedThroughput are the disk types for which IOPS and
+	// throughput can be provisioned.
+	diskTypesWithProvisionedIOPS       = []string{"pd-extreme", "hyperdisk-balanced", "hyperdisk-extreme"}
+	diskTypesWithProvisionedThroughput = []string{"hyperdisk-balanced", "hyperdisk-throughput", "hyperdisk-ml"}
+	// diskTypesWithRegionalReplication are the disk types which can be replicated to two zones of a region.
+	diskTypesWithRegionalReplication = []string{"pd-standard", "pd-balanced", "pd-ssd"}
+
+	// csiControllerContainers, csiFilestoreControllerContainers and csiNodeContainers are the containers of the CSI
+	// drivers whose resource requirements can be overridden.
+	csiControllerContainers          = []string{"driver", "provisioner", "attacher", "snapshotter", "resizer", "livenessProbe"}
+	csiFilestoreControllerContainers = []string{"driver", "provisioner", "resizer", "livenessProbe"}
+	csiNodeContainers                = []string{"driver", "nodeDriverRegistrar", "livenessProbe"}
+)
+
+// cloudControllerManagerFlags are the command line flags of the cloud-controller-manager which can be configured,
+// together with a function validating their values.
+var cloudControllerManagerFlags = map[string]func(string) error{
+	"concurrent-service-syncs":     validateNonNegativeInt,
+	"kube-api-burst":               validateNonNegativeInt,
+	"kube-api-qps":                 validateNonNegativeInt,
+	"min-resync-period":            validateDuration,
+	"node-monitor-period":          validateDuration,
+	"node-status-update-frequency": validateDuration,
+	"node-sync-period":             validateDuration,
+	"route-reconciliation-period":  validateDuration,
+	"v":                            validateNonNegativeInt,
+}
+
+const (
+	replicationTypeNone       = "none"
+	replicationTypeRegionalPD = "regional-pd"
+
+	// filestoreStorageClassName is the name of the StorageClass deployed together with the Filestore CSI driver.
+	filestoreStorageClassName = "gce-sc-filestore"
+)
+
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
 func ValidateControlPlaneConfig(controlPlaneConfig *apisgcp.ControlPlaneConfig, allowedZones, workerZones sets.Set[string], version string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -29,7 +90,272 @@ func ValidateControlPlaneConfig(controlPlaneConfig *apisgcp.ControlPlaneConfig,
 
 	if controlPlaneConfig.CloudControllerManager != nil {
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
+		allErrs = append(allErrs, validateCloudControllerManagerFlags(controlPlaneConfig.CloudControllerManager.Flags, fldPath.Child("cloudControllerManager", "flags"))...)
+		if resources := controlPlaneConfig.CloudControllerManager.Resources; resources != nil {
+			allErrs = append(allErrs, validateResourceRequirements(*resources, fldPath.Child("cloudControllerManager", "resources"))...)
+		}
+		if cloudConfig := controlPlaneConfig.CloudControllerManager.CloudConfig; cloudConfig != nil {
+			allErrs = append(allErrs, validateCloudConfig(cloudConfig, fldPath.Child("cloudControllerManager", "cloudConfig"))...)
+		}
+	}
+
+	if controlPlaneConfig.Storage != nil {
+		allErrs = append(allErrs, validateStorage(controlPlaneConfig.Storage, fldPath.Child("storage"))...)
+	}
+
+	if controlPlaneConfig.InternalLoadBalancer != nil && controlPlaneConfig.InternalLoadBalancer.Subnet != nil {
+		subnetPath := fldPath.Child("internalLoadBalancer", "subnet")
+		for _, msg := range k8svalidation.IsDNS1035Label(*controlPlaneConfig.InternalLoadBalancer.Subnet) {
+			allErrs = append(allErrs, field.Invalid(subnetPath, *controlPlaneConfig.InternalLoadBalancer.Subnet, msg))
+		}
+	}
+
+	if controlPlaneConfig.AuditLogExport != nil {
+		destination := controlPlaneConfig.AuditLogExport.Destination
+		if !auditLogExportDestinationRegex.MatchString(destination) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("auditLogExport", "destination"), destination,
+				"must be a log bucket (logging.googleapis.com/projects/<project>/locations/<location>/buckets/<bucket>) or a Pub/Sub topic (pubsub.googleapis.com/projects/<project>/topics/<topic>)"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateStorage(storage *apisgcp.Storage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if storage.VolumeSnapshotClass != nil {
+		allErrs = append(allErrs, validateVolumeSnapshotClass(storage.VolumeSnapshotClass, fldPath.Child("volumeSnapshotClass"))...)
+	}
+
+	if storage.CSIDriverResources != nil {
+		allErrs = append(allErrs, validateCSIDriverResources(storage.CSIDriverResources, csiControllerContainers, fldPath.Child("csiDriverResources"))...)
+	}
+
+	if storage.Filestore != nil && storage.Filestore.Resources != nil {
+		allErrs = append(allErrs, validateCSIDriverResources(storage.Filestore.Resources, csiFilestoreControllerContainers, fldPath.Child("filestore", "resources"))...)
+	}
+
+	if len(storage.StorageClasses) == 0 {
+		return allErrs
+	}
+
+	if storage.ManagedDefaultStorageClass != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("managedDefaultStorageClass"), "must not be set together with storageClasses, use the default field of the StorageClasses instead"))
+	}
+
+	var (
+		names    = sets.New[string]()
+		defaults int
+	)
+	for i, class := range storage.StorageClasses {
+		idxPath := fldPath.Child("storageClasses").Index(i)
+
+		switch {
+		case class.Name == "":
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the StorageClass"))
+		case names.Has(class.Name):
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), class.Name))
+		case class.Name == filestoreStorageClassName && storage.Filestore != nil && storage.Filestore.Enabled:
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), "is the name of the StorageClass of the Filestore CSI driver"))
+		default:
+			for _, msg := range k8svalidation.IsDNS1123Subdomain(class.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), class.Name, msg))
+			}
+		}
+		names.Insert(class.Name)
+
+		if class.Default {
+			defaults++
+		}
+
+		allErrs = append(allErrs, validateStorageClass(class, idxPath)...)
+	}
+
+	if defaults > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("storageClasses"), defaults, "at most one StorageClass can be marked as default"))
+	}
+
+	return allErrs
+}
+
+func validateStorageClass(class apisgcp.StorageClass, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if class.Type == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must provide the type of the disks"))
+		return allErrs
+	}
+	if !slices.Contains(supportedDiskTypes, class.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), class.Type, supportedDiskTypes))
+		return allErrs
+	}
+
+	if class.ReplicationType != nil {
+		switch *class.ReplicationType {
+		case replicationTypeNone:
+		case replicationTypeRegionalPD:
+			if !slices.Contains(diskTypesWithRegionalReplication, class.Type) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("replicationType"), "regional replication is not supported for disks of type "+class.Type))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("replicationType"), *class.ReplicationType, []string{replicationTypeNone, replicationTypeRegionalPD}))
+		}
+	}
+
+	if class.ProvisionedIOPS != nil {
+		if !slices.Contains(diskTypesWithProvisionedIOPS, class.Type) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("provisionedIOPS"), "IOPS can only be provisioned for disks of type "+strings.Join(diskTypesWithProvisionedIOPS, ", ")))
+		} else if *class.ProvisionedIOPS <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("provisionedIOPS"), *class.ProvisionedIOPS, "must be positive"))
+		}
+	}
+
+	if class.ProvisionedThroughput != nil {
+		if !slices.Contains(diskTypesWithProvisionedThroughput, class.Type) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("provisionedThroughput"), "throughput can only be provisioned for disks of type "+strings.Join(diskTypesWithProvisionedThroughput, ", ")))
+		} else if *class.ProvisionedThroughput <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("provisionedThroughput"), *class.ProvisionedThroughput, "must be positive"))
+		}
+	}
+
+	if class.KmsKeyName != nil && !kmsKeyNameRegex.MatchString(*class.KmsKeyName) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("kmsKeyName"), *class.KmsKeyName, "must be the resource name of a Cloud KMS key, i.e. projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>"))
+	}
+
+	return allErrs
+}
+
+func validateCSIDriverResources(resources *apisgcp.CSIDriverResources, controllerContainers []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, container := range sets.List(sets.KeySet(resources.Controller)) {
+		if !slices.Contains(controllerContainers, container) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("controller").Key(container), container, controllerContainers))
+			continue
+		}
+		allErrs = append(allErrs, validateResourceRequirements(resources.Controller[container], fldPath.Child("controller").Key(container))...)
+	}
+
+	for _, container := range sets.List(sets.KeySet(resources.Node)) {
+		if !slices.Contains(csiNodeContainers, container) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("node").Key(container), container, csiNodeContainers))
+			continue
+		}
+		allErrs = append(allErrs, validateResourceRequirements(resources.Node[container], fldPath.Child("node").Key(container))...)
+	}
+
+	return allErrs
+}
+
+// validateResourceRequirements validates resource requirements overriding the defaults of a container. Only CPU and
+// memory can be configured, and requests must not exceed the limits given for the same resource.
+func validateResourceRequirements(resources corev1.ResourceRequirements, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	supportedResources := []string{string(corev1.ResourceCPU), string(corev1.ResourceMemory)}
+
+	for _, list := range []struct {
+		name      string
+		resources corev1.ResourceList
+	}{
+		{"requests", resources.Requests},
+		{"limits", resources.Limits},
+	} {
+		for name, quantity := range list.resources {
+			resPath := fldPath.Child(list.name).Key(string(name))
+			if !slices.Contains(supportedResources, string(name)) {
+				allErrs = append(allErrs, field.NotSupported(resPath, name, supportedResources))
+				continue
+			}
+			if quantity.Sign() <= 0 {
+				allErrs = append(allErrs, field.Invalid(resPath, quantity.String(), "must be greater than zero"))
+			}
+		}
+	}
+
+	for name, request := range resources.Requests {
+		if limit, ok := resources.Limits[name]; ok && request.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests").Key(string(name)), request.String(), "must be less than or equal to the limit"))
+		}
+	}
+
+	if len(resources.Claims) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("claims"), "resource claims are not supported"))
+	}
+
+	return allErrs
+}
+
+func validateCloudConfig(cloudConfig *apisgcp.CloudConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	nodeTagsPath := fldPath.Child("nodeTags")
+	if len(cloudConfig.NodeTags) > maxNetworkTags {
+		allErrs = append(allErrs, field.TooMany(nodeTagsPath, len(cloudConfig.NodeTags), maxNetworkTags))
+	}
+	nodeTags := sets.New[string]()
+	for i, tag := range cloudConfig.NodeTags {
+		for _, msg := range k8svalidation.IsDNS1035Label(tag) {
+			allErrs = append(allErrs, field.Invalid(nodeTagsPath.Index(i), tag, msg))
+		}
+		if nodeTags.Has(tag) {
+			allErrs = append(allErrs, field.Duplicate(nodeTagsPath.Index(i), tag))
+		}
+		nodeTags.Insert(tag)
+	}
+
+	if prefix := cloudConfig.NodeInstancePrefix; prefix != nil && !nodeInstancePrefixRegex.MatchString(*prefix) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeInstancePrefix"), *prefix,
+			"must start with a lowercase letter and consist of at most 63 lowercase letters, digits and dashes"))
+	}
+
+	if name := cloudConfig.SecondaryRangeName; name != nil {
+		for _, msg := range k8svalidation.IsDNS1035Label(*name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secondaryRangeName"), *name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCloudControllerManagerFlags(flags map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, flag := range sets.List(sets.KeySet(flags)) {
+		validateValue, ok := cloudControllerManagerFlags[flag]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(flag), flag, sets.List(sets.KeySet(cloudControllerManagerFlags))))
+			continue
+		}
+		if err := validateValue(flags[flag]); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(flag), flags[flag], err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNonNegativeInt(value string) error {
+	if i, err := strconv.Atoi(value); err != nil || i < 0 {
+		return fmt.Errorf("must be a non-negative integer")
+	}
+	return nil
+}
+
+func validateDuration(value string) error {
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		return fmt.Errorf("must be a positive duration, e.g. '30s'")
+	}
+	return nil
+}
+
+func validateVolumeSnapshotClass(config *apisgcp.VolumeSnapshotClassConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.StorageLocation != nil && !storageLocationRegex.MatchString(*config.StorageLocation) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("storageLocation"), *config.StorageLocation, "must be the name of a region or multi-region, e.g. 'europe-west1' or 'eu'"))
 	}
+	allErrs = append(allErrs, validateResourceLabels(config.Labels, fldPath.Child("labels"))...)
 
 	return allErrs
 }
@@ -8,8 +8,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
@@ -94,6 +97,327 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				})),
 			))
 		})
+
+		It("should allow supported CCM flags", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				Flags: map[string]string{
+					"concurrent-service-syncs": "20",
+					"node-monitor-period":      "10s",
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should fail with unsupported or invalid CCM flags", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				Flags: map[string]string{
+					"cloud-config":             "/tmp/foo",
+					"concurrent-service-syncs": "many",
+					"node-monitor-period":      "-1s",
+				},
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("cloudControllerManager.flags[cloud-config]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.flags[concurrent-service-syncs]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.flags[node-monitor-period]"),
+				})),
+			))
+		})
+
+		It("should allow customizing the cloud-config of the CCM", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				CloudConfig: &apisgcp.CloudConfig{
+					NodeTags:           []string{"shoot--foo--bar", "lb-targets"},
+					NodeInstancePrefix: ptr.To("shoot--foo--bar-"),
+					SecondaryRangeName: ptr.To("pods"),
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with an invalid cloud-config of the CCM", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				CloudConfig: &apisgcp.CloudConfig{
+					NodeTags:           []string{"lb-targets", "LB_Targets", "lb-targets"},
+					NodeInstancePrefix: ptr.To("-foo"),
+					SecondaryRangeName: ptr.To("Pods"),
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "1.28.2", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.cloudConfig.nodeTags[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("cloudControllerManager.cloudConfig.nodeTags[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.cloudConfig.nodeInstancePrefix"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.cloudConfig.secondaryRangeName"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig InternalLoadBalancer", func() {
+		It("should allow the defaults of internal load balancers", func() {
+			controlPlane.InternalLoadBalancer = &apisgcp.InternalLoadBalancerConfig{
+				Subnet:       ptr.To("my-ilb-subnet"),
+				GlobalAccess: ptr.To(true),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid subnet names", func() {
+			controlPlane.InternalLoadBalancer = &apisgcp.InternalLoadBalancerConfig{
+				Subnet: ptr.To("My_Subnet"),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("internalLoadBalancer.subnet"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig AuditLogExport", func() {
+		It("should allow log buckets and Pub/Sub topics as destination", func() {
+			for _, destination := range []string{
+				"logging.googleapis.com/projects/my-project/locations/europe-west1/buckets/audit",
+				"pubsub.googleapis.com/projects/my-project/topics/audit",
+			} {
+				controlPlane.AuditLogExport = &apisgcp.AuditLogExport{Destination: destination}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+			}
+		})
+
+		It("should forbid other destinations", func() {
+			controlPlane.AuditLogExport = &apisgcp.AuditLogExport{Destination: "storage.googleapis.com/my-bucket"}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("auditLogExport.destination"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig VolumeSnapshotClass", func() {
+		It("should allow a storage location and labels", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				VolumeSnapshotClass: &apisgcp.VolumeSnapshotClassConfig{
+					StorageLocation: ptr.To("eu"),
+					Labels:          map[string]string{"backup": "daily"},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid storage locations and labels", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				VolumeSnapshotClass: &apisgcp.VolumeSnapshotClassConfig{
+					StorageLocation: ptr.To("Europe West"),
+					Labels:          map[string]string{"Backup": "daily", "k8s-cluster-name": "foo"},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.volumeSnapshotClass.storageLocation"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.volumeSnapshotClass.labels[Backup]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.volumeSnapshotClass.labels[k8s-cluster-name]"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig StorageClasses", func() {
+		It("should allow valid StorageClasses", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				StorageClasses: []apisgcp.StorageClass{
+					{Name: "default", Default: true, Type: "pd-balanced", ReplicationType: ptr.To("regional-pd")},
+					{Name: "fast", Type: "hyperdisk-balanced", ProvisionedIOPS: ptr.To[int64](5000), ProvisionedThroughput: ptr.To[int64](250)},
+					{Name: "encrypted", Type: "pd-ssd", KmsKeyName: ptr.To("projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz")},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid StorageClasses", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				ManagedDefaultStorageClass: ptr.To(true),
+				StorageClasses: []apisgcp.StorageClass{
+					{Name: "default", Default: true, Type: "pd-balanced"},
+					{Name: "default", Default: true, Type: "pd-foo"},
+					{Name: "extreme", Type: "pd-extreme", ReplicationType: ptr.To("regional-pd"), ProvisionedThroughput: ptr.To[int64](100)},
+					{Name: "Encrypted", Type: "pd-ssd", ProvisionedIOPS: ptr.To[int64](1000), KmsKeyName: ptr.To("foo")},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.managedDefaultStorageClass"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("storage.storageClasses[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storageClasses[1].type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[2].replicationType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[2].provisionedThroughput"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storageClasses[3].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[3].provisionedIOPS"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storageClasses[3].kmsKeyName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storageClasses"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateControlPlaneConfig resources", func() {
+		It("should allow overriding the resources of the CCM and the CSI drivers", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			}
+			controlPlane.Storage = &apisgcp.Storage{
+				CSIDriverResources: &apisgcp.CSIDriverResources{
+					Controller: map[string]corev1.ResourceRequirements{
+						"provisioner": {Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")}},
+					},
+					Node: map[string]corev1.ResourceRequirements{
+						"driver": {Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("5m")}},
+					},
+				},
+				Filestore: &apisgcp.Filestore{
+					Enabled: true,
+					Resources: &apisgcp.CSIDriverResources{
+						Controller: map[string]corev1.ResourceRequirements{
+							"resizer": {Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("5m")}},
+						},
+					},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid resources", func() {
+			controlPlane.CloudControllerManager = &apisgcp.CloudControllerManagerConfig{
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:              resource.MustParse("2"),
+						corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("0"),
+					},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("cloudControllerManager.resources.requests[ephemeral-storage]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.resources.limits[memory]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("cloudControllerManager.resources.requests[cpu]"),
+				})),
+			))
+		})
+
+		It("should forbid unknown containers of the CSI drivers", func() {
+			controlPlane.Storage = &apisgcp.Storage{
+				CSIDriverResources: &apisgcp.CSIDriverResources{
+					Node: map[string]corev1.ResourceRequirements{
+						"provisioner": {},
+					},
+				},
+				Filestore: &apisgcp.Filestore{
+					Enabled: true,
+					Resources: &apisgcp.CSIDriverResources{
+						Controller: map[string]corev1.ResourceRequirements{
+							"snapshotter": {},
+						},
+					},
+				},
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, allowedZones, workerZones, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.csiDriverResources.node[provisioner]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.filestore.resources.controller[snapshotter]"),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateControlPlaneConfigUpdate", func() {
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"net"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+var (
+	// regionalLoadBalancerTypes are the types of regional internal load balancers which can be health checked by Cloud DNS.
+	regionalLoadBalancerTypes = sets.New("regionalL4ilb", "regionalL7ilb")
+	// loadBalancerTypes are the types of internal load balancers which can be health checked by Cloud DNS.
+	loadBalancerTypes = regionalLoadBalancerTypes.Union(sets.New("globalL7ilb"))
+	// loadBalancerProtocols are the protocols of internal load balancers which can be health checked by Cloud DNS.
+	loadBalancerProtocols = sets.New("tcp", "udp")
+)
+
+// ValidateDNSRecordConfig validates a DNSRecordConfig object.
+func ValidateDNSRecordConfig(config *apisgcp.DNSRecordConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.RoutingPolicy != nil {
+		allErrs = append(allErrs, validateDNSRoutingPolicy(config.RoutingPolicy, fldPath.Child("routingPolicy"))...)
+	}
+
+	return allErrs
+}
+
+func validateDNSRoutingPolicy(policy *apisgcp.DNSRoutingPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var policies int
+	if len(policy.Weighted) > 0 {
+		policies++
+		for i, target := range policy.Weighted {
+			idxPath := fldPath.Child("weighted").Index(i)
+			if target.Weight < 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), target.Weight, "must not be negative"))
+			}
+			if len(target.Values) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("values"), "at least one value is required"))
+			}
+		}
+	}
+	if len(policy.Geo) > 0 {
+		policies++
+		allErrs = append(allErrs, validateDNSGeoTargets(policy.Geo, fldPath.Child("geo"))...)
+	}
+	if policy.Failover != nil {
+		policies++
+		allErrs = append(allErrs, validateDNSFailoverPolicy(policy.Failover, fldPath.Child("failover"))...)
+	}
+
+	if policies != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, policies, "exactly one of weighted, geo or failover must be set"))
+	}
+
+	return allErrs
+}
+
+func validateDNSGeoTargets(targets []apisgcp.DNSGeoTarget, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	locations := sets.New[string]()
+	for i, target := range targets {
+		idxPath := fldPath.Index(i)
+		if target.Location == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("location"), "location is required"))
+		} else if locations.Has(target.Location) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("location"), target.Location))
+		}
+		locations.Insert(target.Location)
+
+		if len(target.Values) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("values"), "at least one value is required"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDNSFailoverPolicy(policy *apisgcp.DNSFailoverPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(policy.Primary) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("primary"), "at least one primary target is required"))
+	}
+	for i, target := range policy.Primary {
+		allErrs = append(allErrs, validateDNSLoadBalancerTarget(target, fldPath.Child("primary").Index(i))...)
+	}
+
+	if len(policy.Backup) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("backup"), "at least one backup target is required"))
+	}
+	allErrs = append(allErrs, validateDNSGeoTargets(policy.Backup, fldPath.Child("backup"))...)
+
+	if p := policy.TrickleTrafficPercent; p != nil && (*p < 0 || *p > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("trickleTrafficPercent"), *p, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+func validateDNSLoadBalancerTarget(target apisgcp.DNSLoadBalancerTarget, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	lbType := ptr.Deref(target.Type, "regionalL4ilb")
+	if !loadBalancerTypes.Has(lbType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), lbType, sets.List(loadBalancerTypes)))
+	}
+	if target.Protocol != nil && !loadBalancerProtocols.Has(*target.Protocol) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), *target.Protocol, sets.List(loadBalancerProtocols)))
+	}
+	if net.ParseIP(target.IPAddress) == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipAddress"), target.IPAddress, "must be a valid IP address"))
+	}
+	if port, err := strconv.Atoi(target.Port); err != nil || port < 1 || port > 65535 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), target.Port, "must be a valid port number"))
+	}
+	if target.Network == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("network"), "network is required"))
+	}
+	if target.Project == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("project"), "project is required"))
+	}
+	if regionalLoadBalancerTypes.Has(lbType) && target.Region == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("region"), "region is required for regional load balancers"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+)
+
+var _ = Describe("DNSRecordConfig validation", func() {
+	var (
+		fldPath = field.NewPath("providerConfig")
+
+		loadBalancer = apisgcp.DNSLoadBalancerTarget{
+			IPAddress: "10.0.0.10",
+			Port:      "443",
+			Network:   "https://www.googleapis.com/compute/v1/projects/project/global/networks/vpc",
+			Project:   "project",
+			Region:    "europe-west1",
+		}
+	)
+
+	It("should allow an empty configuration", func() {
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should allow weighted, geo and failover policies", func() {
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+			Weighted: []apisgcp.DNSWeightedTarget{{Weight: 90, Values: []string{"1.2.3.4"}}, {Weight: 10, Values: []string{"5.6.7.8"}}},
+		}}, fldPath)).To(BeEmpty())
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+			Geo: []apisgcp.DNSGeoTarget{{Location: "europe-west1", Values: []string{"1.2.3.4"}}, {Location: "us-east1", Values: []string{"5.6.7.8"}}},
+		}}, fldPath)).To(BeEmpty())
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+			Failover: &apisgcp.DNSFailoverPolicy{
+				Primary:               []apisgcp.DNSLoadBalancerTarget{loadBalancer},
+				Backup:                []apisgcp.DNSGeoTarget{{Location: "us-east1", Values: []string{"10.1.0.10"}}},
+				TrickleTrafficPercent: ptr.To[int32](5),
+			},
+		}}, fldPath)).To(BeEmpty())
+	})
+
+	It("should require exactly one policy", func() {
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+			Weighted: []apisgcp.DNSWeightedTarget{{Weight: 1, Values: []string{"1.2.3.4"}}},
+			Geo:      []apisgcp.DNSGeoTarget{{Location: "europe-west1", Values: []string{"1.2.3.4"}}},
+		}}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.routingPolicy"),
+			})),
+		))
+	})
+
+	It("should forbid negative weights, duplicate locations and targets without values", func() {
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+			Weighted: []apisgcp.DNSWeightedTarget{{Weight: -1, Values: []string{"1.2.3.4"}}, {Weight: 1}},
+		}}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.routingPolicy.weighted[0].weight"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.routingPolicy.weighted[1].values"),
+			})),
+		))
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+			Geo: []apisgcp.DNSGeoTarget{{Location: "europe-west1", Values: []string{"1.2.3.4"}}, {Location: "europe-west1", Values: []string{"5.6.7.8"}}},
+		}}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("providerConfig.routingPolicy.geo[1].location"),
+			})),
+		))
+	})
+
+	It("should validate the targets of failover policies", func() {
+		invalid := loadBalancer
+		invalid.Type = ptr.To("externalLb")
+		invalid.IPAddress = "foo"
+		invalid.Port = "0"
+
+		global := loadBalancer
+		global.Type = ptr.To("globalL7ilb")
+		global.Region = ""
+
+		regional := loadBalancer
+		regional.Region = ""
+
+		Expect(ValidateDNSRecordConfig(&apisgcp.DNSRecordConfig{RoutingPolicy: &apisgcp.DNSRoutingPolicy{
+			Failover: &apisgcp.DNSFailoverPolicy{
+				Primary:               []apisgcp.DNSLoadBalancerTarget{invalid, global, regional},
+				TrickleTrafficPercent: ptr.To[int32](101),
+			},
+		}}, fldPath)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("providerConfig.routingPolicy.failover.primary[0].type"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.routingPolicy.failover.primary[0].ipAddress"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.routingPolicy.failover.primary[0].port"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.routingPolicy.failover.primary[2].region"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("providerConfig.routingPolicy.failover.backup"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("providerConfig.routingPolicy.failover.trickleTrafficPercent"),
+			})),
+		))
+	})
+})
@@ -5,13 +5,90 @@
 package validation
 
 import (
+	"fmt"
+	"net"
 	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	netutils "k8s.io/utils/net"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+)
+
+const (
+	// staticMinPortsPerVMLowerBound is the lowest number of ports which can be allocated to a VM with static port allocation.
+	staticMinPortsPerVMLowerBound = 2
+	// dynamicMinPortsPerVMLowerBound and dynamicMinPortsPerVMUpperBound limit the minimum number of ports allocated to a
+	// VM with dynamic port allocation.
+	dynamicMinPortsPerVMLowerBound = 32
+	dynamicMinPortsPerVMUpperBound = 32768
+	// dynamicMaxPortsPerVMLowerBound is the lowest maximum number of ports allocated to a VM with dynamic port allocation.
+	dynamicMaxPortsPerVMLowerBound = 64
+	// portsPerVMUpperBound is the highest number of ports which can be allocated to a VM.
+	portsPerVMUpperBound = 65536
+	// vpcMTULowerBound and vpcMTUUpperBound limit the maximum transmission unit of a VPC.
+	vpcMTULowerBound = 1300
+	vpcMTUUpperBound = 8896
+	// maxResourceLabels is the maximum number of resource labels, so that the labels added by the extension do not
+	// exceed the maximum of 64 labels of a GCP resource.
+	maxResourceLabels = 60
+)
+
+var (
+	// natLoggingFilters are the supported filters of the CloudNAT logs.
+	natLoggingFilters = []string{"ERRORS_ONLY", "TRANSLATIONS_ONLY", "ALL"}
+	// serviceAttachmentRegex matches the (partial) URI of a service attachment of Private Service Connect.
+	serviceAttachmentRegex = regexp.MustCompile(`^(https://www\.googleapis\.com/compute/v1/)?projects/[^/]+/regions/[^/]+/serviceAttachments/[^/]+$`)
+	// forwardingRuleRegex matches the (partial) URI of a regional forwarding rule.
+	forwardingRuleRegex = regexp.MustCompile(`^(https://www\.googleapis\.com/compute/v1/)?projects/[^/]+/regions/[^/]+/forwardingRules/[^/]+$`)
+	// nccHubRegex matches the resource name of a Network Connectivity Center hub.
+	nccHubRegex = regexp.MustCompile(`^projects/[^/]+/locations/global/hubs/[^/]+$`)
+	// networkRegex matches the (partial) URI of a VPC network.
+	networkRegex = regexp.MustCompile(`^(https://www\.googleapis\.com/compute/v1/)?projects/[^/]+/global/networks/[^/]+$`)
+	// vpcRoutingModes are the supported dynamic routing modes of a VPC.
+	vpcRoutingModes = []string{"REGIONAL", "GLOBAL"}
+	// cloudRouterAdvertiseModes are the supported modes of the route advertisements of a CloudRouter.
+	cloudRouterAdvertiseModes = []string{"DEFAULT", "CUSTOM"}
+	// cloudRouterAdvertisedGroups are the groups of routes which can be advertised by a CloudRouter.
+	cloudRouterAdvertisedGroups = []string{"ALL_SUBNETS"}
+	// firewallRuleDirections are the supported directions of additional firewall rules.
+	firewallRuleDirections = []string{"INGRESS", "EGRESS"}
+	// firewallRuleProtocols are the protocols which can be specified by name in additional firewall rules.
+	firewallRuleProtocols = []string{"tcp", "udp", "icmp", "esp", "ah", "sctp", "ipip", "all"}
+	// firewallRuleProtocolsWithPorts are the protocols for which ports can be specified in additional firewall rules.
+	firewallRuleProtocolsWithPorts = []string{"tcp", "udp", "sctp"}
+	// firewallPolicyRuleActions are the supported actions of the rules of network firewall policies.
+	firewallPolicyRuleActions = []string{"allow", "deny", "goto_next"}
+	// packetMirroringDirections are the supported directions of the traffic mirrored by packet mirroring policies.
+	packetMirroringDirections = []string{"INGRESS", "EGRESS", "BOTH"}
+	// packetMirroringProtocols are the protocols which can be specified by name in the filter of packet mirroring
+	// policies.
+	packetMirroringProtocols = []string{"tcp", "udp", "icmp", "esp", "ah", "sctp", "ipip"}
+	// labelKeyRegex and labelValueRegex match the keys and values of labels of GCP resources.
+	labelKeyRegex   = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+	labelValueRegex = regexp.MustCompile(`^[a-z0-9_-]{0,63}$`)
+	// reservedResourceLabelKeys are the keys of the labels which are added by the extension to the GCP resources of a
+	// shoot and hence must not be used by resource labels.
+	reservedResourceLabelKeys = []string{"name", "k8s-cluster-name", "gardener-shoot", "gardener-project"}
+	// reservedFirewallRuleNames are the names of the firewall rules created by the extension, which must not be used by
+	// additional firewall rules.
+	reservedFirewallRuleNames = []string{
+		"allow-internal-access",
+		"allow-external-access",
+		"allow-health-checks",
+		"allow-internal-access-ipv6",
+		"allow-external-access-ipv6",
+		"allow-health-checks-ipv6",
+	}
 )
 
 // ValidateInfrastructureConfig validates a InfrastructureConfig object.
@@ -38,7 +115,7 @@ func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR
 	}
 
 	networksPath := fldPath.Child("networks")
-	if len(infra.Networks.Worker) == 0 && len(infra.Networks.Workers) == 0 {
+	if len(infra.Networks.Worker) == 0 && len(infra.Networks.Workers) == 0 && len(infra.Networks.Zones) == 0 {
 		allErrs = append(allErrs, field.Required(networksPath.Child("workers"), "must specify the network range for the worker network"))
 	}
 
@@ -54,8 +131,9 @@ func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(networksPath.Child("workers"), infra.Networks.Workers)...)
 	}
 
+	var internalCIDR cidrvalidation.CIDR
 	if infra.Networks.Internal != nil {
-		internalCIDR := cidrvalidation.NewCIDR(*infra.Networks.Internal, networksPath.Child("internal"))
+		internalCIDR = cidrvalidation.NewCIDR(*infra.Networks.Internal, networksPath.Child("internal"))
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(internalCIDR)...)
 		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(networksPath.Child("internal"), *infra.Networks.Internal)...)
 		if pods != nil {
@@ -67,13 +145,44 @@ func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR
 		if nodes != nil {
 			allErrs = append(allErrs, nodes.ValidateNotOverlap(internalCIDR)...)
 		}
-		allErrs = append(allErrs, workerCIDR.ValidateNotOverlap(internalCIDR)...)
+		if workerCIDR != nil {
+			allErrs = append(allErrs, workerCIDR.ValidateNotOverlap(internalCIDR)...)
+		}
 	}
 
-	if nodes != nil {
+	if infra.Networks.ProxyOnly != nil {
+		proxyOnlyCIDR := cidrvalidation.NewCIDR(*infra.Networks.ProxyOnly, networksPath.Child("proxyOnly"))
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(proxyOnlyCIDR)...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(networksPath.Child("proxyOnly"), *infra.Networks.ProxyOnly)...)
+		if netutils.IsIPv6CIDRString(*infra.Networks.ProxyOnly) {
+			allErrs = append(allErrs, field.Invalid(networksPath.Child("proxyOnly"), *infra.Networks.ProxyOnly, "must be an IPv4 range"))
+		}
+		if pods != nil {
+			allErrs = append(allErrs, pods.ValidateNotOverlap(proxyOnlyCIDR)...)
+		}
+		if services != nil {
+			allErrs = append(allErrs, services.ValidateNotOverlap(proxyOnlyCIDR)...)
+		}
+		if nodes != nil {
+			allErrs = append(allErrs, nodes.ValidateNotOverlap(proxyOnlyCIDR)...)
+		}
+		if workerCIDR != nil {
+			allErrs = append(allErrs, workerCIDR.ValidateNotOverlap(proxyOnlyCIDR)...)
+		}
+		if internalCIDR != nil {
+			allErrs = append(allErrs, internalCIDR.ValidateNotOverlap(proxyOnlyCIDR)...)
+		}
+	}
+
+	// IPv6 node ranges are assigned by GCP to the worker subnet and hence cannot be part of the IPv4 workers range.
+	if nodes != nil && workerCIDR != nil && !netutils.IsIPv6CIDRString(*nodesCIDR) {
 		allErrs = append(allErrs, nodes.ValidateSubset(workerCIDR)...)
 	}
 
+	allErrs = append(allErrs, validateZones(infra, networksPath, nodes, pods, services)...)
+
+	allErrs = append(allErrs, validateSecondaryRanges(infra.Networks.SecondaryRanges, networksPath.Child("secondaryRanges"), pods, services, workerCIDR, internalCIDR)...)
+
 	if infra.Networks.VPC != nil && len(infra.Networks.VPC.Name) == 0 {
 		allErrs = append(allErrs, field.Invalid(networksPath.Child("vpc", "name"), infra.Networks.VPC.Name, "vpc name must not be empty when vpc key is provided"))
 	}
@@ -119,6 +228,637 @@ func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR
 		allErrs = append(allErrs, ValidateCloudNatConfig(infra.Networks.CloudNAT, networksPath)...)
 	}
 
+	allErrs = append(allErrs, validateIPv6Config(infra.Networks.IPv6, networksPath.Child("ipv6"))...)
+
+	allErrs = append(allErrs, validateExistingSubnets(infra, networksPath)...)
+
+	allErrs = append(allErrs, validatePrivateServiceConnectEndpoints(infra.Networks.PrivateServiceConnectEndpoints, networksPath.Child("privateServiceConnectEndpoints"), workerCIDR)...)
+
+	allErrs = append(allErrs, validateAdditionalFirewallRules(infra.Networks.AdditionalFirewallRules, networksPath.Child("additionalFirewallRules"))...)
+
+	allErrs = append(allErrs, validatePeerings(infra.Networks.Peerings, networksPath.Child("peerings"))...)
+	allErrs = append(allErrs, validateFirewallPolicy(infra.Networks.FirewallPolicy, networksPath.Child("firewallPolicy"))...)
+	allErrs = append(allErrs, validatePacketMirroring(infra.Networks.PacketMirroring, networksPath.Child("packetMirroring"))...)
+	allErrs = append(allErrs, validateNCCSpoke(infra.Networks.NCCSpoke, networksPath.Child("nccSpoke"))...)
+
+	if infra.Networks.RoutingMode != nil {
+		// The routing mode of an existing VPC is managed by the user.
+		if infra.Networks.VPC != nil {
+			allErrs = append(allErrs, field.Forbidden(networksPath.Child("routingMode"), "the routing mode can only be configured for the VPC created by the extension, i.e. not together with an existing VPC"))
+		}
+		if !slices.Contains(vpcRoutingModes, *infra.Networks.RoutingMode) {
+			allErrs = append(allErrs, field.NotSupported(networksPath.Child("routingMode"), *infra.Networks.RoutingMode, vpcRoutingModes))
+		}
+	}
+
+	if infra.Networks.MTU != nil {
+		// The MTU of an existing VPC is managed by the user.
+		if infra.Networks.VPC != nil {
+			allErrs = append(allErrs, field.Forbidden(networksPath.Child("mtu"), "the MTU can only be configured for the VPC created by the extension, i.e. not together with an existing VPC"))
+		}
+		if mtu := *infra.Networks.MTU; mtu < vpcMTULowerBound || mtu > vpcMTUUpperBound {
+			allErrs = append(allErrs, field.Invalid(networksPath.Child("mtu"), mtu, fmt.Sprintf("must be between %d and %d", vpcMTULowerBound, vpcMTUUpperBound)))
+		}
+	}
+
+	if infra.Networks.CloudRouterBGP != nil {
+		// The CloudRouter of an existing VPC is managed by the user.
+		if infra.Networks.VPC != nil {
+			allErrs = append(allErrs, field.Forbidden(networksPath.Child("cloudRouterBGP"), "BGP can only be configured for the CloudRouter created by the extension, i.e. not together with an existing VPC"))
+		}
+		allErrs = append(allErrs, validateCloudRouterBGP(infra.Networks.CloudRouterBGP, networksPath.Child("cloudRouterBGP"))...)
+	}
+
+	allErrs = append(allErrs, validateNodeServiceAccount(infra.NodeServiceAccount, fldPath.Child("nodeServiceAccount"))...)
+	allErrs = append(allErrs, validateResourceLabels(infra.ResourceLabels, fldPath.Child("resourceLabels"))...)
+
+	return allErrs
+}
+
+func validateResourceLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(labels) > maxResourceLabels {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(labels), maxResourceLabels))
+	}
+
+	for _, key := range sets.List(sets.KeySet(labels)) {
+		switch {
+		case !labelKeyRegex.MatchString(key):
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), key, "must start with a lowercase letter and consist of at most 63 lowercase letters, digits, underscores and dashes"))
+		case slices.Contains(reservedResourceLabelKeys, key) || strings.HasPrefix(key, "goog"):
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), "label is added by the extension or reserved by GCP"))
+		}
+		if !labelValueRegex.MatchString(labels[key]) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), labels[key], "must consist of at most 63 lowercase letters, digits, underscores and dashes"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCloudRouterBGP(bgp *apisgcp.CloudRouterBGP, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !isPrivateASN(bgp.ASN) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("asn"), bgp.ASN, "must be a private ASN between 64512 and 65534 or between 4200000000 and 4294967294"))
+	}
+
+	if bgp.AdvertiseMode != nil && !slices.Contains(cloudRouterAdvertiseModes, *bgp.AdvertiseMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("advertiseMode"), *bgp.AdvertiseMode, cloudRouterAdvertiseModes))
+	}
+
+	// Groups and IP ranges are ignored by GCP unless routes are advertised in the custom mode.
+	if bgp.AdvertiseMode == nil || *bgp.AdvertiseMode != "CUSTOM" {
+		if len(bgp.AdvertisedGroups) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("advertisedGroups"), "advertised groups can only be configured in the CUSTOM advertise mode"))
+		}
+		if len(bgp.AdvertisedIPRanges) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("advertisedIPRanges"), "advertised IP ranges can only be configured in the CUSTOM advertise mode"))
+		}
+	}
+
+	for i, group := range bgp.AdvertisedGroups {
+		if !slices.Contains(cloudRouterAdvertisedGroups, group) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("advertisedGroups").Index(i), group, cloudRouterAdvertisedGroups))
+		}
+	}
+
+	ranges := sets.New[string]()
+	for i, ipRange := range bgp.AdvertisedIPRanges {
+		rangePath := fldPath.Child("advertisedIPRanges").Index(i).Child("range")
+		if _, ipNet, err := net.ParseCIDR(ipRange.Range); err != nil {
+			allErrs = append(allErrs, field.Invalid(rangePath, ipRange.Range, err.Error()))
+		} else if ipNet.String() != ipRange.Range {
+			allErrs = append(allErrs, field.Invalid(rangePath, ipRange.Range, fmt.Sprintf("must be a canonical CIDR, e.g. %s", ipNet.String())))
+		}
+		if ranges.Has(ipRange.Range) {
+			allErrs = append(allErrs, field.Duplicate(rangePath, ipRange.Range))
+		}
+		ranges.Insert(ipRange.Range)
+	}
+
+	return allErrs
+}
+
+// isPrivateASN returns whether the given autonomous system number is a 16-bit or 32-bit private ASN which can be used
+// by a CloudRouter.
+func isPrivateASN(asn int64) bool {
+	return (asn >= 64512 && asn <= 65534) || (asn >= 4200000000 && asn <= 4294967294)
+}
+
+func validateAdditionalFirewallRules(rules []apisgcp.FirewallRule, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if rule.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name for the firewall rule"))
+		} else {
+			for _, msg := range k8svalidation.IsDNS1035Label(rule.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), rule.Name, msg))
+			}
+			if slices.Contains(reservedFirewallRuleNames, rule.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), rule.Name, "name is reserved for the firewall rules created by the extension"))
+			}
+			if names.Has(rule.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), rule.Name))
+			}
+			names.Insert(rule.Name)
+		}
+
+		if rule.Direction != nil && !slices.Contains(firewallRuleDirections, *rule.Direction) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("direction"), *rule.Direction, firewallRuleDirections))
+		}
+
+		if rule.Priority != nil && (*rule.Priority < 0 || *rule.Priority > 65535) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("priority"), *rule.Priority, "must be between 0 and 65535"))
+		}
+
+		if len(rule.Ranges) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ranges"), "must provide at least one range"))
+		}
+		var ipv4, ipv6 bool
+		for j, cidr := range rule.Ranges {
+			_, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("ranges").Index(j), cidr, "must be a valid CIDR"))
+				continue
+			}
+			if ipNet.IP.To4() != nil {
+				ipv4 = true
+			} else {
+				ipv6 = true
+			}
+		}
+		if ipv4 && ipv6 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ranges"), rule.Ranges, "must not mix IPv4 and IPv6 ranges"))
+		}
+
+		if len(rule.Allowed) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("allowed"), "must allow at least one protocol"))
+		}
+		for j, allowed := range rule.Allowed {
+			allErrs = append(allErrs, validateFirewallRuleProtocol(allowed, idxPath.Child("allowed").Index(j))...)
+		}
+
+		for j, tag := range rule.TargetTags {
+			for _, msg := range k8svalidation.IsDNS1035Label(tag) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("targetTags").Index(j), tag, msg))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateFirewallRuleProtocol(allowed apisgcp.FirewallRuleProtocol, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if allowed.Protocol == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("protocol"), "must provide a protocol"))
+	} else if number, err := strconv.Atoi(allowed.Protocol); err == nil {
+		if number < 0 || number > 255 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("protocol"), allowed.Protocol, "protocol number must be between 0 and 255"))
+		}
+	} else if !slices.Contains(firewallRuleProtocols, allowed.Protocol) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), allowed.Protocol, firewallRuleProtocols))
+	}
+
+	if len(allowed.Ports) > 0 && !slices.Contains(firewallRuleProtocolsWithPorts, allowed.Protocol) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ports"), fmt.Sprintf("ports can only be specified for the protocols %v", firewallRuleProtocolsWithPorts)))
+		return allErrs
+	}
+	for i, port := range allowed.Ports {
+		if !isValidFirewallRulePortRange(port) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ports").Index(i), port, "must be a port or a port range, e.g. 8080 or 30000-32767"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePacketMirroring(mirroring *apisgcp.PacketMirroring, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if mirroring == nil {
+		return allErrs
+	}
+
+	if mirroring.Collector == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("collector"), "must provide the forwarding rule of the collector"))
+	} else if !forwardingRuleRegex.MatchString(mirroring.Collector) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("collector"), mirroring.Collector, "must have the format projects/<project>/regions/<region>/forwardingRules/<name>"))
+	}
+
+	if mirroring.Priority != nil && (*mirroring.Priority < 0 || *mirroring.Priority > 65535) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("priority"), *mirroring.Priority, "must be between 0 and 65535"))
+	}
+
+	if filter := mirroring.Filter; filter != nil {
+		filterPath := fldPath.Child("filter")
+		for i, cidr := range filter.CIDRRanges {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				allErrs = append(allErrs, field.Invalid(filterPath.Child("cidrRanges").Index(i), cidr, "must be a valid CIDR"))
+			}
+		}
+		for i, protocol := range filter.Protocols {
+			if number, err := strconv.Atoi(protocol); err == nil {
+				if number < 0 || number > 255 {
+					allErrs = append(allErrs, field.Invalid(filterPath.Child("protocols").Index(i), protocol, "protocol number must be between 0 and 255"))
+				}
+			} else if !slices.Contains(packetMirroringProtocols, protocol) {
+				allErrs = append(allErrs, field.NotSupported(filterPath.Child("protocols").Index(i), protocol, packetMirroringProtocols))
+			}
+		}
+		if filter.Direction != nil && !slices.Contains(packetMirroringDirections, *filter.Direction) {
+			allErrs = append(allErrs, field.NotSupported(filterPath.Child("direction"), *filter.Direction, packetMirroringDirections))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNCCSpoke(spoke *apisgcp.NCCSpoke, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spoke == nil {
+		return allErrs
+	}
+
+	if spoke.Hub == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("hub"), "must provide the hub"))
+	} else if !nccHubRegex.MatchString(spoke.Hub) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("hub"), spoke.Hub, "must have the format projects/<project>/locations/global/hubs/<name>"))
+	}
+
+	for i, cidr := range spoke.ExcludeExportRanges {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("excludeExportRanges").Index(i), cidr, "must be a valid CIDR"))
+		}
+	}
+
+	return allErrs
+}
+
+func isValidFirewallRulePortRange(portRange string) bool {
+	from, to, isRange := strings.Cut(portRange, "-")
+	fromPort, err := strconv.Atoi(from)
+	if err != nil || fromPort < 0 || fromPort > 65535 {
+		return false
+	}
+	if !isRange {
+		return true
+	}
+	toPort, err := strconv.Atoi(to)
+	return err == nil && toPort >= fromPort && toPort <= 65535
+}
+
+// maxFirewallPolicyRulePriority is the highest priority of user-defined rules of network firewall policies, as the
+// lowest priorities are reserved for the default rules of each policy.
+const maxFirewallPolicyRulePriority = 2147483643
+
+func validateFirewallPolicy(policy *apisgcp.FirewallPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy == nil {
+		return allErrs
+	}
+
+	if policy.Name != nil && len(policy.Rules) > 0 {
+		return append(allErrs, field.Forbidden(fldPath, "must not set both name and rules"))
+	}
+	if policy.Name == nil && len(policy.Rules) == 0 {
+		return append(allErrs, field.Required(fldPath, "must set either name or rules"))
+	}
+	if policy.Name != nil {
+		for _, msg := range k8svalidation.IsDNS1035Label(*policy.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), *policy.Name, msg))
+		}
+	}
+
+	priorities := sets.New[int32]()
+	for i, rule := range policy.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+
+		if rule.Priority < 0 || rule.Priority > maxFirewallPolicyRulePriority {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("priority"), rule.Priority, fmt.Sprintf("must be between 0 and %d", maxFirewallPolicyRulePriority)))
+		} else if priorities.Has(rule.Priority) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("priority"), rule.Priority))
+		}
+		priorities.Insert(rule.Priority)
+
+		if !slices.Contains(firewallPolicyRuleActions, rule.Action) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("action"), rule.Action, firewallPolicyRuleActions))
+		}
+
+		if rule.Direction != nil && !slices.Contains(firewallRuleDirections, *rule.Direction) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("direction"), *rule.Direction, firewallRuleDirections))
+		}
+
+		if len(rule.Ranges) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ranges"), "must provide at least one range"))
+		}
+		for j, cidr := range rule.Ranges {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("ranges").Index(j), cidr, "must be a valid CIDR"))
+			}
+		}
+
+		if len(rule.Protocols) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("protocols"), "must provide at least one protocol"))
+		}
+		for j, protocol := range rule.Protocols {
+			allErrs = append(allErrs, validateFirewallRuleProtocol(protocol, idxPath.Child("protocols").Index(j))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validatePeerings(peerings []apisgcp.VPCPeering, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		names    = sets.New[string]()
+		networks = sets.New[string]()
+	)
+
+	for i, peering := range peerings {
+		idxPath := fldPath.Index(i)
+
+		if peering.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name for the peering"))
+		} else {
+			for _, msg := range k8svalidation.IsDNS1035Label(peering.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), peering.Name, msg))
+			}
+			if names.Has(peering.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), peering.Name))
+			}
+			names.Insert(peering.Name)
+		}
+
+		if peering.Network == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("network"), "must provide the peer network"))
+		} else if !networkRegex.MatchString(peering.Network) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("network"), peering.Network, "must have the format projects/<project>/global/networks/<name>"))
+		} else {
+			// A network can only be peered once with the same VPC.
+			network := strings.TrimPrefix(peering.Network, "https://www.googleapis.com/compute/v1/")
+			if networks.Has(network) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("network"), peering.Network))
+			}
+			networks.Insert(network)
+		}
+	}
+
+	return allErrs
+}
+
+func validatePrivateServiceConnectEndpoints(endpoints []apisgcp.PrivateServiceConnectEndpoint, fldPath *field.Path, workerCIDR cidrvalidation.CIDR) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+		ips     = sets.New[string]()
+	)
+
+	var workerNet *net.IPNet
+	if workerCIDR != nil {
+		_, workerNet, _ = net.ParseCIDR(workerCIDR.GetCIDR())
+	}
+
+	for i, endpoint := range endpoints {
+		idxPath := fldPath.Index(i)
+
+		if endpoint.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name for the endpoint"))
+		} else {
+			for _, msg := range k8svalidation.IsDNS1035Label(endpoint.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), endpoint.Name, msg))
+			}
+			if names.Has(endpoint.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), endpoint.Name))
+			}
+			names.Insert(endpoint.Name)
+		}
+
+		if endpoint.ServiceAttachment == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("serviceAttachment"), "must provide the service attachment of the endpoint"))
+		} else if !serviceAttachmentRegex.MatchString(endpoint.ServiceAttachment) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("serviceAttachment"), endpoint.ServiceAttachment, "must have the format projects/<project>/regions/<region>/serviceAttachments/<name>"))
+		}
+
+		if endpoint.IP != nil {
+			ip := net.ParseIP(*endpoint.IP)
+			switch {
+			case ip == nil || ip.To4() == nil:
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("ip"), *endpoint.IP, "must be a valid IPv4 address"))
+			case workerNet != nil && !workerNet.Contains(ip):
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("ip"), *endpoint.IP, "must be part of the worker subnet"))
+			case ips.Has(*endpoint.IP):
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("ip"), *endpoint.IP))
+			}
+			ips.Insert(*endpoint.IP)
+		}
+	}
+
+	return allErrs
+}
+
+func validateIPv6Config(config *apisgcp.IPv6Config, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		accessTypes = []string{string(apisgcp.IPv6AccessTypeExternal), string(apisgcp.IPv6AccessTypeInternal)}
+	)
+
+	if config == nil {
+		return allErrs
+	}
+
+	if config.WorkersAccessType != nil && !findElement(accessTypes, string(*config.WorkersAccessType)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("workersAccessType"), *config.WorkersAccessType, accessTypes))
+	}
+	if config.InternalAccessType != nil && !findElement(accessTypes, string(*config.InternalAccessType)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("internalAccessType"), *config.InternalAccessType, accessTypes))
+	}
+
+	return allErrs
+}
+
+// validateZones validates the subnets of the zones, which replace the regional worker and internal subnets. The ranges
+// of all subnets must not overlap with each other and with the ranges of the shoot.
+func validateZones(infra *apisgcp.InfrastructureConfig, fldPath *field.Path, nodes, pods, services cidrvalidation.CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(infra.Networks.Zones) == 0 {
+		return allErrs
+	}
+
+	// The settings of the regional subnets and the resources in the regional worker subnet cannot be applied to zones.
+	if infra.Networks.Worker != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("worker"), "the worker range cannot be configured together with zones"))
+	}
+	if infra.Networks.Workers != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("workers"), "the workers range cannot be configured together with zones"))
+	}
+	if infra.Networks.Internal != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("internal"), "the internal range cannot be configured together with zones, use the internal ranges of the zones instead"))
+	}
+	if infra.Networks.ExistingSubnets != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("existingSubnets"), "existing subnets cannot be used together with zones"))
+	}
+	if len(infra.Networks.SecondaryRanges) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("secondaryRanges"), "secondary ranges cannot be configured together with zones"))
+	}
+	if len(infra.Networks.PrivateServiceConnectEndpoints) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("privateServiceConnectEndpoints"), "private service connect endpoints cannot be configured together with zones"))
+	}
+	if infra.Networks.PacketMirroring != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("packetMirroring"), "packet mirroring cannot be configured together with zones"))
+	}
+
+	var (
+		zonesPath = fldPath.Child("zones")
+		names     = sets.New[string]()
+		cidrs     []cidrvalidation.CIDR
+	)
+	if infra.Networks.ProxyOnly != nil {
+		cidrs = append(cidrs, cidrvalidation.NewCIDR(*infra.Networks.ProxyOnly, fldPath.Child("proxyOnly")))
+	}
+
+	for i, zone := range infra.Networks.Zones {
+		idxPath := zonesPath.Index(i)
+
+		if zone.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the zone"))
+		} else if names.Has(zone.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), zone.Name))
+		}
+		names.Insert(zone.Name)
+
+		workers := cidrvalidation.NewCIDR(zone.Workers, idxPath.Child("workers"))
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(workers)...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(idxPath.Child("workers"), zone.Workers)...)
+		if nodes != nil && !netutils.IsIPv6CIDRString(nodes.GetCIDR()) {
+			allErrs = append(allErrs, nodes.ValidateSubset(workers)...)
+		}
+
+		zoneCIDRs := []cidrvalidation.CIDR{workers}
+		if zone.Internal != nil {
+			internal := cidrvalidation.NewCIDR(*zone.Internal, idxPath.Child("internal"))
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(internal)...)
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(idxPath.Child("internal"), *zone.Internal)...)
+			if nodes != nil {
+				allErrs = append(allErrs, nodes.ValidateNotOverlap(internal)...)
+			}
+			zoneCIDRs = append(zoneCIDRs, internal)
+		}
+
+		for _, cidr := range zoneCIDRs {
+			if pods != nil {
+				allErrs = append(allErrs, pods.ValidateNotOverlap(cidr)...)
+			}
+			if services != nil {
+				allErrs = append(allErrs, services.ValidateNotOverlap(cidr)...)
+			}
+			for _, other := range cidrs {
+				allErrs = append(allErrs, other.ValidateNotOverlap(cidr)...)
+			}
+			cidrs = append(cidrs, cidr)
+		}
+	}
+
+	return allErrs
+}
+
+func validateExistingSubnets(infra *apisgcp.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs         = field.ErrorList{}
+		existingSubnets = infra.Networks.ExistingSubnets
+		subnetsPath     = fldPath.Child("existingSubnets")
+	)
+
+	if existingSubnets == nil {
+		return allErrs
+	}
+
+	if infra.Networks.VPC == nil || len(infra.Networks.VPC.Name) == 0 {
+		allErrs = append(allErrs, field.Forbidden(subnetsPath, "existing subnets can only be used with an existing VPC"))
+	}
+
+	if existingSubnets.Workers != nil {
+		if len(*existingSubnets.Workers) == 0 {
+			allErrs = append(allErrs, field.Required(subnetsPath.Child("workers"), "name of the existing subnet must not be empty"))
+		}
+		// Existing subnets are not modified, hence settings of the worker subnet cannot be applied.
+		if infra.Networks.FlowLogs != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("flowLogs"), "flow logs cannot be configured for an existing worker subnet"))
+		}
+		if infra.Networks.IPv6 != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipv6"), "IPv6 cannot be configured for an existing worker subnet"))
+		}
+		if infra.Networks.PrivateGoogleAccess != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("privateGoogleAccess"), "private Google access cannot be configured for an existing worker subnet"))
+		}
+	}
+
+	if existingSubnets.Internal != nil {
+		if len(*existingSubnets.Internal) == 0 {
+			allErrs = append(allErrs, field.Required(subnetsPath.Child("internal"), "name of the existing subnet must not be empty"))
+		}
+		if infra.Networks.Internal == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("internal"), "range of the existing internal subnet must be specified"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNodeServiceAccount(sa *apisgcp.NodeServiceAccount, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if sa == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateRoles(sa.Roles, fldPath.Child("roles"))...)
+
+	names := sets.New[string]()
+	for i, pool := range sa.WorkerPools {
+		poolPath := fldPath.Child("workerPools").Index(i)
+		switch {
+		case pool.Name == "":
+			allErrs = append(allErrs, field.Required(poolPath.Child("name"), "must not be empty"))
+		case names.Has(pool.Name):
+			allErrs = append(allErrs, field.Duplicate(poolPath.Child("name"), pool.Name))
+		default:
+			names.Insert(pool.Name)
+		}
+		allErrs = append(allErrs, validateRoles(pool.Roles, poolPath.Child("roles"))...)
+	}
+
+	return allErrs
+}
+
+func validateRoles(roles []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, role := range roles {
+		switch {
+		case role == "":
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "must not be empty"))
+		case seen.Has(role):
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), role))
+		default:
+			seen.Insert(role)
+		}
+	}
+
 	return allErrs
 }
 
@@ -128,10 +868,25 @@ func ValidateCloudNatConfig(config *apisgcp.CloudNAT, fldPath *field.Path) field
 	allErrs := field.ErrorList{}
 	cloudNatPath := fldPath.Child("cloudNAT")
 
+	if config.Name != nil {
+		for _, msg := range k8svalidation.IsDNS1035Label(*config.Name) {
+			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child("name"), *config.Name, msg))
+		}
+	}
+
 	if config != nil && config.NatIPNames != nil && len(config.NatIPNames) == 0 {
 		allErrs = append(allErrs, field.Invalid(cloudNatPath.Child("natIPNames"), config.NatIPNames, "nat IP names cannot be empty."))
 	}
 
+	if config.NatIPCount != nil {
+		if config.NatIPNames != nil {
+			allErrs = append(allErrs, field.Forbidden(cloudNatPath.Child("natIPCount"), "natIPCount cannot be combined with natIPNames."))
+		}
+		if *config.NatIPCount < 1 {
+			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child("natIPCount"), *config.NatIPCount, "natIPCount must be positive."))
+		}
+	}
+
 	if config.EnableDynamicPortAllocation {
 		if config.EndpointIndependentMapping != nil && config.EndpointIndependentMapping.Enabled {
 			// There is no more fitting field.Error (e.g. field.MutuallyExclusive) so we put the blame on 'enableDynamicPortAllocation' and use the error msg
@@ -147,15 +902,118 @@ func ValidateCloudNatConfig(config *apisgcp.CloudNAT, fldPath *field.Path) field
 			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child("minPortsPerVM"), config.MinPortsPerVM, "minPortsPerVM may not be greater than maxPortsPerVM."))
 		}
 
+		if config.MinPortsPerVM != nil && (*config.MinPortsPerVM < dynamicMinPortsPerVMLowerBound || *config.MinPortsPerVM > dynamicMinPortsPerVMUpperBound) {
+			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child("minPortsPerVM"), *config.MinPortsPerVM, fmt.Sprintf("minPortsPerVM must be between %d and %d if dynamic port allocation is enabled.", dynamicMinPortsPerVMLowerBound, dynamicMinPortsPerVMUpperBound)))
+		}
+		if config.MaxPortsPerVM != nil && (*config.MaxPortsPerVM < dynamicMaxPortsPerVMLowerBound || *config.MaxPortsPerVM > portsPerVMUpperBound) {
+			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child("maxPortsPerVM"), *config.MaxPortsPerVM, fmt.Sprintf("maxPortsPerVM must be between %d and %d.", dynamicMaxPortsPerVMLowerBound, portsPerVMUpperBound)))
+		}
 	} else {
 		if config.MaxPortsPerVM != nil {
 			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child("maxPortsPerVM"), config.MinPortsPerVM, "maxPortsPerVM are only configurable if dynamic port allocation is enabled."))
 		}
+		if config.MinPortsPerVM != nil && (*config.MinPortsPerVM < staticMinPortsPerVMLowerBound || *config.MinPortsPerVM > portsPerVMUpperBound) {
+			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child("minPortsPerVM"), *config.MinPortsPerVM, fmt.Sprintf("minPortsPerVM must be between %d and %d.", staticMinPortsPerVMLowerBound, portsPerVMUpperBound)))
+		}
+	}
+
+	for _, timeout := range []struct {
+		name  string
+		value *int32
+	}{
+		{"icmpIdleTimeoutSec", config.IcmpIdleTimeoutSec},
+		{"tcpEstablishedIdleTimeoutSec", config.TcpEstablishedIdleTimeoutSec},
+		{"tcpTimeWaitTimeoutSec", config.TcpTimeWaitTimeoutSec},
+		{"tcpTransitoryIdleTimeoutSec", config.TcpTransitoryIdleTimeoutSec},
+		{"udpIdleTimeoutSec", config.UdpIdleTimeoutSec},
+	} {
+		if timeout.value != nil && *timeout.value <= 0 {
+			allErrs = append(allErrs, field.Invalid(cloudNatPath.Child(timeout.name), *timeout.value, "timeout must be a positive number of seconds."))
+		}
+	}
+
+	if config.NatLogging != nil && config.NatLogging.Filter != nil && !findElement(natLoggingFilters, *config.NatLogging.Filter) {
+		allErrs = append(allErrs, field.NotSupported(cloudNatPath.Child("natLogging", "filter"), *config.NatLogging.Filter, natLoggingFilters))
+	}
+
+	return allErrs
+}
+
+// validateSecondaryRanges validates the secondary ranges of the worker subnet. The ranges with purpose pods and services
+// must be equal to the pods and services CIDRs of the shoot, all other ranges must not overlap with them.
+func validateSecondaryRanges(secondaryRanges []apisgcp.SecondaryRange, fldPath *field.Path, pods, services cidrvalidation.CIDR, otherCIDRs ...cidrvalidation.CIDR) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		names      = sets.New[string]()
+		purposes   = sets.New[apisgcp.SecondaryRangePurpose]()
+		rangeCIDRs []cidrvalidation.CIDR
+	)
+
+	for i, secondaryRange := range secondaryRanges {
+		idxPath := fldPath.Index(i)
+
+		if secondaryRange.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name for the secondary range"))
+		} else {
+			for _, msg := range k8svalidation.IsDNS1035Label(secondaryRange.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), secondaryRange.Name, msg))
+			}
+			if names.Has(secondaryRange.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), secondaryRange.Name))
+			}
+			names.Insert(secondaryRange.Name)
+		}
+
+		var purposeCIDR cidrvalidation.CIDR
+		if purpose := secondaryRange.Purpose; purpose != nil {
+			switch *purpose {
+			case apisgcp.SecondaryRangePurposePods:
+				purposeCIDR = pods
+			case apisgcp.SecondaryRangePurposeServices:
+				purposeCIDR = services
+			default:
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("purpose"), *purpose, []apisgcp.SecondaryRangePurpose{apisgcp.SecondaryRangePurposePods, apisgcp.SecondaryRangePurposeServices}))
+			}
+			if purposes.Has(*purpose) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("purpose"), *purpose))
+			}
+			purposes.Insert(*purpose)
+		}
+
+		rangeCIDR := cidrvalidation.NewCIDR(secondaryRange.CIDR, idxPath.Child("cidr"))
+		if errs := cidrvalidation.ValidateCIDRParse(rangeCIDR); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(idxPath.Child("cidr"), secondaryRange.CIDR)...)
+
+		if purposeCIDR != nil && purposeCIDR.GetCIDR() != secondaryRange.CIDR {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("cidr"), secondaryRange.CIDR, fmt.Sprintf("secondary range with purpose %s must be equal to the %s CIDR %s of the shoot", *secondaryRange.Purpose, *secondaryRange.Purpose, purposeCIDR.GetCIDR())))
+		}
+
+		others := otherCIDRs
+		if !hasSecondaryRangePurpose(secondaryRange, apisgcp.SecondaryRangePurposePods) {
+			others = append(others, pods)
+		}
+		if !hasSecondaryRangePurpose(secondaryRange, apisgcp.SecondaryRangePurposeServices) {
+			others = append(others, services)
+		}
+		// The overlaps are reported on the secondary range, hence the other CIDRs validate the secondary range.
+		for _, other := range append(others, rangeCIDRs...) {
+			if other != nil {
+				allErrs = append(allErrs, other.ValidateNotOverlap(rangeCIDR)...)
+			}
+		}
+		rangeCIDRs = append(rangeCIDRs, rangeCIDR)
 	}
 
 	return allErrs
 }
 
+func hasSecondaryRangePurpose(secondaryRange apisgcp.SecondaryRange, purpose apisgcp.SecondaryRangePurpose) bool {
+	return secondaryRange.Purpose != nil && *secondaryRange.Purpose == purpose
+}
+
 func isPowerOfTwo(integer int32) bool {
 	// Compare the binary representation of the given positive integer with its predecessor, e.g. '11011' (27) and '11010' (26).
 	// They will share (at least) the leading '1' resulting in the union of them representing a number greater than zero, unless the given one is a power of two.
@@ -184,21 +1042,85 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisgcp.Infrastruc
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Internal, oldConfig.Networks.Internal, networksPath.Child("internal"))...)
 	}
 
-	newWorkerCIDR := newConfig.Networks.Worker
-	newWorker := cidrvalidation.NewCIDR(newWorkerCIDR, networksPath.Child("worker"))
-	if len(newConfig.Networks.Workers) > 0 {
-		newWorkerCIDR = newConfig.Networks.Workers
-		newWorker = cidrvalidation.NewCIDR(newWorkerCIDR, networksPath.Child("workers"))
+	// The routing mode and MTU of the VPC created by the extension cannot be changed.
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(helper.VPCRoutingMode(newConfig.Networks.RoutingMode), helper.VPCRoutingMode(oldConfig.Networks.RoutingMode), networksPath.Child("routingMode"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(helper.VPCMTU(newConfig.Networks.MTU), helper.VPCMTU(oldConfig.Networks.MTU), networksPath.Child("mtu"))...)
+
+	// The worker ranges of zones are validated separately.
+	if len(newConfig.Networks.Zones) == 0 && len(oldConfig.Networks.Zones) == 0 {
+		newWorkerCIDR := newConfig.Networks.Worker
+		newWorker := cidrvalidation.NewCIDR(newWorkerCIDR, networksPath.Child("worker"))
+		if len(newConfig.Networks.Workers) > 0 {
+			newWorkerCIDR = newConfig.Networks.Workers
+			newWorker = cidrvalidation.NewCIDR(newWorkerCIDR, networksPath.Child("workers"))
+		}
+
+		oldWorkerCIDR := oldConfig.Networks.Worker
+		oldWorker := cidrvalidation.NewCIDR(oldWorkerCIDR, networksPath.Child("worker"))
+		if len(oldConfig.Networks.Workers) > 0 {
+			oldWorkerCIDR = oldConfig.Networks.Workers
+			oldWorker = cidrvalidation.NewCIDR(oldWorkerCIDR, networksPath.Child("workers"))
+		}
+		if len(newWorker.ValidateSubset(oldWorker)) > 0 {
+			allErrs = append(allErrs, field.Invalid(newWorker.GetFieldPath(), newWorker.GetCIDR(), "worker CIDR blocks can only be expanded"))
+		}
+	}
+
+	allErrs = append(allErrs, validateZonesUpdate(oldConfig.Networks.Zones, newConfig.Networks.Zones, networksPath.Child("zones"))...)
+
+	// The range of a proxy-only subnet cannot be changed, but the subnet can be added or removed.
+	if oldConfig.Networks.ProxyOnly != nil && newConfig.Networks.ProxyOnly != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.ProxyOnly, oldConfig.Networks.ProxyOnly, networksPath.Child("proxyOnly"))...)
+	}
+
+	for i, newRange := range newConfig.Networks.SecondaryRanges {
+		for _, oldRange := range oldConfig.Networks.SecondaryRanges {
+			if newRange.Name == oldRange.Name {
+				allErrs = append(allErrs, apivalidation.ValidateImmutableField(newRange.CIDR, oldRange.CIDR, networksPath.Child("secondaryRanges").Index(i).Child("cidr"))...)
+				allErrs = append(allErrs, apivalidation.ValidateImmutableField(newRange.Purpose, oldRange.Purpose, networksPath.Child("secondaryRanges").Index(i).Child("purpose"))...)
+			}
+		}
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.ExistingSubnets, oldConfig.Networks.ExistingSubnets, networksPath.Child("existingSubnets"))...)
+
+	// The IPv6 access type of dual-stack subnets cannot be changed.
+	if oldConfig.Networks.IPv6 != nil || newConfig.Networks.IPv6 != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(helper.WorkersIPv6AccessType(newConfig.Networks.IPv6), helper.WorkersIPv6AccessType(oldConfig.Networks.IPv6), networksPath.Child("ipv6", "workersAccessType"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(helper.InternalIPv6AccessType(newConfig.Networks.IPv6), helper.InternalIPv6AccessType(oldConfig.Networks.IPv6), networksPath.Child("ipv6", "internalAccessType"))...)
 	}
 
-	oldWorkerCIDR := oldConfig.Networks.Worker
-	oldWorker := cidrvalidation.NewCIDR(oldWorkerCIDR, networksPath.Child("worker"))
-	if len(oldConfig.Networks.Workers) > 0 {
-		oldWorkerCIDR = oldConfig.Networks.Workers
-		oldWorker = cidrvalidation.NewCIDR(oldWorkerCIDR, networksPath.Child("workers"))
+	return allErrs
+}
+
+// validateZonesUpdate validates the update of the subnets of the zones. Zones can be added and removed, but the nodes
+// cannot be moved between the regional worker subnet and the subnets of the zones.
+func validateZonesUpdate(oldZones, newZones []apisgcp.Zone, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if (len(oldZones) > 0) != (len(newZones) > 0) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot switch between the regional worker subnet and the subnets of zones"))
+		return allErrs
 	}
-	if len(newWorker.ValidateSubset(oldWorker)) > 0 {
-		allErrs = append(allErrs, field.Invalid(newWorker.GetFieldPath(), newWorker.GetCIDR(), "worker CIDR blocks can only be expanded"))
+
+	for i, newZone := range newZones {
+		for _, oldZone := range oldZones {
+			if newZone.Name != oldZone.Name {
+				continue
+			}
+
+			idxPath := fldPath.Index(i)
+			newWorkers := cidrvalidation.NewCIDR(newZone.Workers, idxPath.Child("workers"))
+			if len(newWorkers.ValidateSubset(cidrvalidation.NewCIDR(oldZone.Workers, idxPath.Child("workers")))) > 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("workers"), newZone.Workers, "worker CIDR blocks can only be expanded"))
+			}
+			if newZone.Internal != nil && oldZone.Internal != nil {
+				newInternal := cidrvalidation.NewCIDR(*newZone.Internal, idxPath.Child("internal"))
+				if len(newInternal.ValidateSubset(cidrvalidation.NewCIDR(*oldZone.Internal, idxPath.Child("internal")))) > 0 {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("internal"), *newZone.Internal, "internal CIDR blocks can only be expanded"))
+				}
+			}
+		}
 	}
 
 	return allErrs
@@ -94,6 +94,16 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}))
 			})
 
+			It("should allow IPv6 CIDRs of IPv6 single-stack shoots", func() {
+				nodesIPv6 := "2600:1900:4000:1::/64"
+				podsIPv6 := "fd00:10:96::/56"
+				servicesIPv6 := "fd00:10:64::/112"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodesIPv6, &podsIPv6, &servicesIPv6, fldPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
 			It("should forbid Internal CIDR to overlap with Node - and Worker CIDR", func() {
 				overlappingCIDR := "10.250.1.0/30"
 				infrastructureConfig.Networks.Internal = &overlappingCIDR
@@ -112,6 +122,32 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}))
 			})
 
+			It("should allow a proxy-only subnet", func() {
+				infrastructureConfig.Networks.ProxyOnly = ptr.To("10.11.0.0/23")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid a proxy-only subnet which overlaps with the worker and internal CIDRs", func() {
+				infrastructureConfig.Networks.ProxyOnly = ptr.To("10.0.0.0/8")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.proxyOnly"),
+					"Detail": Equal(`must not overlap with "networking.nodes" ("10.250.0.0/16")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.proxyOnly"),
+					"Detail": Equal(`must not overlap with "networks.workers" ("10.250.0.0/16")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.proxyOnly"),
+					"Detail": Equal(`must not overlap with "networks.internal" ("10.10.0.0/24")`),
+				}))
+			})
+
 			It("should forbid non canonical CIDRs", func() {
 				nodeCIDR := "10.250.0.3/16"
 				podCIDR := "100.96.0.4/11"
@@ -332,85 +368,1240 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Detail": Equal("nat IP names cannot be empty."),
 				}))
 			})
-		})
-	})
+			It("should allow a number of NAT IPs allocated by the extension", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					NatIPCount: ptr.To[int32](2),
+				}
 
-	Describe("#ValidateInfrastructureConfigUpdate", func() {
-		It("should return no errors for an unchanged config", func() {
-			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig, fldPath)).To(BeEmpty())
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid combining the number of NAT IPs with NAT IP names", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT.NatIPCount = ptr.To[int32](0)
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.cloudNAT.natIPCount"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.natIPCount"),
+				}))
+			})
+			It("should allow port allocations and timeouts within the supported ranges", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					EnableDynamicPortAllocation:  true,
+					MinPortsPerVM:                ptr.To[int32](32),
+					MaxPortsPerVM:                ptr.To[int32](65536),
+					IcmpIdleTimeoutSec:           ptr.To[int32](30),
+					TcpEstablishedIdleTimeoutSec: ptr.To[int32](1200),
+					TcpTimeWaitTimeoutSec:        ptr.To[int32](120),
+					TcpTransitoryIdleTimeoutSec:  ptr.To[int32](30),
+					UdpIdleTimeoutSec:            ptr.To[int32](30),
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid port allocations out of the supported ranges", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					EnableDynamicPortAllocation: true,
+					MinPortsPerVM:               ptr.To[int32](16),
+					MaxPortsPerVM:               ptr.To[int32](32),
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.minPortsPerVM"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.maxPortsPerVM"),
+				}))
+
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					MinPortsPerVM: ptr.To[int32](1),
+				}
+
+				errorList = ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.minPortsPerVM"),
+				}))
+			})
+			It("should forbid non-positive timeouts", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					IcmpIdleTimeoutSec: ptr.To[int32](0),
+					UdpIdleTimeoutSec:  ptr.To[int32](-1),
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.icmpIdleTimeoutSec"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.udpIdleTimeoutSec"),
+				}))
+			})
+			It("should allow disabling the nat logging and supported filters", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					NatLogging: &apisgcp.NatLogging{Enabled: ptr.To(false)},
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+
+				newInfrastructureConfig.Networks.CloudNAT.NatLogging = &apisgcp.NatLogging{Filter: ptr.To("TRANSLATIONS_ONLY")}
+
+				errorList = ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid unsupported nat logging filters", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					NatLogging: &apisgcp.NatLogging{Filter: ptr.To("EVERYTHING")},
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.cloudNAT.natLogging.filter"),
+				}))
+			})
+			It("should allow a custom nat name", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					Name:          ptr.To("legacy-nat"),
+					AdoptExisting: true,
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+			It("should forbid invalid nat names", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+					Name: ptr.To("Legacy_NAT"),
+				}
+
+				errorList := ValidateInfrastructureConfig(newInfrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudNAT.name"),
+				}))
+			})
 		})
+		Context("NodeServiceAccount", func() {
+			It("should allow a node service account with default roles", func() {
+				infrastructureConfig.NodeServiceAccount = &apisgcp.NodeServiceAccount{}
 
-		It("should allow changing cloudNAT AND Flow-logs details", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
-				MinPortsPerVM: ptr.To[int32](30),
-				NatIPNames: []apisgcp.NatIPName{{
-					Name: "not-test",
-				}},
-			}
-			newInfrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{
-				AggregationInterval: ptr.To("INTERVAL_30_SEC"),
-			}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
-			Expect(errorList).To(BeEmpty())
+			It("should forbid empty and duplicate roles", func() {
+				infrastructureConfig.NodeServiceAccount = &apisgcp.NodeServiceAccount{
+					Roles: []string{"roles/logging.logWriter", "", "roles/logging.logWriter"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("nodeServiceAccount.roles[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("nodeServiceAccount.roles[2]"),
+				}))
+			})
+
+			It("should allow service accounts of worker pools", func() {
+				infrastructureConfig.NodeServiceAccount = &apisgcp.NodeServiceAccount{
+					WorkerPools: []apisgcp.WorkerPoolServiceAccount{
+						{Name: "pool-a"},
+						{Name: "pool-b", Roles: []string{"roles/storage.objectViewer"}},
+					},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate worker pools and invalid roles of worker pools", func() {
+				infrastructureConfig.NodeServiceAccount = &apisgcp.NodeServiceAccount{
+					WorkerPools: []apisgcp.WorkerPoolServiceAccount{
+						{Name: "pool-a", Roles: []string{""}},
+						{Name: ""},
+						{Name: "pool-a"},
+					},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("nodeServiceAccount.workerPools[0].roles[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("nodeServiceAccount.workerPools[1].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("nodeServiceAccount.workerPools[2].name"),
+				}))
+			})
 		})
 
-		It("should forbid changing infrastructure network details", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.VPC = &apisgcp.VPC{
-				Name: "not-hugo",
-				CloudRouter: &apisgcp.CloudRouter{
-					Name: "not-hugo-cr",
-				},
-			}
-			newInfrastructureConfig.Networks.Workers = "10.96.0.0/16"
-			newInfrastructureConfig.Networks.Worker = "10.96.0.0/16"
-			newInfrastructureConfig.Networks.Internal = ptr.To("10.96.0.0/16")
+		Context("IPv6", func() {
+			It("should allow valid IPv6 access types", func() {
+				infrastructureConfig.Networks.IPv6 = &apisgcp.IPv6Config{
+					WorkersAccessType:  ptr.To(apisgcp.IPv6AccessTypeExternal),
+					InternalAccessType: ptr.To(apisgcp.IPv6AccessTypeInternal),
+				}
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
-			Expect(errorList).To(ConsistOfFields(Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.vpc.name"),
-			}, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.vpc.cloudRouter"),
-			}, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.internal"),
-			}, Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.workers"),
-			}))
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid unsupported IPv6 access types", func() {
+				infrastructureConfig.Networks.IPv6 = &apisgcp.IPv6Config{
+					WorkersAccessType:  ptr.To(apisgcp.IPv6AccessType("PUBLIC")),
+					InternalAccessType: ptr.To(apisgcp.IPv6AccessType("")),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.ipv6.workersAccessType"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.ipv6.internalAccessType"),
+				}))
+			})
 		})
+		Context("ExistingSubnets", func() {
+			It("should allow existing subnets in an existing VPC", func() {
+				infrastructureConfig.Networks.FlowLogs = nil
+				infrastructureConfig.Networks.ExistingSubnets = &apisgcp.ExistingSubnets{
+					Workers:  ptr.To("hugo-nodes"),
+					Internal: ptr.To("hugo-internal"),
+				}
 
-		It("should forbid updating VPC value to nil", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.VPC = nil
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
-			Expect(errorList).To(ConsistOfFields(Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.vpc"),
-			}))
+			It("should forbid existing subnets without an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = nil
+				infrastructureConfig.Networks.ExistingSubnets = &apisgcp.ExistingSubnets{
+					Internal: ptr.To("hugo-internal"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.existingSubnets"),
+				}))
+			})
+
+			It("should forbid empty subnet names and settings which cannot be applied to existing subnets", func() {
+				infrastructureConfig.Networks.Internal = nil
+				infrastructureConfig.Networks.IPv6 = &apisgcp.IPv6Config{}
+				infrastructureConfig.Networks.PrivateGoogleAccess = ptr.To(true)
+				infrastructureConfig.Networks.ExistingSubnets = &apisgcp.ExistingSubnets{
+					Workers:  ptr.To(""),
+					Internal: ptr.To(""),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.existingSubnets.workers"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.existingSubnets.internal"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.flowLogs"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.ipv6"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.privateGoogleAccess"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.internal"),
+				}))
+			})
 		})
 
-		It("should allow expanding the worker subnet", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Workers = "10.250.0.0/15"
+		Context("PrivateServiceConnectEndpoints", func() {
+			It("should allow valid endpoints", func() {
+				infrastructureConfig.Networks.PrivateServiceConnectEndpoints = []apisgcp.PrivateServiceConnectEndpoint{
+					{Name: "cloud-sql", ServiceAttachment: "projects/foo/regions/europe-west1/serviceAttachments/sql", IP: ptr.To("10.250.255.10")},
+					{Name: "saas", ServiceAttachment: "https://www.googleapis.com/compute/v1/projects/bar/regions/europe-west1/serviceAttachments/saas"},
+				}
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
-			Expect(errorList).To(BeEmpty())
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid endpoints", func() {
+				infrastructureConfig.Networks.PrivateServiceConnectEndpoints = []apisgcp.PrivateServiceConnectEndpoint{
+					{Name: "", ServiceAttachment: "projects/foo/serviceAttachments/sql"},
+					{Name: "saas", ServiceAttachment: "", IP: ptr.To("10.251.0.10")},
+					{Name: "saas", ServiceAttachment: "projects/foo/regions/europe-west1/serviceAttachments/saas", IP: ptr.To("fd00::1")},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.privateServiceConnectEndpoints[0].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.privateServiceConnectEndpoints[0].serviceAttachment"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.privateServiceConnectEndpoints[1].serviceAttachment"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.privateServiceConnectEndpoints[1].ip"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.privateServiceConnectEndpoints[2].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.privateServiceConnectEndpoints[2].ip"),
+				}))
+			})
 		})
 
-		It("should forbid shrinking the worker subnet", func() {
-			newInfrastructureConfig := infrastructureConfig.DeepCopy()
-			newInfrastructureConfig.Networks.Workers = "10.250.0.0/17"
+		Context("AdditionalFirewallRules", func() {
+			It("should allow valid firewall rules", func() {
+				infrastructureConfig.Networks.AdditionalFirewallRules = []apisgcp.FirewallRule{
+					{
+						Name:   "allow-ssh",
+						Ranges: []string{"10.0.0.0/8"},
+						Allowed: []apisgcp.FirewallRuleProtocol{
+							{Protocol: "tcp", Ports: []string{"22", "8000-8080"}},
+							{Protocol: "icmp"},
+						},
+					},
+					{
+						Name:       "allow-egress",
+						Direction:  ptr.To("EGRESS"),
+						Priority:   ptr.To[int32](100),
+						Ranges:     []string{"2001:db8::/32"},
+						Allowed:    []apisgcp.FirewallRuleProtocol{{Protocol: "58"}},
+						TargetTags: []string{"proxy"},
+					},
+				}
 
-			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
-			Expect(errorList).To(ConsistOfFields(Fields{
-				"Type":  Equal(field.ErrorTypeInvalid),
-				"Field": Equal("networks.workers"),
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid firewall rules", func() {
+				infrastructureConfig.Networks.AdditionalFirewallRules = []apisgcp.FirewallRule{
+					{
+						Name:      "allow-internal-access",
+						Direction: ptr.To("INBOUND"),
+						Priority:  ptr.To[int32](70000),
+						Ranges:    []string{"10.0.0.0/8", "fd00::/8"},
+						Allowed:   []apisgcp.FirewallRuleProtocol{{Protocol: "icmp", Ports: []string{"80"}}},
+					},
+					{
+						Name:       "allow-internal-access",
+						Ranges:     []string{"10.0.0.0"},
+						Allowed:    []apisgcp.FirewallRuleProtocol{{Protocol: "foo"}, {Protocol: "tcp", Ports: []string{"80-70"}}},
+						TargetTags: []string{"Proxy"},
+					},
+					{
+						Name: "",
+					},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalFirewallRules[0].name"),
+					"Detail": ContainSubstring("reserved"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.additionalFirewallRules[0].direction"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalFirewallRules[0].priority"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalFirewallRules[0].ranges"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.additionalFirewallRules[0].allowed[0].ports"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.additionalFirewallRules[1].name"),
+					"Detail": ContainSubstring("reserved"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.additionalFirewallRules[1].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalFirewallRules[1].ranges[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.additionalFirewallRules[1].allowed[0].protocol"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalFirewallRules[1].allowed[1].ports[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalFirewallRules[1].targetTags[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.additionalFirewallRules[2].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.additionalFirewallRules[2].ranges"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.additionalFirewallRules[2].allowed"),
+				}))
+			})
+		})
+
+		Context("CloudRouterBGP", func() {
+			BeforeEach(func() {
+				// BGP can only be configured for the CloudRouter created by the extension.
+				infrastructureConfig.Networks.VPC = nil
+			})
+
+			It("should allow a valid BGP configuration", func() {
+				infrastructureConfig.Networks.CloudRouterBGP = &apisgcp.CloudRouterBGP{
+					ASN:              4200000000,
+					AdvertiseMode:    ptr.To("CUSTOM"),
+					AdvertisedGroups: []string{"ALL_SUBNETS"},
+					AdvertisedIPRanges: []apisgcp.AdvertisedIPRange{
+						{Range: "10.0.0.0/8", Description: ptr.To("on-premise")},
+						{Range: "2001:db8::/32"},
+					},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an invalid BGP configuration", func() {
+				infrastructureConfig.Networks.CloudRouterBGP = &apisgcp.CloudRouterBGP{
+					ASN:              65535,
+					AdvertiseMode:    ptr.To("ALL"),
+					AdvertisedGroups: []string{"ALL_VPC_SUBNETS"},
+					AdvertisedIPRanges: []apisgcp.AdvertisedIPRange{
+						{Range: "10.0.0.1/8"},
+						{Range: "10.0.0.0"},
+					},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudRouterBGP.asn"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.cloudRouterBGP.advertiseMode"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.cloudRouterBGP.advertisedGroups"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.cloudRouterBGP.advertisedIPRanges"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.cloudRouterBGP.advertisedGroups[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudRouterBGP.advertisedIPRanges[0].range"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.cloudRouterBGP.advertisedIPRanges[1].range"),
+				}))
+			})
+
+			It("should forbid a BGP configuration together with an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = &apisgcp.VPC{
+					Name:        "vpc",
+					CloudRouter: &apisgcp.CloudRouter{Name: "router"},
+				}
+				infrastructureConfig.Networks.CloudRouterBGP = &apisgcp.CloudRouterBGP{ASN: 64512}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.cloudRouterBGP"),
+				}))
+			})
+		})
+
+		Context("RoutingMode", func() {
+			BeforeEach(func() {
+				// The routing mode can only be configured for the VPC created by the extension.
+				infrastructureConfig.Networks.VPC = nil
+			})
+
+			It("should allow the GLOBAL routing mode", func() {
+				infrastructureConfig.Networks.RoutingMode = ptr.To("GLOBAL")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an unsupported routing mode", func() {
+				infrastructureConfig.Networks.RoutingMode = ptr.To("ZONAL")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.routingMode"),
+				}))
+			})
+
+			It("should forbid a routing mode together with an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = &apisgcp.VPC{
+					Name:        "vpc",
+					CloudRouter: &apisgcp.CloudRouter{Name: "router"},
+				}
+				infrastructureConfig.Networks.RoutingMode = ptr.To("GLOBAL")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.routingMode"),
+				}))
+			})
+		})
+
+		Context("MTU", func() {
+			BeforeEach(func() {
+				// The MTU can only be configured for the VPC created by the extension.
+				infrastructureConfig.Networks.VPC = nil
+			})
+
+			It("should allow an MTU for jumbo frames", func() {
+				infrastructureConfig.Networks.MTU = ptr.To[int32](8896)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an MTU out of range", func() {
+				infrastructureConfig.Networks.MTU = ptr.To[int32](9000)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.mtu"),
+				}))
+			})
+
+			It("should forbid an MTU together with an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = &apisgcp.VPC{
+					Name:        "vpc",
+					CloudRouter: &apisgcp.CloudRouter{Name: "router"},
+				}
+				infrastructureConfig.Networks.MTU = ptr.To[int32](1500)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.mtu"),
+				}))
+			})
+		})
+
+		Context("FirewallPolicy", func() {
+			It("should allow referencing an existing policy", func() {
+				infrastructureConfig.Networks.FirewallPolicy = &apisgcp.FirewallPolicy{Name: ptr.To("shared-policy")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow valid rules", func() {
+				infrastructureConfig.Networks.FirewallPolicy = &apisgcp.FirewallPolicy{Rules: []apisgcp.FirewallPolicyRule{
+					{Priority: 1000, Action: "allow", Ranges: []string{"10.0.0.0/8"}, Protocols: []apisgcp.FirewallRuleProtocol{{Protocol: "tcp", Ports: []string{"22"}}}},
+					{Priority: 2000, Action: "deny", Direction: ptr.To("EGRESS"), Ranges: []string{"0.0.0.0/0"}, Protocols: []apisgcp.FirewallRuleProtocol{{Protocol: "all"}}},
+				}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid setting both name and rules", func() {
+				infrastructureConfig.Networks.FirewallPolicy = &apisgcp.FirewallPolicy{Name: ptr.To("shared-policy"), Rules: []apisgcp.FirewallPolicyRule{
+					{Priority: 1000, Action: "allow", Ranges: []string{"10.0.0.0/8"}, Protocols: []apisgcp.FirewallRuleProtocol{{Protocol: "tcp"}}},
+				}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.firewallPolicy"),
+				}))
+			})
+
+			It("should require either name or rules", func() {
+				infrastructureConfig.Networks.FirewallPolicy = &apisgcp.FirewallPolicy{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.firewallPolicy"),
+				}))
+			})
+
+			It("should forbid invalid rules", func() {
+				infrastructureConfig.Networks.FirewallPolicy = &apisgcp.FirewallPolicy{Rules: []apisgcp.FirewallPolicyRule{
+					{Priority: 2147483644, Action: "allow", Ranges: []string{"10.0.0.0/8"}, Protocols: []apisgcp.FirewallRuleProtocol{{Protocol: "tcp"}}},
+					{Priority: 1000, Action: "reject", Direction: ptr.To("BOTH"), Ranges: []string{"10.0.0.0"}, Protocols: []apisgcp.FirewallRuleProtocol{{Protocol: "icmp", Ports: []string{"80"}}}},
+					{Priority: 1000, Action: "allow"},
+				}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.firewallPolicy.rules[0].priority"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.firewallPolicy.rules[1].action"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.firewallPolicy.rules[1].direction"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.firewallPolicy.rules[1].ranges[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.firewallPolicy.rules[1].protocols[0].ports"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.firewallPolicy.rules[2].priority"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.firewallPolicy.rules[2].ranges"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.firewallPolicy.rules[2].protocols"),
+				}))
+			})
+		})
+
+		Context("PacketMirroring", func() {
+			It("should allow a valid packet mirroring", func() {
+				infrastructureConfig.Networks.PacketMirroring = &apisgcp.PacketMirroring{
+					Collector: "projects/foo/regions/europe-west1/forwardingRules/ids",
+					Filter: &apisgcp.PacketMirroringFilter{
+						CIDRRanges: []string{"10.0.0.0/8"},
+						Protocols:  []string{"tcp", "17"},
+						Direction:  ptr.To("INGRESS"),
+					},
+					Priority: ptr.To[int32](100),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should require the collector", func() {
+				infrastructureConfig.Networks.PacketMirroring = &apisgcp.PacketMirroring{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.packetMirroring.collector"),
+				}))
+			})
+
+			It("should forbid an invalid packet mirroring", func() {
+				infrastructureConfig.Networks.PacketMirroring = &apisgcp.PacketMirroring{
+					Collector: "projects/foo/global/forwardingRules/ids",
+					Filter: &apisgcp.PacketMirroringFilter{
+						CIDRRanges: []string{"10.0.0.0"},
+						Protocols:  []string{"all", "256"},
+						Direction:  ptr.To("OUT"),
+					},
+					Priority: ptr.To[int32](65536),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.packetMirroring.collector"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.packetMirroring.priority"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.packetMirroring.filter.cidrRanges[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.packetMirroring.filter.protocols[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.packetMirroring.filter.protocols[1]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.packetMirroring.filter.direction"),
+				}))
+			})
+		})
+
+		Context("NCCSpoke", func() {
+			It("should allow a valid spoke", func() {
+				infrastructureConfig.Networks.NCCSpoke = &apisgcp.NCCSpoke{
+					Hub:                 "projects/foo/locations/global/hubs/hub",
+					ExcludeExportRanges: []string{"10.250.0.0/16"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an invalid spoke", func() {
+				infrastructureConfig.Networks.NCCSpoke = &apisgcp.NCCSpoke{
+					Hub:                 "projects/foo/locations/europe-west1/hubs/hub",
+					ExcludeExportRanges: []string{"10.250.0.0"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.nccSpoke.hub"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.nccSpoke.excludeExportRanges[0]"),
+				}))
+			})
+
+			It("should require the hub", func() {
+				infrastructureConfig.Networks.NCCSpoke = &apisgcp.NCCSpoke{}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.nccSpoke.hub"),
+				}))
+			})
+		})
+
+		Context("Zones", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.Workers = ""
+				infrastructureConfig.Networks.Worker = ""
+				infrastructureConfig.Networks.Internal = nil
+			})
+
+			It("should allow valid zones", func() {
+				infrastructureConfig.Networks.Zones = []apisgcp.Zone{
+					{Name: "europe-west1-b", Workers: "10.250.0.0/18", Internal: ptr.To("10.10.0.0/24")},
+					{Name: "europe-west1-c", Workers: "10.250.64.0/18", Internal: ptr.To("10.10.1.0/24")},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid and overlapping zones", func() {
+				infrastructureConfig.Networks.Zones = []apisgcp.Zone{
+					{Name: "europe-west1-b", Workers: "10.250.0.0/17"},
+					{Name: "europe-west1-b", Workers: "10.250.64.0/18", Internal: ptr.To("100.96.0.0/24")},
+					{Workers: "10.1.0.0/16"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[1].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[1].workers"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[1].internal"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[2].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[2].workers"),
+				}))
+			})
+
+			It("should forbid zones together with the regional subnets", func() {
+				infrastructureConfig.Networks.Workers = "10.250.0.0/16"
+				infrastructureConfig.Networks.Internal = &internal
+				infrastructureConfig.Networks.Zones = []apisgcp.Zone{{Name: "europe-west1-b", Workers: "10.250.0.0/18"}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.workers"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.internal"),
+				}))
+			})
+		})
+
+		Context("ResourceLabels", func() {
+			It("should allow valid resource labels", func() {
+				infrastructureConfig.ResourceLabels = map[string]string{"cost-center": "cc-1234", "team_name": ""}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid and reserved resource labels", func() {
+				infrastructureConfig.ResourceLabels = map[string]string{
+					"Cost-Center":      "cc-1234",
+					"k8s-cluster-name": "foo",
+					"goog-managed":     "foo",
+					"team":             "Foo Bar",
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("resourceLabels[Cost-Center]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("resourceLabels[goog-managed]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("resourceLabels[k8s-cluster-name]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("resourceLabels[team]"),
+				}))
+			})
+		})
+
+		Context("Peerings", func() {
+			It("should allow valid peerings", func() {
+				infrastructureConfig.Networks.Peerings = []apisgcp.VPCPeering{
+					{Name: "shared", Network: "projects/foo/global/networks/shared"},
+					{Name: "onprem", Network: "https://www.googleapis.com/compute/v1/projects/bar/global/networks/onprem", ImportCustomRoutes: ptr.To(true)},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid peerings", func() {
+				infrastructureConfig.Networks.Peerings = []apisgcp.VPCPeering{
+					{Name: "", Network: "projects/foo/networks/shared"},
+					{Name: "Shared", Network: ""},
+					{Name: "shared", Network: "projects/foo/global/networks/shared"},
+					{Name: "shared", Network: "https://www.googleapis.com/compute/v1/projects/foo/global/networks/shared"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.peerings[0].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.peerings[0].network"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.peerings[1].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.peerings[1].network"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.peerings[3].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.peerings[3].network"),
+				}))
+			})
+		})
+
+		Context("SecondaryRanges", func() {
+			It("should allow valid secondary ranges", func() {
+				infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{
+					{Name: "alias-a", CIDR: "10.251.0.0/16"},
+					{Name: "alias-b", CIDR: "10.252.0.0/16"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid and duplicate names", func() {
+				infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{
+					{Name: "", CIDR: "10.251.0.0/16"},
+					{Name: "Alias_A", CIDR: "10.252.0.0/16"},
+					{Name: "alias-c", CIDR: "10.253.0.0/16"},
+					{Name: "alias-c", CIDR: "10.254.0.0/16"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.secondaryRanges[0].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.secondaryRanges[1].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.secondaryRanges[3].name"),
+				}))
+			})
+
+			It("should forbid invalid and non canonical CIDRs", func() {
+				infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{
+					{Name: "alias-a", CIDR: invalidCIDR},
+					{Name: "alias-b", CIDR: "10.251.0.5/16"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[0].cidr"),
+					"Detail": Equal("invalid CIDR address: invalid-cidr"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[1].cidr"),
+					"Detail": Equal("must be valid canonical CIDR"),
+				}))
+			})
+
+			It("should forbid overlapping CIDRs", func() {
+				infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{
+					{Name: "alias-a", CIDR: "10.250.128.0/17"},
+					{Name: "alias-b", CIDR: "10.10.0.0/25"},
+					{Name: "alias-c", CIDR: "100.96.0.0/16"},
+					{Name: "alias-d", CIDR: "10.251.0.0/16"},
+					{Name: "alias-e", CIDR: "10.251.0.0/24"},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[0].cidr"),
+					"Detail": Equal(`must not overlap with "networks.workers" ("10.250.0.0/16")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[1].cidr"),
+					"Detail": Equal(`must not overlap with "networks.internal" ("10.10.0.0/24")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[2].cidr"),
+					"Detail": Equal(`must not overlap with "networking.pods" ("100.96.0.0/11")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[4].cidr"),
+					"Detail": Equal(`must not overlap with "networks.secondaryRanges[3].cidr" ("10.251.0.0/16")`),
+				}))
+			})
+
+			It("should allow secondary ranges for the pods and services of the shoot", func() {
+				infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{
+					{Name: "pods", CIDR: pods, Purpose: ptr.To(apisgcp.SecondaryRangePurposePods)},
+					{Name: "services", CIDR: services, Purpose: ptr.To(apisgcp.SecondaryRangePurposeServices)},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid secondary ranges for pods and services which differ from the CIDRs of the shoot", func() {
+				infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{
+					{Name: "pods", CIDR: "100.96.0.0/12", Purpose: ptr.To(apisgcp.SecondaryRangePurposePods)},
+					{Name: "services", CIDR: pods, Purpose: ptr.To(apisgcp.SecondaryRangePurposeServices)},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[0].cidr"),
+					"Detail": Equal("secondary range with purpose pods must be equal to the pods CIDR 100.96.0.0/11 of the shoot"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[1].cidr"),
+					"Detail": Equal("secondary range with purpose services must be equal to the services CIDR 100.64.0.0/13 of the shoot"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[1].cidr"),
+					"Detail": Equal(`must not overlap with "networking.pods" ("100.96.0.0/11")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.secondaryRanges[1].cidr"),
+					"Detail": Equal(`must not overlap with "networks.secondaryRanges[0].cidr" ("100.96.0.0/12")`),
+				}))
+			})
+
+			It("should forbid unsupported and duplicate purposes", func() {
+				infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{
+					{Name: "pods", CIDR: pods, Purpose: ptr.To(apisgcp.SecondaryRangePurposePods)},
+					{Name: "pods-2", CIDR: "10.251.0.0/16", Purpose: ptr.To(apisgcp.SecondaryRangePurposePods)},
+					{Name: "nodes", CIDR: "10.252.0.0/16", Purpose: ptr.To(apisgcp.SecondaryRangePurpose("nodes"))},
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services, fldPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.secondaryRanges[1].purpose"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.secondaryRanges[1].cidr"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.secondaryRanges[2].purpose"),
+				}))
+			})
+		})
+	})
+
+	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should return no errors for an unchanged config", func() {
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow changing cloudNAT AND Flow-logs details", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{
+				MinPortsPerVM: ptr.To[int32](30),
+				NatIPNames: []apisgcp.NatIPName{{
+					Name: "not-test",
+				}},
+			}
+			newInfrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{
+				AggregationInterval: ptr.To("INTERVAL_30_SEC"),
+			}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid changing infrastructure network details", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC = &apisgcp.VPC{
+				Name: "not-hugo",
+				CloudRouter: &apisgcp.CloudRouter{
+					Name: "not-hugo-cr",
+				},
+			}
+			newInfrastructureConfig.Networks.Workers = "10.96.0.0/16"
+			newInfrastructureConfig.Networks.Worker = "10.96.0.0/16"
+			newInfrastructureConfig.Networks.Internal = ptr.To("10.96.0.0/16")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc.name"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc.cloudRouter"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.internal"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.workers"),
+			}))
+		})
+
+		It("should allow adding zones and expanding their ranges", func() {
+			infrastructureConfig.Networks.Workers = ""
+			infrastructureConfig.Networks.Worker = ""
+			infrastructureConfig.Networks.Zones = []apisgcp.Zone{{Name: "europe-west1-b", Workers: "10.250.0.0/18"}}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = []apisgcp.Zone{
+				{Name: "europe-west1-b", Workers: "10.250.0.0/17"},
+				{Name: "europe-west1-c", Workers: "10.250.128.0/18"},
+			}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid shrinking the ranges of zones", func() {
+			infrastructureConfig.Networks.Workers = ""
+			infrastructureConfig.Networks.Worker = ""
+			infrastructureConfig.Networks.Zones = []apisgcp.Zone{{Name: "europe-west1-b", Workers: "10.250.0.0/18"}}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].Workers = "10.250.0.0/19"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].workers"),
+			}))
+		})
+
+		It("should forbid switching from the regional worker subnet to zones", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones = []apisgcp.Zone{{Name: "europe-west1-b", Workers: "10.250.0.0/18"}}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.zones"),
+			}))
+		})
+
+		It("should allow adding a proxy-only subnet but forbid changing its range", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.ProxyOnly = ptr.To("10.11.0.0/23")
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)).To(BeEmpty())
+
+			changedInfrastructureConfig := newInfrastructureConfig.DeepCopy()
+			changedInfrastructureConfig.Networks.ProxyOnly = ptr.To("10.12.0.0/23")
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, changedInfrastructureConfig, fldPath)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.proxyOnly"),
+			}))
+		})
+
+		It("should allow replacing the NAT IP names", func() {
+			infrastructureConfig.Networks.CloudNAT = &apisgcp.CloudNAT{NatIPNames: []apisgcp.NatIPName{{Name: "nat-a"}, {Name: "nat-b"}}}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.CloudNAT.NatIPNames = []apisgcp.NatIPName{{Name: "nat-c"}}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should allow adding secondary ranges", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{{Name: "alias-a", CIDR: "10.251.0.0/16"}}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid changing the CIDR of an existing secondary range", func() {
+			infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{{Name: "alias-a", CIDR: "10.251.0.0/16"}}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.SecondaryRanges[0].CIDR = "10.252.0.0/16"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.secondaryRanges[0].cidr"),
+			}))
+		})
+
+		It("should forbid changing the purpose of an existing secondary range", func() {
+			infrastructureConfig.Networks.SecondaryRanges = []apisgcp.SecondaryRange{{Name: "alias-a", CIDR: "10.251.0.0/16"}}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.SecondaryRanges[0].Purpose = ptr.To(apisgcp.SecondaryRangePurposePods)
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.secondaryRanges[0].purpose"),
+			}))
+		})
+
+		It("should forbid updating VPC value to nil", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC = nil
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc"),
+			}))
+		})
+
+		It("should allow expanding the worker subnet", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Workers = "10.250.0.0/15"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid shrinking the worker subnet", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Workers = "10.250.0.0/17"
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.workers"),
+			}))
+		})
+
+		It("should allow setting the default IPv6 access types explicitly", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.IPv6 = &apisgcp.IPv6Config{
+				WorkersAccessType:  ptr.To(apisgcp.IPv6AccessTypeExternal),
+				InternalAccessType: ptr.To(apisgcp.IPv6AccessTypeInternal),
+			}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid changing the existing subnets", func() {
+			infrastructureConfig.Networks.ExistingSubnets = &apisgcp.ExistingSubnets{Workers: ptr.To("hugo-nodes")}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.ExistingSubnets.Workers = ptr.To("other-nodes")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.existingSubnets"),
+			}))
+		})
+
+		It("should allow setting the default routing mode explicitly", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.RoutingMode = ptr.To("REGIONAL")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid changing the routing mode", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.RoutingMode = ptr.To("GLOBAL")
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.routingMode"),
+			}))
+		})
+
+		It("should forbid changing the MTU", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.MTU = ptr.To[int32](1500)
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.mtu"),
+			}))
+		})
+
+		It("should forbid changing the IPv6 access types", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.IPv6 = &apisgcp.IPv6Config{
+				WorkersAccessType: ptr.To(apisgcp.IPv6AccessTypeInternal),
+			}
+
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, fldPath)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.ipv6.workersAccessType"),
 			}))
 		})
 	})
@@ -5,30 +5,79 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
-var projectIDRegexp = regexp.MustCompile(`^(?P<project>[a-z][a-z0-9-]{4,28}[a-z0-9])$`)
+var (
+	projectIDRegexp = regexp.MustCompile(`^(?P<project>[a-z][a-z0-9-]{4,28}[a-z0-9])$`)
 
-// ValidateCloudProviderSecret checks whether the given secret contains a valid GCP service account.
+	// allowedCredentialTypes are the types of credentials which are accepted in the serviceaccount.json field. Other
+	// types, e.g. `external_account`, are forbidden because they can make the extension read arbitrary files or URLs.
+	allowedCredentialTypes = []string{
+		gcp.ServiceAccountCredentialType,
+		gcp.AuthorizedUserCredentialType,
+		gcp.ExternalAccountAuthorizedUserCredentialType,
+	}
+)
+
+// stsTokenURLPrefix is the prefix of the token URL of the Security Token Service, which is the only token URL allowed
+// for credentials of external account users.
+const stsTokenURLPrefix = "https://sts.googleapis.com/"
+
+// ValidateCloudProviderSecret checks whether the given secret contains valid GCP credentials. The optional project of
+// the DNS managed zones and the optional credentials of a dedicated DNS service account are validated as well.
 func ValidateCloudProviderSecret(secret *corev1.Secret) error {
-	serviceAccountJSON, ok := secret.Data[gcp.ServiceAccountJSONField]
-	if !ok {
+	if _, ok := secret.Data[gcp.ServiceAccountJSONField]; !ok {
 		return fmt.Errorf("missing %q field in secret", gcp.ServiceAccountJSONField)
 	}
 
-	sa, err := gcp.GetServiceAccountFromJSON(serviceAccountJSON)
+	sa, err := gcp.GetServiceAccountFromSecret(secret)
 	if err != nil {
 		return err
 	}
+	if err := validateCredentials(sa); err != nil {
+		return err
+	}
+
+	if dnsProjectID, ok := secret.Data[gcp.DNSProjectIDField]; ok && !projectIDRegexp.Match(dnsProjectID) {
+		return fmt.Errorf("DNS project ID does not match the expected format '%s'", projectIDRegexp)
+	}
+	if _, ok := secret.Data[gcp.DNSServiceAccountJSONField]; ok {
+		dnsSA, err := gcp.GetDNSServiceAccountFromSecret(secret)
+		if err != nil {
+			return err
+		}
+		if err := validateCredentials(dnsSA); err != nil {
+			return fmt.Errorf("invalid %q field: %w", gcp.DNSServiceAccountJSONField, err)
+		}
+	}
+
+	return nil
+}
+
+func validateCredentials(sa *gcp.ServiceAccount) error {
+	if !slices.Contains(allowedCredentialTypes, sa.Type) {
+		return fmt.Errorf("forbidden credential type %q used. Only %q are allowed", sa.Type, allowedCredentialTypes)
+	}
 
-	if sa.Type != gcp.ServiceAccountCredentialType {
-		return fmt.Errorf("forbidden credential type %q used. Only %q is allowed", sa.Type, gcp.ServiceAccountCredentialType)
+	if sa.Type == gcp.ExternalAccountAuthorizedUserCredentialType {
+		var credentials struct {
+			TokenURL string `json:"token_url"`
+		}
+		if err := json.Unmarshal(sa.Raw, &credentials); err != nil {
+			return err
+		}
+		if !strings.HasPrefix(credentials.TokenURL, stsTokenURLPrefix) {
+			return fmt.Errorf("token URL %q is not allowed, it must start with %q", credentials.TokenURL, stsTokenURLPrefix)
+		}
 	}
 
 	if !projectIDRegexp.MatchString(sa.ProjectID) {
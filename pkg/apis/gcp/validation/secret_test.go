@@ -47,6 +47,46 @@ var _ = Describe("Secret validation", func() {
 		Entry("should succeed when the credential type and project ID is valid",
 			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"project_id": "my-project", "type": "service_account"}`)},
 			BeNil()),
+		Entry("should succeed for the application default credentials of a user with the project ID",
+			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"type": "authorized_user", "client_id": "id", "client_secret": "secret", "refresh_token": "token", "project_id": "my-project"}`)},
+			BeNil()),
+		Entry("should return error for the credentials of a user without project ID",
+			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"type": "authorized_user", "client_id": "id", "client_secret": "secret", "refresh_token": "token"}`)},
+			HaveOccurred()),
+		Entry("should return error for the credentials of a user with the project ID only in the secret",
+			map[string][]byte{
+				gcp.ServiceAccountJSONField: []byte(`{"type": "authorized_user", "client_id": "id", "client_secret": "secret", "refresh_token": "token", "quota_project_id": "my-project"}`),
+				gcp.ProjectIDField:          []byte("my-project"),
+			},
+			HaveOccurred()),
+		Entry("should succeed for the credentials of an external account user with the STS token URL",
+			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"type": "external_account_authorized_user", "token_url": "https://sts.googleapis.com/v1/oauthtoken", "project_id": "my-project"}`)},
+			BeNil()),
+		Entry("should return error for the credentials of an external account user with another token URL",
+			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"type": "external_account_authorized_user", "token_url": "https://example.com/token", "project_id": "my-project"}`)},
+			HaveOccurred()),
+		Entry("should return error for the credentials of an external account",
+			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"project_id": "my-project", "type": "external_account"}`)},
+			HaveOccurred()),
+		Entry("should succeed for a DNS project and the credentials of a dedicated DNS service account",
+			map[string][]byte{
+				gcp.ServiceAccountJSONField:    []byte(`{"project_id": "my-project", "type": "service_account"}`),
+				gcp.DNSProjectIDField:          []byte("my-dns-project"),
+				gcp.DNSServiceAccountJSONField: []byte(`{"project_id": "my-dns-project", "type": "service_account"}`),
+			},
+			BeNil()),
+		Entry("should return error for an invalid DNS project ID",
+			map[string][]byte{
+				gcp.ServiceAccountJSONField: []byte(`{"project_id": "my-project", "type": "service_account"}`),
+				gcp.DNSProjectIDField:       []byte("0dns"),
+			},
+			HaveOccurred()),
+		Entry("should return error for the credentials of an external account as dedicated DNS service account",
+			map[string][]byte{
+				gcp.ServiceAccountJSONField:    []byte(`{"project_id": "my-project", "type": "service_account"}`),
+				gcp.DNSServiceAccountJSONField: []byte(`{"project_id": "my-dns-project", "type": "external_account"}`),
+			},
+			HaveOccurred()),
 		Entry("should fail when the credential type is in not in the allowed list",
 			map[string][]byte{gcp.ServiceAccountJSONField: []byte(`{"project_id": "my-project", "type": "service_account"}`)},
 			BeNil()),
@@ -5,24 +5,90 @@
 package validation
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/helper"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
+// managedZoneRegexp matches the names and the numeric IDs of DNS managed zones.
+var managedZoneRegexp = regexp.MustCompile(`^([a-z]([-a-z0-9]{0,61}[a-z0-9])?|[0-9]+)$`)
+
 // ValidateNetworking validates the network settings of a Shoot.
 func ValidateNetworking(networking *core.Networking, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if networking.Nodes == nil {
+	if len(networking.IPFamilies) > 1 && !isDualStack(networking) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipFamilies"), "dual-stack networking is only supported with IPv4 as primary IP family for GCP shoots"))
+	}
+
+	// The node addresses of IPv6-only shoots are assigned from the IPv6 range which GCP allocates for the worker subnet.
+	if networking.Nodes == nil && !isIPv6SingleStack(networking) {
 		allErrs = append(allErrs, field.Required(fldPath.Child("nodes"), "a nodes CIDR must be provided for GCP shoots"))
 	}
 
 	return allErrs
 }
 
+func isIPv6SingleStack(networking *core.Networking) bool {
+	return len(networking.IPFamilies) == 1 && networking.IPFamilies[0] == core.IPFamilyIPv6
+}
+
+func isDualStack(networking *core.Networking) bool {
+	return len(networking.IPFamilies) == 2 && networking.IPFamilies[0] == core.IPFamilyIPv4 && networking.IPFamilies[1] == core.IPFamilyIPv6
+}
+
+// ValidateDNS validates the Cloud DNS providers of a Shoot. The managed zones of the providers are selected by their
+// name or ID, optionally prefixed with the project hosting them, i.e. `<project>/<zone>`.
+func ValidateDNS(dns *core.DNS, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if dns == nil {
+		return allErrs
+	}
+
+	for i, provider := range dns.Providers {
+		if ptr.Deref(provider.Type, "") != gcp.DNSType || provider.Zones == nil {
+			continue
+		}
+
+		zonesPath := fldPath.Child("providers").Index(i).Child("zones")
+		for j, zone := range provider.Zones.Include {
+			allErrs = append(allErrs, validateManagedZoneID(zone, zonesPath.Child("include").Index(j))...)
+		}
+		for j, zone := range provider.Zones.Exclude {
+			allErrs = append(allErrs, validateManagedZoneID(zone, zonesPath.Child("exclude").Index(j))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateManagedZoneID(zoneID string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	zone := zoneID
+	if project, name, ok := strings.Cut(zoneID, "/"); ok {
+		if !projectIDRegexp.MatchString(project) {
+			allErrs = append(allErrs, field.Invalid(fldPath, zoneID, "project must be a valid GCP project ID"))
+		}
+		zone = name
+	}
+	if !managedZoneRegexp.MatchString(zone) {
+		allErrs = append(allErrs, field.Invalid(fldPath, zoneID, "must be the name or the ID of a DNS managed zone, optionally prefixed with its project, i.e. <project>/<zone>"))
+	}
+
+	return allErrs
+}
+
 // ValidateWorkers validates the workers of a Shoot.
 func ValidateWorkers(workers []core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -34,6 +100,7 @@ func ValidateWorkers(workers []core.Worker, fldPath *field.Path) field.ErrorList
 			allErrs = append(allErrs, field.Required(workerFldPath.Child("volume"), "must not be nil"))
 		} else {
 			allErrs = append(allErrs, validateVolume(worker.Volume, workerFldPath.Child("volume"))...)
+			allErrs = append(allErrs, validatePersistentDiskLimits(worker, workerFldPath)...)
 		}
 
 		if len(worker.Zones) == 0 {
@@ -57,15 +124,28 @@ func validateVolume(vol *core.Volume, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
-// ValidateWorkersUpdate validates updates on Workers.
+// ValidateWorkersUpdate validates updates on Workers. Zones can be removed from a worker, as their machines are migrated
+// by the worker controller, and new zones can be appended. The remaining zones must keep their order, because the
+// minimum and maximum of a worker are distributed over its zones by their index.
 func ValidateWorkersUpdate(oldWorkers, newWorkers []core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, newWorker := range newWorkers {
 		workerFldPath := fldPath.Index(i)
 		oldWorker := helper.FindWorkerByName(oldWorkers, newWorker.Name)
+		if oldWorker == nil {
+			continue
+		}
+
+		newZones := sets.New(newWorker.Zones...)
+		var remainingZones []string
+		for _, zone := range oldWorker.Zones {
+			if newZones.Has(zone) {
+				remainingZones = append(remainingZones, zone)
+			}
+		}
 
-		if oldWorker != nil && validationutils.ShouldEnforceImmutability(newWorker.Zones, oldWorker.Zones) {
-			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.Zones, oldWorker.Zones, workerFldPath.Child("zones"))...)
+		if validationutils.ShouldEnforceImmutability(newWorker.Zones, remainingZones) {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.Zones, remainingZones, workerFldPath.Child("zones"))...)
 		}
 	}
 	return allErrs
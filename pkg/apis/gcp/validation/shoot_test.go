@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"fmt"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -50,7 +52,85 @@ var _ = Describe("Shoot validation", func() {
 				})),
 			))
 		})
+
+		It("should not require a nodes CIDR for IPv6 single-stack shoots", func() {
+			networking := &core.Networking{
+				IPFamilies: []core.IPFamily{core.IPFamilyIPv6},
+			}
+
+			errorList := ValidateNetworking(networking, networkingPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should allow dual-stack networking", func() {
+			networking := &core.Networking{
+				Nodes:      ptr.To("1.2.3.4/5"),
+				IPFamilies: []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6},
+			}
+
+			errorList := ValidateNetworking(networking, networkingPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid dual-stack networking with IPv6 as primary IP family", func() {
+			networking := &core.Networking{
+				Nodes:      ptr.To("1.2.3.4/5"),
+				IPFamilies: []core.IPFamily{core.IPFamilyIPv6, core.IPFamilyIPv4},
+			}
+
+			errorList := ValidateNetworking(networking, networkingPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.networking.ipFamilies"),
+				})),
+			))
+		})
 	})
+	Describe("#ValidateDNS", func() {
+		var fldPath = field.NewPath("spec", "dns")
+
+		It("should allow zones of other projects", func() {
+			dns := &core.DNS{Providers: []core.DNSProvider{{
+				Type:  ptr.To("google-clouddns"),
+				Zones: &core.DNSIncludeExclude{Include: []string{"my-zone", "dns-project/shoot-zone", "dns-project/1234567890"}},
+			}}}
+
+			Expect(ValidateDNS(dns, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid project and zone combinations", func() {
+			dns := &core.DNS{Providers: []core.DNSProvider{
+				{
+					Type:  ptr.To("aws-route53"),
+					Zones: &core.DNSIncludeExclude{Include: []string{"Z1234/foo/bar"}},
+				},
+				{
+					Type:  ptr.To("google-clouddns"),
+					Zones: &core.DNSIncludeExclude{Include: []string{"0project/zone"}, Exclude: []string{"dns-project/", "dns-project/zone/foo"}},
+				},
+			}}
+
+			Expect(ValidateDNS(dns, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.dns.providers[1].zones.include[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.dns.providers[1].zones.exclude[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.dns.providers[1].zones.exclude[1]"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateWorkers", func() {
 		var workers []core.Worker
 
@@ -82,13 +162,60 @@ var _ = Describe("Shoot validation", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should forbid more data volumes than persistent disks can be attached", func() {
+			workers[0].Machine.Type = "e2-small"
+			for i := 0; i < 16; i++ {
+				workers[0].DataVolumes = append(workers[0].DataVolumes, core.DataVolume{Name: fmt.Sprintf("data-%d", i), VolumeSize: "10Gi"})
+			}
+			workers[0].DataVolumes = append(workers[0].DataVolumes, core.DataVolume{Name: "scratch", Type: ptr.To("SCRATCH"), VolumeSize: "375Gi"})
+
+			errorList := ValidateWorkers(workers, field.NewPath("workers"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("workers[0].dataVolumes"),
+				})),
+			))
+		})
+
+		It("should forbid data volumes exceeding the total size of persistent disks", func() {
+			workers[0].Machine.Type = "e2-medium"
+			workers[0].DataVolumes = []core.DataVolume{
+				{Name: "data-1", VolumeSize: "2Ti"},
+				{Name: "data-2", VolumeSize: "1Ti"},
+			}
+
+			errorList := ValidateWorkers(workers, field.NewPath("workers"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("workers[0].dataVolumes"),
+					"Detail": ContainSubstring(`exceeds the limit of 3Ti for machine type "e2-medium"`),
+				})),
+			))
+		})
+
+		It("should allow large data volumes for other machine types", func() {
+			workers[0].Machine.Type = "n2-standard-4"
+			workers[0].DataVolumes = []core.DataVolume{
+				{Name: "data-1", VolumeSize: "2Ti"},
+				{Name: "data-2", VolumeSize: "1Ti"},
+			}
+
+			errorList := ValidateWorkers(workers, field.NewPath("workers"))
+
+			Expect(errorList).To(BeEmpty())
+		})
 	})
 })
 
 func validateWorkerConfig(workers []core.Worker, workerConfig *api.WorkerConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for _, worker := range workers {
-		allErrs = append(allErrs, ValidateWorkerConfig(workerConfig, worker.DataVolumes)...)
+		allErrs = append(allErrs, ValidateWorkerConfig(workerConfig, worker.Machine.Type, worker.DataVolumes)...)
 	}
 
 	return allErrs
@@ -5,28 +5,52 @@
 package validation
 
 import (
+	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 )
 
-var validVolumeLocalSSDInterfacesTypes = sets.New("NVME", "SCSI")
+const (
+	// maxLocalSSDRecoveryTimeout is the maximum local SSD recovery timeout supported by GCP.
+	maxLocalSSDRecoveryTimeout = 168 * time.Hour
+	// minFlexStartMaxRunDuration and maxFlexStartMaxRunDuration are the limits of the run duration of flex-start VMs
+	// supported by GCP.
+	minFlexStartMaxRunDuration = 10 * time.Minute
+	maxFlexStartMaxRunDuration = 7 * 24 * time.Hour
+)
+
+var (
+	validVolumeLocalSSDInterfacesTypes = sets.New(gcp.LocalSSDInterfaceNVME, gcp.LocalSSDInterfaceSCSI)
+	validOnHostMaintenanceValues       = sets.New(gcp.OnHostMaintenanceMigrate, gcp.OnHostMaintenanceTerminate)
+	validProvisioningModels            = sets.New(gcp.ProvisioningModelStandard, gcp.ProvisioningModelFlexStart)
+)
 
 // ValidateWorkerConfig validates a WorkerConfig object.
-func ValidateWorkerConfig(workerConfig *gcp.WorkerConfig, dataVolumes []core.DataVolume) field.ErrorList {
+func ValidateWorkerConfig(workerConfig *gcp.WorkerConfig, machineType string, dataVolumes []core.DataVolume) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	hasLocalSSDs := false
 	for _, volume := range dataVolumes {
 		if volume.Type != nil && *volume.Type == "SCRATCH" {
+			hasLocalSSDs = true
 			if workerConfig == nil || workerConfig.Volume == nil || workerConfig.Volume.LocalSSDInterface == nil {
 				allErrs = append(allErrs, field.Required(field.NewPath("volume", "localSSDInterface"), "must be set when using SCRATCH volumes"))
 			} else {
 				if !validVolumeLocalSSDInterfacesTypes.Has(*workerConfig.Volume.LocalSSDInterface) {
 					allErrs = append(allErrs, field.NotSupported(field.NewPath("volume", "localSSDInterface"), *workerConfig.Volume.LocalSSDInterface, validVolumeLocalSSDInterfacesTypes.UnsortedList()))
+				} else if *workerConfig.Volume.LocalSSDInterface == gcp.LocalSSDInterfaceSCSI && helper.SupportsOnlyNVMeLocalSSD(machineType) {
+					allErrs = append(allErrs, field.Forbidden(field.NewPath("volume", "localSSDInterface"), fmt.Sprintf("machine type %q only supports the %s interface for local SSDs", machineType, gcp.LocalSSDInterfaceNVME)))
 				}
 			}
 		}
@@ -38,6 +62,48 @@ func ValidateWorkerConfig(workerConfig *gcp.WorkerConfig, dataVolumes []core.Dat
 		if workerConfig.Volume != nil {
 			allErrs = append(allErrs, validateDiskEncryption(workerConfig.Volume.Encryption, field.NewPath("volume", "encryption"))...)
 		}
+		allErrs = append(allErrs, validateAliasIPRange(workerConfig.AliasIPRange, field.NewPath("aliasIPRange"))...)
+		allErrs = append(allErrs, validateCanaryRollout(workerConfig.CanaryRollout, field.NewPath("canaryRollout"))...)
+		allErrs = append(allErrs, validateScheduling(workerConfig.Scheduling, machineType, workerConfig.GPU, hasLocalSSDs, field.NewPath("scheduling"))...)
+		allErrs = append(allErrs, validateImageStreaming(workerConfig.ImageStreaming, field.NewPath("imageStreaming"))...)
+		if ptr.Deref(workerConfig.MountHyperdiskML, false) && !helper.SupportsHyperdiskML(machineType) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("mountHyperdiskML"), fmt.Sprintf("machine type %q does not support Hyperdisk ML volumes", machineType)))
+		}
+	}
+
+	return allErrs
+}
+
+// validatePersistentDiskLimits validates that the boot and data volumes of a worker do not exceed the number and total
+// size of persistent disks which can be attached to a VM of its machine type. Local SSDs are not persistent disks and
+// do not count against these limits. Volumes with invalid sizes are already reported by the Gardener validation.
+func validatePersistentDiskLimits(worker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		maxDisks, maxTotalSize = helper.PersistentDiskLimits(worker.Machine.Type)
+		disks                  = 1
+		totalSize              resource.Quantity
+	)
+
+	if size, err := resource.ParseQuantity(worker.Volume.VolumeSize); err == nil {
+		totalSize.Add(size)
+	}
+	for _, volume := range worker.DataVolumes {
+		if volume.Type != nil && *volume.Type == "SCRATCH" {
+			continue
+		}
+		disks++
+		if size, err := resource.ParseQuantity(volume.VolumeSize); err == nil {
+			totalSize.Add(size)
+		}
+	}
+
+	if disks > maxDisks {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("dataVolumes"), disks-1, maxDisks-1))
+	}
+	if totalSize.Cmp(maxTotalSize) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataVolumes"), fmt.Sprintf("total size %s of boot and data volumes exceeds the limit of %s for machine type %q", totalSize.String(), maxTotalSize.String(), worker.Machine.Type)))
 	}
 
 	return allErrs
@@ -92,6 +158,138 @@ func validateServiceAccount(sa *gcp.ServiceAccount, fldPath *field.Path) field.E
 	return allErrs
 }
 
+func validateAliasIPRange(aliasIPRange *gcp.AliasIPRange, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if aliasIPRange == nil {
+		return allErrs
+	}
+
+	if aliasIPRange.SubnetworkRangeName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("subnetworkRangeName"), "must be set when providing an alias IP range"))
+	}
+
+	if prefix, ok := strings.CutPrefix(aliasIPRange.IPCidrRange, "/"); !ok {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipCidrRange"), aliasIPRange.IPCidrRange, "must be a prefix length in the format '/N'"))
+	} else if size, err := strconv.Atoi(prefix); err != nil || size < 1 || size > 32 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipCidrRange"), aliasIPRange.IPCidrRange, "prefix length must be between 1 and 32"))
+	}
+
+	return allErrs
+}
+
+func validateCanaryRollout(canaryRollout *gcp.CanaryRollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if canaryRollout == nil {
+		return allErrs
+	}
+
+	if canaryRollout.Machines <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machines"), canaryRollout.Machines, "must be > 0"))
+	}
+
+	if canaryRollout.SoakDuration != nil && canaryRollout.SoakDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("soakDuration"), canaryRollout.SoakDuration.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateScheduling(scheduling *gcp.Scheduling, machineType string, gpu *gcp.GPU, hasLocalSSDs bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if scheduling == nil {
+		return allErrs
+	}
+
+	if scheduling.OnHostMaintenance != nil {
+		onHostMaintenance := *scheduling.OnHostMaintenance
+		if !validOnHostMaintenanceValues.Has(onHostMaintenance) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("onHostMaintenance"), onHostMaintenance, sets.List(validOnHostMaintenanceValues)))
+		} else if onHostMaintenance == gcp.OnHostMaintenanceMigrate && !helper.SupportsLiveMigration(machineType, gpu) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("onHostMaintenance"), fmt.Sprintf("machine type %q with attached GPUs does not support live migration", machineType)))
+		}
+	}
+
+	if timeout := scheduling.LocalSSDRecoveryTimeout; timeout != nil {
+		timeoutPath := fldPath.Child("localSsdRecoveryTimeout")
+		if !hasLocalSSDs {
+			allErrs = append(allErrs, field.Forbidden(timeoutPath, "can only be set for worker pools with SCRATCH volumes"))
+		} else if timeout.Duration < 0 || timeout.Duration > maxLocalSSDRecoveryTimeout {
+			allErrs = append(allErrs, field.Invalid(timeoutPath, timeout.Duration.String(), fmt.Sprintf("must be between 0 and %s", maxLocalSSDRecoveryTimeout)))
+		} else if timeout.Duration%time.Hour != 0 {
+			allErrs = append(allErrs, field.Invalid(timeoutPath, timeout.Duration.String(), "must be a multiple of one hour"))
+		}
+	}
+
+	allErrs = append(allErrs, validateProvisioningModel(scheduling, machineType, gpu, fldPath)...)
+
+	return allErrs
+}
+
+func validateProvisioningModel(scheduling *gcp.Scheduling, machineType string, gpu *gcp.GPU, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	provisioningModel := ptr.Deref(scheduling.ProvisioningModel, gcp.ProvisioningModelStandard)
+	if !validProvisioningModels.Has(provisioningModel) {
+		return append(allErrs, field.NotSupported(fldPath.Child("provisioningModel"), provisioningModel, sets.List(validProvisioningModels)))
+	}
+
+	if provisioningModel != gcp.ProvisioningModelFlexStart {
+		if scheduling.MaxRunDuration != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxRunDuration"), fmt.Sprintf("can only be set with the %s provisioning model", gcp.ProvisioningModelFlexStart)))
+		}
+		return allErrs
+	}
+
+	if !helper.HasAttachedGPUs(machineType, gpu) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("provisioningModel"), fmt.Sprintf("the %s provisioning model is only supported for machines with attached GPUs", gcp.ProvisioningModelFlexStart)))
+	}
+	if scheduling.AutomaticRestart != nil && *scheduling.AutomaticRestart {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("automaticRestart"), fmt.Sprintf("is not supported with the %s provisioning model", gcp.ProvisioningModelFlexStart)))
+	}
+	if scheduling.MaxRunDuration == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("maxRunDuration"), fmt.Sprintf("must be set with the %s provisioning model", gcp.ProvisioningModelFlexStart)))
+	} else if d := scheduling.MaxRunDuration.Duration; d < minFlexStartMaxRunDuration || d > maxFlexStartMaxRunDuration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxRunDuration"), d.String(), fmt.Sprintf("must be between %s and %s", minFlexStartMaxRunDuration, maxFlexStartMaxRunDuration)))
+	}
+
+	return allErrs
+}
+
+func validateImageStreaming(imageStreaming *gcp.ImageStreaming, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if imageStreaming == nil {
+		return allErrs
+	}
+
+	upstreams := sets.New[string]()
+	for i, mirror := range imageStreaming.Mirrors {
+		idxPath := fldPath.Child("mirrors").Index(i)
+
+		switch {
+		case mirror.Upstream == "":
+			allErrs = append(allErrs, field.Required(idxPath.Child("upstream"), "must not be empty"))
+		case strings.Contains(mirror.Upstream, "/"):
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("upstream"), mirror.Upstream, "must be a registry host without scheme and path"))
+		case upstreams.Has(mirror.Upstream):
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("upstream"), mirror.Upstream))
+		default:
+			upstreams.Insert(mirror.Upstream)
+		}
+
+		if mirror.Repository == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("repository"), "must not be empty"))
+		} else if u, err := url.Parse(mirror.Repository); err != nil || u.Scheme != "https" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("repository"), mirror.Repository, "must be an https URL"))
+		}
+	}
+
+	return allErrs
+}
+
 // validateDiskEncryption validates the provider specific disk encryption configuration for a volume
 func validateDiskEncryption(encryption *gcp.DiskEncryption, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -5,10 +5,13 @@
 package validation_test
 
 import (
+	"time"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -144,6 +147,48 @@ var _ = Describe("#ValidateWorkers", func() {
 		))
 	})
 
+	It("should forbid SCSI local SSDs for machine types that only support NVMe", func() {
+		workers[1].Machine.Type = "c3-standard-8"
+
+		errorList := validateWorkerConfig(workers, &gcp.WorkerConfig{
+			Volume: &gcp.Volume{
+				LocalSSDInterface: ptr.To("SCSI"),
+			},
+		})
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("volume.localSSDInterface"),
+			})),
+		))
+	})
+
+	It("should allow SCSI local SSDs for older machine types", func() {
+		workers[1].Machine.Type = "n2-standard-8"
+
+		errorList := validateWorkerConfig(workers, &gcp.WorkerConfig{
+			Volume: &gcp.Volume{
+				LocalSSDInterface: ptr.To("SCSI"),
+			},
+		})
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should allow Hyperdisk ML volumes for supported machine types", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{MountHyperdiskML: ptr.To(true)}, "c3-standard-8", nil)
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should forbid Hyperdisk ML volumes for unsupported machine types", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{MountHyperdiskML: ptr.To(true)}, "n2-standard-8", nil)
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("mountHyperdiskML"),
+			})),
+		))
+	})
+
 	It("should forbid because interface of worker config is not configured", func() {
 
 		errorList := validateWorkerConfig(workers, nil)
@@ -161,7 +206,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				Email:  "",
 				Scopes: []string{"scope-1"},
 			},
-		}, nil)
+		}, "n1-standard-2", nil)
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -178,7 +223,7 @@ var _ = Describe("#ValidateWorkers", func() {
 					KmsKeyName: ptr.To("  "),
 				},
 			},
-		}, nil)
+		}, "n1-standard-2", nil)
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -194,7 +239,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				Email:  "foo",
 				Scopes: []string{},
 			},
-		}, nil)
+		}, "n1-standard-2", nil)
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -210,7 +255,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				Email:  "foo",
 				Scopes: []string{"baz", ""},
 			},
-		}, nil)
+		}, "n1-standard-2", nil)
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -226,7 +271,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				Email:  "foo",
 				Scopes: []string{"baz", "bar", "baz"},
 			},
-		}, nil)
+		}, "n1-standard-2", nil)
 
 		Expect(errorList).To(ConsistOf(
 			PointTo(MatchFields(IgnoreExtras, Fields{
@@ -242,7 +287,7 @@ var _ = Describe("#ValidateWorkers", func() {
 				Email:  "foo",
 				Scopes: []string{"baz"},
 			},
-		}, nil)
+		}, "n1-standard-2", nil)
 
 		Expect(errorList).To(BeEmpty())
 	})
@@ -258,6 +303,7 @@ var _ = Describe("#ValidateWorkers", func() {
 					Scopes: []string{"baz"},
 				},
 			},
+			"n1-standard-2",
 			nil,
 		)
 
@@ -280,6 +326,7 @@ var _ = Describe("#ValidateWorkers", func() {
 					Scopes: []string{"baz"},
 				},
 			},
+			"n1-standard-2",
 			nil,
 		)
 
@@ -302,12 +349,345 @@ var _ = Describe("#ValidateWorkers", func() {
 					Scopes: []string{"baz"},
 				},
 			},
+			"n1-standard-2",
 			nil,
 		)
 
 		Expect(errorList).To(BeEmpty())
 	})
 
+	It("should allow valid alias IP range", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			AliasIPRange: &gcp.AliasIPRange{
+				SubnetworkRangeName: "alias",
+				IPCidrRange:         "/24",
+			},
+		}, "n1-standard-2", nil)
+
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should forbid because alias IP range is misconfigured", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			AliasIPRange: &gcp.AliasIPRange{
+				IPCidrRange: "10.0.0.0/24",
+			},
+		}, "n1-standard-2", nil)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("aliasIPRange.subnetworkRangeName"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("aliasIPRange.ipCidrRange"),
+			})),
+		))
+	})
+
+	It("should forbid because alias IP range prefix length is out of bounds", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			AliasIPRange: &gcp.AliasIPRange{
+				SubnetworkRangeName: "alias",
+				IPCidrRange:         "/33",
+			},
+		}, "n1-standard-2", nil)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("aliasIPRange.ipCidrRange"),
+			})),
+		))
+	})
+
+	It("should allow valid canary rollout", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			CanaryRollout: &gcp.CanaryRollout{
+				Machines:     1,
+				SoakDuration: &metav1.Duration{Duration: time.Hour},
+			},
+		}, "n1-standard-2", nil)
+
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should forbid because canary rollout is misconfigured", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			CanaryRollout: &gcp.CanaryRollout{
+				SoakDuration: &metav1.Duration{Duration: -time.Minute},
+			},
+		}, "n1-standard-2", nil)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("canaryRollout.machines"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("canaryRollout.soakDuration"),
+			})),
+		))
+	})
+
+	It("should allow valid scheduling options", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			Scheduling: &gcp.Scheduling{
+				OnHostMaintenance: ptr.To("TERMINATE"),
+				AutomaticRestart:  ptr.To(false),
+			},
+		}, "n1-standard-2", nil)
+
+		Expect(errorList).To(BeEmpty())
+	})
+
+	It("should forbid unsupported onHostMaintenance values", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			Scheduling: &gcp.Scheduling{
+				OnHostMaintenance: ptr.To("FOO"),
+			},
+		}, "n1-standard-2", nil)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("scheduling.onHostMaintenance"),
+			})),
+		))
+	})
+
+	It("should forbid live migration for machines with GPUs", func() {
+		errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+			GPU: &gcp.GPU{
+				AcceleratorType: "nvidia-tesla-t4",
+				Count:           1,
+			},
+			Scheduling: &gcp.Scheduling{
+				OnHostMaintenance: ptr.To("MIGRATE"),
+			},
+		}, "n1-standard-2", nil)
+
+		Expect(errorList).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("scheduling.onHostMaintenance"),
+			})),
+		))
+	})
+
+	Describe("local SSD recovery timeout", func() {
+		var dataVolumes []core.DataVolume
+
+		BeforeEach(func() {
+			dataVolumes = []core.DataVolume{{Type: ptr.To("SCRATCH")}}
+		})
+
+		It("should allow a valid local SSD recovery timeout", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Volume: &gcp.Volume{LocalSSDInterface: ptr.To("NVME")},
+				Scheduling: &gcp.Scheduling{
+					LocalSSDRecoveryTimeout: &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			}, "n1-standard-2", dataVolumes)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid a local SSD recovery timeout without local SSDs", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Scheduling: &gcp.Scheduling{
+					LocalSSDRecoveryTimeout: &metav1.Duration{Duration: time.Hour},
+				},
+			}, "n1-standard-2", nil)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("scheduling.localSsdRecoveryTimeout"),
+				})),
+			))
+		})
+
+		DescribeTable("should forbid invalid local SSD recovery timeouts",
+			func(timeout time.Duration) {
+				errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+					Volume: &gcp.Volume{LocalSSDInterface: ptr.To("NVME")},
+					Scheduling: &gcp.Scheduling{
+						LocalSSDRecoveryTimeout: &metav1.Duration{Duration: timeout},
+					},
+				}, "n1-standard-2", dataVolumes)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("scheduling.localSsdRecoveryTimeout"),
+					})),
+				))
+			},
+			Entry("negative", -time.Hour),
+			Entry("too long", 169*time.Hour),
+			Entry("not a multiple of one hour", 90*time.Minute),
+		)
+	})
+
+	Describe("flex-start provisioning model", func() {
+		var gpu *gcp.GPU
+
+		BeforeEach(func() {
+			gpu = &gcp.GPU{AcceleratorType: "nvidia-tesla-t4", Count: 1}
+		})
+
+		It("should allow the flex-start provisioning model for machines with GPUs", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				GPU: gpu,
+				Scheduling: &gcp.Scheduling{
+					ProvisioningModel: ptr.To("FLEX_START"),
+					MaxRunDuration:    &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			}, "n1-standard-2", nil)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid an unknown provisioning model", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Scheduling: &gcp.Scheduling{
+					ProvisioningModel: ptr.To("SPOT"),
+				},
+			}, "n1-standard-2", nil)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("scheduling.provisioningModel"),
+				})),
+			))
+		})
+
+		It("should forbid the flex-start provisioning model for machines without GPUs", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				Scheduling: &gcp.Scheduling{
+					ProvisioningModel: ptr.To("FLEX_START"),
+					MaxRunDuration:    &metav1.Duration{Duration: time.Hour},
+				},
+			}, "n1-standard-2", nil)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("scheduling.provisioningModel"),
+				})),
+			))
+		})
+
+		It("should require a max run duration and forbid automatic restarts", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				GPU: gpu,
+				Scheduling: &gcp.Scheduling{
+					ProvisioningModel: ptr.To("FLEX_START"),
+					AutomaticRestart:  ptr.To(true),
+				},
+			}, "n1-standard-2", nil)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("scheduling.automaticRestart"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("scheduling.maxRunDuration"),
+				})),
+			))
+		})
+
+		DescribeTable("should forbid invalid max run durations",
+			func(duration time.Duration) {
+				errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+					GPU: gpu,
+					Scheduling: &gcp.Scheduling{
+						ProvisioningModel: ptr.To("FLEX_START"),
+						MaxRunDuration:    &metav1.Duration{Duration: duration},
+					},
+				}, "n1-standard-2", nil)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("scheduling.maxRunDuration"),
+					})),
+				))
+			},
+			Entry("too short", time.Minute),
+			Entry("too long", 8*24*time.Hour),
+		)
+
+		It("should forbid a max run duration without the flex-start provisioning model", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				GPU: gpu,
+				Scheduling: &gcp.Scheduling{
+					MaxRunDuration: &metav1.Duration{Duration: time.Hour},
+				},
+			}, "n1-standard-2", nil)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("scheduling.maxRunDuration"),
+				})),
+			))
+		})
+	})
+
+	Describe("image streaming", func() {
+		It("should allow valid registry mirrors", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				ImageStreaming: &gcp.ImageStreaming{
+					LazyPulling: true,
+					Mirrors: []gcp.RegistryMirror{
+						{Upstream: "docker.io", Repository: "https://europe-docker.pkg.dev/v2/my-project/docker-hub"},
+						{Upstream: "ghcr.io", Repository: "https://europe-docker.pkg.dev/v2/my-project/ghcr"},
+					},
+				},
+			}, "n1-standard-2", nil)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid registry mirrors", func() {
+			errorList := ValidateWorkerConfig(&gcp.WorkerConfig{
+				ImageStreaming: &gcp.ImageStreaming{
+					Mirrors: []gcp.RegistryMirror{
+						{Upstream: "docker.io", Repository: "https://europe-docker.pkg.dev/v2/my-project/docker-hub"},
+						{Upstream: "docker.io", Repository: "http://europe-docker.pkg.dev/v2/my-project/docker-hub"},
+						{Upstream: "https://ghcr.io"},
+					},
+				},
+			}, "n1-standard-2", nil)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("imageStreaming.mirrors[1].upstream"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("imageStreaming.mirrors[1].repository"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("imageStreaming.mirrors[2].upstream"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("imageStreaming.mirrors[2].repository"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateWorkersUpdate", func() {
 		It("should pass because workers are unchanged", func() {
 			newWorkers := copyWorkers(workers)
@@ -332,17 +712,20 @@ var _ = Describe("#ValidateWorkers", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
-		It("should forbid removing a zone from a worker", func() {
+		It("should allow removing a zone from a worker", func() {
 			newWorkers := copyWorkers(workers)
 			newWorkers[1].Zones = newWorkers[1].Zones[1:]
 			errorList := ValidateWorkersUpdate(workers, newWorkers, nilPath)
 
-			Expect(errorList).To(ConsistOf(
-				PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("[1].zones"),
-				})),
-			))
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should allow removing a zone from a worker while adding another one", func() {
+			newWorkers := copyWorkers(workers)
+			newWorkers[1].Zones = []string{workers[1].Zones[1], "another-zone"}
+			errorList := ValidateWorkersUpdate(workers, newWorkers, nilPath)
+
+			Expect(errorList).To(BeEmpty())
 		})
 
 		It("should forbid changing the zone order", func() {
@@ -365,10 +748,24 @@ var _ = Describe("#ValidateWorkers", func() {
 			))
 		})
 
-		It("should forbid adding a zone while changing an existing one", func() {
+		It("should forbid changing the order of the remaining zones while removing a zone", func() {
+			workers[0].Zones = append(workers[0].Zones, "zone3")
+			newWorkers := copyWorkers(workers)
+			newWorkers[0].Zones = []string{"zone3", "zone1"}
+			errorList := ValidateWorkersUpdate(workers, newWorkers, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("[0].zones"),
+				})),
+			))
+		})
+
+		It("should forbid adding a zone in front of the remaining zones", func() {
 			newWorkers := copyWorkers(workers)
 			newWorkers = append(newWorkers, core.Worker{Name: "worker3", Zones: []string{"zone1"}})
-			newWorkers[1].Zones[0] = workers[1].Zones[1]
+			newWorkers[1].Zones = append([]string{"another-zone"}, newWorkers[1].Zones...)
 			errorList := ValidateWorkersUpdate(workers, newWorkers, nilPath)
 
 			Expect(errorList).To(ConsistOf(
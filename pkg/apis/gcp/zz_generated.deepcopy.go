@@ -10,9 +10,286 @@
 package gcp
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvertisedIPRange) DeepCopyInto(out *AdvertisedIPRange) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvertisedIPRange.
+func (in *AdvertisedIPRange) DeepCopy() *AdvertisedIPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvertisedIPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliasIPRange) DeepCopyInto(out *AliasIPRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliasIPRange.
+func (in *AliasIPRange) DeepCopy() *AliasIPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(AliasIPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogExport) DeepCopyInto(out *AuditLogExport) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogExport.
+func (in *AuditLogExport) DeepCopy() *AuditLogExport {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogExportStatus) DeepCopyInto(out *AuditLogExportStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogExportStatus.
+func (in *AuditLogExportStatus) DeepCopy() *AuditLogExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketConfig) DeepCopyInto(out *BackupBucketConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.KmsKeyName != nil {
+		in, out := &in.KmsKeyName, &out.KmsKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(BackupBucketLifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketConfig.
+func (in *BackupBucketConfig) DeepCopy() *BackupBucketConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketLifecycle) DeepCopyInto(out *BackupBucketLifecycle) {
+	*out = *in
+	if in.DeleteAfterDays != nil {
+		in, out := &in.DeleteAfterDays, &out.DeleteAfterDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeleteNoncurrentAfterDays != nil {
+		in, out := &in.DeleteNoncurrentAfterDays, &out.DeleteNoncurrentAfterDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]LifecycleTransition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketLifecycle.
+func (in *BackupBucketLifecycle) DeepCopy() *BackupBucketLifecycle {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketLifecycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSIDriverConfig) DeepCopyInto(out *CSIDriverConfig) {
+	*out = *in
+	if in.Channel != nil {
+		in, out := &in.Channel, &out.Channel
+		*out = new(CSIDriverChannel)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSIDriverConfig.
+func (in *CSIDriverConfig) DeepCopy() *CSIDriverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CSIDriverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSIDriverResources) DeepCopyInto(out *CSIDriverResources) {
+	*out = *in
+	if in.Controller != nil {
+		in, out := &in.Controller, &out.Controller
+		*out = make(map[string]corev1.ResourceRequirements, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
+		*out = make(map[string]corev1.ResourceRequirements, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSIDriverResources.
+func (in *CSIDriverResources) DeepCopy() *CSIDriverResources {
+	if in == nil {
+		return nil
+	}
+	out := new(CSIDriverResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRollout) DeepCopyInto(out *CanaryRollout) {
+	*out = *in
+	if in.SoakDuration != nil {
+		in, out := &in.SoakDuration, &out.SoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRollout.
+func (in *CanaryRollout) DeepCopy() *CanaryRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRolloutStatus) DeepCopyInto(out *CanaryRolloutStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRolloutStatus.
+func (in *CanaryRolloutStatus) DeepCopy() *CanaryRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudConfig) DeepCopyInto(out *CloudConfig) {
+	*out = *in
+	if in.NodeTags != nil {
+		in, out := &in.NodeTags, &out.NodeTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeInstancePrefix != nil {
+		in, out := &in.NodeInstancePrefix, &out.NodeInstancePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryRangeName != nil {
+		in, out := &in.SecondaryRangeName, &out.SecondaryRangeName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudConfig.
+func (in *CloudConfig) DeepCopy() *CloudConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -23,6 +300,22 @@ func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManager
 			(*out)[key] = val
 		}
 	}
+	if in.Flags != nil {
+		in, out := &in.Flags, &out.Flags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = (*in).DeepCopy()
+	}
+	if in.CloudConfig != nil {
+		in, out := &in.CloudConfig, &out.CloudConfig
+		*out = new(CloudConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -39,6 +332,11 @@ func (in *CloudControllerManagerConfig) DeepCopy() *CloudControllerManagerConfig
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudNAT) DeepCopyInto(out *CloudNAT) {
 	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
 	if in.EndpointIndependentMapping != nil {
 		in, out := &in.EndpointIndependentMapping, &out.EndpointIndependentMapping
 		*out = new(EndpointIndependentMapping)
@@ -59,6 +357,11 @@ func (in *CloudNAT) DeepCopyInto(out *CloudNAT) {
 		*out = make([]NatIPName, len(*in))
 		copy(*out, *in)
 	}
+	if in.NatIPCount != nil {
+		in, out := &in.NatIPCount, &out.NatIPCount
+		*out = new(int32)
+		**out = **in
+	}
 	if in.IcmpIdleTimeoutSec != nil {
 		in, out := &in.IcmpIdleTimeoutSec, &out.IcmpIdleTimeoutSec
 		*out = new(int32)
@@ -84,6 +387,11 @@ func (in *CloudNAT) DeepCopyInto(out *CloudNAT) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.NatLogging != nil {
+		in, out := &in.NatLogging, &out.NatLogging
+		*out = new(NatLogging)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -97,6 +405,22 @@ func (in *CloudNAT) DeepCopy() *CloudNAT {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNATStatus) DeepCopyInto(out *CloudNATStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNATStatus.
+func (in *CloudNATStatus) DeepCopy() *CloudNATStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNATStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 	*out = *in
@@ -108,6 +432,16 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OpsAgent != nil {
+		in, out := &in.OpsAgent, &out.OpsAgent
+		*out = new(OpsAgentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSIDriver != nil {
+		in, out := &in.CSIDriver, &out.CSIDriver
+		*out = new(CSIDriverConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -145,6 +479,39 @@ func (in *CloudRouter) DeepCopy() *CloudRouter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudRouterBGP) DeepCopyInto(out *CloudRouterBGP) {
+	*out = *in
+	if in.AdvertiseMode != nil {
+		in, out := &in.AdvertiseMode, &out.AdvertiseMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdvertisedGroups != nil {
+		in, out := &in.AdvertisedGroups, &out.AdvertisedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdvertisedIPRanges != nil {
+		in, out := &in.AdvertisedIPRanges, &out.AdvertisedIPRanges
+		*out = make([]AdvertisedIPRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudRouterBGP.
+func (in *CloudRouterBGP) DeepCopy() *CloudRouterBGP {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudRouterBGP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 	*out = *in
@@ -159,6 +526,16 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InternalLoadBalancer != nil {
+		in, out := &in.InternalLoadBalancer, &out.InternalLoadBalancer
+		*out = new(InternalLoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuditLogExport != nil {
+		in, out := &in.AuditLogExport, &out.AuditLogExport
+		*out = new(AuditLogExport)
+		**out = **in
+	}
 	return
 }
 
@@ -181,114 +558,146 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+func (in *ControlPlaneStatus) DeepCopyInto(out *ControlPlaneStatus) {
 	*out = *in
-	if in.KmsKeyName != nil {
-		in, out := &in.KmsKeyName, &out.KmsKeyName
-		*out = new(string)
+	out.TypeMeta = in.TypeMeta
+	if in.InternalLoadBalancer != nil {
+		in, out := &in.InternalLoadBalancer, &out.InternalLoadBalancer
+		*out = new(InternalLoadBalancerStatus)
 		**out = **in
 	}
-	if in.KmsKeyServiceAccount != nil {
-		in, out := &in.KmsKeyServiceAccount, &out.KmsKeyServiceAccount
-		*out = new(string)
+	if in.AuditLogExport != nil {
+		in, out := &in.AuditLogExport, &out.AuditLogExport
+		*out = new(AuditLogExportStatus)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
-func (in *DiskEncryption) DeepCopy() *DiskEncryption {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneStatus.
+func (in *ControlPlaneStatus) DeepCopy() *ControlPlaneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DiskEncryption)
+	out := new(ControlPlaneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlaneStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EndpointIndependentMapping) DeepCopyInto(out *EndpointIndependentMapping) {
+func (in *DNSFailoverPolicy) DeepCopyInto(out *DNSFailoverPolicy) {
 	*out = *in
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = make([]DNSLoadBalancerTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = make([]DNSGeoTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TrickleTrafficPercent != nil {
+		in, out := &in.TrickleTrafficPercent, &out.TrickleTrafficPercent
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointIndependentMapping.
-func (in *EndpointIndependentMapping) DeepCopy() *EndpointIndependentMapping {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSFailoverPolicy.
+func (in *DNSFailoverPolicy) DeepCopy() *DNSFailoverPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(EndpointIndependentMapping)
+	out := new(DNSFailoverPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlowLogs) DeepCopyInto(out *FlowLogs) {
+func (in *DNSGeoTarget) DeepCopyInto(out *DNSGeoTarget) {
 	*out = *in
-	if in.AggregationInterval != nil {
-		in, out := &in.AggregationInterval, &out.AggregationInterval
-		*out = new(string)
-		**out = **in
-	}
-	if in.FlowSampling != nil {
-		in, out := &in.FlowSampling, &out.FlowSampling
-		*out = new(float64)
-		**out = **in
-	}
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = new(string)
-		**out = **in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogs.
-func (in *FlowLogs) DeepCopy() *FlowLogs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSGeoTarget.
+func (in *DNSGeoTarget) DeepCopy() *DNSGeoTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(FlowLogs)
+	out := new(DNSGeoTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GPU) DeepCopyInto(out *GPU) {
+func (in *DNSLoadBalancerTarget) DeepCopyInto(out *DNSLoadBalancerTarget) {
 	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPU.
-func (in *GPU) DeepCopy() *GPU {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSLoadBalancerTarget.
+func (in *DNSLoadBalancerTarget) DeepCopy() *DNSLoadBalancerTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(GPU)
+	out := new(DNSLoadBalancerTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
+func (in *DNSRecordConfig) DeepCopyInto(out *DNSRecordConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.Networks.DeepCopyInto(&out.Networks)
+	if in.RoutingPolicy != nil {
+		in, out := &in.RoutingPolicy, &out.RoutingPolicy
+		*out = new(DNSRoutingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureConfig.
-func (in *InfrastructureConfig) DeepCopy() *InfrastructureConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordConfig.
+func (in *DNSRecordConfig) DeepCopy() *DNSRecordConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureConfig)
+	out := new(DNSRecordConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
+func (in *DNSRecordConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -296,25 +705,83 @@ func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
+func (in *DNSRoutingPolicy) DeepCopyInto(out *DNSRoutingPolicy) {
+	*out = *in
+	if in.Weighted != nil {
+		in, out := &in.Weighted, &out.Weighted
+		*out = make([]DNSWeightedTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Geo != nil {
+		in, out := &in.Geo, &out.Geo
+		*out = make([]DNSGeoTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(DNSFailoverPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRoutingPolicy.
+func (in *DNSRoutingPolicy) DeepCopy() *DNSRoutingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRoutingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSWeightedTarget) DeepCopyInto(out *DNSWeightedTarget) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSWeightedTarget.
+func (in *DNSWeightedTarget) DeepCopy() *DNSWeightedTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSWeightedTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskClone) DeepCopyInto(out *DiskClone) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.Networks.DeepCopyInto(&out.Networks)
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureStatus.
-func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskClone.
+func (in *DiskClone) DeepCopy() *DiskClone {
 	if in == nil {
 		return nil
 	}
-	out := new(InfrastructureStatus)
+	out := new(DiskClone)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
+func (in *DiskClone) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -322,53 +789,55 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+func (in *DiskCloneClaim) DeepCopyInto(out *DiskCloneClaim) {
 	*out = *in
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
-func (in *MachineImage) DeepCopy() *MachineImage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskCloneClaim.
+func (in *DiskCloneClaim) DeepCopy() *DiskCloneClaim {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImage)
+	out := new(DiskCloneClaim)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+func (in *DiskCloneClaimStatus) DeepCopyInto(out *DiskCloneClaimStatus) {
 	*out = *in
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
 		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
-func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskCloneClaimStatus.
+func (in *DiskCloneClaimStatus) DeepCopy() *DiskCloneClaimStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImageVersion)
+	out := new(DiskCloneClaimStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineImages) DeepCopyInto(out *MachineImages) {
+func (in *DiskCloneList) DeepCopyInto(out *DiskCloneList) {
 	*out = *in
-	if in.Versions != nil {
-		in, out := &in.Versions, &out.Versions
-		*out = make([]MachineImageVersion, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DiskClone, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -376,107 +845,1200 @@ func (in *MachineImages) DeepCopyInto(out *MachineImages) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
-func (in *MachineImages) DeepCopy() *MachineImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskCloneList.
+func (in *DiskCloneList) DeepCopy() *DiskCloneList {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineImages)
+	out := new(DiskCloneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiskCloneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskCloneSpec) DeepCopyInto(out *DiskCloneSpec) {
+	*out = *in
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]DiskCloneClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskCloneSpec.
+func (in *DiskCloneSpec) DeepCopy() *DiskCloneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskCloneSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NatIP) DeepCopyInto(out *NatIP) {
+func (in *DiskCloneStatus) DeepCopyInto(out *DiskCloneStatus) {
 	*out = *in
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]DiskCloneClaimStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatIP.
-func (in *NatIP) DeepCopy() *NatIP {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskCloneStatus.
+func (in *DiskCloneStatus) DeepCopy() *DiskCloneStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NatIP)
+	out := new(DiskCloneStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NatIPName) DeepCopyInto(out *NatIPName) {
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
 	*out = *in
+	if in.KmsKeyName != nil {
+		in, out := &in.KmsKeyName, &out.KmsKeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.KmsKeyServiceAccount != nil {
+		in, out := &in.KmsKeyServiceAccount, &out.KmsKeyServiceAccount
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatIPName.
-func (in *NatIPName) DeepCopy() *NatIPName {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
 	if in == nil {
 		return nil
 	}
-	out := new(NatIPName)
+	out := new(DiskEncryption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+func (in *EndpointIndependentMapping) DeepCopyInto(out *EndpointIndependentMapping) {
 	*out = *in
-	if in.VPC != nil {
-		in, out := &in.VPC, &out.VPC
-		*out = new(VPC)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointIndependentMapping.
+func (in *EndpointIndependentMapping) DeepCopy() *EndpointIndependentMapping {
+	if in == nil {
+		return nil
 	}
-	if in.CloudNAT != nil {
-		in, out := &in.CloudNAT, &out.CloudNAT
-		*out = new(CloudNAT)
-		(*in).DeepCopyInto(*out)
+	out := new(EndpointIndependentMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorRecord) DeepCopyInto(out *ErrorRecord) {
+	*out = *in
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastObservedTime.DeepCopyInto(&out.LastObservedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorRecord.
+func (in *ErrorRecord) DeepCopy() *ErrorRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExistingSubnets) DeepCopyInto(out *ExistingSubnets) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(string)
+		**out = **in
 	}
 	if in.Internal != nil {
 		in, out := &in.Internal, &out.Internal
 		*out = new(string)
 		**out = **in
 	}
-	if in.FlowLogs != nil {
-		in, out := &in.FlowLogs, &out.FlowLogs
-		*out = new(FlowLogs)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExistingSubnets.
+func (in *ExistingSubnets) DeepCopy() *ExistingSubnets {
+	if in == nil {
+		return nil
+	}
+	out := new(ExistingSubnets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Filestore) DeepCopyInto(out *Filestore) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(CSIDriverResources)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
-func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Filestore.
+func (in *Filestore) DeepCopy() *Filestore {
+	if in == nil {
+		return nil
+	}
+	out := new(Filestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallPolicy) DeepCopyInto(out *FirewallPolicy) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FirewallPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallPolicy.
+func (in *FirewallPolicy) DeepCopy() *FirewallPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallPolicyRule) DeepCopyInto(out *FirewallPolicyRule) {
+	*out = *in
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]FirewallRuleProtocol, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallPolicyRule.
+func (in *FirewallPolicyRule) DeepCopy() *FirewallPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Allowed != nil {
+		in, out := &in.Allowed, &out.Allowed
+		*out = make([]FirewallRuleProtocol, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetTags != nil {
+		in, out := &in.TargetTags, &out.TargetTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRuleProtocol) DeepCopyInto(out *FirewallRuleProtocol) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRuleProtocol.
+func (in *FirewallRuleProtocol) DeepCopy() *FirewallRuleProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRuleProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLogs) DeepCopyInto(out *FlowLogs) {
+	*out = *in
+	if in.AggregationInterval != nil {
+		in, out := &in.AggregationInterval, &out.AggregationInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.FlowSampling != nil {
+		in, out := &in.FlowSampling, &out.FlowSampling
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogs.
+func (in *FlowLogs) DeepCopy() *FlowLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPU) DeepCopyInto(out *GPU) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPU.
+func (in *GPU) DeepCopy() *GPU {
+	if in == nil {
+		return nil
+	}
+	out := new(GPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6Config) DeepCopyInto(out *IPv6Config) {
+	*out = *in
+	if in.WorkersAccessType != nil {
+		in, out := &in.WorkersAccessType, &out.WorkersAccessType
+		*out = new(IPv6AccessType)
+		**out = **in
+	}
+	if in.InternalAccessType != nil {
+		in, out := &in.InternalAccessType, &out.InternalAccessType
+		*out = new(IPv6AccessType)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6Config.
+func (in *IPv6Config) DeepCopy() *IPv6Config {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageStreaming) DeepCopyInto(out *ImageStreaming) {
+	*out = *in
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]RegistryMirror, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStreaming.
+func (in *ImageStreaming) DeepCopy() *ImageStreaming {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageStreaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Networks.DeepCopyInto(&out.Networks)
+	if in.NodeServiceAccount != nil {
+		in, out := &in.NodeServiceAccount, &out.NodeServiceAccount
+		*out = new(NodeServiceAccount)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceLabels != nil {
+		in, out := &in.ResourceLabels, &out.ResourceLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureConfig.
+func (in *InfrastructureConfig) DeepCopy() *InfrastructureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructurePlan) DeepCopyInto(out *InfrastructurePlan) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]PlannedOperation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructurePlan.
+func (in *InfrastructurePlan) DeepCopy() *InfrastructurePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructurePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Networks.DeepCopyInto(&out.Networks)
+	if in.WorkerPoolServiceAccounts != nil {
+		in, out := &in.WorkerPoolServiceAccounts, &out.WorkerPoolServiceAccounts
+		*out = make([]WorkerPoolServiceAccountStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ErrorHistory != nil {
+		in, out := &in.ErrorHistory, &out.ErrorHistory
+		*out = make([]ErrorRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(InfrastructurePlan)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureStatus.
+func (in *InfrastructureStatus) DeepCopy() *InfrastructureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InfrastructureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalLoadBalancerConfig) DeepCopyInto(out *InternalLoadBalancerConfig) {
+	*out = *in
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalAccess != nil {
+		in, out := &in.GlobalAccess, &out.GlobalAccess
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalLoadBalancerConfig.
+func (in *InternalLoadBalancerConfig) DeepCopy() *InternalLoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalLoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternalLoadBalancerStatus) DeepCopyInto(out *InternalLoadBalancerStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalLoadBalancerStatus.
+func (in *InternalLoadBalancerStatus) DeepCopy() *InternalLoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InternalLoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleTransition) DeepCopyInto(out *LifecycleTransition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleTransition.
+func (in *LifecycleTransition) DeepCopy() *LifecycleTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImage) DeepCopyInto(out *MachineImage) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImage.
+func (in *MachineImage) DeepCopy() *MachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersion.
+func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImages) DeepCopyInto(out *MachineImages) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]MachineImageVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImages.
+func (in *MachineImages) DeepCopy() *MachineImages {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NCCSpoke) DeepCopyInto(out *NCCSpoke) {
+	*out = *in
+	if in.ExcludeExportRanges != nil {
+		in, out := &in.ExcludeExportRanges, &out.ExcludeExportRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NCCSpoke.
+func (in *NCCSpoke) DeepCopy() *NCCSpoke {
+	if in == nil {
+		return nil
+	}
+	out := new(NCCSpoke)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NCCSpokeStatus) DeepCopyInto(out *NCCSpokeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NCCSpokeStatus.
+func (in *NCCSpokeStatus) DeepCopy() *NCCSpokeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NCCSpokeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatIP) DeepCopyInto(out *NatIP) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatIP.
+func (in *NatIP) DeepCopy() *NatIP {
+	if in == nil {
+		return nil
+	}
+	out := new(NatIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatIPName) DeepCopyInto(out *NatIPName) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatIPName.
+func (in *NatIPName) DeepCopy() *NatIPName {
+	if in == nil {
+		return nil
+	}
+	out := new(NatIPName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatIPRotationStatus) DeepCopyInto(out *NatIPRotationStatus) {
+	*out = *in
+	if in.Draining != nil {
+		in, out := &in.Draining, &out.Draining
+		*out = new(NatIP)
+		**out = **in
+	}
+	if in.DrainingSince != nil {
+		in, out := &in.DrainingSince, &out.DrainingSince
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatIPRotationStatus.
+func (in *NatIPRotationStatus) DeepCopy() *NatIPRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NatIPRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatLogging) DeepCopyInto(out *NatLogging) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatLogging.
+func (in *NatLogging) DeepCopy() *NatLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(NatLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
+	*out = *in
+	if in.VPC != nil {
+		in, out := &in.VPC, &out.VPC
+		*out = new(VPC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudNAT != nil {
+		in, out := &in.CloudNAT, &out.CloudNAT
+		*out = new(CloudNAT)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudRouterBGP != nil {
+		in, out := &in.CloudRouterBGP, &out.CloudRouterBGP
+		*out = new(CloudRouterBGP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoutingMode != nil {
+		in, out := &in.RoutingMode, &out.RoutingMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProxyOnly != nil {
+		in, out := &in.ProxyOnly, &out.ProxyOnly
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]Zone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(FlowLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateGoogleAccess != nil {
+		in, out := &in.PrivateGoogleAccess, &out.PrivateGoogleAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecondaryRanges != nil {
+		in, out := &in.SecondaryRanges, &out.SecondaryRanges
+		*out = make([]SecondaryRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(IPv6Config)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExistingSubnets != nil {
+		in, out := &in.ExistingSubnets, &out.ExistingSubnets
+		*out = new(ExistingSubnets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateServiceConnectEndpoints != nil {
+		in, out := &in.PrivateServiceConnectEndpoints, &out.PrivateServiceConnectEndpoints
+		*out = make([]PrivateServiceConnectEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalFirewallRules != nil {
+		in, out := &in.AdditionalFirewallRules, &out.AdditionalFirewallRules
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Peerings != nil {
+		in, out := &in.Peerings, &out.Peerings
+		*out = make([]VPCPeering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FirewallPolicy != nil {
+		in, out := &in.FirewallPolicy, &out.FirewallPolicy
+		*out = new(FirewallPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PacketMirroring != nil {
+		in, out := &in.PacketMirroring, &out.PacketMirroring
+		*out = new(PacketMirroring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateDNSZone != nil {
+		in, out := &in.PrivateDNSZone, &out.PrivateDNSZone
+		*out = new(PrivateDNSZone)
+		**out = **in
+	}
+	if in.NCCSpoke != nil {
+		in, out := &in.NCCSpoke, &out.NCCSpoke
+		*out = new(NCCSpoke)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
+func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	in.VPC.DeepCopyInto(&out.VPC)
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CloudNAT != nil {
+		in, out := &in.CloudNAT, &out.CloudNAT
+		*out = new(CloudNATStatus)
+		**out = **in
+	}
+	if in.NatIPs != nil {
+		in, out := &in.NatIPs, &out.NatIPs
+		*out = make([]NatIP, len(*in))
+		copy(*out, *in)
+	}
+	if in.NatIPRotation != nil {
+		in, out := &in.NatIPRotation, &out.NatIPRotation
+		*out = new(NatIPRotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateServiceConnectEndpoints != nil {
+		in, out := &in.PrivateServiceConnectEndpoints, &out.PrivateServiceConnectEndpoints
+		*out = make([]PrivateServiceConnectEndpointStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Peerings != nil {
+		in, out := &in.Peerings, &out.Peerings
+		*out = make([]VPCPeeringStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.FirewallPolicy != nil {
+		in, out := &in.FirewallPolicy, &out.FirewallPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.PacketMirroring != nil {
+		in, out := &in.PacketMirroring, &out.PacketMirroring
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDNSZone != nil {
+		in, out := &in.PrivateDNSZone, &out.PrivateDNSZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.NCCSpoke != nil {
+		in, out := &in.NCCSpoke, &out.NCCSpoke
+		*out = new(NCCSpokeStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeServiceAccount) DeepCopyInto(out *NodeServiceAccount) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeServiceAccount.
+func (in *NodeServiceAccount) DeepCopy() *NodeServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsAgentConfig) DeepCopyInto(out *OpsAgentConfig) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsAgentConfig.
+func (in *OpsAgentConfig) DeepCopy() *OpsAgentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsAgentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroring) DeepCopyInto(out *PacketMirroring) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(PacketMirroringFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroring.
+func (in *PacketMirroring) DeepCopy() *PacketMirroring {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMirroringFilter) DeepCopyInto(out *PacketMirroringFilter) {
+	*out = *in
+	if in.CIDRRanges != nil {
+		in, out := &in.CIDRRanges, &out.CIDRRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMirroringFilter.
+func (in *PacketMirroringFilter) DeepCopy() *PacketMirroringFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMirroringFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedOperation) DeepCopyInto(out *PlannedOperation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedOperation.
+func (in *PlannedOperation) DeepCopy() *PlannedOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateDNSZone) DeepCopyInto(out *PrivateDNSZone) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateDNSZone.
+func (in *PrivateDNSZone) DeepCopy() *PrivateDNSZone {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateDNSZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateServiceConnectEndpoint) DeepCopyInto(out *PrivateServiceConnectEndpoint) {
+	*out = *in
+	if in.IP != nil {
+		in, out := &in.IP, &out.IP
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateServiceConnectEndpoint.
+func (in *PrivateServiceConnectEndpoint) DeepCopy() *PrivateServiceConnectEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateServiceConnectEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateServiceConnectEndpointStatus) DeepCopyInto(out *PrivateServiceConnectEndpointStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateServiceConnectEndpointStatus.
+func (in *PrivateServiceConnectEndpointStatus) DeepCopy() *PrivateServiceConnectEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateServiceConnectEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryMirror) DeepCopyInto(out *RegistryMirror) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryMirror.
+func (in *RegistryMirror) DeepCopy() *RegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Scheduling) DeepCopyInto(out *Scheduling) {
+	*out = *in
+	if in.OnHostMaintenance != nil {
+		in, out := &in.OnHostMaintenance, &out.OnHostMaintenance
+		*out = new(string)
+		**out = **in
+	}
+	if in.AutomaticRestart != nil {
+		in, out := &in.AutomaticRestart, &out.AutomaticRestart
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LocalSSDRecoveryTimeout != nil {
+		in, out := &in.LocalSSDRecoveryTimeout, &out.LocalSSDRecoveryTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ProvisioningModel != nil {
+		in, out := &in.ProvisioningModel, &out.ProvisioningModel
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxRunDuration != nil {
+		in, out := &in.MaxRunDuration, &out.MaxRunDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Scheduling.
+func (in *Scheduling) DeepCopy() *Scheduling {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkConfig)
+	out := new(Scheduling)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+func (in *SecondaryRange) DeepCopyInto(out *SecondaryRange) {
 	*out = *in
-	in.VPC.DeepCopyInto(&out.VPC)
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]Subnet, len(*in))
-		copy(*out, *in)
-	}
-	if in.NatIPs != nil {
-		in, out := &in.NatIPs, &out.NatIPs
-		*out = make([]NatIP, len(*in))
-		copy(*out, *in)
+	if in.Purpose != nil {
+		in, out := &in.Purpose, &out.Purpose
+		*out = new(SecondaryRangePurpose)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
-func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryRange.
+func (in *SecondaryRange) DeepCopy() *SecondaryRange {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkStatus)
+	out := new(SecondaryRange)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -515,6 +2077,28 @@ func (in *Storage) DeepCopyInto(out *Storage) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Filestore != nil {
+		in, out := &in.Filestore, &out.Filestore
+		*out = new(Filestore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeSnapshotClass != nil {
+		in, out := &in.VolumeSnapshotClass, &out.VolumeSnapshotClass
+		*out = new(VolumeSnapshotClassConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]StorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CSIDriverResources != nil {
+		in, out := &in.CSIDriverResources, &out.CSIDriverResources
+		*out = new(CSIDriverResources)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -528,9 +2112,67 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	if in.ReplicationType != nil {
+		in, out := &in.ReplicationType, &out.ReplicationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProvisionedIOPS != nil {
+		in, out := &in.ProvisionedIOPS, &out.ProvisionedIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ProvisionedThroughput != nil {
+		in, out := &in.ProvisionedThroughput, &out.ProvisionedThroughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KmsKeyName != nil {
+		in, out := &in.KmsKeyName, &out.KmsKeyName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRRange != nil {
+		in, out := &in.IPv6CIDRRange, &out.IPv6CIDRRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4CIDRRange != nil {
+		in, out := &in.IPv4CIDRRange, &out.IPv4CIDRRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryRanges != nil {
+		in, out := &in.SecondaryRanges, &out.SecondaryRanges
+		*out = make([]SecondaryRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -565,6 +2207,48 @@ func (in *VPC) DeepCopy() *VPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeering) DeepCopyInto(out *VPCPeering) {
+	*out = *in
+	if in.ExportCustomRoutes != nil {
+		in, out := &in.ExportCustomRoutes, &out.ExportCustomRoutes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImportCustomRoutes != nil {
+		in, out := &in.ImportCustomRoutes, &out.ImportCustomRoutes
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeering.
+func (in *VPCPeering) DeepCopy() *VPCPeering {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringStatus) DeepCopyInto(out *VPCPeeringStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringStatus.
+func (in *VPCPeeringStatus) DeepCopy() *VPCPeeringStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Volume) DeepCopyInto(out *Volume) {
 	*out = *in
@@ -591,6 +2275,34 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotClassConfig) DeepCopyInto(out *VolumeSnapshotClassConfig) {
+	*out = *in
+	if in.StorageLocation != nil {
+		in, out := &in.StorageLocation, &out.StorageLocation
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotClassConfig.
+func (in *VolumeSnapshotClassConfig) DeepCopy() *VolumeSnapshotClassConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotClassConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
@@ -615,6 +2327,66 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 		*out = new(ServiceAccount)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AliasIPRange != nil {
+		in, out := &in.AliasIPRange, &out.AliasIPRange
+		*out = new(AliasIPRange)
+		**out = **in
+	}
+	if in.CanaryRollout != nil {
+		in, out := &in.CanaryRollout, &out.CanaryRollout
+		*out = new(CanaryRollout)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(Scheduling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PropagateShootLabels != nil {
+		in, out := &in.PropagateShootLabels, &out.PropagateShootLabels
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SerialPortLoggingEnable != nil {
+		in, out := &in.SerialPortLoggingEnable, &out.SerialPortLoggingEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstallOpsAgent != nil {
+		in, out := &in.InstallOpsAgent, &out.InstallOpsAgent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableOSLogin != nil {
+		in, out := &in.EnableOSLogin, &out.EnableOSLogin
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QuotaAwareRollingUpdate != nil {
+		in, out := &in.QuotaAwareRollingUpdate, &out.QuotaAwareRollingUpdate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ZoneCircuitBreaker != nil {
+		in, out := &in.ZoneCircuitBreaker, &out.ZoneCircuitBreaker
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ImageStreaming != nil {
+		in, out := &in.ImageStreaming, &out.ImageStreaming
+		*out = new(ImageStreaming)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MountHyperdiskML != nil {
+		in, out := &in.MountHyperdiskML, &out.MountHyperdiskML
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -636,6 +2408,64 @@ func (in *WorkerConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolServiceAccount) DeepCopyInto(out *WorkerPoolServiceAccount) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolServiceAccount.
+func (in *WorkerPoolServiceAccount) DeepCopy() *WorkerPoolServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolServiceAccountStatus) DeepCopyInto(out *WorkerPoolServiceAccountStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolServiceAccountStatus.
+func (in *WorkerPoolServiceAccountStatus) DeepCopy() *WorkerPoolServiceAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolServiceAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolStatus) DeepCopyInto(out *WorkerPoolStatus) {
+	*out = *in
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolStatus.
+func (in *WorkerPoolStatus) DeepCopy() *WorkerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	*out = *in
@@ -647,6 +2477,41 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CanaryRollouts != nil {
+		in, out := &in.CanaryRollouts, &out.CanaryRollouts
+		*out = make([]CanaryRolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]WorkerPoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ZoneMigrations != nil {
+		in, out := &in.ZoneMigrations, &out.ZoneMigrations
+		*out = make([]ZoneMigrationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ZoneCircuitBreakers != nil {
+		in, out := &in.ZoneCircuitBreakers, &out.ZoneCircuitBreakers
+		*out = make([]ZoneCircuitBreakerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ErrorHistory != nil {
+		in, out := &in.ErrorHistory, &out.ErrorHistory
+		*out = make([]ErrorRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -667,3 +2532,62 @@ func (in *WorkerStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Zone) DeepCopyInto(out *Zone) {
+	*out = *in
+	if in.Internal != nil {
+		in, out := &in.Internal, &out.Internal
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Zone.
+func (in *Zone) DeepCopy() *Zone {
+	if in == nil {
+		return nil
+	}
+	out := new(Zone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneCircuitBreakerStatus) DeepCopyInto(out *ZoneCircuitBreakerStatus) {
+	*out = *in
+	in.SuspendedUntil.DeepCopyInto(&out.SuspendedUntil)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneCircuitBreakerStatus.
+func (in *ZoneCircuitBreakerStatus) DeepCopy() *ZoneCircuitBreakerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneCircuitBreakerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneMigrationStatus) DeepCopyInto(out *ZoneMigrationStatus) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneMigrationStatus.
+func (in *ZoneMigrationStatus) DeepCopy() *ZoneMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -71,6 +71,32 @@ func (c *Config) ApplyETCDBackup(etcdBackup *config.ETCDBackup) {
 	*etcdBackup = c.Config.ETCD.Backup
 }
 
+// ApplyDNS sets the given dns configuration to that of this Config.
+func (c *Config) ApplyDNS(dns *config.DNS) {
+	if c.Config.DNS != nil {
+		*dns = *c.Config.DNS
+	}
+}
+
+// ApplyAPIServerInternalLoadBalancers sets the given internal load balancer configuration to that of this Config.
+func (c *Config) ApplyAPIServerInternalLoadBalancers(loadBalancers *[]config.APIServerInternalLoadBalancer) {
+	*loadBalancers = c.Config.APIServerInternalLoadBalancers
+}
+
+// ApplyAllowedLocations sets the given allowed locations to that of this Config.
+func (c *Config) ApplyAllowedLocations(allowedLocations *config.AllowedLocations) {
+	if c.Config.AllowedLocations != nil {
+		*allowedLocations = *c.Config.AllowedLocations
+	}
+}
+
+// ApplyBastion sets the given bastion configuration to that of this Config.
+func (c *Config) ApplyBastion(bastion *config.Bastion) {
+	if c.Config.Bastion != nil {
+		*bastion = *c.Config.Bastion
+	}
+}
+
 // Options initializes empty config.ControllerConfiguration, applies the set values and returns it.
 func (c *Config) Options() config.ControllerConfiguration {
 	var cfg config.ControllerConfiguration
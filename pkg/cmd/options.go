@@ -23,13 +23,20 @@ import (
 	backupentrycontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/backupentry"
 	bastioncontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/bastion"
 	controlplanecontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/controlplane"
+	deletionprotectioncontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/deletionprotection"
+	diskclonecontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/diskclone"
+	dnsdelegationcontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsdelegation"
 	dnsrecordcontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsrecord"
 	healthcheckcontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/healthcheck"
 	infrastructurecontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure"
+	internalloadbalancercontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/internalloadbalancer"
+	machinedebugcontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/machinedebug"
 	workercontroller "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	controlplanewebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/controlplane"
 	controlplaneexposurewebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/controlplaneexposure"
 	infrastructurewebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/infrastructure"
+	internalloadbalancerwebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/internalloadbalancer"
+	locationwebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/location"
 	shootwebhook "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/shoot"
 )
 
@@ -41,8 +48,13 @@ func ControllerSwitchOptions() *controllercmd.SwitchOptions {
 		controllercmd.Switch(extensionsbastioncontroller.ControllerName, bastioncontroller.AddToManager),
 		controllercmd.Switch(extensionscontrolplanecontroller.ControllerName, controlplanecontroller.AddToManager),
 		controllercmd.Switch(extensionsdnsrecordcontroller.ControllerName, dnsrecordcontroller.AddToManager),
+		controllercmd.Switch(dnsdelegationcontroller.ControllerName, dnsdelegationcontroller.AddToManager),
 		controllercmd.Switch(extensionsinfrastructurecontroller.ControllerName, infrastructurecontroller.AddToManager),
 		controllercmd.Switch(extensionsworkercontroller.ControllerName, workercontroller.AddToManager),
+		controllercmd.Switch(machinedebugcontroller.ControllerName, machinedebugcontroller.AddToManager),
+		controllercmd.Switch(deletionprotectioncontroller.ControllerName, deletionprotectioncontroller.AddToManager),
+		controllercmd.Switch(internalloadbalancercontroller.ControllerName, internalloadbalancercontroller.AddToManager),
+		controllercmd.Switch(diskclonecontroller.ControllerName, diskclonecontroller.AddToManager),
 		controllercmd.Switch(extensionshealthcheckcontroller.ControllerName, healthcheckcontroller.AddToManager),
 		controllercmd.Switch(extensionsheartbeatcontroller.ControllerName, extensionsheartbeatcontroller.AddToManager),
 	)
@@ -54,6 +66,8 @@ func WebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(extensioncontrolplanewebhook.WebhookName, controlplanewebhook.New),
 		webhookcmd.Switch(extensioncontrolplanewebhook.ExposureWebhookName, controlplaneexposurewebhook.New),
 		webhookcmd.Switch(infrastructurewebhook.WebhookName, infrastructurewebhook.AddToManager),
+		webhookcmd.Switch(internalloadbalancerwebhook.WebhookName, internalloadbalancerwebhook.AddToManager),
+		webhookcmd.Switch(locationwebhook.WebhookName, locationwebhook.AddToManager),
 		webhookcmd.Switch(extensionshootwebhook.WebhookName, shootwebhook.AddToManager),
 	)
 }
@@ -6,18 +6,30 @@ package backupbucket
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 
+	"cloud.google.com/go/storage"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
 	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
+// defaultStorageClass is the storage class of buckets if no storage class is configured.
+const defaultStorageClass = "STANDARD"
+
 type actuator struct {
 	backupbucket.Actuator
 	client client.Client
@@ -29,13 +41,109 @@ func newActuator(mgr manager.Manager) backupbucket.Actuator {
 	}
 }
 
-func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
-	storageClient, err := gcpclient.NewStorageClientFromSecretRef(ctx, a.client, bb.Spec.SecretRef)
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
+	config, err := helper.BackupBucketConfigFromRawExtension(bb.Spec.ProviderConfig)
+	if err != nil {
+		return fmt.Errorf("could not decode provider config of backup bucket: %w", err)
+	}
+	if errs := validation.ValidateBackupBucketConfig(config, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return fmt.Errorf("invalid provider config of backup bucket: %w", errs.ToAggregate())
+	}
+
+	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, a.client, bb.Spec.SecretRef)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
+	storageClient, err := gcpclient.NewStorageClient(ctx, serviceAccount)
+	if err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	if config.KmsKeyName != nil {
+		if err := checkKMSKey(ctx, log, serviceAccount, storageClient, *config.KmsKeyName); err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
+		}
+	}
+
+	if err := storageClient.CreateBucketIfNotExists(ctx, bb.Name, ptr.Deref(config.Location, bb.Spec.Region)); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	if err := storageClient.SetStorageClass(ctx, bb.Name, ptr.Deref(config.StorageClass, defaultStorageClass)); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	if err := storageClient.SetTurboReplication(ctx, bb.Name, config.TurboReplication); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	if err := storageClient.SetDefaultKMSKey(ctx, bb.Name, ptr.Deref(config.KmsKeyName, "")); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	if err := storageClient.SetVersioning(ctx, bb.Name, config.Versioning); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	if config.Lifecycle != nil {
+		if err := storageClient.SetLifecycleRules(ctx, bb.Name, lifecycleRules(config.Lifecycle)); err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
+		}
+	}
 
-	return util.DetermineError(storageClient.CreateBucketIfNotExists(ctx, bb.Name, bb.Spec.Region), helper.KnownCodes)
+	var retentionPeriod time.Duration
+	if config.RetentionPeriod != nil {
+		retentionPeriod = config.RetentionPeriod.Duration
+	}
+	if err := storageClient.SetRetentionPolicy(ctx, bb.Name, retentionPeriod, config.Locked); err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+	return util.DetermineError(storageClient.SetDefaultEventBasedHold(ctx, bb.Name, config.EventBasedHold), helper.KnownCodes)
+}
+
+// lifecycleRules returns the lifecycle rules of a bucket for the given lifecycle configuration.
+func lifecycleRules(lifecycle *apisgcp.BackupBucketLifecycle) []gcpclient.LifecycleRule {
+	var rules []gcpclient.LifecycleRule
+	for _, transition := range lifecycle.Transitions {
+		rules = append(rules, gcpclient.LifecycleRule{
+			Action:    storage.LifecycleAction{Type: storage.SetStorageClassAction, StorageClass: transition.StorageClass},
+			Condition: storage.LifecycleCondition{AgeInDays: int64(transition.AfterDays)},
+		})
+	}
+	if lifecycle.DeleteAfterDays != nil {
+		rules = append(rules, gcpclient.LifecycleRule{
+			Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{AgeInDays: int64(*lifecycle.DeleteAfterDays)},
+		})
+	}
+	if lifecycle.DeleteNoncurrentAfterDays != nil {
+		rules = append(rules, gcpclient.LifecycleRule{
+			Action:    storage.LifecycleAction{Type: storage.DeleteAction},
+			Condition: storage.LifecycleCondition{DaysSinceNoncurrentTime: int64(*lifecycle.DeleteNoncurrentAfterDays)},
+		})
+	}
+	return rules
+}
+
+// checkKMSKey checks that the Cloud Storage service agent of the project is allowed to use the given key, as otherwise
+// no objects can be written to the bucket. The check is skipped if the IAM policies of the key cannot be read.
+func checkKMSKey(ctx context.Context, log logr.Logger, serviceAccount *gcp.ServiceAccount, storageClient gcpclient.StorageClient, kmsKeyName string) error {
+	kmsClient, err := gcpclient.NewKMSClient(ctx, serviceAccount)
+	if err != nil {
+		return err
+	}
+	serviceAgent, err := storageClient.GetServiceAgent(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get Cloud Storage service agent: %w", err)
+	}
+
+	usable, err := kmsClient.IsKeyUsableBy(ctx, kmsKeyName, "serviceAccount:"+serviceAgent)
+	if gcpclient.IsErrorCode(err, http.StatusForbidden) {
+		log.Info("Skipping check of KMS key as its IAM policy cannot be read", "kmsKeyName", kmsKeyName)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not check KMS key %s: %w", kmsKeyName, err)
+	}
+	if !usable {
+		return fmt.Errorf("the Cloud Storage service agent %s must be granted the roles/cloudkms.cryptoKeyEncrypterDecrypter role on KMS key %s", serviceAgent, kmsKeyName)
+	}
+	return nil
 }
 
 func (a *actuator) Delete(ctx context.Context, _ logr.Logger, bb *extensionsv1alpha1.BackupBucket) error {
@@ -7,6 +7,7 @@ package backupentry
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
@@ -14,10 +15,13 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
@@ -31,15 +35,61 @@ func newActuator(mgr manager.Manager) genericactuator.BackupEntryDelegate {
 	}
 }
 
+// GetETCDSecretData returns the data of the backup secret of the etcds. The token URL and the credential source of
+// workload identity federation credentials are replaced, so that etcd-backup-restore reads the subject token from the
+// mounted backup secret.
 func (a *actuator) GetETCDSecretData(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.BackupEntry, backupSecretData map[string][]byte) (map[string][]byte, error) {
-	return backupSecretData, nil
+	serviceAccount, err := gcp.GetServiceAccountFromSecret(&corev1.Secret{Data: backupSecretData})
+	if err != nil {
+		return nil, err
+	}
+	if serviceAccount.Type != gcp.ExternalAccountCredentialType {
+		return backupSecretData, nil
+	}
+
+	config, err := gcp.ParseExternalAccountConfig(serviceAccount.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse credentials of external account: %w", err)
+	}
+	credentials, err := config.ETCDCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	data := maps.Clone(backupSecretData)
+	data[gcp.ServiceAccountJSONField] = credentials
+	return data, nil
 }
 
-func (a *actuator) Delete(ctx context.Context, _ logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
+// Delete deletes the objects of the BackupEntry in the backup bucket. The progress of the deletion is stored in an
+// annotation of the BackupEntry after each page of objects, so that the deletion is resumed there after a restart.
+func (a *actuator) Delete(ctx context.Context, log logr.Logger, be *extensionsv1alpha1.BackupEntry) error {
 	storageClient, err := gcpclient.NewStorageClientFromSecretRef(ctx, a.client, be.Spec.SecretRef)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
-	entryName := strings.TrimPrefix(be.Name, v1beta1constants.BackupSourcePrefix+"-")
-	return util.DetermineError(storageClient.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, fmt.Sprintf("%s/", entryName)), helper.KnownCodes)
+
+	var (
+		entryName   = strings.TrimPrefix(be.Name, v1beta1constants.BackupSourcePrefix+"-")
+		prefix      = fmt.Sprintf("%s/", entryName)
+		startOffset = be.Annotations[gcp.AnnotationKeyDeletionCheckpoint]
+	)
+	if !strings.HasPrefix(startOffset, prefix) {
+		startOffset = ""
+	}
+	if startOffset != "" {
+		log.Info("Resuming deletion of backup entry", "checkpoint", startOffset)
+	}
+
+	return util.DetermineError(storageClient.DeleteObjectsWithPrefix(ctx, be.Spec.BucketName, prefix, gcpclient.DeleteObjectsOptions{
+		StartOffset: startOffset,
+		Checkpoint: func(ctx context.Context, checkpoint string) error {
+			patch := client.MergeFrom(be.DeepCopy())
+			metav1.SetMetaDataAnnotation(&be.ObjectMeta, gcp.AnnotationKeyDeletionCheckpoint, checkpoint)
+			if err := a.client.Patch(ctx, be, patch); err != nil {
+				return fmt.Errorf("could not store deletion checkpoint: %w", err)
+			}
+			return nil
+		},
+	}), helper.KnownCodes)
 }
@@ -9,8 +9,12 @@ import (
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
 	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
@@ -30,13 +34,24 @@ type AddOptions struct {
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
+// Additionally, a controller is added which rotates the subject tokens of workload identity federation credentials in
+// the etcd backup secrets independent of the reconciliation of the BackupEntries.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
-	return backupentry.Add(ctx, mgr, backupentry.AddArgs{
+	if err := backupentry.Add(ctx, mgr, backupentry.AddArgs{
 		Actuator:          genericactuator.NewActuator(mgr, newActuator(mgr)),
 		ControllerOptions: opts.Controller,
 		Predicates:        backupentry.DefaultPredicates(opts.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(TokenControllerName).
+		For(&extensionsv1alpha1.BackupEntry{}, builder.WithPredicates(extensionspredicate.HasType(gcp.Type), predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{MaxConcurrentReconciles: opts.Controller.MaxConcurrentReconciles}).
+		Complete(NewTokenReconciler(mgr.GetClient()))
 }
 
 // AddToManager adds a controller with the default Options.
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupentry"
+	"github.com/gardener/gardener/extensions/pkg/controller/backupentry/genericactuator"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// TokenControllerName is the name of the controller which rotates the subject tokens of workload identity
+	// federation credentials in the etcd backup secrets.
+	TokenControllerName = "backupentry-token"
+
+	// tokenSyncPeriod is the maximum period after which the subject token in the etcd backup secret is synced with the
+	// backup secret.
+	tokenSyncPeriod = 5 * time.Minute
+	// minTokenSyncPeriod is the minimum period after which the subject token is synced again, so that tokens which are
+	// about to expire are not synced in a tight loop.
+	minTokenSyncPeriod = 10 * time.Second
+)
+
+type tokenReconciler struct {
+	client client.Client
+}
+
+// NewTokenReconciler creates a new reconcile.Reconciler which keeps the subject token of workload identity federation
+// credentials in the etcd backup secret of a BackupEntry in sync with the backup secret. Subject tokens are short-lived,
+// while BackupEntries are only reconciled rarely, hence the token is synced periodically before it expires.
+func NewTokenReconciler(c client.Client) reconcile.Reconciler {
+	return &tokenReconciler{client: c}
+}
+
+// Reconcile copies the subject token of the backup secret into the etcd backup secret of the BackupEntry.
+func (r *tokenReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	be := &extensionsv1alpha1.BackupEntry{}
+	if err := r.client.Get(ctx, request.NamespacedName, be); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if be.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	backupSecret, err := extensionscontroller.GetSecretByReference(ctx, r.client, &be.Spec.SecretRef)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	serviceAccount, err := gcp.GetServiceAccountFromSecret(backupSecret)
+	if err != nil || serviceAccount.Type != gcp.ExternalAccountCredentialType {
+		// Invalid backup secrets are reported by the reconciliation of the BackupEntry.
+		return reconcile.Result{}, nil
+	}
+	token := backupSecret.Data[gcp.WorkloadIdentityTokenField]
+
+	etcdSecret := emptyETCDBackupSecret(be.Name)
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(etcdSecret), etcdSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The etcd backup secret is created by the reconciliation of the BackupEntry.
+			return reconcile.Result{RequeueAfter: tokenSyncPeriod}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if createdBy := etcdSecret.Annotations[genericactuator.AnnotationKeyCreatedByBackupEntry]; createdBy != be.Name {
+		return reconcile.Result{}, nil
+	}
+
+	if !bytes.Equal(etcdSecret.Data[gcp.WorkloadIdentityTokenField], token) {
+		log.FromContext(ctx).Info("Rotating subject token in etcd backup secret", "secret", client.ObjectKeyFromObject(etcdSecret))
+		patch := client.MergeFrom(etcdSecret.DeepCopy())
+		etcdSecret.Data[gcp.WorkloadIdentityTokenField] = token
+		if err := r.client.Patch(ctx, etcdSecret, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not rotate subject token in etcd backup secret: %w", err)
+		}
+	}
+
+	expiry := gcp.SubjectTokenExpiry(token)
+	if !expiry.IsZero() && expiry.Before(time.Now()) {
+		return reconcile.Result{}, fmt.Errorf("subject token in backup secret %s/%s expired at %s and has to be rotated", backupSecret.Namespace, backupSecret.Name, expiry.UTC().Format(time.RFC3339))
+	}
+	return reconcile.Result{RequeueAfter: tokenSyncInterval(expiry, time.Now())}, nil
+}
+
+// tokenSyncInterval returns the interval after which a token with the given expiration time is synced again. The token
+// is synced after at most half of its remaining lifetime, so that a token rotated in the backup secret is propagated
+// before the previous token expires.
+func tokenSyncInterval(expiry, now time.Time) time.Duration {
+	if expiry.IsZero() {
+		return tokenSyncPeriod
+	}
+	return min(tokenSyncPeriod, max(minTokenSyncPeriod, expiry.Sub(now)/2))
+}
+
+// emptyETCDBackupSecret returns the etcd backup secret of the BackupEntry with the given name, which is created by the
+// generic actuator.
+func emptyETCDBackupSecret(backupEntryName string) *corev1.Secret {
+	secretName := v1beta1constants.BackupSecretName
+	if strings.HasPrefix(backupEntryName, v1beta1constants.BackupSourcePrefix) {
+		secretName = fmt.Sprintf("%s-%s", v1beta1constants.BackupSourcePrefix, v1beta1constants.BackupSecretName)
+	}
+	shootTechnicalID, _ := backupentry.ExtractShootDetailsFromBackupEntryName(backupEntryName)
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: shootTechnicalID,
+		},
+	}
+}
@@ -120,6 +120,10 @@ func getWorkersCIDR(cluster *controller.Cluster) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// The nodes CIDR of the shoot contains the worker ranges of all zones.
+	if len(infrastructureConfig.Networks.Zones) > 0 && cluster.Shoot.Spec.Networking != nil && cluster.Shoot.Spec.Networking.Nodes != nil {
+		return *cluster.Shoot.Spec.Networking.Nodes, nil
+	}
 	return infrastructureConfig.Networks.Workers, nil
 }
 
@@ -272,12 +272,12 @@ func computeInstanceDefine(opt *Options, userData []byte) *compute.Instance {
 		MachineType:        machineTypeDefine(opt),
 		NetworkInterfaces:  networkInterfacesDefine(opt),
 		Tags:               &compute.Tags{Items: []string{opt.BastionInstanceName}},
-		Metadata:           &compute.Metadata{Items: metadataItemsDefine(userData)},
+		Metadata:           &compute.Metadata{Items: metadataItemsDefine(userData, opt.EnableOSLogin)},
 	}
 }
 
-func metadataItemsDefine(userData []byte) []*compute.MetadataItems {
-	return []*compute.MetadataItems{
+func metadataItemsDefine(userData []byte, enableOSLogin bool) []*compute.MetadataItems {
+	items := []*compute.MetadataItems{
 		{
 			Key:   "startup-script",
 			Value: ptr.To(string(userData)),
@@ -287,6 +287,15 @@ func metadataItemsDefine(userData []byte) []*compute.MetadataItems {
 			Value: ptr.To("TRUE"),
 		},
 	}
+
+	if enableOSLogin {
+		items = append(items, &compute.MetadataItems{
+			Key:   "enable-oslogin",
+			Value: ptr.To("TRUE"),
+		})
+	}
+
+	return items
 }
 
 func machineTypeDefine(opt *Options) string {
@@ -22,6 +22,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	gcpapi "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
@@ -72,6 +73,26 @@ var _ = Describe("Bastion", func() {
 			Expect(options.ProjectID).To(Equal("projectID"))
 			Expect(options.Network).To(Equal("projects/projectID/global/networks/vNet"))
 			Expect(options.WorkersCIDR).To(Equal("10.250.0.0/16"))
+			Expect(options.EnableOSLogin).To(BeFalse())
+		})
+
+		It("should enable OS Login if it is enabled for any worker pool", func() {
+			workerConfig, _ := json.Marshal(&gcpapi.WorkerConfig{EnableOSLogin: ptr.To(true)})
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "pool-1"},
+				{Name: "pool-2", ProviderConfig: &runtime.RawExtension{Raw: workerConfig}},
+			}
+
+			options, err := DetermineOptions(bastion, cluster, "projectID", "vNet", "subnet")
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(options.EnableOSLogin).To(BeTrue())
+		})
+	})
+
+	Describe("metadataItemsDefine", func() {
+		It("should only set the enable-oslogin metadata if requested", func() {
+			Expect(metadataItemsDefine([]byte("data"), false)).NotTo(ContainElement(HaveField("Key", "enable-oslogin")))
+			Expect(metadataItemsDefine([]byte("data"), true)).To(ContainElement(And(HaveField("Key", "enable-oslogin"), HaveField("Value", ptr.To("TRUE")))))
 		})
 	})
 
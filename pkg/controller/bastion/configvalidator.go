@@ -8,6 +8,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"slices"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -19,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
@@ -28,14 +31,17 @@ type configValidator struct {
 	client           client.Client
 	gcpClientFactory gcpclient.Factory
 	logger           logr.Logger
+	bastionConfig    config.Bastion
 }
 
-// NewConfigValidator creates a new ConfigValidator.
-func NewConfigValidator(mgr manager.Manager, logger logr.Logger, gcpClientFactory gcpclient.Factory) bastion.ConfigValidator {
+// NewConfigValidator creates a new ConfigValidator. The ingress CIDRs of bastions are validated against the allowed
+// and denied ingress CIDRs of the given bastion configuration.
+func NewConfigValidator(mgr manager.Manager, logger logr.Logger, gcpClientFactory gcpclient.Factory, bastionConfig config.Bastion) bastion.ConfigValidator {
 	return &configValidator{
 		client:           mgr.GetClient(),
 		gcpClientFactory: gcpClientFactory,
 		logger:           logger.WithName("gcp-bastion-config-validator"),
+		bastionConfig:    bastionConfig,
 	}
 }
 
@@ -45,6 +51,8 @@ func (c *configValidator) Validate(ctx context.Context, bastion *extensionsv1alp
 
 	logger := c.logger.WithValues("bastion", client.ObjectKeyFromObject(bastion))
 
+	allErrs = append(allErrs, validateIngressCIDRs(bastion, c.bastionConfig)...)
+
 	infrastructureStatus, subnet, err := getInfrastructureStatus(ctx, c.client, cluster)
 	if err != nil {
 		allErrs = append(allErrs, field.InternalError(nil, err))
@@ -137,3 +145,58 @@ func (c *configValidator) validateInfrastructureStatus(ctx context.Context, comp
 
 	return allErrs
 }
+
+// validateIngressCIDRs validates that the ingress CIDRs of the given bastion are contained in one of the allowed ingress
+// CIDRs (if any) and do not contain any of the denied ingress CIDRs of the bastion configuration.
+func validateIngressCIDRs(bastion *extensionsv1alpha1.Bastion, bastionConfig config.Bastion) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allowed, err := parseCIDRs(bastionConfig.AllowedIngressCIDRs)
+	if err != nil {
+		return append(allErrs, field.InternalError(nil, fmt.Errorf("invalid allowed ingress CIDRs: %w", err)))
+	}
+	denied, err := parseCIDRs(bastionConfig.DeniedIngressCIDRs)
+	if err != nil {
+		return append(allErrs, field.InternalError(nil, fmt.Errorf("invalid denied ingress CIDRs: %w", err)))
+	}
+
+	for i, ingress := range bastion.Spec.Ingress {
+		cidrPath := field.NewPath("spec", "ingress").Index(i).Child("ipBlock", "cidr")
+
+		_, ingressCIDR, err := net.ParseCIDR(ingress.IPBlock.CIDR)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(cidrPath, ingress.IPBlock.CIDR, err.Error()))
+			continue
+		}
+
+		if len(allowed) > 0 && !slices.ContainsFunc(allowed, func(cidr *net.IPNet) bool { return cidrContains(cidr, ingressCIDR) }) {
+			allErrs = append(allErrs, field.Forbidden(cidrPath, fmt.Sprintf("ingress CIDR %s is not contained in any of the allowed ingress CIDRs %v", ingress.IPBlock.CIDR, bastionConfig.AllowedIngressCIDRs)))
+		}
+		for _, cidr := range denied {
+			if cidrContains(ingressCIDR, cidr) {
+				allErrs = append(allErrs, field.Forbidden(cidrPath, fmt.Sprintf("ingress CIDR %s contains the denied ingress CIDR %s", ingress.IPBlock.CIDR, cidr.String())))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	var result []*net.IPNet
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ipNet)
+	}
+	return result, nil
+}
+
+// cidrContains returns whether the outer CIDR contains the inner CIDR.
+func cidrContains(outer, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
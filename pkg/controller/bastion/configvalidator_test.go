@@ -19,12 +19,14 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/api/compute/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
@@ -63,7 +65,7 @@ var _ = Describe("ConfigValidator", func() {
 
 		mgr = mockmanager.NewMockManager(ctrl)
 		mgr.EXPECT().GetClient().Return(c)
-		cv = NewConfigValidator(mgr, logger, gcpClientFactory)
+		cv = NewConfigValidator(mgr, logger, gcpClientFactory, config.Bastion{})
 
 		bastion = &extensionsv1alpha1.Bastion{}
 		cluster = &extensions.Cluster{}
@@ -147,6 +149,60 @@ var _ = Describe("ConfigValidator", func() {
 				}))
 		})
 	})
+
+	Describe("#validateIngressCIDRs", func() {
+		var bastionConfig config.Bastion
+
+		BeforeEach(func() {
+			bastionConfig = config.Bastion{
+				AllowedIngressCIDRs: []string{"0.0.0.0/0"},
+				DeniedIngressCIDRs:  []string{"0.0.0.0/0", "10.0.0.0/8"},
+			}
+		})
+
+		withIngress := func(cidrs ...string) *extensionsv1alpha1.Bastion {
+			bastion := &extensionsv1alpha1.Bastion{}
+			for _, cidr := range cidrs {
+				bastion.Spec.Ingress = append(bastion.Spec.Ingress, extensionsv1alpha1.BastionIngressPolicy{IPBlock: networkingv1.IPBlock{CIDR: cidr}})
+			}
+			return bastion
+		}
+
+		It("should allow ingress CIDRs which do not contain denied CIDRs", func() {
+			Expect(validateIngressCIDRs(withIngress("213.69.151.0/24", "10.1.0.0/16"), bastionConfig)).To(BeEmpty())
+		})
+
+		It("should forbid ingress CIDRs which contain denied CIDRs", func() {
+			Expect(validateIngressCIDRs(withIngress("213.69.151.0/24", "0.0.0.0/0", "10.0.0.0/7"), bastionConfig)).To(ConsistOfFields(
+				gstruct.Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.ingress[1].ipBlock.cidr"),
+				},
+				gstruct.Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.ingress[1].ipBlock.cidr"),
+				},
+				gstruct.Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.ingress[2].ipBlock.cidr"),
+					"Detail": Equal("ingress CIDR 10.0.0.0/7 contains the denied ingress CIDR 10.0.0.0/8"),
+				}))
+		})
+
+		It("should forbid ingress CIDRs which are not contained in the allowed CIDRs", func() {
+			bastionConfig.AllowedIngressCIDRs = []string{"213.69.0.0/16"}
+
+			Expect(validateIngressCIDRs(withIngress("213.69.151.0/24", "213.0.0.0/8"), bastionConfig)).To(ConsistOfFields(
+				gstruct.Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.ingress[1].ipBlock.cidr"),
+				}))
+		})
+
+		It("should allow all ingress CIDRs without policy", func() {
+			Expect(validateIngressCIDRs(withIngress("0.0.0.0/0"), config.Bastion{})).To(BeEmpty())
+		})
+	})
 })
 
 func encode(obj runtime.Object) []byte {
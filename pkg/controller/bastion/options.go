@@ -37,6 +37,7 @@ type Options struct {
 	ProjectID           string
 	Network             string
 	WorkersCIDR         string
+	EnableOSLogin       bool
 }
 
 type providerStatusRaw struct {
@@ -64,6 +65,11 @@ func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.C
 		return nil, err
 	}
 
+	enableOSLogin, err := isOSLoginEnabled(cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	region := cluster.Shoot.Spec.Region
 	return &Options{
 		Shoot:               cluster.Shoot,
@@ -74,9 +80,30 @@ func DetermineOptions(bastion *extensionsv1alpha1.Bastion, cluster *controller.C
 		ProjectID:           projectID,
 		Network:             fmt.Sprintf("projects/%s/global/networks/%s", projectID, vNetworkName),
 		WorkersCIDR:         workersCidr,
+		EnableOSLogin:       enableOSLogin,
 	}, nil
 }
 
+// isOSLoginEnabled returns true if OS Login is enabled for any worker pool of the shoot, so that the nodes can be
+// reached via the bastion with the same IAM-based credentials.
+func isOSLoginEnabled(cluster *extensions.Cluster) (bool, error) {
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		if worker.ProviderConfig == nil || worker.ProviderConfig.Raw == nil {
+			continue
+		}
+
+		workerConfig := &gcpapi.WorkerConfig{}
+		if err := json.Unmarshal(worker.ProviderConfig.Raw, workerConfig); err != nil {
+			return false, fmt.Errorf("could not decode providerConfig of worker pool %q: %w", worker.Name, err)
+		}
+		if workerConfig.EnableOSLogin != nil && *workerConfig.EnableOSLogin {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func getZone(cluster *extensions.Cluster, region string, providerStatus *providerStatusRaw) string {
 	if providerStatus != nil {
 		return providerStatus.Zone
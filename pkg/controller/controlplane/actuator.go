@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// actuator wraps the generic control plane actuator. It additionally manages the Cloud Logging sink of the exported
+// audit logs and stores the defaults of internal load balancers and the sink in the provider status of the ControlPlane.
+type actuator struct {
+	controlplane.Actuator
+	client           client.Client
+	scheme           *runtime.Scheme
+	decoder          runtime.Decoder
+	gcpClientFactory gcpclient.Factory
+}
+
+// NewActuator returns an actuator which reconciles the control plane with the given actuator and updates the provider
+// status of the ControlPlane afterwards.
+func NewActuator(mgr manager.Manager, a controlplane.Actuator) controlplane.Actuator {
+	return &actuator{
+		Actuator:         a,
+		client:           mgr.GetClient(),
+		scheme:           mgr.GetScheme(),
+		decoder:          serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+		gcpClientFactory: gcpclient.New(),
+	}
+}
+
+// Reconcile reconciles the control plane and updates the provider status of the ControlPlane.
+func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (bool, error) {
+	requeue, err := a.Actuator.Reconcile(ctx, log, cp, cluster)
+	if err != nil || !isNormalPurpose(cp) {
+		return requeue, err
+	}
+
+	cpConfig := &apisgcp.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := a.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return requeue, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+	}
+	infraStatus := &apisgcp.InfrastructureStatus{}
+	if cp.Spec.InfrastructureProviderStatus != nil {
+		if _, _, err := a.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+			return requeue, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+	}
+
+	status := getControlPlaneStatus(cpConfig, infraStatus, cp)
+	if status.AuditLogExport, err = a.reconcileAuditLogSink(ctx, log, cp, cpConfig); err != nil {
+		return requeue, err
+	}
+	return requeue, a.updateProviderStatus(ctx, cp, status)
+}
+
+// Delete deletes the Cloud Logging sink of the exported audit logs, if any, and the control plane.
+func (a *actuator) Delete(ctx context.Context, log logr.Logger, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if isNormalPurpose(cp) {
+		if _, err := a.reconcileAuditLogSink(ctx, log, cp, &apisgcp.ControlPlaneConfig{}); err != nil {
+			return err
+		}
+	}
+	return a.Actuator.Delete(ctx, log, cp, cluster)
+}
+
+// reconcileAuditLogSink creates or updates the Cloud Logging sink which routes the audit logs of the kube-apiserver to
+// the configured destination. If the export of audit logs is not configured, the sink is deleted if it was created
+// before according to the provider status, so that no permissions are required for shoots which never used it.
+func (a *actuator) reconcileAuditLogSink(
+	ctx context.Context,
+	log logr.Logger,
+	cp *extensionsv1alpha1.ControlPlane,
+	cpConfig *apisgcp.ControlPlaneConfig,
+) (*apisgcp.AuditLogExportStatus, error) {
+	if cpConfig.AuditLogExport == nil {
+		currentStatus, err := a.getProviderStatus(cp)
+		if err != nil || currentStatus == nil || currentStatus.AuditLogExport == nil {
+			return nil, err
+		}
+
+		loggingClient, err := a.gcpClientFactory.Logging(ctx, a.client, cp.Spec.SecretRef)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("Deleting Cloud Logging sink of the audit logs", "sink", currentStatus.AuditLogExport.SinkName)
+		if err := loggingClient.DeleteSink(ctx, currentStatus.AuditLogExport.SinkName); err != nil {
+			return nil, fmt.Errorf("failed to delete Cloud Logging sink %s: %w", currentStatus.AuditLogExport.SinkName, err)
+		}
+		return nil, nil
+	}
+
+	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, a.client, cp.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+	if serviceAccount.Type != gcp.ServiceAccountCredentialType {
+		return nil, fmt.Errorf("the export of audit logs requires the credentials of a service account, but the cloud provider secret contains credentials of type %q", serviceAccount.Type)
+	}
+	loggingClient, err := a.gcpClientFactory.Logging(ctx, a.client, cp.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		id      = gcp.AuditLogSinkID(cp.Namespace)
+		desired = targetAuditLogSink(id, serviceAccount.ProjectID, cp.Namespace, cpConfig.AuditLogExport)
+	)
+
+	sink, err := loggingClient.GetSink(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case sink == nil:
+		log.Info("Creating Cloud Logging sink of the audit logs", "sink", id)
+		if sink, err = loggingClient.CreateSink(ctx, desired); err != nil {
+			return nil, fmt.Errorf("failed to create Cloud Logging sink %s: %w", id, err)
+		}
+	case sink.Destination != desired.Destination || sink.Filter != desired.Filter:
+		log.Info("Updating Cloud Logging sink of the audit logs", "sink", id)
+		if sink, err = loggingClient.UpdateSink(ctx, id, desired); err != nil {
+			return nil, fmt.Errorf("failed to update Cloud Logging sink %s: %w", id, err)
+		}
+	}
+
+	return &apisgcp.AuditLogExportStatus{SinkName: id, WriterIdentity: sink.WriterIdentity}, nil
+}
+
+func (a *actuator) getProviderStatus(cp *extensionsv1alpha1.ControlPlane) (*apisgcp.ControlPlaneStatus, error) {
+	if cp.Status.ProviderStatus == nil || cp.Status.ProviderStatus.Raw == nil {
+		return nil, nil
+	}
+	status := &apisgcp.ControlPlaneStatus{}
+	if _, _, err := a.decoder.Decode(cp.Status.ProviderStatus.Raw, nil, status); err != nil {
+		return nil, fmt.Errorf("could not decode providerStatus of controlplane '%s': %w", kutil.ObjectName(cp), err)
+	}
+	return status, nil
+}
+
+func (a *actuator) updateProviderStatus(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, status *apisgcp.ControlPlaneStatus) error {
+	statusV1alpha1 := &v1alpha1.ControlPlaneStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ControlPlaneStatus",
+		},
+	}
+	if err := a.scheme.Convert(status, statusV1alpha1, nil); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(cp.DeepCopy())
+	cp.Status.ProviderStatus = &runtime.RawExtension{Object: statusV1alpha1}
+	return a.client.Status().Patch(ctx, cp, patch)
+}
+
+func isNormalPurpose(cp *extensionsv1alpha1.ControlPlane) bool {
+	return ptr.Deref(cp.Spec.Purpose, extensionsv1alpha1.Normal) == extensionsv1alpha1.Normal
+}
+
+// getControlPlaneStatus returns the provider status of the ControlPlane, i.e. the defaults applied to internal load
+// balancers.
+func getControlPlaneStatus(
+	cpConfig *apisgcp.ControlPlaneConfig,
+	infraStatus *apisgcp.InfrastructureStatus,
+	cp *extensionsv1alpha1.ControlPlane,
+) *apisgcp.ControlPlaneStatus {
+	status := &apisgcp.InternalLoadBalancerStatus{
+		Subnet: getInternalLoadBalancerSubnet(cpConfig, infraStatus, cp),
+	}
+	if cpConfig.InternalLoadBalancer != nil {
+		status.GlobalAccess = ptr.Deref(cpConfig.InternalLoadBalancer.GlobalAccess, false)
+	}
+	return &apisgcp.ControlPlaneStatus{InternalLoadBalancer: status}
+}
+
+// targetAuditLogSink returns the desired Cloud Logging sink which routes the log with the audit logs of the shoot with
+// the given technical ID in the given project to the configured destination.
+func targetAuditLogSink(id, projectID, technicalID string, config *apisgcp.AuditLogExport) *gcpclient.LogSink {
+	return &gcpclient.LogSink{
+		Name:        id,
+		Description: fmt.Sprintf("Audit logs of the kube-apiserver of the shoot %s managed by Gardener", technicalID),
+		Destination: config.Destination,
+		Filter:      fmt.Sprintf(`logName="projects/%s/logs/%s"`, projectID, gcp.AuditLogID(technicalID)),
+	}
+}
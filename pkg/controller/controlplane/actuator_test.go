@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+var _ = Describe("Actuator", func() {
+	Describe("#getControlPlaneStatus", func() {
+		var (
+			cp          *extensionsv1alpha1.ControlPlane
+			infraStatus *apisgcp.InfrastructureStatus
+		)
+
+		BeforeEach(func() {
+			cp = &extensionsv1alpha1.ControlPlane{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar"}}
+			infraStatus = &apisgcp.InfrastructureStatus{
+				Networks: apisgcp.NetworkStatus{
+					VPC: apisgcp.VPC{Name: "vpc"},
+					Subnets: []apisgcp.Subnet{
+						{Name: "internal", Purpose: apisgcp.PurposeInternal},
+					},
+				},
+			}
+		})
+
+		It("should default to the internal subnet of the infrastructure", func() {
+			Expect(getControlPlaneStatus(&apisgcp.ControlPlaneConfig{}, infraStatus, cp)).To(Equal(&apisgcp.ControlPlaneStatus{
+				InternalLoadBalancer: &apisgcp.InternalLoadBalancerStatus{Subnet: "internal"},
+			}))
+		})
+
+		It("should return the configured defaults of internal load balancers", func() {
+			cpConfig := &apisgcp.ControlPlaneConfig{
+				InternalLoadBalancer: &apisgcp.InternalLoadBalancerConfig{
+					Subnet:       ptr.To("my-subnet"),
+					GlobalAccess: ptr.To(true),
+				},
+			}
+
+			Expect(getControlPlaneStatus(cpConfig, infraStatus, cp)).To(Equal(&apisgcp.ControlPlaneStatus{
+				InternalLoadBalancer: &apisgcp.InternalLoadBalancerStatus{Subnet: "my-subnet", GlobalAccess: true},
+			}))
+		})
+	})
+
+	Describe("#targetAuditLogSink", func() {
+		It("should route the audit log of the shoot to the destination", func() {
+			Expect(targetAuditLogSink("shoot--foo--bar-audit-logs", "my-project", "shoot--foo--bar", &apisgcp.AuditLogExport{
+				Destination: "pubsub.googleapis.com/projects/my-project/topics/audit",
+			})).To(Equal(&gcpclient.LogSink{
+				Name:        "shoot--foo--bar-audit-logs",
+				Description: "Audit logs of the kube-apiserver of the shoot shoot--foo--bar managed by Gardener",
+				Destination: "pubsub.googleapis.com/projects/my-project/topics/audit",
+				Filter:      `logName="projects/my-project/logs/shoot--foo--bar-kube-apiserver-audit"`,
+			}))
+		})
+	})
+})
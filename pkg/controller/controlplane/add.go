@@ -50,7 +50,7 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 	}
 
 	return controlplane.Add(ctx, mgr, controlplane.AddArgs{
-		Actuator:          genericActuator,
+		Actuator:          NewActuator(mgr, genericActuator),
 		ControllerOptions: opts.Controller,
 		Predicates:        controlplane.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
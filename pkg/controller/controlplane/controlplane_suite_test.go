@@ -9,9 +9,15 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 )
 
 func TestControlplane(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Controlplane Suite")
 }
+
+var _ = BeforeSuite(func() {
+	features.RegisterExtensionFeatureGate()
+})
@@ -9,6 +9,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
@@ -32,13 +34,17 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	autoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-provider-gcp/charts"
+	"github.com/gardener/gardener-extension-provider-gcp/imagevector"
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	gcpapihelper "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal/apihelper"
@@ -51,6 +57,9 @@ const (
 	csiSnapshotValidationServerName      = gcp.CSISnapshotValidationName + "-server"
 )
 
+// ImageVector is exposed for testing.
+var ImageVector = imagevector.ImageVector()
+
 func secretConfigsFunc(namespace string) []extensionssecretsmanager.SecretConfigWithOptions {
 	return []extensionssecretsmanager.SecretConfigWithOptions{
 		{
@@ -149,6 +158,20 @@ var (
 					{Type: &corev1.Service{}, Name: gcp.CSISnapshotValidationName},
 				},
 			},
+			{
+				Name: gcp.CSIFilestoreControllerName,
+				Images: []string{
+					gcp.CSIFilestoreDriverImageName,
+					gcp.CSIProvisionerImageName,
+					gcp.CSIResizerImageName,
+					gcp.CSILivenessProbeImageName,
+				},
+				Objects: []*chart.Object{
+					{Type: &appsv1.Deployment{}, Name: gcp.CSIFilestoreControllerName},
+					{Type: &corev1.ConfigMap{}, Name: gcp.CSIFilestoreControllerConfigName},
+					{Type: &autoscalingv1.VerticalPodAutoscaler{}, Name: gcp.CSIFilestoreControllerName + "-vpa"},
+				},
+			},
 		},
 	}
 
@@ -164,6 +187,7 @@ var (
 					{Type: &rbacv1.ClusterRoleBinding{}, Name: "system:controller:cloud-node-controller"},
 					{Type: &rbacv1.ClusterRole{}, Name: "gce:cloud-provider"},
 					{Type: &rbacv1.ClusterRoleBinding{}, Name: "gce:cloud-provider"},
+					{Type: &corev1.ConfigMap{}, Name: gcp.InternalLoadBalancerConfigMapName},
 				},
 			},
 			{
@@ -212,6 +236,20 @@ var (
 					{Type: &rbacv1.ClusterRoleBinding{}, Name: gcp.UsernamePrefix + gcp.CSISnapshotValidationName},
 				},
 			},
+			{
+				Name: gcp.CSIFilestoreNodeName,
+				Images: []string{
+					gcp.CSIFilestoreDriverImageName,
+					gcp.CSINodeDriverRegistrarImageName,
+					gcp.CSILivenessProbeImageName,
+				},
+				Objects: []*chart.Object{
+					{Type: &appsv1.DaemonSet{}, Name: gcp.CSIFilestoreNodeName},
+					{Type: &storagev1.CSIDriver{}, Name: gcp.CSIFilestoreProvisioner},
+					{Type: &corev1.ServiceAccount{}, Name: gcp.CSIFilestoreNodeName},
+					{Type: extensionscontroller.GetVerticalPodAutoscalerObject(), Name: gcp.CSIFilestoreNodeName},
+				},
+			},
 		},
 	}
 
@@ -327,7 +365,14 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	map[string]interface{},
 	error,
 ) {
-	return getControlPlaneShootChartValues(cluster, cp, secretsReader)
+	cpConfig := &apisgcp.ControlPlaneConfig{}
+	if cp.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+	}
+
+	return getControlPlaneShootChartValues(cpConfig, cluster, cp, secretsReader)
 }
 
 // getConfigChartValues collects and returns the configuration chart values.
@@ -338,16 +383,31 @@ func getConfigChartValues(
 	serviceAccount *gcp.ServiceAccount,
 ) (map[string]interface{}, error) {
 	// Determine network names
-	networkName, subNetworkName := getNetworkNames(infraStatus, cp)
+	networkName, _ := getNetworkNames(infraStatus, cp)
 
 	// Collect config chart values
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"projectID":      serviceAccount.ProjectID,
 		"networkName":    networkName,
-		"subNetworkName": subNetworkName,
+		"subNetworkName": getInternalLoadBalancerSubnet(cpConfig, infraStatus, cp),
 		"zone":           cpConfig.Zone,
-		"nodeTags":       cp.Namespace,
-	}, nil
+		"nodeTags":       []string{cp.Namespace},
+	}
+
+	if cpConfig.CloudControllerManager != nil && cpConfig.CloudControllerManager.CloudConfig != nil {
+		cloudConfig := cpConfig.CloudControllerManager.CloudConfig
+		if len(cloudConfig.NodeTags) > 0 {
+			values["nodeTags"] = cloudConfig.NodeTags
+		}
+		if cloudConfig.NodeInstancePrefix != nil {
+			values["nodeInstancePrefix"] = *cloudConfig.NodeInstancePrefix
+		}
+		if cloudConfig.SecondaryRangeName != nil {
+			values["secondaryRangeName"] = *cloudConfig.SecondaryRangeName
+		}
+	}
+
+	return values, nil
 }
 
 // getControlPlaneChartValues collects and returns the control plane chart values.
@@ -373,12 +433,18 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 		return nil, err
 	}
 
+	csiFilestore, err := getCSIFilestoreControllerChartValues(cpConfig, cp, cluster, serviceAccount, checksums, scaledDown)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]interface{}{
 		"global": map[string]interface{}{
 			"genericTokenKubeconfigSecretName": extensionscontroller.GenericTokenKubeconfigSecretNameFromCluster(cluster),
 		},
 		gcp.CloudControllerManagerName: ccm,
 		gcp.CSIControllerName:          csi,
+		gcp.CSIFilestoreControllerName: csiFilestore,
 	}, nil
 }
 
@@ -417,6 +483,10 @@ func (vp *valuesProvider) getCCMChartValues(
 
 	if cpConfig.CloudControllerManager != nil {
 		values["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
+		values["flags"] = cpConfig.CloudControllerManager.Flags
+		if resources := cpConfig.CloudControllerManager.Resources; resources != nil {
+			values["resources"] = getResourcesChartValues(*resources)
+		}
 	}
 
 	ok, err := vp.isOverlayEnabled(cluster.Shoot.Spec.Networking)
@@ -431,7 +501,7 @@ func (vp *valuesProvider) getCCMChartValues(
 // getCSIControllerChartValues collects and returns the CSIController chart values.
 func getCSIControllerChartValues(
 	cpConfig *apisgcp.ControlPlaneConfig,
-	_ *extensionsv1alpha1.ControlPlane,
+	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 	secretsReader secretsmanager.Reader,
 	serviceAccount *gcp.ServiceAccount,
@@ -443,7 +513,7 @@ func getCSIControllerChartValues(
 		return nil, fmt.Errorf("secret %q not found", csiSnapshotValidationServerName)
 	}
 
-	return map[string]interface{}{
+	values := map[string]interface{}{
 		"enabled":   true,
 		"replicas":  extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
 		"projectID": serviceAccount.ProjectID,
@@ -461,11 +531,108 @@ func getCSIControllerChartValues(
 			},
 			"topologyAwareRoutingEnabled": gardencorev1beta1helper.IsTopologyAwareRoutingForShootControlPlaneEnabled(cluster.Seed, cluster.Shoot),
 		},
-	}, nil
+	}
+
+	if resources := getCSIDriverResources(cpConfig); resources != nil && len(resources.Controller) > 0 {
+		values["resources"] = getContainerResourcesChartValues(resources.Controller)
+	}
+
+	driverImage, err := getCSIDriverImage(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if driverImage != "" {
+		values["driverImage"] = driverImage
+	}
+
+	extraLabels, err := getCSIExtraLabels(cp, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if len(extraLabels) > 0 {
+		values["extraLabels"] = extraLabels
+	}
+
+	return values, nil
+}
+
+// getCSIFilestoreControllerChartValues collects and returns the chart values of the controller of the Filestore CSI driver.
+func getCSIFilestoreControllerChartValues(
+	cpConfig *apisgcp.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	serviceAccount *gcp.ServiceAccount,
+	checksums map[string]string,
+	scaledDown bool,
+) (map[string]interface{}, error) {
+	if !isFilestoreEnabled(cpConfig) {
+		return map[string]interface{}{"enabled": false}, nil
+	}
+
+	values := map[string]interface{}{
+		"enabled":   true,
+		"replicas":  extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1),
+		"projectID": serviceAccount.ProjectID,
+		"zone":      cpConfig.Zone,
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+		},
+	}
+
+	if resources := cpConfig.Storage.Filestore.Resources; resources != nil && len(resources.Controller) > 0 {
+		values["resources"] = getContainerResourcesChartValues(resources.Controller)
+	}
+
+	extraLabels, err := getCSIExtraLabels(cp, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if len(extraLabels) > 0 {
+		values["extraLabels"] = extraLabels
+	}
+
+	return values, nil
+}
+
+// getCSIDriverImage returns the image of the CSI driver selected in the CloudProfileConfig or an empty string if the
+// image of the stable channel, i.e. the one of the image vector, shall be used.
+func getCSIDriverImage(cluster *extensionscontroller.Cluster) (string, error) {
+	cloudProfileConfig, err := gcpapihelper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return "", err
+	}
+	if cloudProfileConfig == nil || cloudProfileConfig.CSIDriver == nil {
+		return "", nil
+	}
+
+	if cloudProfileConfig.CSIDriver.Image != nil {
+		return *cloudProfileConfig.CSIDriver.Image, nil
+	}
+	if ptr.Deref(cloudProfileConfig.CSIDriver.Channel, apisgcp.CSIDriverChannelStable) == apisgcp.CSIDriverChannelLatest {
+		image, err := ImageVector.FindImage(gcp.CSIDriverLatestImageName)
+		if err != nil {
+			return "", fmt.Errorf("could not find image of the %s channel of the CSI driver: %w", apisgcp.CSIDriverChannelLatest, err)
+		}
+		return image.String(), nil
+	}
+	return "", nil
+}
+
+// getCSIExtraLabels returns the labels which are added to the volumes provisioned by the CSI drivers, i.e. the labels
+// of the shoot and the resource labels of the infrastructure. The cluster name label is always set by the charts.
+func getCSIExtraLabels(cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (map[string]string, error) {
+	infrastructureConfig, err := gcpapihelper.InfrastructureConfigFromRawExtension(cluster.Shoot.Spec.Provider.InfrastructureConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode infrastructure config: %w", err)
+	}
+	extraLabels := gcp.ResourceLabels(cp.Namespace, cluster.Shoot, infrastructureConfig.ResourceLabels)
+	delete(extraLabels, gcp.LabelKeyClusterName)
+	return extraLabels, nil
 }
 
 // getControlPlaneShootChartValues collects and returns the control plane shoot chart values.
 func getControlPlaneShootChartValues(
+	cpConfig *apisgcp.ControlPlaneConfig,
 	cluster *extensionscontroller.Cluster,
 	cp *extensionsv1alpha1.ControlPlane,
 	secretsReader secretsmanager.Reader,
@@ -476,17 +643,54 @@ func getControlPlaneShootChartValues(
 		return nil, fmt.Errorf("secret %q not found", caNameControlPlane)
 	}
 
-	return map[string]interface{}{
-		gcp.CloudControllerManagerName: map[string]interface{}{"enabled": true},
-		gcp.CSINodeName: map[string]interface{}{
-			"enabled":           true,
-			"kubernetesVersion": kubernetesVersion,
-			"vpaEnabled":        gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
-			"webhookConfig": map[string]interface{}{
-				"url":      "https://" + gcp.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
-				"caBundle": string(caSecret.Data[secretutils.DataKeyCertificateBundle]),
-			},
+	csiNode := map[string]interface{}{
+		"enabled":           true,
+		"kubernetesVersion": kubernetesVersion,
+		"vpaEnabled":        gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
+		"webhookConfig": map[string]interface{}{
+			"url":      "https://" + gcp.CSISnapshotValidationName + "." + cp.Namespace + "/volumesnapshot",
+			"caBundle": string(caSecret.Data[secretutils.DataKeyCertificateBundle]),
 		},
+	}
+	// The metadata server is not reachable via its IPv4 link-local address from IPv6-only nodes.
+	if gcp.IsIPv6SingleStack(cluster.Shoot.Spec.Networking) {
+		csiNode["metadataHost"] = "[" + gcp.MetadataServerIPv6 + "]"
+	}
+	if resources := getCSIDriverResources(cpConfig); resources != nil && len(resources.Node) > 0 {
+		csiNode["resources"] = getContainerResourcesChartValues(resources.Node)
+	}
+	driverImage, err := getCSIDriverImage(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if driverImage != "" {
+		csiNode["driverImage"] = driverImage
+	}
+
+	ccm := map[string]interface{}{
+		"enabled": true,
+	}
+	if cpConfig.InternalLoadBalancer != nil && ptr.Deref(cpConfig.InternalLoadBalancer.GlobalAccess, false) {
+		if !features.ExtensionFeatureGate.Enabled(features.InternalLoadBalancerGlobalAccess) {
+			return nil, fmt.Errorf("the global access of internal load balancers can only be enabled by default if the %s feature gate is enabled", features.InternalLoadBalancerGlobalAccess)
+		}
+		ccm["internalLoadBalancerGlobalAccess"] = true
+	}
+
+	csiFilestoreNode := map[string]interface{}{
+		"enabled": isFilestoreEnabled(cpConfig),
+	}
+	if isFilestoreEnabled(cpConfig) {
+		csiFilestoreNode["vpaEnabled"] = gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot)
+		if resources := cpConfig.Storage.Filestore.Resources; resources != nil && len(resources.Node) > 0 {
+			csiFilestoreNode["resources"] = getContainerResourcesChartValues(resources.Node)
+		}
+	}
+
+	return map[string]interface{}{
+		gcp.CloudControllerManagerName: ccm,
+		gcp.CSINodeName:                csiNode,
+		gcp.CSIFilestoreNodeName:       csiFilestoreNode,
 	}, nil
 }
 
@@ -494,7 +698,7 @@ func getControlPlaneShootChartValues(
 func (vp *valuesProvider) GetStorageClassesChartValues(
 	_ context.Context,
 	cp *extensionsv1alpha1.ControlPlane,
-	_ *extensionscontroller.Cluster,
+	cluster *extensionscontroller.Cluster,
 ) (map[string]interface{}, error) {
 	managedDefaultStorageClass := true
 	managedDefaultVolumeSnapshotClass := true
@@ -512,10 +716,150 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 		managedDefaultVolumeSnapshotClass = ptr.Deref(cpConfig.Storage.ManagedDefaultVolumeSnapshotClass, true)
 	}
 
-	return map[string]interface{}{
+	filestore := map[string]interface{}{
+		"enabled": isFilestoreEnabled(cpConfig),
+	}
+	if isFilestoreEnabled(cpConfig) {
+		// Filestore instances are connected to the VPC of the shoot, otherwise they are created in the 'default' network.
+		infraStatus := &apisgcp.InfrastructureStatus{}
+		if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+			return nil, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane '%s': %w", kutil.ObjectName(cp), err)
+		}
+		filestore["network"], _ = getNetworkNames(infraStatus, cp)
+	}
+
+	// The volumes are only provisioned in the zones of the worker pools, so that no volumes are created in zones
+	// without nodes which could mount them.
+	zones := getWorkerZones(cluster)
+
+	values := map[string]interface{}{
 		"managedDefaultStorageClass":        managedDefaultStorageClass,
 		"managedDefaultVolumeSnapshotClass": managedDefaultVolumeSnapshotClass,
-	}, nil
+		"filestore":                         filestore,
+		"zones":                             zones,
+	}
+	if cpConfig.Storage != nil && cpConfig.Storage.VolumeSnapshotClass != nil {
+		values["volumeSnapshotClass"] = getVolumeSnapshotClassParameters(cpConfig.Storage.VolumeSnapshotClass)
+	}
+	if cpConfig.Storage != nil && len(cpConfig.Storage.StorageClasses) > 0 {
+		values["storageClasses"] = getStorageClasses(cpConfig.Storage.StorageClasses, zones)
+	}
+
+	return values, nil
+}
+
+// getStorageClasses returns the StorageClasses configured in the ControlPlaneConfig together with their parameters as
+// expected by the persistent disk CSI driver.
+func getStorageClasses(classes []apisgcp.StorageClass, zones []string) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, class := range classes {
+		parameters := map[string]interface{}{
+			"type": class.Type,
+		}
+		if class.ReplicationType != nil {
+			parameters["replication-type"] = *class.ReplicationType
+		}
+		if class.ProvisionedIOPS != nil {
+			parameters["provisioned-iops-on-create"] = strconv.FormatInt(*class.ProvisionedIOPS, 10)
+		}
+		if class.ProvisionedThroughput != nil {
+			parameters["provisioned-throughput-on-create"] = strconv.FormatInt(*class.ProvisionedThroughput, 10) + "Mi"
+		}
+		if class.KmsKeyName != nil {
+			parameters["disk-encryption-kms-key"] = *class.KmsKeyName
+		}
+
+		storageClass := map[string]interface{}{
+			"name":       class.Name,
+			"default":    class.Default,
+			"parameters": parameters,
+		}
+		// Regional disks are replicated to a second zone, which must be part of the allowed topologies as well.
+		if len(zones) > 1 || (len(zones) == 1 && ptr.Deref(class.ReplicationType, "") != "regional-pd") {
+			storageClass["zones"] = zones
+		}
+
+		result = append(result, storageClass)
+	}
+	return result
+}
+
+// getWorkerZones returns the sorted zones of the worker pools of the shoot.
+func getWorkerZones(cluster *extensionscontroller.Cluster) []string {
+	zones := sets.New[string]()
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		zones.Insert(worker.Zones...)
+	}
+	return sets.List(zones)
+}
+
+// getVolumeSnapshotClassParameters returns the parameters of the 'default' VolumeSnapshotClass as expected by the
+// persistent disk CSI driver.
+func getVolumeSnapshotClassParameters(config *apisgcp.VolumeSnapshotClassConfig) map[string]interface{} {
+	parameters := map[string]interface{}{}
+	if config.StorageLocation != nil {
+		parameters["storage-locations"] = *config.StorageLocation
+	}
+	if len(config.Labels) > 0 {
+		var labels []string
+		for _, key := range sets.List(sets.KeySet(config.Labels)) {
+			labels = append(labels, key+"="+config.Labels[key])
+		}
+		parameters["labels"] = strings.Join(labels, ",")
+	}
+	return parameters
+}
+
+func getCSIDriverResources(cpConfig *apisgcp.ControlPlaneConfig) *apisgcp.CSIDriverResources {
+	if cpConfig.Storage == nil {
+		return nil
+	}
+	return cpConfig.Storage.CSIDriverResources
+}
+
+// getContainerResourcesChartValues returns the chart values of the given resource requirements of containers, keyed by
+// the name of the container.
+func getContainerResourcesChartValues(resources map[string]corev1.ResourceRequirements) map[string]interface{} {
+	values := make(map[string]interface{}, len(resources))
+	for container, requirements := range resources {
+		values[container] = getResourcesChartValues(requirements)
+	}
+	return values
+}
+
+// getResourcesChartValues returns the chart values of the given resource requirements. The values are merged with the
+// defaults of the charts, hence only the given resources override the defaults.
+func getResourcesChartValues(resources corev1.ResourceRequirements) map[string]interface{} {
+	values := map[string]interface{}{}
+	for key, list := range map[string]corev1.ResourceList{"requests": resources.Requests, "limits": resources.Limits} {
+		if len(list) == 0 {
+			continue
+		}
+		quantities := make(map[string]interface{}, len(list))
+		for name, quantity := range list {
+			quantities[string(name)] = quantity.String()
+		}
+		values[key] = quantities
+	}
+	return values
+}
+
+func isFilestoreEnabled(cpConfig *apisgcp.ControlPlaneConfig) bool {
+	return cpConfig.Storage != nil && cpConfig.Storage.Filestore != nil && cpConfig.Storage.Filestore.Enabled
+}
+
+// getInternalLoadBalancerSubnet returns the name of the subnet in which the cloud-controller-manager creates internal
+// load balancers, i.e. the configured subnet or the internal subnet of the infrastructure, if any.
+func getInternalLoadBalancerSubnet(
+	cpConfig *apisgcp.ControlPlaneConfig,
+	infraStatus *apisgcp.InfrastructureStatus,
+	cp *extensionsv1alpha1.ControlPlane,
+) string {
+	if cpConfig.InternalLoadBalancer != nil && cpConfig.InternalLoadBalancer.Subnet != nil {
+		return *cpConfig.InternalLoadBalancer.Subnet
+	}
+	_, subNetworkName := getNetworkNames(infraStatus, cp)
+	return subNetworkName
 }
 
 // getNetworkNames determines the network and subnetwork names from the given infrastructure status and controlplane.
@@ -14,8 +14,10 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 	mockmanager "github.com/gardener/gardener/third_party/mock/controller-runtime/manager"
 	. "github.com/onsi/ginkgo/v2"
@@ -23,6 +25,7 @@ import (
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
@@ -30,6 +33,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
 )
@@ -69,6 +73,9 @@ var _ = Describe("ValuesProvider", func() {
 								FeatureGates: map[string]bool{
 									"RotateKubeletServerCertificate": true,
 								},
+								Flags: map[string]string{
+									"node-monitor-period": "10s",
+								},
 							},
 							Storage: &apisgcp.Storage{
 								ManagedDefaultStorageClass:        ptr.To(true),
@@ -183,9 +190,53 @@ var _ = Describe("ValuesProvider", func() {
 				"networkName":    "vpc-1234",
 				"subNetworkName": "subnet-acbd1234",
 				"zone":           zone,
-				"nodeTags":       namespace,
+				"nodeTags":       []string{namespace},
 			}))
 		})
+
+		It("should return the configured settings of the cloud-config", func() {
+			c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: zone,
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					CloudConfig: &apisgcp.CloudConfig{
+						NodeTags:           []string{"lb-targets"},
+						NodeInstancePrefix: ptr.To("test-"),
+						SecondaryRangeName: ptr.To("pods"),
+					},
+				},
+			})
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"projectID":          projectID,
+				"networkName":        "vpc-1234",
+				"subNetworkName":     "subnet-acbd1234",
+				"zone":               zone,
+				"nodeTags":           []string{"lb-targets"},
+				"nodeInstancePrefix": "test-",
+				"secondaryRangeName": "pods",
+			}))
+		})
+
+		It("should use the configured subnet for internal load balancers", func() {
+			c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
+
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: zone,
+				InternalLoadBalancer: &apisgcp.InternalLoadBalancerConfig{
+					Subnet: ptr.To("my-internal-subnet"),
+				},
+			})
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("subNetworkName", "my-internal-subnet"))
+		})
 	})
 
 	Describe("#GetControlPlaneChartValues", func() {
@@ -203,6 +254,9 @@ var _ = Describe("ValuesProvider", func() {
 			"featureGates": map[string]bool{
 				"RotateKubeletServerCertificate": true,
 			},
+			"flags": map[string]string{
+				"node-monitor-period": "10s",
+			},
 			"tlsCipherSuites": []string{
 				"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
 				"TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
@@ -259,9 +313,92 @@ var _ = Describe("ValuesProvider", func() {
 						"topologyAwareRoutingEnabled": false,
 					},
 				}),
+				gcp.CSIFilestoreControllerName: map[string]interface{}{"enabled": false},
+			}))
+		})
+
+		It("should return correct control plane chart values if the Filestore CSI driver is enabled", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: zone,
+				Storage: &apisgcp.Storage{
+					Filestore: &apisgcp.Filestore{Enabled: true},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSIFilestoreControllerName]).To(Equal(utils.MergeMaps(enabledTrue, map[string]interface{}{
+				"replicas":  1,
+				"projectID": projectID,
+				"zone":      zone,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-" + v1beta1constants.SecretNameCloudProvider: checksums[v1beta1constants.SecretNameCloudProvider],
+				},
+			})))
+		})
+
+		It("should override the resources of the CCM and the CSI controllers", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Zone: zone,
+				CloudControllerManager: &apisgcp.CloudControllerManagerConfig{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+					},
+				},
+				Storage: &apisgcp.Storage{
+					CSIDriverResources: &apisgcp.CSIDriverResources{
+						Controller: map[string]corev1.ResourceRequirements{
+							"provisioner": {Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}},
+						},
+					},
+					Filestore: &apisgcp.Filestore{
+						Enabled: true,
+						Resources: &apisgcp.CSIDriverResources{
+							Controller: map[string]corev1.ResourceRequirements{
+								"driver": {Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("20Mi")}},
+							},
+						},
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(HaveKeyWithValue("resources", map[string]interface{}{
+				"requests": map[string]interface{}{"cpu": "200m"},
+			}))
+			Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("resources", map[string]interface{}{
+				"provisioner": map[string]interface{}{
+					"limits": map[string]interface{}{"memory": "1Gi"},
+				},
+			}))
+			Expect(values[gcp.CSIFilestoreControllerName]).To(HaveKeyWithValue("resources", map[string]interface{}{
+				"driver": map[string]interface{}{
+					"requests": map[string]interface{}{"memory": "20Mi"},
+				},
 			}))
 		})
 
+		It("should use the image of the CSI driver configured in the CloudProfileConfig", func() {
+			cluster.CloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					ProviderConfig: &runtime.RawExtension{
+						Raw: encode(&apisgcp.CloudProfileConfig{
+							CSIDriver: &apisgcp.CSIDriverConfig{
+								Image: ptr.To("example.com/csi-driver:v1.2.3"),
+							},
+						}),
+					},
+				},
+			}
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSIControllerName]).To(HaveKeyWithValue("driverImage", "example.com/csi-driver:v1.2.3"))
+		})
+
 		It("should return correct control plane chart values for clusters without overlay", func() {
 			shootWithoutOverlay := cluster.Shoot.DeepCopy()
 			shootWithoutOverlay.Spec.Networking.Type = ptr.To("calico")
@@ -346,8 +483,132 @@ var _ = Describe("ValuesProvider", func() {
 						"caBundle": "",
 					},
 				}),
+				gcp.CSIFilestoreNodeName: map[string]interface{}{"enabled": false},
 			}))
 		})
+
+		It("should deploy the node plugin of the Filestore CSI driver if it is enabled", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					Filestore: &apisgcp.Filestore{Enabled: true},
+				},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSIFilestoreNodeName]).To(Equal(utils.MergeMaps(enabledTrue, map[string]interface{}{
+				"vpaEnabled": true,
+			})))
+		})
+
+		It("should override the resources of the CSI node plugins", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					CSIDriverResources: &apisgcp.CSIDriverResources{
+						Node: map[string]corev1.ResourceRequirements{
+							"driver": {Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("5m")}},
+						},
+					},
+					Filestore: &apisgcp.Filestore{
+						Enabled: true,
+						Resources: &apisgcp.CSIDriverResources{
+							Node: map[string]corev1.ResourceRequirements{
+								"nodeDriverRegistrar": {Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")}},
+							},
+						},
+					},
+				},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSINodeName]).To(HaveKeyWithValue("resources", map[string]interface{}{
+				"driver": map[string]interface{}{
+					"requests": map[string]interface{}{"cpu": "5m"},
+				},
+			}))
+			Expect(values[gcp.CSIFilestoreNodeName]).To(HaveKeyWithValue("resources", map[string]interface{}{
+				"nodeDriverRegistrar": map[string]interface{}{
+					"limits": map[string]interface{}{"memory": "64Mi"},
+				},
+			}))
+		})
+
+		It("should fail to enable the global access of internal load balancers if the feature gate is disabled", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				InternalLoadBalancer: &apisgcp.InternalLoadBalancerConfig{GlobalAccess: ptr.To(true)},
+			})
+
+			_, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).To(MatchError(ContainSubstring(string(features.InternalLoadBalancerGlobalAccess))))
+		})
+
+		It("should enable the global access of internal load balancers if the feature gate is enabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.InternalLoadBalancerGlobalAccess, true))
+
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				InternalLoadBalancer: &apisgcp.InternalLoadBalancerConfig{GlobalAccess: ptr.To(true)},
+			})
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CloudControllerManagerName]).To(Equal(utils.MergeMaps(enabledTrue, map[string]interface{}{
+				"internalLoadBalancerGlobalAccess": true,
+			})))
+		})
+
+		It("should use the image of the latest channel of the CSI driver if it is selected", func() {
+			DeferCleanup(test.WithVar(&ImageVector, imagevectorutils.ImageVector{{
+				Name:       gcp.CSIDriverLatestImageName,
+				Repository: "foo",
+				Tag:        ptr.To("bar"),
+			}}))
+			cluster.CloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					ProviderConfig: &runtime.RawExtension{
+						Raw: encode(&apisgcp.CloudProfileConfig{
+							CSIDriver: &apisgcp.CSIDriverConfig{
+								Channel: ptr.To(apisgcp.CSIDriverChannelLatest),
+							},
+						}),
+					},
+				},
+			}
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSINodeName]).To(HaveKeyWithValue("driverImage", "foo:bar"))
+		})
+
+		It("should not override the image of the CSI driver for the stable channel", func() {
+			cluster.CloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					ProviderConfig: &runtime.RawExtension{
+						Raw: encode(&apisgcp.CloudProfileConfig{
+							CSIDriver: &apisgcp.CSIDriverConfig{
+								Channel: ptr.To(apisgcp.CSIDriverChannelStable),
+							},
+						}),
+					},
+				},
+			}
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSINodeName]).NotTo(HaveKey("driverImage"))
+		})
+
+		It("should configure the IPv6 metadata server address for IPv6 single-stack shoots", func() {
+			cluster.Shoot.Spec.Networking.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values[gcp.CSINodeName]).To(HaveKeyWithValue("metadataHost", "[fd20:ce::254]"))
+		})
 	})
 	Describe("#GetStorageClassesChartValues()", func() {
 		It("should return correct storage class chart values when using managed classes", func() {
@@ -356,6 +617,8 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values).To(Equal(map[string]interface{}{
 				"managedDefaultStorageClass":        true,
 				"managedDefaultVolumeSnapshotClass": true,
+				"filestore":                         map[string]interface{}{"enabled": false},
+				"zones":                             []string{},
 			}))
 		})
 
@@ -372,6 +635,120 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values).To(Equal(map[string]interface{}{
 				"managedDefaultStorageClass":        false,
 				"managedDefaultVolumeSnapshotClass": false,
+				"filestore":                         map[string]interface{}{"enabled": false},
+				"zones":                             []string{},
+			}))
+		})
+
+		It("should return the parameters of the VolumeSnapshotClass", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					VolumeSnapshotClass: &apisgcp.VolumeSnapshotClassConfig{
+						StorageLocation: ptr.To("eu"),
+						Labels:          map[string]string{"team": "storage", "backup": "daily"},
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("volumeSnapshotClass", map[string]interface{}{
+				"storage-locations": "eu",
+				"labels":            "backup=daily,team=storage",
+			}))
+		})
+
+		It("should return the configured StorageClasses", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					StorageClasses: []apisgcp.StorageClass{
+						{Name: "default", Default: true, Type: "pd-balanced", ReplicationType: ptr.To("regional-pd")},
+						{Name: "fast", Type: "hyperdisk-balanced", ProvisionedIOPS: ptr.To[int64](5000), ProvisionedThroughput: ptr.To[int64](250), KmsKeyName: ptr.To("projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz")},
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("storageClasses", []map[string]interface{}{
+				{
+					"name":    "default",
+					"default": true,
+					"parameters": map[string]interface{}{
+						"type":             "pd-balanced",
+						"replication-type": "regional-pd",
+					},
+				},
+				{
+					"name":    "fast",
+					"default": false,
+					"parameters": map[string]interface{}{
+						"type":                             "hyperdisk-balanced",
+						"provisioned-iops-on-create":       "5000",
+						"provisioned-throughput-on-create": "250Mi",
+						"disk-encryption-kms-key":          "projects/foo/locations/europe-west1/keyRings/bar/cryptoKeys/baz",
+					},
+				},
+			}))
+		})
+
+		It("should restrict the StorageClasses to the zones of the worker pools", func() {
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "worker-a", Zones: []string{"europe-west1-c", "europe-west1-b"}},
+				{Name: "worker-b", Zones: []string{"europe-west1-b"}},
+			}
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					StorageClasses: []apisgcp.StorageClass{
+						{Name: "default", Type: "pd-balanced", ReplicationType: ptr.To("regional-pd")},
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("zones", []string{"europe-west1-b", "europe-west1-c"}))
+			Expect(values["storageClasses"]).To(ConsistOf(HaveKeyWithValue("zones", []string{"europe-west1-b", "europe-west1-c"})))
+		})
+
+		It("should not restrict regional StorageClasses to a single zone", func() {
+			cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "worker", Zones: []string{"europe-west1-b"}},
+			}
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					StorageClasses: []apisgcp.StorageClass{
+						{Name: "regional", Type: "pd-balanced", ReplicationType: ptr.To("regional-pd")},
+						{Name: "zonal", Type: "pd-balanced"},
+					},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["storageClasses"]).To(ConsistOf(
+				And(HaveKeyWithValue("name", "regional"), Not(HaveKey("zones"))),
+				And(HaveKeyWithValue("name", "zonal"), HaveKeyWithValue("zones", []string{"europe-west1-b"})),
+			))
+		})
+
+		It("should return the network of the Filestore StorageClass if the Filestore CSI driver is enabled", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig.Raw = encode(&apisgcp.ControlPlaneConfig{
+				Storage: &apisgcp.Storage{
+					Filestore: &apisgcp.Filestore{Enabled: true},
+				},
+			})
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue("filestore", map[string]interface{}{
+				"enabled": true,
+				"network": "vpc-1234",
 			}))
 		})
 	})
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionprotection
+
+import (
+	"context"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// ControllerName is the name of the machine deletion protection controller.
+	ControllerName = "machine-deletion-protection"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when adding the GCP machine deletion protection controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&machinev1alpha1.Machine{}, builder.WithPredicates(IsBeingDeleted())).
+		WithOptions(opts.Controller).
+		Complete(NewReconciler(mgr.GetClient(), gcpclient.New()))
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
+
+// IsBeingDeleted returns a predicate that only lets through machines which are being deleted and whose deletion
+// protection has not been removed yet.
+func IsBeingDeleted() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetDeletionTimestamp() != nil && obj.GetAnnotations()[AnnotationDeletionProtectionRemoved] != "true"
+	})
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionprotection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// AnnotationDeletionProtectionRemoved is the annotation which marks machines whose instance deletion protection has
+	// been removed.
+	AnnotationDeletionProtectionRemoved = "gcp.provider.extensions.gardener.cloud/deletion-protection-removed"
+
+	providerGCP = "GCP"
+)
+
+// providerSpec contains the fields of the machine class provider spec relevant for this controller.
+type providerSpec struct {
+	DeletionProtection bool `json:"deletionProtection"`
+}
+
+type reconciler struct {
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
+}
+
+// NewReconciler creates a new reconcile.Reconciler which removes the deletion protection of the instances of machines
+// which are being deleted.
+func NewReconciler(c client.Client, gcpClientFactory gcpclient.Factory) reconcile.Reconciler {
+	return &reconciler{
+		client:           c,
+		gcpClientFactory: gcpClientFactory,
+	}
+}
+
+// Reconcile removes the deletion protection of the instance backing a machine which is being deleted, so that the
+// machine-controller-manager is able to delete the instance.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	machine := &machinev1alpha1.Machine{}
+	if err := r.client.Get(ctx, request.NamespacedName, machine); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if machine.DeletionTimestamp == nil || machine.Annotations[AnnotationDeletionProtectionRemoved] == "true" {
+		return reconcile.Result{}, nil
+	}
+
+	machineClass := &machinev1alpha1.MachineClass{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: machine.Spec.Class.Name}, machineClass); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if machineClass.Provider != providerGCP || machineClass.ProviderSpec.Raw == nil {
+		return reconcile.Result{}, nil
+	}
+
+	spec := &providerSpec{}
+	if err := json.Unmarshal(machineClass.ProviderSpec.Raw, spec); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not decode provider spec of machine class %q: %w", machineClass.Name, err)
+	}
+	if !spec.DeletionProtection || machine.Spec.ProviderID == "" {
+		return reconcile.Result{}, nil
+	}
+
+	zone, instance, err := gcp.ParseProviderID(machine.Spec.ProviderID)
+	if err != nil {
+		logger.Error(err, "Cannot remove deletion protection")
+		return reconcile.Result{}, nil
+	}
+
+	secretRef := machineClass.CredentialsSecretRef
+	if secretRef == nil {
+		secretRef = machineClass.SecretRef
+	}
+	if secretRef == nil {
+		return reconcile.Result{}, fmt.Errorf("machine class %q does not reference any credentials", machineClass.Name)
+	}
+
+	computeClient, err := r.gcpClientFactory.Compute(ctx, r.client, *secretRef)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create compute client: %w", err)
+	}
+
+	logger.Info("Removing deletion protection", "zone", zone, "instance", instance)
+	if err := computeClient.SetInstanceDeletionProtection(ctx, zone, instance, false); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not remove deletion protection of instance %q: %w", instance, err)
+	}
+
+	patch := client.MergeFrom(machine.DeepCopy())
+	metav1.SetMetaDataAnnotation(&machine.ObjectMeta, AnnotationDeletionProtectionRemoved, "true")
+	return reconcile.Result{}, r.client.Patch(ctx, machine, patch)
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deletionprotection_test
+
+import (
+	"context"
+	"fmt"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/deletionprotection"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		zone      = "europe-west1-b"
+		instance  = "shoot--foo--bar-worker-z1-abcde"
+	)
+
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+
+		scheme           *runtime.Scheme
+		c                client.Client
+		gcpClientFactory *mockgcpclient.MockFactory
+		computeClient    *mockgcpclient.MockComputeClient
+		r                reconcile.Reconciler
+
+		secretRef    corev1.SecretReference
+		machineClass *machinev1alpha1.MachineClass
+		machine      *machinev1alpha1.Machine
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		scheme = runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		secretRef = corev1.SecretReference{Name: "cloudprovider", Namespace: namespace}
+		machineClass = &machinev1alpha1.MachineClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: "worker-z1", Namespace: namespace},
+			CredentialsSecretRef: &secretRef,
+			Provider:             "GCP",
+			ProviderSpec:         runtime.RawExtension{Raw: []byte(`{"deletionProtection":true}`)},
+		}
+		machine = &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              instance,
+				Namespace:         namespace,
+				DeletionTimestamp: ptr.To(metav1.Now()),
+				Finalizers:        []string{"machine.sapcloud.io/machine-controller-manager"},
+			},
+			Spec: machinev1alpha1.MachineSpec{
+				Class:      machinev1alpha1.ClassSpec{Kind: "MachineClass", Name: machineClass.Name},
+				ProviderID: fmt.Sprintf("gce:///project/%s/%s", zone, instance),
+			},
+		}
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: machine.Name, Namespace: namespace}}
+
+		gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(machineClass, machine).Build()
+		r = NewReconciler(c, gcpClientFactory)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should remove the deletion protection of the instance and mark the machine", func() {
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().SetInstanceDeletionProtection(ctx, zone, instance, false)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, machine)).To(Succeed())
+		Expect(machine.Annotations).To(HaveKeyWithValue(AnnotationDeletionProtectionRemoved, "true"))
+	})
+
+	It("should not mark the machine if the deletion protection cannot be removed", func() {
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().SetInstanceDeletionProtection(ctx, zone, instance, false).Return(fmt.Errorf("forbidden"))
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).To(HaveOccurred())
+
+		Expect(c.Get(ctx, request.NamespacedName, machine)).To(Succeed())
+		Expect(machine.Annotations).NotTo(HaveKey(AnnotationDeletionProtectionRemoved))
+	})
+
+	Context("deletion protection disabled", func() {
+		BeforeEach(func() {
+			machineClass.ProviderSpec.Raw = []byte(`{"deletionProtection":false}`)
+		})
+
+		It("should not touch the instance", func() {
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+	})
+
+	Context("machine not being deleted", func() {
+		BeforeEach(func() {
+			machine.DeletionTimestamp = nil
+			machine.Finalizers = nil
+		})
+
+		It("should not touch the instance", func() {
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+	})
+
+	Context("machine of another provider", func() {
+		BeforeEach(func() {
+			machineClass.Provider = "AWS"
+		})
+
+		It("should not touch the instance", func() {
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diskclone
+
+import (
+	"context"
+
+	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// ControllerName is the name of the disk clone controller.
+	ControllerName = "diskclone"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when adding the GCP disk clone controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	if err := gcpv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		return err
+	}
+
+	shootScheme := runtime.NewScheme()
+	schemeBuilder := runtime.NewSchemeBuilder(
+		kubernetesscheme.AddToScheme,
+		volumesnapshotv1.AddToScheme,
+	)
+	if err := schemeBuilder.AddToScheme(shootScheme); err != nil {
+		return err
+	}
+
+	shootClient := func(ctx context.Context, namespace string) (client.Client, error) {
+		_, shootClient, err := util.NewClientForShoot(ctx, mgr.GetClient(), namespace, client.Options{Scheme: shootScheme}, extensionsconfig.RESTOptions{})
+		return shootClient, err
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gcpv1alpha1.DiskClone{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(opts.Controller).
+		Complete(NewReconciler(mgr.GetClient(), gcpclient.New(), shootClient))
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
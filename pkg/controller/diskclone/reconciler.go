@@ -0,0 +1,380 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diskclone
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// FinalizerName is the finalizer of DiskClones which ensures that the created disk snapshots are deleted.
+	FinalizerName = "gcp.provider.extensions.gardener.cloud/diskclone"
+
+	csiDriverName = "pd.csi.storage.gke.io"
+
+	snapshotStatusReady  = "READY"
+	snapshotStatusFailed = "FAILED"
+
+	requeueInterval = 30 * time.Second
+)
+
+// ShootClientFunc returns a client for the shoot whose control plane runs in the given namespace of the seed.
+type ShootClientFunc func(ctx context.Context, namespace string) (client.Client, error)
+
+type reconciler struct {
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
+	shootClient      ShootClientFunc
+}
+
+// NewReconciler creates a new reconcile.Reconciler which clones disks of persistent volumes of a shoot into another
+// shoot.
+func NewReconciler(c client.Client, gcpClientFactory gcpclient.Factory, shootClient ShootClientFunc) reconcile.Reconciler {
+	return &reconciler{
+		client:           c,
+		gcpClientFactory: gcpClientFactory,
+		shootClient:      shootClient,
+	}
+}
+
+// Reconcile snapshots the disks of the persistent volume claims of the source shoot and provides persistent volume
+// claims restored from the snapshots in the target shoot.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	diskClone := &gcpv1alpha1.DiskClone{}
+	if err := r.client.Get(ctx, request.NamespacedName, diskClone); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if diskClone.DeletionTimestamp != nil {
+		return reconcile.Result{}, r.delete(ctx, diskClone)
+	}
+
+	if !controllerutil.ContainsFinalizer(diskClone, FinalizerName) {
+		patch := client.MergeFrom(diskClone.DeepCopy())
+		controllerutil.AddFinalizer(diskClone, FinalizerName)
+		if err := r.client.Patch(ctx, diskClone, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not add finalizer: %w", err)
+		}
+	}
+
+	computeClient, err := r.gcpClientFactory.Compute(ctx, r.client, sourceSecretRef(diskClone))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create compute client for source shoot: %w", err)
+	}
+	// The disks are restored with the credentials of the target shoot, which are not granted access to the snapshots in
+	// other projects.
+	targetServiceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, r.client, targetSecretRef(diskClone))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not read credentials of target shoot: %w", err)
+	}
+	sourceClient, err := r.shootClient(ctx, diskClone.Spec.SourceNamespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create client for source shoot: %w", err)
+	}
+	targetClient, err := r.shootClient(ctx, diskClone.Namespace)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create client for target shoot: %w", err)
+	}
+
+	var (
+		statuses = make([]gcpv1alpha1.DiskCloneClaimStatus, 0, len(diskClone.Spec.Claims))
+		pending  bool
+		cloneErr error
+	)
+	for _, claim := range diskClone.Spec.Claims {
+		status := claimStatus(diskClone, claim)
+		// Once a claim could not be cloned, the statuses of the remaining claims are kept, so that the status of the
+		// snapshots created so far is written before the error is returned.
+		if cloneErr == nil && status.Phase != gcpv1alpha1.DiskClonePhaseSucceeded {
+			status, cloneErr = r.cloneClaim(ctx, computeClient, sourceClient, targetClient, targetServiceAccount.ProjectID, diskClone, claim)
+			if cloneErr != nil {
+				cloneErr = fmt.Errorf("could not clone persistent volume claim %s/%s: %w", claim.Namespace, claim.Name, cloneErr)
+				status.Phase, status.Message = gcpv1alpha1.DiskClonePhasePending, ptr.To(cloneErr.Error())
+			}
+		}
+		if status.Name == "" {
+			continue
+		}
+
+		pending = pending || status.Phase == gcpv1alpha1.DiskClonePhasePending
+		statuses = append(statuses, status)
+	}
+	// The statuses of claims which were removed from the spec are kept, so that their snapshots are deleted together
+	// with the DiskClone.
+	for _, status := range diskClone.Status.Claims {
+		if !hasClaim(diskClone.Spec.Claims, status.Namespace, status.Name) {
+			statuses = append(statuses, status)
+		}
+	}
+
+	patch := client.MergeFrom(diskClone.DeepCopy())
+	diskClone.Status.ObservedGeneration = diskClone.Generation
+	diskClone.Status.Claims = statuses
+	if err := r.client.Status().Patch(ctx, diskClone, patch); err != nil {
+		return reconcile.Result{}, errors.Join(cloneErr, fmt.Errorf("could not update status: %w", err))
+	}
+	if cloneErr != nil {
+		return reconcile.Result{}, cloneErr
+	}
+
+	if pending {
+		return reconcile.Result{RequeueAfter: requeueInterval}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *reconciler) cloneClaim(
+	ctx context.Context,
+	computeClient gcpclient.ComputeClient,
+	sourceClient, targetClient client.Client,
+	targetProject string,
+	diskClone *gcpv1alpha1.DiskClone,
+	claim gcpv1alpha1.DiskCloneClaim,
+) (gcpv1alpha1.DiskCloneClaimStatus, error) {
+	status := gcpv1alpha1.DiskCloneClaimStatus{
+		Namespace: claim.Namespace,
+		Name:      claim.Name,
+		Snapshot:  SnapshotName(diskClone, claim),
+	}
+	failed := func(format string, args ...any) (gcpv1alpha1.DiskCloneClaimStatus, error) {
+		status.Phase = gcpv1alpha1.DiskClonePhaseFailed
+		status.Message = ptr.To(fmt.Sprintf(format, args...))
+		return status, nil
+	}
+	pending := func(message string) (gcpv1alpha1.DiskCloneClaimStatus, error) {
+		status.Phase = gcpv1alpha1.DiskClonePhasePending
+		status.Message = ptr.To(message)
+		return status, nil
+	}
+
+	sourcePVC := &corev1.PersistentVolumeClaim{}
+	if err := sourceClient.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: claim.Name}, sourcePVC); err != nil {
+		if apierrors.IsNotFound(err) {
+			return failed("persistent volume claim not found in source shoot")
+		}
+		return status, err
+	}
+	if sourcePVC.Spec.VolumeName == "" {
+		return pending("persistent volume claim is not bound in source shoot")
+	}
+
+	sourcePV := &corev1.PersistentVolume{}
+	if err := sourceClient.Get(ctx, client.ObjectKey{Name: sourcePVC.Spec.VolumeName}, sourcePV); err != nil {
+		return status, err
+	}
+	if sourcePV.Spec.CSI == nil || sourcePV.Spec.CSI.Driver != csiDriverName {
+		return failed("persistent volume %s is not provisioned by the %s driver", sourcePV.Name, csiDriverName)
+	}
+	project, zone, disk, err := parseVolumeHandle(sourcePV.Spec.CSI.VolumeHandle)
+	if err != nil {
+		return failed("%v", err)
+	}
+	if project != targetProject {
+		return failed("disk %s is in project %s, but the target shoot uses project %s: cloning disks across projects is not supported", disk, project, targetProject)
+	}
+
+	snapshot, err := computeClient.GetSnapshot(ctx, status.Snapshot)
+	if err != nil {
+		return status, err
+	}
+	if snapshot == nil {
+		if err := computeClient.CreateDiskSnapshot(ctx, zone, disk, status.Snapshot); err != nil {
+			return status, fmt.Errorf("could not create snapshot of disk %s: %w", disk, err)
+		}
+		return pending("snapshot is being created")
+	}
+	switch snapshot.Status {
+	case snapshotStatusReady:
+	case snapshotStatusFailed:
+		return failed("snapshot of disk %s failed", disk)
+	default:
+		return pending(fmt.Sprintf("snapshot is %s", strings.ToLower(snapshot.Status)))
+	}
+
+	if err := r.restoreClaim(ctx, targetClient, sourcePVC, claim, status.Snapshot, fmt.Sprintf("projects/%s/global/snapshots/%s", project, status.Snapshot)); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return failed("%v", err)
+		}
+		return status, err
+	}
+
+	status.Phase = gcpv1alpha1.DiskClonePhaseSucceeded
+	return status, nil
+}
+
+// restoreClaim creates a persistent volume claim in the target shoot which is restored from a pre-provisioned volume
+// snapshot of the given disk snapshot.
+func (r *reconciler) restoreClaim(ctx context.Context, targetClient client.Client, sourcePVC *corev1.PersistentVolumeClaim, claim gcpv1alpha1.DiskCloneClaim, name, snapshotHandle string) error {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: claim.Namespace}}
+	if err := targetClient.Create(ctx, namespace); client.IgnoreAlreadyExists(err) != nil {
+		return fmt.Errorf("could not create namespace %s: %w", namespace.Name, err)
+	}
+
+	snapshotContent := &volumesnapshotv1.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: volumesnapshotv1.VolumeSnapshotContentSpec{
+			// The disk snapshot is deleted together with the DiskClone.
+			DeletionPolicy: volumesnapshotv1.VolumeSnapshotContentRetain,
+			Driver:         csiDriverName,
+			Source:         volumesnapshotv1.VolumeSnapshotContentSource{SnapshotHandle: &snapshotHandle},
+			VolumeSnapshotRef: corev1.ObjectReference{
+				Namespace: claim.Namespace,
+				Name:      name,
+			},
+		},
+	}
+	if err := targetClient.Create(ctx, snapshotContent); client.IgnoreAlreadyExists(err) != nil {
+		return fmt.Errorf("could not create volume snapshot content %s: %w", snapshotContent.Name, err)
+	}
+
+	volumeSnapshot := &volumesnapshotv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: claim.Namespace},
+		Spec: volumesnapshotv1.VolumeSnapshotSpec{
+			Source: volumesnapshotv1.VolumeSnapshotSource{VolumeSnapshotContentName: &snapshotContent.Name},
+		},
+	}
+	if err := targetClient.Create(ctx, volumeSnapshot); client.IgnoreAlreadyExists(err) != nil {
+		return fmt.Errorf("could not create volume snapshot %s: %w", volumeSnapshot.Name, err)
+	}
+
+	storageClassName := sourcePVC.Spec.StorageClassName
+	if claim.StorageClassName != nil {
+		storageClassName = claim.StorageClassName
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: claim.Name, Namespace: claim.Namespace, Labels: sourcePVC.Labels},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      sourcePVC.Spec.AccessModes,
+			Resources:        sourcePVC.Spec.Resources,
+			StorageClassName: storageClassName,
+			VolumeMode:       sourcePVC.Spec.VolumeMode,
+			DataSource: &corev1.TypedLocalObjectReference{
+				APIGroup: ptr.To(volumesnapshotv1.GroupName),
+				Kind:     "VolumeSnapshot",
+				Name:     volumeSnapshot.Name,
+			},
+		},
+	}
+	if err := targetClient.Create(ctx, pvc); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("could not create persistent volume claim: %w", err)
+		}
+
+		existing := &corev1.PersistentVolumeClaim{}
+		if err := targetClient.Get(ctx, client.ObjectKeyFromObject(pvc), existing); err != nil {
+			return err
+		}
+		if existing.Spec.DataSource == nil || existing.Spec.DataSource.Name != volumeSnapshot.Name {
+			return apierrors.NewAlreadyExists(corev1.Resource("persistentvolumeclaims"), pvc.Namespace+"/"+pvc.Name)
+		}
+	}
+
+	return nil
+}
+
+func (r *reconciler) delete(ctx context.Context, diskClone *gcpv1alpha1.DiskClone) error {
+	if !controllerutil.ContainsFinalizer(diskClone, FinalizerName) {
+		return nil
+	}
+
+	computeClient, err := r.gcpClientFactory.Compute(ctx, r.client, sourceSecretRef(diskClone))
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("could not create compute client for source shoot: %w", err)
+		}
+		// The source shoot is already gone together with its snapshots.
+		log.FromContext(ctx).Info("Credentials of source shoot not found, skipping deletion of snapshots")
+	} else {
+		// The snapshots of the claims in the spec are deleted by their deterministic names, as they might have been
+		// created without being recorded in the status.
+		snapshots := sets.New[string]()
+		for _, claim := range diskClone.Spec.Claims {
+			snapshots.Insert(SnapshotName(diskClone, claim))
+		}
+		for _, status := range diskClone.Status.Claims {
+			if status.Snapshot != "" {
+				snapshots.Insert(status.Snapshot)
+			}
+		}
+
+		for _, snapshot := range sets.List(snapshots) {
+			if err := computeClient.DeleteSnapshot(ctx, snapshot); err != nil {
+				return fmt.Errorf("could not delete snapshot %s: %w", snapshot, err)
+			}
+		}
+	}
+
+	patch := client.MergeFrom(diskClone.DeepCopy())
+	controllerutil.RemoveFinalizer(diskClone, FinalizerName)
+	return r.client.Patch(ctx, diskClone, patch)
+}
+
+// SnapshotName returns the name of the disk snapshot of the given claim. It is also used for the volume snapshot in the
+// target shoot.
+func SnapshotName(diskClone *gcpv1alpha1.DiskClone, claim gcpv1alpha1.DiskCloneClaim) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{diskClone.Namespace, diskClone.Name, claim.Namespace, claim.Name}, "/")))
+	return "diskclone-" + hex.EncodeToString(hash[:])[:16]
+}
+
+func sourceSecretRef(diskClone *gcpv1alpha1.DiskClone) corev1.SecretReference {
+	return corev1.SecretReference{Namespace: diskClone.Spec.SourceNamespace, Name: v1beta1constants.SecretNameCloudProvider}
+}
+
+func targetSecretRef(diskClone *gcpv1alpha1.DiskClone) corev1.SecretReference {
+	return corev1.SecretReference{Namespace: diskClone.Namespace, Name: v1beta1constants.SecretNameCloudProvider}
+}
+
+func claimStatus(diskClone *gcpv1alpha1.DiskClone, claim gcpv1alpha1.DiskCloneClaim) gcpv1alpha1.DiskCloneClaimStatus {
+	for _, status := range diskClone.Status.Claims {
+		if status.Namespace == claim.Namespace && status.Name == claim.Name {
+			return status
+		}
+	}
+	return gcpv1alpha1.DiskCloneClaimStatus{}
+}
+
+func hasClaim(claims []gcpv1alpha1.DiskCloneClaim, namespace, name string) bool {
+	for _, claim := range claims {
+		if claim.Namespace == namespace && claim.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseVolumeHandle parses the volume handle of a persistent volume provisioned by the GCP CSI driver, which has the
+// format projects/<project>/zones/<zone>/disks/<disk>.
+func parseVolumeHandle(volumeHandle string) (project, zone, disk string, err error) {
+	parts := strings.Split(volumeHandle, "/")
+	if len(parts) != 6 || parts[0] != "projects" || parts[4] != "disks" {
+		return "", "", "", fmt.Errorf("volume handle %q has an unexpected format", volumeHandle)
+	}
+	if parts[2] != "zones" {
+		return "", "", "", fmt.Errorf("volume handle %q does not refer to a zonal disk, only zonal disks are supported", volumeHandle)
+	}
+	return parts[1], parts[3], parts[5], nil
+}
@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diskclone_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gcpv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/diskclone"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		sourceNamespace = "shoot--foo--source"
+		targetNamespace = "shoot--foo--target"
+		zone            = "europe-west1-b"
+		disk            = "pv-shoot--foo--source-1234"
+	)
+
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+
+		c                client.Client
+		sourceClient     client.Client
+		targetClient     client.Client
+		gcpClientFactory *mockgcpclient.MockFactory
+		computeClient    *mockgcpclient.MockComputeClient
+		r                reconcile.Reconciler
+
+		secretRef    corev1.SecretReference
+		targetSecret *corev1.Secret
+		claim        gcpv1alpha1.DiskCloneClaim
+		diskClone    *gcpv1alpha1.DiskClone
+		snapshotName string
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		scheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(gcpv1alpha1.AddToScheme(scheme)).To(Succeed())
+		shootScheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(shootScheme)).To(Succeed())
+		Expect(volumesnapshotv1.AddToScheme(shootScheme)).To(Succeed())
+
+		secretRef = corev1.SecretReference{Name: "cloudprovider", Namespace: sourceNamespace}
+		claim = gcpv1alpha1.DiskCloneClaim{Namespace: "default", Name: "data"}
+		diskClone = &gcpv1alpha1.DiskClone{
+			ObjectMeta: metav1.ObjectMeta{Name: "clone", Namespace: targetNamespace, Generation: 1},
+			Spec: gcpv1alpha1.DiskCloneSpec{
+				SourceNamespace: sourceNamespace,
+				Claims:          []gcpv1alpha1.DiskCloneClaim{claim},
+			},
+		}
+		snapshotName = SnapshotName(diskClone, claim)
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(diskClone)}
+
+		targetSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: targetNamespace},
+			Data: map[string][]byte{
+				gcp.ServiceAccountJSONField: []byte(`{"project_id": "source-project", "type": "service_account"}`),
+			},
+		}
+
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(diskClone, targetSecret).WithStatusSubresource(diskClone).Build()
+		sourceClient = fakeclient.NewClientBuilder().WithScheme(shootScheme).WithObjects(
+			&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: claim.Name, Namespace: claim.Namespace},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: ptr.To("default"),
+					VolumeName:       "pv-1234",
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			},
+			&corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv-1234"},
+				Spec: corev1.PersistentVolumeSpec{
+					PersistentVolumeSource: corev1.PersistentVolumeSource{
+						CSI: &corev1.CSIPersistentVolumeSource{
+							Driver:       "pd.csi.storage.gke.io",
+							VolumeHandle: "projects/source-project/zones/" + zone + "/disks/" + disk,
+						},
+					},
+				},
+			},
+		).Build()
+		targetClient = fakeclient.NewClientBuilder().WithScheme(shootScheme).Build()
+
+		gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+		r = NewReconciler(c, gcpClientFactory, func(_ context.Context, namespace string) (client.Client, error) {
+			if namespace == sourceNamespace {
+				return sourceClient, nil
+			}
+			return targetClient, nil
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should create the snapshot of the disk and wait until it is ready", func() {
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().GetSnapshot(ctx, snapshotName).Return(nil, nil)
+		computeClient.EXPECT().CreateDiskSnapshot(ctx, zone, disk, snapshotName)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+		Expect(c.Get(ctx, request.NamespacedName, diskClone)).To(Succeed())
+		Expect(diskClone.Finalizers).To(ConsistOf(FinalizerName))
+		Expect(diskClone.Status.Claims).To(ConsistOf(gcpv1alpha1.DiskCloneClaimStatus{
+			Namespace: claim.Namespace,
+			Name:      claim.Name,
+			Snapshot:  snapshotName,
+			Phase:     gcpv1alpha1.DiskClonePhasePending,
+			Message:   ptr.To("snapshot is being created"),
+		}))
+	})
+
+	It("should restore the persistent volume claim in the target shoot once the snapshot is ready", func() {
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().GetSnapshot(ctx, snapshotName).Return(&gcpclient.Snapshot{Name: snapshotName, Status: "READY"}, nil)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, diskClone)).To(Succeed())
+		Expect(diskClone.Status.Claims).To(ConsistOf(gcpv1alpha1.DiskCloneClaimStatus{
+			Namespace: claim.Namespace,
+			Name:      claim.Name,
+			Snapshot:  snapshotName,
+			Phase:     gcpv1alpha1.DiskClonePhaseSucceeded,
+		}))
+
+		snapshotContent := &volumesnapshotv1.VolumeSnapshotContent{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: snapshotName}, snapshotContent)).To(Succeed())
+		Expect(snapshotContent.Spec.Source.SnapshotHandle).To(Equal(ptr.To("projects/source-project/global/snapshots/" + snapshotName)))
+
+		Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: snapshotName}, &volumesnapshotv1.VolumeSnapshot{})).To(Succeed())
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: claim.Name}, pvc)).To(Succeed())
+		Expect(pvc.Spec.StorageClassName).To(Equal(ptr.To("default")))
+		Expect(pvc.Spec.DataSource).To(Equal(&corev1.TypedLocalObjectReference{
+			APIGroup: ptr.To("snapshot.storage.k8s.io"),
+			Kind:     "VolumeSnapshot",
+			Name:     snapshotName,
+		}))
+	})
+
+	It("should fail if the target shoot uses another project than the disk", func() {
+		targetSecret.Data[gcp.ServiceAccountJSONField] = []byte(`{"project_id": "target-project", "type": "service_account"}`)
+		Expect(c.Update(ctx, targetSecret)).To(Succeed())
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, diskClone)).To(Succeed())
+		Expect(diskClone.Status.Claims).To(ConsistOf(gcpv1alpha1.DiskCloneClaimStatus{
+			Namespace: claim.Namespace,
+			Name:      claim.Name,
+			Snapshot:  snapshotName,
+			Phase:     gcpv1alpha1.DiskClonePhaseFailed,
+			Message:   ptr.To("disk " + disk + " is in project source-project, but the target shoot uses project target-project: cloning disks across projects is not supported"),
+		}))
+	})
+
+	It("should fail if the persistent volume claim does not exist in the source shoot", func() {
+		diskClone.Spec.Claims = []gcpv1alpha1.DiskCloneClaim{{Namespace: "default", Name: "unknown"}}
+		Expect(c.Update(ctx, diskClone)).To(Succeed())
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, diskClone)).To(Succeed())
+		Expect(diskClone.Status.Claims).To(HaveLen(1))
+		Expect(diskClone.Status.Claims[0].Phase).To(Equal(gcpv1alpha1.DiskClonePhaseFailed))
+	})
+
+	It("should record the created snapshots in the status if a later claim cannot be cloned", func() {
+		otherClaim := gcpv1alpha1.DiskCloneClaim{Namespace: "default", Name: "other"}
+		Expect(sourceClient.Create(ctx, &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: otherClaim.Name, Namespace: otherClaim.Namespace},
+			Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-missing"},
+		})).To(Succeed())
+		diskClone.Spec.Claims = append(diskClone.Spec.Claims, otherClaim)
+		Expect(c.Update(ctx, diskClone)).To(Succeed())
+
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().GetSnapshot(ctx, snapshotName).Return(nil, nil)
+		computeClient.EXPECT().CreateDiskSnapshot(ctx, zone, disk, snapshotName)
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("could not clone persistent volume claim default/other")))
+
+		Expect(c.Get(ctx, request.NamespacedName, diskClone)).To(Succeed())
+		Expect(diskClone.Status.Claims).To(ConsistOf(
+			gcpv1alpha1.DiskCloneClaimStatus{
+				Namespace: claim.Namespace,
+				Name:      claim.Name,
+				Snapshot:  snapshotName,
+				Phase:     gcpv1alpha1.DiskClonePhasePending,
+				Message:   ptr.To("snapshot is being created"),
+			},
+			And(
+				HaveField("Name", otherClaim.Name),
+				HaveField("Snapshot", SnapshotName(diskClone, otherClaim)),
+				HaveField("Phase", gcpv1alpha1.DiskClonePhasePending),
+			),
+		))
+	})
+
+	It("should keep the statuses of claims removed from the spec", func() {
+		removedStatus := gcpv1alpha1.DiskCloneClaimStatus{Namespace: "default", Name: "removed", Snapshot: "diskclone-removed", Phase: gcpv1alpha1.DiskClonePhaseSucceeded}
+		diskClone.Status.Claims = []gcpv1alpha1.DiskCloneClaimStatus{removedStatus}
+		Expect(c.Status().Update(ctx, diskClone)).To(Succeed())
+
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().GetSnapshot(ctx, snapshotName).Return(nil, nil)
+		computeClient.EXPECT().CreateDiskSnapshot(ctx, zone, disk, snapshotName)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+
+		Expect(c.Get(ctx, request.NamespacedName, diskClone)).To(Succeed())
+		Expect(diskClone.Status.Claims).To(ConsistOf(HaveField("Name", claim.Name), removedStatus))
+	})
+
+	It("should delete the snapshots of the claims in the spec and the status on deletion", func() {
+		diskClone.Finalizers = []string{FinalizerName}
+		Expect(c.Update(ctx, diskClone)).To(Succeed())
+		diskClone.Status.Claims = []gcpv1alpha1.DiskCloneClaimStatus{{Namespace: "default", Name: "removed", Snapshot: "diskclone-removed", Phase: gcpv1alpha1.DiskClonePhaseSucceeded}}
+		Expect(c.Status().Update(ctx, diskClone)).To(Succeed())
+		Expect(c.Delete(ctx, diskClone)).To(Succeed())
+
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().DeleteSnapshot(ctx, snapshotName)
+		computeClient.EXPECT().DeleteSnapshot(ctx, "diskclone-removed")
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, diskClone)).To(BeNotFoundError())
+	})
+
+	It("should delete the snapshots and remove the finalizer on deletion", func() {
+		diskClone.Finalizers = []string{FinalizerName}
+		Expect(c.Update(ctx, diskClone)).To(Succeed())
+		diskClone.Status.Claims = []gcpv1alpha1.DiskCloneClaimStatus{{Namespace: claim.Namespace, Name: claim.Name, Snapshot: snapshotName, Phase: gcpv1alpha1.DiskClonePhaseSucceeded}}
+		Expect(c.Status().Update(ctx, diskClone)).To(Succeed())
+		Expect(c.Delete(ctx, diskClone)).To(Succeed())
+
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().DeleteSnapshot(ctx, snapshotName)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, diskClone)).To(BeNotFoundError())
+	})
+})
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnsdelegation
+
+import (
+	"context"
+
+	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// ControllerName is the name of the controller delegating subdomain zones in their parent zones.
+	ControllerName = "dnsdelegation"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when adding the dnsdelegation controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// DNS is the configuration for the dnsrecord controller, which contains the delegations.
+	DNS config.DNS
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager. The controller is only added
+// if delegations are configured.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	if len(opts.DNS.Delegations) == 0 {
+		return nil
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&extensionsv1alpha1.DNSRecord{}, builder.WithPredicates(extensionspredicate.HasType(gcp.DNSType))).
+		WithOptions(opts.Controller).
+		Complete(NewReconciler(mgr.GetClient(), gcpclient.New(), opts.DNS))
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
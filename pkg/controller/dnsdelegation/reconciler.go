@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnsdelegation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsrecord"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// delegationTTL is the TTL of the NS records delegating subdomain zones.
+const delegationTTL = 3600
+
+type reconciler struct {
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
+	dnsConfig        config.DNS
+}
+
+// NewReconciler creates a new reconcile.Reconciler which delegates the DNS managed zones of DNSRecords in their
+// configured parent zones.
+func NewReconciler(c client.Client, gcpClientFactory gcpclient.Factory, dnsConfig config.DNS) reconcile.Reconciler {
+	return &reconciler{
+		client:           c,
+		gcpClientFactory: gcpClientFactory,
+		dnsConfig:        dnsConfig,
+	}
+}
+
+// Reconcile creates the NS records for the DNS managed zone of a DNSRecord in the parent zone of a configured
+// delegation, e.g. if the zone of a shoot domain is hosted in another GCP project than the zone of its parent domain.
+// The NS records are kept when the DNSRecord is deleted, as the zone usually hosts further records.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	dns := &extensionsv1alpha1.DNSRecord{}
+	if err := r.client.Get(ctx, request.NamespacedName, dns); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// The zone is determined by the dnsrecord controller.
+	if dns.DeletionTimestamp != nil || dns.Status.Zone == nil || *dns.Status.Zone == "" {
+		return reconcile.Result{}, nil
+	}
+
+	delegation := delegationForName(r.dnsConfig.Delegations, dns.Spec.Name)
+	if delegation == nil {
+		return reconcile.Result{}, nil
+	}
+	parentDomain := strings.TrimSuffix(delegation.Domain, ".")
+
+	dnsClient, err := r.gcpClientFactory.DNS(ctx, r.client, dnsrecord.SecretRefForName(r.dnsConfig, dns))
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create DNS client: %w", err)
+	}
+	zoneName, nameServers, err := dnsClient.GetNameServers(ctx, *dns.Status.Zone)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get name servers of DNS managed zone %s: %w", *dns.Status.Zone, err)
+	}
+
+	// Records of the parent zone itself do not need to be delegated, and private zones cannot be delegated.
+	if !strings.HasSuffix(zoneName, "."+parentDomain) || len(nameServers) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	parentDNSClient, err := r.gcpClientFactory.DNS(ctx, r.client, delegation.SecretRef)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create DNS client for parent domain %s: %w", parentDomain, err)
+	}
+	zones, err := parentDNSClient.GetManagedZones(ctx)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get DNS managed zones: %w", err)
+	}
+	parentZone, ok := zones[parentDomain]
+	if !ok {
+		return reconcile.Result{}, fmt.Errorf("could not find DNS managed zone for parent domain %s", parentDomain)
+	}
+
+	log.FromContext(ctx).Info("Delegating DNS managed zone", "managedZone", *dns.Status.Zone, "name", zoneName, "parentManagedZone", parentZone, "nameServers", nameServers)
+	if err := parentDNSClient.CreateOrUpdateRecordSet(ctx, parentZone, zoneName, "NS", nameServers, delegationTTL); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create or update NS recordset for %s in DNS managed zone %s: %w", zoneName, parentZone, err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// delegationForName returns the delegation of the most specific parent domain of the given name.
+func delegationForName(delegations []config.DNSDelegation, name string) *config.DNSDelegation {
+	var (
+		result  *config.DNSDelegation
+		matched string
+	)
+
+	name = strings.TrimSuffix(name, ".")
+	for i, delegation := range delegations {
+		domain := strings.TrimSuffix(delegation.Domain, ".")
+		if strings.HasSuffix(name, "."+domain) && len(domain) > len(matched) {
+			result = &delegations[i]
+			matched = domain
+		}
+	}
+
+	return result
+}
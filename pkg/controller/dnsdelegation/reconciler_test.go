@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnsdelegation_test
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsdelegation"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Reconciler", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+
+		c                client.Client
+		gcpClientFactory *mockgcpclient.MockFactory
+		dnsClient        *mockgcpclient.MockDNSClient
+		parentDNSClient  *mockgcpclient.MockDNSClient
+		r                reconcile.Reconciler
+
+		secretRef       corev1.SecretReference
+		parentSecretRef corev1.SecretReference
+		dnsConfig       config.DNS
+		dns             *extensionsv1alpha1.DNSRecord
+		request         reconcile.Request
+		nameServers     []string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+		dnsClient = mockgcpclient.NewMockDNSClient(ctrl)
+		parentDNSClient = mockgcpclient.NewMockDNSClient(ctrl)
+
+		secretRef = corev1.SecretReference{Name: "dns", Namespace: namespace}
+		parentSecretRef = corev1.SecretReference{Name: "dns-example-com", Namespace: "garden"}
+		dnsConfig = config.DNS{
+			Delegations: []config.DNSDelegation{{Domain: "example.com", SecretRef: parentSecretRef}},
+		}
+		dns = &extensionsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar-external", Namespace: namespace},
+			Spec: extensionsv1alpha1.DNSRecordSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "google-clouddns"},
+				SecretRef:   secretRef,
+				Name:        "api.bar.example.com",
+				RecordType:  extensionsv1alpha1.DNSRecordTypeA,
+				Values:      []string{"1.2.3.4"},
+			},
+			Status: extensionsv1alpha1.DNSRecordStatus{Zone: ptr.To("project-b/bar")},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(dns)}
+		nameServers = []string{"ns-cloud-a1.googledomains.com.", "ns-cloud-a2.googledomains.com."}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	JustBeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(dns).Build()
+		r = NewReconciler(c, gcpClientFactory, dnsConfig)
+	})
+
+	It("should delegate the zone of the record in the parent zone", func() {
+		gcpClientFactory.EXPECT().DNS(ctx, c, secretRef).Return(dnsClient, nil)
+		dnsClient.EXPECT().GetNameServers(ctx, "project-b/bar").Return("bar.example.com", nameServers, nil)
+		gcpClientFactory.EXPECT().DNS(ctx, c, parentSecretRef).Return(parentDNSClient, nil)
+		parentDNSClient.EXPECT().GetManagedZones(ctx).Return(map[string]string{"example.com": "project-a/example"}, nil)
+		parentDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, "project-a/example", "bar.example.com", "NS", nameServers, int64(3600))
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should not delegate the parent zone itself", func() {
+		gcpClientFactory.EXPECT().DNS(ctx, c, secretRef).Return(dnsClient, nil)
+		dnsClient.EXPECT().GetNameServers(ctx, "project-b/bar").Return("example.com", nameServers, nil)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should not delegate private zones", func() {
+		gcpClientFactory.EXPECT().DNS(ctx, c, secretRef).Return(dnsClient, nil)
+		dnsClient.EXPECT().GetNameServers(ctx, "project-b/bar").Return("bar.example.com", nil, nil)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	Context("record without a delegation", func() {
+		BeforeEach(func() {
+			dns.Spec.Name = "api.bar.example.org"
+		})
+
+		It("should ignore the record", func() {
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+	})
+
+	Context("record without a zone", func() {
+		BeforeEach(func() {
+			dns.Status.Zone = nil
+		})
+
+		It("should wait until the zone of the record is determined", func() {
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		})
+	})
+
+	It("should fail if the parent zone does not exist", func() {
+		gcpClientFactory.EXPECT().DNS(ctx, c, secretRef).Return(dnsClient, nil)
+		dnsClient.EXPECT().GetNameServers(ctx, "project-b/bar").Return("bar.example.com", nameServers, nil)
+		gcpClientFactory.EXPECT().DNS(ctx, c, parentSecretRef).Return(parentDNSClient, nil)
+		parentDNSClient.EXPECT().GetManagedZones(ctx).Return(map[string]string{"example.org": "project-a/example"}, nil)
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).To(MatchError(ContainSubstring("could not find DNS managed zone for parent domain example.com")))
+	})
+})
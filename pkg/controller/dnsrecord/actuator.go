@@ -7,6 +7,7 @@ package dnsrecord
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -17,10 +18,14 @@ import (
 	reconcilerutils "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
@@ -34,20 +39,30 @@ const (
 type actuator struct {
 	client           client.Client
 	gcpClientFactory gcpclient.Factory
+	dnsConfig        config.DNS
 }
 
 // NewActuator creates a new dnsrecord.Actuator.
-func NewActuator(mgr manager.Manager, gcpClientFactory gcpclient.Factory) dnsrecord.Actuator {
+func NewActuator(mgr manager.Manager, gcpClientFactory gcpclient.Factory, dnsConfig config.DNS) dnsrecord.Actuator {
 	return &actuator{
 		client:           mgr.GetClient(),
 		gcpClientFactory: gcpClientFactory,
+		dnsConfig:        dnsConfig,
 	}
 }
 
 // Reconcile reconciles the DNSRecord.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
+	config, err := helper.DNSRecordConfigFromRawExtension(dns.Spec.ProviderConfig)
+	if err != nil {
+		return fmt.Errorf("could not decode provider config of DNS record: %w", err)
+	}
+	if errs := validation.ValidateDNSRecordConfig(config, field.NewPath("spec", "providerConfig")); len(errs) > 0 {
+		return fmt.Errorf("invalid provider config of DNS record: %w", errs.ToAggregate())
+	}
+
 	// Create GCP DNS client
-	dnsClient, err := a.gcpClientFactory.DNS(ctx, a.client, dns.Spec.SecretRef)
+	dnsClient, err := a.gcpClientFactory.DNS(ctx, a.client, a.secretRefForName(dns))
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -60,11 +75,21 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 
 	// Create or update DNS recordset
 	ttl := extensionsv1alpha1helper.GetDNSRecordTTL(dns.Spec.TTL)
-	log.Info("Creating or updating DNS recordset", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", dns.Spec.Values, "dnsrecord", kutil.ObjectName(dns))
-	if err := dnsClient.CreateOrUpdateRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl); err != nil {
-		return &reconcilerutils.RequeueAfterError{
-			Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and rrdatas %v: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
-			RequeueAfter: requeueAfterOnProviderError,
+	if config.RoutingPolicy != nil {
+		log.Info("Creating or updating DNS recordset with routing policy", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "dnsrecord", kutil.ObjectName(dns))
+		if err := dnsClient.CreateOrUpdateRoutingPolicyRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), routingPolicy(config.RoutingPolicy), ttl); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS recordset with routing policy in managed zone %s with name %s and type %s: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+	} else {
+		log.Info("Creating or updating DNS recordset", "managedZone", managedZone, "name", dns.Spec.Name, "type", dns.Spec.RecordType, "rrdatas", dns.Spec.Values, "dnsrecord", kutil.ObjectName(dns))
+		if err := dnsClient.CreateOrUpdateRecordSet(ctx, managedZone, dns.Spec.Name, string(dns.Spec.RecordType), dns.Spec.Values, ttl); err != nil {
+			return &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not create or update DNS recordset in managed zone %s with name %s, type %s, and rrdatas %v: %+v", managedZone, dns.Spec.Name, dns.Spec.RecordType, dns.Spec.Values, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
 		}
 	}
 
@@ -77,7 +102,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 // Delete deletes the DNSRecord.
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, _ *extensionscontroller.Cluster) error {
 	// Create GCP DNS client
-	dnsClient, err := a.gcpClientFactory.DNS(ctx, a.client, dns.Spec.SecretRef)
+	dnsClient, err := a.gcpClientFactory.DNS(ctx, a.client, a.secretRefForName(dns))
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -114,10 +139,44 @@ func (a *actuator) Migrate(context.Context, logr.Logger, *extensionsv1alpha1.DNS
 	return nil
 }
 
+func (a *actuator) secretRefForName(dns *extensionsv1alpha1.DNSRecord) corev1.SecretReference {
+	return SecretRefForName(a.dnsConfig, dns)
+}
+
+// SecretRefForName returns the reference to the credentials configured for the most specific domain containing the
+// name of the given DNSRecord. If no credentials are configured for it, the secret referenced by the DNSRecord is used.
+func SecretRefForName(dnsConfig config.DNS, dns *extensionsv1alpha1.DNSRecord) corev1.SecretReference {
+	var (
+		name      = strings.TrimSuffix(dns.Spec.Name, ".")
+		secretRef = dns.Spec.SecretRef
+		matched   string
+	)
+
+	for _, credentials := range dnsConfig.Credentials {
+		domain := strings.TrimSuffix(credentials.Domain, ".")
+		if (name == domain || strings.HasSuffix(name, "."+domain)) && len(domain) > len(matched) {
+			secretRef = credentials.SecretRef
+			matched = domain
+		}
+	}
+
+	return secretRef
+}
+
+// getManagedZone returns the ID of the managed zone of the given DNSRecord. The zone of the spec, which can be a public or
+// a private zone, is selected by its name or numeric ID. If no zone is specified, the zone of the status or the public
+// or private zone with the longest DNS name containing the name of the DNSRecord is returned.
 func (a *actuator) getManagedZone(ctx context.Context, log logr.Logger, dns *extensionsv1alpha1.DNSRecord, dnsClient gcpclient.DNSClient) (string, error) {
 	switch {
 	case dns.Spec.Zone != nil && *dns.Spec.Zone != "":
-		return *dns.Spec.Zone, nil
+		zone, err := dnsClient.ResolveManagedZone(ctx, *dns.Spec.Zone)
+		if err != nil {
+			return "", &reconcilerutils.RequeueAfterError{
+				Cause:        fmt.Errorf("could not resolve DNS managed zone %s: %+v", *dns.Spec.Zone, err),
+				RequeueAfter: requeueAfterOnProviderError,
+			}
+		}
+		return zone, nil
 	case dns.Status.Zone != nil && *dns.Status.Zone != "":
 		return *dns.Status.Zone, nil
 	default:
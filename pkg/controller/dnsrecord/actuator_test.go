@@ -6,6 +6,7 @@ package dnsrecord_test
 
 import (
 	"context"
+	"errors"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -14,13 +15,17 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
+	googledns "google.golang.org/api/dns/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/dnsrecord"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
@@ -67,7 +72,7 @@ var _ = Describe("Actuator", func() {
 		ctx = context.TODO()
 		logger = log.Log.WithName("test")
 
-		a = NewActuator(mgr, gcpClientFactory)
+		a = NewActuator(mgr, gcpClientFactory, config.DNS{})
 
 		dns = &extensionsv1alpha1.DNSRecord{
 			ObjectMeta: metav1.ObjectMeta{
@@ -116,6 +121,86 @@ var _ = Describe("Actuator", func() {
 			err := a.Reconcile(ctx, logger, dns, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should reconcile the DNSRecord with the credentials configured for the most specific domain", func() {
+			secretRef := corev1.SecretReference{Name: "dns-shoot-example-com", Namespace: "garden"}
+			mgr.EXPECT().GetClient().Return(c)
+			a = NewActuator(mgr, gcpClientFactory, config.DNS{
+				Credentials: []config.DNSCredentials{
+					{Domain: "example.com", SecretRef: corev1.SecretReference{Name: "dns-example-com", Namespace: "garden"}},
+					{Domain: shootDomain, SecretRef: secretRef},
+					{Domain: "other.com", SecretRef: corev1.SecretReference{Name: "dns-other-com", Namespace: "garden"}},
+				},
+			})
+			dns.Spec.Zone = ptr.To(zone)
+
+			gcpClientFactory.EXPECT().DNS(ctx, c, secretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().ResolveManagedZone(ctx, zone).Return(zone, nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reconcile the DNSRecord with a weighted routing policy", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "DNSRecordConfig",
+"routingPolicy": {"weighted": [{"weight": 90, "values": ["1.2.3.4"]}, {"weight": 10, "values": ["5.6.7.8"]}]}
+}`)}
+
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().GetManagedZones(ctx).Return(zones, nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRoutingPolicyRecordSet(ctx, zone, domainName, string(extensionsv1alpha1.DNSRecordTypeA), &googledns.RRSetRoutingPolicy{
+				Wrr: &googledns.RRSetRoutingPolicyWrrPolicy{Items: []*googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+					{Weight: 90, Rrdatas: []string{"1.2.3.4"}, ForceSendFields: []string{"Weight"}},
+					{Weight: 10, Rrdatas: []string{"5.6.7.8"}, ForceSendFields: []string{"Weight"}},
+				}},
+			}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).Return(nil)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the routing policy is invalid", func() {
+			dns.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "gcp.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "DNSRecordConfig",
+"routingPolicy": {"weighted": [{"weight": -1, "values": ["1.2.3.4"]}]}
+}`)}
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("invalid provider config of DNS record")))
+		})
+
+		It("should reconcile the DNSRecord in the private zone selected by its ID", func() {
+			dns.Spec.Zone = ptr.To("1234567890")
+
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().ResolveManagedZone(ctx, "1234567890").Return("project/private-zone", nil)
+			gcpDNSClient.EXPECT().CreateOrUpdateRecordSet(ctx, "project/private-zone", domainName, string(extensionsv1alpha1.DNSRecordTypeA), []string{address}, int64(120)).Return(nil)
+			sw.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{}), gomock.Any()).DoAndReturn(
+				func(_ context.Context, obj *extensionsv1alpha1.DNSRecord, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(obj.Status.Zone).To(PointTo(Equal("project/private-zone")))
+					return nil
+				},
+			)
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the selected zone cannot be resolved", func() {
+			dns.Spec.Zone = ptr.To("forwarding-zone")
+
+			gcpClientFactory.EXPECT().DNS(ctx, c, dns.Spec.SecretRef).Return(gcpDNSClient, nil)
+			gcpDNSClient.EXPECT().ResolveManagedZone(ctx, "forwarding-zone").Return("", errors.New("forwarding zone"))
+
+			err := a.Reconcile(ctx, logger, dns, nil)
+			Expect(err).To(MatchError(ContainSubstring("could not resolve DNS managed zone forwarding-zone")))
+		})
 	})
 
 	Describe("#Delete", func() {
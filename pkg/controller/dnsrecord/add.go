@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
@@ -26,13 +27,19 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// DNS is the configuration for the dnsrecord controller.
+	DNS config.DNS
 }
 
 // AddToManagerWithOptions adds a controller with the given Options to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddOptions) error {
+	// The changes of the records are batched per managed zone, so that the DNSRecords of many shoots do not exhaust the
+	// quotas of Cloud DNS.
+	batcher := gcpclient.NewDNSChangeBatcher(gcpclient.DefaultDNSChangeBatchWindow, gcpclient.DefaultDNSChangeQPS, gcpclient.DefaultDNSChangeBurst)
+
 	return dnsrecord.Add(ctx, mgr, dnsrecord.AddArgs{
-		Actuator:          NewActuator(mgr, gcpclient.New()),
+		Actuator:          NewActuator(mgr, gcpclient.New(gcpclient.WithDNSChangeBatcher(batcher)), opts.DNS),
 		ControllerOptions: opts.Controller,
 		Predicates:        dnsrecord.DefaultPredicates(ctx, mgr, opts.IgnoreOperationAnnotation),
 		Type:              gcp.DNSType,
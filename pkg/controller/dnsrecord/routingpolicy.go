@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnsrecord
+
+import (
+	googledns "google.golang.org/api/dns/v1"
+	"k8s.io/utils/ptr"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// routingPolicy returns the Cloud DNS routing policy of the given routing policy of a DNSRecordConfig.
+func routingPolicy(policy *apisgcp.DNSRoutingPolicy) *gcpclient.RoutingPolicy {
+	result := &gcpclient.RoutingPolicy{}
+
+	switch {
+	case len(policy.Weighted) > 0:
+		result.Wrr = &googledns.RRSetRoutingPolicyWrrPolicy{}
+		for _, target := range policy.Weighted {
+			result.Wrr.Items = append(result.Wrr.Items, &googledns.RRSetRoutingPolicyWrrPolicyWrrPolicyItem{
+				Weight:  float64(target.Weight),
+				Rrdatas: target.Values,
+				// A weight of zero must be sent explicitly, as it is omitted otherwise.
+				ForceSendFields: []string{"Weight"},
+			})
+		}
+	case len(policy.Geo) > 0:
+		result.Geo = geoPolicy(policy.Geo)
+	case policy.Failover != nil:
+		primary := &googledns.RRSetRoutingPolicyHealthCheckTargets{}
+		for _, target := range policy.Failover.Primary {
+			primary.InternalLoadBalancers = append(primary.InternalLoadBalancers, &googledns.RRSetRoutingPolicyLoadBalancerTarget{
+				LoadBalancerType: ptr.Deref(target.Type, "regionalL4ilb"),
+				IpAddress:        target.IPAddress,
+				Port:             target.Port,
+				IpProtocol:       ptr.Deref(target.Protocol, "tcp"),
+				NetworkUrl:       target.Network,
+				Project:          target.Project,
+				Region:           target.Region,
+			})
+		}
+		result.PrimaryBackup = &googledns.RRSetRoutingPolicyPrimaryBackupPolicy{
+			PrimaryTargets:   primary,
+			BackupGeoTargets: geoPolicy(policy.Failover.Backup),
+			TrickleTraffic:   float64(ptr.Deref(policy.Failover.TrickleTrafficPercent, 0)) / 100,
+		}
+	}
+
+	return result
+}
+
+func geoPolicy(targets []apisgcp.DNSGeoTarget) *googledns.RRSetRoutingPolicyGeoPolicy {
+	result := &googledns.RRSetRoutingPolicyGeoPolicy{}
+	for _, target := range targets {
+		result.Items = append(result.Items, &googledns.RRSetRoutingPolicyGeoPolicyGeoPolicyItem{
+			Location: target.Location,
+			Rrdatas:  target.Values,
+		})
+	}
+	return result
+}
@@ -6,20 +6,26 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal"
 	infrainternal "github.com/gardener/gardener-extension-provider-gcp/pkg/internal/infrastructure"
 )
@@ -28,14 +34,16 @@ type actuator struct {
 	client                     client.Client
 	restConfig                 *rest.Config
 	disableProjectedTokenMount bool
+	gcpClientFactory           gcpclient.Factory
 }
 
 // NewActuator creates a new infrastructure.Actuator.
-func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool) infrastructure.Actuator {
+func NewActuator(mgr manager.Manager, disableProjectedTokenMount bool, gcpClientFactory gcpclient.Factory) infrastructure.Actuator {
 	return &actuator{
 		client:                     mgr.GetClient(),
 		restConfig:                 mgr.GetConfig(),
 		disableProjectedTokenMount: disableProjectedTokenMount,
+		gcpClientFactory:           gcpClientFactory,
 	}
 }
 
@@ -45,12 +53,111 @@ func (a *actuator) updateProviderStatus(
 	status *v1alpha1.InfrastructureStatus,
 	state *runtime.RawExtension,
 ) error {
+	// Keep the error history of the previous status so that recurring errors can still be diagnosed after a
+	// successful reconciliation.
+	if previousStatus, err := previousProviderStatus(infra); err == nil && len(status.ErrorHistory) == 0 {
+		for _, record := range previousStatus.ErrorHistory {
+			out := v1alpha1.ErrorRecord{}
+			if err := v1alpha1.Convert_gcp_ErrorRecord_To_v1alpha1_ErrorRecord(&record, &out, nil); err != nil {
+				return err
+			}
+			status.ErrorHistory = append(status.ErrorHistory, out)
+		}
+	}
+
 	patch := client.MergeFrom(infra.DeepCopy())
 	infra.Status.ProviderStatus = &runtime.RawExtension{Object: status}
 	infra.Status.State = state
 	return a.client.Status().Patch(ctx, infra, patch)
 }
 
+// recordError adds the given error to the error history in the provider status of the infrastructure. Failures are
+// only logged to not hide the original error.
+func (a *actuator) recordError(
+	ctx context.Context,
+	log logr.Logger,
+	infra *extensionsv1alpha1.Infrastructure,
+	operation gardencorev1beta1.LastOperationType,
+	err error,
+) {
+	if err == nil {
+		return
+	}
+
+	if recordErr := a.updateErrorHistory(ctx, infra, operation, err); recordErr != nil {
+		log.Error(recordErr, "Could not record error in infrastructure provider status")
+	}
+}
+
+// updateState persists the given state of the flow reconciliation and keeps the provider status.
+func (a *actuator) updateState(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, state *runtime.RawExtension) error {
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.State = state
+	return a.client.Status().Patch(ctx, infra, patch)
+}
+
+func (a *actuator) updateErrorHistory(
+	ctx context.Context,
+	infra *extensionsv1alpha1.Infrastructure,
+	operation gardencorev1beta1.LastOperationType,
+	err error,
+) error {
+	status, decodeErr := previousProviderStatus(infra)
+	if decodeErr != nil {
+		return decodeErr
+	}
+	status.ErrorHistory = helper.RecordError(status.ErrorHistory, string(operation), err, metav1.Now())
+
+	statusV1alpha1 := &v1alpha1.InfrastructureStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "InfrastructureStatus",
+		},
+	}
+	if err := helper.Scheme.Convert(status, statusV1alpha1, nil); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.ProviderStatus = &runtime.RawExtension{Object: statusV1alpha1}
+	return a.client.Status().Patch(ctx, infra, patch)
+}
+
+// updatePlan publishes the given plan in the provider status of the infrastructure and keeps the rest of the status.
+func (a *actuator) updatePlan(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, plan *v1alpha1.InfrastructurePlan) error {
+	status, err := previousProviderStatus(infra)
+	if err != nil {
+		return err
+	}
+
+	statusV1alpha1 := &v1alpha1.InfrastructureStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "InfrastructureStatus",
+		},
+	}
+	if err := helper.Scheme.Convert(status, statusV1alpha1, nil); err != nil {
+		return err
+	}
+	statusV1alpha1.Plan = plan
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.ProviderStatus = &runtime.RawExtension{Object: statusV1alpha1}
+	return a.client.Status().Patch(ctx, infra, patch)
+}
+
+func previousProviderStatus(infra *extensionsv1alpha1.Infrastructure) (*api.InfrastructureStatus, error) {
+	if infra.Status.ProviderStatus == nil || infra.Status.ProviderStatus.Raw == nil {
+		return &api.InfrastructureStatus{}, nil
+	}
+
+	status, err := helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode infrastructure provider status: %w", err)
+	}
+	return status, nil
+}
+
 func (a *actuator) cleanupTerraformerResources(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure) error {
 	tf, err := internal.NewTerraformer(log, a.restConfig, infrainternal.TerraformerPurpose, infra, a.disableProjectedTokenMount)
 	if err != nil {
@@ -91,11 +198,89 @@ func shouldUseFlow(infra *extensionsv1alpha1.Infrastructure, cluster *extensions
 		return false, err
 	}
 
-	if state != nil {
+	// IPv6 single-stack and dual-stack shoots are only supported by the flow-based reconciliation.
+	if state != nil || isIPv6SingleStack(cluster) || isDualStack(cluster) {
 		return true, nil
 	}
 
-	return strings.EqualFold(infra.Annotations[gcp.AnnotationKeyUseFlow], "true") ||
+	if strings.EqualFold(infra.Annotations[gcp.AnnotationKeyUseFlow], "true") ||
 		(cluster.Shoot != nil && strings.EqualFold(cluster.Shoot.Annotations[gcp.AnnotationKeyUseFlow], "true")) ||
-		(cluster.Seed != nil && strings.EqualFold(cluster.Seed.Labels[gcp.SeedLabelKeyUseFlow], "true")), nil
+		(cluster.Seed != nil && strings.EqualFold(cluster.Seed.Labels[gcp.SeedLabelKeyUseFlow], "true")) {
+		return true, nil
+	}
+
+	// New infrastructures which use fields only supported by the flow-based reconciliation are created by the flow.
+	// Infrastructures which are already reconciled by Terraformer are only switched by the verified migration, see
+	// migrateToFlow.
+	if hasTerraformState(infra) {
+		return false, nil
+	}
+	fields, err := flowOnlyFields(infra)
+	if err != nil {
+		return false, err
+	}
+	return len(fields) > 0, nil
+}
+
+// hasTerraformState returns whether the infrastructure was reconciled by Terraformer, i.e. whether its status contains
+// a state which is not a FlowState.
+func hasTerraformState(infra *extensionsv1alpha1.Infrastructure) bool {
+	if infra.Status.State == nil || len(infra.Status.State.Raw) == 0 {
+		return false
+	}
+	state, err := getFlowStateFromInfrastructureStatus(infra)
+	return err == nil && state == nil
+}
+
+// flowOnlyFields returns the paths of the fields of the InfrastructureConfig which are set and which are only supported
+// by the flow-based reconciliation, i.e. existing subnets, NAT IPs allocated by the extension, Private Service Connect
+// endpoints, additional firewall rules, proxy-only subnets, the BGP configuration of the CloudRouter, VPC peerings, the
+// routing mode and MTU of the VPC, network firewall policies, packet mirroring, private DNS zones, NCC spokes, subnets
+// of zones and service accounts of worker pools.
+func flowOnlyFields(infra *extensionsv1alpha1.Infrastructure) ([]string, error) {
+	if infra.Spec.ProviderConfig == nil {
+		return nil, nil
+	}
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		networks = config.Networks
+		fields   []string
+	)
+	for _, f := range []struct {
+		path string
+		set  bool
+	}{
+		{"networks.existingSubnets", networks.ExistingSubnets != nil},
+		{"networks.cloudNAT.natIPCount", networks.CloudNAT != nil && networks.CloudNAT.NatIPCount != nil},
+		{"networks.privateServiceConnectEndpoints", len(networks.PrivateServiceConnectEndpoints) > 0},
+		{"networks.additionalFirewallRules", len(networks.AdditionalFirewallRules) > 0},
+		{"networks.proxyOnly", networks.ProxyOnly != nil},
+		{"networks.cloudRouterBGP", networks.CloudRouterBGP != nil},
+		{"networks.peerings", len(networks.Peerings) > 0},
+		{"networks.routingMode", networks.RoutingMode != nil},
+		{"networks.mtu", networks.MTU != nil},
+		{"networks.firewallPolicy", networks.FirewallPolicy != nil},
+		{"networks.packetMirroring", networks.PacketMirroring != nil},
+		{"networks.privateDNSZone", networks.PrivateDNSZone != nil},
+		{"networks.nccSpoke", networks.NCCSpoke != nil},
+		{"networks.zones", len(networks.Zones) > 0},
+		{"nodeServiceAccount.workerPools", config.NodeServiceAccount != nil && len(config.NodeServiceAccount.WorkerPools) > 0},
+	} {
+		if f.set {
+			fields = append(fields, f.path)
+		}
+	}
+	return fields, nil
+}
+
+func isIPv6SingleStack(cluster *extensionscontroller.Cluster) bool {
+	return cluster.Shoot != nil && gcp.IsIPv6SingleStack(cluster.Shoot.Spec.Networking)
+}
+
+func isDualStack(cluster *extensionscontroller.Cluster) bool {
+	return cluster.Shoot != nil && gcp.IsDualStack(cluster.Shoot.Spec.Networking)
 }
@@ -6,19 +6,31 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow"
 )
 
+// ConditionTypeForeignResourcesInNetwork is the type of the condition of the Infrastructure which reports whether the
+// deletion is paused because the network contains resources which were not created by Gardener.
+const ConditionTypeForeignResourcesInNetwork gardencorev1beta1.ConditionType = "ForeignResourcesInNetwork"
+
 // Delete implements infrastructure.Actuator.
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
-	return util.DetermineError(a.delete(ctx, log, infra, cluster), helper.KnownCodes)
+	err := a.delete(ctx, log, infra, cluster)
+	a.recordError(ctx, log, infra, gardencorev1beta1.LastOperationTypeDelete, err)
+	return util.DetermineError(err, helper.KnownCodes)
 }
 
 // ForceDelete forcefully deletes the Infrastructure.
@@ -36,11 +48,38 @@ func (a *actuator) delete(ctx context.Context, log logr.Logger, infra *extension
 		return NewTerraformReconciler(a.client, a.restConfig, nil, a.disableProjectedTokenMount).Delete(ctx, log, cluster, infra)
 	}
 
-	flow, err := infraflow.NewFlowReconciler(ctx, log, infra, cluster, a.client)
+	flow, err := infraflow.NewFlowReconciler(ctx, log, infra, cluster, a.client, a.gcpClientFactory)
 	if err != nil {
 		return err
 	}
-	return flow.Delete(ctx)
+	err = flow.Delete(ctx)
+	if resources, checked := flow.ForeignResources(); checked {
+		if condErr := a.updateForeignResourcesCondition(ctx, infra, resources); condErr != nil {
+			log.Error(condErr, "Could not update condition of the infrastructure", "type", ConditionTypeForeignResourcesInNetwork)
+		}
+	}
+	return err
+}
+
+// updateForeignResourcesCondition updates the condition reporting whether the deletion is paused because the network
+// contains resources which were not created by Gardener. The condition is only added once such resources were found.
+func (a *actuator) updateForeignResourcesCondition(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, resources []string) error {
+	if len(resources) == 0 && v1beta1helper.GetCondition(infra.Status.Conditions, ConditionTypeForeignResourcesInNetwork) == nil {
+		return nil
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, infra.Status.Conditions, ConditionTypeForeignResourcesInNetwork)
+	if len(resources) > 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionTrue, "ForeignResourcesFound",
+			fmt.Sprintf("Deletion is paused because the network contains resources which were not created by Gardener: %s", strings.Join(resources, ", ")))
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionFalse, "NoForeignResources",
+			"The network does not contain resources which were not created by Gardener.")
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, condition)
+	return a.client.Status().Patch(ctx, infra, patch)
 }
 
 func shouldDeleteWithFlow(infra *extensionsv1alpha1.Infrastructure) (bool, error) {
@@ -7,37 +7,88 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
 	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
+// ConditionTypeOrphanedResources is the type of the condition of the Infrastructure which reports resources of the
+// shoot which are not part of the desired state of the infrastructure anymore, e.g. because they were leaked by an
+// interrupted reconciliation.
+const ConditionTypeOrphanedResources gardencorev1beta1.ConditionType = "OrphanedResources"
+
 // Reconcile implements infrastructure.Actuator.
 func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
-	return util.DetermineError(a.reconcile(ctx, log, infra, cluster, terraformer.StateConfigMapInitializerFunc(terraformer.CreateState)), helper.KnownCodes)
+	err := a.reconcile(ctx, log, infra, cluster, terraformer.StateConfigMapInitializerFunc(terraformer.CreateState))
+	a.recordError(ctx, log, infra, gardencorev1beta1.LastOperationTypeReconcile, err)
+	return util.DetermineError(err, helper.KnownCodes)
 }
 
 func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster, terraformState terraformer.StateConfigMapInitializer) error {
+	if isIPv6SingleStack(cluster) && !features.ExtensionFeatureGate.Enabled(features.IPv6SingleStack) {
+		return fmt.Errorf("IPv6 single-stack shoots are only supported if the %s feature gate is enabled", features.IPv6SingleStack)
+	}
+	if isDualStack(cluster) && !features.ExtensionFeatureGate.Enabled(features.DualStack) {
+		return fmt.Errorf("dual-stack shoots are only supported if the %s feature gate is enabled", features.DualStack)
+	}
+
 	useFlow, err := shouldUseFlow(infra, cluster)
 	if err != nil {
 		return err
 	}
 
-	// Terraform case
-	if !useFlow {
-		reconciler := NewTerraformReconciler(a.client, a.restConfig, terraformState, a.disableProjectedTokenMount)
-		status, state, err := reconciler.Reconcile(ctx, log, cluster, infra)
+	// Plan case
+	if strings.EqualFold(infra.Annotations[gcp.AnnotationKeyPlan], "true") {
+		if !useFlow {
+			return fmt.Errorf("plan mode is only supported by the flow-based reconciliation of the infrastructure")
+		}
+
+		flow, err := infraflow.NewFlowReconciler(ctx, log, infra, cluster, a.client, a.gcpClientFactory)
+		if err != nil {
+			return err
+		}
+		plan, err := flow.Plan(ctx)
 		if err != nil {
+			return fmt.Errorf("planning the reconciliation failed: %w", err)
+		}
+		if err := a.updatePlan(ctx, infra, plan); err != nil {
+			return err
+		}
+		if err := a.removePlanAnnotation(ctx, infra); err != nil {
 			return err
 		}
+		// The infrastructure was not reconciled, hence the reconciliation must not succeed. It is not retried either, as
+		// the retry would apply the changes which were only meant to be planned.
+		return reconcile.TerminalError(fmt.Errorf("the reconciliation of the infrastructure was only planned, the plan with %d operations is published in the provider status", len(plan.Operations)))
+	}
 
-		return a.updateProviderStatus(ctx, infra, status, state)
+	// Terraform case
+	if !useFlow {
+		// The migration is verified against the Terraformer state of the last reconciliation before Terraform is applied
+		// again. A migrated infrastructure is reconciled by the flow right away, so that fields which are only supported
+		// by the flow-based reconciliation are applied.
+		migrated, err := a.migrateToFlow(ctx, log, infra, cluster)
+		if err != nil {
+			return fmt.Errorf("migration to flow reconciliation failed: %w", err)
+		}
+		if !migrated {
+			return a.reconcileWithTerraform(ctx, log, infra, cluster, terraformState)
+		}
+		log.Info("Continuing with flow-based reconciliation of migrated infrastructure")
 	}
 
 	// Flow case
@@ -45,15 +96,118 @@ func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extens
 		return fmt.Errorf("cleaning up terraformer resources failed: %w", err)
 	}
 
-	flow, err := infraflow.NewFlowReconciler(ctx, log, infra, cluster, a.client)
+	flow, err := infraflow.NewFlowReconciler(ctx, log, infra, cluster, a.client, a.gcpClientFactory)
 	if err != nil {
 		return err
 	}
 
 	status, state, err := flow.Reconcile(ctx)
+	if resources, checked := flow.OrphanedResources(); checked {
+		if condErr := a.updateOrphanedResourcesCondition(ctx, infra, resources); condErr != nil {
+			log.Error(condErr, "Could not update condition of the infrastructure", "type", ConditionTypeOrphanedResources)
+		}
+	}
+	if err != nil {
+		// The state records the resources and role bindings created by the failed reconciliation, which would otherwise
+		// be leaked. The status is only updated by successful reconciliations since it lacks the failed resources.
+		if state != nil {
+			if stateErr := a.updateState(ctx, infra, state); stateErr != nil {
+				log.Error(stateErr, "Could not persist the state of the failed reconciliation")
+			}
+		}
+		return err
+	}
+
+	if err := a.updateProviderStatus(ctx, infra, status, state); err != nil {
+		return err
+	}
+	return a.removeReconcileAnnotation(ctx, infra)
+}
+
+// reconcileWithTerraform reconciles an infrastructure which is not migrated to the flow-based reconciliation with
+// Terraformer.
+func (a *actuator) reconcileWithTerraform(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster, terraformState terraformer.StateConfigMapInitializer) error {
+	// Fields which are only supported by the flow-based reconciliation would be ignored by Terraformer, hence they are
+	// only accepted once the infrastructure is migrated to the flow.
+	fields, err := flowOnlyFields(infra)
+	if err != nil {
+		return err
+	}
+	if len(fields) > 0 {
+		if flowMigrationMode(infra, cluster) == "true" {
+			return fmt.Errorf("the fields %s are only supported by the flow-based reconciliation, but the migration of the infrastructure is blocked, see condition %s",
+				strings.Join(fields, ", "), ConditionTypeFlowMigration)
+		}
+		return fmt.Errorf("the fields %s are only supported by the flow-based reconciliation, the infrastructure has to be migrated by annotating the shoot with %s=true first",
+			strings.Join(fields, ", "), gcp.AnnotationKeyMigrateToFlow)
+	}
+
+	if _, ok := infra.Annotations[gcp.AnnotationKeyReconcile]; ok {
+		log.Info("Ignoring selection of subsystems to reconcile, which is only supported by the flow-based reconciliation")
+	}
+
+	reconciler := NewTerraformReconciler(a.client, a.restConfig, terraformState, a.disableProjectedTokenMount)
+	status, state, err := reconciler.Reconcile(ctx, log, cluster, infra)
 	if err != nil {
+		// The state records the resources and role bindings created by the failed reconciliation, which would otherwise
+		// be leaked. The status is only updated by successful reconciliations since it lacks the failed resources.
+		if state != nil {
+			if stateErr := a.updateState(ctx, infra, state); stateErr != nil {
+				log.Error(stateErr, "Could not persist the state of the failed reconciliation")
+			}
+		}
+		return err
+	}
+
+	if err := a.updateProviderStatus(ctx, infra, status, state); err != nil {
 		return err
 	}
+	return a.removeReconcileAnnotation(ctx, infra)
+}
+
+// updateOrphanedResourcesCondition updates the condition reporting the orphaned resources of the shoot. The condition
+// is only added once such resources were found.
+func (a *actuator) updateOrphanedResourcesCondition(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, resources []string) error {
+	if len(resources) == 0 && v1beta1helper.GetCondition(infra.Status.Conditions, ConditionTypeOrphanedResources) == nil {
+		return nil
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, infra.Status.Conditions, ConditionTypeOrphanedResources)
+	if len(resources) > 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionTrue, "OrphanedResourcesFound",
+			fmt.Sprintf("The following resources of the shoot are not part of the desired state of the infrastructure: %s", strings.Join(resources, ", ")))
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionFalse, "NoOrphanedResources",
+			"No orphaned resources of the shoot were found.")
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, condition)
+	return a.client.Status().Patch(ctx, infra, patch)
+}
+
+// removePlanAnnotation removes the annotation putting the reconciliation into plan mode, so that the next reconciliation
+// applies the changes again.
+func (a *actuator) removePlanAnnotation(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) error {
+	patch := client.MergeFrom(infra.DeepCopy())
+	delete(infra.Annotations, gcp.AnnotationKeyPlan)
+	if err := a.client.Patch(ctx, infra, patch); err != nil {
+		return fmt.Errorf("could not remove annotation %s: %w", gcp.AnnotationKeyPlan, err)
+	}
+	return nil
+}
 
-	return a.updateProviderStatus(ctx, infra, status, state)
+// removeReconcileAnnotation removes the annotation selecting the subsystems to reconcile, so that the next
+// reconciliation covers the whole infrastructure again.
+func (a *actuator) removeReconcileAnnotation(ctx context.Context, infra *extensionsv1alpha1.Infrastructure) error {
+	if _, ok := infra.Annotations[gcp.AnnotationKeyReconcile]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	delete(infra.Annotations, gcp.AnnotationKeyReconcile)
+	if err := a.client.Patch(ctx, infra, patch); err != nil {
+		return fmt.Errorf("could not remove annotation %s: %w", gcp.AnnotationKeyReconcile, err)
+	}
+	return nil
 }
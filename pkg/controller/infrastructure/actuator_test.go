@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+var _ = Describe("Actuator", func() {
+	const (
+		flowOnlyConfig = `{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16","mtu":1500,"cloudNAT":{"natIPCount":2}}}`
+		plainConfig    = `{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16"}}`
+		terraformState = `{"data":"","encoding":"none"}`
+		flowState      = `{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"FlowState","data":{}}`
+	)
+
+	var (
+		infra   *extensionsv1alpha1.Infrastructure
+		cluster *extensionscontroller.Cluster
+	)
+
+	BeforeEach(func() {
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+		}
+		cluster = &extensionscontroller.Cluster{
+			Shoot: &gardencorev1beta1.Shoot{},
+			Seed:  &gardencorev1beta1.Seed{},
+		}
+	})
+
+	withConfig := func(config string) {
+		infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(config)}
+	}
+	withState := func(state string) {
+		infra.Status.State = &runtime.RawExtension{Raw: []byte(state)}
+	}
+
+	Describe("#flowOnlyFields", func() {
+		It("should return the fields which are only supported by the flow", func() {
+			withConfig(flowOnlyConfig)
+			Expect(flowOnlyFields(infra)).To(Equal([]string{"networks.cloudNAT.natIPCount", "networks.mtu"}))
+		})
+
+		It("should return the service accounts of worker pools", func() {
+			withConfig(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","networks":{"workers":"10.250.0.0/16"},"nodeServiceAccount":{"workerPools":[{"name":"pool"}]}}`)
+			Expect(flowOnlyFields(infra)).To(Equal([]string{"nodeServiceAccount.workerPools"}))
+		})
+
+		It("should return no fields if none of them is set", func() {
+			withConfig(plainConfig)
+			Expect(flowOnlyFields(infra)).To(BeEmpty())
+		})
+
+		It("should return no fields if there is no provider config", func() {
+			Expect(flowOnlyFields(infra)).To(BeEmpty())
+		})
+	})
+
+	Describe("#shouldUseFlow", func() {
+		It("should use the flow for new infrastructures with fields only supported by the flow", func() {
+			withConfig(flowOnlyConfig)
+			Expect(shouldUseFlow(infra, cluster)).To(BeTrue())
+		})
+
+		It("should use Terraformer for new infrastructures without such fields", func() {
+			withConfig(plainConfig)
+			Expect(shouldUseFlow(infra, cluster)).To(BeFalse())
+		})
+
+		It("should not switch infrastructures reconciled by Terraformer because of fields only supported by the flow", func() {
+			withConfig(flowOnlyConfig)
+			withState(terraformState)
+			Expect(shouldUseFlow(infra, cluster)).To(BeFalse())
+		})
+
+		It("should use the flow for infrastructures with a flow state", func() {
+			withConfig(plainConfig)
+			withState(flowState)
+			Expect(shouldUseFlow(infra, cluster)).To(BeTrue())
+		})
+
+		It("should use the flow if it is requested by annotation", func() {
+			withConfig(plainConfig)
+			withState(terraformState)
+			cluster.Shoot.Annotations = map[string]string{gcp.AnnotationKeyUseFlow: "true"}
+			Expect(shouldUseFlow(infra, cluster)).To(BeTrue())
+		})
+	})
+})
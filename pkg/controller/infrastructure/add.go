@@ -30,14 +30,19 @@ type AddOptions struct {
 	// DisableProjectedTokenMount specifies whether the projected token mount shall be disabled for the terraformer.
 	// Used for testing only.
 	DisableProjectedTokenMount bool
+	// ClientOptions are the options of the GCP clients used by the flow-based reconciliation, e.g. to point them to a
+	// fake server. Used for testing only.
+	ClientOptions []gcpclient.Option
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
 // The opts.Reconciler is being set with a newly instantiated actuator.
 func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, options AddOptions) error {
+	gcpClientFactory := gcpclient.New(options.ClientOptions...)
+
 	return infrastructure.Add(ctx, mgr, infrastructure.AddArgs{
-		Actuator:          NewActuator(mgr, options.DisableProjectedTokenMount),
-		ConfigValidator:   NewConfigValidator(mgr, log.Log, gcpclient.New()),
+		Actuator:          NewActuator(mgr, options.DisableProjectedTokenMount, gcpClientFactory),
+		ConfigValidator:   NewConfigValidator(mgr, log.Log, gcpClientFactory),
 		ControllerOptions: options.Controller,
 		Predicates:        infrastructure.DefaultPredicates(ctx, mgr, options.IgnoreOperationAnnotation),
 		Type:              gcp.Type,
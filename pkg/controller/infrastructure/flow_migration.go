@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// ConditionTypeFlowMigration is the type of the condition of the Infrastructure which reports the result of the
+// migration of the infrastructure from Terraformer to the flow reconciliation.
+const ConditionTypeFlowMigration gardencorev1beta1.ConditionType = "FlowMigration"
+
+// flowMigrationMode returns the value of the annotation requesting the migration of the infrastructure to the flow
+// reconciliation or an empty string if it is not requested.
+func flowMigrationMode(infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) string {
+	mode := infra.Annotations[gcp.AnnotationKeyMigrateToFlow]
+	if mode == "" && cluster.Shoot != nil {
+		mode = cluster.Shoot.Annotations[gcp.AnnotationKeyMigrateToFlow]
+	}
+
+	switch {
+	case strings.EqualFold(mode, "true"):
+		return "true"
+	case strings.EqualFold(mode, gcp.MigrateToFlowVerify):
+		return gcp.MigrateToFlowVerify
+	default:
+		return ""
+	}
+}
+
+// migrateToFlow migrates an infrastructure which was reconciled by Terraformer to the flow reconciliation if it is
+// requested by annotation. It runs before Terraform is applied, so that the resources of the Terraformer state of the
+// last reconciliation are verified against the resources in GCP, i.e. changes of the resources or of the
+// InfrastructureConfig since then are detected. The migration is blocked as long as there are discrepancies, which are
+// reported in the FlowMigration condition. Otherwise, the Terraformer state is replaced by the FlowState and true is
+// returned, so that the infrastructure is reconciled by the flow from now on.
+func (a *actuator) migrateToFlow(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *extensionscontroller.Cluster) (bool, error) {
+	mode := flowMigrationMode(infra, cluster)
+	if mode == "" {
+		return false, nil
+	}
+
+	rawState, err := getTerraformerRawState(infra.Status.State)
+	if err != nil {
+		return false, err
+	}
+	if rawState == nil {
+		log.Info("Postponing migration to flow reconciliation until the infrastructure was reconciled by Terraformer")
+		return false, nil
+	}
+	tfState, err := shared.UnmarshalTerraformStateFromTerraformer(rawState)
+	if err != nil {
+		return false, fmt.Errorf("could not read Terraformer state: %w", err)
+	}
+
+	flow, err := infraflow.NewFlowReconciler(ctx, log, infra, cluster, a.client, a.gcpClientFactory)
+	if err != nil {
+		return false, err
+	}
+	discrepancies, err := flow.VerifyTerraformState(ctx, tfState)
+	if err != nil {
+		return false, fmt.Errorf("could not verify Terraformer state: %w", err)
+	}
+
+	if len(discrepancies) > 0 {
+		log.Info("Migration to flow reconciliation is blocked by discrepancies", "discrepancies", discrepancies)
+		return false, a.updateFlowMigrationCondition(ctx, infra, gardencorev1beta1.ConditionFalse, "DiscrepanciesFound",
+			fmt.Sprintf("The migration to the flow reconciliation is blocked by the following discrepancies: %s", strings.Join(discrepancies, "; ")))
+	}
+	if mode == gcp.MigrateToFlowVerify {
+		return false, a.updateFlowMigrationCondition(ctx, infra, gardencorev1beta1.ConditionTrue, "MigrationVerified",
+			"The Terraformer state matches the resources in GCP, the infrastructure can be migrated to the flow reconciliation.")
+	}
+
+	state, err := flow.MigrateTerraformState(tfState)
+	if err != nil {
+		return false, err
+	}
+
+	log.Info("Migrating infrastructure to flow reconciliation")
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.State = state
+	if err := a.client.Status().Patch(ctx, infra, patch); err != nil {
+		return false, fmt.Errorf("could not store migrated flow state: %w", err)
+	}
+	return true, a.updateFlowMigrationCondition(ctx, infra, gardencorev1beta1.ConditionTrue, "Migrated",
+		"The Terraformer state was migrated, the infrastructure is reconciled by the flow reconciliation.")
+}
+
+func (a *actuator) updateFlowMigrationCondition(
+	ctx context.Context,
+	infra *extensionsv1alpha1.Infrastructure,
+	status gardencorev1beta1.ConditionStatus,
+	reason, message string,
+) error {
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, infra.Status.Conditions, ConditionTypeFlowMigration)
+	condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, status, reason, message)
+
+	patch := client.MergeFrom(infra.DeepCopy())
+	infra.Status.Conditions = v1beta1helper.MergeConditions(infra.Status.Conditions, condition)
+	return a.client.Status().Patch(ctx, infra, patch)
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+const (
+	// flowStateKeyAdditionalFirewallRules is the key of the names of the additional firewall rules created by the
+	// extension in the FlowState.
+	flowStateKeyAdditionalFirewallRules = "additionalFirewallRules"
+
+	defaultFirewallRuleDirection = "INGRESS"
+	defaultFirewallRulePriority  = 1000
+)
+
+// additionalFirewallRuleName returns the name of the firewall rule of the given additional rule.
+func (c *FlowReconciler) additionalFirewallRuleName(name string) string {
+	return fmt.Sprintf("%s-%s", c.clusterName, name)
+}
+
+// createdAdditionalFirewallRules returns the names of the additional firewall rules which were created by the extension.
+func (c *FlowReconciler) createdAdditionalFirewallRules() []string {
+	if data := c.state.Get(flowStateKeyAdditionalFirewallRules); data != "" {
+		return strings.Split(data, ",")
+	}
+	return nil
+}
+
+func (c *FlowReconciler) storeCreatedAdditionalFirewallRules(names []string) {
+	if len(names) == 0 {
+		c.state.Delete(flowStateKeyAdditionalFirewallRules)
+		return
+	}
+	c.state.Set(flowStateKeyAdditionalFirewallRules, strings.Join(names, ","))
+}
+
+// ensureAdditionalFirewallRules creates or updates the additional firewall rules of the InfrastructureConfig and deletes
+// the additional rules which are not configured anymore.
+func (c *FlowReconciler) ensureAdditionalFirewallRules(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	if err := c.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+
+	var (
+		vpc     = GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+		created = c.createdAdditionalFirewallRules()
+		names   []string
+	)
+
+	for _, additionalRule := range c.config.Networks.AdditionalFirewallRules {
+		rule := firewallRuleAdditional(c.additionalFirewallRuleName(additionalRule.Name), vpc.SelfLink, c.clusterName, additionalRule)
+		names = append(names, rule.Name)
+		if !slices.Contains(created, rule.Name) {
+			created = append(created, rule.Name)
+			c.storeCreatedAdditionalFirewallRules(created)
+		}
+
+		gcpRule, err := c.computeClient.GetFirewallRule(ctx, rule.Name)
+		if err != nil {
+			return fmt.Errorf("failed to ensure firewall rule [name=%s]: %v", rule.Name, err)
+		}
+
+		// The direction of a firewall rule cannot be changed, hence the rule is recreated.
+		if gcpRule != nil && gcpRule.Direction != rule.Direction {
+			log.Info(fmt.Sprintf("recreating firewall rule [name=%s] with direction %s", rule.Name, rule.Direction))
+			if err := c.computeClient.DeleteFirewallRule(ctx, rule.Name); err != nil {
+				return err
+			}
+			gcpRule = nil
+		}
+
+		if gcpRule == nil {
+			if _, err = c.computeClient.InsertFirewallRule(ctx, rule); err != nil {
+				log.Info(fmt.Sprintf("failed to create firewall %s rule: %v", rule.Name, err))
+				return err
+			}
+			continue
+		}
+		if _, err = c.updater.Firewall(ctx, c.computeClient, rule); err != nil {
+			log.Info(fmt.Sprintf("failed to update firewall %s rule: %v", rule.Name, err))
+			return err
+		}
+	}
+
+	for _, name := range created {
+		if slices.Contains(names, name) {
+			continue
+		}
+		log.Info(fmt.Sprintf("destroying firewall rule [name=%s]", name))
+		if err := c.computeClient.DeleteFirewallRule(ctx, name); err != nil {
+			return err
+		}
+	}
+	c.storeCreatedAdditionalFirewallRules(names)
+
+	return nil
+}
+
+func firewallRuleAdditional(name, network, clusterName string, rule gcp.FirewallRule) *compute.Firewall {
+	firewall := &compute.Firewall{
+		Name:            name,
+		Network:         network,
+		Direction:       ptr.Deref(rule.Direction, defaultFirewallRuleDirection),
+		Priority:        int64(ptr.Deref(rule.Priority, defaultFirewallRulePriority)),
+		TargetTags:      rule.TargetTags,
+		ForceSendFields: []string{"Disabled", "Priority"},
+		NullFields:      []string{"Denied", "SourceServiceAccounts", "SourceTags", "TargetServiceAccounts"},
+	}
+	// Additional rules apply to the nodes of the shoot unless other instances are targeted explicitly, because the VPC
+	// can be shared with other shoots.
+	if len(firewall.TargetTags) == 0 {
+		firewall.TargetTags = []string{clusterName}
+	}
+
+	if firewall.Direction == "EGRESS" {
+		firewall.DestinationRanges = rule.Ranges
+		firewall.NullFields = append(firewall.NullFields, "SourceRanges")
+	} else {
+		firewall.SourceRanges = rule.Ranges
+		firewall.NullFields = append(firewall.NullFields, "DestinationRanges")
+	}
+
+	for _, allowed := range rule.Allowed {
+		firewall.Allowed = append(firewall.Allowed, &compute.FirewallAllowed{
+			IPProtocol: allowed.Protocol,
+			Ports:      allowed.Ports,
+		})
+	}
+
+	return firewall
+}
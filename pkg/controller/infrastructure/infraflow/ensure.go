@@ -3,12 +3,17 @@ package infraflow
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
+	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/internal/infrastructure"
 )
@@ -23,20 +28,28 @@ func (c *FlowReconciler) ensureServiceAccount(ctx context.Context) error {
 	}
 
 	if sa == nil {
-		if features.ExtensionFeatureGate.Enabled(features.DisableGardenerServiceAccountCreation) {
+		// A dedicated node service account is always created when requested explicitly.
+		if c.config.NodeServiceAccount == nil && features.ExtensionFeatureGate.Enabled(features.DisableGardenerServiceAccountCreation) {
 			c.Log.Info(fmt.Sprintf("feature gate %s is enabled. Skipping service account creation", features.DisableGardenerServiceAccountCreation))
 			return nil
 		}
+		// Terraformer only created the service account for new infrastructures, which is kept after the migration.
+		if c.config.NodeServiceAccount == nil && c.state.Get(flowStateKeySkipServiceAccountCreation) == "true" {
+			c.Log.Info("infrastructure was migrated from Terraformer without service account. Skipping service account creation")
+			return nil
+		}
 
 		log.Info("creating service account", "name", serviceAccountName)
 		sa, err = c.iamClient.CreateServiceAccount(ctx, serviceAccountName)
 		if err != nil {
 			return err
 		}
-		c.whiteboard.SetObject(ObjectKeyServiceAccount, sa)
 	}
+	c.whiteboard.SetObject(ObjectKeyServiceAccount, sa)
 
-	return nil
+	// The role bindings are also reconciled if no dedicated node service account is requested (anymore), so that the
+	// roles granted before are revoked if the option was unset.
+	return c.ensureServiceAccountRoles(ctx, serviceAccountName, sa.Email, helper.NodeServiceAccountRoles(c.config.NodeServiceAccount))
 }
 
 func (c *FlowReconciler) ensureVPC(ctx context.Context) error {
@@ -56,7 +69,14 @@ func (c *FlowReconciler) ensureVPC(ctx context.Context) error {
 		return err
 	}
 
-	targetVPC := targetNetwork(vpcName)
+	targetVPC := targetNetwork(vpcName, helper.VPCRoutingMode(c.config.Networks.RoutingMode))
+	if c.dualStack && c.hasInternalIPv6Subnet() {
+		// Subnets with internal IPv6 ranges require a ULA internal IPv6 range of the VPC.
+		targetVPC.EnableUlaInternalIpv6 = true
+	}
+	if mtu := c.config.Networks.MTU; mtu != nil {
+		targetVPC.Mtu = int64(*mtu)
+	}
 	if current == nil {
 		log.Info("creating...")
 		current, err = c.computeClient.InsertNetwork(ctx, targetVPC)
@@ -113,13 +133,41 @@ func (c *FlowReconciler) ensureSubnet(ctx context.Context) error {
 		cidr = c.config.Networks.Worker
 	}
 
+	if isExistingWorkersSubnet(c.config) {
+		subnet, err := c.getExistingSubnet(ctx, subnetName, cidr, vpc.SelfLink)
+		if err != nil {
+			return err
+		}
+		for _, secondaryRange := range c.config.Networks.SecondaryRanges {
+			if !slices.ContainsFunc(subnet.SecondaryIpRanges, func(r *compute.SubnetworkSecondaryRange) bool {
+				return r.RangeName == secondaryRange.Name && r.IpCidrRange == secondaryRange.CIDR
+			}) {
+				return fmt.Errorf("existing subnet [Name=%s] has no secondary range %s with range %s", subnetName, secondaryRange.Name, secondaryRange.CIDR)
+			}
+		}
+
+		c.whiteboard.SetObject(ObjectKeyNodeSubnet, subnet)
+		return nil
+	}
+
 	targetSubnet := targetSubnetState(
 		subnetName,
 		"gardener-managed worker subnet",
 		cidr,
 		vpc.SelfLink,
 		c.config.Networks.FlowLogs,
+		c.config.Networks.SecondaryRanges,
 	)
+	targetSubnet.PrivateIpGoogleAccess = ptr.Deref(c.config.Networks.PrivateGoogleAccess, false)
+	if c.ipv6SingleStack {
+		// The machines of IPv6 single-stack shoots only get an IPv6 address from the external IPv6 range of the subnet.
+		targetSubnet.StackType = gcpinternal.StackTypeIPv4IPv6
+		targetSubnet.Ipv6AccessType = gcpinternal.IPv6AccessTypeExternal
+	}
+	if c.dualStack {
+		targetSubnet.StackType = gcpinternal.StackTypeIPv4IPv6
+		targetSubnet.Ipv6AccessType = string(helper.WorkersIPv6AccessType(c.config.Networks.IPv6))
+	}
 
 	subnet, err := c.computeClient.GetSubnet(ctx, region, subnetName)
 	if err != nil {
@@ -161,6 +209,16 @@ func (c *FlowReconciler) ensureInternalSubnet(ctx context.Context) error {
 
 	subnetName := c.internalSubnetNameFromConfig()
 
+	if isExistingInternalSubnet(c.config) {
+		subnet, err := c.getExistingSubnet(ctx, subnetName, *c.config.Networks.Internal, vpc.SelfLink)
+		if err != nil {
+			return err
+		}
+
+		c.whiteboard.SetObject(ObjectKeyInternalSubnet, subnet)
+		return nil
+	}
+
 	subnet, err := c.computeClient.GetSubnet(ctx, region, subnetName)
 	if err != nil {
 		return err
@@ -172,7 +230,12 @@ func (c *FlowReconciler) ensureInternalSubnet(ctx context.Context) error {
 		*c.config.Networks.Internal,
 		vpc.SelfLink,
 		nil,
+		nil,
 	)
+	if c.dualStack {
+		desired.StackType = gcpinternal.StackTypeIPv4IPv6
+		desired.Ipv6AccessType = string(helper.InternalIPv6AccessType(c.config.Networks.IPv6))
+	}
 	if subnet == nil {
 		log.Info("creating...")
 		subnet, err = c.computeClient.InsertSubnet(ctx, region, desired)
@@ -191,6 +254,71 @@ func (c *FlowReconciler) ensureInternalSubnet(ctx context.Context) error {
 	return nil
 }
 
+// getExistingSubnet returns an existing subnet referenced in the InfrastructureConfig. Existing subnets are adopted as
+// they are, i.e. they are never modified, but they have to belong to the VPC and have the configured IPv4 range.
+func (c *FlowReconciler) getExistingSubnet(ctx context.Context, name, cidr, network string) (*compute.Subnetwork, error) {
+	c.LogFromContext(ctx).Info("ensuring existing subnet", "name", name)
+
+	subnet, err := c.computeClient.GetSubnet(ctx, c.infra.Spec.Region, name)
+	if err != nil {
+		return nil, err
+	}
+	if subnet == nil {
+		return nil, fmt.Errorf("failed to locate existing subnet [Name=%s]", name)
+	}
+	if subnet.Network != network {
+		return nil, fmt.Errorf("existing subnet [Name=%s] does not belong to the VPC %s", name, network)
+	}
+	if subnet.IpCidrRange != cidr {
+		return nil, fmt.Errorf("range %s of existing subnet [Name=%s] does not match the configured range %s", subnet.IpCidrRange, name, cidr)
+	}
+	return subnet, nil
+}
+
+// ensureProxyOnlySubnet creates the proxy-only subnet which is used by the proxies of internal Envoy-based application
+// load balancers. The range of a proxy-only subnet cannot be expanded.
+func (c *FlowReconciler) ensureProxyOnlySubnet(ctx context.Context) error {
+	var (
+		log    = c.LogFromContext(ctx)
+		region = c.infra.Spec.Region
+	)
+
+	if c.config.Networks.ProxyOnly == nil {
+		return c.ensureProxyOnlySubnetDeleted(ctx)
+	}
+
+	if err := c.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+	vpc := GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+
+	subnetName := c.proxyOnlySubnetNameFromConfig()
+	subnet, err := c.computeClient.GetSubnet(ctx, region, subnetName)
+	if err != nil {
+		return err
+	}
+
+	if subnet == nil {
+		log.Info("creating proxy-only subnet")
+		subnet, err = c.computeClient.InsertSubnet(ctx, region, &compute.Subnetwork{
+			Name:        subnetName,
+			Description: "gardener-managed proxy-only subnet",
+			IpCidrRange: *c.config.Networks.ProxyOnly,
+			Network:     vpc.SelfLink,
+			Purpose:     gcpinternal.SubnetPurposeRegionalManagedProxy,
+			Role:        gcpinternal.SubnetRoleActive,
+		})
+		if err != nil {
+			return err
+		}
+	} else if subnet.IpCidrRange != *c.config.Networks.ProxyOnly {
+		return fmt.Errorf("range of proxy-only subnet %s cannot be changed from %s to %s", subnetName, subnet.IpCidrRange, *c.config.Networks.ProxyOnly)
+	}
+
+	c.whiteboard.SetObject(ObjectKeyProxyOnlySubnet, subnet)
+	return nil
+}
+
 func (c *FlowReconciler) ensureCloudRouter(ctx context.Context) error {
 	if c.config.Networks.VPC != nil && c.config.Networks.VPC.CloudRouter != nil {
 		return c.ensureUserManagedCloudRouter(ctx)
@@ -205,7 +333,7 @@ func (c *FlowReconciler) ensureCloudRouter(ctx context.Context) error {
 
 	routerName := c.cloudRouterNameFromConfig()
 
-	desired := targetRouterState(routerName, "gardener-managed router", vpc.SelfLink)
+	desired := targetRouterState(routerName, "gardener-managed router", vpc.SelfLink, c.config.Networks.CloudRouterBGP)
 	router, err := c.computeClient.GetRouter(ctx, c.infra.Spec.Region, routerName)
 	if err != nil {
 		return err
@@ -217,6 +345,14 @@ func (c *FlowReconciler) ensureCloudRouter(ctx context.Context) error {
 		}
 	} else {
 		log.Info("router already exists")
+		// BGP peers are not managed by the extension, e.g. they are added together with Cloud VPN tunnels, and the BGP
+		// settings of the router are only changed if they are configured.
+		desired.BgpPeers = router.BgpPeers
+		if desired.Bgp == nil {
+			desired.Bgp = router.Bgp
+		} else if router.Bgp != nil {
+			desired.Bgp.KeepaliveInterval = router.Bgp.KeepaliveInterval
+		}
 		if router, err = c.updater.Router(ctx, c.computeClient, c.infra.Spec.Region, desired, router); err != nil {
 			return err
 		}
@@ -248,22 +384,65 @@ func (c *FlowReconciler) ensureUserManagedCloudRouter(ctx context.Context) error
 
 func (c *FlowReconciler) ensureAddresses(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
-	if c.config.Networks.CloudNAT == nil || len(c.config.Networks.CloudNAT.NatIPNames) == 0 {
+	if c.config.Networks.CloudNAT == nil || (len(c.config.Networks.CloudNAT.NatIPNames) == 0 && c.config.Networks.CloudNAT.NatIPCount == nil) {
 		return nil
 	}
 
-	var addresses []string
-	for _, name := range c.config.Networks.CloudNAT.NatIPNames {
-		ip, err := c.computeClient.GetAddress(ctx, c.infra.Spec.Region, name.Name)
+	if c.config.Networks.CloudNAT.NatIPCount != nil {
+		if err := c.ensureAllocatedNatIPs(ctx); err != nil {
+			return err
+		}
+	}
+
+	rotation, err := c.loadNatIPRotationState()
+	if err != nil {
+		return err
+	}
+
+	var (
+		addresses []string
+		ips       []v1alpha1.NatIP
+	)
+	for _, name := range c.natIPNames(rotation) {
+		ip, err := c.computeClient.GetAddress(ctx, c.infra.Spec.Region, name)
 		if err != nil {
 			log.Error(err, "failed to locate user-managed IP address")
 			return err
 		}
+		if ip == nil {
+			return fmt.Errorf("failed to locate IP address [Name=%s]", name)
+		}
 		addresses = append(addresses, ip.SelfLink)
+		ips = append(ips, v1alpha1.NatIP{IP: ip.Address, Name: ip.Name})
 	}
 
 	if len(addresses) > 0 {
 		c.whiteboard.SetObject(ObjectKeyIPAddress, addresses)
+		c.whiteboard.SetObject(ObjectKeyNatIPs, ips)
+	}
+
+	if rotation.Draining != "" {
+		ip, err := c.computeClient.GetAddress(ctx, c.infra.Spec.Region, rotation.Draining)
+		if err != nil {
+			return err
+		}
+		if ip != nil {
+			c.whiteboard.SetObject(ObjectKeyDrainingIPAddress, ip)
+		}
+	}
+
+	var removed []string
+	for _, name := range sets.List(sets.KeySet(rotation.Removed)) {
+		ip, err := c.computeClient.GetAddress(ctx, c.infra.Spec.Region, name)
+		if err != nil {
+			return err
+		}
+		if ip != nil {
+			removed = append(removed, ip.SelfLink)
+		}
+	}
+	if len(removed) > 0 {
+		c.whiteboard.SetObject(ObjectKeyRemovedIPAddresses, removed)
 	}
 	return nil
 }
@@ -276,14 +455,32 @@ func (c *FlowReconciler) ensureCloudNAT(ctx context.Context) error {
 
 	log.Info("ensuring nat")
 
-	if err := c.ensureObjectKeys(ObjectKeyRouter, ObjectKeyNodeSubnet); err != nil {
+	if err := c.ensureObjectKeys(ObjectKeyRouter); err != nil {
+		return err
+	}
+	subnetURLs, err := c.nodeSubnetURLs()
+	if err != nil {
 		return err
 	}
 
-	subnet := GetObject[*client.Subnetwork](c.whiteboard, ObjectKeyNodeSubnet)
 	router := GetObject[*client.Router](c.whiteboard, ObjectKeyRouter)
 
 	natName := c.cloudNatNameFromConfig()
+	if err := c.checkCloudNATOwnership(router, natName); err != nil {
+		return err
+	}
+
+	// GCP allows only one NAT per subnet range, hence a renamed NAT has to be removed before the new one is created.
+	if previousName := c.state.Get(flowStateKeyCloudNATName); previousName != "" && previousName != natName {
+		log.Info("deleting renamed nat", "name", previousName)
+		if _, err := c.updater.DeleteNAT(ctx, c.computeClient, c.infra.Spec.Region, router.Name, previousName); err != nil {
+			return err
+		}
+		if router, err = c.computeClient.GetRouter(ctx, c.infra.Spec.Region, router.Name); err != nil {
+			return err
+		}
+	}
+
 	var (
 		nat       *compute.RouterNat
 		addresses []string
@@ -293,11 +490,27 @@ func (c *FlowReconciler) ensureCloudNAT(ctx context.Context) error {
 		addresses = a.([]string)
 	}
 
-	targetNat := targetNATState(natName, subnet.SelfLink, c.config.Networks.CloudNAT, addresses)
+	var drainAddresses []string
+	if a := GetObject[*client.Address](c.whiteboard, ObjectKeyDrainingIPAddress); a != nil {
+		drainAddresses = append(drainAddresses, a.SelfLink)
+	}
+	drainAddresses = append(drainAddresses, GetObject[[]string](c.whiteboard, ObjectKeyRemovedIPAddresses)...)
+
+	targetNat := targetNATState(natName, subnetURLs, c.config.Networks.CloudNAT, addresses, drainAddresses)
 	router, nat, err = c.updater.NAT(ctx, c.computeClient, c.infra.Spec.Region, router, targetNat)
 	if err != nil {
 		return err
 	}
+	c.state.Set(flowStateKeyCloudNATName, natName)
+
+	rotation, err := c.loadNatIPRotationState()
+	if err != nil {
+		return err
+	}
+	rotation.Applied = c.natIPNames(rotation)
+	if err := c.storeNatIPRotationState(rotation); err != nil {
+		return err
+	}
 
 	c.whiteboard.SetObject(ObjectKeyRouter, router)
 	c.whiteboard.SetObject(ObjectKeyNAT, nat)
@@ -314,13 +527,47 @@ func (c *FlowReconciler) ensureFirewallRules(ctx context.Context) error {
 	}
 	vpc := GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
 
-	cidrs := []*string{c.podCIDR, c.config.Networks.Internal, ptr.To(c.config.Networks.Workers), ptr.To(c.config.Networks.Worker)}
+	// The pod network of IPv6 single-stack shoots is allowed by the IPv6 rules below.
+	podCIDR := c.podCIDR
+	if c.ipv6SingleStack {
+		podCIDR = nil
+	}
+
+	// The proxies of internal application load balancers connect to the backends from the proxy-only subnet.
+	cidrs := []*string{podCIDR, c.config.Networks.Internal, c.config.Networks.ProxyOnly, ptr.To(c.config.Networks.Workers), ptr.To(c.config.Networks.Worker)}
+	for _, secondaryRange := range c.config.Networks.SecondaryRanges {
+		cidrs = append(cidrs, ptr.To(secondaryRange.CIDR))
+	}
+	cidrs = append(cidrs, c.zoneCIDRs()...)
 	rules := []*compute.Firewall{
 		firewallRuleAllowExternal(firewallRuleAllowExternalName(c.clusterName), vpc.SelfLink),
 		firewallRuleAllowInternal(firewallRuleAllowInternalName(c.clusterName), vpc.SelfLink, cidrs),
 		firewallRuleAllowHealthChecks(firewallRuleAllowHealthChecksName(c.clusterName), vpc.SelfLink),
 	}
 
+	if c.ipv6SingleStack || c.dualStack {
+		if err := c.ensureObjectKeys(ObjectKeyNodeSubnet); err != nil {
+			return err
+		}
+		subnet := GetObject[*compute.Subnetwork](c.whiteboard, ObjectKeyNodeSubnet)
+
+		// The pods of dual-stack shoots get their IPv6 addresses from the IPv6 range of the worker subnet.
+		var ipv6CIDRs []*string
+		if c.ipv6SingleStack {
+			ipv6CIDRs = append(ipv6CIDRs, c.podCIDR)
+		}
+		ipv6CIDRs = append(ipv6CIDRs, subnetIPv6CIDRRange(subnet))
+		if internalSubnet := GetObject[*compute.Subnetwork](c.whiteboard, ObjectKeyInternalSubnet); internalSubnet != nil {
+			ipv6CIDRs = append(ipv6CIDRs, subnetIPv6CIDRRange(internalSubnet))
+		}
+
+		rules = append(rules,
+			firewallRuleAllowExternalIPv6(firewallRuleIPv6Name(firewallRuleAllowExternalName(c.clusterName)), vpc.SelfLink),
+			firewallRuleAllowInternalIPv6(firewallRuleIPv6Name(firewallRuleAllowInternalName(c.clusterName)), vpc.SelfLink, ipv6CIDRs),
+			firewallRuleAllowHealthChecksIPv6(firewallRuleIPv6Name(firewallRuleAllowHealthChecksName(c.clusterName)), vpc.SelfLink),
+		)
+	}
+
 	for _, rule := range rules {
 		gcpRule, err := c.computeClient.GetFirewallRule(ctx, rule.Name)
 		if err != nil {
@@ -384,12 +631,21 @@ func (c *FlowReconciler) ensureInternalSubnetDeleted(ctx context.Context) error
 	return nil
 }
 
-func (c *FlowReconciler) ensureServiceAccountDeleted(ctx context.Context) error {
+func (c *FlowReconciler) ensureProxyOnlySubnetDeleted(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 
-	serviceAccountName := c.serviceAccountNameFromConfig()
-	log.Info("deleting service account")
-	if err := c.iamClient.DeleteServiceAccount(ctx, serviceAccountName); err != nil {
+	subnetName := c.proxyOnlySubnetNameFromConfig()
+	log.Info("deleting proxy-only subnet")
+	if err := c.computeClient.DeleteSubnet(ctx, c.infra.Spec.Region, subnetName); err != nil {
+		return err
+	}
+
+	c.whiteboard.DeleteObject(ObjectKeyProxyOnlySubnet)
+	return nil
+}
+
+func (c *FlowReconciler) ensureServiceAccountDeleted(ctx context.Context) error {
+	if err := c.ensureServiceAccountRemoved(ctx, c.serviceAccountNameFromConfig()); err != nil {
 		return err
 	}
 
@@ -424,14 +680,23 @@ func (c *FlowReconciler) ensureCloudNATDeleted(ctx context.Context) error {
 	// }
 
 	routerName := c.cloudRouterNameFromConfig()
-	natName := c.cloudNatNameFromConfig()
+	// a NAT which was renamed but not yet removed is deleted as well.
+	natNames := []string{c.cloudNatNameFromConfig()}
+	if name := c.state.Get(flowStateKeyCloudNATName); name != "" && name != natNames[0] {
+		natNames = append(natNames, name)
+	}
 
-	log.Info("deleting nat")
-	router, err := c.updater.DeleteNAT(ctx, c.computeClient, c.infra.Spec.Region, routerName, natName)
-	if err != nil {
-		return err
+	var router *compute.Router
+	for _, natName := range natNames {
+		log.Info("deleting nat", "name", natName)
+		var err error
+		router, err = c.updater.DeleteNAT(ctx, c.computeClient, c.infra.Spec.Region, routerName, natName)
+		if err != nil {
+			return err
+		}
 	}
 	log.Info("nat deleted successfully")
+	c.state.Delete(flowStateKeyCloudNATName)
 	c.whiteboard.SetObject(ObjectKeyRouter, router)
 	return nil
 }
@@ -470,6 +735,7 @@ func (c *FlowReconciler) ensureFirewallRulesDeleted(ctx context.Context) error {
 			return err
 		}
 	}
+	c.storeCreatedAdditionalFirewallRules(nil)
 
 	return nil
 }
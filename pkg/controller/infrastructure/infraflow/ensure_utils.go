@@ -1,23 +1,35 @@
 package infraflow
 
 import (
+	"context"
 	"fmt"
+	"maps"
 
 	compute "google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
 )
 
 const (
-	// DefaultVPCRoutingConfigRegional is a constant for VPC Routing configuration option that enables regional routing.
-	DefaultVPCRoutingConfigRegional = "REGIONAL"
 	// DefaultAggregationInterval is the default value for the aggregation interval.
 	DefaultAggregationInterval = "INTERVAL_5_MIN"
 	// DefaultFlowSampling is the default value for the flow sampling.
 	DefaultFlowSampling = 0.5
 	// DefaultMetadata is the default value for the Flow Logs metadata.
 	DefaultMetadata = "EXCLUDE_ALL_METADATA"
+	// DefaultNatLoggingFilter is the default filter of the CloudNAT logs.
+	DefaultNatLoggingFilter = "ERRORS_ONLY"
+	// DefaultCloudRouterAdvertiseMode is the default mode of the route advertisements of the CloudRouter.
+	DefaultCloudRouterAdvertiseMode = "DEFAULT"
+
+	// flowStateKeyCloudNATName is the key of the name of the CloudNAT managed by the extension in the FlowState.
+	flowStateKeyCloudNATName = "cloudNATName"
+	// flowStateKeySkipServiceAccountCreation is the key in the FlowState which is set for infrastructures migrated from
+	// Terraformer which were created without the service account of the shoot.
+	flowStateKeySkipServiceAccountCreation = "skipServiceAccountCreation"
 )
 
 // GetObject returns the object and attempts to cast it to the specified type.
@@ -29,6 +41,20 @@ func GetObject[T any](wb shared.Whiteboard, key string) T {
 	return o.(T)
 }
 
+// ensureAddressLabels updates the labels of the given address created by the extension if they differ from the labels
+// of the resources of the shoot.
+func (c *FlowReconciler) ensureAddressLabels(ctx context.Context, address *compute.Address) error {
+	if maps.Equal(address.Labels, c.labels) {
+		return nil
+	}
+
+	c.LogFromContext(ctx).Info(fmt.Sprintf("updating labels of address [name=%s]", address.Name))
+	if err := c.computeClient.SetAddressLabels(ctx, c.infra.Spec.Region, address, c.labels); err != nil {
+		return fmt.Errorf("failed to update labels of address [name=%s]: %w", address.Name, err)
+	}
+	return nil
+}
+
 func (c *FlowReconciler) ensureObjectKeys(keys ...string) error {
 	for _, k := range keys {
 		if c.whiteboard.GetObject(k) == nil {
@@ -51,13 +77,23 @@ func (c *FlowReconciler) vpcNameFromConfig() string {
 }
 
 func (c *FlowReconciler) subnetNameFromConfig() string {
+	if isExistingWorkersSubnet(c.config) {
+		return *c.config.Networks.ExistingSubnets.Workers
+	}
 	return fmt.Sprintf("%s-nodes", c.clusterName)
 }
 
 func (c *FlowReconciler) internalSubnetNameFromConfig() string {
+	if isExistingInternalSubnet(c.config) {
+		return *c.config.Networks.ExistingSubnets.Internal
+	}
 	return fmt.Sprintf("%s-internal", c.clusterName)
 }
 
+func (c *FlowReconciler) proxyOnlySubnetNameFromConfig() string {
+	return fmt.Sprintf("%s-proxy-only", c.clusterName)
+}
+
 func (c *FlowReconciler) cloudRouterNameFromConfig() string {
 	routerName := fmt.Sprintf("%s-cloud-router", c.clusterName)
 	if c.config.Networks.VPC != nil && c.config.Networks.VPC.CloudRouter != nil {
@@ -67,9 +103,37 @@ func (c *FlowReconciler) cloudRouterNameFromConfig() string {
 }
 
 func (c *FlowReconciler) cloudNatNameFromConfig() string {
+	if c.config.Networks.CloudNAT != nil && c.config.Networks.CloudNAT.Name != nil {
+		return *c.config.Networks.CloudNAT.Name
+	}
+	return c.defaultCloudNatName()
+}
+
+func (c *FlowReconciler) defaultCloudNatName() string {
 	return fmt.Sprintf("%s-cloud-nat", c.clusterName)
 }
 
+// checkCloudNATOwnership returns an error if a NAT with the given name exists on the router which was not created by the
+// extension and shall not be adopted. NATs with the default name are always owned by the extension.
+func (c *FlowReconciler) checkCloudNATOwnership(router *compute.Router, name string) error {
+	if name == c.defaultCloudNatName() || c.state.Get(flowStateKeyCloudNATName) == name {
+		return nil
+	}
+	if c.config.Networks.CloudNAT != nil && c.config.Networks.CloudNAT.AdoptExisting {
+		return nil
+	}
+	for _, nat := range router.Nats {
+		if nat.Name == name {
+			return fmt.Errorf("CloudNAT %s already exists on router %s but was not created by the extension, set networks.cloudNAT.adoptExisting to take it over", name, router.Name)
+		}
+	}
+	return nil
+}
+
+func serviceAccountMember(email string) string {
+	return fmt.Sprintf("serviceAccount:%s", email)
+}
+
 func firewallRuleAllowInternalName(base string) string {
 	return fmt.Sprintf("%s-allow-internal-access", base)
 }
@@ -82,18 +146,24 @@ func firewallRuleAllowHealthChecksName(base string) string {
 	return fmt.Sprintf("%s-allow-health-checks", base)
 }
 
-func targetNetwork(name string) *compute.Network {
+// GCP does not allow to mix IPv4 and IPv6 ranges in a single firewall rule, hence IPv6 traffic is allowed by
+// dedicated rules.
+func firewallRuleIPv6Name(name string) string {
+	return fmt.Sprintf("%s-ipv6", name)
+}
+
+func targetNetwork(name, routingMode string) *compute.Network {
 	return &compute.Network{
 		Name:                  name,
 		AutoCreateSubnetworks: false,
 		RoutingConfig: &compute.NetworkRoutingConfig{
-			RoutingMode: DefaultVPCRoutingConfigRegional,
+			RoutingMode: routingMode,
 		},
 		ForceSendFields: []string{"AutoCreateSubnetworks"},
 	}
 }
 
-func targetSubnetState(name, description, cidr, networkName string, flowLogs *gcp.FlowLogs) *compute.Subnetwork {
+func targetSubnetState(name, description, cidr, networkName string, flowLogs *gcp.FlowLogs, secondaryRanges []gcp.SecondaryRange) *compute.Subnetwork {
 	subnet := &compute.Subnetwork{
 		Description:           description,
 		PrivateIpGoogleAccess: false,
@@ -104,6 +174,13 @@ func targetSubnetState(name, description, cidr, networkName string, flowLogs *gc
 		LogConfig:             nil,
 	}
 
+	for _, secondaryRange := range secondaryRanges {
+		subnet.SecondaryIpRanges = append(subnet.SecondaryIpRanges, &compute.SubnetworkSecondaryRange{
+			RangeName:   secondaryRange.Name,
+			IpCidrRange: secondaryRange.CIDR,
+		})
+	}
+
 	if flowLogs != nil {
 		subnet.EnableFlowLogs = true
 		subnet.LogConfig = &compute.SubnetworkLogConfig{}
@@ -116,6 +193,8 @@ func targetSubnetState(name, description, cidr, networkName string, flowLogs *gc
 		subnet.LogConfig.FlowSampling = DefaultFlowSampling
 		if flowLogs.FlowSampling != nil {
 			subnet.LogConfig.FlowSampling = *flowLogs.FlowSampling
+			// a sampling rate of 0 would be omitted and defaulted by GCP otherwise.
+			subnet.LogConfig.ForceSendFields = append(subnet.LogConfig.ForceSendFields, "FlowSampling")
 		}
 
 		subnet.LogConfig.Metadata = DefaultMetadata
@@ -127,15 +206,35 @@ func targetSubnetState(name, description, cidr, networkName string, flowLogs *gc
 	return subnet
 }
 
-func targetRouterState(name, description, vpcName string) *compute.Router {
+func targetRouterState(name, description, vpcName string, bgpConfig *gcp.CloudRouterBGP) *compute.Router {
 	return &compute.Router{
 		Name:        name,
 		Description: description,
 		Network:     vpcName,
+		Bgp:         targetRouterBgpState(bgpConfig),
 	}
 }
 
-func targetNATState(name, subnetURL string, natConfig *gcp.CloudNAT, natIpUrls []string) *compute.RouterNat {
+func targetRouterBgpState(bgpConfig *gcp.CloudRouterBGP) *compute.RouterBgp {
+	if bgpConfig == nil {
+		return nil
+	}
+
+	bgp := &compute.RouterBgp{
+		Asn:              bgpConfig.ASN,
+		AdvertiseMode:    ptr.Deref(bgpConfig.AdvertiseMode, DefaultCloudRouterAdvertiseMode),
+		AdvertisedGroups: bgpConfig.AdvertisedGroups,
+	}
+	for _, ipRange := range bgpConfig.AdvertisedIPRanges {
+		bgp.AdvertisedIpRanges = append(bgp.AdvertisedIpRanges, &compute.RouterAdvertisedIpRange{
+			Range:       ipRange.Range,
+			Description: ptr.Deref(ipRange.Description, ""),
+		})
+	}
+	return bgp
+}
+
+func targetNATState(name string, subnetURLs []string, natConfig *gcp.CloudNAT, natIpUrls, drainNatIpUrls []string) *compute.RouterNat {
 	nat := &compute.RouterNat{
 		DrainNatIps:                      nil,
 		EnableDynamicPortAllocation:      false,
@@ -143,7 +242,7 @@ func targetNATState(name, subnetURL string, natConfig *gcp.CloudNAT, natIpUrls [
 		EndpointTypes:                    nil,
 		LogConfig: &compute.RouterNatLogConfig{
 			Enable: true,
-			Filter: "ERRORS_ONLY",
+			Filter: DefaultNatLoggingFilter,
 		},
 		MaxPortsPerVm:                 65536,
 		MinPortsPerVm:                 2048,
@@ -152,19 +251,20 @@ func targetNATState(name, subnetURL string, natConfig *gcp.CloudNAT, natIpUrls [
 		NatIps:                        nil,
 		Rules:                         nil,
 		SourceSubnetworkIpRangesToNat: "LIST_OF_SUBNETWORKS",
-		Subnetworks: []*compute.RouterNatSubnetworkToNat{
-			{
-				Name:                subnetURL,
-				SourceIpRangesToNat: []string{"ALL_IP_RANGES"},
-			},
-		},
-		IcmpIdleTimeoutSec:           30,
-		TcpEstablishedIdleTimeoutSec: 1200,
-		TcpTimeWaitTimeoutSec:        120,
-		TcpTransitoryIdleTimeoutSec:  30,
-		UdpIdleTimeoutSec:            30,
-		ForceSendFields:              nil,
-		NullFields:                   nil,
+		IcmpIdleTimeoutSec:            30,
+		TcpEstablishedIdleTimeoutSec:  1200,
+		TcpTimeWaitTimeoutSec:         120,
+		TcpTransitoryIdleTimeoutSec:   30,
+		UdpIdleTimeoutSec:             30,
+		ForceSendFields:               nil,
+		NullFields:                    nil,
+	}
+
+	for _, subnetURL := range subnetURLs {
+		nat.Subnetworks = append(nat.Subnetworks, &compute.RouterNatSubnetworkToNat{
+			Name:                subnetURL,
+			SourceIpRangesToNat: []string{"ALL_IP_RANGES"},
+		})
 	}
 
 	if natConfig != nil {
@@ -200,11 +300,21 @@ func targetNATState(name, subnetURL string, natConfig *gcp.CloudNAT, natIpUrls [
 		if natConfig.UdpIdleTimeoutSec != nil {
 			nat.UdpIdleTimeoutSec = int64(*natConfig.UdpIdleTimeoutSec)
 		}
+
+		if natConfig.NatLogging != nil {
+			if natConfig.NatLogging.Enabled != nil {
+				nat.LogConfig.Enable = *natConfig.NatLogging.Enabled
+			}
+			if natConfig.NatLogging.Filter != nil {
+				nat.LogConfig.Filter = *natConfig.NatLogging.Filter
+			}
+		}
 	}
 
 	if len(natIpUrls) > 0 {
 		nat.NatIpAllocateOption = "MANUAL_ONLY"
 		nat.NatIps = append(nat.NatIps, natIpUrls...)
+		nat.DrainNatIps = append(nat.DrainNatIps, drainNatIpUrls...)
 	}
 	return nat
 }
@@ -285,6 +395,70 @@ func firewallRuleAllowHealthChecks(name, network string) *compute.Firewall {
 	}
 }
 
+func firewallRuleAllowInternalIPv6(name, network string, cidrs []*string) *compute.Firewall {
+	firewall := &compute.Firewall{
+		Name:      name,
+		Network:   network,
+		Direction: "INGRESS",
+		Allowed: []*compute.FirewallAllowed{
+			{
+				IPProtocol: "58", // ICMPv6
+			},
+			{
+				IPProtocol: "tcp",
+				Ports:      []string{"1-65535"},
+			},
+			{
+				IPProtocol: "udp",
+				Ports:      []string{"1-65535"},
+			},
+		},
+		ForceSendFields: []string{"Disabled", "Priority"},
+		NullFields:      []string{"Denied", "DestinationRanges", "SourceServiceAccounts", "SourceTags", "TargetTags", "TargetServiceAccounts"},
+	}
+	for _, cidr := range cidrs {
+		if cidr != nil && len(*cidr) > 0 {
+			firewall.SourceRanges = append(firewall.SourceRanges, *cidr)
+		}
+	}
+
+	return firewall
+}
+
+func firewallRuleAllowExternalIPv6(name, network string) *compute.Firewall {
+	firewall := firewallRuleAllowExternal(name, network)
+	firewall.SourceRanges = []string{"::/0"}
+	return firewall
+}
+
+func firewallRuleAllowHealthChecksIPv6(name, network string) *compute.Firewall {
+	firewall := firewallRuleAllowHealthChecks(name, network)
+	firewall.SourceRanges = []string{
+		"2600:2d00:1:b029::/64",
+		"2600:2d00:1:1::/64",
+		"2600:1901:8001::/48",
+	}
+	return firewall
+}
+
+// subnetIPv6CIDRRange returns the IPv6 range of the given subnet. Subnets have either an external or an internal
+// IPv6 range depending on their IPv6 access type.
+func subnetIPv6CIDRRange(subnet *compute.Subnetwork) *string {
+	if len(subnet.ExternalIpv6Prefix) > 0 {
+		return ptr.To(subnet.ExternalIpv6Prefix)
+	}
+	if len(subnet.InternalIpv6Prefix) > 0 {
+		return ptr.To(subnet.InternalIpv6Prefix)
+	}
+	return nil
+}
+
+// hasInternalIPv6Subnet returns whether any subnet of a dual-stack shoot gets an internal IPv6 range.
+func (c *FlowReconciler) hasInternalIPv6Subnet() bool {
+	return helper.WorkersIPv6AccessType(c.config.Networks.IPv6) == gcp.IPv6AccessTypeInternal ||
+		(c.config.Networks.Internal != nil && helper.InternalIPv6AccessType(c.config.Networks.IPv6) == gcp.IPv6AccessTypeInternal)
+}
+
 func isUserRouter(config *gcp.InfrastructureConfig) bool {
 	return config.Networks.VPC != nil &&
 		config.Networks.VPC.CloudRouter != nil &&
@@ -294,3 +468,11 @@ func isUserRouter(config *gcp.InfrastructureConfig) bool {
 func isUserVPC(config *gcp.InfrastructureConfig) bool {
 	return config.Networks.VPC != nil && len(config.Networks.VPC.Name) > 0
 }
+
+func isExistingWorkersSubnet(config *gcp.InfrastructureConfig) bool {
+	return config.Networks.ExistingSubnets != nil && config.Networks.ExistingSubnets.Workers != nil
+}
+
+func isExistingInternalSubnet(config *gcp.InfrastructureConfig) bool {
+	return config.Networks.ExistingSubnets != nil && config.Networks.ExistingSubnets.Internal != nil
+}
@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+const (
+	// flowStateKeyFirewallPolicy is the key of the name of the network firewall policy associated with the VPC by the
+	// extension in the FlowState.
+	flowStateKeyFirewallPolicy = "firewallPolicy"
+	// flowStateKeyFirewallPolicyCreated is the key in the FlowState which indicates that the associated network firewall
+	// policy was created by the extension and hence can be deleted.
+	flowStateKeyFirewallPolicyCreated = "firewallPolicyCreated"
+
+	// firewallPolicyReservedPriority is the lowest priority of the default rules of network firewall policies, which
+	// are not managed by the extension.
+	firewallPolicyReservedPriority = 2147483644
+)
+
+// firewallPolicyName returns the name of the network firewall policy of the InfrastructureConfig and whether it is
+// created by the extension.
+func (c *FlowReconciler) firewallPolicyName() (string, bool) {
+	policy := c.config.Networks.FirewallPolicy
+	if policy == nil {
+		return "", false
+	}
+	if policy.Name != nil {
+		return *policy.Name, false
+	}
+	return c.clusterName, true
+}
+
+func (c *FlowReconciler) storeFirewallPolicy(name string, created bool) {
+	if name == "" {
+		c.state.Delete(flowStateKeyFirewallPolicy)
+	} else {
+		c.state.Set(flowStateKeyFirewallPolicy, name)
+	}
+	if created {
+		c.state.Set(flowStateKeyFirewallPolicyCreated, "true")
+	} else {
+		c.state.Delete(flowStateKeyFirewallPolicyCreated)
+	}
+}
+
+// ensureFirewallPolicy associates the network firewall policy of the InfrastructureConfig with the VPC. The policy is
+// created with the configured rules if no existing policy is referenced. A previously associated policy is detached and
+// deleted if it was created by the extension.
+func (c *FlowReconciler) ensureFirewallPolicy(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	if err := c.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+
+	var (
+		vpc           = GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+		name, created = c.firewallPolicyName()
+	)
+
+	if previous := c.state.Get(flowStateKeyFirewallPolicy); previous != "" && previous != name {
+		if err := c.ensureFirewallPolicyDetached(ctx, previous, c.state.Get(flowStateKeyFirewallPolicyCreated) == "true"); err != nil {
+			return err
+		}
+		c.storeFirewallPolicy("", false)
+	}
+
+	if name == "" {
+		c.whiteboard.DeleteObject(ObjectKeyFirewallPolicy)
+		return nil
+	}
+	c.storeFirewallPolicy(name, created)
+
+	policy, err := c.computeClient.GetNetworkFirewallPolicy(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if created {
+		desired := targetFirewallPolicy(name, c.config.Networks.FirewallPolicy.Rules)
+		if policy == nil {
+			log.Info(fmt.Sprintf("creating network firewall policy [name=%s]", name))
+			if policy, err = c.computeClient.InsertNetworkFirewallPolicy(ctx, desired); err != nil {
+				return fmt.Errorf("failed to create network firewall policy [name=%s]: %w", name, err)
+			}
+		} else if err := c.ensureFirewallPolicyRules(ctx, policy, desired.Rules); err != nil {
+			return err
+		}
+	} else if policy == nil {
+		return fmt.Errorf("network firewall policy [name=%s] does not exist", name)
+	}
+
+	if findFirewallPolicyAssociation(policy, c.clusterName) == nil {
+		log.Info(fmt.Sprintf("associating network firewall policy [name=%s] with VPC [name=%s]", name, vpc.Name))
+		if err := c.computeClient.AddNetworkFirewallPolicyAssociation(ctx, name, &compute.FirewallPolicyAssociation{
+			Name:             c.clusterName,
+			AttachmentTarget: vpc.SelfLink,
+		}); err != nil {
+			return fmt.Errorf("failed to associate network firewall policy [name=%s] with VPC [name=%s]: %w", name, vpc.Name, err)
+		}
+	}
+	c.whiteboard.SetObject(ObjectKeyFirewallPolicy, name)
+
+	return nil
+}
+
+// ensureFirewallPolicyRules adds, updates and removes the rules of the given network firewall policy, except for its
+// default rules, so that they match the desired rules.
+func (c *FlowReconciler) ensureFirewallPolicyRules(ctx context.Context, policy *compute.FirewallPolicy, desired []*compute.FirewallPolicyRule) error {
+	log := c.LogFromContext(ctx)
+
+	for _, rule := range desired {
+		current := findFirewallPolicyRule(policy, rule.Priority)
+		if current == nil {
+			log.Info(fmt.Sprintf("adding rule with priority %d to network firewall policy [name=%s]", rule.Priority, policy.Name))
+			if err := c.computeClient.AddNetworkFirewallPolicyRule(ctx, policy.Name, rule); err != nil {
+				return fmt.Errorf("failed to add rule with priority %d to network firewall policy [name=%s]: %w", rule.Priority, policy.Name, err)
+			}
+			continue
+		}
+		if !firewallPolicyRuleEqual(current, rule) {
+			log.Info(fmt.Sprintf("updating rule with priority %d of network firewall policy [name=%s]", rule.Priority, policy.Name))
+			if err := c.computeClient.PatchNetworkFirewallPolicyRule(ctx, policy.Name, rule); err != nil {
+				return fmt.Errorf("failed to update rule with priority %d of network firewall policy [name=%s]: %w", rule.Priority, policy.Name, err)
+			}
+		}
+	}
+
+	for _, current := range policy.Rules {
+		if current.Priority >= firewallPolicyReservedPriority || slices.ContainsFunc(desired, func(rule *compute.FirewallPolicyRule) bool {
+			return rule.Priority == current.Priority
+		}) {
+			continue
+		}
+		log.Info(fmt.Sprintf("removing rule with priority %d from network firewall policy [name=%s]", current.Priority, policy.Name))
+		if err := c.computeClient.RemoveNetworkFirewallPolicyRule(ctx, policy.Name, current.Priority); err != nil {
+			return fmt.Errorf("failed to remove rule with priority %d from network firewall policy [name=%s]: %w", current.Priority, policy.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ensureFirewallPolicyDeleted detaches the network firewall policy from the VPC. The policy is only deleted if it was
+// created by the extension, as referenced policies may be shared with other networks.
+func (c *FlowReconciler) ensureFirewallPolicyDeleted(ctx context.Context) error {
+	name, created := c.firewallPolicyName()
+	if previous := c.state.Get(flowStateKeyFirewallPolicy); previous != "" {
+		name, created = previous, c.state.Get(flowStateKeyFirewallPolicyCreated) == "true"
+	}
+	if name == "" {
+		return nil
+	}
+
+	if err := c.ensureFirewallPolicyDetached(ctx, name, created); err != nil {
+		return err
+	}
+	c.storeFirewallPolicy("", false)
+	c.whiteboard.DeleteObject(ObjectKeyFirewallPolicy)
+	return nil
+}
+
+func (c *FlowReconciler) ensureFirewallPolicyDetached(ctx context.Context, name string, created bool) error {
+	log := c.LogFromContext(ctx)
+
+	policy, err := c.computeClient.GetNetworkFirewallPolicy(ctx, name)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+
+	if findFirewallPolicyAssociation(policy, c.clusterName) != nil {
+		log.Info(fmt.Sprintf("removing association of network firewall policy [name=%s]", name))
+		if err := c.computeClient.RemoveNetworkFirewallPolicyAssociation(ctx, name, c.clusterName); err != nil {
+			return fmt.Errorf("failed to remove association of network firewall policy [name=%s]: %w", name, err)
+		}
+	}
+
+	if created {
+		log.Info(fmt.Sprintf("destroying network firewall policy [name=%s]", name))
+		if err := c.computeClient.DeleteNetworkFirewallPolicy(ctx, name); err != nil {
+			return fmt.Errorf("failed to delete network firewall policy [name=%s]: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func targetFirewallPolicy(name string, rules []gcp.FirewallPolicyRule) *compute.FirewallPolicy {
+	policy := &compute.FirewallPolicy{
+		Name:        name,
+		Description: "Network firewall policy of the shoot managed by Gardener",
+	}
+	for _, rule := range rules {
+		policy.Rules = append(policy.Rules, targetFirewallPolicyRule(rule))
+	}
+	return policy
+}
+
+func targetFirewallPolicyRule(rule gcp.FirewallPolicyRule) *compute.FirewallPolicyRule {
+	target := &compute.FirewallPolicyRule{
+		Priority:        int64(rule.Priority),
+		Action:          rule.Action,
+		Direction:       ptr.Deref(rule.Direction, defaultFirewallRuleDirection),
+		Description:     ptr.Deref(rule.Description, ""),
+		Match:           &compute.FirewallPolicyRuleMatcher{},
+		ForceSendFields: []string{"Priority"},
+	}
+	if target.Direction == "EGRESS" {
+		target.Match.DestIpRanges = rule.Ranges
+	} else {
+		target.Match.SrcIpRanges = rule.Ranges
+	}
+	for _, protocol := range rule.Protocols {
+		target.Match.Layer4Configs = append(target.Match.Layer4Configs, &compute.FirewallPolicyRuleMatcherLayer4Config{
+			IpProtocol: protocol.Protocol,
+			Ports:      protocol.Ports,
+		})
+	}
+	return target
+}
+
+// firewallPolicyRuleEqual compares the managed fields of two rules of a network firewall policy.
+func firewallPolicyRuleEqual(current, desired *compute.FirewallPolicyRule) bool {
+	if current.Action != desired.Action || current.Direction != desired.Direction || current.Description != desired.Description {
+		return false
+	}
+	if current.Match == nil {
+		return false
+	}
+	if !slices.Equal(current.Match.SrcIpRanges, desired.Match.SrcIpRanges) || !slices.Equal(current.Match.DestIpRanges, desired.Match.DestIpRanges) {
+		return false
+	}
+	if len(current.Match.Layer4Configs) != len(desired.Match.Layer4Configs) {
+		return false
+	}
+	for i := range current.Match.Layer4Configs {
+		if current.Match.Layer4Configs[i].IpProtocol != desired.Match.Layer4Configs[i].IpProtocol ||
+			!slices.Equal(current.Match.Layer4Configs[i].Ports, desired.Match.Layer4Configs[i].Ports) {
+			return false
+		}
+	}
+	return true
+}
+
+func findFirewallPolicyRule(policy *compute.FirewallPolicy, priority int64) *compute.FirewallPolicyRule {
+	for _, rule := range policy.Rules {
+		if rule.Priority == priority {
+			return rule
+		}
+	}
+	return nil
+}
+
+func findFirewallPolicyAssociation(policy *compute.FirewallPolicy, name string) *compute.FirewallPolicyAssociation {
+	for _, association := range policy.Associations {
+		if association.Name == name {
+			return association
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ package infraflow
 
 import (
 	"encoding/json"
+	"sync"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -24,9 +25,12 @@ var (
 )
 
 // FlowState stores information about the infrastructure state for use with the FlowReconciler.
+// The tasks of the flow run concurrently, hence Data must only be accessed with Get, Set and Delete while the flow runs.
 type FlowState struct {
 	metav1.TypeMeta
 	Data map[string]string `json:"data"`
+
+	lock sync.RWMutex
 }
 
 // NewFlowState creates a new FlowState object.
@@ -40,8 +44,35 @@ func NewFlowState() *FlowState {
 	}
 }
 
+// Get returns the value of the given key, or an empty string if the key is not set.
+func (f *FlowState) Get(key string) string {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	return f.Data[key]
+}
+
+// Set sets the value of the given key.
+func (f *FlowState) Set(key, value string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.Data[key] = value
+}
+
+// Delete removes the given key.
+func (f *FlowState) Delete(key string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	delete(f.Data, key)
+}
+
 // ToJSON marshals state as JSON
 func (f *FlowState) ToJSON() ([]byte, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
 	return json.Marshal(f)
 }
 
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ForeignResources returns the resources which were found in the network during the last deletion attempt and which
+// were not created by Gardener, and whether the network was checked at all.
+func (c *FlowReconciler) ForeignResources() ([]string, bool) {
+	if !c.whiteboard.HasObject(ObjectKeyForeignResources) {
+		return nil, false
+	}
+	return GetObject[[]string](c.whiteboard, ObjectKeyForeignResources), true
+}
+
+// ensureNoForeignResources checks that neither the VPC nor the subnets which are deleted with the infrastructure
+// contain instances or forwarding rules which were not created by Gardener, so that a network which is shared with
+// others is not deleted under their feet.
+func (c *FlowReconciler) ensureNoForeignResources(ctx context.Context) error {
+	var (
+		network string
+		subnets = sets.New[string]()
+	)
+
+	if !isUserVPC(c.config) {
+		vpc, err := c.computeClient.GetNetwork(ctx, c.vpcNameFromConfig())
+		if err != nil {
+			return err
+		}
+		if vpc != nil {
+			network = vpc.SelfLink
+		}
+	}
+
+	var subnetNames []string
+	if !isExistingWorkersSubnet(c.config) {
+		subnetNames = append(subnetNames, c.subnetNameFromConfig())
+	}
+	if !isExistingInternalSubnet(c.config) {
+		subnetNames = append(subnetNames, c.internalSubnetNameFromConfig())
+	}
+	subnetNames = append(subnetNames, c.createdZoneSubnets()...)
+	for _, name := range subnetNames {
+		subnet, err := c.computeClient.GetSubnet(ctx, c.infra.Spec.Region, name)
+		if err != nil {
+			return err
+		}
+		if subnet != nil {
+			subnets.Insert(subnet.SelfLink)
+		}
+	}
+
+	if network == "" && subnets.Len() == 0 {
+		return nil
+	}
+
+	instances, err := c.computeClient.ListInstances(ctx)
+	if err != nil {
+		return err
+	}
+	rules, err := c.computeClient.ListForwardingRules(ctx, c.infra.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	inNetwork := func(n, subnet string) bool {
+		return (network != "" && n == network) || subnets.Has(subnet)
+	}
+
+	var foreign []string
+	for _, instance := range instances {
+		if instance.Tags != nil && slices.Contains(instance.Tags.Items, c.clusterName) {
+			continue
+		}
+		for _, networkInterface := range instance.NetworkInterfaces {
+			if inNetwork(networkInterface.Network, networkInterface.Subnetwork) {
+				foreign = append(foreign, fmt.Sprintf("instance %s/%s", path.Base(instance.Zone), instance.Name))
+				break
+			}
+		}
+	}
+	for _, rule := range rules {
+		if strings.HasPrefix(rule.Name, c.clusterName+"-") {
+			continue
+		}
+		if inNetwork(rule.Network, rule.Subnetwork) {
+			foreign = append(foreign, fmt.Sprintf("forwarding rule %s", rule.Name))
+		}
+	}
+
+	c.whiteboard.SetObject(ObjectKeyForeignResources, foreign)
+	if len(foreign) > 0 {
+		return fmt.Errorf("deletion is paused because the network contains resources which were not created by Gardener: %s", strings.Join(foreign, ", "))
+	}
+	return nil
+}
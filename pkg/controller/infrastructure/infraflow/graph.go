@@ -16,13 +16,26 @@ const (
 func (c *FlowReconciler) buildReconcileGraph() *flow.Graph {
 	g := flow.NewGraph("infrastructure reconciliation")
 
-	c.AddTask(g, "ensure service account", c.ensureServiceAccount,
+	ensureServiceAccount := c.AddTask(g, "ensure service account", c.ensureServiceAccount,
 		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemServiceAccount)),
+	)
+	ensureWorkerPoolServiceAccounts := c.AddTask(g, "ensure worker pool service accounts", c.ensureWorkerPoolServiceAccounts,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemServiceAccount)),
+		// both tasks record the granted roles in the FlowState.
+		shared.Dependencies(ensureServiceAccount),
 	)
 	ensureVPC := c.AddTask(g, "ensure VPC", c.ensureVPC,
 		shared.Timeout(defaultCreateTimeout),
 	)
 	ensureSubnet := c.AddTask(g, "ensure worker subnet", c.ensureSubnet,
+		shared.Timeout(defaultCreateTimeout),
+		// the regional worker subnet is replaced by the subnets of the zones.
+		shared.DoIf(len(c.config.Networks.Zones) == 0),
+		shared.Dependencies(ensureVPC),
+	)
+	ensureZoneSubnets := c.AddTask(g, "ensure subnets of zones", c.ensureZoneSubnets,
 		shared.Timeout(defaultCreateTimeout),
 		shared.Dependencies(ensureVPC),
 	)
@@ -30,21 +43,96 @@ func (c *FlowReconciler) buildReconcileGraph() *flow.Graph {
 		shared.Timeout(defaultCreateTimeout),
 		shared.Dependencies(ensureVPC),
 	)
+	ensureProxyOnlySubnet := c.AddTask(g, "ensure proxy-only subnet", c.ensureProxyOnlySubnet,
+		shared.Timeout(defaultCreateTimeout),
+		shared.Dependencies(ensureVPC),
+	)
 	ensureRouter := c.AddTask(g, "ensure router", c.ensureCloudRouter,
 		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemNAT)),
 		shared.Dependencies(ensureVPC),
 	)
+	ensureNatIPRotation := c.AddTask(g, "ensure NAT IP rotation", c.ensureNatIPRotation,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemNAT)),
+	)
 	ensureIpAddresses := c.AddTask(g, "ensure IP addresses", c.ensureAddresses,
 		shared.Timeout(defaultCreateTimeout),
-		shared.DoIf(c.config.Networks.CloudNAT != nil && len(c.config.Networks.CloudNAT.NatIPNames) > 0),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemNAT)),
+		shared.DoIf(c.config.Networks.CloudNAT != nil && (len(c.config.Networks.CloudNAT.NatIPNames) > 0 || c.config.Networks.CloudNAT.NatIPCount != nil)),
+		shared.Dependencies(ensureNatIPRotation),
+	)
+	ensureNAT := c.AddTask(g, "ensure nats", c.ensureCloudNAT,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemNAT)),
+		shared.Dependencies(ensureRouter, ensureSubnet, ensureZoneSubnets, ensureIpAddresses))
+	ensureNatIPsReleased := c.AddTask(g, "ensure unused NAT IPs released", c.ensureNatIPsReleased,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemNAT)),
+		shared.Dependencies(ensureNAT),
+	)
+	// the subnets of removed zones can only be deleted once the CloudNAT does not refer to them anymore.
+	ensureRemovedZoneSubnetsDeleted := c.AddTask(g, "ensure subnets of removed zones deleted", c.ensureRemovedZoneSubnetsDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemNAT)),
+		shared.Dependencies(ensureNAT),
+	)
+
+	ensureFirewall := c.AddTask(g, "ensure firewall", c.ensureFirewallRules,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemFirewalls)),
+		shared.Dependencies(ensureVPC, ensureSubnet, ensureInternalSubnet, ensureZoneSubnets),
+	)
+
+	ensureAdditionalFirewallRules := c.AddTask(g, "ensure additional firewall rules", c.ensureAdditionalFirewallRules,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemFirewalls)),
+		shared.Dependencies(ensureVPC),
+	)
+
+	ensurePrivateServiceConnectEndpoints := c.AddTask(g, "ensure private service connect endpoints", c.ensurePrivateServiceConnectEndpoints,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemPrivateServiceConnect)),
+		shared.Dependencies(ensureVPC, ensureSubnet),
+	)
+
+	ensurePeerings := c.AddTask(g, "ensure VPC peerings", c.ensurePeerings,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemPeerings)),
+		shared.Dependencies(ensureVPC),
+	)
+
+	ensureFirewallPolicy := c.AddTask(g, "ensure network firewall policy", c.ensureFirewallPolicy,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemFirewalls)),
+		shared.Dependencies(ensureVPC),
 	)
-	c.AddTask(g, "ensure nats", c.ensureCloudNAT,
+
+	ensurePacketMirroring := c.AddTask(g, "ensure packet mirroring", c.ensurePacketMirroring,
 		shared.Timeout(defaultCreateTimeout),
-		shared.Dependencies(ensureRouter, ensureSubnet, ensureIpAddresses))
+		shared.DoIf(c.reconcilesSubsystem(SubsystemPacketMirroring)),
+		shared.Dependencies(ensureVPC, ensureSubnet),
+	)
 
-	c.AddTask(g, "ensure firewall", c.ensureFirewallRules,
+	ensurePrivateDNSZone := c.AddTask(g, "ensure private DNS zone", c.ensurePrivateDNSZone,
 		shared.Timeout(defaultCreateTimeout),
-		shared.Dependencies(ensureVPC, ensureSubnet, ensureInternalSubnet),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemPrivateDNSZone)),
+		shared.Dependencies(ensureVPC),
+	)
+	ensureNCCSpoke := c.AddTask(g, "ensure NCC spoke", c.ensureNCCSpoke,
+		shared.Timeout(defaultCreateTimeout),
+		shared.DoIf(c.reconcilesSubsystem(SubsystemNCCSpoke)),
+		shared.Dependencies(ensureVPC),
+	)
+
+	// The sweep for orphaned resources compares the resources with the desired state, hence it runs after all other
+	// tasks and only if the whole infrastructure is reconciled.
+	c.AddTask(g, "ensure orphaned resources", c.ensureOrphanedResources,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.DoIf(len(c.subsystems) == 0),
+		shared.Dependencies(ensureServiceAccount, ensureWorkerPoolServiceAccounts, ensureSubnet, ensureInternalSubnet, ensureZoneSubnets, ensureProxyOnlySubnet, ensureNAT, ensureNatIPsReleased, ensureRemovedZoneSubnetsDeleted,
+			ensureFirewall, ensureAdditionalFirewallRules, ensurePrivateServiceConnectEndpoints, ensurePeerings, ensureFirewallPolicy,
+			ensurePacketMirroring, ensurePrivateDNSZone, ensureNCCSpoke),
 	)
 
 	return g
@@ -53,8 +141,12 @@ func (c *FlowReconciler) buildReconcileGraph() *flow.Graph {
 func (c *FlowReconciler) buildDeleteGraph() *flow.Graph {
 	g := flow.NewGraph("infrastructure deletion")
 
-	c.AddTask(g, "destroy service account", c.ensureServiceAccountDeleted,
+	ensureServiceAccountDeleted := c.AddTask(g, "destroy service account", c.ensureServiceAccountDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+	)
+	c.AddTask(g, "destroy worker pool service accounts", c.ensureWorkerPoolServiceAccountsDeleted,
 		shared.Timeout(defaultDeleteTimeout),
+		shared.Dependencies(ensureServiceAccountDeleted),
 	)
 	c.AddTask(g, "destroy kubernetes routes", c.ensureKubernetesRoutesDeleted, shared.Timeout(defaultDeleteTimeout))
 	ensureFirewallDeleted := c.AddTask(g, "destroy infrastructure firewall", c.ensureFirewallRulesDeleted, shared.Timeout(defaultDeleteTimeout))
@@ -65,6 +157,11 @@ func (c *FlowReconciler) buildDeleteGraph() *flow.Graph {
 	)
 	ensureInternalSubnetDeleted := c.AddTask(g, "destroy internal subnet", c.ensureInternalSubnetDeleted,
 		shared.Timeout(defaultDeleteTimeout),
+		// existing subnets are never deleted.
+		shared.DoIf(!isExistingInternalSubnet(c.config)),
+	)
+	ensureProxyOnlySubnetDeleted := c.AddTask(g, "destroy proxy-only subnet", c.ensureProxyOnlySubnetDeleted,
+		shared.Timeout(defaultDeleteTimeout),
 	)
 	ensureCloudRouterDeleted := c.AddTask(g, "ensure router deleted", c.ensureCloudRouterDeleted,
 		shared.Timeout(defaultDeleteTimeout),
@@ -72,13 +169,42 @@ func (c *FlowReconciler) buildDeleteGraph() *flow.Graph {
 		// for user-managed CloudRouters, skip deletion.
 		shared.DoIf(!isUserRouter(c.config)),
 	)
+	c.AddTask(g, "destroy NAT IPs", c.ensureNatIPsDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.Dependencies(ensureNatDeleted, ensureCloudRouterDeleted),
+	)
+	ensurePrivateServiceConnectEndpointsDeleted := c.AddTask(g, "destroy private service connect endpoints", c.ensurePrivateServiceConnectEndpointsDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+	)
+	ensurePacketMirroringDeleted := c.AddTask(g, "destroy packet mirroring", c.ensurePacketMirroringDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+	)
 	ensureSubnetDeleted := c.AddTask(g, "destroy worker subnet", c.ensureSubnetDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+		shared.Dependencies(ensureCloudRouterDeleted, ensurePrivateServiceConnectEndpointsDeleted, ensurePacketMirroringDeleted),
+		// existing subnets are never deleted.
+		shared.DoIf(!isExistingWorkersSubnet(c.config)),
+	)
+	ensureZoneSubnetsDeleted := c.AddTask(g, "destroy subnets of zones", c.ensureZoneSubnetsDeleted,
 		shared.Timeout(defaultDeleteTimeout),
 		shared.Dependencies(ensureCloudRouterDeleted),
 	)
+	ensurePeeringsDeleted := c.AddTask(g, "destroy VPC peerings", c.ensurePeeringsDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+	)
+	ensureFirewallPolicyDeleted := c.AddTask(g, "destroy network firewall policy", c.ensureFirewallPolicyDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+	)
+	ensurePrivateDNSZoneDeleted := c.AddTask(g, "destroy private DNS zone", c.ensurePrivateDNSZoneDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+	)
+	ensureNCCSpokeDeleted := c.AddTask(g, "destroy NCC spoke", c.ensureNCCSpokeDeleted,
+		shared.Timeout(defaultDeleteTimeout),
+	)
 	c.AddTask(g, "destroy vpc", c.ensureVPCDeleted,
 		shared.Timeout(defaultDeleteTimeout),
-		shared.Dependencies(ensureSubnetDeleted, ensureInternalSubnetDeleted, ensureCloudRouterDeleted, ensureFirewallDeleted),
+		shared.Dependencies(ensureSubnetDeleted, ensureInternalSubnetDeleted, ensureZoneSubnetsDeleted, ensureProxyOnlySubnetDeleted, ensureCloudRouterDeleted, ensureFirewallDeleted, ensurePeeringsDeleted, ensureFirewallPolicyDeleted, ensurePacketMirroringDeleted, ensurePrivateDNSZoneDeleted,
+			ensureNCCSpokeDeleted),
 		shared.DoIf(!isUserVPC(c.config)),
 	)
 
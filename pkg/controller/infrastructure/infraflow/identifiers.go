@@ -3,16 +3,46 @@ package infraflow
 const (
 	// ObjectKeyServiceAccount is the key to store the service account object.
 	ObjectKeyServiceAccount = "service-account"
+	// ObjectKeyWorkerPoolServiceAccounts is the key for the status of the service accounts of the worker pools.
+	ObjectKeyWorkerPoolServiceAccounts = "service-accounts-worker-pools"
 	// ObjectKeyVPC is the key to store the VPC object.
 	ObjectKeyVPC = "vpc"
 	// ObjectKeyNodeSubnet is the key to store the nodes subnet object.
 	ObjectKeyNodeSubnet = "subnet-nodes"
 	// ObjectKeyInternalSubnet is the key to store the internal subnet object.
 	ObjectKeyInternalSubnet = "subnet-internal"
+	// ObjectKeyZoneSubnets is the key to store the subnets of the zones.
+	ObjectKeyZoneSubnets = "subnets-zones"
+	// ObjectKeyProxyOnlySubnet is the key for the proxy-only subnet.
+	ObjectKeyProxyOnlySubnet = "subnet-proxy-only"
 	// ObjectKeyRouter router is the key for the CloudRouter.
 	ObjectKeyRouter = "router"
 	// ObjectKeyNAT is the key for the .CloudNAT object.
 	ObjectKeyNAT = "nat"
 	// ObjectKeyIPAddress is the key for the IP Address slice.
 	ObjectKeyIPAddress = "addresses/ip"
+	// ObjectKeyNatIPs is the key for the slice of the status of the NAT IPs.
+	ObjectKeyNatIPs = "addresses/nat-ips"
+	// ObjectKeyDrainingIPAddress is the key for the address of the NAT IP which is drained.
+	ObjectKeyDrainingIPAddress = "addresses/draining"
+	// ObjectKeyPrivateServiceConnectEndpoints is the key for the status of the Private Service Connect endpoints.
+	ObjectKeyPrivateServiceConnectEndpoints = "private-service-connect-endpoints"
+	// ObjectKeyPeerings is the key for the status of the VPC peerings.
+	ObjectKeyPeerings = "peerings"
+	// ObjectKeyFirewallPolicy is the key for the name of the network firewall policy associated with the VPC.
+	ObjectKeyFirewallPolicy = "firewallPolicy"
+	// ObjectKeyPacketMirroring is the key for the name of the packet mirroring policy of the worker subnet.
+	ObjectKeyPacketMirroring = "packetMirroring"
+	// ObjectKeyPrivateDNSZone is the key for the name of the private Cloud DNS zone of the internal domain of the shoot.
+	ObjectKeyPrivateDNSZone = "privateDNSZone"
+	// ObjectKeyNCCSpoke is the key for the status of the Network Connectivity Center spoke of the VPC.
+	ObjectKeyNCCSpoke = "nccSpoke"
+	// ObjectKeyRemovedIPAddresses is the key for the slice of the addresses which were removed from the NAT and are drained.
+	ObjectKeyRemovedIPAddresses = "addresses/removed"
+	// ObjectKeyForeignResources is the key for the descriptions of the resources in the network which were not created
+	// by Gardener.
+	ObjectKeyForeignResources = "foreign-resources"
+	// ObjectKeyOrphanedResources is the key for the descriptions of the orphaned resources of the shoot found by the last
+	// sweep.
+	ObjectKeyOrphanedResources = "orphaned-resources"
 )
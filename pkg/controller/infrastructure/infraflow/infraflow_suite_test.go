@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
+	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+func TestInfraflow(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Infrastructure Flow Suite")
+}
+
+var _ = BeforeSuite(func() {
+	features.RegisterExtensionFeatureGate()
+})
+
+const (
+	// fakeProject is the project of the service account of the infrastructures reconciled against the fake server.
+	fakeProject = "project"
+	// fakeRegion is the region of the infrastructures reconciled against the fake server.
+	fakeRegion = "europe-west1"
+	// fakeClusterName is the technical ID of the shoot of the infrastructures reconciled against the fake server.
+	fakeClusterName = "shoot--foo--bar"
+)
+
+// newFakeInfrastructure returns an infrastructure of the shoot fakeClusterName with the given InfrastructureConfig.
+func newFakeInfrastructure(config *v1alpha1.InfrastructureConfig) *extensionsv1alpha1.Infrastructure {
+	config.TypeMeta = metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"}
+	raw, err := json.Marshal(config)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+
+	return &extensionsv1alpha1.Infrastructure{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: fakeClusterName, Annotations: map[string]string{}},
+		Spec: extensionsv1alpha1.InfrastructureSpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "gcp", ProviderConfig: &runtime.RawExtension{Raw: raw}},
+			Region:      fakeRegion,
+			SecretRef:   corev1.SecretReference{Name: "cloudprovider", Namespace: fakeClusterName},
+		},
+	}
+}
+
+// newFakeFlowReconciler returns a FlowReconciler for the given infrastructure whose clients talk to the given fake
+// server. Like for the actuator, the FlowState is read from the status of the infrastructure. The given objects, e.g.
+// DNSRecords, are added to the seed client.
+func newFakeFlowReconciler(ctx context.Context, server *fake.Server, infra *extensionsv1alpha1.Infrastructure, objects ...client.Object) *FlowReconciler {
+	scheme := runtime.NewScheme()
+	ExpectWithOffset(1, corev1.AddToScheme(scheme)).To(Succeed())
+	ExpectWithOffset(1, extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: infra.Spec.SecretRef.Name, Namespace: infra.Spec.SecretRef.Namespace},
+		Data:       map[string][]byte{gcpinternal.ServiceAccountJSONField: server.ServiceAccountJSON(fakeProject)},
+	}
+	c := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(append(objects, secret)...).Build()
+
+	cluster := &controller.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeClusterName},
+		Shoot: &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Networking: &gardencorev1beta1.Networking{Pods: ptr.To("100.96.0.0/11")},
+			},
+		},
+	}
+
+	reconciler, err := NewFlowReconciler(ctx, logr.Discard(), infra, cluster, c, gcpclient.New(server.ClientOptions()...))
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return reconciler
+}
+
+// reconcileWithFakeServer reconciles the given infrastructure against the given fake server. Like the actuator, the
+// returned status and state are stored in the infrastructure, hence the next reconciliation continues with them.
+func reconcileWithFakeServer(ctx context.Context, server *fake.Server, infra *extensionsv1alpha1.Infrastructure, objects ...client.Object) (*FlowReconciler, *v1alpha1.InfrastructureStatus, error) {
+	reconciler := newFakeFlowReconciler(ctx, server, infra, objects...)
+	status, state, err := reconciler.Reconcile(ctx)
+	if status != nil {
+		raw, marshalErr := json.Marshal(status)
+		ExpectWithOffset(1, marshalErr).NotTo(HaveOccurred())
+		infra.Status.ProviderStatus = &runtime.RawExtension{Raw: raw}
+	}
+	if state != nil {
+		infra.Status.State = state
+	}
+	return reconciler, status, err
+}
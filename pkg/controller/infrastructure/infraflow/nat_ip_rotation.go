@@ -0,0 +1,393 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// natIPDrainDuration is the minimum duration a replaced NAT IP is drained before it is removed from the NAT.
+	natIPDrainDuration = time.Hour
+
+	// flowStateKeyNatIPRotation is the key of the NAT IP rotation state in the FlowState.
+	flowStateKeyNatIPRotation = "natIPRotation"
+)
+
+// natIPRotationState is the state of the NAT IP rotation which is kept in the FlowState.
+type natIPRotationState struct {
+	// ID is the value of the annotation which requested the rotation.
+	ID string `json:"id"`
+	// Next is the index of the configured NAT IP name which is replaced next.
+	Next int `json:"next"`
+	// Completed indicates whether all NAT IPs are replaced and the replaced IPs are drained.
+	Completed bool `json:"completed"`
+	// Draining is the name of the address which is currently drained.
+	Draining string `json:"draining,omitempty"`
+	// DrainingSince is the time when draining of the address started.
+	DrainingSince *metav1.Time `json:"drainingSince,omitempty"`
+	// Replacements maps the configured NAT IP names to the names of the addresses which replaced them. The replacing
+	// addresses are owned by the extension.
+	Replacements map[string]string `json:"replacements,omitempty"`
+	// Released are the names of owned addresses which are not used by the NAT anymore and can be released.
+	Released []string `json:"released,omitempty"`
+	// Applied are the names of the addresses used by the NAT with the last successful reconciliation.
+	Applied []string `json:"applied,omitempty"`
+	// Removed maps the names of addresses which were removed from the NAT configuration to the time when their
+	// draining started. They are removed from the NAT once they were drained for natIPDrainDuration.
+	Removed map[string]metav1.Time `json:"removed,omitempty"`
+}
+
+func (c *FlowReconciler) loadNatIPRotationState() (*natIPRotationState, error) {
+	rotation := &natIPRotationState{}
+	if data := c.state.Get(flowStateKeyNatIPRotation); data != "" {
+		if err := json.Unmarshal([]byte(data), rotation); err != nil {
+			return nil, fmt.Errorf("could not decode NAT IP rotation state: %w", err)
+		}
+	}
+	return rotation, nil
+}
+
+func (c *FlowReconciler) storeNatIPRotationState(rotation *natIPRotationState) error {
+	if rotation.ID == "" && len(rotation.Replacements) == 0 && len(rotation.Released) == 0 && len(rotation.Applied) == 0 && len(rotation.Removed) == 0 {
+		c.state.Delete(flowStateKeyNatIPRotation)
+		return nil
+	}
+
+	data, err := json.Marshal(rotation)
+	if err != nil {
+		return err
+	}
+	c.state.Set(flowStateKeyNatIPRotation, string(data))
+	return nil
+}
+
+// rotatableNatIPNames returns the names of the addresses which are replaced by a rotation of the NAT IPs, i.e. the
+// configured NAT IP names or the addresses allocated for the configured number of NAT IPs.
+func (c *FlowReconciler) rotatableNatIPNames() []string {
+	var names []string
+	if c.config.Networks.CloudNAT == nil {
+		return names
+	}
+	if c.config.Networks.CloudNAT.NatIPCount != nil {
+		return c.allocatedNatIPNames()
+	}
+
+	for _, name := range c.config.Networks.CloudNAT.NatIPNames {
+		names = append(names, name.Name)
+	}
+	return names
+}
+
+// natIPNames returns the names of the addresses used by the NAT, i.e. the configured NAT IP names or the allocated
+// addresses, or the addresses which replaced them.
+func (c *FlowReconciler) natIPNames(rotation *natIPRotationState) []string {
+	var names []string
+	for _, name := range c.rotatableNatIPNames() {
+		if replacement, ok := rotation.Replacements[name]; ok {
+			names = append(names, replacement)
+		} else {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// ensureNatIPRotation advances the rotation of the static NAT IPs requested by the rotation annotation. The NAT IPs are
+// replaced one at a time: a new address is reserved and added to the NAT, while the replaced one is drained, i.e. it is
+// only used by established connections. Once the replaced address was drained for natIPDrainDuration, it is removed
+// from the NAT and the next NAT IP is replaced with the next reconciliation.
+func (c *FlowReconciler) ensureNatIPRotation(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	rotation, err := c.loadNatIPRotationState()
+	if err != nil {
+		return err
+	}
+
+	names := c.rotatableNatIPNames()
+
+	// Forget replacements of NAT IP names which are not configured anymore, their addresses are released after the NAT
+	// does not use them anymore.
+	for name, replacement := range rotation.Replacements {
+		if !slices.Contains(names, name) {
+			delete(rotation.Replacements, name)
+			rotation.Released = append(rotation.Released, replacement)
+		}
+	}
+
+	if rotation.Draining != "" && rotation.DrainingSince != nil && time.Since(rotation.DrainingSince.Time) >= natIPDrainDuration {
+		log.Info("draining of replaced NAT IP finished", "address", rotation.Draining)
+		if !c.isConfiguredNatIPName(rotation.Draining) {
+			rotation.Released = append(rotation.Released, rotation.Draining)
+		}
+		rotation.Draining = ""
+		rotation.DrainingSince = nil
+	}
+
+	if c.natIPRotationID != "" && c.natIPRotationID != rotation.ID && rotation.Draining == "" {
+		log.Info("starting rotation of NAT IPs", "id", c.natIPRotationID)
+		rotation.ID = c.natIPRotationID
+		rotation.Next = 0
+		rotation.Completed = false
+	}
+
+	if rotation.ID != "" && !rotation.Completed && rotation.Draining == "" {
+		if rotation.Next >= len(names) {
+			log.Info("rotation of NAT IPs completed", "id", rotation.ID)
+			rotation.Completed = true
+		} else {
+			name := names[rotation.Next]
+			replaced := c.natIPNames(rotation)[rotation.Next]
+			replacement := fmt.Sprintf("%s-nat-%s-%d", c.infra.Namespace, utils.ComputeSHA256Hex([]byte(rotation.ID))[:8], rotation.Next)
+
+			if err := c.ensureNatIPAddress(ctx, replacement); err != nil {
+				return err
+			}
+
+			log.Info("replacing NAT IP", "address", replaced, "replacement", replacement)
+			if rotation.Replacements == nil {
+				rotation.Replacements = map[string]string{}
+			}
+			rotation.Replacements[name] = replacement
+			rotation.Draining = replaced
+			rotation.DrainingSince = ptr.To(metav1.Now())
+			rotation.Next++
+		}
+	}
+
+	c.drainRemovedNatIPs(ctx, rotation)
+	return c.storeNatIPRotationState(rotation)
+}
+
+// drainRemovedNatIPs starts draining the addresses which were used by the NAT but are not configured anymore, e.g.
+// because they were removed from the NAT IP names, and stops draining them after natIPDrainDuration. Hence, changes of
+// the NAT IPs are applied additively: new addresses are used right away, while removed ones are only removed from the
+// NAT after established connections had time to finish. Draining is only possible as long as the NAT uses static IPs.
+func (c *FlowReconciler) drainRemovedNatIPs(ctx context.Context, rotation *natIPRotationState) {
+	log := c.LogFromContext(ctx)
+
+	current := c.natIPNames(rotation)
+	if len(current) == 0 {
+		rotation.Removed = nil
+		return
+	}
+
+	for _, name := range rotation.Applied {
+		if _, ok := rotation.Removed[name]; ok || slices.Contains(current, name) || name == rotation.Draining {
+			continue
+		}
+		log.Info("draining removed NAT IP", "address", name)
+		if rotation.Removed == nil {
+			rotation.Removed = map[string]metav1.Time{}
+		}
+		rotation.Removed[name] = metav1.Now()
+	}
+
+	for name, since := range rotation.Removed {
+		if slices.Contains(current, name) {
+			delete(rotation.Removed, name)
+		} else if time.Since(since.Time) >= natIPDrainDuration {
+			log.Info("draining of removed NAT IP finished", "address", name)
+			delete(rotation.Removed, name)
+		}
+	}
+}
+
+func (c *FlowReconciler) ensureNatIPAddress(ctx context.Context, name string) error {
+	address, err := c.computeClient.GetAddress(ctx, c.infra.Spec.Region, name)
+	if err != nil {
+		return err
+	}
+	if address != nil {
+		return c.ensureAddressLabels(ctx, address)
+	}
+
+	c.LogFromContext(ctx).Info("reserving NAT IP", "address", name)
+	_, err = c.computeClient.InsertAddress(ctx, c.infra.Spec.Region, &client.Address{
+		Name:        name,
+		Description: fmt.Sprintf("NAT IP of Shoot %s created by the gardener-extension-provider-gcp.", c.infra.Namespace),
+		AddressType: "EXTERNAL",
+		NetworkTier: "PREMIUM",
+		Labels:      c.labels,
+	})
+	return err
+}
+
+// allocatedNatIPName returns the name of the address with the given index which is allocated by the extension for the
+// NAT if a number of NAT IPs is configured.
+func (c *FlowReconciler) allocatedNatIPName(index int) string {
+	return fmt.Sprintf("%s-nat-ip-%d", c.infra.Namespace, index)
+}
+
+// allocatedNatIPNames returns the names of the addresses allocated by the extension for the configured number of NAT IPs.
+func (c *FlowReconciler) allocatedNatIPNames() []string {
+	var names []string
+	if c.config.Networks.CloudNAT == nil || c.config.Networks.CloudNAT.NatIPCount == nil {
+		return names
+	}
+
+	for i := 0; i < int(*c.config.Networks.CloudNAT.NatIPCount); i++ {
+		names = append(names, c.allocatedNatIPName(i))
+	}
+	return names
+}
+
+// listAllocatedNatIPNames returns the names of the existing addresses allocated by the extension for the configured
+// number of NAT IPs. The addresses are not necessarily numbered consecutively, as allocated addresses which were replaced
+// by a rotation are released.
+func (c *FlowReconciler) listAllocatedNatIPNames(ctx context.Context) ([]string, error) {
+	addresses, err := c.computeClient.ListAddresses(ctx, c.infra.Spec.Region, fmt.Sprintf(`name eq "%s-nat-ip-[0-9]+"`, c.infra.Namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, address := range addresses {
+		names = append(names, address.Name)
+	}
+	return names, nil
+}
+
+// ensureAllocatedNatIPs reserves the addresses for the configured number of NAT IPs, unless they were replaced by a
+// rotation. Addresses exceeding the configured number are released after they are removed from the NAT.
+func (c *FlowReconciler) ensureAllocatedNatIPs(ctx context.Context) error {
+	rotation, err := c.loadNatIPRotationState()
+	if err != nil {
+		return err
+	}
+
+	names := c.allocatedNatIPNames()
+	for _, name := range names {
+		if _, ok := rotation.Replacements[name]; ok {
+			continue
+		}
+		if err := c.ensureNatIPAddress(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	existing, err := c.listAllocatedNatIPNames(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range existing {
+		// a replaced address which is still drained is released once draining finished
+		if slices.Contains(names, name) || name == rotation.Draining || slices.Contains(rotation.Released, name) {
+			continue
+		}
+		rotation.Released = append(rotation.Released, name)
+	}
+
+	return c.storeNatIPRotationState(rotation)
+}
+
+// ensureAllocatedNatIPsDeleted releases all addresses allocated by the extension for the configured number of NAT IPs.
+func (c *FlowReconciler) ensureAllocatedNatIPsDeleted(ctx context.Context) error {
+	names, err := c.listAllocatedNatIPNames(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		c.LogFromContext(ctx).Info("releasing NAT IP", "address", name)
+		if err := c.computeClient.DeleteAddress(ctx, c.infra.Spec.Region, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ownedNatIPNames returns the names of all addresses reserved by the extension for the NAT.
+func (c *FlowReconciler) ownedNatIPNames(rotation *natIPRotationState) []string {
+	var names []string
+	for _, replacement := range rotation.Replacements {
+		names = append(names, replacement)
+	}
+	if rotation.Draining != "" && !slices.Contains(names, rotation.Draining) && !c.isConfiguredNatIPName(rotation.Draining) {
+		names = append(names, rotation.Draining)
+	}
+	return append(names, rotation.Released...)
+}
+
+// isConfiguredNatIPName returns whether the given name is one of the configured NAT IP names. These addresses are
+// provided by the user and are never released by the extension, unlike the addresses allocated for the configured
+// number of NAT IPs.
+func (c *FlowReconciler) isConfiguredNatIPName(name string) bool {
+	return c.config.Networks.CloudNAT != nil && slices.ContainsFunc(c.config.Networks.CloudNAT.NatIPNames, func(n gcp.NatIPName) bool {
+		return n.Name == name
+	})
+}
+
+// ensureNatIPsReleased releases the addresses reserved by the extension which are not used by the NAT anymore.
+func (c *FlowReconciler) ensureNatIPsReleased(ctx context.Context) error {
+	rotation, err := c.loadNatIPRotationState()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range slices.Clone(rotation.Released) {
+		// drained addresses are still used by the NAT
+		if _, ok := rotation.Removed[name]; ok {
+			continue
+		}
+
+		c.LogFromContext(ctx).Info("releasing NAT IP", "address", name)
+		if err := c.computeClient.DeleteAddress(ctx, c.infra.Spec.Region, name); err != nil {
+			return err
+		}
+		rotation.Released = slices.DeleteFunc(rotation.Released, func(n string) bool { return n == name })
+		if err := c.storeNatIPRotationState(rotation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureNatIPsDeleted releases all addresses reserved by the extension for the NAT.
+func (c *FlowReconciler) ensureNatIPsDeleted(ctx context.Context) error {
+	rotation, err := c.loadNatIPRotationState()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range c.ownedNatIPNames(rotation) {
+		c.LogFromContext(ctx).Info("releasing NAT IP", "address", name)
+		if err := c.computeClient.DeleteAddress(ctx, c.infra.Spec.Region, name); err != nil {
+			return err
+		}
+	}
+	return c.ensureAllocatedNatIPsDeleted(ctx)
+}
+
+// natIPRotationStatus returns the status of the last requested NAT IP rotation.
+func (c *FlowReconciler) natIPRotationStatus() (*v1alpha1.NatIPRotationStatus, error) {
+	rotation, err := c.loadNatIPRotationState()
+	if err != nil || rotation.ID == "" {
+		return nil, err
+	}
+
+	status := &v1alpha1.NatIPRotationStatus{
+		ID:            rotation.ID,
+		Completed:     rotation.Completed,
+		DrainingSince: rotation.DrainingSince,
+	}
+	if address := GetObject[*client.Address](c.whiteboard, ObjectKeyDrainingIPAddress); address != nil {
+		status.Draining = &v1alpha1.NatIP{IP: address.Address}
+	}
+	return status, nil
+}
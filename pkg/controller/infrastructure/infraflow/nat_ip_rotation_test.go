@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("NAT IP rotation", func() {
+	const (
+		addressesCollection = "projects/project/regions/europe-west1/addresses"
+		routerPath          = "projects/project/regions/europe-west1/routers/" + fakeClusterName + "-cloud-router"
+	)
+
+	var (
+		ctx    = context.Background()
+		server *fake.Server
+		infra  *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		var err error
+		server, err = fake.NewServer()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(server.Close)
+
+		infra = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+			Networks: v1alpha1.NetworkConfig{Workers: "10.250.0.0/16"},
+		})
+	})
+
+	reconcile := func() *v1alpha1.InfrastructureStatus {
+		_, status, err := reconcileWithFakeServer(ctx, server, infra)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return status
+	}
+
+	setCloudNAT := func(cloudNAT *v1alpha1.CloudNAT) {
+		state, status := infra.Status.State, infra.Status.ProviderStatus
+		infra = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+			Networks: v1alpha1.NetworkConfig{Workers: "10.250.0.0/16", CloudNAT: cloudNAT},
+		})
+		infra.Status.State, infra.Status.ProviderStatus = state, status
+	}
+
+	rotate := func(id string) {
+		infra.Annotations[gcpinternal.AnnotationKeyRotateNatIPs] = id
+	}
+
+	// finishDraining moves the start of the draining of all drained NAT IPs into the past, so that the next
+	// reconciliation finds them drained.
+	finishDraining := func() {
+		state, err := NewFlowStateFromJSON(infra.Status.State.Raw)
+		Expect(err).NotTo(HaveOccurred())
+		rotation := &natIPRotationState{}
+		Expect(json.Unmarshal([]byte(state.Data[flowStateKeyNatIPRotation]), rotation)).To(Succeed())
+
+		drainedSince := metav1.NewTime(time.Now().Add(-natIPDrainDuration))
+		if rotation.DrainingSince != nil {
+			rotation.DrainingSince = &drainedSince
+		}
+		for name := range rotation.Removed {
+			rotation.Removed[name] = drainedSince
+		}
+
+		data, err := json.Marshal(rotation)
+		Expect(err).NotTo(HaveOccurred())
+		state.Data[flowStateKeyNatIPRotation] = string(data)
+		raw, err := state.ToJSON()
+		Expect(err).NotTo(HaveOccurred())
+		infra.Status.State = &runtime.RawExtension{Raw: raw}
+	}
+
+	addresses := func() []string {
+		var names []string
+		for _, address := range server.List(addressesCollection) {
+			names = append(names, address["name"].(string))
+		}
+		return names
+	}
+
+	// natAddresses returns the names of the addresses in the given field of the CloudNAT, i.e. natIps or drainNatIps.
+	natAddresses := func(field string) []string {
+		router, ok := server.Get(routerPath)
+		ExpectWithOffset(1, ok).To(BeTrue())
+		nats, _ := router["nats"].([]any)
+		ExpectWithOffset(1, nats).To(HaveLen(1))
+
+		var names []string
+		links, _ := nats[0].(map[string]any)[field].([]any)
+		for _, link := range links {
+			names = append(names, path.Base(link.(string)))
+		}
+		return names
+	}
+
+	allocated := func(index int) string {
+		return fmt.Sprintf("%s-nat-ip-%d", fakeClusterName, index)
+	}
+
+	replacement := func(id string, index int) string {
+		return fmt.Sprintf("%s-nat-%s-%d", fakeClusterName, utils.ComputeSHA256Hex([]byte(id))[:8], index)
+	}
+
+	Context("with a number of NAT IPs", func() {
+		BeforeEach(func() {
+			setCloudNAT(&v1alpha1.CloudNAT{NatIPCount: ptr.To[int32](2)})
+		})
+
+		It("should reserve the NAT IPs and use them for the CloudNAT", func() {
+			status := reconcile()
+
+			Expect(addresses()).To(ConsistOf(allocated(0), allocated(1)))
+			Expect(natAddresses("natIps")).To(ConsistOf(allocated(0), allocated(1)))
+			Expect(status.Networks.NatIPs).To(ConsistOf(
+				HaveField("Name", allocated(0)),
+				HaveField("Name", allocated(1)),
+			))
+			Expect(status.Networks.NatIPRotation).To(BeNil())
+		})
+
+		It("should drain and release the NAT IPs exceeding a decreased number", func() {
+			reconcile()
+
+			setCloudNAT(&v1alpha1.CloudNAT{NatIPCount: ptr.To[int32](1)})
+			reconcile()
+			Expect(natAddresses("natIps")).To(ConsistOf(allocated(0)))
+			Expect(natAddresses("drainNatIps")).To(ConsistOf(allocated(1)))
+			Expect(addresses()).To(ConsistOf(allocated(0), allocated(1)))
+
+			finishDraining()
+			reconcile()
+			Expect(natAddresses("natIps")).To(ConsistOf(allocated(0)))
+			Expect(natAddresses("drainNatIps")).To(BeEmpty())
+			Expect(addresses()).To(ConsistOf(allocated(0)))
+		})
+
+		It("should replace the NAT IPs one at a time and release the replaced ones once they are drained", func() {
+			reconcile()
+			ip0, ok := server.Get(addressesCollection + "/" + allocated(0))
+			Expect(ok).To(BeTrue())
+
+			rotate("1")
+			status := reconcile()
+			Expect(addresses()).To(ConsistOf(allocated(0), allocated(1), replacement("1", 0)))
+			Expect(natAddresses("natIps")).To(ConsistOf(replacement("1", 0), allocated(1)))
+			Expect(natAddresses("drainNatIps")).To(ConsistOf(allocated(0)))
+			Expect(status.Networks.NatIPRotation).To(And(
+				HaveField("ID", "1"),
+				HaveField("Completed", BeFalse()),
+				HaveField("Draining", Equal(&v1alpha1.NatIP{IP: ip0["address"].(string)})),
+				HaveField("DrainingSince", Not(BeNil())),
+			))
+
+			By("keeping the replaced NAT IP until it is drained")
+			reconcile()
+			Expect(natAddresses("drainNatIps")).To(ConsistOf(allocated(0)))
+
+			finishDraining()
+			reconcile()
+			Expect(addresses()).To(ConsistOf(allocated(1), replacement("1", 0), replacement("1", 1)))
+			Expect(natAddresses("natIps")).To(ConsistOf(replacement("1", 0), replacement("1", 1)))
+			Expect(natAddresses("drainNatIps")).To(ConsistOf(allocated(1)))
+
+			finishDraining()
+			status = reconcile()
+			Expect(addresses()).To(ConsistOf(replacement("1", 0), replacement("1", 1)))
+			Expect(natAddresses("natIps")).To(ConsistOf(replacement("1", 0), replacement("1", 1)))
+			Expect(natAddresses("drainNatIps")).To(BeEmpty())
+			Expect(status.Networks.NatIPRotation).To(Equal(&v1alpha1.NatIPRotationStatus{ID: "1", Completed: true}))
+
+			By("not reserving the replaced NAT IPs again")
+			reconcile()
+			Expect(addresses()).To(ConsistOf(replacement("1", 0), replacement("1", 1)))
+		})
+
+		It("should release the replacement of a NAT IP exceeding a decreased number", func() {
+			reconcile()
+			rotate("1")
+			reconcile()
+			finishDraining()
+			reconcile()
+			finishDraining()
+			reconcile()
+
+			setCloudNAT(&v1alpha1.CloudNAT{NatIPCount: ptr.To[int32](1)})
+			reconcile()
+			Expect(natAddresses("natIps")).To(ConsistOf(replacement("1", 0)))
+			Expect(natAddresses("drainNatIps")).To(ConsistOf(replacement("1", 1)))
+
+			finishDraining()
+			reconcile()
+			Expect(addresses()).To(ConsistOf(replacement("1", 0)))
+			Expect(natAddresses("drainNatIps")).To(BeEmpty())
+		})
+
+		It("should release all reserved NAT IPs on deletion", func() {
+			reconcile()
+			rotate("1")
+			reconcile()
+			Expect(addresses()).To(HaveLen(3))
+
+			Expect(newFakeFlowReconciler(ctx, server, infra).ensureNatIPsDeleted(ctx)).To(Succeed())
+			Expect(addresses()).To(BeEmpty())
+		})
+	})
+
+	Context("with NAT IP names", func() {
+		BeforeEach(func() {
+			for i, name := range []string{"user-ip-0", "user-ip-1"} {
+				server.Put(addressesCollection+"/"+name, map[string]any{"address": fmt.Sprintf("198.51.100.%d", i)})
+			}
+			setCloudNAT(&v1alpha1.CloudNAT{NatIPNames: []v1alpha1.NatIPName{{Name: "user-ip-0"}, {Name: "user-ip-1"}}})
+		})
+
+		It("should use the configured NAT IPs for the CloudNAT", func() {
+			status := reconcile()
+
+			Expect(natAddresses("natIps")).To(ConsistOf("user-ip-0", "user-ip-1"))
+			Expect(status.Networks.NatIPs).To(ConsistOf(
+				v1alpha1.NatIP{IP: "198.51.100.0", Name: "user-ip-0"},
+				v1alpha1.NatIP{IP: "198.51.100.1", Name: "user-ip-1"},
+			))
+		})
+
+		It("should drain removed NAT IPs before they are removed from the CloudNAT", func() {
+			reconcile()
+
+			setCloudNAT(&v1alpha1.CloudNAT{NatIPNames: []v1alpha1.NatIPName{{Name: "user-ip-0"}}})
+			reconcile()
+			Expect(natAddresses("natIps")).To(ConsistOf("user-ip-0"))
+			Expect(natAddresses("drainNatIps")).To(ConsistOf("user-ip-1"))
+
+			By("stopping to drain NAT IPs which are configured again")
+			setCloudNAT(&v1alpha1.CloudNAT{NatIPNames: []v1alpha1.NatIPName{{Name: "user-ip-0"}, {Name: "user-ip-1"}}})
+			reconcile()
+			Expect(natAddresses("natIps")).To(ConsistOf("user-ip-0", "user-ip-1"))
+			Expect(natAddresses("drainNatIps")).To(BeEmpty())
+
+			setCloudNAT(&v1alpha1.CloudNAT{NatIPNames: []v1alpha1.NatIPName{{Name: "user-ip-0"}}})
+			reconcile()
+			finishDraining()
+			reconcile()
+			Expect(natAddresses("natIps")).To(ConsistOf("user-ip-0"))
+			Expect(natAddresses("drainNatIps")).To(BeEmpty())
+			Expect(addresses()).To(ConsistOf("user-ip-0", "user-ip-1"))
+		})
+
+		It("should replace the configured NAT IPs but never release them", func() {
+			reconcile()
+
+			rotate("1")
+			reconcile()
+			Expect(natAddresses("natIps")).To(ConsistOf(replacement("1", 0), "user-ip-1"))
+			Expect(natAddresses("drainNatIps")).To(ConsistOf("user-ip-0"))
+
+			finishDraining()
+			reconcile()
+			finishDraining()
+			status := reconcile()
+			Expect(natAddresses("natIps")).To(ConsistOf(replacement("1", 0), replacement("1", 1)))
+			Expect(addresses()).To(ConsistOf("user-ip-0", "user-ip-1", replacement("1", 0), replacement("1", 1)))
+			Expect(status.Networks.NatIPRotation.Completed).To(BeTrue())
+
+			Expect(newFakeFlowReconciler(ctx, server, infra).ensureNatIPsDeleted(ctx)).To(Succeed())
+			Expect(addresses()).To(ConsistOf("user-ip-0", "user-ip-1"))
+		})
+
+		It("should start a new rotation only after the replaced NAT IP of the previous one is drained", func() {
+			reconcile()
+			rotate("1")
+			reconcile()
+
+			rotate("2")
+			status := reconcile()
+			Expect(status.Networks.NatIPRotation.ID).To(Equal("1"))
+			Expect(natAddresses("drainNatIps")).To(ConsistOf("user-ip-0"))
+
+			finishDraining()
+			status = reconcile()
+			Expect(status.Networks.NatIPRotation.ID).To(Equal("2"))
+			Expect(natAddresses("natIps")).To(ConsistOf(replacement("2", 0), "user-ip-1"))
+			Expect(natAddresses("drainNatIps")).To(ConsistOf(replacement("1", 0)))
+		})
+	})
+})
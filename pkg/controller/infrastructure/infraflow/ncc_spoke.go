@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/networkconnectivity/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+)
+
+// flowStateKeyNCCSpoke is the key of the name of the Network Connectivity Center spoke created by the extension in the
+// FlowState.
+const flowStateKeyNCCSpoke = "nccSpoke"
+
+func (c *FlowReconciler) nccSpokeName() string {
+	return c.clusterName
+}
+
+// ensureNCCSpoke registers the VPC as a spoke of the configured Network Connectivity Center hub. The hub and the
+// excluded export ranges of a spoke cannot be changed, hence the spoke is recreated if they are changed. The spoke is
+// deleted if it is not configured (anymore).
+func (c *FlowReconciler) ensureNCCSpoke(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	if c.config.Networks.NCCSpoke == nil {
+		return c.ensureNCCSpokeDeleted(ctx)
+	}
+
+	if err := c.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+
+	var (
+		vpc     = GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+		name    = c.nccSpokeName()
+		desired = targetNCCSpoke(c.config.Networks.NCCSpoke.Hub, vpc.SelfLink, c.config.Networks.NCCSpoke.ExcludeExportRanges, c.labels)
+	)
+
+	spoke, err := c.nccClient.GetSpoke(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if spoke != nil && !isSameNCCSpoke(spoke, desired) {
+		log.Info(fmt.Sprintf("recreating changed NCC spoke [name=%s]", name))
+		c.state.Set(flowStateKeyNCCSpoke, name)
+		if err := c.ensureNCCSpokeDeleted(ctx); err != nil {
+			return err
+		}
+		spoke = nil
+	}
+
+	if spoke == nil {
+		log.Info(fmt.Sprintf("creating NCC spoke [name=%s] on hub %s", name, desired.Hub))
+		c.state.Set(flowStateKeyNCCSpoke, name)
+		if spoke, err = c.nccClient.CreateSpoke(ctx, name, desired); err != nil {
+			return fmt.Errorf("failed to create NCC spoke [name=%s]: %w", name, err)
+		}
+	}
+	c.state.Set(flowStateKeyNCCSpoke, name)
+
+	c.whiteboard.SetObject(ObjectKeyNCCSpoke, &v1alpha1.NCCSpokeStatus{Name: name, State: spoke.State})
+	return nil
+}
+
+// ensureNCCSpokeDeleted detaches the VPC from the Network Connectivity Center hub by deleting the spoke. The spoke is only
+// deleted if it was created by the extension, so that no permissions are required for shoots which never used it.
+func (c *FlowReconciler) ensureNCCSpokeDeleted(ctx context.Context) error {
+	name := c.state.Get(flowStateKeyNCCSpoke)
+	if name == "" {
+		return nil
+	}
+
+	c.LogFromContext(ctx).Info(fmt.Sprintf("destroying NCC spoke [name=%s]", name))
+	if err := c.nccClient.DeleteSpoke(ctx, name); err != nil {
+		return fmt.Errorf("failed to delete NCC spoke [name=%s]: %w", name, err)
+	}
+
+	c.state.Delete(flowStateKeyNCCSpoke)
+	c.whiteboard.DeleteObject(ObjectKeyNCCSpoke)
+	return nil
+}
+
+func targetNCCSpoke(hub, network string, excludeExportRanges []string, labels map[string]string) *networkconnectivity.Spoke {
+	return &networkconnectivity.Spoke{
+		Hub:         hub,
+		Description: "VPC of the shoot managed by Gardener",
+		Labels:      labels,
+		LinkedVpcNetwork: &networkconnectivity.LinkedVpcNetwork{
+			Uri:                 network,
+			ExcludeExportRanges: excludeExportRanges,
+		},
+	}
+}
+
+func isSameNCCSpoke(current, desired *networkconnectivity.Spoke) bool {
+	if current.LinkedVpcNetwork == nil || !isSameResource(current.LinkedVpcNetwork.Uri, desired.LinkedVpcNetwork.Uri) {
+		return false
+	}
+	return nccHubID(current.Hub) == nccHubID(desired.Hub) &&
+		sets.New(current.LinkedVpcNetwork.ExcludeExportRanges...).Equal(sets.New(desired.LinkedVpcNetwork.ExcludeExportRanges...))
+}
+
+// nccHubID returns the name of the given hub without the project, as GCP may return the project number instead of the
+// project ID in the resource names of hubs.
+func nccHubID(hub string) string {
+	if _, id, ok := strings.Cut(hub, "/locations/"); ok {
+		return id
+	}
+	return hub
+}
@@ -0,0 +1,273 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// flowStateKeyOrphanedResourcesSweep is the key of the time of the last sweep for orphaned resources in the FlowState.
+	flowStateKeyOrphanedResourcesSweep = "orphanedResourcesSweep"
+
+	// orphanedResourcesSweepInterval is the minimum interval between two sweeps for orphaned resources.
+	orphanedResourcesSweepInterval = 6 * time.Hour
+	// orphanedResourceMinAge is the minimum age of resources which are considered to be orphaned, so that resources
+	// which are created concurrently, e.g. the disks of new machines, are not affected.
+	orphanedResourceMinAge = time.Hour
+	// csiDiskDescriptionMarker is contained in the description of the disks provisioned by the CSI driver for a
+	// PersistentVolume. These disks belong to the volumes of the shoot and are never considered to be orphaned.
+	csiDiskDescriptionMarker = "kubernetes.io/created-for/pv/name"
+	// bastionResourceMarker is contained in the names of the resources of bastions, which are managed by the bastion
+	// controller.
+	bastionResourceMarker = "-bastion-"
+)
+
+// orphanedResource is a resource of the shoot which is not part of the desired state of the infrastructure anymore.
+type orphanedResource struct {
+	description string
+	delete      func(context.Context) error
+}
+
+// OrphanedResources returns the resources of the shoot which were found to be orphaned by the last reconciliation and
+// whether the sweep was performed at all.
+func (c *FlowReconciler) OrphanedResources() ([]string, bool) {
+	if !c.whiteboard.HasObject(ObjectKeyOrphanedResources) {
+		return nil, false
+	}
+	return GetObject[[]string](c.whiteboard, ObjectKeyOrphanedResources), true
+}
+
+// ensureOrphanedResources sweeps for resources of the shoot which are not part of the desired state of the
+// infrastructure anymore, e.g. disks, addresses or firewall rules leaked by interrupted reconciliations. The resources
+// are only reported unless their deletion is requested by annotation. The sweep is best effort and never fails the
+// reconciliation.
+func (c *FlowReconciler) ensureOrphanedResources(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	if last, err := time.Parse(time.RFC3339, c.state.Get(flowStateKeyOrphanedResourcesSweep)); err == nil && time.Since(last) < orphanedResourcesSweepInterval {
+		return nil
+	}
+
+	var orphans []orphanedResource
+	for _, find := range []func(context.Context) ([]orphanedResource, error){
+		c.findOrphanedFirewallRules,
+		c.findOrphanedAddresses,
+		c.findOrphanedForwardingRules,
+		c.findOrphanedDisks,
+	} {
+		found, err := find(ctx)
+		if err != nil {
+			log.Error(err, "failed to sweep for orphaned resources")
+			return nil
+		}
+		orphans = append(orphans, found...)
+	}
+
+	var remaining []string
+	for _, orphan := range orphans {
+		if !c.deleteOrphanedResources {
+			log.Info(fmt.Sprintf("found orphaned %s", orphan.description))
+			remaining = append(remaining, orphan.description)
+			continue
+		}
+
+		log.Info(fmt.Sprintf("destroying orphaned %s", orphan.description))
+		if err := orphan.delete(ctx); err != nil {
+			log.Error(err, fmt.Sprintf("failed to delete orphaned %s", orphan.description))
+			remaining = append(remaining, orphan.description)
+		}
+	}
+
+	c.state.Set(flowStateKeyOrphanedResourcesSweep, time.Now().UTC().Format(time.RFC3339))
+	c.whiteboard.SetObject(ObjectKeyOrphanedResources, remaining)
+	return nil
+}
+
+// findOrphanedFirewallRules returns the firewall rules of the VPC which are named after the shoot but are neither
+// created by the infrastructure reconciliation nor by a bastion.
+func (c *FlowReconciler) findOrphanedFirewallRules(ctx context.Context) ([]orphanedResource, error) {
+	if err := c.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return nil, err
+	}
+	vpc := GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+
+	desired := sets.New(
+		firewallRuleAllowInternalName(c.clusterName),
+		firewallRuleAllowExternalName(c.clusterName),
+		firewallRuleAllowHealthChecksName(c.clusterName),
+	)
+	if c.ipv6SingleStack || c.dualStack {
+		for _, name := range desired.UnsortedList() {
+			desired.Insert(firewallRuleIPv6Name(name))
+		}
+	}
+	for _, rule := range c.config.Networks.AdditionalFirewallRules {
+		desired.Insert(c.additionalFirewallRuleName(rule.Name))
+	}
+	desired.Insert(c.createdAdditionalFirewallRules()...)
+
+	rules, err := c.computeClient.ListFirewallRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []orphanedResource
+	for _, rule := range rules {
+		if !strings.HasPrefix(rule.Name, c.clusterName+"-") || strings.Contains(rule.Name, bastionResourceMarker) ||
+			desired.Has(rule.Name) || !isSameResource(rule.Network, vpc.SelfLink) || !isOrphanCandidate(rule.CreationTimestamp) {
+			continue
+		}
+		name := rule.Name
+		orphans = append(orphans, orphanedResource{
+			description: fmt.Sprintf("firewall rule %s", name),
+			delete: func(ctx context.Context) error {
+				return c.computeClient.DeleteFirewallRule(ctx, name)
+			},
+		})
+	}
+	return orphans, nil
+}
+
+// findOrphanedAddresses returns the addresses of the NAT and of the Private Service Connect endpoints which are not
+// used or tracked by the infrastructure reconciliation anymore.
+func (c *FlowReconciler) findOrphanedAddresses(ctx context.Context) ([]orphanedResource, error) {
+	desired, err := c.desiredAddressNames()
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := c.computeClient.ListAddresses(ctx, c.infra.Spec.Region, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []orphanedResource
+	for _, address := range addresses {
+		if !c.isManagedAddressName(address.Name) || desired.Has(address.Name) || !isOrphanCandidate(address.CreationTimestamp) {
+			continue
+		}
+		name := address.Name
+		orphans = append(orphans, orphanedResource{
+			description: fmt.Sprintf("address %s", name),
+			delete: func(ctx context.Context) error {
+				return c.computeClient.DeleteAddress(ctx, c.infra.Spec.Region, name)
+			},
+		})
+	}
+	return orphans, nil
+}
+
+// findOrphanedForwardingRules returns the forwarding rules of Private Service Connect endpoints which are not
+// configured or tracked by the infrastructure reconciliation anymore.
+func (c *FlowReconciler) findOrphanedForwardingRules(ctx context.Context) ([]orphanedResource, error) {
+	desired := c.desiredPrivateServiceConnectEndpointNames()
+
+	rules, err := c.computeClient.ListForwardingRules(ctx, c.infra.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []orphanedResource
+	for _, rule := range rules {
+		if !strings.HasPrefix(rule.Name, c.privateServiceConnectEndpointName("")) || desired.Has(rule.Name) || !isOrphanCandidate(rule.CreationTimestamp) {
+			continue
+		}
+		name := rule.Name
+		orphans = append(orphans, orphanedResource{
+			description: fmt.Sprintf("forwarding rule %s", name),
+			delete: func(ctx context.Context) error {
+				return c.computeClient.DeleteForwardingRule(ctx, c.infra.Spec.Region, name)
+			},
+		})
+	}
+	return orphans, nil
+}
+
+// findOrphanedDisks returns the disks in the zones of the region which are labelled with the technical ID of the shoot
+// and are not attached to any instance. Disks provisioned by the CSI driver and disks of bastions are skipped.
+func (c *FlowReconciler) findOrphanedDisks(ctx context.Context) ([]orphanedResource, error) {
+	region, err := c.computeClient.GetRegion(ctx, c.infra.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []orphanedResource
+	for _, zoneURL := range region.Zones {
+		zone := path.Base(zoneURL)
+		disks, err := c.computeClient.ListDisks(ctx, zone, fmt.Sprintf("labels.%s=%q", gcpinternal.LabelKeyClusterName, c.clusterName))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, disk := range disks {
+			if len(disk.Users) > 0 || strings.Contains(disk.Description, csiDiskDescriptionMarker) ||
+				strings.Contains(disk.Name, bastionResourceMarker) || !isOrphanCandidate(disk.CreationTimestamp) {
+				continue
+			}
+			name := disk.Name
+			orphans = append(orphans, orphanedResource{
+				description: fmt.Sprintf("disk %s/%s", zone, name),
+				delete: func(ctx context.Context) error {
+					return c.computeClient.DeleteDisk(ctx, zone, name)
+				},
+			})
+		}
+	}
+	return orphans, nil
+}
+
+// desiredAddressNames returns the names of the addresses which are used or still tracked by the infrastructure
+// reconciliation, i.e. the NAT IPs including the ones which are drained or released, and the addresses of the Private
+// Service Connect endpoints.
+func (c *FlowReconciler) desiredAddressNames() (sets.Set[string], error) {
+	rotation, err := c.loadNatIPRotationState()
+	if err != nil {
+		return nil, err
+	}
+
+	desired := c.desiredPrivateServiceConnectEndpointNames()
+	desired.Insert(c.allocatedNatIPNames()...)
+	desired.Insert(c.natIPNames(rotation)...)
+	desired.Insert(c.ownedNatIPNames(rotation)...)
+	return desired, nil
+}
+
+func (c *FlowReconciler) desiredPrivateServiceConnectEndpointNames() sets.Set[string] {
+	desired := sets.New(c.createdPrivateServiceConnectEndpoints()...)
+	for _, endpoint := range c.config.Networks.PrivateServiceConnectEndpoints {
+		desired.Insert(c.privateServiceConnectEndpointName(endpoint.Name))
+	}
+	return desired
+}
+
+// isManagedAddressName returns whether the address with the given name is reserved by the extension, i.e. whether it
+// is a NAT IP reserved for the configured number of NAT IPs or a rotation, or the address of a Private Service Connect
+// endpoint.
+func (c *FlowReconciler) isManagedAddressName(name string) bool {
+	return slices.ContainsFunc([]string{
+		c.infra.Namespace + "-nat-",
+		c.privateServiceConnectEndpointName(""),
+	}, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// isOrphanCandidate returns whether a resource with the given creation timestamp is old enough to be considered to be
+// orphaned.
+func isOrphanCandidate(creationTimestamp string) bool {
+	created, err := time.Parse(time.RFC3339, creationTimestamp)
+	return err == nil && time.Since(created) >= orphanedResourceMinAge
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("Orphaned resources", func() {
+	const (
+		firewallsCollection       = "projects/project/global/firewalls"
+		addressesCollection       = "projects/project/regions/europe-west1/addresses"
+		forwardingRulesCollection = "projects/project/regions/europe-west1/forwardingRules"
+		disksCollection           = "projects/project/zones/europe-west1-b/disks"
+	)
+
+	var (
+		ctx    = context.Background()
+		server *fake.Server
+		infra  *extensionsv1alpha1.Infrastructure
+
+		old   = time.Now().Add(-2 * orphanedResourceMinAge).UTC().Format(time.RFC3339)
+		young = time.Now().UTC().Format(time.RFC3339)
+	)
+
+	reconcile := func() *FlowReconciler {
+		reconciler, _, err := reconcileWithFakeServer(ctx, server, infra)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return reconciler
+	}
+
+	// resetSweep removes the time of the last sweep from the state, so that the next reconciliation sweeps again.
+	resetSweep := func() {
+		state, err := NewFlowStateFromJSON(infra.Status.State.Raw)
+		Expect(err).NotTo(HaveOccurred())
+		delete(state.Data, flowStateKeyOrphanedResourcesSweep)
+		raw, err := state.ToJSON()
+		Expect(err).NotTo(HaveOccurred())
+		infra.Status.State = &runtime.RawExtension{Raw: raw}
+	}
+
+	names := func(collection string) []string {
+		var names []string
+		for _, resource := range server.List(collection) {
+			names = append(names, resource["name"].(string))
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		var err error
+		server, err = fake.NewServer()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(server.Close)
+
+		server.Put("projects/project/regions/europe-west1", map[string]any{
+			"zones": []any{
+				server.ComputeEndpoint() + "projects/project/zones/europe-west1-b",
+				server.ComputeEndpoint() + "projects/project/zones/europe-west1-c",
+			},
+		})
+
+		infra = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+			Networks: v1alpha1.NetworkConfig{
+				Workers:  "10.250.0.0/16",
+				CloudNAT: &v1alpha1.CloudNAT{NatIPCount: ptr.To[int32](1)},
+			},
+		})
+		reconciler := reconcile()
+		orphans, swept := reconciler.OrphanedResources()
+		Expect(swept).To(BeTrue())
+		Expect(orphans).To(BeEmpty())
+
+		// The resources of the infrastructure become old enough to be considered by the next sweep.
+		for _, collection := range []string{firewallsCollection, addressesCollection} {
+			for _, resource := range server.List(collection) {
+				resource["creationTimestamp"] = old
+				server.Put(strings.TrimPrefix(resource["selfLink"].(string), server.ComputeEndpoint()), resource)
+			}
+		}
+
+		vpc := server.ComputeEndpoint() + "projects/project/global/networks/" + fakeClusterName
+		server.Put(firewallsCollection+"/"+fakeClusterName+"-leaked", map[string]any{"network": vpc, "creationTimestamp": old})
+		server.Put(firewallsCollection+"/"+fakeClusterName+"-new", map[string]any{"network": vpc, "creationTimestamp": young})
+		server.Put(firewallsCollection+"/"+fakeClusterName+"-bastion-foo", map[string]any{"network": vpc, "creationTimestamp": old})
+		server.Put(firewallsCollection+"/"+fakeClusterName+"-other-vpc", map[string]any{"network": server.ComputeEndpoint() + "projects/project/global/networks/other", "creationTimestamp": old})
+		server.Put(firewallsCollection+"/other", map[string]any{"network": vpc, "creationTimestamp": old})
+
+		server.Put(addressesCollection+"/"+fakeClusterName+"-nat-leaked", map[string]any{"creationTimestamp": old})
+		server.Put(addressesCollection+"/other", map[string]any{"creationTimestamp": old})
+
+		server.Put(forwardingRulesCollection+"/"+fakeClusterName+"-psc-leaked", map[string]any{"creationTimestamp": old})
+
+		labels := map[string]any{gcpinternal.LabelKeyClusterName: fakeClusterName}
+		server.Put(disksCollection+"/leaked", map[string]any{"labels": labels, "creationTimestamp": old})
+		server.Put(disksCollection+"/attached", map[string]any{"labels": labels, "creationTimestamp": old, "users": []any{"instance"}})
+		server.Put(disksCollection+"/pv", map[string]any{"labels": labels, "creationTimestamp": old, "description": `{"kubernetes.io/created-for/pv/name":"pv"}`})
+		server.Put(disksCollection+"/"+fakeClusterName+"-bastion-foo", map[string]any{"labels": labels, "creationTimestamp": old})
+		server.Put("projects/project/zones/europe-west1-c/disks/other", map[string]any{"labels": map[string]any{gcpinternal.LabelKeyClusterName: "other"}, "creationTimestamp": old})
+
+		resetSweep()
+	})
+
+	It("should report the orphaned resources without deleting them", func() {
+		orphans, swept := reconcile().OrphanedResources()
+		Expect(swept).To(BeTrue())
+		Expect(orphans).To(ConsistOf(
+			"firewall rule "+fakeClusterName+"-leaked",
+			"address "+fakeClusterName+"-nat-leaked",
+			"forwarding rule "+fakeClusterName+"-psc-leaked",
+			"disk europe-west1-b/leaked",
+		))
+
+		Expect(names(firewallsCollection)).To(ContainElement(fakeClusterName + "-leaked"))
+		Expect(names(addressesCollection)).To(ContainElement(fakeClusterName + "-nat-leaked"))
+		Expect(names(forwardingRulesCollection)).To(ContainElement(fakeClusterName + "-psc-leaked"))
+		Expect(names(disksCollection)).To(ContainElement("leaked"))
+	})
+
+	It("should delete the orphaned resources if requested by annotation", func() {
+		infra.Annotations[gcpinternal.AnnotationKeyDeleteOrphanedResources] = "true"
+
+		orphans, swept := reconcile().OrphanedResources()
+		Expect(swept).To(BeTrue())
+		Expect(orphans).To(BeEmpty())
+
+		Expect(names(firewallsCollection)).NotTo(ContainElement(fakeClusterName + "-leaked"))
+		Expect(names(firewallsCollection)).To(ContainElements(
+			firewallRuleAllowInternalName(fakeClusterName),
+			fakeClusterName+"-new",
+			fakeClusterName+"-bastion-foo",
+			fakeClusterName+"-other-vpc",
+			"other",
+		))
+		Expect(names(addressesCollection)).To(ConsistOf(fakeClusterName+"-nat-ip-0", "other"))
+		Expect(names(forwardingRulesCollection)).To(BeEmpty())
+		Expect(names(disksCollection)).To(ConsistOf("attached", "pv", fakeClusterName+"-bastion-foo"))
+		Expect(names("projects/project/zones/europe-west1-c/disks")).To(ConsistOf("other"))
+	})
+
+	It("should report the resources which could not be deleted", func() {
+		infra.Annotations[gcpinternal.AnnotationKeyDeleteOrphanedResources] = "true"
+		server.Fail(http.MethodDelete, "/compute/v1/"+disksCollection+"/leaked", http.StatusForbidden)
+
+		orphans, swept := reconcile().OrphanedResources()
+		Expect(swept).To(BeTrue())
+		Expect(orphans).To(ConsistOf("disk europe-west1-b/leaked"))
+		Expect(names(disksCollection)).To(ContainElement("leaked"))
+	})
+
+	It("should only sweep once within the interval", func() {
+		reconcile()
+
+		infra.Annotations[gcpinternal.AnnotationKeyDeleteOrphanedResources] = "true"
+		_, swept := reconcile().OrphanedResources()
+		Expect(swept).To(BeFalse())
+		Expect(names(disksCollection)).To(ContainElement("leaked"))
+	})
+
+	It("should not fail the reconciliation if the sweep fails", func() {
+		server.Fail(http.MethodGet, "/compute/v1/"+disksCollection, http.StatusInternalServerError)
+
+		_, swept := reconcile().OrphanedResources()
+		Expect(swept).To(BeFalse())
+
+		state, err := NewFlowStateFromJSON(infra.Status.State.Raw)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(state.Data).NotTo(HaveKey(flowStateKeyOrphanedResourcesSweep))
+	})
+})
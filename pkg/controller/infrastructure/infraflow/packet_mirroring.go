@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+const (
+	// defaultPacketMirroringPriority is the priority of packet mirroring policies if none is configured.
+	defaultPacketMirroringPriority = 1000
+	// defaultPacketMirroringDirection is the direction of the mirrored traffic if none is configured.
+	defaultPacketMirroringDirection = "BOTH"
+)
+
+func (c *FlowReconciler) packetMirroringName() string {
+	return c.clusterName
+}
+
+// ensurePacketMirroring creates or updates the packet mirroring policy of the worker subnet. The policy is deleted if
+// packet mirroring is not configured (anymore).
+func (c *FlowReconciler) ensurePacketMirroring(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	if c.config.Networks.PacketMirroring == nil {
+		return c.ensurePacketMirroringDeleted(ctx)
+	}
+
+	if err := c.ensureObjectKeys(ObjectKeyVPC, ObjectKeyNodeSubnet); err != nil {
+		return err
+	}
+
+	var (
+		vpc     = GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+		subnet  = GetObject[*compute.Subnetwork](c.whiteboard, ObjectKeyNodeSubnet)
+		name    = c.packetMirroringName()
+		region  = c.infra.Spec.Region
+		desired = targetPacketMirroring(name, vpc.SelfLink, subnet.SelfLink, c.config.Networks.PacketMirroring)
+	)
+
+	mirroring, err := c.computeClient.GetPacketMirroring(ctx, region, name)
+	if err != nil {
+		return err
+	}
+
+	if mirroring == nil {
+		log.Info(fmt.Sprintf("creating packet mirroring policy [name=%s]", name))
+		if mirroring, err = c.computeClient.InsertPacketMirroring(ctx, region, desired); err != nil {
+			return fmt.Errorf("failed to create packet mirroring policy [name=%s]: %w", name, err)
+		}
+	} else if !packetMirroringEqual(mirroring, desired) {
+		log.Info(fmt.Sprintf("updating packet mirroring policy [name=%s]", name))
+		if mirroring, err = c.computeClient.PatchPacketMirroring(ctx, region, name, desired); err != nil {
+			return fmt.Errorf("failed to update packet mirroring policy [name=%s]: %w", name, err)
+		}
+	}
+
+	c.whiteboard.SetObject(ObjectKeyPacketMirroring, mirroring.Name)
+	return nil
+}
+
+// ensurePacketMirroringDeleted deletes the packet mirroring policy of the worker subnet.
+func (c *FlowReconciler) ensurePacketMirroringDeleted(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	name := c.packetMirroringName()
+	mirroring, err := c.computeClient.GetPacketMirroring(ctx, c.infra.Spec.Region, name)
+	if err != nil {
+		return err
+	}
+	if mirroring != nil {
+		log.Info(fmt.Sprintf("destroying packet mirroring policy [name=%s]", name))
+		if err := c.computeClient.DeletePacketMirroring(ctx, c.infra.Spec.Region, name); err != nil {
+			return fmt.Errorf("failed to delete packet mirroring policy [name=%s]: %w", name, err)
+		}
+	}
+
+	c.whiteboard.DeleteObject(ObjectKeyPacketMirroring)
+	return nil
+}
+
+func targetPacketMirroring(name, network, subnet string, config *gcp.PacketMirroring) *compute.PacketMirroring {
+	mirroring := &compute.PacketMirroring{
+		Name:         name,
+		Description:  "Packet mirroring of the worker subnet of the shoot managed by Gardener",
+		Network:      &compute.PacketMirroringNetworkInfo{Url: network},
+		CollectorIlb: &compute.PacketMirroringForwardingRuleInfo{Url: config.Collector},
+		MirroredResources: &compute.PacketMirroringMirroredResourceInfo{
+			Subnetworks: []*compute.PacketMirroringMirroredResourceInfoSubnetInfo{{Url: subnet}},
+		},
+		Filter:          &compute.PacketMirroringFilter{Direction: defaultPacketMirroringDirection},
+		Priority:        int64(ptr.Deref(config.Priority, defaultPacketMirroringPriority)),
+		Enable:          "TRUE",
+		ForceSendFields: []string{"Priority"},
+	}
+	if config.Filter != nil {
+		mirroring.Filter.CidrRanges = config.Filter.CIDRRanges
+		mirroring.Filter.IPProtocols = config.Filter.Protocols
+		mirroring.Filter.Direction = ptr.Deref(config.Filter.Direction, defaultPacketMirroringDirection)
+	}
+	// The filter fields are sent explicitly, so that removed ranges and protocols are also removed by a patch.
+	mirroring.Filter.ForceSendFields = []string{"CidrRanges", "IPProtocols"}
+	return mirroring
+}
+
+// packetMirroringEqual compares the managed fields of two packet mirroring policies.
+func packetMirroringEqual(current, desired *compute.PacketMirroring) bool {
+	if current.Priority != desired.Priority || current.Enable != desired.Enable {
+		return false
+	}
+	if current.CollectorIlb == nil || !isSameResource(current.CollectorIlb.Url, desired.CollectorIlb.Url) {
+		return false
+	}
+	if current.MirroredResources == nil || len(current.MirroredResources.Subnetworks) != 1 ||
+		len(current.MirroredResources.Instances) > 0 || len(current.MirroredResources.Tags) > 0 ||
+		!isSameResource(current.MirroredResources.Subnetworks[0].Url, desired.MirroredResources.Subnetworks[0].Url) {
+		return false
+	}
+	if current.Filter == nil {
+		return false
+	}
+	return current.Filter.Direction == desired.Filter.Direction &&
+		slices.Equal(current.Filter.CidrRanges, desired.Filter.CidrRanges) &&
+		slices.Equal(current.Filter.IPProtocols, desired.Filter.IPProtocols)
+}
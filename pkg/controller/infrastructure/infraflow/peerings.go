@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+)
+
+// flowStateKeyPeerings is the key of the names of the VPC peerings created by the extension in the FlowState.
+const flowStateKeyPeerings = "peerings"
+
+// peeringName returns the name of the VPC peering of the given configured peering.
+func (c *FlowReconciler) peeringName(name string) string {
+	return fmt.Sprintf("%s-%s", c.clusterName, name)
+}
+
+// createdPeerings returns the names of the VPC peerings which were created by the extension.
+func (c *FlowReconciler) createdPeerings() []string {
+	if data := c.state.Get(flowStateKeyPeerings); data != "" {
+		return strings.Split(data, ",")
+	}
+	return nil
+}
+
+func (c *FlowReconciler) storeCreatedPeerings(names []string) {
+	if len(names) == 0 {
+		c.state.Delete(flowStateKeyPeerings)
+		return
+	}
+	c.state.Set(flowStateKeyPeerings, strings.Join(names, ","))
+}
+
+// ensurePeerings creates or updates the VPC peerings of the InfrastructureConfig and removes the peerings which are not
+// configured anymore.
+func (c *FlowReconciler) ensurePeerings(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	if err := c.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+
+	var (
+		vpc     = GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+		created = c.createdPeerings()
+		names   []string
+	)
+
+	for _, peering := range c.config.Networks.Peerings {
+		desired := targetPeering(c.peeringName(peering.Name), peering)
+		names = append(names, desired.Name)
+		if !slices.Contains(created, desired.Name) {
+			created = append(created, desired.Name)
+			c.storeCreatedPeerings(created)
+		}
+
+		current := findPeering(vpc, desired.Name)
+		// The peer network of a peering cannot be changed, hence the peering is recreated.
+		if current != nil && !isSameResource(current.Network, desired.Network) {
+			log.Info(fmt.Sprintf("recreating VPC peering [name=%s] with network %s", desired.Name, desired.Network))
+			if err := c.computeClient.RemovePeering(ctx, vpc.Name, desired.Name); err != nil {
+				return err
+			}
+			current = nil
+		}
+
+		if current == nil {
+			log.Info(fmt.Sprintf("creating VPC peering [name=%s]", desired.Name))
+			if err := c.computeClient.AddPeering(ctx, vpc.Name, desired); err != nil {
+				return fmt.Errorf("failed to create VPC peering [name=%s]: %w", desired.Name, err)
+			}
+			continue
+		}
+		if current.ExportCustomRoutes != desired.ExportCustomRoutes || current.ImportCustomRoutes != desired.ImportCustomRoutes {
+			log.Info(fmt.Sprintf("updating VPC peering [name=%s]", desired.Name))
+			if err := c.computeClient.UpdatePeering(ctx, vpc.Name, desired); err != nil {
+				return fmt.Errorf("failed to update VPC peering [name=%s]: %w", desired.Name, err)
+			}
+		}
+	}
+
+	for _, name := range created {
+		if slices.Contains(names, name) || findPeering(vpc, name) == nil {
+			continue
+		}
+		log.Info(fmt.Sprintf("removing VPC peering [name=%s]", name))
+		if err := c.computeClient.RemovePeering(ctx, vpc.Name, name); err != nil {
+			return err
+		}
+	}
+	c.storeCreatedPeerings(names)
+
+	// The VPC is read again to determine the state of the peerings.
+	vpc, err := c.computeClient.GetNetwork(ctx, vpc.Name)
+	if err != nil {
+		return err
+	}
+	if vpc == nil {
+		return fmt.Errorf("failed to read VPC [name=%s]", c.vpcNameFromConfig())
+	}
+
+	var status []v1alpha1.VPCPeeringStatus
+	for _, peering := range c.config.Networks.Peerings {
+		if current := findPeering(vpc, c.peeringName(peering.Name)); current != nil {
+			status = append(status, v1alpha1.VPCPeeringStatus{Name: peering.Name, State: current.State})
+		}
+	}
+	c.whiteboard.SetObject(ObjectKeyPeerings, status)
+
+	return nil
+}
+
+// ensurePeeringsDeleted removes all VPC peerings of the shoot.
+func (c *FlowReconciler) ensurePeeringsDeleted(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	names := c.createdPeerings()
+	for _, peering := range c.config.Networks.Peerings {
+		if name := c.peeringName(peering.Name); !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	vpc, err := c.computeClient.GetNetwork(ctx, c.vpcNameFromConfig())
+	if err != nil {
+		return err
+	}
+	if vpc != nil {
+		for _, name := range names {
+			if findPeering(vpc, name) == nil {
+				continue
+			}
+			log.Info(fmt.Sprintf("removing VPC peering [name=%s]", name))
+			if err := c.computeClient.RemovePeering(ctx, vpc.Name, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.storeCreatedPeerings(nil)
+	c.whiteboard.DeleteObject(ObjectKeyPeerings)
+	return nil
+}
+
+func targetPeering(name string, peering gcp.VPCPeering) *compute.NetworkPeering {
+	return &compute.NetworkPeering{
+		Name:                 name,
+		Network:              peering.Network,
+		ExchangeSubnetRoutes: true,
+		ExportCustomRoutes:   ptr.Deref(peering.ExportCustomRoutes, false),
+		ImportCustomRoutes:   ptr.Deref(peering.ImportCustomRoutes, false),
+		ForceSendFields:      []string{"ExchangeSubnetRoutes", "ExportCustomRoutes", "ImportCustomRoutes"},
+	}
+}
+
+func findPeering(vpc *compute.Network, name string) *compute.NetworkPeering {
+	for _, peering := range vpc.Peerings {
+		if peering.Name == name {
+			return peering
+		}
+	}
+	return nil
+}
+
+// isSameResource returns whether the URL of a resource returned by GCP, which is always a full URL, refers to the
+// configured resource, which may be a partial URL.
+func isSameResource(current, desired string) bool {
+	return current == desired || strings.HasSuffix(current, "/"+strings.TrimPrefix(desired, "/"))
+}
@@ -0,0 +1,428 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// PlannedActionCreate is the action of a planned operation which creates a resource.
+	PlannedActionCreate = "Create"
+	// PlannedActionUpdate is the action of a planned operation which updates a resource.
+	PlannedActionUpdate = "Update"
+	// PlannedActionDelete is the action of a planned operation which deletes a resource.
+	PlannedActionDelete = "Delete"
+
+	computeBaseURL = "https://www.googleapis.com/compute/v1"
+)
+
+// Plan computes the operations which the reconciliation of the infrastructure would perform without performing them.
+// The GCP resources are read as usual, while all modifications are only recorded. Neither the state nor the status of
+// the infrastructure is changed.
+func (c *FlowReconciler) Plan(ctx context.Context) (*v1alpha1.InfrastructurePlan, error) {
+	recorder := &planRecorder{}
+	c.computeClient = &plannedComputeClient{ComputeClient: c.computeClient, recorder: recorder, projectID: c.serviceAccount.ProjectID}
+	c.iamClient = &plannedIAMClient{IAMClient: c.iamClient, recorder: recorder, projectID: c.serviceAccount.ProjectID}
+	c.dnsClient = &plannedDNSClient{DNSClient: c.dnsClient, recorder: recorder}
+	c.nccClient = &plannedNetworkConnectivityClient{NetworkConnectivityClient: c.nccClient, recorder: recorder}
+
+	c.Log.Info("starting Flow Reconciliation in plan mode")
+	g := c.buildReconcileGraph()
+	f := g.Compile()
+	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
+		return nil, err
+	}
+
+	return &v1alpha1.InfrastructurePlan{
+		Time:       metav1.Now(),
+		Operations: recorder.operations,
+	}, nil
+}
+
+// planRecorder records the operations of a reconciliation in plan mode. The tasks of the reconciliation run
+// concurrently, hence the access is synchronized.
+type planRecorder struct {
+	lock       sync.Mutex
+	operations []v1alpha1.PlannedOperation
+}
+
+func (r *planRecorder) record(action, resource, name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.operations = append(r.operations, v1alpha1.PlannedOperation{Action: action, Resource: resource, Name: name})
+}
+
+// plannedComputeClient reads the compute resources with the wrapped client and records all modifications instead of
+// performing them. Created and patched resources are returned as desired with their self links, so that dependent
+// resources can be planned, too.
+type plannedComputeClient struct {
+	gcpclient.ComputeClient
+	recorder  *planRecorder
+	projectID string
+}
+
+func (c *plannedComputeClient) InsertAddress(_ context.Context, region string, address *gcpclient.Address) (*gcpclient.Address, error) {
+	c.recorder.record(PlannedActionCreate, "Address", address.Name)
+	created := *address
+	created.SelfLink = fmt.Sprintf("%s/projects/%s/regions/%s/addresses/%s", computeBaseURL, c.projectID, region, address.Name)
+	return &created, nil
+}
+
+func (c *plannedComputeClient) DeleteAddress(ctx context.Context, region, name string) error {
+	address, err := c.GetAddress(ctx, region, name)
+	if err != nil || address == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "Address", name)
+	return nil
+}
+
+func (c *plannedComputeClient) SetAddressLabels(_ context.Context, _ string, address *gcpclient.Address, _ map[string]string) error {
+	c.recorder.record(PlannedActionUpdate, "Address", address.Name)
+	return nil
+}
+
+func (c *plannedComputeClient) InsertForwardingRule(_ context.Context, region string, rule *gcpclient.ForwardingRule) (*gcpclient.ForwardingRule, error) {
+	c.recorder.record(PlannedActionCreate, "ForwardingRule", rule.Name)
+	created := *rule
+	created.SelfLink = fmt.Sprintf("%s/projects/%s/regions/%s/forwardingRules/%s", computeBaseURL, c.projectID, region, rule.Name)
+	return &created, nil
+}
+
+func (c *plannedComputeClient) DeleteForwardingRule(ctx context.Context, region, name string) error {
+	rule, err := c.GetForwardingRule(ctx, region, name)
+	if err != nil || rule == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "ForwardingRule", name)
+	return nil
+}
+
+func (c *plannedComputeClient) InsertNetwork(_ context.Context, nw *gcpclient.Network) (*gcpclient.Network, error) {
+	c.recorder.record(PlannedActionCreate, "Network", nw.Name)
+	created := *nw
+	created.SelfLink = fmt.Sprintf("%s/projects/%s/global/networks/%s", computeBaseURL, c.projectID, nw.Name)
+	return &created, nil
+}
+
+func (c *plannedComputeClient) DeleteNetwork(ctx context.Context, id string) error {
+	nw, err := c.GetNetwork(ctx, id)
+	if err != nil || nw == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "Network", id)
+	return nil
+}
+
+func (c *plannedComputeClient) PatchNetwork(ctx context.Context, id string, nw *gcpclient.Network) (*gcpclient.Network, error) {
+	current, err := c.GetNetwork(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.record(PlannedActionUpdate, "Network", id)
+	patched := *nw
+	if current != nil {
+		patched.SelfLink = current.SelfLink
+	}
+	return &patched, nil
+}
+
+func (c *plannedComputeClient) AddPeering(_ context.Context, id string, peering *gcpclient.NetworkPeering) error {
+	c.recorder.record(PlannedActionCreate, "NetworkPeering", id+"/"+peering.Name)
+	return nil
+}
+
+func (c *plannedComputeClient) UpdatePeering(_ context.Context, id string, peering *gcpclient.NetworkPeering) error {
+	c.recorder.record(PlannedActionUpdate, "NetworkPeering", id+"/"+peering.Name)
+	return nil
+}
+
+func (c *plannedComputeClient) RemovePeering(_ context.Context, id, name string) error {
+	c.recorder.record(PlannedActionDelete, "NetworkPeering", id+"/"+name)
+	return nil
+}
+
+func (c *plannedComputeClient) InsertSubnet(_ context.Context, region string, subnet *gcpclient.Subnetwork) (*gcpclient.Subnetwork, error) {
+	c.recorder.record(PlannedActionCreate, "Subnetwork", subnet.Name)
+	created := *subnet
+	created.SelfLink = fmt.Sprintf("%s/projects/%s/regions/%s/subnetworks/%s", computeBaseURL, c.projectID, region, subnet.Name)
+	return &created, nil
+}
+
+func (c *plannedComputeClient) PatchSubnet(ctx context.Context, region, id string, subnet *gcpclient.Subnetwork) (*gcpclient.Subnetwork, error) {
+	current, err := c.GetSubnet(ctx, region, id)
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.record(PlannedActionUpdate, "Subnetwork", id)
+	patched := *subnet
+	if current != nil {
+		patched.SelfLink = current.SelfLink
+	}
+	return &patched, nil
+}
+
+func (c *plannedComputeClient) DeleteSubnet(ctx context.Context, region, id string) error {
+	subnet, err := c.GetSubnet(ctx, region, id)
+	if err != nil || subnet == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "Subnetwork", id)
+	return nil
+}
+
+func (c *plannedComputeClient) ExpandSubnet(ctx context.Context, region, id, _ string) (*gcpclient.Subnetwork, error) {
+	c.recorder.record(PlannedActionUpdate, "Subnetwork", id)
+	return c.GetSubnet(ctx, region, id)
+}
+
+func (c *plannedComputeClient) SetSubnetPrivateIpGoogleAccess(ctx context.Context, region, id string, _ bool) (*gcpclient.Subnetwork, error) {
+	c.recorder.record(PlannedActionUpdate, "Subnetwork", id)
+	return c.GetSubnet(ctx, region, id)
+}
+
+func (c *plannedComputeClient) InsertRouter(_ context.Context, region string, router *gcpclient.Router) (*gcpclient.Router, error) {
+	c.recorder.record(PlannedActionCreate, "Router", router.Name)
+	created := *router
+	created.SelfLink = fmt.Sprintf("%s/projects/%s/regions/%s/routers/%s", computeBaseURL, c.projectID, region, router.Name)
+	return &created, nil
+}
+
+func (c *plannedComputeClient) PatchRouter(ctx context.Context, region, id string, router *gcpclient.Router) (*gcpclient.Router, error) {
+	current, err := c.GetRouter(ctx, region, id)
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.record(PlannedActionUpdate, "Router", id)
+	patched := *router
+	if current != nil {
+		patched.SelfLink = current.SelfLink
+	}
+	return &patched, nil
+}
+
+func (c *plannedComputeClient) DeleteRouter(ctx context.Context, region, id string) error {
+	router, err := c.GetRouter(ctx, region, id)
+	if err != nil || router == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "Router", id)
+	return nil
+}
+
+func (c *plannedComputeClient) DeleteRoute(_ context.Context, id string) error {
+	c.recorder.record(PlannedActionDelete, "Route", id)
+	return nil
+}
+
+func (c *plannedComputeClient) InsertFirewallRule(_ context.Context, firewall *gcpclient.Firewall) (*gcpclient.Firewall, error) {
+	c.recorder.record(PlannedActionCreate, "FirewallRule", firewall.Name)
+	created := *firewall
+	created.SelfLink = fmt.Sprintf("%s/projects/%s/global/firewalls/%s", computeBaseURL, c.projectID, firewall.Name)
+	return &created, nil
+}
+
+func (c *plannedComputeClient) PatchFirewallRule(ctx context.Context, name string, firewall *gcpclient.Firewall) (*gcpclient.Firewall, error) {
+	current, err := c.GetFirewallRule(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.record(PlannedActionUpdate, "FirewallRule", name)
+	patched := *firewall
+	if current != nil {
+		patched.SelfLink = current.SelfLink
+	}
+	return &patched, nil
+}
+
+func (c *plannedComputeClient) DeleteFirewallRule(ctx context.Context, name string) error {
+	firewall, err := c.GetFirewallRule(ctx, name)
+	if err != nil || firewall == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "FirewallRule", name)
+	return nil
+}
+
+func (c *plannedComputeClient) InsertNetworkFirewallPolicy(_ context.Context, policy *gcpclient.FirewallPolicy) (*gcpclient.FirewallPolicy, error) {
+	c.recorder.record(PlannedActionCreate, "NetworkFirewallPolicy", policy.Name)
+	created := *policy
+	created.SelfLink = fmt.Sprintf("%s/projects/%s/global/firewallPolicies/%s", computeBaseURL, c.projectID, policy.Name)
+	return &created, nil
+}
+
+func (c *plannedComputeClient) DeleteNetworkFirewallPolicy(ctx context.Context, name string) error {
+	policy, err := c.GetNetworkFirewallPolicy(ctx, name)
+	if err != nil || policy == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "NetworkFirewallPolicy", name)
+	return nil
+}
+
+func (c *plannedComputeClient) AddNetworkFirewallPolicyRule(_ context.Context, name string, rule *gcpclient.FirewallPolicyRule) error {
+	c.recorder.record(PlannedActionCreate, "NetworkFirewallPolicyRule", fmt.Sprintf("%s/%d", name, rule.Priority))
+	return nil
+}
+
+func (c *plannedComputeClient) PatchNetworkFirewallPolicyRule(_ context.Context, name string, rule *gcpclient.FirewallPolicyRule) error {
+	c.recorder.record(PlannedActionUpdate, "NetworkFirewallPolicyRule", fmt.Sprintf("%s/%d", name, rule.Priority))
+	return nil
+}
+
+func (c *plannedComputeClient) RemoveNetworkFirewallPolicyRule(_ context.Context, name string, priority int64) error {
+	c.recorder.record(PlannedActionDelete, "NetworkFirewallPolicyRule", fmt.Sprintf("%s/%d", name, priority))
+	return nil
+}
+
+func (c *plannedComputeClient) AddNetworkFirewallPolicyAssociation(_ context.Context, name string, association *gcpclient.FirewallPolicyAssociation) error {
+	c.recorder.record(PlannedActionCreate, "NetworkFirewallPolicyAssociation", name+"/"+association.Name)
+	return nil
+}
+
+func (c *plannedComputeClient) RemoveNetworkFirewallPolicyAssociation(_ context.Context, name, associationName string) error {
+	c.recorder.record(PlannedActionDelete, "NetworkFirewallPolicyAssociation", name+"/"+associationName)
+	return nil
+}
+
+func (c *plannedComputeClient) InsertPacketMirroring(_ context.Context, region string, mirroring *gcpclient.PacketMirroring) (*gcpclient.PacketMirroring, error) {
+	c.recorder.record(PlannedActionCreate, "PacketMirroring", mirroring.Name)
+	created := *mirroring
+	created.SelfLink = fmt.Sprintf("%s/projects/%s/regions/%s/packetMirrorings/%s", computeBaseURL, c.projectID, region, mirroring.Name)
+	return &created, nil
+}
+
+func (c *plannedComputeClient) PatchPacketMirroring(ctx context.Context, region, name string, mirroring *gcpclient.PacketMirroring) (*gcpclient.PacketMirroring, error) {
+	current, err := c.GetPacketMirroring(ctx, region, name)
+	if err != nil {
+		return nil, err
+	}
+	c.recorder.record(PlannedActionUpdate, "PacketMirroring", name)
+	patched := *mirroring
+	if current != nil {
+		patched.SelfLink = current.SelfLink
+	}
+	return &patched, nil
+}
+
+func (c *plannedComputeClient) DeletePacketMirroring(_ context.Context, _, name string) error {
+	c.recorder.record(PlannedActionDelete, "PacketMirroring", name)
+	return nil
+}
+
+func (c *plannedComputeClient) SetInstanceDeletionProtection(_ context.Context, zone, instance string, _ bool) error {
+	c.recorder.record(PlannedActionUpdate, "Instance", zone+"/"+instance)
+	return nil
+}
+
+func (c *plannedComputeClient) DeleteDisk(_ context.Context, zone, name string) error {
+	c.recorder.record(PlannedActionDelete, "Disk", zone+"/"+name)
+	return nil
+}
+
+func (c *plannedComputeClient) CreateDiskSnapshot(_ context.Context, _, _, snapshot string) error {
+	c.recorder.record(PlannedActionCreate, "Snapshot", snapshot)
+	return nil
+}
+
+func (c *plannedComputeClient) DeleteSnapshot(_ context.Context, name string) error {
+	c.recorder.record(PlannedActionDelete, "Snapshot", name)
+	return nil
+}
+
+// plannedIAMClient reads the IAM resources with the wrapped client and records all modifications instead of performing
+// them.
+type plannedIAMClient struct {
+	gcpclient.IAMClient
+	recorder  *planRecorder
+	projectID string
+}
+
+func (c *plannedIAMClient) CreateServiceAccount(_ context.Context, accountID string) (*gcpclient.ServiceAccount, error) {
+	c.recorder.record(PlannedActionCreate, "ServiceAccount", accountID)
+	email := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", accountID, c.projectID)
+	return &gcpclient.ServiceAccount{
+		Name:  fmt.Sprintf("projects/%s/serviceAccounts/%s", c.projectID, email),
+		Email: email,
+	}, nil
+}
+
+func (c *plannedIAMClient) DeleteServiceAccount(_ context.Context, name string) error {
+	c.recorder.record(PlannedActionDelete, "ServiceAccount", name)
+	return nil
+}
+
+// UpdateProjectRoleBindings records an update of the role bindings of the member. It is only called if the roles differ
+// from the ones recorded in the FlowState.
+func (c *plannedIAMClient) UpdateProjectRoleBindings(_ context.Context, member string, _, _ []string) error {
+	c.recorder.record(PlannedActionUpdate, "ProjectRoleBindings", member)
+	return nil
+}
+
+// plannedDNSClient reads the Cloud DNS resources with the wrapped client and records all modifications instead of
+// performing them.
+type plannedDNSClient struct {
+	gcpclient.DNSClient
+	recorder *planRecorder
+}
+
+// CreateOrUpdateRecordSet records an update of the record set. Record sets are applied idempotently, hence the update
+// is always planned.
+func (c *plannedDNSClient) CreateOrUpdateRecordSet(_ context.Context, managedZone, name, recordType string, _ []string, _ int64) error {
+	c.recorder.record(PlannedActionUpdate, "ResourceRecordSet", fmt.Sprintf("%s/%s/%s", managedZone, name, recordType))
+	return nil
+}
+
+func (c *plannedDNSClient) DeleteRecordSet(_ context.Context, managedZone, name, recordType string) error {
+	c.recorder.record(PlannedActionDelete, "ResourceRecordSet", fmt.Sprintf("%s/%s/%s", managedZone, name, recordType))
+	return nil
+}
+
+func (c *plannedDNSClient) CreateManagedZone(_ context.Context, zone *gcpclient.ManagedZone) (*gcpclient.ManagedZone, error) {
+	c.recorder.record(PlannedActionCreate, "ManagedZone", zone.Name)
+	return zone, nil
+}
+
+func (c *plannedDNSClient) PatchManagedZone(_ context.Context, name string, _ *gcpclient.ManagedZone) error {
+	c.recorder.record(PlannedActionUpdate, "ManagedZone", name)
+	return nil
+}
+
+func (c *plannedDNSClient) DeleteManagedZone(ctx context.Context, name string) error {
+	zone, err := c.GetManagedZone(ctx, name)
+	if err != nil || zone == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "ManagedZone", name)
+	return nil
+}
+
+type plannedNetworkConnectivityClient struct {
+	gcpclient.NetworkConnectivityClient
+	recorder *planRecorder
+}
+
+func (c *plannedNetworkConnectivityClient) CreateSpoke(_ context.Context, id string, spoke *gcpclient.Spoke) (*gcpclient.Spoke, error) {
+	c.recorder.record(PlannedActionCreate, "Spoke", id)
+	return spoke, nil
+}
+
+func (c *plannedNetworkConnectivityClient) DeleteSpoke(ctx context.Context, id string) error {
+	spoke, err := c.GetSpoke(ctx, id)
+	if err != nil || spoke == nil {
+		return err
+	}
+	c.recorder.record(PlannedActionDelete, "Spoke", id)
+	return nil
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Plan", func() {
+	const (
+		project = "project"
+		region  = "europe-west1"
+	)
+
+	var (
+		ctx  = context.Background()
+		ctrl *gomock.Controller
+
+		recorder      *planRecorder
+		computeClient *mockgcpclient.MockComputeClient
+		dnsClient     *mockgcpclient.MockDNSClient
+
+		plannedCompute *plannedComputeClient
+		plannedIAM     *plannedIAMClient
+		plannedDNS     *plannedDNSClient
+	)
+
+	BeforeEach(func() {
+		// All modifying calls are unexpected for the mocks, i.e. the tests fail if the planned clients perform them.
+		ctrl = gomock.NewController(GinkgoT())
+		recorder = &planRecorder{}
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+		dnsClient = mockgcpclient.NewMockDNSClient(ctrl)
+
+		plannedCompute = &plannedComputeClient{ComputeClient: computeClient, recorder: recorder, projectID: project}
+		plannedIAM = &plannedIAMClient{recorder: recorder, projectID: project}
+		plannedDNS = &plannedDNSClient{DNSClient: dnsClient, recorder: recorder}
+	})
+
+	Describe("plannedComputeClient", func() {
+		It("should plan the creation of resources and return them with their self links", func() {
+			network, err := plannedCompute.InsertNetwork(ctx, &gcpclient.Network{Name: "vpc"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(network.SelfLink).To(Equal("https://www.googleapis.com/compute/v1/projects/project/global/networks/vpc"))
+
+			subnet, err := plannedCompute.InsertSubnet(ctx, region, &gcpclient.Subnetwork{Name: "nodes", Network: network.SelfLink})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnet.SelfLink).To(Equal("https://www.googleapis.com/compute/v1/projects/project/regions/europe-west1/subnetworks/nodes"))
+			Expect(subnet.Network).To(Equal(network.SelfLink))
+
+			address, err := plannedCompute.InsertAddress(ctx, region, &gcpclient.Address{Name: "nat"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(address.SelfLink).To(Equal("https://www.googleapis.com/compute/v1/projects/project/regions/europe-west1/addresses/nat"))
+
+			Expect(recorder.operations).To(Equal([]v1alpha1.PlannedOperation{
+				{Action: PlannedActionCreate, Resource: "Network", Name: "vpc"},
+				{Action: PlannedActionCreate, Resource: "Subnetwork", Name: "nodes"},
+				{Action: PlannedActionCreate, Resource: "Address", Name: "nat"},
+			}))
+		})
+
+		It("should plan updates and keep the self links of the current resources", func() {
+			computeClient.EXPECT().GetRouter(ctx, region, "router").Return(&gcpclient.Router{Name: "router", SelfLink: "router-link"}, nil)
+			computeClient.EXPECT().GetFirewallRule(ctx, "fw").Return(&gcpclient.Firewall{Name: "fw", SelfLink: "fw-link"}, nil)
+
+			router, err := plannedCompute.PatchRouter(ctx, region, "router", &gcpclient.Router{Name: "router", Network: "vpc"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(router.SelfLink).To(Equal("router-link"))
+			Expect(router.Network).To(Equal("vpc"))
+
+			firewall, err := plannedCompute.PatchFirewallRule(ctx, "fw", &gcpclient.Firewall{Name: "fw", Priority: 1000})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(firewall.SelfLink).To(Equal("fw-link"))
+			Expect(firewall.Priority).To(BeEquivalentTo(1000))
+
+			Expect(recorder.operations).To(Equal([]v1alpha1.PlannedOperation{
+				{Action: PlannedActionUpdate, Resource: "Router", Name: "router"},
+				{Action: PlannedActionUpdate, Resource: "FirewallRule", Name: "fw"},
+			}))
+		})
+
+		It("should return the current subnet for planned in-place updates", func() {
+			current := &gcpclient.Subnetwork{Name: "nodes", IpCidrRange: "10.250.0.0/19"}
+			computeClient.EXPECT().GetSubnet(ctx, region, "nodes").Return(current, nil).Times(2)
+
+			Expect(plannedCompute.ExpandSubnet(ctx, region, "nodes", "10.250.0.0/16")).To(Equal(current))
+			Expect(plannedCompute.SetSubnetPrivateIpGoogleAccess(ctx, region, "nodes", true)).To(Equal(current))
+			Expect(recorder.operations).To(Equal([]v1alpha1.PlannedOperation{
+				{Action: PlannedActionUpdate, Resource: "Subnetwork", Name: "nodes"},
+				{Action: PlannedActionUpdate, Resource: "Subnetwork", Name: "nodes"},
+			}))
+		})
+
+		It("should only plan the deletion of existing resources", func() {
+			computeClient.EXPECT().GetAddress(ctx, region, "present").Return(&gcpclient.Address{Name: "present"}, nil)
+			computeClient.EXPECT().GetAddress(ctx, region, "absent").Return(nil, nil)
+			computeClient.EXPECT().GetNetwork(ctx, "vpc").Return(nil, nil)
+
+			Expect(plannedCompute.DeleteAddress(ctx, region, "present")).To(Succeed())
+			Expect(plannedCompute.DeleteAddress(ctx, region, "absent")).To(Succeed())
+			Expect(plannedCompute.DeleteNetwork(ctx, "vpc")).To(Succeed())
+			Expect(recorder.operations).To(Equal([]v1alpha1.PlannedOperation{
+				{Action: PlannedActionDelete, Resource: "Address", Name: "present"},
+			}))
+		})
+
+		It("should return the errors of reading the current resources", func() {
+			computeClient.EXPECT().GetNetwork(ctx, "vpc").Return(nil, fmt.Errorf("fake"))
+			computeClient.EXPECT().GetSubnet(ctx, region, "nodes").Return(nil, fmt.Errorf("fake"))
+
+			Expect(plannedCompute.DeleteNetwork(ctx, "vpc")).To(MatchError("fake"))
+			_, err := plannedCompute.PatchSubnet(ctx, region, "nodes", &gcpclient.Subnetwork{})
+			Expect(err).To(MatchError("fake"))
+			Expect(recorder.operations).To(BeEmpty())
+		})
+
+		It("should name the operations of sub-resources after their parents", func() {
+			Expect(plannedCompute.AddPeering(ctx, "vpc", &gcpclient.NetworkPeering{Name: "peer"})).To(Succeed())
+			Expect(plannedCompute.AddNetworkFirewallPolicyRule(ctx, "policy", &gcpclient.FirewallPolicyRule{Priority: 100})).To(Succeed())
+			Expect(plannedCompute.RemoveNetworkFirewallPolicyAssociation(ctx, "policy", "vpc")).To(Succeed())
+			Expect(plannedCompute.SetInstanceDeletionProtection(ctx, "europe-west1-b", "node", true)).To(Succeed())
+
+			Expect(recorder.operations).To(Equal([]v1alpha1.PlannedOperation{
+				{Action: PlannedActionCreate, Resource: "NetworkPeering", Name: "vpc/peer"},
+				{Action: PlannedActionCreate, Resource: "NetworkFirewallPolicyRule", Name: "policy/100"},
+				{Action: PlannedActionDelete, Resource: "NetworkFirewallPolicyAssociation", Name: "policy/vpc"},
+				{Action: PlannedActionUpdate, Resource: "Instance", Name: "europe-west1-b/node"},
+			}))
+		})
+	})
+
+	Describe("plannedIAMClient", func() {
+		It("should plan the creation of the service account and return its email", func() {
+			serviceAccount, err := plannedIAM.CreateServiceAccount(ctx, "shoot")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceAccount.Email).To(Equal("shoot@project.iam.gserviceaccount.com"))
+			Expect(serviceAccount.Name).To(Equal("projects/project/serviceAccounts/shoot@project.iam.gserviceaccount.com"))
+
+			Expect(plannedIAM.UpdateProjectRoleBindings(ctx, "serviceAccount:"+serviceAccount.Email, []string{"roles/logging.logWriter"}, nil)).To(Succeed())
+			Expect(plannedIAM.DeleteServiceAccount(ctx, "shoot")).To(Succeed())
+
+			Expect(recorder.operations).To(Equal([]v1alpha1.PlannedOperation{
+				{Action: PlannedActionCreate, Resource: "ServiceAccount", Name: "shoot"},
+				{Action: PlannedActionUpdate, Resource: "ProjectRoleBindings", Name: "serviceAccount:shoot@project.iam.gserviceaccount.com"},
+				{Action: PlannedActionDelete, Resource: "ServiceAccount", Name: "shoot"},
+			}))
+		})
+	})
+
+	Describe("plannedDNSClient", func() {
+		It("should plan the changes of record sets and managed zones", func() {
+			dnsClient.EXPECT().GetManagedZone(ctx, "absent").Return(nil, nil)
+			dnsClient.EXPECT().GetManagedZone(ctx, "present").Return(&gcpclient.ManagedZone{Name: "present"}, nil)
+
+			zone, err := plannedDNS.CreateManagedZone(ctx, &gcpclient.ManagedZone{Name: "zone"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zone.Name).To(Equal("zone"))
+			Expect(plannedDNS.CreateOrUpdateRecordSet(ctx, "zone", "api.example.com.", "A", []string{"10.0.0.1"}, 120)).To(Succeed())
+			Expect(plannedDNS.DeleteManagedZone(ctx, "absent")).To(Succeed())
+			Expect(plannedDNS.DeleteManagedZone(ctx, "present")).To(Succeed())
+
+			Expect(recorder.operations).To(Equal([]v1alpha1.PlannedOperation{
+				{Action: PlannedActionCreate, Resource: "ManagedZone", Name: "zone"},
+				{Action: PlannedActionUpdate, Resource: "ResourceRecordSet", Name: "zone/api.example.com./A"},
+				{Action: PlannedActionDelete, Resource: "ManagedZone", Name: "present"},
+			}))
+		})
+	})
+
+	Describe("plannedNetworkConnectivityClient", func() {
+		It("should only plan the deletion of existing spokes", func() {
+			planned := &plannedNetworkConnectivityClient{NetworkConnectivityClient: &fakeNetworkConnectivityClient{spokes: map[string]*gcpclient.Spoke{"present": {}}}, recorder: recorder}
+
+			Expect(planned.CreateSpoke(ctx, "new", &gcpclient.Spoke{})).NotTo(BeNil())
+			Expect(planned.DeleteSpoke(ctx, "present")).To(Succeed())
+			Expect(planned.DeleteSpoke(ctx, "absent")).To(Succeed())
+
+			Expect(recorder.operations).To(Equal([]v1alpha1.PlannedOperation{
+				{Action: PlannedActionCreate, Resource: "Spoke", Name: "new"},
+				{Action: PlannedActionDelete, Resource: "Spoke", Name: "present"},
+			}))
+		})
+	})
+
+	Describe("planRecorder", func() {
+		It("should record operations concurrently", func() {
+			var wg sync.WaitGroup
+			for i := range 100 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					recorder.record(PlannedActionCreate, "FirewallRule", fmt.Sprintf("fw-%d", i))
+				}()
+			}
+			wg.Wait()
+
+			Expect(recorder.operations).To(HaveLen(100))
+		})
+	})
+})
+
+// fakeNetworkConnectivityClient returns the given spokes and fails all modifications.
+type fakeNetworkConnectivityClient struct {
+	gcpclient.NetworkConnectivityClient
+	spokes map[string]*gcpclient.Spoke
+}
+
+func (c *fakeNetworkConnectivityClient) GetSpoke(_ context.Context, id string) (*gcpclient.Spoke, error) {
+	return c.spokes[id], nil
+}
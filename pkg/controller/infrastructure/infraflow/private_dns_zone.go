@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/dns/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+const (
+	// flowStateKeyPrivateDNSZone is the key of the name of the private Cloud DNS zone created by the extension in the
+	// FlowState.
+	flowStateKeyPrivateDNSZone = "privateDNSZone"
+
+	// defaultPrivateDNSRecordTTL is the TTL of the record of the kube-apiserver if the DNSRecord does not specify one.
+	defaultPrivateDNSRecordTTL = 120
+)
+
+// privateDNSRecordTypes are the record types which the DNSRecord of the internal domain of the kube-apiserver may have.
+var privateDNSRecordTypes = []extensionsv1alpha1.DNSRecordType{
+	extensionsv1alpha1.DNSRecordTypeA,
+	extensionsv1alpha1.DNSRecordTypeAAAA,
+	extensionsv1alpha1.DNSRecordTypeCNAME,
+}
+
+func isPrivateDNSZoneEnabled(config *gcp.InfrastructureConfig) bool {
+	return config.Networks.PrivateDNSZone != nil && config.Networks.PrivateDNSZone.Enabled
+}
+
+// getInternalDNSRecord returns the DNSRecord of the internal domain of the kube-apiserver of the shoot or nil if it does
+// not exist yet.
+func getInternalDNSRecord(ctx context.Context, c client.Client, namespace, shootName string) (*extensionsv1alpha1.DNSRecord, error) {
+	record := &extensionsv1alpha1.DNSRecord{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: shootName + "-" + v1beta1constants.DNSRecordInternalName}, record); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return record, nil
+}
+
+func (c *FlowReconciler) privateDNSZoneName() string {
+	return c.clusterName
+}
+
+// ensurePrivateDNSZone creates the private Cloud DNS zone of the internal domain of the shoot, binds it to the VPC and
+// registers the record of the kube-apiserver in it. The zone is deleted if it is not enabled (anymore).
+func (c *FlowReconciler) ensurePrivateDNSZone(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	if !isPrivateDNSZoneEnabled(c.config) {
+		return c.ensurePrivateDNSZoneDeleted(ctx)
+	}
+
+	name := c.privateDNSZoneName()
+	if c.internalDNSRecord == nil {
+		// The DNSRecord is created once the kube-apiserver is exposed, i.e. after the first reconciliation of the
+		// infrastructure. The zone is created by the next reconciliation.
+		log.Info("internal DNS record of the shoot does not exist yet, skipping private DNS zone")
+		if c.state.Get(flowStateKeyPrivateDNSZone) != "" {
+			c.whiteboard.SetObject(ObjectKeyPrivateDNSZone, name)
+		}
+		return nil
+	}
+
+	if err := c.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+
+	var (
+		vpc     = GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+		record  = c.internalDNSRecord
+		dnsName = ensureTrailingDot(strings.TrimPrefix(record.Spec.Name, "api."))
+	)
+
+	zone, err := c.dnsClient.GetManagedZone(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	// The DNS name of a zone is immutable, hence the zone is recreated if the internal domain of the shoot changed.
+	if zone != nil && zone.DnsName != dnsName {
+		c.state.Set(flowStateKeyPrivateDNSZone, name)
+		if err := c.ensurePrivateDNSZoneDeleted(ctx); err != nil {
+			return err
+		}
+		zone = nil
+	}
+
+	if zone == nil {
+		log.Info(fmt.Sprintf("creating private DNS zone [name=%s]", name))
+		if _, err := c.dnsClient.CreateManagedZone(ctx, targetPrivateDNSZone(name, dnsName, vpc.SelfLink)); err != nil {
+			return fmt.Errorf("failed to create private DNS zone [name=%s]: %w", name, err)
+		}
+	} else if !isPrivateDNSZoneBoundToNetwork(zone, vpc.SelfLink) {
+		log.Info(fmt.Sprintf("binding private DNS zone [name=%s] to VPC [name=%s]", name, vpc.Name))
+		if err := c.dnsClient.PatchManagedZone(ctx, name, &dns.ManagedZone{
+			PrivateVisibilityConfig: targetPrivateDNSZone(name, dnsName, vpc.SelfLink).PrivateVisibilityConfig,
+		}); err != nil {
+			return fmt.Errorf("failed to bind private DNS zone [name=%s] to VPC [name=%s]: %w", name, vpc.Name, err)
+		}
+	}
+	c.state.Set(flowStateKeyPrivateDNSZone, name)
+
+	// Records of other types are removed, as they would conflict with the record if the type of the DNSRecord changed,
+	// e.g. as the load balancer of the kube-apiserver is exposed via a hostname instead of an IP address.
+	for _, recordType := range privateDNSRecordTypes {
+		if recordType == record.Spec.RecordType {
+			continue
+		}
+		if err := c.dnsClient.DeleteRecordSet(ctx, name, record.Spec.Name, string(recordType)); err != nil {
+			return fmt.Errorf("failed to delete %s record [name=%s] in private DNS zone [name=%s]: %w", recordType, record.Spec.Name, name, err)
+		}
+	}
+	if err := c.dnsClient.CreateOrUpdateRecordSet(ctx, name, record.Spec.Name, string(record.Spec.RecordType), record.Spec.Values, ptr.Deref(record.Spec.TTL, defaultPrivateDNSRecordTTL)); err != nil {
+		return fmt.Errorf("failed to update %s record [name=%s] in private DNS zone [name=%s]: %w", record.Spec.RecordType, record.Spec.Name, name, err)
+	}
+
+	c.whiteboard.SetObject(ObjectKeyPrivateDNSZone, name)
+	return nil
+}
+
+// ensurePrivateDNSZoneDeleted deletes the private Cloud DNS zone of the internal domain of the shoot including its
+// records. The zone is only deleted if it was created by the extension, so that no DNS permissions are required for
+// shoots which never enabled it.
+func (c *FlowReconciler) ensurePrivateDNSZoneDeleted(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	name := c.state.Get(flowStateKeyPrivateDNSZone)
+	if name == "" {
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("destroying private DNS zone [name=%s]", name))
+	if err := c.dnsClient.DeleteManagedZone(ctx, name); err != nil {
+		return fmt.Errorf("failed to delete private DNS zone [name=%s]: %w", name, err)
+	}
+
+	c.state.Delete(flowStateKeyPrivateDNSZone)
+	c.whiteboard.DeleteObject(ObjectKeyPrivateDNSZone)
+	return nil
+}
+
+func targetPrivateDNSZone(name, dnsName, network string) *dns.ManagedZone {
+	return &dns.ManagedZone{
+		Name:        name,
+		DnsName:     dnsName,
+		Description: "Private zone of the internal domain of the shoot managed by Gardener",
+		Visibility:  "private",
+		PrivateVisibilityConfig: &dns.ManagedZonePrivateVisibilityConfig{
+			Networks: []*dns.ManagedZonePrivateVisibilityConfigNetwork{{NetworkUrl: network}},
+		},
+	}
+}
+
+func isPrivateDNSZoneBoundToNetwork(zone *dns.ManagedZone, network string) bool {
+	if zone.PrivateVisibilityConfig == nil {
+		return false
+	}
+	for _, n := range zone.PrivateVisibilityConfig.Networks {
+		if isSameResource(n.NetworkUrl, network) {
+			return true
+		}
+	}
+	return false
+}
+
+func ensureTrailingDot(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"net/http"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("Private DNS zone", func() {
+	const zonePath = "projects/project/managedZones/" + fakeClusterName
+
+	var (
+		ctx    = context.Background()
+		server *fake.Server
+		infra  *extensionsv1alpha1.Infrastructure
+		record *extensionsv1alpha1.DNSRecord
+	)
+
+	setPrivateDNSZone := func(privateDNSZone *v1alpha1.PrivateDNSZone) {
+		state, status := infra.Status.State, infra.Status.ProviderStatus
+		infra = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+			Networks: v1alpha1.NetworkConfig{Workers: "10.250.0.0/16", PrivateDNSZone: privateDNSZone},
+		})
+		infra.Status.State, infra.Status.ProviderStatus = state, status
+	}
+
+	reconcile := func(objects ...client.Object) *v1alpha1.InfrastructureStatus {
+		_, status, err := reconcileWithFakeServer(ctx, server, infra, objects...)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return status
+	}
+
+	recordSets := func() []map[string]any {
+		var recordSets []map[string]any
+		for _, recordSet := range server.RecordSets(zonePath) {
+			if recordSet["type"] != "SOA" && recordSet["type"] != "NS" {
+				recordSets = append(recordSets, recordSet)
+			}
+		}
+		return recordSets
+	}
+
+	BeforeEach(func() {
+		var err error
+		server, err = fake.NewServer()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(server.Close)
+
+		infra = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+			Networks: v1alpha1.NetworkConfig{Workers: "10.250.0.0/16", PrivateDNSZone: &v1alpha1.PrivateDNSZone{Enabled: true}},
+		})
+
+		record = &extensionsv1alpha1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar-internal", Namespace: fakeClusterName},
+			Spec: extensionsv1alpha1.DNSRecordSpec{
+				Name:       "api.bar.example.com",
+				RecordType: extensionsv1alpha1.DNSRecordTypeA,
+				Values:     []string{"10.250.0.10"},
+			},
+		}
+	})
+
+	It("should skip the zone until the internal DNS record exists", func() {
+		status := reconcile()
+		Expect(status.Networks.PrivateDNSZone).To(BeNil())
+		_, ok := server.Get(zonePath)
+		Expect(ok).To(BeFalse())
+
+		status = reconcile(record)
+		Expect(status.Networks.PrivateDNSZone).To(Equal(ptr.To(fakeClusterName)))
+	})
+
+	It("should create the zone bound to the VPC with the record of the kube-apiserver", func() {
+		status := reconcile(record)
+		Expect(status.Networks.PrivateDNSZone).To(Equal(ptr.To(fakeClusterName)))
+
+		zone, ok := server.Get(zonePath)
+		Expect(ok).To(BeTrue())
+		Expect(zone).To(HaveKeyWithValue("dnsName", "bar.example.com."))
+		Expect(zone).To(HaveKeyWithValue("visibility", "private"))
+		Expect(zone).To(HaveKeyWithValue("privateVisibilityConfig", HaveKeyWithValue("networks", ConsistOf(
+			HaveKeyWithValue("networkUrl", server.ComputeEndpoint()+"projects/project/global/networks/"+fakeClusterName),
+		))))
+
+		Expect(recordSets()).To(ConsistOf(And(
+			HaveKeyWithValue("name", "api.bar.example.com."),
+			HaveKeyWithValue("type", "A"),
+			HaveKeyWithValue("ttl", BeNumerically("==", defaultPrivateDNSRecordTTL)),
+			HaveKeyWithValue("rrdatas", []any{"10.250.0.10"}),
+		)))
+
+		By("keeping the zone if the record does not exist anymore")
+		status = reconcile()
+		Expect(status.Networks.PrivateDNSZone).To(Equal(ptr.To(fakeClusterName)))
+		Expect(recordSets()).To(HaveLen(1))
+	})
+
+	It("should replace the record if its type changes", func() {
+		reconcile(record)
+
+		record.Spec.RecordType = extensionsv1alpha1.DNSRecordTypeCNAME
+		record.Spec.Values = []string{"lb.example.com"}
+		record.Spec.TTL = ptr.To[int64](300)
+		reconcile(record)
+
+		Expect(recordSets()).To(ConsistOf(And(
+			HaveKeyWithValue("name", "api.bar.example.com."),
+			HaveKeyWithValue("type", "CNAME"),
+			HaveKeyWithValue("ttl", BeNumerically("==", 300)),
+			HaveKeyWithValue("rrdatas", []any{"lb.example.com."}),
+		)))
+	})
+
+	It("should recreate the zone if the internal domain changes", func() {
+		reconcile(record)
+
+		record.Spec.Name = "api.baz.example.com"
+		status := reconcile(record)
+		Expect(status.Networks.PrivateDNSZone).To(Equal(ptr.To(fakeClusterName)))
+
+		zone, ok := server.Get(zonePath)
+		Expect(ok).To(BeTrue())
+		Expect(zone).To(HaveKeyWithValue("dnsName", "baz.example.com."))
+		Expect(recordSets()).To(ConsistOf(HaveKeyWithValue("name", "api.baz.example.com.")))
+	})
+
+	It("should delete the zone including its records if it is disabled", func() {
+		reconcile(record)
+
+		setPrivateDNSZone(&v1alpha1.PrivateDNSZone{Enabled: false})
+		status := reconcile(record)
+		Expect(status.Networks.PrivateDNSZone).To(BeNil())
+		_, ok := server.Get(zonePath)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should not call Cloud DNS if the zone was never enabled", func() {
+		server.Fail(http.MethodGet, "/dns/dns/v1/"+zonePath, http.StatusForbidden)
+		server.Fail(http.MethodDelete, "/dns/dns/v1/"+zonePath, http.StatusForbidden)
+
+		setPrivateDNSZone(nil)
+		status := reconcile(record)
+		Expect(status.Networks.PrivateDNSZone).To(BeNil())
+	})
+})
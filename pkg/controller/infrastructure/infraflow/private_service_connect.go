@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// flowStateKeyPrivateServiceConnectEndpoints is the key of the names of the Private Service Connect endpoints created by
+// the extension in the FlowState.
+const flowStateKeyPrivateServiceConnectEndpoints = "privateServiceConnectEndpoints"
+
+// privateServiceConnectEndpointName returns the name of the address and forwarding rule of the given endpoint.
+func (c *FlowReconciler) privateServiceConnectEndpointName(name string) string {
+	return fmt.Sprintf("%s-psc-%s", c.clusterName, name)
+}
+
+// createdPrivateServiceConnectEndpoints returns the names of the Private Service Connect endpoints which were created
+// by the extension.
+func (c *FlowReconciler) createdPrivateServiceConnectEndpoints() []string {
+	if data := c.state.Get(flowStateKeyPrivateServiceConnectEndpoints); data != "" {
+		return strings.Split(data, ",")
+	}
+	return nil
+}
+
+func (c *FlowReconciler) storeCreatedPrivateServiceConnectEndpoints(names []string) {
+	if len(names) == 0 {
+		c.state.Delete(flowStateKeyPrivateServiceConnectEndpoints)
+		return
+	}
+	c.state.Set(flowStateKeyPrivateServiceConnectEndpoints, strings.Join(names, ","))
+}
+
+// ensurePrivateServiceConnectEndpoints creates the configured Private Service Connect endpoints, i.e. an internal
+// address in the worker subnet and a forwarding rule to the service attachment, and deletes the endpoints which are not
+// configured anymore.
+func (c *FlowReconciler) ensurePrivateServiceConnectEndpoints(ctx context.Context) error {
+	keys := []string{ObjectKeyVPC}
+	// There is no regional worker subnet if the subnets of zones are used, which do not support endpoints.
+	if len(c.config.Networks.PrivateServiceConnectEndpoints) > 0 {
+		keys = append(keys, ObjectKeyNodeSubnet)
+	}
+	if err := c.ensureObjectKeys(keys...); err != nil {
+		return err
+	}
+
+	var (
+		vpc      = GetObject[*client.Network](c.whiteboard, ObjectKeyVPC)
+		subnet   = GetObject[*client.Subnetwork](c.whiteboard, ObjectKeyNodeSubnet)
+		created  = c.createdPrivateServiceConnectEndpoints()
+		names    []string
+		statuses []v1alpha1.PrivateServiceConnectEndpointStatus
+	)
+
+	for _, endpoint := range c.config.Networks.PrivateServiceConnectEndpoints {
+		name := c.privateServiceConnectEndpointName(endpoint.Name)
+		names = append(names, name)
+		if !slices.Contains(created, name) {
+			created = append(created, name)
+			c.storeCreatedPrivateServiceConnectEndpoints(created)
+		}
+
+		status, err := c.ensurePrivateServiceConnectEndpoint(ctx, name, endpoint, vpc, subnet)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, *status)
+	}
+
+	for _, name := range created {
+		if slices.Contains(names, name) {
+			continue
+		}
+		if err := c.deletePrivateServiceConnectEndpoint(ctx, name); err != nil {
+			return err
+		}
+	}
+	c.storeCreatedPrivateServiceConnectEndpoints(names)
+
+	c.whiteboard.SetObject(ObjectKeyPrivateServiceConnectEndpoints, statuses)
+	return nil
+}
+
+func (c *FlowReconciler) ensurePrivateServiceConnectEndpoint(
+	ctx context.Context,
+	name string,
+	endpoint gcp.PrivateServiceConnectEndpoint,
+	vpc *client.Network,
+	subnet *client.Subnetwork,
+) (
+	*v1alpha1.PrivateServiceConnectEndpointStatus,
+	error,
+) {
+	log := c.LogFromContext(ctx).WithValues("endpoint", name)
+
+	address, err := c.computeClient.GetAddress(ctx, c.infra.Spec.Region, name)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := c.computeClient.GetForwardingRule(ctx, c.infra.Spec.Region, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Neither the address nor the target of an endpoint can be changed, hence the endpoint is recreated.
+	if (address != nil && endpoint.IP != nil && address.Address != *endpoint.IP) ||
+		(rule != nil && !strings.HasSuffix(rule.Target, endpoint.ServiceAttachment)) {
+		log.Info("recreating private service connect endpoint")
+		if err := c.deletePrivateServiceConnectEndpoint(ctx, name); err != nil {
+			return nil, err
+		}
+		address, rule = nil, nil
+	}
+
+	if address == nil {
+		log.Info("reserving private service connect endpoint address")
+		if address, err = c.computeClient.InsertAddress(ctx, c.infra.Spec.Region, &client.Address{
+			Name:        name,
+			Description: fmt.Sprintf("Private Service Connect endpoint of Shoot %s created by the gardener-extension-provider-gcp.", c.infra.Namespace),
+			AddressType: "INTERNAL",
+			Subnetwork:  subnet.SelfLink,
+			Address:     ptr.Deref(endpoint.IP, ""),
+			Labels:      c.labels,
+		}); err != nil {
+			return nil, err
+		}
+	} else if err := c.ensureAddressLabels(ctx, address); err != nil {
+		return nil, err
+	}
+
+	if rule == nil {
+		log.Info("creating private service connect endpoint")
+		if rule, err = c.computeClient.InsertForwardingRule(ctx, c.infra.Spec.Region, &client.ForwardingRule{
+			Name:        name,
+			Description: fmt.Sprintf("Private Service Connect endpoint of Shoot %s created by the gardener-extension-provider-gcp.", c.infra.Namespace),
+			Network:     vpc.SelfLink,
+			IPAddress:   address.SelfLink,
+			Target:      endpoint.ServiceAttachment,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1alpha1.PrivateServiceConnectEndpointStatus{
+		Name:             endpoint.Name,
+		IP:               address.Address,
+		ConnectionStatus: rule.PscConnectionStatus,
+	}, nil
+}
+
+// deletePrivateServiceConnectEndpoint deletes the forwarding rule and afterwards the address of the given endpoint.
+func (c *FlowReconciler) deletePrivateServiceConnectEndpoint(ctx context.Context, name string) error {
+	c.LogFromContext(ctx).Info("deleting private service connect endpoint", "endpoint", name)
+	if err := c.computeClient.DeleteForwardingRule(ctx, c.infra.Spec.Region, name); err != nil {
+		return err
+	}
+	return c.computeClient.DeleteAddress(ctx, c.infra.Spec.Region, name)
+}
+
+// ensurePrivateServiceConnectEndpointsDeleted deletes all Private Service Connect endpoints of the shoot.
+func (c *FlowReconciler) ensurePrivateServiceConnectEndpointsDeleted(ctx context.Context) error {
+	names := c.createdPrivateServiceConnectEndpoints()
+	for _, endpoint := range c.config.Networks.PrivateServiceConnectEndpoints {
+		if name := c.privateServiceConnectEndpointName(endpoint.Name); !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range names {
+		if err := c.deletePrivateServiceConnectEndpoint(ctx, name); err != nil {
+			return err
+		}
+	}
+	c.storeCreatedPrivateServiceConnectEndpoints(nil)
+	return nil
+}
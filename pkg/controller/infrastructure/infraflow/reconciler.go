@@ -2,12 +2,14 @@ package infraflow
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
@@ -30,9 +32,29 @@ type FlowReconciler struct {
 	clusterName    string
 	whiteboard     shared.Whiteboard
 	podCIDR        *string
+	// ipv6SingleStack is true for shoots which only use the IPv6 IP family.
+	ipv6SingleStack bool
+	// dualStack is true for shoots which use the IPv4 and the IPv6 IP family.
+	dualStack bool
+	// state is the FlowState of the infrastructure which is persisted in the status.
+	state *FlowState
+	// natIPRotationID is the value of the annotation requesting a rotation of the NAT IPs.
+	natIPRotationID string
+	// subsystems are the subsystems selected by the reconcile annotation. All subsystems are reconciled if it is empty.
+	subsystems []string
+	// internalDNSRecord is the DNSRecord of the internal domain of the kube-apiserver. It is only read if the private
+	// DNS zone is enabled and nil if it does not exist yet.
+	internalDNSRecord *extensionsv1alpha1.DNSRecord
+	// labels are the labels of the GCP resources of the shoot which support labels.
+	labels map[string]string
+	// deleteOrphanedResources is true if the orphaned resources found by the sweep shall be deleted instead of only
+	// being reported.
+	deleteOrphanedResources bool
 
 	computeClient gcpclient.ComputeClient
 	iamClient     gcpclient.IAMClient
+	dnsClient     gcpclient.DNSClient
+	nccClient     gcpclient.NetworkConnectivityClient
 }
 
 // NewFlowReconciler returns a new FlowReconciler.
@@ -42,6 +64,7 @@ func NewFlowReconciler(
 	infra *extensionsv1alpha1.Infrastructure,
 	cluster *controller.Cluster,
 	c client.Client,
+	gc gcpclient.Factory,
 ) (*FlowReconciler, error) {
 	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
 	if err != nil {
@@ -53,7 +76,6 @@ func NewFlowReconciler(
 		return nil, err
 	}
 
-	gc := gcpclient.New()
 	com, err := gc.Compute(ctx, c, infra.Spec.SecretRef)
 	if err != nil {
 		return nil, err
@@ -64,20 +86,73 @@ func NewFlowReconciler(
 		return nil, err
 	}
 
+	dns, err := gc.DNS(ctx, c, infra.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	ncc, err := gc.NetworkConnectivity(ctx, c, infra.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var internalDNSRecord *extensionsv1alpha1.DNSRecord
+	if isPrivateDNSZoneEnabled(config) {
+		if internalDNSRecord, err = getInternalDNSRecord(ctx, c, infra.Namespace, cluster.Shoot.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	state := NewFlowState()
+	if infra.Status.State != nil && len(infra.Status.State.Raw) > 0 {
+		isFlowState, err := IsJSONFlowState(infra.Status.State.Raw)
+		if err != nil {
+			return nil, err
+		}
+		if isFlowState {
+			if state, err = NewFlowStateFromJSON(infra.Status.State.Raw); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	natIPRotationID := infra.Annotations[gcpinternal.AnnotationKeyRotateNatIPs]
+	if natIPRotationID == "" && cluster.Shoot != nil {
+		natIPRotationID = cluster.Shoot.Annotations[gcpinternal.AnnotationKeyRotateNatIPs]
+	}
+
+	deleteOrphanedResources := strings.EqualFold(infra.Annotations[gcpinternal.AnnotationKeyDeleteOrphanedResources], "true") ||
+		(cluster.Shoot != nil && strings.EqualFold(cluster.Shoot.Annotations[gcpinternal.AnnotationKeyDeleteOrphanedResources], "true"))
+
+	subsystems, err := ParseSubsystems(infra.Annotations[gcpinternal.AnnotationKeyReconcile])
+	if err != nil {
+		return nil, err
+	}
+
 	wb := shared.NewWhiteboard()
 	bfc := shared.NewBasicFlowContext(log, wb, nil)
 	fr := &FlowReconciler{
-		BasicFlowContext: bfc,
-		whiteboard:       wb,
-		infra:            infra,
-		serviceAccount:   serviceAccount,
-		config:           config,
-		updater:          gcpclient.NewUpdater(gc, serviceAccount, log),
-		clusterName:      cluster.ObjectMeta.Name,
-		podCIDR:          cluster.Shoot.Spec.Networking.Pods,
+		BasicFlowContext:        bfc,
+		whiteboard:              wb,
+		infra:                   infra,
+		serviceAccount:          serviceAccount,
+		config:                  config,
+		updater:                 gcpclient.NewUpdater(gc, serviceAccount, log),
+		clusterName:             cluster.ObjectMeta.Name,
+		podCIDR:                 cluster.Shoot.Spec.Networking.Pods,
+		ipv6SingleStack:         gcpinternal.IsIPv6SingleStack(cluster.Shoot.Spec.Networking),
+		dualStack:               gcpinternal.IsDualStack(cluster.Shoot.Spec.Networking),
+		state:                   state,
+		natIPRotationID:         natIPRotationID,
+		subsystems:              subsystems,
+		internalDNSRecord:       internalDNSRecord,
+		labels:                  gcpinternal.ResourceLabels(cluster.ObjectMeta.Name, cluster.Shoot, config.ResourceLabels),
+		deleteOrphanedResources: deleteOrphanedResources,
 
 		computeClient: com,
 		iamClient:     iam,
+		dnsClient:     dns,
+		nccClient:     ncc,
 	}
 
 	return fr, nil
@@ -87,7 +162,11 @@ func NewFlowReconciler(
 func (c *FlowReconciler) Reconcile(ctx context.Context) (*v1alpha1.InfrastructureStatus, *runtime.RawExtension, error) {
 	g := c.buildReconcileGraph()
 	f := g.Compile()
-	c.Log.Info("starting Flow Reconciliation")
+	if len(c.subsystems) > 0 {
+		c.Log.Info("starting Flow Reconciliation of selected subsystems", "subsystems", c.subsystems)
+	} else {
+		c.Log.Info("starting Flow Reconciliation")
+	}
 	err := f.Run(ctx, flow.Opts{Log: c.Log})
 	if err != nil {
 		c.Log.Error(err, "flow reconciliation failed")
@@ -101,8 +180,13 @@ func (c *FlowReconciler) Reconcile(ctx context.Context) (*v1alpha1.Infrastructur
 	return c.getStatus()
 }
 
-// Delete is used to destroy the infrastructure.
+// Delete is used to destroy the infrastructure. The deletion is paused as long as the network contains resources which
+// were not created by Gardener.
 func (c *FlowReconciler) Delete(ctx context.Context) error {
+	if err := c.ensureNoForeignResources(ctx); err != nil {
+		return err
+	}
+
 	g := c.buildDeleteGraph()
 	f := g.Compile()
 	return f.Run(ctx, flow.Opts{Log: c.Log})
@@ -120,19 +204,57 @@ func (c *FlowReconciler) getStatus() (*v1alpha1.InfrastructureStatus, *runtime.R
 
 	if n := GetObject[*gcpclient.Network](c.whiteboard, ObjectKeyVPC); n != nil {
 		status.Networks.VPC.Name = n.Name
+		if n.Mtu > 0 {
+			status.Networks.MTU = ptr.To(int32(n.Mtu))
+		}
 	}
 
 	if s := GetObject[*gcpclient.Subnetwork](c.whiteboard, ObjectKeyNodeSubnet); s != nil {
 		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
-			Name:    s.Name,
-			Purpose: v1alpha1.PurposeNodes,
+			Name:            s.Name,
+			Purpose:         v1alpha1.PurposeNodes,
+			SelfLink:        s.SelfLink,
+			IPv6CIDRRange:   subnetIPv6CIDRRange(s),
+			IPv4CIDRRange:   ptr.To(s.IpCidrRange),
+			SecondaryRanges: subnetSecondaryRanges(s, c.config.Networks.SecondaryRanges),
 		})
 	}
 
 	if s := GetObject[*gcpclient.Subnetwork](c.whiteboard, ObjectKeyInternalSubnet); s != nil {
 		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
-			Name:    s.Name,
-			Purpose: v1alpha1.PurposeInternal,
+			Name:          s.Name,
+			Purpose:       v1alpha1.PurposeInternal,
+			SelfLink:      s.SelfLink,
+			IPv6CIDRRange: subnetIPv6CIDRRange(s),
+			IPv4CIDRRange: ptr.To(s.IpCidrRange),
+		})
+	}
+
+	for _, subnets := range GetObject[[]zoneSubnets](c.whiteboard, ObjectKeyZoneSubnets) {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:          subnets.workers.Name,
+			Purpose:       v1alpha1.PurposeNodes,
+			SelfLink:      subnets.workers.SelfLink,
+			Zone:          ptr.To(subnets.zone),
+			IPv4CIDRRange: ptr.To(subnets.workers.IpCidrRange),
+		})
+		if subnets.internal != nil {
+			status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+				Name:          subnets.internal.Name,
+				Purpose:       v1alpha1.PurposeInternal,
+				SelfLink:      subnets.internal.SelfLink,
+				Zone:          ptr.To(subnets.zone),
+				IPv4CIDRRange: ptr.To(subnets.internal.IpCidrRange),
+			})
+		}
+	}
+
+	if s := GetObject[*gcpclient.Subnetwork](c.whiteboard, ObjectKeyProxyOnlySubnet); s != nil {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:          s.Name,
+			Purpose:       v1alpha1.PurposeProxyOnly,
+			SelfLink:      s.SelfLink,
+			IPv4CIDRRange: ptr.To(s.IpCidrRange),
 		})
 	}
 
@@ -142,11 +264,41 @@ func (c *FlowReconciler) getStatus() (*v1alpha1.InfrastructureStatus, *runtime.R
 		}
 	}
 
+	if nat := GetObject[*gcpclient.RouterNat](c.whiteboard, ObjectKeyNAT); nat != nil {
+		status.Networks.CloudNAT = &v1alpha1.CloudNATStatus{
+			Name: nat.Name,
+		}
+	}
+
 	if s := GetObject[*gcpclient.ServiceAccount](c.whiteboard, ObjectKeyServiceAccount); s != nil {
 		status.ServiceAccountEmail = s.Email
 	}
+	status.WorkerPoolServiceAccounts = GetObject[[]v1alpha1.WorkerPoolServiceAccountStatus](c.whiteboard, ObjectKeyWorkerPoolServiceAccounts)
+
+	status.Networks.NatIPs = append(status.Networks.NatIPs, GetObject[[]v1alpha1.NatIP](c.whiteboard, ObjectKeyNatIPs)...)
+
+	natIPRotation, err := c.natIPRotationStatus()
+	if err != nil {
+		return nil, nil, err
+	}
+	status.Networks.NatIPRotation = natIPRotation
+	status.Networks.PrivateServiceConnectEndpoints = GetObject[[]v1alpha1.PrivateServiceConnectEndpointStatus](c.whiteboard, ObjectKeyPrivateServiceConnectEndpoints)
+	status.Networks.Peerings = GetObject[[]v1alpha1.VPCPeeringStatus](c.whiteboard, ObjectKeyPeerings)
+	if name := GetObject[string](c.whiteboard, ObjectKeyFirewallPolicy); name != "" {
+		status.Networks.FirewallPolicy = ptr.To(name)
+	}
+	if name := GetObject[string](c.whiteboard, ObjectKeyPacketMirroring); name != "" {
+		status.Networks.PacketMirroring = ptr.To(name)
+	}
+	if name := GetObject[string](c.whiteboard, ObjectKeyPrivateDNSZone); name != "" {
+		status.Networks.PrivateDNSZone = ptr.To(name)
+	}
+	status.Networks.NCCSpoke = GetObject[*v1alpha1.NCCSpokeStatus](c.whiteboard, ObjectKeyNCCSpoke)
+	if err := c.keepStatusOfSkippedSubsystems(status); err != nil {
+		return nil, nil, err
+	}
 
-	bytes, err := NewFlowState().ToJSON()
+	bytes, err := c.state.ToJSON()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -156,3 +308,19 @@ func (c *FlowReconciler) getStatus() (*v1alpha1.InfrastructureStatus, *runtime.R
 	}
 	return status, state, nil
 }
+
+// subnetSecondaryRanges returns the secondary ranges of the given subnet with the purposes of the configured secondary
+// ranges.
+func subnetSecondaryRanges(subnet *gcpclient.Subnetwork, secondaryRanges []gcp.SecondaryRange) []v1alpha1.SecondaryRange {
+	var result []v1alpha1.SecondaryRange
+	for _, subnetRange := range subnet.SecondaryIpRanges {
+		secondaryRange := v1alpha1.SecondaryRange{Name: subnetRange.RangeName, CIDR: subnetRange.IpCidrRange}
+		for _, configRange := range secondaryRanges {
+			if configRange.Name == subnetRange.RangeName {
+				secondaryRange.Purpose = (*v1alpha1.SecondaryRangePurpose)(configRange.Purpose)
+			}
+		}
+		result = append(result, secondaryRange)
+	}
+	return result
+}
@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+)
+
+const (
+	// flowStateKeyServiceAccountRoles is the key of the IAM roles granted by the extension in the FlowState, by the ID of
+	// the service account they were granted to.
+	flowStateKeyServiceAccountRoles = "serviceAccountRoles"
+	// flowStateKeyWorkerPoolServiceAccounts is the key of the IDs of the service accounts of worker pools created by the
+	// extension in the FlowState.
+	flowStateKeyWorkerPoolServiceAccounts = "workerPoolServiceAccounts"
+)
+
+// workerPoolServiceAccountName returns the ID of the service account of the given worker pool. IDs of service accounts
+// are limited to 30 characters, hence it is derived from the technical ID of the shoot and the name of the pool.
+func (c *FlowReconciler) workerPoolServiceAccountName(pool string) string {
+	return "gardener-pool-" + utils.ComputeSHA256Hex([]byte(c.clusterName + "/" + pool))[:16]
+}
+
+// grantedServiceAccountRoles returns the IAM roles which were granted by the extension, by the ID of the service
+// account they were granted to.
+func (c *FlowReconciler) grantedServiceAccountRoles() (map[string][]string, error) {
+	granted := map[string][]string{}
+	if data := c.state.Get(flowStateKeyServiceAccountRoles); data != "" {
+		if err := json.Unmarshal([]byte(data), &granted); err != nil {
+			return nil, fmt.Errorf("could not decode granted service account roles: %w", err)
+		}
+	}
+	return granted, nil
+}
+
+func (c *FlowReconciler) storeGrantedServiceAccountRoles(granted map[string][]string) error {
+	if len(granted) == 0 {
+		c.state.Delete(flowStateKeyServiceAccountRoles)
+		return nil
+	}
+
+	data, err := json.Marshal(granted)
+	if err != nil {
+		return err
+	}
+	c.state.Set(flowStateKeyServiceAccountRoles, string(data))
+	return nil
+}
+
+// createdWorkerPoolServiceAccounts returns the IDs of the service accounts of worker pools which were created by the
+// extension.
+func (c *FlowReconciler) createdWorkerPoolServiceAccounts() sets.Set[string] {
+	if data := c.state.Get(flowStateKeyWorkerPoolServiceAccounts); data != "" {
+		return sets.New(strings.Split(data, ",")...)
+	}
+	return sets.New[string]()
+}
+
+func (c *FlowReconciler) storeCreatedWorkerPoolServiceAccounts(names sets.Set[string]) {
+	if names.Len() == 0 {
+		c.state.Delete(flowStateKeyWorkerPoolServiceAccounts)
+		return
+	}
+	c.state.Set(flowStateKeyWorkerPoolServiceAccounts, strings.Join(sets.List(names), ","))
+}
+
+// ensureServiceAccountRoles grants the given roles to the service account and revokes the roles which were granted
+// before but are not given anymore. Only the roles recorded in the FlowState are revoked, and the IAM policy of the
+// project is only read and written if the roles differ from the recorded ones.
+func (c *FlowReconciler) ensureServiceAccountRoles(ctx context.Context, name, email string, roles []string) error {
+	granted, err := c.grantedServiceAccountRoles()
+	if err != nil {
+		return err
+	}
+
+	var (
+		current = sets.New(granted[name]...)
+		desired = sets.New(roles...)
+		add     = sets.List(desired.Difference(current))
+		remove  = sets.List(current.Difference(desired))
+	)
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	c.LogFromContext(ctx).Info("updating role bindings of service account", "name", name, "add", add, "remove", remove)
+	if err := c.iamClient.UpdateProjectRoleBindings(ctx, serviceAccountMember(email), add, remove); err != nil {
+		return err
+	}
+
+	if desired.Len() == 0 {
+		delete(granted, name)
+	} else {
+		granted[name] = sets.List(desired)
+	}
+	return c.storeGrantedServiceAccountRoles(granted)
+}
+
+// ensureServiceAccountRemoved revokes the roles granted to the service account with the given ID and deletes it.
+func (c *FlowReconciler) ensureServiceAccountRemoved(ctx context.Context, name string) error {
+	sa, err := c.iamClient.GetServiceAccount(ctx, name)
+	if err != nil {
+		return err
+	}
+	if sa == nil {
+		// The bindings of deleted service accounts cannot be removed by their member anymore.
+		granted, err := c.grantedServiceAccountRoles()
+		if err != nil {
+			return err
+		}
+		delete(granted, name)
+		return c.storeGrantedServiceAccountRoles(granted)
+	}
+
+	if err := c.ensureServiceAccountRoles(ctx, name, sa.Email, nil); err != nil {
+		return err
+	}
+
+	c.LogFromContext(ctx).Info("deleting service account", "name", name)
+	return c.iamClient.DeleteServiceAccount(ctx, name)
+}
+
+// ensureWorkerPoolServiceAccounts creates the service accounts of the worker pools which request a dedicated one, grants
+// their roles and removes the service accounts of worker pools which do not request one anymore.
+func (c *FlowReconciler) ensureWorkerPoolServiceAccounts(ctx context.Context) error {
+	var (
+		log      = c.LogFromContext(ctx)
+		created  = c.createdWorkerPoolServiceAccounts()
+		desired  = sets.New[string]()
+		statuses []v1alpha1.WorkerPoolServiceAccountStatus
+	)
+
+	if c.config.NodeServiceAccount != nil {
+		for _, pool := range c.config.NodeServiceAccount.WorkerPools {
+			name := c.workerPoolServiceAccountName(pool.Name)
+			desired.Insert(name)
+
+			sa, err := c.iamClient.GetServiceAccount(ctx, name)
+			if err != nil {
+				return err
+			}
+			if sa == nil {
+				log.Info("creating service account of worker pool", "name", name, "pool", pool.Name)
+				if sa, err = c.iamClient.CreateServiceAccount(ctx, name); err != nil {
+					return err
+				}
+			}
+			created.Insert(name)
+			c.storeCreatedWorkerPoolServiceAccounts(created)
+
+			if err := c.ensureServiceAccountRoles(ctx, name, sa.Email, helper.WorkerPoolServiceAccountRoles(c.config.NodeServiceAccount, pool)); err != nil {
+				return err
+			}
+			statuses = append(statuses, v1alpha1.WorkerPoolServiceAccountStatus{Name: pool.Name, Email: sa.Email})
+		}
+	}
+
+	for _, name := range sets.List(created.Difference(desired)) {
+		if err := c.ensureServiceAccountRemoved(ctx, name); err != nil {
+			return err
+		}
+		created.Delete(name)
+		c.storeCreatedWorkerPoolServiceAccounts(created)
+	}
+
+	c.whiteboard.SetObject(ObjectKeyWorkerPoolServiceAccounts, statuses)
+	return nil
+}
+
+// ensureWorkerPoolServiceAccountsDeleted removes the service accounts of all worker pools created by the extension.
+func (c *FlowReconciler) ensureWorkerPoolServiceAccountsDeleted(ctx context.Context) error {
+	created := c.createdWorkerPoolServiceAccounts()
+	if c.config.NodeServiceAccount != nil {
+		for _, pool := range c.config.NodeServiceAccount.WorkerPools {
+			created.Insert(c.workerPoolServiceAccountName(pool.Name))
+		}
+	}
+
+	for _, name := range sets.List(created) {
+		if err := c.ensureServiceAccountRemoved(ctx, name); err != nil {
+			return err
+		}
+	}
+
+	c.whiteboard.DeleteObject(ObjectKeyWorkerPoolServiceAccounts)
+	return nil
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"net/http"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("Service accounts", func() {
+	const (
+		getIAMPolicyPath  = "/cloudresourcemanager/v1/projects/" + fakeProject + ":getIamPolicy"
+		shootMember       = "serviceAccount:" + fakeClusterName + "@" + fakeProject + ".iam.gserviceaccount.com"
+		serviceAccounts   = "projects/" + fakeProject + "/serviceAccounts"
+		storageViewerRole = "roles/storage.objectViewer"
+	)
+
+	var (
+		ctx    = context.Background()
+		server *fake.Server
+		infra  *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		var err error
+		server, err = fake.NewServer()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(server.Close)
+		DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.DisableGardenerServiceAccountCreation, false))
+
+		infra = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+			Networks: v1alpha1.NetworkConfig{Workers: "10.250.0.0/16"},
+		})
+	})
+
+	reconcile := func() *v1alpha1.InfrastructureStatus {
+		_, status, err := reconcileWithFakeServer(ctx, server, infra)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return status
+	}
+
+	setNodeServiceAccount := func(sa *v1alpha1.NodeServiceAccount) {
+		infra.Spec.ProviderConfig = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+			Networks:           v1alpha1.NetworkConfig{Workers: "10.250.0.0/16"},
+			NodeServiceAccount: sa,
+		}).Spec.ProviderConfig
+	}
+
+	// rolesOf returns the roles which the given member is bound to in the IAM policy of the project.
+	rolesOf := func(member string) []string {
+		var roles []string
+		bindings, _ := server.Policy("projects/" + fakeProject)["bindings"].([]any)
+		for _, b := range bindings {
+			binding := b.(map[string]any)
+			for _, m := range binding["members"].([]any) {
+				if m == member {
+					roles = append(roles, binding["role"].(string))
+				}
+			}
+		}
+		return roles
+	}
+
+	It("should not read the IAM policy of the project if no roles are granted", func() {
+		server.Fail(http.MethodPost, getIAMPolicyPath, http.StatusForbidden)
+
+		status := reconcile()
+		Expect(status.ServiceAccountEmail).NotTo(BeEmpty())
+		Expect(status.WorkerPoolServiceAccounts).To(BeEmpty())
+	})
+
+	It("should only update the role bindings if the roles change and only revoke the granted ones", func() {
+		setNodeServiceAccount(&v1alpha1.NodeServiceAccount{})
+		reconcile()
+		Expect(rolesOf(shootMember)).To(ConsistOf(helper.DefaultNodeServiceAccountRoles))
+
+		By("not reading the IAM policy again")
+		server.Fail(http.MethodPost, getIAMPolicyPath, http.StatusForbidden)
+		reconcile()
+
+		By("granting an additional role manually")
+		server.Recover(http.MethodPost, getIAMPolicyPath)
+		policy := server.Policy("projects/" + fakeProject)
+		policy["bindings"] = append(policy["bindings"].([]any), map[string]any{"role": "roles/viewer", "members": []any{shootMember}})
+		server.PutPolicy("projects/"+fakeProject, policy)
+
+		By("revoking only the granted roles when the option is unset")
+		setNodeServiceAccount(nil)
+		reconcile()
+		Expect(rolesOf(shootMember)).To(ConsistOf("roles/viewer"))
+
+		By("not reading the IAM policy anymore")
+		server.Fail(http.MethodPost, getIAMPolicyPath, http.StatusForbidden)
+		reconcile()
+	})
+
+	It("should record the granted roles if the reconciliation fails", func() {
+		setNodeServiceAccount(&v1alpha1.NodeServiceAccount{
+			WorkerPools: []v1alpha1.WorkerPoolServiceAccount{{Name: "pool-a"}, {Name: "pool-b"}},
+		})
+		poolBPath := "/iam/v1/" + serviceAccounts + "/" + newFakeFlowReconciler(ctx, server, infra).workerPoolServiceAccountName("pool-b") + "@" + fakeProject + ".iam.gserviceaccount.com"
+		server.Fail(http.MethodGet, poolBPath, http.StatusInternalServerError)
+
+		_, _, err := reconcileWithFakeServer(ctx, server, infra)
+		Expect(err).To(HaveOccurred())
+		Expect(rolesOf(shootMember)).To(ConsistOf(helper.DefaultNodeServiceAccountRoles))
+
+		By("revoking the roles granted by the failed reconciliation")
+		server.Recover(http.MethodGet, poolBPath)
+		setNodeServiceAccount(nil)
+		reconcile()
+		Expect(rolesOf(shootMember)).To(BeEmpty())
+		Expect(server.List(serviceAccounts)).To(HaveLen(1))
+	})
+
+	It("should create service accounts for worker pools and remove them if they are not requested anymore", func() {
+		setNodeServiceAccount(&v1alpha1.NodeServiceAccount{
+			WorkerPools: []v1alpha1.WorkerPoolServiceAccount{
+				{Name: "pool-a"},
+				{Name: "pool-b", Roles: []string{storageViewerRole}},
+			},
+		})
+		status := reconcile()
+		Expect(status.WorkerPoolServiceAccounts).To(HaveLen(2))
+		Expect(status.WorkerPoolServiceAccounts[0].Name).To(Equal("pool-a"))
+		Expect(status.WorkerPoolServiceAccounts[1].Name).To(Equal("pool-b"))
+		Expect(server.List(serviceAccounts)).To(HaveLen(3))
+
+		poolAEmail, poolBEmail := status.WorkerPoolServiceAccounts[0].Email, status.WorkerPoolServiceAccounts[1].Email
+		poolAMember, poolBMember := "serviceAccount:"+poolAEmail, "serviceAccount:"+poolBEmail
+		Expect(rolesOf(poolAMember)).To(ConsistOf(helper.DefaultNodeServiceAccountRoles))
+		Expect(rolesOf(poolBMember)).To(ConsistOf(storageViewerRole))
+
+		By("removing the service account of a worker pool")
+		setNodeServiceAccount(&v1alpha1.NodeServiceAccount{
+			WorkerPools: []v1alpha1.WorkerPoolServiceAccount{{Name: "pool-a"}},
+		})
+		status = reconcile()
+		Expect(status.WorkerPoolServiceAccounts).To(ConsistOf(v1alpha1.WorkerPoolServiceAccountStatus{Name: "pool-a", Email: poolAEmail}))
+		Expect(server.List(serviceAccounts)).To(HaveLen(2))
+		Expect(rolesOf(poolBMember)).To(BeEmpty())
+
+		By("removing all service accounts when the infrastructure is deleted")
+		reconciler := newFakeFlowReconciler(ctx, server, infra)
+		Expect(reconciler.ensureServiceAccountDeleted(ctx)).To(Succeed())
+		Expect(reconciler.ensureWorkerPoolServiceAccountsDeleted(ctx)).To(Succeed())
+		Expect(server.List(serviceAccounts)).To(BeEmpty())
+		Expect(rolesOf(shootMember)).To(BeEmpty())
+		Expect(rolesOf(poolAMember)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+)
+
+const (
+	// SubsystemServiceAccount is the subsystem of the service account of the shoot.
+	SubsystemServiceAccount = "service-account"
+	// SubsystemNAT is the subsystem of the CloudRouter, the CloudNAT and the NAT IPs.
+	SubsystemNAT = "nat"
+	// SubsystemFirewalls is the subsystem of the firewall rules and the network firewall policy.
+	SubsystemFirewalls = "firewalls"
+	// SubsystemPrivateServiceConnect is the subsystem of the Private Service Connect endpoints.
+	SubsystemPrivateServiceConnect = "private-service-connect"
+	// SubsystemPeerings is the subsystem of the VPC peerings.
+	SubsystemPeerings = "peerings"
+	// SubsystemPacketMirroring is the subsystem of the packet mirroring policy of the worker subnet.
+	SubsystemPacketMirroring = "packet-mirroring"
+	// SubsystemPrivateDNSZone is the subsystem of the private Cloud DNS zone of the internal domain of the shoot.
+	SubsystemPrivateDNSZone = "private-dns-zone"
+	// SubsystemNCCSpoke is the subsystem of the Network Connectivity Center spoke of the VPC.
+	SubsystemNCCSpoke = "ncc-spoke"
+)
+
+// Subsystems are the subsystems of the infrastructure which can be reconciled selectively. The VPC and the subnets are
+// always reconciled, as all subsystems depend on them.
+var Subsystems = []string{
+	SubsystemServiceAccount,
+	SubsystemNAT,
+	SubsystemFirewalls,
+	SubsystemPrivateServiceConnect,
+	SubsystemPeerings,
+	SubsystemPacketMirroring,
+	SubsystemPrivateDNSZone,
+	SubsystemNCCSpoke,
+}
+
+// ParseSubsystems parses the comma-separated subsystems of the reconcile annotation. It returns nil if the value is
+// empty, i.e. if all subsystems are reconciled.
+func ParseSubsystems(value string) ([]string, error) {
+	var subsystems []string
+	for _, subsystem := range strings.Split(value, ",") {
+		subsystem = strings.TrimSpace(subsystem)
+		if subsystem == "" {
+			continue
+		}
+		if !slices.Contains(Subsystems, subsystem) {
+			return nil, fmt.Errorf("unknown subsystem %q to reconcile, supported subsystems are %s", subsystem, strings.Join(Subsystems, ", "))
+		}
+		subsystems = append(subsystems, subsystem)
+	}
+	return subsystems, nil
+}
+
+// reconcilesSubsystem returns true if the given subsystem is reconciled, i.e. if no subsystems were selected or the
+// given one is among them.
+func (c *FlowReconciler) reconcilesSubsystem(subsystem string) bool {
+	return len(c.subsystems) == 0 || slices.Contains(c.subsystems, subsystem)
+}
+
+// keepStatusOfSkippedSubsystems copies the status of the subsystems which were not reconciled from the previous
+// provider status, as their resources were not read.
+func (c *FlowReconciler) keepStatusOfSkippedSubsystems(status *v1alpha1.InfrastructureStatus) error {
+	if len(c.subsystems) == 0 || c.infra.Status.ProviderStatus == nil || c.infra.Status.ProviderStatus.Raw == nil {
+		return nil
+	}
+
+	previousInternal, err := helper.InfrastructureStatusFromRaw(c.infra.Status.ProviderStatus)
+	if err != nil {
+		return fmt.Errorf("could not decode infrastructure provider status: %w", err)
+	}
+	previous := &v1alpha1.InfrastructureStatus{}
+	if err := helper.Scheme.Convert(previousInternal, previous, nil); err != nil {
+		return err
+	}
+
+	if !c.reconcilesSubsystem(SubsystemServiceAccount) {
+		status.ServiceAccountEmail = previous.ServiceAccountEmail
+		status.WorkerPoolServiceAccounts = previous.WorkerPoolServiceAccounts
+	}
+	if !c.reconcilesSubsystem(SubsystemNAT) {
+		status.Networks.VPC.CloudRouter = previous.Networks.VPC.CloudRouter
+		status.Networks.CloudNAT = previous.Networks.CloudNAT
+		status.Networks.NatIPs = previous.Networks.NatIPs
+		status.Networks.NatIPRotation = previous.Networks.NatIPRotation
+	}
+	if !c.reconcilesSubsystem(SubsystemFirewalls) {
+		status.Networks.FirewallPolicy = previous.Networks.FirewallPolicy
+	}
+	if !c.reconcilesSubsystem(SubsystemPrivateServiceConnect) {
+		status.Networks.PrivateServiceConnectEndpoints = previous.Networks.PrivateServiceConnectEndpoints
+	}
+	if !c.reconcilesSubsystem(SubsystemPeerings) {
+		status.Networks.Peerings = previous.Networks.Peerings
+	}
+	if !c.reconcilesSubsystem(SubsystemPacketMirroring) {
+		status.Networks.PacketMirroring = previous.Networks.PacketMirroring
+	}
+	if !c.reconcilesSubsystem(SubsystemPrivateDNSZone) {
+		status.Networks.PrivateDNSZone = previous.Networks.PrivateDNSZone
+	}
+	if !c.reconcilesSubsystem(SubsystemNCCSpoke) {
+		status.Networks.NCCSpoke = previous.Networks.NCCSpoke
+	}
+	return nil
+}
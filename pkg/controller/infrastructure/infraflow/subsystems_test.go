@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
+	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("Subsystems", func() {
+	Describe("#ParseSubsystems", func() {
+		It("should return no subsystems for an empty value", func() {
+			Expect(ParseSubsystems("")).To(BeNil())
+			Expect(ParseSubsystems(" , ")).To(BeNil())
+		})
+
+		It("should parse the comma-separated subsystems", func() {
+			Expect(ParseSubsystems("nat, firewalls,private-dns-zone")).To(Equal([]string{SubsystemNAT, SubsystemFirewalls, SubsystemPrivateDNSZone}))
+		})
+
+		It("should reject unknown subsystems", func() {
+			_, err := ParseSubsystems("nat,vpc")
+			Expect(err).To(MatchError(ContainSubstring(`unknown subsystem "vpc"`)))
+		})
+	})
+
+	Describe("#Reconcile", func() {
+		const (
+			addressesCollection = "projects/project/regions/europe-west1/addresses"
+			firewallPath        = "projects/project/global/firewalls/" + fakeClusterName + "-allow-health-checks"
+		)
+
+		var (
+			ctx    = context.Background()
+			server *fake.Server
+			infra  *extensionsv1alpha1.Infrastructure
+			status *v1alpha1.InfrastructureStatus
+		)
+
+		BeforeEach(func() {
+			var err error
+			server, err = fake.NewServer()
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(server.Close)
+			DeferCleanup(test.WithFeatureGate(features.ExtensionFeatureGate, features.DisableGardenerServiceAccountCreation, false))
+
+			infra = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+				Networks: v1alpha1.NetworkConfig{
+					Workers:  "10.250.0.0/16",
+					CloudNAT: &v1alpha1.CloudNAT{NatIPCount: ptr.To[int32](1)},
+				},
+			})
+			_, status, err = reconcileWithFakeServer(ctx, server, infra)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.ServiceAccountEmail).NotTo(BeEmpty())
+			Expect(status.Networks.NatIPs).To(HaveLen(1))
+			Expect(status.Networks.CloudNAT).NotTo(BeNil())
+
+			// The resources drift from the desired state and the sweep for orphaned resources is due again.
+			Expect(server.List(addressesCollection)).To(HaveLen(1))
+			server.Delete(firewallPath)
+			infra.Spec.ProviderConfig = newFakeInfrastructure(&v1alpha1.InfrastructureConfig{
+				Networks: v1alpha1.NetworkConfig{
+					Workers:  "10.250.0.0/16",
+					CloudNAT: &v1alpha1.CloudNAT{NatIPCount: ptr.To[int32](2)},
+				},
+			}).Spec.ProviderConfig
+
+			state, err := NewFlowStateFromJSON(infra.Status.State.Raw)
+			Expect(err).NotTo(HaveOccurred())
+			delete(state.Data, flowStateKeyOrphanedResourcesSweep)
+			raw, err := state.ToJSON()
+			Expect(err).NotTo(HaveOccurred())
+			infra.Status.State = &runtime.RawExtension{Raw: raw}
+		})
+
+		It("should only reconcile the selected subsystems and keep the status of the other ones", func() {
+			infra.Annotations[gcpinternal.AnnotationKeyReconcile] = SubsystemFirewalls
+
+			reconciler, newStatus, err := reconcileWithFakeServer(ctx, server, infra)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, ok := server.Get(firewallPath)
+			Expect(ok).To(BeTrue())
+			Expect(server.List(addressesCollection)).To(HaveLen(1))
+
+			Expect(newStatus.ServiceAccountEmail).To(Equal(status.ServiceAccountEmail))
+			Expect(newStatus.Networks.VPC.CloudRouter).To(Equal(status.Networks.VPC.CloudRouter))
+			Expect(newStatus.Networks.CloudNAT).To(Equal(status.Networks.CloudNAT))
+			Expect(newStatus.Networks.NatIPs).To(Equal(status.Networks.NatIPs))
+			Expect(newStatus.Networks.Subnets).To(Equal(status.Networks.Subnets))
+
+			By("not sweeping for orphaned resources")
+			_, swept := reconciler.OrphanedResources()
+			Expect(swept).To(BeFalse())
+		})
+
+		It("should reconcile all subsystems without the annotation", func() {
+			reconciler, newStatus, err := reconcileWithFakeServer(ctx, server, infra)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, ok := server.Get(firewallPath)
+			Expect(ok).To(BeTrue())
+			Expect(server.List(addressesCollection)).To(HaveLen(2))
+			Expect(newStatus.Networks.NatIPs).To(HaveLen(2))
+
+			_, swept := reconciler.OrphanedResources()
+			Expect(swept).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// ignoredTerraformResourceTypes are the types of the resources of the Terraformer state which are not verified, because
+// the flow reconciliation derives them from the InfrastructureConfig and does not look them up by name.
+var ignoredTerraformResourceTypes = sets.New("google_project_iam_member", "null_resource")
+
+// terraformResource describes how a resource of the Terraformer state is found by the flow reconciliation.
+type terraformResource struct {
+	// attribute is the attribute of the resource in the Terraformer state which holds its name.
+	attribute string
+	// name is the name which the flow reconciliation expects for the resource.
+	name string
+	// exists checks whether the resource with the given name exists in GCP.
+	exists func(ctx context.Context, name string, attributes map[string]interface{}) (bool, error)
+}
+
+// terraformResources returns the resources of the Terraformer state which are taken over by the flow reconciliation,
+// keyed by their address in the Terraformer state.
+func (c *FlowReconciler) terraformResources() map[string]terraformResource {
+	region := c.infra.Spec.Region
+	subnetExists := func(ctx context.Context, name string, _ map[string]interface{}) (bool, error) {
+		subnet, err := c.computeClient.GetSubnet(ctx, region, name)
+		return subnet != nil, err
+	}
+	firewallRuleExists := func(ctx context.Context, name string, _ map[string]interface{}) (bool, error) {
+		rule, err := c.computeClient.GetFirewallRule(ctx, name)
+		return rule != nil, err
+	}
+
+	return map[string]terraformResource{
+		"google_compute_network.network": {
+			name: c.vpcNameFromConfig(),
+			exists: func(ctx context.Context, name string, _ map[string]interface{}) (bool, error) {
+				vpc, err := c.computeClient.GetNetwork(ctx, name)
+				return vpc != nil, err
+			},
+		},
+		"google_compute_subnetwork.subnetwork-nodes":    {name: c.subnetNameFromConfig(), exists: subnetExists},
+		"google_compute_subnetwork.subnetwork-internal": {name: c.internalSubnetNameFromConfig(), exists: subnetExists},
+		"google_compute_router.router": {
+			name: c.cloudRouterNameFromConfig(),
+			exists: func(ctx context.Context, name string, _ map[string]interface{}) (bool, error) {
+				router, err := c.computeClient.GetRouter(ctx, region, name)
+				return router != nil, err
+			},
+		},
+		"google_compute_router_nat.nat": {
+			name: c.cloudNatNameFromConfig(),
+			exists: func(ctx context.Context, name string, _ map[string]interface{}) (bool, error) {
+				router, err := c.computeClient.GetRouter(ctx, region, c.cloudRouterNameFromConfig())
+				if err != nil || router == nil {
+					return false, err
+				}
+				return routerHasNAT(router.Nats, name), nil
+			},
+		},
+		"google_compute_firewall.rule-allow-internal-access": {name: firewallRuleAllowInternalName(c.clusterName), exists: firewallRuleExists},
+		"google_compute_firewall.rule-allow-external-access": {name: firewallRuleAllowExternalName(c.clusterName), exists: firewallRuleExists},
+		"google_compute_firewall.rule-allow-health-checks":   {name: firewallRuleAllowHealthChecksName(c.clusterName), exists: firewallRuleExists},
+		"google_service_account.serviceaccount": {
+			attribute: "account_id",
+			name:      c.serviceAccountNameFromConfig(),
+			exists: func(ctx context.Context, name string, attributes map[string]interface{}) (bool, error) {
+				sa, err := c.iamClient.GetServiceAccount(ctx, name)
+				if err != nil || sa == nil {
+					return false, err
+				}
+				email, _ := shared.AttributeAsString(attributes, "email")
+				return sa.Email == email, nil
+			},
+		},
+	}
+}
+
+// VerifyTerraformState compares the resources recorded in the given Terraformer state with the resources which the flow
+// reconciliation manages for the infrastructure and checks that they exist in GCP. It returns the discrepancies which
+// prevent a safe migration of the infrastructure to the flow reconciliation.
+func (c *FlowReconciler) VerifyTerraformState(ctx context.Context, tfState *shared.TerraformState) ([]string, error) {
+	var (
+		discrepancies []string
+		resources     = c.terraformResources()
+	)
+
+	for _, resource := range tfState.Resources {
+		if resource.Mode != shared.ModeManaged || ignoredTerraformResourceTypes.Has(resource.Type) {
+			continue
+		}
+
+		address := resource.Type + "." + resource.Name
+		expected, ok := resources[address]
+		if !ok {
+			discrepancies = append(discrepancies, fmt.Sprintf("%s in the Terraformer state is not managed by the flow reconciliation", address))
+			continue
+		}
+		if len(resource.Instances) != 1 {
+			discrepancies = append(discrepancies, fmt.Sprintf("%s has %d instances in the Terraformer state", address, len(resource.Instances)))
+			continue
+		}
+
+		attributes := resource.Instances[0].Attributes
+		attribute := expected.attribute
+		if attribute == "" {
+			attribute = shared.AttributeKeyName
+		}
+		name, _ := shared.AttributeAsString(attributes, attribute)
+		if name != expected.name {
+			discrepancies = append(discrepancies, fmt.Sprintf("%s %s in the Terraformer state does not match the expected name %s", address, name, expected.name))
+			continue
+		}
+
+		exists, err := expected.exists(ctx, name, attributes)
+		if err != nil {
+			return nil, fmt.Errorf("could not verify %s %s: %w", address, name, err)
+		}
+		if !exists {
+			discrepancies = append(discrepancies, fmt.Sprintf("%s %s in the Terraformer state does not exist", address, name))
+		}
+	}
+
+	return discrepancies, nil
+}
+
+// MigrateTerraformState converts the given Terraformer state into the FlowState of the infrastructure. The resources of
+// the Terraformer state are looked up by name by the flow reconciliation, hence only the decisions of Terraformer which
+// cannot be derived from the names are carried over, i.e. the ownership of a CloudNAT with a custom name, whether the
+// service account of the shoot was created and the roles granted to it.
+func (c *FlowReconciler) MigrateTerraformState(tfState *shared.TerraformState) (*runtime.RawExtension, error) {
+	state := NewFlowState()
+	// A CloudNAT with a custom name was created by Terraform and is owned by the extension.
+	if name := tfState.GetManagedResourceInstanceName("google_compute_router_nat", "nat"); name != nil && *name != c.defaultCloudNatName() {
+		state.Set(flowStateKeyCloudNATName, *name)
+	}
+	// Terraformer did not create the service account for infrastructures which were created while the
+	// DisableGardenerServiceAccountCreation feature gate was enabled, even if the feature gate was disabled later on.
+	if len(tfState.FindManagedResourcesByType("google_service_account")) == 0 {
+		state.Set(flowStateKeySkipServiceAccountCreation, "true")
+	}
+	// The roles granted by Terraformer are recorded like the ones granted by the flow reconciliation, otherwise they would
+	// never be revoked.
+	roles := sets.New[string]()
+	for _, resource := range tfState.FindManagedResourcesByType("google_project_iam_member") {
+		if !strings.HasPrefix(resource.Name, "serviceaccount-role-") {
+			continue
+		}
+		for _, instance := range resource.Instances {
+			if role, ok := shared.AttributeAsString(instance.Attributes, "role"); ok {
+				roles.Insert(role)
+			}
+		}
+	}
+	if roles.Len() > 0 {
+		data, err := json.Marshal(map[string][]string{c.serviceAccountNameFromConfig(): sets.List(roles)})
+		if err != nil {
+			return nil, err
+		}
+		state.Set(flowStateKeyServiceAccountRoles, string(data))
+	}
+
+	raw, err := state.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
+func routerHasNAT(nats []*compute.RouterNat, name string) bool {
+	for _, nat := range nats {
+		if nat.Name == name {
+			return true
+		}
+	}
+	return false
+}
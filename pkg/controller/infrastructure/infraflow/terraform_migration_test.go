@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/controller/infrastructure/infraflow/shared"
+	gcpinternal "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("Terraform migration", func() {
+	const (
+		project     = "project"
+		region      = "europe-west1"
+		clusterName = "shoot--foo--bar"
+	)
+
+	var (
+		ctx    = context.Background()
+		server *fake.Server
+
+		iamClient  gcpclient.IAMClient
+		reconciler *FlowReconciler
+		tfState    *shared.TerraformState
+	)
+
+	BeforeEach(func() {
+		var err error
+		server, err = fake.NewServer()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(server.Close)
+
+		serviceAccount, err := gcpinternal.GetServiceAccountFromJSON(server.ServiceAccountJSON(project))
+		Expect(err).NotTo(HaveOccurred())
+		computeClient, err := gcpclient.NewComputeClient(ctx, serviceAccount, server.ClientOptions()...)
+		Expect(err).NotTo(HaveOccurred())
+		iamClient, err = gcpclient.NewIAMClient(ctx, serviceAccount, server.ClientOptions()...)
+		Expect(err).NotTo(HaveOccurred())
+
+		wb := shared.NewWhiteboard()
+		reconciler = &FlowReconciler{
+			BasicFlowContext: shared.NewBasicFlowContext(logr.Discard(), wb, nil),
+			whiteboard:       wb,
+			infra:            &extensionsv1alpha1.Infrastructure{Spec: extensionsv1alpha1.InfrastructureSpec{Region: region}},
+			config:           &gcp.InfrastructureConfig{Networks: gcp.NetworkConfig{Workers: "10.250.0.0/16"}},
+			serviceAccount:   serviceAccount,
+			clusterName:      clusterName,
+			state:            NewFlowState(),
+			computeClient:    computeClient,
+			iamClient:        iamClient,
+		}
+
+		data, err := os.ReadFile("testdata/terraform.tfstate")
+		Expect(err).NotTo(HaveOccurred())
+		tfState, err = shared.UnmarshalTerraformState(data)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The resources of the Terraformer state exist in GCP.
+		server.Put("projects/project/global/networks/"+clusterName, map[string]any{})
+		server.Put("projects/project/regions/europe-west1/subnetworks/"+clusterName+"-nodes", map[string]any{"ipCidrRange": "10.250.0.0/16"})
+		server.Put("projects/project/regions/europe-west1/routers/"+clusterName+"-cloud-router", map[string]any{
+			"nats": []any{map[string]any{"name": clusterName + "-cloud-nat"}},
+		})
+		for _, name := range []string{"-allow-internal-access", "-allow-external-access", "-allow-health-checks"} {
+			server.Put("projects/project/global/firewalls/"+clusterName+name, map[string]any{})
+		}
+		_, err = iamClient.CreateServiceAccount(ctx, clusterName)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	attributes := func(address string) map[string]interface{} {
+		for _, resource := range tfState.Resources {
+			if resource.Type+"."+resource.Name == address {
+				return resource.Instances[0].Attributes
+			}
+		}
+		Fail("resource " + address + " not found in the Terraformer state")
+		return nil
+	}
+
+	removeResource := func(address string) {
+		for i, resource := range tfState.Resources {
+			if resource.Type+"."+resource.Name == address {
+				tfState.Resources = append(tfState.Resources[:i], tfState.Resources[i+1:]...)
+				return
+			}
+		}
+		Fail("resource " + address + " not found in the Terraformer state")
+	}
+
+	Describe("#VerifyTerraformState", func() {
+		It("should not find discrepancies if the resources of the Terraformer state exist", func() {
+			Expect(reconciler.VerifyTerraformState(ctx, tfState)).To(BeEmpty())
+		})
+
+		It("should report resources which were deleted since the last reconciliation", func() {
+			server.Put("projects/project/regions/europe-west1/routers/"+clusterName+"-cloud-router", map[string]any{})
+			Expect(reconciler.computeClient.DeleteFirewallRule(ctx, clusterName+"-allow-health-checks")).To(Succeed())
+			Expect(iamClient.DeleteServiceAccount(ctx, clusterName)).To(Succeed())
+
+			Expect(reconciler.VerifyTerraformState(ctx, tfState)).To(ConsistOf(
+				"google_compute_firewall.rule-allow-health-checks shoot--foo--bar-allow-health-checks in the Terraformer state does not exist",
+				"google_compute_router_nat.nat shoot--foo--bar-cloud-nat in the Terraformer state does not exist",
+				"google_service_account.serviceaccount shoot--foo--bar in the Terraformer state does not exist",
+			))
+		})
+
+		It("should report resources which the flow would look up with a different name", func() {
+			reconciler.config.Networks.CloudNAT = &gcp.CloudNAT{Name: ptr.To("nat")}
+			reconciler.config.Networks.ExistingSubnets = &gcp.ExistingSubnets{Workers: ptr.To("workers")}
+
+			Expect(reconciler.VerifyTerraformState(ctx, tfState)).To(ConsistOf(
+				"google_compute_router_nat.nat shoot--foo--bar-cloud-nat in the Terraformer state does not match the expected name nat",
+				"google_compute_subnetwork.subnetwork-nodes shoot--foo--bar-nodes in the Terraformer state does not match the expected name workers",
+			))
+		})
+
+		It("should report resources which are not managed by the flow", func() {
+			tfState.Resources = append(tfState.Resources,
+				shared.TFResource{Mode: shared.ModeManaged, Type: "google_compute_route", Name: "route", Instances: []shared.TFInstance{{}}},
+				shared.TFResource{Mode: shared.ModeManaged, Type: "google_compute_subnetwork", Name: "subnetwork-internal"},
+				shared.TFResource{Mode: "data", Type: "google_compute_address", Name: "nat-ip"},
+			)
+
+			Expect(reconciler.VerifyTerraformState(ctx, tfState)).To(ConsistOf(
+				"google_compute_route.route in the Terraformer state is not managed by the flow reconciliation",
+				"google_compute_subnetwork.subnetwork-internal has 0 instances in the Terraformer state",
+			))
+		})
+
+		It("should return errors of the API", func() {
+			server.Fail(http.MethodGet, "/compute/v1/projects/project/global/networks/"+clusterName, http.StatusInternalServerError)
+
+			_, err := reconciler.VerifyTerraformState(ctx, tfState)
+			Expect(err).To(MatchError(ContainSubstring("could not verify google_compute_network.network " + clusterName)))
+		})
+	})
+
+	Describe("#MigrateTerraformState", func() {
+		flowState := func() *FlowState {
+			raw, err := reconciler.MigrateTerraformState(tfState)
+			Expect(err).NotTo(HaveOccurred())
+			state, err := NewFlowStateFromJSON(raw.Raw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.HasValidVersion()).To(BeTrue())
+			return state
+		}
+
+		It("should not carry over resources which are found by their default names", func() {
+			removeResource("google_project_iam_member.serviceaccount-role-0")
+
+			Expect(flowState().Data).To(BeEmpty())
+		})
+
+		It("should carry over the ownership of a CloudNAT with a custom name", func() {
+			attributes("google_compute_router_nat.nat")["name"] = "nat"
+			removeResource("google_project_iam_member.serviceaccount-role-0")
+
+			Expect(flowState().Data).To(Equal(map[string]string{flowStateKeyCloudNATName: "nat"}))
+		})
+
+		It("should carry over the roles granted to the service account", func() {
+			tfState.Resources = append(tfState.Resources, shared.TFResource{
+				Mode: shared.ModeManaged,
+				Type: "google_project_iam_member",
+				Name: "serviceaccount-role-1",
+				Instances: []shared.TFInstance{{Attributes: map[string]interface{}{
+					"role":   "roles/logging.logWriter",
+					"member": "serviceAccount:" + clusterName + "@project.iam.gserviceaccount.com",
+				}}},
+			})
+
+			reconciler.state = flowState()
+			Expect(reconciler.grantedServiceAccountRoles()).To(Equal(map[string][]string{
+				clusterName: {"roles/compute.viewer", "roles/logging.logWriter"},
+			}))
+		})
+
+		It("should keep an infrastructure without service account without one", func() {
+			removeResource("google_service_account.serviceaccount")
+			Expect(iamClient.DeleteServiceAccount(ctx, clusterName)).To(Succeed())
+			reconciler.state = flowState()
+
+			Expect(reconciler.ensureServiceAccount(ctx)).To(Succeed())
+			Expect(iamClient.GetServiceAccount(ctx, clusterName)).To(BeNil())
+		})
+	})
+})
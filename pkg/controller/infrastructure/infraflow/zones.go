@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+// flowStateKeyZoneSubnets is the key of the names of the subnets of zones created by the extension in the FlowState.
+const flowStateKeyZoneSubnets = "zoneSubnets"
+
+// zoneSubnets are the subnets of a zone of the InfrastructureConfig.
+type zoneSubnets struct {
+	zone     string
+	workers  *compute.Subnetwork
+	internal *compute.Subnetwork
+}
+
+// zoneSubnetName returns the name of the subnet with the given purpose of the given zone. The subnets are named after
+// the suffix of the zone, as the names of subnets are limited to 63 characters.
+func (c *FlowReconciler) zoneSubnetName(purpose gcp.SubnetPurpose, zone string) string {
+	return fmt.Sprintf("%s-%s-%s", c.clusterName, purpose, strings.TrimPrefix(zone, c.infra.Spec.Region+"-"))
+}
+
+// createdZoneSubnets returns the names of the subnets of zones which were created by the extension.
+func (c *FlowReconciler) createdZoneSubnets() []string {
+	if data := c.state.Get(flowStateKeyZoneSubnets); data != "" {
+		return strings.Split(data, ",")
+	}
+	return nil
+}
+
+func (c *FlowReconciler) storeCreatedZoneSubnets(names []string) {
+	if len(names) == 0 {
+		c.state.Delete(flowStateKeyZoneSubnets)
+		return
+	}
+	c.state.Set(flowStateKeyZoneSubnets, strings.Join(names, ","))
+}
+
+// ensureZoneSubnets creates or updates the worker and internal subnets of the zones of the InfrastructureConfig. The
+// subnets of zones which are not configured anymore are deleted by ensureRemovedZoneSubnetsDeleted, as they are still
+// referenced by the CloudNAT.
+func (c *FlowReconciler) ensureZoneSubnets(ctx context.Context) error {
+	if len(c.config.Networks.Zones) == 0 {
+		return nil
+	}
+
+	if err := c.ensureObjectKeys(ObjectKeyVPC); err != nil {
+		return err
+	}
+
+	var (
+		vpc    = GetObject[*compute.Network](c.whiteboard, ObjectKeyVPC)
+		result []zoneSubnets
+		err    error
+	)
+
+	for _, zone := range c.config.Networks.Zones {
+		subnets := zoneSubnets{zone: zone.Name}
+
+		workers := targetSubnetState(
+			c.zoneSubnetName(gcp.PurposeNodes, zone.Name),
+			fmt.Sprintf("gardener-managed worker subnet of zone %s", zone.Name),
+			zone.Workers,
+			vpc.SelfLink,
+			c.config.Networks.FlowLogs,
+			nil,
+		)
+		workers.PrivateIpGoogleAccess = ptr.Deref(c.config.Networks.PrivateGoogleAccess, false)
+		if subnets.workers, err = c.ensureZoneSubnet(ctx, workers); err != nil {
+			return err
+		}
+
+		if zone.Internal != nil {
+			internal := targetSubnetState(
+				c.zoneSubnetName(gcp.PurposeInternal, zone.Name),
+				fmt.Sprintf("gardener-managed internal subnet of zone %s", zone.Name),
+				*zone.Internal,
+				vpc.SelfLink,
+				nil,
+				nil,
+			)
+			if subnets.internal, err = c.ensureZoneSubnet(ctx, internal); err != nil {
+				return err
+			}
+		}
+
+		result = append(result, subnets)
+	}
+
+	c.whiteboard.SetObject(ObjectKeyZoneSubnets, result)
+	return nil
+}
+
+func (c *FlowReconciler) ensureZoneSubnet(ctx context.Context, desired *compute.Subnetwork) (*compute.Subnetwork, error) {
+	log := c.LogFromContext(ctx).WithValues("subnet", desired.Name)
+
+	if created := c.createdZoneSubnets(); !slices.Contains(created, desired.Name) {
+		c.storeCreatedZoneSubnets(append(created, desired.Name))
+	}
+
+	subnet, err := c.computeClient.GetSubnet(ctx, c.infra.Spec.Region, desired.Name)
+	if err != nil {
+		return nil, err
+	}
+	if subnet == nil {
+		log.Info("creating subnet of zone")
+		return c.computeClient.InsertSubnet(ctx, c.infra.Spec.Region, desired)
+	}
+	return c.updater.Subnet(ctx, c.computeClient, c.infra.Spec.Region, desired, subnet)
+}
+
+// ensureRemovedZoneSubnetsDeleted deletes the subnets of zones which were created by the extension but are not
+// configured anymore.
+func (c *FlowReconciler) ensureRemovedZoneSubnetsDeleted(ctx context.Context) error {
+	var names []string
+	for _, zone := range c.config.Networks.Zones {
+		names = append(names, c.zoneSubnetName(gcp.PurposeNodes, zone.Name))
+		if zone.Internal != nil {
+			names = append(names, c.zoneSubnetName(gcp.PurposeInternal, zone.Name))
+		}
+	}
+
+	return c.deleteZoneSubnets(ctx, func(name string) bool { return !slices.Contains(names, name) })
+}
+
+// ensureZoneSubnetsDeleted deletes all subnets of zones which were created by the extension.
+func (c *FlowReconciler) ensureZoneSubnetsDeleted(ctx context.Context) error {
+	if err := c.deleteZoneSubnets(ctx, func(string) bool { return true }); err != nil {
+		return err
+	}
+	c.whiteboard.DeleteObject(ObjectKeyZoneSubnets)
+	return nil
+}
+
+func (c *FlowReconciler) deleteZoneSubnets(ctx context.Context, shouldDelete func(name string) bool) error {
+	created := c.createdZoneSubnets()
+	for _, name := range slices.Clone(created) {
+		if !shouldDelete(name) {
+			continue
+		}
+
+		c.LogFromContext(ctx).Info("deleting subnet of zone", "subnet", name)
+		if err := c.computeClient.DeleteSubnet(ctx, c.infra.Spec.Region, name); err != nil {
+			return err
+		}
+		created = slices.DeleteFunc(created, func(n string) bool { return n == name })
+		c.storeCreatedZoneSubnets(created)
+	}
+	return nil
+}
+
+// nodeSubnetURLs returns the self-links of the subnets of the nodes, i.e. of the regional worker subnet or of the worker
+// subnets of the zones.
+func (c *FlowReconciler) nodeSubnetURLs() ([]string, error) {
+	if len(c.config.Networks.Zones) == 0 {
+		if err := c.ensureObjectKeys(ObjectKeyNodeSubnet); err != nil {
+			return nil, err
+		}
+		return []string{GetObject[*compute.Subnetwork](c.whiteboard, ObjectKeyNodeSubnet).SelfLink}, nil
+	}
+
+	if err := c.ensureObjectKeys(ObjectKeyZoneSubnets); err != nil {
+		return nil, err
+	}
+	var urls []string
+	for _, subnets := range GetObject[[]zoneSubnets](c.whiteboard, ObjectKeyZoneSubnets) {
+		urls = append(urls, subnets.workers.SelfLink)
+	}
+	return urls, nil
+}
+
+// zoneCIDRs returns the IPv4 ranges of the subnets of the zones.
+func (c *FlowReconciler) zoneCIDRs() []*string {
+	var cidrs []*string
+	for _, zone := range c.config.Networks.Zones {
+		cidrs = append(cidrs, ptr.To(zone.Workers), zone.Internal)
+	}
+	return cidrs
+}
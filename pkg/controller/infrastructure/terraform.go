@@ -68,7 +68,7 @@ func (t *TerraformReconciler) Reconcile(ctx context.Context, log logr.Logger, cl
 		return nil, nil, err
 	}
 
-	createSA, err := shouldCreateServiceAccount(infra)
+	createSA, err := shouldCreateServiceAccount(infra, config)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -170,8 +170,13 @@ func (t *TerraformReconciler) Delete(ctx context.Context, log logr.Logger, _ *ex
 }
 
 // shouldCreateServiceAccount checks whether terraform needs to create/reconcile a gardener-managed service account.
+// A dedicated node service account is always created if it is requested in the InfrastructureConfig.
 // For existing infrastructure the existence of a created service account is retrieved from the terraformer state.
-func shouldCreateServiceAccount(infra *extensionsv1alpha1.Infrastructure) (bool, error) {
+func shouldCreateServiceAccount(infra *extensionsv1alpha1.Infrastructure, config *api.InfrastructureConfig) (bool, error) {
+	if config.NodeServiceAccount != nil {
+		return true, nil
+	}
+
 	var newCluster, hasServiceAccount bool
 	rawState, err := getTerraformerRawState(infra.Status.State)
 	if err != nil {
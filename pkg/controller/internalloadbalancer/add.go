@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internalloadbalancer
+
+import (
+	"context"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+)
+
+const (
+	// ControllerName is the name of the controller persisting the IP addresses of internal load balancers.
+	ControllerName = "internal-load-balancer"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when adding the internal load balancer controller to the manager.
+type AddOptions struct {
+	// Controller are the controller.Options.
+	Controller controller.Options
+	// LoadBalancers are the internal load balancers exposing kube-apiservers.
+	LoadBalancers []config.APIServerInternalLoadBalancer
+}
+
+// AddToManagerWithOptions adds a controller with the given Options to the given manager. The controller is only added
+// if there are internal load balancers without a configured IP address.
+func AddToManagerWithOptions(_ context.Context, mgr manager.Manager, opts AddOptions) error {
+	namespaces := sets.New[string]()
+	for _, loadBalancer := range opts.LoadBalancers {
+		if loadBalancer.IP == nil {
+			namespaces.Insert(loadBalancer.Namespace)
+		}
+	}
+	if namespaces.Len() == 0 {
+		return nil
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&corev1.Service{}, builder.WithPredicates(IsIngressGatewayService(namespaces))).
+		WithOptions(opts.Controller).
+		Complete(NewReconciler(mgr.GetClient()))
+}
+
+// AddToManager adds a controller with the default Options.
+func AddToManager(ctx context.Context, mgr manager.Manager) error {
+	return AddToManagerWithOptions(ctx, mgr, DefaultAddOptions)
+}
+
+// IsIngressGatewayService returns a predicate that only lets through the istio ingress gateway services in the given
+// namespaces.
+func IsIngressGatewayService(namespaces sets.Set[string]) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetName() == v1beta1constants.DefaultSNIIngressServiceName && namespaces.Has(obj.GetNamespace())
+	})
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internalloadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+type reconciler struct {
+	client client.Client
+}
+
+// NewReconciler creates a new reconcile.Reconciler which persists the IP addresses allocated for internal load
+// balancers.
+func NewReconciler(c client.Client) reconcile.Reconciler {
+	return &reconciler{client: c}
+}
+
+// Reconcile persists the IP address allocated for the load balancer of an istio ingress gateway service in an
+// annotation of its namespace, so that the same IP address is used if the service is recreated.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	service := &corev1.Service{}
+	if err := r.client.Get(ctx, request.NamespacedName, service); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return reconcile.Result{}, nil
+	}
+
+	var ip string
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			ip = ingress.IP
+			break
+		}
+	}
+	if ip == "" {
+		return reconcile.Result{}, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.client.Get(ctx, client.ObjectKey{Name: service.Namespace}, namespace); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get namespace %q: %w", service.Namespace, err)
+	}
+	if persistedIP := namespace.Annotations[gcp.AnnotationKeyInternalLoadBalancerIP]; persistedIP != "" {
+		if persistedIP != ip {
+			log.FromContext(ctx).Info("Load balancer IP differs from persisted IP", "ip", ip, "persistedIP", persistedIP)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	log.FromContext(ctx).Info("Persisting load balancer IP", "ip", ip)
+	patch := client.MergeFrom(namespace.DeepCopy())
+	metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, gcp.AnnotationKeyInternalLoadBalancerIP, ip)
+	return reconcile.Result{}, r.client.Patch(ctx, namespace, patch)
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internalloadbalancer_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/internalloadbalancer"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+var _ = Describe("Reconciler", func() {
+	const namespaceName = "istio-ingress-handler-internal"
+
+	var (
+		ctx = context.TODO()
+
+		c client.Client
+		r reconcile.Reconciler
+
+		namespace *corev1.Namespace
+		service   *corev1.Service
+		request   reconcile.Request
+	)
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespaceName}}
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "istio-ingressgateway", Namespace: namespaceName},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.10"}},
+			}},
+		}
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: service.Name, Namespace: namespaceName}}
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(namespace, service).Build()
+		r = NewReconciler(c)
+	})
+
+	It("should persist the allocated IP address", func() {
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Annotations).To(HaveKeyWithValue(gcp.AnnotationKeyInternalLoadBalancerIP, "10.0.0.10"))
+	})
+
+	Context("IP address already persisted", func() {
+		BeforeEach(func() {
+			namespace.Annotations = map[string]string{gcp.AnnotationKeyInternalLoadBalancerIP: "10.0.0.20"}
+		})
+
+		It("should not overwrite the persisted IP address", func() {
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+			Expect(namespace.Annotations).To(HaveKeyWithValue(gcp.AnnotationKeyInternalLoadBalancerIP, "10.0.0.20"))
+		})
+	})
+
+	Context("no IP address allocated yet", func() {
+		BeforeEach(func() {
+			service.Status.LoadBalancer.Ingress = nil
+		})
+
+		It("should not persist anything", func() {
+			Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+			Expect(namespace.Annotations).NotTo(HaveKey(gcp.AnnotationKeyInternalLoadBalancerIP))
+		})
+	})
+})
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package machinedebug
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+const (
+	// AnnotationCaptureDebugInfo is the annotation which requests capturing the serial console output and a screenshot
+	// of the instance backing a machine.
+	AnnotationCaptureDebugInfo = "gcp.provider.extensions.gardener.cloud/capture-debug-info"
+
+	// DataKeySerialPortOutput is the data key of the captured serial console output.
+	DataKeySerialPortOutput = "serial-port-output.log"
+	// DataKeyScreenshot is the binary data key of the captured screenshot.
+	DataKeyScreenshot = "screenshot.png"
+	// DataKeyScreenshotError is the data key holding the reason why no screenshot could be captured.
+	DataKeyScreenshotError = "screenshot-error"
+	// DataKeyInstance is the data key of the captured instance.
+	DataKeyInstance = "instance"
+	// DataKeyCapturedAt is the data key of the capture timestamp.
+	DataKeyCapturedAt = "captured-at"
+
+	// maxSerialPortOutputSize is the maximum number of serial console bytes stored. Only the tail is kept because it
+	// usually contains the reason of a crash.
+	maxSerialPortOutputSize = 512 * 1024
+	// maxScreenshotSize is the maximum size of a stored screenshot to stay well below the ConfigMap size limit.
+	maxScreenshotSize = 256 * 1024
+
+	providerGCP = "GCP"
+)
+
+// ConfigMapName returns the name of the ConfigMap which holds the debug information of the given machine.
+func ConfigMapName(machineName string) string {
+	return machineName + "-debug-info"
+}
+
+type reconciler struct {
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
+}
+
+// NewReconciler creates a new reconcile.Reconciler which captures debug information of GCP instances.
+func NewReconciler(c client.Client, gcpClientFactory gcpclient.Factory) reconcile.Reconciler {
+	return &reconciler{
+		client:           c,
+		gcpClientFactory: gcpClientFactory,
+	}
+}
+
+// Reconcile captures the serial console output and a screenshot of the instance backing the machine into a ConfigMap
+// and removes the capture annotation afterwards.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.FromContext(ctx)
+
+	machine := &machinev1alpha1.Machine{}
+	if err := r.client.Get(ctx, request.NamespacedName, machine); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if machine.DeletionTimestamp != nil || machine.Annotations[AnnotationCaptureDebugInfo] != "true" {
+		return reconcile.Result{}, nil
+	}
+
+	machineClass := &machinev1alpha1.MachineClass{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: machine.Spec.Class.Name}, machineClass); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get machine class %q: %w", machine.Spec.Class.Name, err)
+	}
+	if machineClass.Provider != providerGCP {
+		return reconcile.Result{}, nil
+	}
+
+	if machine.Spec.ProviderID == "" {
+		logger.Info("Machine has no provider ID yet, requeueing")
+		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	zone, instance, err := gcp.ParseProviderID(machine.Spec.ProviderID)
+	if err != nil {
+		logger.Error(err, "Cannot capture debug information")
+		return reconcile.Result{}, r.removeAnnotation(ctx, machine)
+	}
+
+	secretRef := machineClass.CredentialsSecretRef
+	if secretRef == nil {
+		secretRef = machineClass.SecretRef
+	}
+	if secretRef == nil {
+		logger.Info("Machine class does not reference any credentials, cannot capture debug information")
+		return reconcile.Result{}, r.removeAnnotation(ctx, machine)
+	}
+
+	computeClient, err := r.gcpClientFactory.Compute(ctx, r.client, *secretRef)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not create compute client: %w", err)
+	}
+
+	logger.Info("Capturing debug information", "zone", zone, "instance", instance)
+
+	serialPortOutput, err := computeClient.GetInstanceSerialPortOutput(ctx, zone, instance)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not get serial port output of instance %q: %w", instance, err)
+	}
+	if len(serialPortOutput) > maxSerialPortOutputSize {
+		serialPortOutput = serialPortOutput[len(serialPortOutput)-maxSerialPortOutputSize:]
+	}
+
+	data := map[string]string{
+		DataKeyInstance:         fmt.Sprintf("%s/%s", zone, instance),
+		DataKeyCapturedAt:       time.Now().UTC().Format(time.RFC3339),
+		DataKeySerialPortOutput: serialPortOutput,
+	}
+	binaryData := map[string][]byte{}
+
+	// Screenshots are only available if the display device is enabled for the instance, hence a failure must not
+	// prevent storing the serial console output.
+	screenshot, err := r.captureScreenshot(ctx, computeClient, zone, instance)
+	if err != nil {
+		data[DataKeyScreenshotError] = err.Error()
+	} else {
+		binaryData[DataKeyScreenshot] = screenshot
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName(machine.Name), Namespace: machine.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.client, configMap, func() error {
+		metav1.SetMetaDataLabel(&configMap.ObjectMeta, "machine", machine.Name)
+		configMap.Data = data
+		configMap.BinaryData = binaryData
+		return nil
+	}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("could not store debug information in config map %q: %w", configMap.Name, err)
+	}
+
+	return reconcile.Result{}, r.removeAnnotation(ctx, machine)
+}
+
+func (r *reconciler) captureScreenshot(ctx context.Context, computeClient gcpclient.ComputeClient, zone, instance string) ([]byte, error) {
+	contents, err := computeClient.GetInstanceScreenshot(ctx, zone, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	screenshot, err := base64.StdEncoding.DecodeString(contents)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode screenshot: %w", err)
+	}
+	if len(screenshot) > maxScreenshotSize {
+		return nil, fmt.Errorf("screenshot size %d exceeds the maximum of %d bytes", len(screenshot), maxScreenshotSize)
+	}
+
+	return screenshot, nil
+}
+
+func (r *reconciler) removeAnnotation(ctx context.Context, machine *machinev1alpha1.Machine) error {
+	patch := client.MergeFrom(machine.DeepCopy())
+	delete(machine.Annotations, AnnotationCaptureDebugInfo)
+	return r.client.Patch(ctx, machine, patch)
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package machinedebug_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/machinedebug"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		zone      = "europe-west1-b"
+		instance  = "shoot--foo--bar-worker-z1-abcde"
+	)
+
+	var (
+		ctx  = context.TODO()
+		ctrl *gomock.Controller
+
+		c                client.Client
+		gcpClientFactory *mockgcpclient.MockFactory
+		computeClient    *mockgcpclient.MockComputeClient
+		r                reconcile.Reconciler
+
+		secretRef    corev1.SecretReference
+		machineClass *machinev1alpha1.MachineClass
+		machine      *machinev1alpha1.Machine
+		request      reconcile.Request
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		scheme := runtime.NewScheme()
+		Expect(kubernetesscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(machinev1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		secretRef = corev1.SecretReference{Name: "cloudprovider", Namespace: namespace}
+		machineClass = &machinev1alpha1.MachineClass{
+			ObjectMeta:           metav1.ObjectMeta{Name: "worker-z1", Namespace: namespace},
+			CredentialsSecretRef: &secretRef,
+			Provider:             "GCP",
+		}
+		machine = &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        instance,
+				Namespace:   namespace,
+				Annotations: map[string]string{AnnotationCaptureDebugInfo: "true"},
+			},
+			Spec: machinev1alpha1.MachineSpec{
+				Class:      machinev1alpha1.ClassSpec{Kind: "MachineClass", Name: machineClass.Name},
+				ProviderID: fmt.Sprintf("gce:///project/%s/%s", zone, instance),
+			},
+		}
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Name: machine.Name, Namespace: namespace}}
+
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(machineClass, machine).Build()
+		gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+		computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+		r = NewReconciler(c, gcpClientFactory)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should store the serial port output and the screenshot and remove the annotation", func() {
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().GetInstanceSerialPortOutput(ctx, zone, instance).Return("kernel panic", nil)
+		computeClient.EXPECT().GetInstanceScreenshot(ctx, zone, instance).Return(base64.StdEncoding.EncodeToString([]byte("png")), nil)
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		configMap := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ConfigMapName(machine.Name)}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKeyWithValue(DataKeySerialPortOutput, "kernel panic"))
+		Expect(configMap.Data).To(HaveKeyWithValue(DataKeyInstance, zone+"/"+instance))
+		Expect(configMap.Data).NotTo(HaveKey(DataKeyScreenshotError))
+		Expect(configMap.BinaryData).To(HaveKeyWithValue(DataKeyScreenshot, []byte("png")))
+
+		Expect(c.Get(ctx, request.NamespacedName, machine)).To(Succeed())
+		Expect(machine.Annotations).NotTo(HaveKey(AnnotationCaptureDebugInfo))
+	})
+
+	It("should store the serial port output even if the screenshot cannot be captured", func() {
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().GetInstanceSerialPortOutput(ctx, zone, instance).Return("kernel panic", nil)
+		computeClient.EXPECT().GetInstanceScreenshot(ctx, zone, instance).Return("", fmt.Errorf("display device not enabled"))
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		configMap := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ConfigMapName(machine.Name)}, configMap)).To(Succeed())
+		Expect(configMap.Data).To(HaveKeyWithValue(DataKeySerialPortOutput, "kernel panic"))
+		Expect(configMap.Data).To(HaveKeyWithValue(DataKeyScreenshotError, "display device not enabled"))
+		Expect(configMap.BinaryData).To(BeEmpty())
+	})
+
+	It("should keep the annotation if capturing the serial port output fails", func() {
+		gcpClientFactory.EXPECT().Compute(ctx, c, secretRef).Return(computeClient, nil)
+		computeClient.EXPECT().GetInstanceSerialPortOutput(ctx, zone, instance).Return("", fmt.Errorf("not found"))
+
+		_, err := r.Reconcile(ctx, request)
+		Expect(err).To(HaveOccurred())
+
+		Expect(c.Get(ctx, request.NamespacedName, machine)).To(Succeed())
+		Expect(machine.Annotations).To(HaveKeyWithValue(AnnotationCaptureDebugInfo, "true"))
+	})
+
+	It("should remove the annotation if the provider ID is malformed", func() {
+		machine.Spec.ProviderID = "gce://foo"
+		Expect(c.Update(ctx, machine)).To(Succeed())
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, machine)).To(Succeed())
+		Expect(machine.Annotations).NotTo(HaveKey(AnnotationCaptureDebugInfo))
+	})
+
+	It("should ignore machines of other providers", func() {
+		machineClass.Provider = "AWS"
+		Expect(c.Update(ctx, machineClass)).To(Succeed())
+
+		Expect(r.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, machine)).To(Succeed())
+		Expect(machine.Annotations).To(HaveKeyWithValue(AnnotationCaptureDebugInfo, "true"))
+	})
+})
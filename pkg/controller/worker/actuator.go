@@ -14,6 +14,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes"
@@ -24,31 +25,38 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 )
 
 type delegateFactory struct {
-	gardenReader client.Reader
-	seedClient   client.Client
-	restConfig   *rest.Config
-	scheme       *runtime.Scheme
+	gardenReader     client.Reader
+	seedClient       client.Client
+	gcpClientFactory gcpclient.Factory
+	restConfig       *rest.Config
+	scheme           *runtime.Scheme
 }
 
 // NewActuator creates a new Actuator that updates the status of the handled WorkerPoolConfigs.
 func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actuator {
 	workerDelegate := &delegateFactory{
-		gardenReader: gardenCluster.GetAPIReader(),
-		seedClient:   mgr.GetClient(),
-		restConfig:   mgr.GetConfig(),
-		scheme:       mgr.GetScheme(),
+		gardenReader:     gardenCluster.GetAPIReader(),
+		seedClient:       mgr.GetClient(),
+		gcpClientFactory: gcpclient.New(),
+		restConfig:       mgr.GetConfig(),
+		scheme:           mgr.GetScheme(),
 	}
 
-	return genericactuator.NewActuator(
-		mgr,
-		gardenCluster,
-		workerDelegate,
-		func(err error) []gardencorev1beta1.ErrorCode {
-			return util.DetermineErrorCodes(err, helper.KnownCodes)
-		},
+	return WithErrorHistory(
+		genericactuator.NewActuator(
+			mgr,
+			gardenCluster,
+			workerDelegate,
+			func(err error) []gardencorev1beta1.ErrorCode {
+				return util.DetermineErrorCodes(err, helper.KnownCodes)
+			},
+		),
+		mgr.GetClient(),
+		mgr.GetScheme(),
 	)
 }
 
@@ -70,6 +78,7 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 
 	return NewWorkerDelegate(
 		d.seedClient,
+		d.gcpClientFactory,
 		d.scheme,
 
 		seedChartApplier,
@@ -81,9 +90,10 @@ func (d *delegateFactory) WorkerDelegate(_ context.Context, worker *extensionsv1
 }
 
 type workerDelegate struct {
-	client  client.Client
-	decoder runtime.Decoder
-	scheme  *runtime.Scheme
+	client           client.Client
+	gcpClientFactory gcpclient.Factory
+	decoder          runtime.Decoder
+	scheme           *runtime.Scheme
 
 	seedChartApplier gardener.ChartApplier
 	serverVersion    string
@@ -92,14 +102,27 @@ type workerDelegate struct {
 	cluster            *extensionscontroller.Cluster
 	worker             *extensionsv1alpha1.Worker
 
-	machineClasses     []map[string]interface{}
-	machineDeployments worker.MachineDeployments
-	machineImages      []api.MachineImage
+	machineClasses              []map[string]interface{}
+	machineDeployments          worker.MachineDeployments
+	machineImages               []api.MachineImage
+	canaryRollouts              []api.CanaryRolloutStatus
+	poolStatuses                []api.WorkerPoolStatus
+	quotaThrottles              []string
+	zoneMigrations              []api.ZoneMigrationStatus
+	zoneCircuitBreakers         []api.ZoneCircuitBreakerStatus
+	machineDeploymentsInCluster map[string]*machinev1alpha1.MachineDeployment
+	machinesInCluster           []machinev1alpha1.Machine
+	freeQuotas                  map[string]float64
+	// serialPortLoggingChecked is true if the worker pools with serial port logging were checked against the
+	// organization policy of the project, and serialPortLoggingDisabledPools are the pools for which it is disabled.
+	serialPortLoggingChecked       bool
+	serialPortLoggingDisabledPools []string
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
 func NewWorkerDelegate(
 	client client.Client,
+	gcpClientFactory gcpclient.Factory,
 	scheme *runtime.Scheme,
 
 	seedChartApplier gardener.ChartApplier,
@@ -113,9 +136,10 @@ func NewWorkerDelegate(
 		return nil, err
 	}
 	return &workerDelegate{
-		client:  client,
-		scheme:  scheme,
-		decoder: serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
+		client:           client,
+		gcpClientFactory: gcpClientFactory,
+		scheme:           scheme,
+		decoder:          serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder(),
 
 		seedChartApplier: seedChartApplier,
 		serverVersion:    serverVersion,
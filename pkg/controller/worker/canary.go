@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+const canaryMachineDeploymentSuffix = "-canary"
+
+// applyCanaryRollout stages the rollout of a changed machine class for the machine deployments of the given pool.
+// As long as the canary phase is not finished, the machine deployments keep their current machine class and the new
+// machine class is only used by additional canary machine deployments. The canary phase is finished once the soak
+// duration has passed and all canary machines are available. The returned status is nil if no rollout is in progress.
+func (w *workerDelegate) applyCanaryRollout(
+	ctx context.Context,
+	poolName string,
+	poolHash string,
+	canaryRollout *apisgcp.CanaryRollout,
+	machineDeployments worker.MachineDeployments,
+) (
+	worker.MachineDeployments,
+	*apisgcp.CanaryRolloutStatus,
+	error,
+) {
+	existingMachineDeployments, err := w.getMachineDeploymentsInCluster(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Only machine deployments which already exist with a different machine class are rolled out to canaries first.
+	// New machine deployments are created with the new machine class right away.
+	var pending []int
+	for i, machineDeployment := range machineDeployments {
+		existing, ok := existingMachineDeployments[machineDeployment.Name]
+		if ok && existing.Spec.Template.Spec.Class.Name != machineDeployment.ClassName {
+			pending = append(pending, i)
+		}
+	}
+	if len(pending) == 0 {
+		return machineDeployments, nil, nil
+	}
+
+	status, err := w.findCanaryRolloutStatus(poolName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if status == nil || status.Hash != poolHash {
+		status = &apisgcp.CanaryRolloutStatus{
+			PoolName:  poolName,
+			Hash:      poolHash,
+			StartTime: metav1.Now(),
+		}
+	}
+
+	var soakDuration time.Duration
+	if canaryRollout.SoakDuration != nil {
+		soakDuration = canaryRollout.SoakDuration.Duration
+	}
+
+	if time.Since(status.StartTime.Time) >= soakDuration && canariesAvailable(existingMachineDeployments, machineDeployments, pending) {
+		return machineDeployments, nil, nil
+	}
+
+	result := make(worker.MachineDeployments, 0, len(machineDeployments)+len(pending))
+	result = append(result, machineDeployments...)
+	for _, i := range pending {
+		canary := machineDeployments[i]
+		canary.Name += canaryMachineDeploymentSuffix
+		canary.Minimum = canaryRollout.Machines
+		canary.Maximum = canaryRollout.Machines
+		canary.MaxSurge = intstr.FromInt32(1)
+		canary.MaxUnavailable = intstr.FromInt32(0)
+		result = append(result, canary)
+
+		currentClassName := existingMachineDeployments[machineDeployments[i].Name].Spec.Template.Spec.Class.Name
+		result[i].ClassName = currentClassName
+		result[i].SecretName = currentClassName
+	}
+
+	return result, status, nil
+}
+
+func canariesAvailable(existingMachineDeployments map[string]*machinev1alpha1.MachineDeployment, machineDeployments worker.MachineDeployments, pending []int) bool {
+	for _, i := range pending {
+		canary, ok := existingMachineDeployments[machineDeployments[i].Name+canaryMachineDeploymentSuffix]
+		if !ok || canary.Spec.Template.Spec.Class.Name != machineDeployments[i].ClassName || canary.Status.AvailableReplicas < canary.Spec.Replicas {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *workerDelegate) findCanaryRolloutStatus(poolName string) (*apisgcp.CanaryRolloutStatus, error) {
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range workerStatus.CanaryRollouts {
+		if status.PoolName == poolName {
+			return &status, nil
+		}
+	}
+	return nil, nil
+}
+
+func (w *workerDelegate) getMachineDeploymentsInCluster(ctx context.Context) (map[string]*machinev1alpha1.MachineDeployment, error) {
+	if w.machineDeploymentsInCluster != nil {
+		return w.machineDeploymentsInCluster, nil
+	}
+
+	machineDeploymentList := &machinev1alpha1.MachineDeploymentList{}
+	if err := w.client.List(ctx, machineDeploymentList, client.InNamespace(w.worker.Namespace)); err != nil {
+		return nil, fmt.Errorf("could not list machine deployments: %w", err)
+	}
+
+	w.machineDeploymentsInCluster = make(map[string]*machinev1alpha1.MachineDeployment, len(machineDeploymentList.Items))
+	for i := range machineDeploymentList.Items {
+		w.machineDeploymentsInCluster[machineDeploymentList.Items[i].Name] = &machineDeploymentList.Items[i]
+	}
+	return w.machineDeploymentsInCluster, nil
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+)
+
+type errorHistoryActuator struct {
+	worker.Actuator
+
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+// WithErrorHistory wraps the given actuator so that errors of the Reconcile and Delete operations are recorded in
+// the error history of the worker provider status.
+func WithErrorHistory(actuator worker.Actuator, c client.Client, scheme *runtime.Scheme) worker.Actuator {
+	return &errorHistoryActuator{
+		Actuator: actuator,
+		client:   c,
+		scheme:   scheme,
+	}
+}
+
+// Reconcile implements worker.Actuator.
+func (a *errorHistoryActuator) Reconcile(ctx context.Context, log logr.Logger, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	err := a.Actuator.Reconcile(ctx, log, w, cluster)
+	a.recordError(ctx, log, w, gardencorev1beta1.LastOperationTypeReconcile, err)
+	return err
+}
+
+// Delete implements worker.Actuator.
+func (a *errorHistoryActuator) Delete(ctx context.Context, log logr.Logger, w *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	err := a.Actuator.Delete(ctx, log, w, cluster)
+	a.recordError(ctx, log, w, gardencorev1beta1.LastOperationTypeDelete, err)
+	return err
+}
+
+// recordError adds the given error to the error history in the provider status of the worker. Failures are only
+// logged to not hide the original error.
+func (a *errorHistoryActuator) recordError(
+	ctx context.Context,
+	log logr.Logger,
+	w *extensionsv1alpha1.Worker,
+	operation gardencorev1beta1.LastOperationType,
+	err error,
+) {
+	if err == nil {
+		return
+	}
+
+	delegate := &workerDelegate{
+		client:  a.client,
+		scheme:  a.scheme,
+		decoder: serializer.NewCodecFactory(a.scheme).UniversalDecoder(),
+		worker:  w,
+	}
+
+	workerStatus, decodeErr := delegate.decodeWorkerProviderStatus()
+	if decodeErr != nil {
+		log.Error(decodeErr, "Could not record error in worker provider status")
+		return
+	}
+
+	workerStatus.ErrorHistory = helper.RecordError(workerStatus.ErrorHistory, string(operation), err, metav1.Now())
+	if updateErr := delegate.updateWorkerProviderStatus(ctx, workerStatus); updateErr != nil {
+		log.Error(updateErr, "Could not record error in worker provider status")
+	}
+}
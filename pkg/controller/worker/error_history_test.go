@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	apiv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+)
+
+type fakeActuator struct {
+	worker.Actuator
+
+	err error
+}
+
+func (f *fakeActuator) Reconcile(_ context.Context, _ logr.Logger, _ *extensionsv1alpha1.Worker, _ *extensionscontroller.Cluster) error {
+	return f.err
+}
+
+var _ = Describe("#WithErrorHistory", func() {
+	var (
+		ctx = context.TODO()
+
+		scheme   *runtime.Scheme
+		c        client.Client
+		actuator *fakeActuator
+		w        *extensionsv1alpha1.Worker
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(api.AddToScheme(scheme)).To(Succeed())
+		Expect(apiv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		w = &extensionsv1alpha1.Worker{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "shoot--foo--bar"},
+			Status: extensionsv1alpha1.WorkerStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ProviderStatus: &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerStatus{
+						TypeMeta:      metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+						MachineImages: []apiv1alpha1.MachineImage{{Name: "gardenlinux", Version: "1.0", Image: "image"}},
+					})},
+				},
+			},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(w).WithStatusSubresource(w).Build()
+		actuator = &fakeActuator{}
+	})
+
+	workerStatus := func() *apiv1alpha1.WorkerStatus {
+		GinkgoHelper()
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(w), w)).To(Succeed())
+		status := &apiv1alpha1.WorkerStatus{}
+		Expect(json.Unmarshal(w.Status.ProviderStatus.Raw, status)).To(Succeed())
+		return status
+	}
+
+	It("should record the error in the provider status and keep the other fields", func() {
+		actuator.err = fmt.Errorf("googleapi: Error 403: QUOTA_EXCEEDED")
+
+		Expect(WithErrorHistory(actuator, c, scheme).Reconcile(ctx, logr.Discard(), w, nil)).To(MatchError(actuator.err))
+
+		status := workerStatus()
+		Expect(status.MachineImages).To(HaveLen(1))
+		Expect(status.ErrorHistory).To(HaveLen(1))
+		Expect(status.ErrorHistory[0].Operation).To(Equal("Reconcile"))
+		Expect(status.ErrorHistory[0].Message).To(Equal(actuator.err.Error()))
+		Expect(status.ErrorHistory[0].Codes).To(ContainElement("ERR_INFRA_QUOTA_EXCEEDED"))
+		Expect(status.ErrorHistory[0].Count).To(Equal(int32(1)))
+	})
+
+	It("should not touch the provider status if the operation succeeds", func() {
+		Expect(WithErrorHistory(actuator, c, scheme).Reconcile(ctx, logr.Discard(), w, nil)).To(Succeed())
+
+		Expect(workerStatus().ErrorHistory).To(BeEmpty())
+	})
+})
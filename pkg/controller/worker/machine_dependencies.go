@@ -6,6 +6,26 @@ package worker
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+const (
+	// ConditionTypeSerialPortLoggingDisabledByPolicy is the type of the condition of the Worker which reports whether
+	// serial port logging is enabled for worker pools but disabled by the organization policy of the project.
+	ConditionTypeSerialPortLoggingDisabledByPolicy gardencorev1beta1.ConditionType = "SerialPortLoggingDisabledByPolicy"
+
+	// constraintDisableSerialPortLogging is the organization policy constraint which disables the export of the serial
+	// console output to Cloud Logging.
+	constraintDisableSerialPortLogging = "constraints/compute.disableSerialPortLogging"
 )
 
 func (w *workerDelegate) DeployMachineDependencies(_ context.Context) error {
@@ -17,10 +37,87 @@ func (w *workerDelegate) CleanupMachineDependencies(_ context.Context) error {
 }
 
 // PreReconcileHook implements genericactuator.WorkerDelegate.
-func (w *workerDelegate) PreReconcileHook(_ context.Context) error {
+func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
+	w.checkSerialPortLogging(ctx)
 	return nil
 }
 
+// checkSerialPortLogging determines the worker pools for which serial port logging is enabled but disabled by the
+// organization policy of the project, since GCP silently ignores the setting in this case. They are reported in the
+// SerialPortLoggingDisabledByPolicy condition. Failures of the check are only logged to not block the reconciliation.
+func (w *workerDelegate) checkSerialPortLogging(ctx context.Context) {
+	logger := log.FromContext(ctx)
+
+	pools, err := w.serialPortLoggingPools()
+	if err != nil {
+		logger.Error(err, "Could not determine worker pools with serial port logging")
+		return
+	}
+	if len(pools) == 0 {
+		w.serialPortLoggingChecked = true
+		return
+	}
+
+	iamClient, err := w.gcpClientFactory.IAM(ctx, w.client, w.worker.Spec.SecretRef)
+	if err != nil {
+		logger.Error(err, "Could not create IAM client to check the organization policy for serial port logging")
+		return
+	}
+
+	enforced, err := iamClient.IsBooleanConstraintEnforced(ctx, constraintDisableSerialPortLogging)
+	if err != nil {
+		logger.Error(err, "Could not check the organization policy for serial port logging", "constraint", constraintDisableSerialPortLogging)
+		return
+	}
+
+	w.serialPortLoggingChecked = true
+	if enforced {
+		logger.Info("Serial port logging is enabled for worker pools but disabled by the organization policy of the project, the serial console output will not be exported to Cloud Logging",
+			"constraint", constraintDisableSerialPortLogging, "workerPools", pools)
+		w.serialPortLoggingDisabledPools = pools
+	}
+}
+
+// serialPortLoggingCondition returns the condition reporting whether serial port logging is disabled by the
+// organization policy. The condition is only added once serial port logging is disabled for the first time, otherwise
+// nil is returned. It is not changed either if the organization policy could not be checked.
+func (w *workerDelegate) serialPortLoggingCondition() []gardencorev1beta1.Condition {
+	conditions := w.worker.Status.Conditions
+	if !w.serialPortLoggingChecked ||
+		(len(w.serialPortLoggingDisabledPools) == 0 && v1beta1helper.GetCondition(conditions, ConditionTypeSerialPortLoggingDisabledByPolicy) == nil) {
+		return nil
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, conditions, ConditionTypeSerialPortLoggingDisabledByPolicy)
+	if len(w.serialPortLoggingDisabledPools) > 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionTrue, "OrganizationPolicyEnforced",
+			fmt.Sprintf("Serial port logging is enabled for the worker pools %s, but the organization policy constraint %s of the project disables it, hence the serial console output is not exported to Cloud Logging.",
+				strings.Join(w.serialPortLoggingDisabledPools, ", "), constraintDisableSerialPortLogging))
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionFalse, "SerialPortLoggingNotDisabled",
+			"Serial port logging is not disabled by the organization policy for any worker pool.")
+	}
+	return []gardencorev1beta1.Condition{condition}
+}
+
+func (w *workerDelegate) serialPortLoggingPools() ([]string, error) {
+	var pools []string
+	for _, pool := range w.worker.Spec.Pools {
+		if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+			continue
+		}
+
+		workerConfig := &apisgcp.WorkerConfig{}
+		if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+			return nil, fmt.Errorf("could not decode provider config of worker pool %q: %w", pool.Name, err)
+		}
+		if ptr.Deref(workerConfig.SerialPortLoggingEnable, false) {
+			pools = append(pools, pool.Name)
+		}
+	}
+	return pools, nil
+}
+
 // PostReconcileHook implements genericactuator.WorkerDelegate.
 func (w *workerDelegate) PostReconcileHook(_ context.Context) error {
 	return nil
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	apiv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
+)
+
+type fakeIAMClient struct {
+	gcpclient.IAMClient
+
+	enforced    bool
+	err         error
+	constraints []string
+}
+
+func (f *fakeIAMClient) IsBooleanConstraintEnforced(_ context.Context, constraint string) (bool, error) {
+	f.constraints = append(f.constraints, constraint)
+	return f.enforced, f.err
+}
+
+var _ = Describe("Machine dependencies", func() {
+	Describe("#PreReconcileHook", func() {
+		var (
+			ctx  = context.TODO()
+			ctrl *gomock.Controller
+
+			scheme           *runtime.Scheme
+			gcpClientFactory *mockgcpclient.MockFactory
+			iamClient        *fakeIAMClient
+			secretRef        corev1.SecretReference
+			w                *extensionsv1alpha1.Worker
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			scheme = runtime.NewScheme()
+			Expect(api.AddToScheme(scheme)).To(Succeed())
+			Expect(apiv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+			iamClient = &fakeIAMClient{}
+			secretRef = corev1.SecretReference{Name: "cloudprovider", Namespace: "shoot--foo--bar"}
+
+			w = &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: secretRef.Namespace},
+				Spec: extensionsv1alpha1.WorkerSpec{
+					SecretRef: secretRef,
+					Pools: []extensionsv1alpha1.WorkerPool{
+						{Name: "pool-1"},
+						{
+							Name: "pool-2",
+							ProviderConfig: &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+								TypeMeta:                metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerConfig"},
+								SerialPortLoggingEnable: ptr.To(true),
+							})},
+						},
+					},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should check the organization policy if serial port logging is enabled", func() {
+			gcpClientFactory.EXPECT().IAM(ctx, nil, secretRef).Return(iamClient, nil)
+			iamClient.enforced = true
+
+			workerDelegate, err := NewWorkerDelegate(nil, gcpClientFactory, scheme, nil, "", w, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			Expect(iamClient.constraints).To(ConsistOf("constraints/compute.disableSerialPortLogging"))
+		})
+
+		It("should not fail if the organization policy cannot be checked", func() {
+			gcpClientFactory.EXPECT().IAM(ctx, nil, secretRef).Return(iamClient, nil)
+			iamClient.err = fmt.Errorf("permission denied")
+
+			workerDelegate, err := NewWorkerDelegate(nil, gcpClientFactory, scheme, nil, "", w, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+		})
+
+		It("should not check the organization policy if serial port logging is not enabled", func() {
+			w.Spec.Pools = w.Spec.Pools[:1]
+
+			workerDelegate, err := NewWorkerDelegate(nil, gcpClientFactory, scheme, nil, "", w, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			Expect(iamClient.constraints).To(BeEmpty())
+		})
+	})
+})
@@ -31,7 +31,11 @@ func (w *workerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	}
 
 	workerStatus.MachineImages = w.machineImages
-	if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
+	workerStatus.CanaryRollouts = w.canaryRollouts
+	workerStatus.Pools = w.poolStatuses
+	workerStatus.ZoneMigrations = w.zoneMigrations
+	workerStatus.ZoneCircuitBreakers = w.zoneCircuitBreakers
+	if err := w.updateWorkerProviderStatus(ctx, workerStatus, append(w.quotaThrottledCondition(), w.serialPortLoggingCondition()...)...); err != nil {
 		return fmt.Errorf("unable to update worker provider status: %w", err)
 	}
 
@@ -7,12 +7,17 @@ package worker
 import (
 	"context"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	genericworkeractuator "github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -21,6 +26,8 @@ import (
 	computev1 "google.golang.org/api/compute/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -34,6 +41,22 @@ var labelRegex = regexp.MustCompile(`[^a-z0-9_-]`)
 
 const (
 	maxGcpLabelCharactersSize = 63
+	// maxGcpLabels is the maximum number of labels of a GCP resource.
+	maxGcpLabels = 64
+	// gcpReservedLabelPrefix is the prefix of label keys reserved by GCP.
+	gcpReservedLabelPrefix = "goog"
+	// gardenerLabelDomain is the domain of labels managed by Gardener which are not propagated from the shoot.
+	gardenerLabelDomain = "gardener.cloud/"
+
+	gceLabelName        = "name"
+	gceLabelClusterName = gcp.LabelKeyClusterName
+	// loggingWriteScope and monitoringWriteScope are the OAuth scopes required by the Ops Agent.
+	loggingWriteScope    = "https://www.googleapis.com/auth/logging.write"
+	monitoringWriteScope = "https://www.googleapis.com/auth/monitoring.write"
+	// defaultOpsAgentVersion is the Ops Agent version installed if no version is configured in the CloudProfileConfig.
+	defaultOpsAgentVersion = "latest"
+	// flexStartMachineCreationTimeout is the default machine creation timeout of flex-start worker pools.
+	flexStartMachineCreationTimeout = 2 * time.Hour
 	// ResourceGPU is the GPU resource . It should be a non-negative integer.
 	ResourceGPU v1.ResourceName = "gpu"
 )
@@ -74,11 +97,16 @@ func (w *workerDelegate) GenerateMachineDeployments(ctx context.Context) (worker
 	return w.machineDeployments, nil
 }
 
-func (w *workerDelegate) generateMachineConfig(_ context.Context) error {
+func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	var (
 		machineDeployments = worker.MachineDeployments{}
 		machineClasses     []map[string]interface{}
 		machineImages      []apisgcp.MachineImage
+		canaryRollouts     []apisgcp.CanaryRolloutStatus
+		poolStatuses       []apisgcp.WorkerPoolStatus
+		quotaThrottles     []string
+		zoneMigrations     []apisgcp.ZoneMigrationStatus
+		circuitBreakers    []apisgcp.ZoneCircuitBreakerStatus
 	)
 
 	infrastructureStatus := &apisgcp.InfrastructureStatus{}
@@ -91,6 +119,21 @@ func (w *workerDelegate) generateMachineConfig(_ context.Context) error {
 		return err
 	}
 
+	var (
+		shoot          *gardencorev1beta1.Shoot
+		resourceLabels map[string]string
+	)
+	if w.cluster != nil && w.cluster.Shoot != nil {
+		shoot = w.cluster.Shoot
+		if raw := shoot.Spec.Provider.InfrastructureConfig; raw != nil && raw.Raw != nil {
+			infrastructureConfig := &apisgcp.InfrastructureConfig{}
+			if _, _, err := w.decoder.Decode(raw.Raw, nil, infrastructureConfig); err != nil {
+				return fmt.Errorf("could not decode infrastructure config: %w", err)
+			}
+			resourceLabels = infrastructureConfig.ResourceLabels
+		}
+	}
+
 	for _, pool := range w.worker.Spec.Pools {
 		zoneLen := int32(len(pool.Zones))
 
@@ -99,8 +142,6 @@ func (w *workerDelegate) generateMachineConfig(_ context.Context) error {
 			return err
 		}
 
-		poolLabels := getGcePoolLabels(w.worker, pool)
-
 		arch := ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
 		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, &arch)
 		if err != nil {
@@ -121,6 +162,12 @@ func (w *workerDelegate) generateMachineConfig(_ context.Context) error {
 			}
 		}
 
+		var shootLabels map[string]string
+		if ptr.Deref(workerConfig.PropagateShootLabels, false) && shoot != nil {
+			shootLabels = shoot.Labels
+		}
+		poolLabels := getGcePoolLabels(w.worker, pool, gcp.ShootLabels(shoot), resourceLabels, shootLabels)
+
 		disks := make([]map[string]interface{}, 0)
 		// root volume
 		if pool.Volume != nil {
@@ -154,44 +201,83 @@ func (w *workerDelegate) generateMachineConfig(_ context.Context) error {
 			disks = append(disks, disk)
 		}
 
+		networkInterface := map[string]interface{}{
+			"disableExternalIP": true,
+		}
+		if gcp.IsIPv6SingleStack(w.cluster.Shoot.Spec.Networking) {
+			networkInterface["stackType"] = gcp.StackTypeIPv6Only
+			networkInterface["ipv6AccessType"] = gcp.IPv6AccessTypeExternal
+		}
+		if gcp.IsDualStack(w.cluster.Shoot.Spec.Networking) {
+			// The IPv6 access type of dual-stack network interfaces is inherited from the subnet.
+			networkInterface["stackType"] = gcp.StackTypeIPv4IPv6
+		}
+		if workerConfig.AliasIPRange != nil {
+			// Infrastructures reconciled by older versions do not report their secondary ranges.
+			if len(nodesSubnet.SecondaryRanges) > 0 && !slices.ContainsFunc(nodesSubnet.SecondaryRanges, func(r apisgcp.SecondaryRange) bool {
+				return r.Name == workerConfig.AliasIPRange.SubnetworkRangeName
+			}) {
+				return fmt.Errorf("secondary range %s of the alias IP range of worker pool %s does not exist in subnet %s", workerConfig.AliasIPRange.SubnetworkRangeName, pool.Name, nodesSubnet.Name)
+			}
+			networkInterface["ipCidrRange"] = workerConfig.AliasIPRange.IPCidrRange
+			networkInterface["subnetworkRangeName"] = workerConfig.AliasIPRange.SubnetworkRangeName
+
+			maxPods, err := w.effectiveMaxPods(pool.Name, workerConfig.AliasIPRange)
+			if err != nil {
+				return err
+			}
+			poolStatuses = append(poolStatuses, apisgcp.WorkerPoolStatus{Name: pool.Name, MaxPods: &maxPods})
+		}
+
 		serviceAccounts := make([]map[string]interface{}, 0)
 		if workerConfig.ServiceAccount != nil {
 			serviceAccounts = append(serviceAccounts, map[string]interface{}{
 				"email":  workerConfig.ServiceAccount.Email,
 				"scopes": workerConfig.ServiceAccount.Scopes,
 			})
-		} else if len(infrastructureStatus.ServiceAccountEmail) != 0 {
+		} else if email := gcpapihelper.NodeServiceAccountEmail(infrastructureStatus, pool.Name); len(email) != 0 {
+			scopes := []string{computev1.ComputeScope}
+			if ptr.Deref(workerConfig.InstallOpsAgent, false) {
+				scopes = append(scopes, loggingWriteScope, monitoringWriteScope)
+			}
 			serviceAccounts = append(serviceAccounts, map[string]interface{}{
-				"email":  infrastructureStatus.ServiceAccountEmail,
-				"scopes": []string{computev1.ComputeScope},
+				"email":  email,
+				"scopes": scopes,
 			})
 		}
 
-		isLiveMigrationAllowed := true
+		existingZonalMachineDeployments, usedIndexes, err := w.existingZonalMachineDeployments(ctx, pool.Name)
+		if err != nil {
+			return err
+		}
+		deploymentNames := zonalMachineDeploymentNames(w.worker.Namespace, pool, existingZonalMachineDeployments, usedIndexes)
+
+		isLiveMigrationAllowed := gcpapihelper.SupportsLiveMigration(pool.MachineType, workerConfig.GPU)
+		poolMachineDeployments := worker.MachineDeployments{}
 
 		for zoneIndex, zone := range pool.Zones {
 			zoneIdx := int32(zoneIndex)
+
+			// The machines of a zone are placed in the worker subnet of the zone if the infrastructure has subnets of zones.
+			zoneNodesSubnet, err := gcpapihelper.FindSubnetByPurposeAndZone(infrastructureStatus.Networks.Subnets, apisgcp.PurposeNodes, zone)
+			if err != nil {
+				return err
+			}
+			zoneNetworkInterface := maps.Clone(networkInterface)
+			zoneNetworkInterface["subnetwork"] = zoneNodesSubnet.Name
+
 			machineClassSpec := map[string]interface{}{
 				"region":             w.worker.Spec.Region,
 				"zone":               zone,
 				"canIpForward":       true,
-				"deletionProtection": false,
+				"deletionProtection": ptr.Deref(workerConfig.DeletionProtection, false),
 				"description":        fmt.Sprintf("Machine of Shoot %s created by machine-controller-manager.", w.worker.Name),
 				"disks":              disks,
 				"labels":             poolLabels,
-				// TODO: make this configurable for the user
-				"metadata": []map[string]string{
-					{
-						"key":   "block-project-ssh-keys",
-						"value": "TRUE",
-					},
-				},
-				"machineType": pool.MachineType,
+				"metadata":           instanceMetadata(workerConfig, w.cloudProfileConfig),
+				"machineType":        pool.MachineType,
 				"networkInterfaces": []map[string]interface{}{
-					{
-						"subnetwork":        nodesSubnet.Name,
-						"disableExternalIP": true,
-					},
+					zoneNetworkInterface,
 				},
 				"secret": map[string]interface{}{
 					"cloudConfig": string(pool.UserData),
@@ -209,12 +295,13 @@ func (w *workerDelegate) generateMachineConfig(_ context.Context) error {
 			}
 
 			var (
-				deploymentName = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+				deploymentName = deploymentNames[zone]
 				className      = fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
-				gpuCount       int32
+				// using this gpu count for scale-from-zero cases
+				gpuCount = gcpapihelper.AttachedGPUCount(pool.MachineType, workerConfig.GPU)
 			)
 
-			machineDeployments = append(machineDeployments, worker.MachineDeployment{
+			poolMachineDeployments = append(poolMachineDeployments, worker.MachineDeployment{
 				Name:                 deploymentName,
 				ClassName:            className,
 				SecretName:           className,
@@ -225,7 +312,7 @@ func (w *workerDelegate) generateMachineConfig(_ context.Context) error {
 				Labels:               addTopologyLabel(pool.Labels, zone),
 				Annotations:          pool.Annotations,
 				Taints:               pool.Taints,
-				MachineConfiguration: genericworkeractuator.ReadMachineConfiguration(pool),
+				MachineConfiguration: machineConfiguration(pool, workerConfig.Scheduling),
 			})
 
 			machineClassSpec["name"] = className
@@ -245,41 +332,101 @@ func (w *workerDelegate) generateMachineConfig(_ context.Context) error {
 					"acceleratorType": workerConfig.GPU.AcceleratorType,
 					"count":           workerConfig.GPU.Count,
 				}
-				// using this gpu count for scale-from-zero cases
-				gpuCount = workerConfig.GPU.Count
-				isLiveMigrationAllowed = false
 			}
 
 			if workerConfig.MinCpuPlatform != nil {
 				machineClassSpec["minCpuPlatform"] = *workerConfig.MinCpuPlatform
 			}
 
-			if pool.NodeTemplate != nil {
+			if capacity := nodeTemplateCapacity(pool, gpuCount); capacity != nil {
 				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-					Capacity:     initializeCapacity(pool.NodeTemplate.Capacity, gpuCount),
+					Capacity:     capacity,
 					InstanceType: pool.MachineType,
 					Region:       w.worker.Spec.Region,
 					Zone:         zone,
 				}
 
-				numGpus := pool.NodeTemplate.Capacity[ResourceGPU]
+				numGpus := capacity[ResourceGPU]
 				if !numGpus.IsZero() {
 					isLiveMigrationAllowed = false
 				}
 			}
 
-			setSchedulingPolicy(machineClassSpec, isLiveMigrationAllowed)
+			setSchedulingPolicy(machineClassSpec, isLiveMigrationAllowed, workerConfig.Scheduling)
 			machineClasses = append(machineClasses, machineClassSpec)
 		}
+
+		if ptr.Deref(workerConfig.ZoneCircuitBreaker, false) {
+			var statuses []apisgcp.ZoneCircuitBreakerStatus
+			poolMachineDeployments, statuses, err = w.applyZoneCircuitBreaker(ctx, pool, poolMachineDeployments)
+			if err != nil {
+				return err
+			}
+			circuitBreakers = append(circuitBreakers, statuses...)
+		}
+
+		migratingMachineDeployments, migrations, err := w.migrateRemovedZones(ctx, pool, existingZonalMachineDeployments, poolMachineDeployments)
+		if err != nil {
+			return err
+		}
+		zoneMigrations = append(zoneMigrations, migrations...)
+
+		if workerConfig.CanaryRollout != nil {
+			var canaryRollout *apisgcp.CanaryRolloutStatus
+			poolMachineDeployments, canaryRollout, err = w.applyCanaryRollout(ctx, pool.Name, workerPoolHash, workerConfig.CanaryRollout, poolMachineDeployments)
+			if err != nil {
+				return err
+			}
+			if canaryRollout != nil {
+				canaryRollouts = append(canaryRollouts, *canaryRollout)
+			}
+		}
+		if ptr.Deref(workerConfig.QuotaAwareRollingUpdate, false) {
+			throttles, err := w.limitSurgeToQuota(ctx, pool, workerConfig, poolMachineDeployments)
+			if err != nil {
+				return err
+			}
+			quotaThrottles = append(quotaThrottles, throttles...)
+		}
+		machineDeployments = append(machineDeployments, poolMachineDeployments...)
+		machineDeployments = append(machineDeployments, migratingMachineDeployments...)
 	}
 
 	w.machineDeployments = machineDeployments
 	w.machineClasses = machineClasses
 	w.machineImages = machineImages
+	w.canaryRollouts = canaryRollouts
+	w.poolStatuses = poolStatuses
+	w.quotaThrottles = quotaThrottles
+	w.zoneMigrations = zoneMigrations
+	w.zoneCircuitBreakers = circuitBreakers
 
 	return nil
 }
 
+// effectiveMaxPods returns the maximum number of pods per node of the given worker pool, i.e. the maximum configured
+// in the kubelet configuration of the Shoot limited by the number of pods fitting into the alias IP range.
+func (w *workerDelegate) effectiveMaxPods(poolName string, aliasIPRange *apisgcp.AliasIPRange) (int32, error) {
+	aliasIPRangeMaxPods, err := gcpapihelper.MaxPodsForAliasIPRange(aliasIPRange.IPCidrRange)
+	if err != nil {
+		return 0, err
+	}
+
+	maxPods := gcpapihelper.DefaultMaxPods
+	if shoot := w.cluster.Shoot; shoot != nil {
+		if shoot.Spec.Kubernetes.Kubelet != nil && shoot.Spec.Kubernetes.Kubelet.MaxPods != nil {
+			maxPods = *shoot.Spec.Kubernetes.Kubelet.MaxPods
+		}
+		for _, worker := range shoot.Spec.Provider.Workers {
+			if worker.Name == poolName && worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil && worker.Kubernetes.Kubelet.MaxPods != nil {
+				maxPods = *worker.Kubernetes.Kubelet.MaxPods
+			}
+		}
+	}
+
+	return min(maxPods, aliasIPRangeMaxPods), nil
+}
+
 func createDiskSpecForVolume(volume v1alpha1.Volume, machineImage string, boot bool, labels map[string]interface{}) (map[string]interface{}, error) {
 	return createDiskSpec(volume.Size, boot, &machineImage, volume.Type, labels)
 }
@@ -331,43 +478,198 @@ func addDiskEncryptionDetails(disk map[string]interface{}, encryption *apisgcp.D
 	disk["encryption"] = encryptionMap
 }
 
-func getGcePoolLabels(worker *v1alpha1.Worker, pool v1alpha1.WorkerPool) map[string]interface{} {
+// getGcePoolLabels returns the labels of the GCE instances and disks of the given worker pool. Besides the labels
+// identifying the shoot, the labels of the pool, the resource labels of the infrastructure and, if given, the labels of
+// the shoot are sanitized and added in this order of precedence unless their keys are reserved.
+func getGcePoolLabels(worker *v1alpha1.Worker, pool v1alpha1.WorkerPool, identifyingLabels, resourceLabels, shootLabels map[string]string) map[string]interface{} {
 	gceInstanceLabels := map[string]interface{}{
-		"name": SanitizeGcpLabelValue(worker.Name),
+		gceLabelName: SanitizeGcpLabelValue(worker.Name),
 		// Add shoot id to keep consistency with the label added to all disks by the csi-driver
-		"k8s-cluster-name": SanitizeGcpLabelValue(worker.Namespace),
+		gceLabelClusterName: SanitizeGcpLabelValue(worker.Namespace),
+	}
+	for k, v := range identifyingLabels {
+		gceInstanceLabels[k] = SanitizeGcpLabelValue(v)
 	}
-	for k, v := range pool.Labels {
-		if label := SanitizeGcpLabel(k); label != "" {
-			gceInstanceLabels[label] = SanitizeGcpLabelValue(v)
+	addGceLabels(gceInstanceLabels, pool.Labels)
+	addGceLabels(gceInstanceLabels, resourceLabels)
+
+	// Labels managed by Gardener only describe the shoot to Gardener and are of no use for cost allocation.
+	filteredShootLabels := make(map[string]string, len(shootLabels))
+	for k, v := range shootLabels {
+		if !strings.Contains(k, gardenerLabelDomain) {
+			filteredShootLabels[k] = v
 		}
 	}
+	addGceLabels(gceInstanceLabels, filteredShootLabels)
+
 	return gceInstanceLabels
 }
 
-func initializeCapacity(capacityList v1.ResourceList, gpuCount int32) v1.ResourceList {
-	resultCapacity := capacityList.DeepCopy()
+// addGceLabels sanitizes the given labels and adds them in the order of their keys as long as their keys are not reserved,
+// not already present and the maximum number of labels is not exceeded.
+func addGceLabels(gceLabels map[string]interface{}, labels map[string]string) {
+	for _, k := range sets.List(sets.KeySet(labels)) {
+		if len(gceLabels) >= maxGcpLabels {
+			return
+		}
+
+		label := SanitizeGcpLabel(k)
+		if label == "" || isReservedGcpLabel(label) {
+			continue
+		}
+		if _, ok := gceLabels[label]; ok {
+			continue
+		}
+		gceLabels[label] = SanitizeGcpLabelValue(labels[k])
+	}
+}
+
+// isReservedGcpLabel returns true if the given sanitized label key is set by the extension or reserved by GCP.
+func isReservedGcpLabel(label string) bool {
+	return label == gceLabelName || label == gceLabelClusterName || label == gcp.LabelKeyShootName || label == gcp.LabelKeyProjectName ||
+		strings.HasPrefix(label, gcpReservedLabelPrefix)
+}
+
+// nodeTemplateCapacity returns the capacity of the nodes of the given pool which is used by the cluster-autoscaler to
+// scale the pool from zero. The capacity provided by Gardener is completed with the resources known from the machine
+// type, i.e. the attached GPUs and the vCPUs and (extended) memory of custom machine types, and the size of the boot
+// disk as ephemeral storage. It returns nil if neither the CPU nor the memory capacity is known.
+func nodeTemplateCapacity(pool v1alpha1.WorkerPool, gpuCount int32) v1.ResourceList {
+	var capacity v1.ResourceList
+	if pool.NodeTemplate != nil {
+		capacity = pool.NodeTemplate.Capacity.DeepCopy()
+	}
+
+	if cpus, memory, ok := gcpapihelper.CustomMachineTypeResources(pool.MachineType); ok {
+		if capacity == nil {
+			capacity = v1.ResourceList{}
+		}
+		capacity[v1.ResourceCPU] = *resource.NewQuantity(cpus, resource.DecimalSI)
+		capacity[v1.ResourceMemory] = *resource.NewQuantity(memory*1024*1024, resource.BinarySI)
+	}
+
+	if capacity == nil {
+		return nil
+	}
+
 	if gpuCount != 0 {
-		resultCapacity[ResourceGPU] = *resource.NewQuantity(int64(gpuCount), resource.DecimalSI)
+		capacity[ResourceGPU] = *resource.NewQuantity(int64(gpuCount), resource.DecimalSI)
 	}
 
-	return resultCapacity
+	if _, ok := capacity[v1.ResourceEphemeralStorage]; !ok && pool.Volume != nil {
+		if size, err := resource.ParseQuantity(pool.Volume.Size); err == nil {
+			capacity[v1.ResourceEphemeralStorage] = size
+		}
+	}
+
+	return capacity
 }
 
-func setSchedulingPolicy(machineClassSpec map[string]interface{}, isLiveMigrationAllowed bool) {
+// setSchedulingPolicy sets the scheduling options of the machine class. Live migration is never configured for
+// machines which do not support it, even if it was requested explicitly.
+func setSchedulingPolicy(machineClassSpec map[string]interface{}, isLiveMigrationAllowed bool, scheduling *apisgcp.Scheduling) {
+	onHostMaintenance := apisgcp.OnHostMaintenanceTerminate
 	if isLiveMigrationAllowed {
-		machineClassSpec["scheduling"] = map[string]interface{}{
-			"automaticRestart":  true,
-			"onHostMaintenance": "MIGRATE",
-			"preemptible":       false,
+		onHostMaintenance = apisgcp.OnHostMaintenanceMigrate
+		if scheduling != nil && scheduling.OnHostMaintenance != nil {
+			onHostMaintenance = *scheduling.OnHostMaintenance
 		}
-	} else {
-		machineClassSpec["scheduling"] = map[string]interface{}{
-			"automaticRestart":  true,
-			"onHostMaintenance": "TERMINATE",
-			"preemptible":       false,
+	}
+
+	automaticRestart := true
+	if scheduling != nil && scheduling.AutomaticRestart != nil {
+		automaticRestart = *scheduling.AutomaticRestart
+	}
+
+	schedulingSpec := map[string]interface{}{
+		"automaticRestart":  automaticRestart,
+		"onHostMaintenance": onHostMaintenance,
+		"preemptible":       false,
+	}
+	if scheduling != nil && scheduling.LocalSSDRecoveryTimeout != nil {
+		schedulingSpec["localSsdRecoveryTimeout"] = scheduling.LocalSSDRecoveryTimeout.Duration.String()
+	}
+	if isFlexStart(scheduling) {
+		// Flex-start VMs are deleted once their run duration has elapsed and cannot be restarted by GCE.
+		schedulingSpec["automaticRestart"] = false
+		schedulingSpec["provisioningModel"] = apisgcp.ProvisioningModelFlexStart
+		schedulingSpec["instanceTerminationAction"] = "DELETE"
+		if scheduling.MaxRunDuration != nil {
+			schedulingSpec["maxRunDuration"] = scheduling.MaxRunDuration.Duration.String()
 		}
 	}
+
+	machineClassSpec["scheduling"] = schedulingSpec
+}
+
+// machineConfiguration reads the machine configuration of the given pool. Flex-start capacity is provisioned from a
+// queue and may take much longer than regular capacity to be fulfilled, hence the machine creation timeout is raised
+// unless it was configured explicitly for the pool.
+func machineConfiguration(pool v1alpha1.WorkerPool, scheduling *apisgcp.Scheduling) *machinev1alpha1.MachineConfiguration {
+	machineConfig := genericworkeractuator.ReadMachineConfiguration(pool)
+	if !isFlexStart(scheduling) {
+		return machineConfig
+	}
+
+	if machineConfig == nil {
+		machineConfig = &machinev1alpha1.MachineConfiguration{}
+	}
+	if machineConfig.MachineCreationTimeout == nil {
+		machineConfig.MachineCreationTimeout = &metav1.Duration{Duration: flexStartMachineCreationTimeout}
+	}
+	return machineConfig
+}
+
+// opsAgentStartupScript installs the Ops Agent of the given version via the official installation script unless it is
+// already running. Startup scripts are executed by the guest environment on every boot.
+const opsAgentStartupScript = `#!/bin/bash
+set -o errexit
+if systemctl is-active --quiet google-cloud-ops-agent; then
+  exit 0
+fi
+curl -sSfL -o /tmp/add-google-cloud-ops-agent-repo.sh https://dl.google.com/cloudagents/add-google-cloud-ops-agent-repo.sh
+bash /tmp/add-google-cloud-ops-agent-repo.sh --also-install --version=%s
+`
+
+func instanceMetadata(workerConfig *apisgcp.WorkerConfig, cloudProfileConfig *apisgcp.CloudProfileConfig) []map[string]string {
+	// TODO: make this configurable for the user
+	metadata := []map[string]string{
+		{
+			"key":   "block-project-ssh-keys",
+			"value": "TRUE",
+		},
+	}
+
+	if workerConfig.SerialPortLoggingEnable != nil {
+		metadata = append(metadata, map[string]string{
+			"key":   "serial-port-logging-enable",
+			"value": strings.ToUpper(strconv.FormatBool(*workerConfig.SerialPortLoggingEnable)),
+		})
+	}
+
+	if workerConfig.EnableOSLogin != nil {
+		metadata = append(metadata, map[string]string{
+			"key":   "enable-oslogin",
+			"value": strings.ToUpper(strconv.FormatBool(*workerConfig.EnableOSLogin)),
+		})
+	}
+
+	if ptr.Deref(workerConfig.InstallOpsAgent, false) {
+		version := defaultOpsAgentVersion
+		if cloudProfileConfig != nil && cloudProfileConfig.OpsAgent != nil && cloudProfileConfig.OpsAgent.Version != nil {
+			version = *cloudProfileConfig.OpsAgent.Version
+		}
+		metadata = append(metadata, map[string]string{
+			"key":   "startup-script",
+			"value": fmt.Sprintf(opsAgentStartupScript, version),
+		})
+	}
+
+	return metadata
+}
+
+func isFlexStart(scheduling *apisgcp.Scheduling) bool {
+	return scheduling != nil && ptr.Deref(scheduling.ProvisioningModel, "") == apisgcp.ProvisioningModelFlexStart
 }
 
 // SanitizeGcpLabel will sanitize the label base on the gcp label Restrictions
@@ -6,6 +6,7 @@ package worker_test
 
 import (
 	"context"
+	"embed"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
@@ -25,6 +26,8 @@ import (
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -32,12 +35,15 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-gcp/charts"
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	mockgcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/mock"
 )
 
 var _ = Describe("Machines", func() {
@@ -69,7 +75,7 @@ var _ = Describe("Machines", func() {
 
 	Context("workerDelegate", func() {
 		BeforeEach(func() {
-			workerDelegate, _ = NewWorkerDelegate(nil, scheme, nil, "", nil, nil)
+			workerDelegate, _ = NewWorkerDelegate(nil, nil, scheme, nil, "", nil, nil)
 		})
 
 		Describe("#GenerateMachineDeployments, #DeployMachineClasses", func() {
@@ -118,6 +124,7 @@ var _ = Describe("Machines", func() {
 				poolLabels map[string]string
 
 				nodeCapacity         corev1.ResourceList
+				nodeTemplateCapacity corev1.ResourceList
 				nodeTemplateZone1    machinev1alpha1.NodeTemplate
 				nodeTemplateZone2    machinev1alpha1.NodeTemplate
 				machineConfiguration *machinev1alpha1.MachineConfiguration
@@ -130,6 +137,8 @@ var _ = Describe("Machines", func() {
 				clusterWithoutImages   *extensionscontroller.Cluster
 				cluster                *extensionscontroller.Cluster
 				w                      *extensionsv1alpha1.Worker
+
+				existingMachineDeployments []machinev1alpha1.MachineDeployment
 			)
 
 			BeforeEach(func() {
@@ -181,15 +190,17 @@ var _ = Describe("Machines", func() {
 					"gpu":    resource.MustParse("0"),
 					"memory": resource.MustParse("128Gi"),
 				}
+				nodeTemplateCapacity = nodeCapacity.DeepCopy()
+				nodeTemplateCapacity["ephemeral-storage"] = resource.MustParse(fmt.Sprintf("%dGi", volumeSize))
 				nodeTemplateZone1 = machinev1alpha1.NodeTemplate{
-					Capacity:     nodeCapacity,
+					Capacity:     nodeTemplateCapacity,
 					InstanceType: machineType,
 					Region:       region,
 					Zone:         zone1,
 				}
 
 				nodeTemplateZone2 = machinev1alpha1.NodeTemplate{
-					Capacity:     nodeCapacity,
+					Capacity:     nodeTemplateCapacity,
 					InstanceType: machineType,
 					Region:       region,
 					Zone:         zone2,
@@ -332,6 +343,10 @@ var _ = Describe("Machines", func() {
 											Scopes: []string{"bar"},
 										},
 										MinCpuPlatform: &minCpuPlatform,
+										AliasIPRange: &api.AliasIPRange{
+											SubnetworkRangeName: "alias",
+											IPCidrRange:         "/24",
+										},
 									}),
 								},
 								UserData: userData,
@@ -358,7 +373,14 @@ var _ = Describe("Machines", func() {
 				workerPoolHash1, _ = worker.WorkerPoolHash(w.Spec.Pools[0], cluster)
 				workerPoolHash2, _ = worker.WorkerPoolHash(w.Spec.Pools[1], cluster)
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, clusterWithoutImages)
+
+				existingMachineDeployments = nil
+				c.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineDeploymentList{}), gomock.Any()).DoAndReturn(
+					func(_ context.Context, list *machinev1alpha1.MachineDeploymentList, _ ...client.ListOption) error {
+						list.Items = existingMachineDeployments
+						return nil
+					}).AnyTimes()
 			})
 
 			Describe("machine images", func() {
@@ -443,9 +465,20 @@ var _ = Describe("Machines", func() {
 
 					var (
 						machineClassPool2 = useDefaultMachineClass(
-							defaultMachineClass,
-							"serviceAccounts",
-							[]map[string]interface{}{{"email": "foo", "scopes": []string{"bar"}}},
+							useDefaultMachineClass(
+								defaultMachineClass,
+								"serviceAccounts",
+								[]map[string]interface{}{{"email": "foo", "scopes": []string{"bar"}}},
+							),
+							"networkInterfaces",
+							[]map[string]interface{}{
+								{
+									"subnetwork":          subnetName,
+									"disableExternalIP":   disableExternalIP,
+									"ipCidrRange":         "/24",
+									"subnetworkRangeName": "alias",
+								},
+							},
 						)
 
 						machineClassPool1Zone1 = useDefaultMachineClass(defaultMachineClass, "zone", zone1)
@@ -551,7 +584,7 @@ var _ = Describe("Machines", func() {
 							},
 						}),
 					}
-					workerDelegateCloudRouter, _ := NewWorkerDelegate(c, scheme, chartApplier, "", workerCloudRouter, cluster)
+					workerDelegateCloudRouter, _ := NewWorkerDelegate(c, nil, scheme, chartApplier, "", workerCloudRouter, cluster)
 					// Test workerDelegate.DeployMachineClasses()
 					chartApplier.EXPECT().ApplyFromEmbeddedFS(
 						context.TODO(),
@@ -578,6 +611,9 @@ var _ = Describe("Machines", func() {
 								Architecture: ptr.To(archAMD),
 							},
 						},
+						Pools: []apiv1alpha1.WorkerPoolStatus{
+							{Name: namePool2, MaxPods: ptr.To[int32](110)},
+						},
 					}
 					workerWithExpectedImages := w.DeepCopy()
 					workerWithExpectedImages.Status.ProviderStatus = &runtime.RawExtension{
@@ -599,7 +635,7 @@ var _ = Describe("Machines", func() {
 
 			It("should fail because the version is invalid", func() {
 				clusterWithoutImages.Shoot.Spec.Kubernetes.Version = "invalid"
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -609,7 +645,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the infrastructure status cannot be decoded", func() {
 				w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -621,7 +657,7 @@ var _ = Describe("Machines", func() {
 					Raw: encode(&api.InfrastructureStatus{}),
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -631,7 +667,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the machine image for given architecture cannot be found", func() {
 				w.Spec.Pools[0].Architecture = ptr.To(archARM)
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -639,7 +675,7 @@ var _ = Describe("Machines", func() {
 			})
 
 			It("should fail because the machine image cannot be found", func() {
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, clusterWithoutImages)
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, clusterWithoutImages)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -649,7 +685,7 @@ var _ = Describe("Machines", func() {
 			It("should fail because the volume size cannot be decoded", func() {
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -670,7 +706,7 @@ var _ = Describe("Machines", func() {
 					NodeConditions:         testNodeConditions,
 				}
 
-				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, "", w, cluster)
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
 
 				result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
 				resultSettings := result[0].MachineConfiguration
@@ -683,6 +719,854 @@ var _ = Describe("Machines", func() {
 				Expect(resultSettings.MaxEvictRetries).To(Equal(&testMaxEvictRetries))
 				Expect(resultSettings.NodeConditions).To(Equal(&resultNodeConditions))
 			})
+
+			Describe("flex-start provisioning model", func() {
+				BeforeEach(func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							GPU: &api.GPU{
+								AcceleratorType: "nvidia-tesla-t4",
+								Count:           1,
+							},
+							Scheduling: &api.Scheduling{
+								ProvisioningModel: ptr.To(api.ProvisioningModelFlexStart),
+								MaxRunDuration:    &metav1.Duration{Duration: 24 * time.Hour},
+							},
+						}),
+					}
+				})
+
+				It("should raise the machine creation timeout to tolerate the delayed fulfillment", func() {
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].MachineConfiguration.MachineCreationTimeout).To(Equal(&metav1.Duration{Duration: 2 * time.Hour}))
+					Expect(result[1].MachineConfiguration.MachineCreationTimeout).To(Equal(&metav1.Duration{Duration: 2 * time.Hour}))
+				})
+
+				It("should keep an explicitly configured machine creation timeout", func() {
+					w.Spec.Pools[0].MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{
+						MachineCreationTimeout: &metav1.Duration{Duration: 30 * time.Minute},
+					}
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].MachineConfiguration.MachineCreationTimeout).To(Equal(&metav1.Duration{Duration: 30 * time.Minute}))
+				})
+			})
+
+			deployedMachineClass := func() map[string]interface{} {
+				var machineClass map[string]interface{}
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(
+					context.TODO(),
+					charts.InternalChart,
+					filepath.Join("internal", "machineclass"),
+					namespace,
+					"machineclass",
+					gomock.Any(),
+				).DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+					applyOpts := &kubernetes.ApplyOptions{}
+					for _, opt := range opts {
+						opt.MutateApplyOptions(applyOpts)
+					}
+					machineClass = applyOpts.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})[0]
+					return nil
+				})
+
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+				Expect(workerDelegate.DeployMachineClasses(context.TODO())).To(Succeed())
+				return machineClass
+			}
+
+			Describe("instance labels", func() {
+				deployedLabels := func() map[string]interface{} {
+					return deployedMachineClass()["labels"].(map[string]interface{})
+				}
+
+				BeforeEach(func() {
+					cluster.Shoot.Labels = map[string]string{
+						"cost-center":                 "CC-1234",
+						"component":                   "shoot",
+						"shoot.gardener.cloud/status": "healthy",
+					}
+				})
+
+				It("should not propagate the shoot labels by default", func() {
+					Expect(deployedLabels()).To(Equal(map[string]interface{}{
+						"name":             name,
+						"k8s-cluster-name": namespace,
+						"component":        "tidb",
+					}))
+				})
+
+				It("should propagate the shoot labels if enabled", func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							PropagateShootLabels: ptr.To(true),
+						}),
+					}
+
+					Expect(deployedLabels()).To(Equal(map[string]interface{}{
+						"name":             name,
+						"k8s-cluster-name": namespace,
+						"component":        "tidb",
+						"cost-center":      "cc-1234",
+					}))
+				})
+
+				It("should not overwrite reserved labels", func() {
+					w.Spec.Pools[0].Labels = map[string]string{
+						"name":             "foo",
+						"k8s-cluster-name": "foo",
+						"goog-managed-by":  "foo",
+					}
+
+					Expect(deployedLabels()).To(Equal(map[string]interface{}{
+						"name":             name,
+						"k8s-cluster-name": namespace,
+					}))
+				})
+
+				It("should add the labels identifying the shoot", func() {
+					cluster.Shoot.Name = "foo"
+					cluster.Shoot.Namespace = "garden-dev"
+
+					Expect(deployedLabels()).To(Equal(map[string]interface{}{
+						"name":             name,
+						"k8s-cluster-name": namespace,
+						"gardener-shoot":   "foo",
+						"gardener-project": "dev",
+						"component":        "tidb",
+					}))
+				})
+
+				It("should add the resource labels of the infrastructure with lower precedence than the pool labels", func() {
+					cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.InfrastructureConfig{
+							TypeMeta: metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+							ResourceLabels: map[string]string{
+								"cost-center": "cc-5678",
+								"component":   "infrastructure",
+							},
+						}),
+					}
+
+					Expect(deployedLabels()).To(Equal(map[string]interface{}{
+						"name":             name,
+						"k8s-cluster-name": namespace,
+						"component":        "tidb",
+						"cost-center":      "cc-5678",
+					}))
+				})
+			})
+
+			Describe("serial port logging", func() {
+				It("should not set the metadata by default", func() {
+					Expect(deployedMachineClass()["metadata"]).To(Equal([]map[string]string{
+						{"key": "block-project-ssh-keys", "value": "TRUE"},
+					}))
+				})
+
+				It("should set the metadata if configured", func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							SerialPortLoggingEnable: ptr.To(true),
+						}),
+					}
+
+					Expect(deployedMachineClass()["metadata"]).To(Equal([]map[string]string{
+						{"key": "block-project-ssh-keys", "value": "TRUE"},
+						{"key": "serial-port-logging-enable", "value": "TRUE"},
+					}))
+				})
+
+				Describe("organization policy", func() {
+					var (
+						gcpClientFactory *mockgcpclient.MockFactory
+						iamClient        *fakeIAMClient
+					)
+
+					BeforeEach(func() {
+						gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+						iamClient = &fakeIAMClient{}
+
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+							Raw: encode(&api.WorkerConfig{
+								Volume: &api.Volume{
+									LocalSSDInterface: &localVolumeInterface,
+								},
+								SerialPortLoggingEnable: ptr.To(true),
+							}),
+						}
+					})
+
+					// expectConditions reconciles the worker and expects that the status is patched with the given conditions.
+					expectConditions := func(matchers ...interface{}) {
+						ctx := context.TODO()
+						gcpClientFactory.EXPECT().IAM(ctx, c, w.Spec.SecretRef).Return(iamClient, nil)
+						workerDelegate, _ = NewWorkerDelegate(c, gcpClientFactory, scheme, chartApplier, "", w, cluster)
+						Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+						c.EXPECT().Status().Return(statusWriter)
+						statusWriter.EXPECT().Patch(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+							Expect(obj.(*extensionsv1alpha1.Worker).Status.Conditions).To(ConsistOf(matchers...))
+							return nil
+						})
+						Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+					}
+
+					It("should report the worker pools in a condition if the organization policy disables serial port logging", func() {
+						iamClient.enforced = true
+
+						expectConditions(MatchFields(IgnoreExtras, Fields{
+							"Type":    Equal(ConditionTypeSerialPortLoggingDisabledByPolicy),
+							"Status":  Equal(gardencorev1beta1.ConditionTrue),
+							"Reason":  Equal("OrganizationPolicyEnforced"),
+							"Message": ContainSubstring("enabled for the worker pools " + namePool1),
+						}))
+						Expect(iamClient.constraints).To(ConsistOf("constraints/compute.disableSerialPortLogging"))
+					})
+
+					It("should not add the condition if the organization policy does not disable serial port logging", func() {
+						expectConditions()
+					})
+
+					It("should update the condition once the organization policy does not disable serial port logging anymore", func() {
+						w.Status.Conditions = []gardencorev1beta1.Condition{{
+							Type:   ConditionTypeSerialPortLoggingDisabledByPolicy,
+							Status: gardencorev1beta1.ConditionTrue,
+							Reason: "OrganizationPolicyEnforced",
+						}}
+
+						expectConditions(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(ConditionTypeSerialPortLoggingDisabledByPolicy),
+							"Status": Equal(gardencorev1beta1.ConditionFalse),
+							"Reason": Equal("SerialPortLoggingNotDisabled"),
+						}))
+					})
+
+					It("should keep the condition if the organization policy cannot be checked", func() {
+						iamClient.err = fmt.Errorf("permission denied")
+						condition := gardencorev1beta1.Condition{
+							Type:   ConditionTypeSerialPortLoggingDisabledByPolicy,
+							Status: gardencorev1beta1.ConditionTrue,
+							Reason: "OrganizationPolicyEnforced",
+						}
+						w.Status.Conditions = []gardencorev1beta1.Condition{condition}
+
+						expectConditions(Equal(condition))
+					})
+				})
+			})
+
+			Describe("OS Login", func() {
+				It("should set the metadata if configured", func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							EnableOSLogin: ptr.To(true),
+						}),
+					}
+
+					Expect(deployedMachineClass()["metadata"]).To(Equal([]map[string]string{
+						{"key": "block-project-ssh-keys", "value": "TRUE"},
+						{"key": "enable-oslogin", "value": "TRUE"},
+					}))
+				})
+			})
+
+			Describe("ops agent", func() {
+				BeforeEach(func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							InstallOpsAgent: ptr.To(true),
+						}),
+					}
+				})
+
+				It("should install the latest version by default and grant the required scopes", func() {
+					machineClass := deployedMachineClass()
+
+					metadata := machineClass["metadata"].([]map[string]string)
+					Expect(metadata).To(HaveLen(2))
+					Expect(metadata[1]).To(HaveKeyWithValue("key", "startup-script"))
+					Expect(metadata[1]["value"]).To(ContainSubstring("--also-install --version=latest\n"))
+
+					Expect(machineClass["serviceAccounts"]).To(Equal([]map[string]interface{}{{
+						"email": serviceAccountEmail,
+						"scopes": []string{
+							"https://www.googleapis.com/auth/compute",
+							"https://www.googleapis.com/auth/logging.write",
+							"https://www.googleapis.com/auth/monitoring.write",
+						},
+					}}))
+				})
+
+				It("should install the version configured in the cloud profile", func() {
+					cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+					Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+					cloudProfileConfig.OpsAgent = &apiv1alpha1.OpsAgentConfig{Version: ptr.To("2.46.0")}
+					cluster.CloudProfile.Spec.ProviderConfig.Raw = encode(cloudProfileConfig)
+
+					metadata := deployedMachineClass()["metadata"].([]map[string]string)
+					Expect(metadata).To(HaveLen(2))
+					Expect(metadata[1]["value"]).To(ContainSubstring("--also-install --version=2.46.0\n"))
+				})
+			})
+
+			Describe("node service account", func() {
+				It("should use the service account created for the worker pool", func() {
+					w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+						Raw: encode(&api.InfrastructureStatus{
+							ServiceAccountEmail: serviceAccountEmail,
+							WorkerPoolServiceAccounts: []api.WorkerPoolServiceAccountStatus{
+								{Name: namePool1, Email: "pool-1@project.iam.gserviceaccount.com"},
+							},
+							Networks: api.NetworkStatus{
+								Subnets: []api.Subnet{{Name: subnetName, Purpose: api.PurposeNodes}},
+							},
+						}),
+					}
+
+					Expect(deployedMachineClass()["serviceAccounts"]).To(Equal([]map[string]interface{}{{
+						"email":  "pool-1@project.iam.gserviceaccount.com",
+						"scopes": []string{"https://www.googleapis.com/auth/compute"},
+					}}))
+				})
+			})
+
+			Describe("scale-from-zero node template", func() {
+				deployedNodeTemplate := func() machinev1alpha1.NodeTemplate {
+					return deployedMachineClass()["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+				}
+
+				It("should add the GPUs of accelerator-optimized machine types", func() {
+					w.Spec.Pools[0].MachineType = "a2-highgpu-4g"
+
+					Expect(deployedNodeTemplate().Capacity).To(Equal(corev1.ResourceList{
+						"cpu":               resource.MustParse("8"),
+						"gpu":               *resource.NewQuantity(4, resource.DecimalSI),
+						"memory":            resource.MustParse("128Gi"),
+						"ephemeral-storage": resource.MustParse("20Gi"),
+					}))
+				})
+
+				It("should derive the capacity of custom machine types", func() {
+					w.Spec.Pools[0].MachineType = "n2-custom-6-24576-ext"
+					w.Spec.Pools[0].NodeTemplate = nil
+
+					nodeTemplate := deployedNodeTemplate()
+					Expect(nodeTemplate.InstanceType).To(Equal("n2-custom-6-24576-ext"))
+					Expect(nodeTemplate.Zone).To(Equal(zone1))
+					Expect(nodeTemplate.Capacity).To(Equal(corev1.ResourceList{
+						"cpu":               *resource.NewQuantity(6, resource.DecimalSI),
+						"memory":            *resource.NewQuantity(24576*1024*1024, resource.BinarySI),
+						"ephemeral-storage": resource.MustParse("20Gi"),
+					}))
+				})
+
+				It("should not add a node template if the capacity is unknown", func() {
+					w.Spec.Pools[0].NodeTemplate = nil
+
+					Expect(deployedMachineClass()).NotTo(HaveKey("nodeTemplate"))
+				})
+			})
+
+			It("should limit the maximum number of pods to the size of the alias IP range", func() {
+				cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{
+					Name: namePool2,
+					Kubernetes: &gardencorev1beta1.WorkerKubernetes{
+						Kubelet: &gardencorev1beta1.KubeletConfig{MaxPods: ptr.To[int32](250)},
+					},
+				}}
+				workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+				ctx := context.TODO()
+				c.EXPECT().Status().Return(statusWriter)
+				statusWriter.EXPECT().Patch(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+					workerStatus, ok := obj.(*extensionsv1alpha1.Worker).Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+					Expect(ok).To(BeTrue())
+					Expect(workerStatus.Pools).To(ConsistOf(apiv1alpha1.WorkerPoolStatus{Name: namePool2, MaxPods: ptr.To[int32](128)}))
+					return nil
+				})
+
+				Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+			})
+
+			Describe("quota-aware rolling update", func() {
+				var (
+					gcpClientFactory     *mockgcpclient.MockFactory
+					computeClient        *mockgcpclient.MockComputeClient
+					deploymentNamesPool1 []string
+					quotas               []*gcpclient.Quota
+				)
+
+				BeforeEach(func() {
+					gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+					computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+					w.Spec.Pools[0].MachineType = "n2-custom-4-16384"
+					w.Spec.Pools[0].MaxSurge = intstr.FromInt32(6)
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							QuotaAwareRollingUpdate: ptr.To(true),
+						}),
+					}
+
+					deploymentNamesPool1 = []string{
+						fmt.Sprintf("%s-%s-z1", namespace, namePool1),
+						fmt.Sprintf("%s-%s-z2", namespace, namePool1),
+					}
+					quotas = []*gcpclient.Quota{
+						{Metric: "CPUS", Limit: 1000, Usage: 100},
+						{Metric: "N2_CPUS", Limit: 100, Usage: 80},
+					}
+
+					existingMachineDeployments = []machinev1alpha1.MachineDeployment{
+						newMachineDeployment(deploymentNamesPool1[0], zone1, deploymentNamesPool1[0]+"-oldhash", 5, 5),
+						newMachineDeployment(deploymentNamesPool1[1], zone2, deploymentNamesPool1[1]+"-oldhash", 5, 5),
+					}
+				})
+
+				expectGetRegion := func() {
+					gcpClientFactory.EXPECT().Compute(gomock.Any(), c, w.Spec.SecretRef).Return(computeClient, nil)
+					computeClient.EXPECT().GetRegion(gomock.Any(), region).Return(&gcpclient.Region{Quotas: quotas}, nil)
+				}
+
+				It("should keep the surge if the quotas suffice", func() {
+					quotas[1].Limit = 1000
+					expectGetRegion()
+					workerDelegate, _ = NewWorkerDelegate(c, gcpClientFactory, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].MaxSurge).To(Equal(intstr.FromInt32(3)))
+					Expect(result[1].MaxSurge).To(Equal(intstr.FromInt32(3)))
+				})
+
+				It("should limit the surge to the free quota and report it in a condition", func() {
+					expectGetRegion()
+					workerDelegate, _ = NewWorkerDelegate(c, gcpClientFactory, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].MaxSurge).To(Equal(intstr.FromInt32(3)))
+					Expect(result[1].MaxSurge).To(Equal(intstr.FromInt32(2)))
+
+					c.EXPECT().Status().Return(statusWriter)
+					statusWriter.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						conditions := obj.(*extensionsv1alpha1.Worker).Status.Conditions
+						Expect(conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+							"Type":    Equal(ConditionTypeRollingUpdateThrottledByQuota),
+							"Status":  Equal(gardencorev1beta1.ConditionTrue),
+							"Reason":  Equal("QuotaExceeded"),
+							"Message": ContainSubstring(fmt.Sprintf("surge of machine deployment %q limited from 3 to 2 machines by quota N2_CPUS", deploymentNamesPool1[1])),
+						})))
+						return nil
+					})
+					Expect(workerDelegate.UpdateMachineImagesStatus(context.TODO())).To(Succeed())
+				})
+
+				It("should keep a surge of one machine if no machine may be unavailable", func() {
+					quotas[1].Usage = 100
+					w.Spec.Pools[0].MaxUnavailable = intstr.FromInt32(0)
+					expectGetRegion()
+					workerDelegate, _ = NewWorkerDelegate(c, gcpClientFactory, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].MaxSurge).To(Equal(intstr.FromInt32(1)))
+					Expect(result[1].MaxSurge).To(Equal(intstr.FromInt32(1)))
+				})
+			})
+
+			Describe("canary rollout", func() {
+				var (
+					oldClassNamePool1Zone1 string
+					oldClassNamePool1Zone2 string
+					deploymentNamesPool1   []string
+				)
+
+				BeforeEach(func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							CanaryRollout: &api.CanaryRollout{
+								Machines:     1,
+								SoakDuration: &metav1.Duration{Duration: time.Hour},
+							},
+						}),
+					}
+					workerPoolHash1, _ = worker.WorkerPoolHash(w.Spec.Pools[0], cluster)
+
+					deploymentNamesPool1 = []string{
+						fmt.Sprintf("%s-%s-z1", namespace, namePool1),
+						fmt.Sprintf("%s-%s-z2", namespace, namePool1),
+					}
+					oldClassNamePool1Zone1 = deploymentNamesPool1[0] + "-oldhash"
+					oldClassNamePool1Zone2 = deploymentNamesPool1[1] + "-oldhash"
+					existingMachineDeployments = []machinev1alpha1.MachineDeployment{
+						newMachineDeployment(deploymentNamesPool1[0], zone1, oldClassNamePool1Zone1, 3, 3),
+						newMachineDeployment(deploymentNamesPool1[1], zone2, oldClassNamePool1Zone2, 2, 2),
+					}
+				})
+
+				It("should not create canaries for new machine deployments", func() {
+					existingMachineDeployments = nil
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(4))
+					Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s", deploymentNamesPool1[0], workerPoolHash1)))
+				})
+
+				It("should roll out the changed machine class to canary machines first", func() {
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(6))
+
+					Expect(result[0].Name).To(Equal(deploymentNamesPool1[0]))
+					Expect(result[0].ClassName).To(Equal(oldClassNamePool1Zone1))
+					Expect(result[0].SecretName).To(Equal(oldClassNamePool1Zone1))
+					Expect(result[1].Name).To(Equal(deploymentNamesPool1[1]))
+					Expect(result[1].ClassName).To(Equal(oldClassNamePool1Zone2))
+
+					Expect(result[2].Name).To(Equal(deploymentNamesPool1[0] + "-canary"))
+					Expect(result[2].ClassName).To(Equal(fmt.Sprintf("%s-%s", deploymentNamesPool1[0], workerPoolHash1)))
+					Expect(result[2].Minimum).To(Equal(int32(1)))
+					Expect(result[2].Maximum).To(Equal(int32(1)))
+					Expect(result[3].Name).To(Equal(deploymentNamesPool1[1] + "-canary"))
+					Expect(result[3].ClassName).To(Equal(fmt.Sprintf("%s-%s", deploymentNamesPool1[1], workerPoolHash1)))
+				})
+
+				It("should keep the canaries until the soak duration has passed", func() {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+							CanaryRollouts: []apiv1alpha1.CanaryRolloutStatus{
+								{PoolName: namePool1, Hash: workerPoolHash1, StartTime: metav1.NewTime(time.Now().Add(-30 * time.Minute))},
+							},
+						}),
+					}
+					existingMachineDeployments = append(existingMachineDeployments,
+						newMachineDeployment(deploymentNamesPool1[0]+"-canary", zone1, fmt.Sprintf("%s-%s", deploymentNamesPool1[0], workerPoolHash1), 1, 1),
+						newMachineDeployment(deploymentNamesPool1[1]+"-canary", zone2, fmt.Sprintf("%s-%s", deploymentNamesPool1[1], workerPoolHash1), 1, 1),
+					)
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(6))
+					Expect(result[0].ClassName).To(Equal(oldClassNamePool1Zone1))
+				})
+
+				It("should roll out the changed machine class to all machines after the soak duration", func() {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+							CanaryRollouts: []apiv1alpha1.CanaryRolloutStatus{
+								{PoolName: namePool1, Hash: workerPoolHash1, StartTime: metav1.NewTime(time.Now().Add(-2 * time.Hour))},
+							},
+						}),
+					}
+					existingMachineDeployments = append(existingMachineDeployments,
+						newMachineDeployment(deploymentNamesPool1[0]+"-canary", zone1, fmt.Sprintf("%s-%s", deploymentNamesPool1[0], workerPoolHash1), 1, 1),
+						newMachineDeployment(deploymentNamesPool1[1]+"-canary", zone2, fmt.Sprintf("%s-%s", deploymentNamesPool1[1], workerPoolHash1), 1, 1),
+					)
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(4))
+					Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s", deploymentNamesPool1[0], workerPoolHash1)))
+					Expect(result[1].ClassName).To(Equal(fmt.Sprintf("%s-%s", deploymentNamesPool1[1], workerPoolHash1)))
+				})
+
+				It("should not roll out the changed machine class to all machines if the canaries are not available", func() {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+							CanaryRollouts: []apiv1alpha1.CanaryRolloutStatus{
+								{PoolName: namePool1, Hash: workerPoolHash1, StartTime: metav1.NewTime(time.Now().Add(-2 * time.Hour))},
+							},
+						}),
+					}
+					existingMachineDeployments = append(existingMachineDeployments,
+						newMachineDeployment(deploymentNamesPool1[0]+"-canary", zone1, fmt.Sprintf("%s-%s", deploymentNamesPool1[0], workerPoolHash1), 1, 0),
+						newMachineDeployment(deploymentNamesPool1[1]+"-canary", zone2, fmt.Sprintf("%s-%s", deploymentNamesPool1[1], workerPoolHash1), 1, 1),
+					)
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(6))
+					Expect(result[0].ClassName).To(Equal(oldClassNamePool1Zone1))
+				})
+			})
+
+			Describe("zone circuit breaker", func() {
+				var (
+					deploymentNamesPool1 []string
+					machines             []machinev1alpha1.Machine
+				)
+
+				BeforeEach(func() {
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+						Raw: encode(&api.WorkerConfig{
+							Volume: &api.Volume{
+								LocalSSDInterface: &localVolumeInterface,
+							},
+							ZoneCircuitBreaker: ptr.To(true),
+						}),
+					}
+
+					deploymentNamesPool1 = []string{
+						fmt.Sprintf("%s-%s-z1", namespace, namePool1),
+						fmt.Sprintf("%s-%s-z2", namespace, namePool1),
+					}
+					existingMachineDeployments = []machinev1alpha1.MachineDeployment{
+						newMachineDeployment(deploymentNamesPool1[0], zone1, deploymentNamesPool1[0]+"-hash", 3, 2),
+						newMachineDeployment(deploymentNamesPool1[1], zone2, deploymentNamesPool1[1]+"-hash", 2, 2),
+					}
+					machines = nil
+
+					c.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), gomock.Any()).DoAndReturn(
+						func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+							list.Items = machines
+							return nil
+						}).AnyTimes()
+				})
+
+				setZoneCircuitBreakers := func(statuses ...apiv1alpha1.ZoneCircuitBreakerStatus) {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta:            metav1.TypeMeta{APIVersion: apiv1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+							ZoneCircuitBreakers: statuses,
+						}),
+					}
+				}
+
+				expectZoneCircuitBreakers := func(matcher gomegatypes.GomegaMatcher) {
+					c.EXPECT().Status().Return(statusWriter)
+					statusWriter.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						workerStatus, ok := obj.(*extensionsv1alpha1.Worker).Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+						Expect(ok).To(BeTrue())
+						Expect(workerStatus.ZoneCircuitBreakers).To(matcher)
+						return nil
+					})
+					Expect(workerDelegate.UpdateMachineImagesStatus(context.TODO())).To(Succeed())
+				}
+
+				It("should suspend a zone in which machines could not be created and create the machines in the other zones", func() {
+					machines = []machinev1alpha1.Machine{
+						newFailedMachine(deploymentNamesPool1[0], "ZONE_RESOURCE_POOL_EXHAUSTED: The zone does not have enough resources available", time.Now()),
+					}
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].Name).To(Equal(deploymentNamesPool1[0]))
+					Expect(result[0].Minimum).To(Equal(int32(2)))
+					Expect(result[0].Maximum).To(Equal(int32(2)))
+					Expect(result[1].Name).To(Equal(deploymentNamesPool1[1]))
+					Expect(result[1].Minimum).To(Equal(minPool1 - 2))
+					Expect(result[1].Maximum).To(Equal(maxPool1 - 2))
+
+					expectZoneCircuitBreakers(ConsistOf(MatchFields(IgnoreExtras, Fields{
+						"PoolName":       Equal(namePool1),
+						"Zone":           Equal(zone1),
+						"Failures":       Equal(int32(1)),
+						"SuspendedUntil": WithTransform(func(t metav1.Time) time.Time { return t.Time }, BeTemporally("~", time.Now().Add(5*time.Minute), time.Minute)),
+						"LastError":      ContainSubstring("ZONE_RESOURCE_POOL_EXHAUSTED"),
+					})))
+				})
+
+				It("should not suspend a zone because of errors which are not specific to the zone", func() {
+					machines = []machinev1alpha1.Machine{
+						newFailedMachine(deploymentNamesPool1[0], "QUOTA_EXCEEDED: Quota 'N2_CPUS' exceeded", time.Now()),
+					}
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].Minimum).To(Equal(worker.DistributeOverZones(0, minPool1, 2)))
+					Expect(result[1].Minimum).To(Equal(worker.DistributeOverZones(1, minPool1, 2)))
+					expectZoneCircuitBreakers(BeEmpty())
+				})
+
+				It("should double the suspension if machines still cannot be created after the suspension", func() {
+					suspendedUntil := time.Now().Add(-time.Minute)
+					setZoneCircuitBreakers(apiv1alpha1.ZoneCircuitBreakerStatus{PoolName: namePool1, Zone: zone1, Failures: 2, SuspendedUntil: metav1.NewTime(suspendedUntil)})
+					machines = []machinev1alpha1.Machine{
+						newFailedMachine(deploymentNamesPool1[0], "Internal error", suspendedUntil.Add(30*time.Second)),
+					}
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].Maximum).To(Equal(int32(2)))
+					expectZoneCircuitBreakers(ConsistOf(MatchFields(IgnoreExtras, Fields{
+						"Failures":       Equal(int32(3)),
+						"SuspendedUntil": WithTransform(func(t metav1.Time) time.Time { return t.Time }, BeTemporally("~", time.Now().Add(20*time.Minute), time.Minute)),
+					})))
+				})
+
+				It("should reset the failures of a zone once a machine was created after the suspension", func() {
+					suspendedUntil := time.Now().Add(-10 * time.Minute)
+					setZoneCircuitBreakers(apiv1alpha1.ZoneCircuitBreakerStatus{PoolName: namePool1, Zone: zone1, Failures: 2, SuspendedUntil: metav1.NewTime(suspendedUntil)})
+					machine := machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{
+						Name:              deploymentNamesPool1[0] + "-abcde",
+						Labels:            map[string]string{"name": deploymentNamesPool1[0]},
+						CreationTimestamp: metav1.NewTime(suspendedUntil.Add(time.Minute)),
+					}}
+					machine.Status.CurrentStatus.Phase = machinev1alpha1.MachineRunning
+					machines = []machinev1alpha1.Machine{machine}
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].Maximum).To(Equal(worker.DistributeOverZones(0, maxPool1, 2)))
+					expectZoneCircuitBreakers(BeEmpty())
+				})
+
+				It("should not suspend all zones of a worker pool", func() {
+					machines = []machinev1alpha1.Machine{
+						newFailedMachine(deploymentNamesPool1[0], "ZONE_RESOURCE_POOL_EXHAUSTED", time.Now()),
+						newFailedMachine(deploymentNamesPool1[1], "ZONE_RESOURCE_POOL_EXHAUSTED", time.Now()),
+					}
+					workerDelegate, _ = NewWorkerDelegate(c, nil, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].Maximum).To(Equal(worker.DistributeOverZones(0, maxPool1, 2)))
+					Expect(result[1].Maximum).To(Equal(worker.DistributeOverZones(1, maxPool1, 2)))
+					expectZoneCircuitBreakers(HaveLen(2))
+				})
+			})
+
+			Describe("zone migration", func() {
+				var (
+					gcpClientFactory     *mockgcpclient.MockFactory
+					computeClient        *mockgcpclient.MockComputeClient
+					deploymentNamesPool1 []string
+					zone3                string
+					diskFilter           string
+				)
+
+				BeforeEach(func() {
+					gcpClientFactory = mockgcpclient.NewMockFactory(ctrl)
+					computeClient = mockgcpclient.NewMockComputeClient(ctrl)
+
+					deploymentNamesPool1 = []string{
+						fmt.Sprintf("%s-%s-z1", namespace, namePool1),
+						fmt.Sprintf("%s-%s-z2", namespace, namePool1),
+						fmt.Sprintf("%s-%s-z3", namespace, namePool1),
+					}
+					zone3 = region + "c"
+					diskFilter = fmt.Sprintf("labels.k8s-cluster-name = %q", namespace)
+
+					existingMachineDeployments = []machinev1alpha1.MachineDeployment{
+						newMachineDeployment(deploymentNamesPool1[0], zone1, deploymentNamesPool1[0]+"-oldhash", 3, 3),
+						newMachineDeployment(deploymentNamesPool1[1], zone2, fmt.Sprintf("%s-%s", deploymentNamesPool1[1], workerPoolHash1), 3, 3),
+					}
+				})
+
+				expectListDisks := func(zone string, disks ...*gcpclient.Disk) {
+					gcpClientFactory.EXPECT().Compute(gomock.Any(), c, w.Spec.SecretRef).Return(computeClient, nil)
+					computeClient.EXPECT().ListDisks(gomock.Any(), zone, diskFilter).Return(disks, nil)
+				}
+
+				It("should keep the machine deployment names of the remaining zones and remove the machines of a removed zone", func() {
+					w.Spec.Pools[0].Zones = []string{zone2}
+					expectListDisks(zone1)
+					workerDelegate, _ = NewWorkerDelegate(c, gcpClientFactory, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(3))
+					Expect(result[0].Name).To(Equal(deploymentNamesPool1[1]))
+					Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s", deploymentNamesPool1[1], workerPoolHash1)))
+					Expect(result[0].Minimum).To(Equal(minPool1))
+				})
+
+				It("should give new zones the lowest free index", func() {
+					w.Spec.Pools[0].Zones = []string{zone3, zone2}
+					existingMachineDeployments = existingMachineDeployments[1:]
+					workerDelegate, _ = NewWorkerDelegate(c, gcpClientFactory, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(4))
+					Expect(result[0].Name).To(Equal(deploymentNamesPool1[0]))
+					Expect(result[0].Labels).To(HaveKeyWithValue(gcp.CSIDiskDriverTopologyKey, zone3))
+					Expect(result[1].Name).To(Equal(deploymentNamesPool1[1]))
+					Expect(result[1].Labels).To(HaveKeyWithValue(gcp.CSIDiskDriverTopologyKey, zone2))
+				})
+
+				It("should keep the machines of a removed zone until the machines of the remaining zones are available", func() {
+					w.Spec.Pools[0].Zones = []string{zone2, zone3}
+					workerDelegate, _ = NewWorkerDelegate(c, gcpClientFactory, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(5))
+					Expect(result[0].Name).To(Equal(deploymentNamesPool1[1]))
+					Expect(result[1].Name).To(Equal(deploymentNamesPool1[2]))
+					Expect(result[2].Name).To(Equal(deploymentNamesPool1[0]))
+					Expect(result[2].ClassName).To(Equal(deploymentNamesPool1[0] + "-oldhash"))
+					Expect(result[2].Minimum).To(Equal(int32(3)))
+					Expect(result[2].Maximum).To(Equal(int32(3)))
+				})
+
+				It("should keep the machines of a removed zone as long as persistent disks exist in the zone", func() {
+					w.Spec.Pools[0].Zones = []string{zone2}
+					expectListDisks(zone1, &gcpclient.Disk{Name: "pvc-1234"}, &gcpclient.Disk{Name: deploymentNamesPool1[0] + "-abcde"})
+					workerDelegate, _ = NewWorkerDelegate(c, gcpClientFactory, scheme, chartApplier, "", w, cluster)
+
+					result, err := workerDelegate.GenerateMachineDeployments(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(HaveLen(4))
+					Expect(result[1].Name).To(Equal(deploymentNamesPool1[0]))
+
+					c.EXPECT().Status().Return(statusWriter)
+					statusWriter.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						workerStatus, ok := obj.(*extensionsv1alpha1.Worker).Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+						Expect(ok).To(BeTrue())
+						Expect(workerStatus.ZoneMigrations).To(ConsistOf(apiv1alpha1.ZoneMigrationStatus{
+							PoolName:          namePool1,
+							Zone:              zone1,
+							MachineDeployment: deploymentNamesPool1[0],
+							Disks:             []string{"pvc-1234"},
+						}))
+						return nil
+					})
+					Expect(workerDelegate.UpdateMachineImagesStatus(context.TODO())).To(Succeed())
+				})
+			})
 		})
 	})
 
@@ -706,6 +1590,30 @@ func encode(obj runtime.Object) []byte {
 	return data
 }
 
+func newMachineDeployment(name, zone, className string, replicas, availableReplicas int32) machinev1alpha1.MachineDeployment {
+	machineDeployment := machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	machineDeployment.Spec.Replicas = replicas
+	machineDeployment.Spec.Template.Spec.Class.Name = className
+	machineDeployment.Spec.Template.Spec.NodeTemplateSpec.Labels = map[string]string{gcp.CSIDiskDriverTopologyKey: zone}
+	machineDeployment.Status.AvailableReplicas = availableReplicas
+	return machineDeployment
+}
+
+func newFailedMachine(machineDeploymentName, description string, failedAt time.Time) machinev1alpha1.Machine {
+	machine := machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{
+		Name:   machineDeploymentName + "-" + utils.ComputeSHA256Hex([]byte(description))[:5],
+		Labels: map[string]string{"name": machineDeploymentName},
+	}}
+	machine.Status.CurrentStatus.Phase = machinev1alpha1.MachineCrashLoopBackOff
+	machine.Status.LastOperation = machinev1alpha1.LastOperation{
+		Description:    description,
+		LastUpdateTime: metav1.NewTime(failedAt),
+		State:          machinev1alpha1.MachineStateFailed,
+		Type:           machinev1alpha1.MachineOperationCreate,
+	}
+	return machine
+}
+
 func useDefaultMachineClass(def map[string]interface{}, key string, value interface{}) map[string]interface{} {
 	out := make(map[string]interface{}, len(def)+1)
 
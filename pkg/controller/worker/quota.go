@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/clock"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	gcpapihelper "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+)
+
+const (
+	// ConditionTypeRollingUpdateThrottledByQuota is the type of the condition of the Worker which reports whether the
+	// surge of rolling updates is limited by the quotas of the region.
+	ConditionTypeRollingUpdateThrottledByQuota gardencorev1beta1.ConditionType = "RollingUpdateThrottledByQuota"
+
+	quotaMetricCPUs = "CPUS"
+)
+
+// machineQuotaDemand is the amount of quota per quota metric consumed by a single machine of a worker pool.
+type machineQuotaDemand map[string]float64
+
+// quotaDemandOfPool returns the quota consumed by a single machine of the given worker pool.
+func (w *workerDelegate) quotaDemandOfPool(pool v1alpha1.WorkerPool, workerConfig *apisgcp.WorkerConfig) machineQuotaDemand {
+	demand := machineQuotaDemand{}
+
+	cpus, _, ok := gcpapihelper.CustomMachineTypeResources(pool.MachineType)
+	if !ok && w.cluster != nil && w.cluster.CloudProfile != nil {
+		for _, machineType := range w.cluster.CloudProfile.Spec.MachineTypes {
+			if machineType.Name == pool.MachineType {
+				cpus = machineType.CPU.Value()
+			}
+		}
+	}
+	if cpus > 0 {
+		// The quota of the machine family, e.g. N2_CPUS, applies in addition to the regional CPU quota. Families which
+		// are only limited by the regional CPU quota do not have a dedicated quota metric and are skipped later on.
+		demand[quotaMetricCPUs] = float64(cpus)
+		demand[strings.ToUpper(machineFamily(pool.MachineType))+"_"+quotaMetricCPUs] = float64(cpus)
+	}
+
+	if gpus := gcpapihelper.AttachedGPUCount(pool.MachineType, workerConfig.GPU); gpus > 0 && workerConfig.GPU != nil {
+		demand[gpuQuotaMetric(workerConfig.GPU.AcceleratorType)] = float64(gpus)
+	}
+
+	return demand
+}
+
+// limitSurgeToQuota limits the surge of the machine deployments of the given worker pool which are rolled to a new
+// machine class to the number of machines fitting into the free quotas of the region. The free quotas are shared by
+// all worker pools and reduced by the surge granted to the machine deployments. If the surge of a machine deployment
+// is limited, a message describing the limitation is returned. The surge is never limited below one machine for
+// machine deployments without max unavailable machines, as they could not be rolled at all otherwise.
+func (w *workerDelegate) limitSurgeToQuota(
+	ctx context.Context,
+	pool v1alpha1.WorkerPool,
+	workerConfig *apisgcp.WorkerConfig,
+	machineDeployments worker.MachineDeployments,
+) (
+	[]string,
+	error,
+) {
+	existingMachineDeployments, err := w.getMachineDeploymentsInCluster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rolling []int
+	for i, machineDeployment := range machineDeployments {
+		existing, ok := existingMachineDeployments[machineDeployment.Name]
+		if ok && existing.Spec.Template.Spec.Class.Name != machineDeployment.ClassName {
+			rolling = append(rolling, i)
+		}
+	}
+	if len(rolling) == 0 {
+		return nil, nil
+	}
+
+	freeQuotas, err := w.getFreeQuotas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		demand   = w.quotaDemandOfPool(pool, workerConfig)
+		messages []string
+	)
+
+	for _, i := range rolling {
+		machineDeployment := &machineDeployments[i]
+
+		surge, err := intstr.GetScaledValueFromIntOrPercent(&machineDeployment.MaxSurge, int(machineDeployment.Maximum), true)
+		if err != nil {
+			return nil, fmt.Errorf("could not determine max surge of machine deployment %q: %w", machineDeployment.Name, err)
+		}
+
+		allowed, limitingMetric := surge, ""
+		for metric, amount := range demand {
+			free, ok := freeQuotas[metric]
+			if !ok || amount <= 0 {
+				continue
+			}
+			if machines := int(math.Floor(free / amount)); machines < allowed {
+				allowed, limitingMetric = max(machines, 0), metric
+			}
+		}
+
+		if allowed == 0 {
+			maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(&machineDeployment.MaxUnavailable, int(machineDeployment.Maximum), false)
+			if err != nil {
+				return nil, fmt.Errorf("could not determine max unavailable machines of machine deployment %q: %w", machineDeployment.Name, err)
+			}
+			if maxUnavailable == 0 {
+				allowed = 1
+			}
+		}
+
+		for metric, amount := range demand {
+			if _, ok := freeQuotas[metric]; ok {
+				freeQuotas[metric] -= float64(allowed) * amount
+			}
+		}
+
+		if allowed < surge {
+			machineDeployment.MaxSurge = intstr.FromInt32(int32(allowed))
+			messages = append(messages, fmt.Sprintf("surge of machine deployment %q limited from %d to %d machines by quota %s in region %s", machineDeployment.Name, surge, allowed, limitingMetric, w.worker.Spec.Region))
+		}
+	}
+
+	return messages, nil
+}
+
+// getFreeQuotas returns the unused amount of the quotas of the region of the worker. The quotas are only fetched once
+// per reconciliation, so that the surge granted to the machine deployments of all worker pools can be accounted.
+func (w *workerDelegate) getFreeQuotas(ctx context.Context) (map[string]float64, error) {
+	if w.freeQuotas != nil {
+		return w.freeQuotas, nil
+	}
+
+	computeClient, err := w.gcpClientFactory.Compute(ctx, w.client, w.worker.Spec.SecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not create compute client: %w", err)
+	}
+
+	region, err := computeClient.GetRegion(ctx, w.worker.Spec.Region)
+	if err != nil {
+		return nil, fmt.Errorf("could not get quotas of region %q: %w", w.worker.Spec.Region, err)
+	}
+
+	w.freeQuotas = make(map[string]float64, len(region.Quotas))
+	for _, quota := range region.Quotas {
+		w.freeQuotas[quota.Metric] = quota.Limit - quota.Usage
+	}
+	return w.freeQuotas, nil
+}
+
+// quotaThrottledCondition returns the condition reporting whether rolling updates are limited by quotas. The condition
+// is only added once rolling updates are limited for the first time, otherwise nil is returned.
+func (w *workerDelegate) quotaThrottledCondition() []gardencorev1beta1.Condition {
+	conditions := w.worker.Status.Conditions
+	if len(w.quotaThrottles) == 0 && v1beta1helper.GetCondition(conditions, ConditionTypeRollingUpdateThrottledByQuota) == nil {
+		return nil
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(clock.RealClock{}, conditions, ConditionTypeRollingUpdateThrottledByQuota)
+	if len(w.quotaThrottles) > 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionTrue, "QuotaExceeded", strings.Join(w.quotaThrottles, "; "))
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(clock.RealClock{}, condition, gardencorev1beta1.ConditionFalse, "QuotaSufficient", "Rolling updates are not limited by quotas.")
+	}
+	return []gardencorev1beta1.Condition{condition}
+}
+
+// machineFamily returns the family of the given machine type, e.g. n2 for n2-standard-8.
+func machineFamily(machineType string) string {
+	family, _, _ := strings.Cut(machineType, "-")
+	return family
+}
+
+// gpuQuotaMetric returns the quota metric of the given accelerator type, e.g. NVIDIA_T4_GPUS for nvidia-tesla-t4.
+func gpuQuotaMetric(acceleratorType string) string {
+	metric := strings.TrimPrefix(strings.ToUpper(acceleratorType), "NVIDIA-TESLA-")
+	metric = strings.TrimPrefix(metric, "NVIDIA-")
+	return "NVIDIA_" + strings.ReplaceAll(metric, "-", "_") + "_GPUS"
+}
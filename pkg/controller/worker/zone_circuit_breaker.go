@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+const (
+	// zoneSuspensionBase is the duration of the suspension of a zone after the first failure, which doubles with every
+	// consecutive failure up to zoneSuspensionMax.
+	zoneSuspensionBase = 5 * time.Minute
+	zoneSuspensionMax  = time.Hour
+	// zoneFailureResetPeriod is the period after the end of a suspension after which the failures of a zone are
+	// forgotten if no machine was created in the zone since then.
+	zoneFailureResetPeriod = 24 * time.Hour
+)
+
+// zonalCreateErrors are parts of the errors of failed machine creations which indicate that machines cannot be created
+// in the zone for the time being, e.g. because of stockouts or internal errors of the zone. Other errors, e.g. exceeded
+// regional quotas or invalid configurations, affect all zones alike.
+var zonalCreateErrors = []string{
+	"ZONE_RESOURCE_POOL_EXHAUSTED",
+	"does not have enough resources available",
+	"stockout",
+	"INTERNAL_ERROR",
+	"Internal error",
+}
+
+// applyZoneCircuitBreaker suspends the creation of machines in the zones of the given worker pool in which machines
+// could not be created. The suspension starts with five minutes and doubles with every consecutive failure up to one
+// hour. During the suspension, the machine deployment of the zone is limited to its available machines and the other
+// machines of the pool are distributed over the remaining zones. The failures of a zone are reset once a machine was
+// created in the zone after the suspension. The zones are not suspended if machines could not be created in any zone of
+// the pool, as the pool could not be scaled at all otherwise.
+func (w *workerDelegate) applyZoneCircuitBreaker(
+	ctx context.Context,
+	pool v1alpha1.WorkerPool,
+	machineDeployments worker.MachineDeployments,
+) (
+	worker.MachineDeployments,
+	[]apisgcp.ZoneCircuitBreakerStatus,
+	error,
+) {
+	workerStatus, err := w.decodeWorkerProviderStatus()
+	if err != nil {
+		return nil, nil, err
+	}
+	previous := map[string]apisgcp.ZoneCircuitBreakerStatus{}
+	for _, status := range workerStatus.ZoneCircuitBreakers {
+		if status.PoolName == pool.Name {
+			previous[status.Zone] = status
+		}
+	}
+
+	machines, err := w.getMachinesInCluster(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		now       = metav1.Now()
+		statuses  []apisgcp.ZoneCircuitBreakerStatus
+		suspended = map[int]bool{}
+	)
+
+	for i, zone := range pool.Zones {
+		status, ok := previous[zone]
+		zoneMachines := machinesOfMachineDeployment(machines, machineDeployments[i].Name)
+
+		if failure, failedAt := lastZonalCreateFailure(zoneMachines); failure != "" && (!ok || (!now.Before(&status.SuspendedUntil) && failedAt.After(status.SuspendedUntil.Time))) {
+			status = apisgcp.ZoneCircuitBreakerStatus{
+				PoolName:       pool.Name,
+				Zone:           zone,
+				Failures:       status.Failures + 1,
+				SuspendedUntil: metav1.NewTime(now.Add(zoneSuspension(status.Failures + 1))),
+				LastError:      failure,
+			}
+			ok = true
+		} else if ok && now.After(status.SuspendedUntil.Add(zoneFailureResetPeriod)) {
+			ok = false
+		} else if ok && machineCreatedSince(zoneMachines, status.SuspendedUntil.Time) {
+			ok = false
+		}
+
+		if !ok {
+			continue
+		}
+		statuses = append(statuses, status)
+		if now.Before(&status.SuspendedUntil) {
+			suspended[i] = true
+		}
+	}
+
+	if len(suspended) == 0 || len(suspended) == len(pool.Zones) {
+		return machineDeployments, statuses, nil
+	}
+
+	existingMachineDeployments, err := w.getMachineDeploymentsInCluster(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		result  = append(worker.MachineDeployments{}, machineDeployments...)
+		minimum = pool.Minimum
+		maximum = pool.Maximum
+		healthy []int
+	)
+
+	for i := range result {
+		if !suspended[i] {
+			healthy = append(healthy, i)
+			continue
+		}
+
+		var available int32
+		if existing, ok := existingMachineDeployments[result[i].Name]; ok {
+			available = existing.Status.AvailableReplicas
+		}
+		result[i].Minimum = min(result[i].Minimum, available)
+		result[i].Maximum = min(result[i].Maximum, available)
+		minimum -= result[i].Minimum
+		maximum -= result[i].Maximum
+	}
+
+	for healthyIdx, i := range healthy {
+		result[i].Minimum = worker.DistributeOverZones(int32(healthyIdx), max(minimum, 0), int32(len(healthy)))
+		result[i].Maximum = worker.DistributeOverZones(int32(healthyIdx), max(maximum, 0), int32(len(healthy)))
+	}
+
+	return result, statuses, nil
+}
+
+// zoneSuspension returns the duration of the suspension of a zone after the given number of consecutive failures.
+func zoneSuspension(failures int32) time.Duration {
+	suspension := zoneSuspensionBase
+	for i := int32(1); i < failures && suspension < zoneSuspensionMax; i++ {
+		suspension *= 2
+	}
+	return min(suspension, zoneSuspensionMax)
+}
+
+// lastZonalCreateFailure returns the error and time of the last failed creation of the given machines which indicates
+// that machines cannot be created in their zone.
+func lastZonalCreateFailure(machines []machinev1alpha1.Machine) (string, time.Time) {
+	var (
+		failure  string
+		failedAt time.Time
+	)
+
+	for _, machine := range machines {
+		lastOperation := machine.Status.LastOperation
+		if lastOperation.Type != machinev1alpha1.MachineOperationCreate || lastOperation.State != machinev1alpha1.MachineStateFailed {
+			continue
+		}
+		if !isZonalCreateError(lastOperation.Description) || lastOperation.LastUpdateTime.Time.Before(failedAt) {
+			continue
+		}
+		failure, failedAt = lastOperation.Description, lastOperation.LastUpdateTime.Time
+	}
+
+	return failure, failedAt
+}
+
+func isZonalCreateError(description string) bool {
+	for _, zonalError := range zonalCreateErrors {
+		if strings.Contains(strings.ToLower(description), strings.ToLower(zonalError)) {
+			return true
+		}
+	}
+	return false
+}
+
+// machineCreatedSince returns whether one of the given machines was created after the given time and is running.
+func machineCreatedSince(machines []machinev1alpha1.Machine, since time.Time) bool {
+	for _, machine := range machines {
+		if machine.CreationTimestamp.Time.After(since) && machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// machinesOfMachineDeployment returns the machines of the machine deployment with the given name, which are labeled
+// with its name.
+func machinesOfMachineDeployment(machines []machinev1alpha1.Machine, name string) []machinev1alpha1.Machine {
+	var result []machinev1alpha1.Machine
+	for _, machine := range machines {
+		if machine.Labels["name"] == name {
+			result = append(result, machine)
+		}
+	}
+	return result
+}
+
+func (w *workerDelegate) getMachinesInCluster(ctx context.Context) ([]machinev1alpha1.Machine, error) {
+	if w.machinesInCluster != nil {
+		return w.machinesInCluster, nil
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := w.client.List(ctx, machineList, client.InNamespace(w.worker.Namespace)); err != nil {
+		return nil, fmt.Errorf("could not list machines: %w", err)
+	}
+
+	w.machinesInCluster = machineList.Items
+	if w.machinesInCluster == nil {
+		w.machinesInCluster = []machinev1alpha1.Machine{}
+	}
+	return w.machinesInCluster, nil
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// persistentDiskNamePrefix is the prefix of the names of the disks provisioned for persistent volumes.
+const persistentDiskNamePrefix = "pvc-"
+
+// zonalMachineDeploymentNamePrefix returns the prefix of the names of the machine deployments of the given worker pool.
+// The prefix is followed by the index of the machine deployment.
+func zonalMachineDeploymentNamePrefix(namespace, poolName string) string {
+	return fmt.Sprintf("%s-%s-z", namespace, poolName)
+}
+
+// zonalMachineDeploymentIndex returns the index of the given machine deployment name, or false if the name has not the
+// given prefix followed by an index.
+func zonalMachineDeploymentIndex(name, prefix string) (int, bool) {
+	suffix, ok := strings.CutPrefix(name, prefix)
+	if !ok {
+		return 0, false
+	}
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index < 1 || strconv.Itoa(index) != suffix {
+		return 0, false
+	}
+	return index, true
+}
+
+// existingZonalMachineDeployments returns the existing machine deployments of the given worker pool by their zone and
+// the indexes used by their names.
+func (w *workerDelegate) existingZonalMachineDeployments(ctx context.Context, poolName string) (map[string]*machinev1alpha1.MachineDeployment, sets.Set[int], error) {
+	existingMachineDeployments, err := w.getMachineDeploymentsInCluster(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		prefix      = zonalMachineDeploymentNamePrefix(w.worker.Namespace, poolName)
+		byZone      = map[string]*machinev1alpha1.MachineDeployment{}
+		usedIndexes = sets.New[int]()
+	)
+
+	for _, name := range sets.List(sets.KeySet(existingMachineDeployments)) {
+		index, ok := zonalMachineDeploymentIndex(name, prefix)
+		if !ok {
+			continue
+		}
+		usedIndexes.Insert(index)
+
+		zone := existingMachineDeployments[name].Spec.Template.Spec.NodeTemplateSpec.Labels[gcp.CSIDiskDriverTopologyKey]
+		if _, ok := byZone[zone]; zone != "" && !ok {
+			byZone[zone] = existingMachineDeployments[name]
+		}
+	}
+
+	return byZone, usedIndexes, nil
+}
+
+// zonalMachineDeploymentNames returns the names of the machine deployments of the zones of the given worker pool. The
+// machine deployment of a zone keeps its name as long as the zone is part of the worker pool, so that removing zones
+// does not move the machines of the other zones. Machine deployments of new zones get the lowest free index.
+func zonalMachineDeploymentNames(namespace string, pool v1alpha1.WorkerPool, existing map[string]*machinev1alpha1.MachineDeployment, usedIndexes sets.Set[int]) map[string]string {
+	var (
+		names     = make(map[string]string, len(pool.Zones))
+		used      = usedIndexes.Clone()
+		nextIndex = 1
+	)
+
+	for _, zone := range pool.Zones {
+		if machineDeployment, ok := existing[zone]; ok {
+			names[zone] = machineDeployment.Name
+		}
+	}
+
+	for _, zone := range pool.Zones {
+		if _, ok := names[zone]; ok {
+			continue
+		}
+		for used.Has(nextIndex) {
+			nextIndex++
+		}
+		used.Insert(nextIndex)
+		names[zone] = fmt.Sprintf("%s%d", zonalMachineDeploymentNamePrefix(namespace, pool.Name), nextIndex)
+	}
+
+	return names
+}
+
+// migrateRemovedZones returns the machine deployments of the zones which were removed from the given worker pool and
+// whose machines are kept for now. The machines of removed zones are only removed once the machine deployments of the
+// remaining zones are available, one zone per reconciliation, and not as long as persistent disks of the cluster exist
+// in the removed zone, because the persistent volumes could not be attached in the remaining zones.
+func (w *workerDelegate) migrateRemovedZones(
+	ctx context.Context,
+	pool v1alpha1.WorkerPool,
+	existing map[string]*machinev1alpha1.MachineDeployment,
+	machineDeployments worker.MachineDeployments,
+) (
+	worker.MachineDeployments,
+	[]apisgcp.ZoneMigrationStatus,
+	error,
+) {
+	removedZones := sets.KeySet(existing).Delete(pool.Zones...)
+	if removedZones.Len() == 0 {
+		return nil, nil, nil
+	}
+
+	existingMachineDeployments, err := w.getMachineDeploymentsInCluster(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	available := true
+	for _, machineDeployment := range machineDeployments {
+		current, ok := existingMachineDeployments[machineDeployment.Name]
+		if !ok || current.Status.AvailableReplicas < current.Spec.Replicas {
+			available = false
+			break
+		}
+	}
+
+	var (
+		kept     worker.MachineDeployments
+		statuses []apisgcp.ZoneMigrationStatus
+		removed  bool
+	)
+
+	for _, zone := range sets.List(removedZones) {
+		var disks []string
+		if available && !removed {
+			disks, err = w.persistentDisksInZone(ctx, zone)
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(disks) == 0 {
+				removed = true
+				continue
+			}
+		}
+
+		machineDeployment := existing[zone]
+		kept = append(kept, keptMachineDeployment(machineDeployment))
+		statuses = append(statuses, apisgcp.ZoneMigrationStatus{
+			PoolName:          pool.Name,
+			Zone:              zone,
+			MachineDeployment: machineDeployment.Name,
+			Disks:             disks,
+		})
+	}
+
+	return kept, statuses, nil
+}
+
+// keptMachineDeployment returns the given existing machine deployment with its current machine class and number of
+// machines.
+func keptMachineDeployment(existing *machinev1alpha1.MachineDeployment) worker.MachineDeployment {
+	className := existing.Spec.Template.Spec.Class.Name
+	return worker.MachineDeployment{
+		Name:                 existing.Name,
+		ClassName:            className,
+		SecretName:           className,
+		Minimum:              existing.Spec.Replicas,
+		Maximum:              existing.Spec.Replicas,
+		MaxSurge:             intstr.FromInt32(1),
+		MaxUnavailable:       intstr.FromInt32(0),
+		Labels:               existing.Spec.Template.Spec.NodeTemplateSpec.Labels,
+		Annotations:          existing.Spec.Template.Spec.NodeTemplateSpec.Annotations,
+		Taints:               existing.Spec.Template.Spec.NodeTemplateSpec.Spec.Taints,
+		MachineConfiguration: existing.Spec.Template.Spec.MachineConfiguration,
+	}
+}
+
+// persistentDisksInZone returns the names of the disks provisioned for persistent volumes of the cluster in the given
+// zone. The CSI driver labels the disks with the name of the cluster.
+func (w *workerDelegate) persistentDisksInZone(ctx context.Context, zone string) ([]string, error) {
+	computeClient, err := w.gcpClientFactory.Compute(ctx, w.client, w.worker.Spec.SecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("could not create compute client: %w", err)
+	}
+
+	disks, err := computeClient.ListDisks(ctx, zone, fmt.Sprintf("labels.%s = %q", gceLabelClusterName, SanitizeGcpLabelValue(w.worker.Namespace)))
+	if err != nil {
+		return nil, fmt.Errorf("could not list disks in zone %s: %w", zone, err)
+	}
+
+	var names []string
+	for _, disk := range disks {
+		if strings.HasPrefix(disk.Name, persistentDiskNamePrefix) {
+			names = append(names, disk.Name)
+		}
+	}
+	return names, nil
+}
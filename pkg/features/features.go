@@ -13,6 +13,23 @@ const (
 	// DisableGardenerServiceAccountCreation controls whether the gcp provider will create a default service account for VMs managed by MCM.
 	// beta: v1.29.0
 	DisableGardenerServiceAccountCreation featuregate.Feature = "DisableGardenerServiceAccountCreation"
+
+	// IPv6SingleStack enables the experimental support for shoots with IPv6-only networking.
+	// alpha: v1.35.0
+	IPv6SingleStack featuregate.Feature = "IPv6SingleStack"
+
+	// DualStack enables the experimental support for shoots with dual-stack (IPv4 and IPv6) networking.
+	// alpha: v1.35.0
+	DualStack featuregate.Feature = "DualStack"
+
+	// VolumeCloning enables the validation of PersistentVolumeClaims cloned from other PersistentVolumeClaims in shoots.
+	// alpha: v1.35.0
+	VolumeCloning featuregate.Feature = "VolumeCloning"
+
+	// InternalLoadBalancerGlobalAccess enables the default for the global access of internal load balancers of shoots,
+	// which is applied by a webhook in the shoots.
+	// alpha: v1.35.0
+	InternalLoadBalancerGlobalAccess featuregate.Feature = "InternalLoadBalancerGlobalAccess"
 )
 
 // ExtensionFeatureGate is the feature gate for the extension controllers.
@@ -22,5 +39,9 @@ var ExtensionFeatureGate = featuregate.NewFeatureGate()
 func RegisterExtensionFeatureGate() {
 	runtime.Must(ExtensionFeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
 		DisableGardenerServiceAccountCreation: {Default: true, PreRelease: featuregate.Beta},
+		IPv6SingleStack:                       {Default: false, PreRelease: featuregate.Alpha},
+		DualStack:                             {Default: false, PreRelease: featuregate.Alpha},
+		VolumeCloning:                         {Default: false, PreRelease: featuregate.Alpha},
+		InternalLoadBalancerGlobalAccess:      {Default: false, PreRelease: featuregate.Alpha},
 	}))
 }
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+// AuditLogExporterName is the name of the sidecar container of the kube-apiserver which exports the audit logs to
+// Cloud Logging.
+const AuditLogExporterName = "audit-log-exporter"
+
+// AuditLogID returns the ID of the log in Cloud Logging to which the audit logs of the kube-apiserver of the shoot with
+// the given technical ID are written.
+func AuditLogID(technicalID string) string {
+	return technicalID + "-kube-apiserver-audit"
+}
+
+// AuditLogSinkID returns the ID of the Cloud Logging sink which routes the audit logs of the shoot with the given
+// technical ID to the configured destination.
+func AuditLogSinkID(technicalID string) string {
+	return technicalID + "-audit-logs"
+}
@@ -9,10 +9,7 @@ import (
 	"net/http"
 	"strings"
 
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
 	compute "google.golang.org/api/compute/v1"
-	"google.golang.org/api/option"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
@@ -23,6 +20,23 @@ type ComputeClient interface {
 	GetExternalAddresses(ctx context.Context, region string) (map[string][]string, error)
 	// GetAddress returns a Address.
 	GetAddress(ctx context.Context, region, name string) (*Address, error)
+	// InsertAddress reserves an Address with the given specification.
+	InsertAddress(ctx context.Context, region string, address *Address) (*Address, error)
+	// DeleteAddress releases the Address specified by name. Return no error if the address is not found.
+	DeleteAddress(ctx context.Context, region, name string) error
+	// SetAddressLabels replaces the labels of the given Address.
+	SetAddressLabels(ctx context.Context, region string, address *Address, labels map[string]string) error
+	// ListAddresses lists the Addresses in the given region matching the given filter expression.
+	ListAddresses(ctx context.Context, region, filter string) ([]*Address, error)
+
+	// GetForwardingRule returns the ForwardingRule specified by name.
+	GetForwardingRule(ctx context.Context, region, name string) (*ForwardingRule, error)
+	// InsertForwardingRule creates a ForwardingRule with the given specification.
+	InsertForwardingRule(ctx context.Context, region string, rule *ForwardingRule) (*ForwardingRule, error)
+	// DeleteForwardingRule deletes the ForwardingRule specified by name. Return no error if the rule is not found.
+	DeleteForwardingRule(ctx context.Context, region, name string) error
+	// ListForwardingRules lists all ForwardingRules in the given region.
+	ListForwardingRules(ctx context.Context, region string) ([]*ForwardingRule, error)
 
 	// InsertNetwork creates a Network with the given specification.
 	InsertNetwork(ctx context.Context, nw *Network) (*Network, error)
@@ -32,6 +46,12 @@ type ComputeClient interface {
 	DeleteNetwork(ctx context.Context, id string) error
 	// PatchNetwork patches the network identified by id with the given specification.
 	PatchNetwork(ctx context.Context, id string, nw *Network) (*Network, error)
+	// AddPeering adds the given peering to the network identified by id.
+	AddPeering(ctx context.Context, id string, peering *NetworkPeering) error
+	// UpdatePeering updates the given peering of the network identified by id.
+	UpdatePeering(ctx context.Context, id string, peering *NetworkPeering) error
+	// RemovePeering removes the peering with the given name from the network identified by id.
+	RemovePeering(ctx context.Context, id, name string) error
 
 	// InsertSubnet creates a Subnetwork with the given specification.
 	InsertSubnet(ctx context.Context, region string, subnet *Subnetwork) (*Subnetwork, error)
@@ -43,6 +63,8 @@ type ComputeClient interface {
 	DeleteSubnet(ctx context.Context, region, id string) error
 	// ExpandSubnet expands the subnet to the target CIDR.
 	ExpandSubnet(ctx context.Context, region, id, cidr string) (*Subnetwork, error)
+	// SetSubnetPrivateIpGoogleAccess enables or disables Private Google Access on the subnet.
+	SetSubnetPrivateIpGoogleAccess(ctx context.Context, region, id string, enabled bool) (*Subnetwork, error)
 
 	// InsertRouter creates a router with the given specification.
 	InsertRouter(ctx context.Context, region string, router *Router) (*Router, error)
@@ -67,6 +89,61 @@ type ComputeClient interface {
 	DeleteFirewallRule(ctx context.Context, firewall string) error
 	// ListFirewallRules lists all firewall rules.
 	ListFirewallRules(ctx context.Context) ([]*Firewall, error)
+
+	// InsertNetworkFirewallPolicy creates a global network firewall policy with the given specification.
+	InsertNetworkFirewallPolicy(ctx context.Context, policy *FirewallPolicy) (*FirewallPolicy, error)
+	// GetNetworkFirewallPolicy returns the global network firewall policy specified by name.
+	GetNetworkFirewallPolicy(ctx context.Context, name string) (*FirewallPolicy, error)
+	// DeleteNetworkFirewallPolicy deletes the global network firewall policy specified by name.
+	DeleteNetworkFirewallPolicy(ctx context.Context, name string) error
+	// AddNetworkFirewallPolicyRule adds the given rule to the global network firewall policy specified by name.
+	AddNetworkFirewallPolicyRule(ctx context.Context, name string, rule *FirewallPolicyRule) error
+	// PatchNetworkFirewallPolicyRule updates the rule with the priority of the given rule of the global network firewall
+	// policy specified by name.
+	PatchNetworkFirewallPolicyRule(ctx context.Context, name string, rule *FirewallPolicyRule) error
+	// RemoveNetworkFirewallPolicyRule removes the rule with the given priority from the global network firewall policy
+	// specified by name.
+	RemoveNetworkFirewallPolicyRule(ctx context.Context, name string, priority int64) error
+	// AddNetworkFirewallPolicyAssociation associates the global network firewall policy specified by name with the
+	// target of the given association.
+	AddNetworkFirewallPolicyAssociation(ctx context.Context, name string, association *FirewallPolicyAssociation) error
+	// RemoveNetworkFirewallPolicyAssociation removes the association with the given name from the global network
+	// firewall policy specified by name.
+	RemoveNetworkFirewallPolicyAssociation(ctx context.Context, name, associationName string) error
+
+	// InsertPacketMirroring creates a packet mirroring policy with the given specification.
+	InsertPacketMirroring(ctx context.Context, region string, mirroring *PacketMirroring) (*PacketMirroring, error)
+	// GetPacketMirroring returns the packet mirroring policy specified by name.
+	GetPacketMirroring(ctx context.Context, region, name string) (*PacketMirroring, error)
+	// PatchPacketMirroring updates the packet mirroring policy specified by name with the given specification.
+	PatchPacketMirroring(ctx context.Context, region, name string, mirroring *PacketMirroring) (*PacketMirroring, error)
+	// DeletePacketMirroring deletes the packet mirroring policy specified by name.
+	DeletePacketMirroring(ctx context.Context, region, name string) error
+
+	// ListInstances lists the instances of all zones.
+	ListInstances(ctx context.Context) ([]*Instance, error)
+	// GetInstanceSerialPortOutput returns the output of the first serial port of the specified instance.
+	GetInstanceSerialPortOutput(ctx context.Context, zone, instance string) (string, error)
+	// GetInstanceScreenshot returns a base64 encoded PNG screenshot of the specified instance.
+	GetInstanceScreenshot(ctx context.Context, zone, instance string) (string, error)
+	// SetInstanceDeletionProtection enables or disables the deletion protection of the specified instance. Return no
+	// error if the instance is not found.
+	SetInstanceDeletionProtection(ctx context.Context, zone, instance string, deletionProtection bool) error
+
+	// ListDisks lists the disks in the given zone matching the given filter expression.
+	ListDisks(ctx context.Context, zone, filter string) ([]*Disk, error)
+	// DeleteDisk deletes the specified disk. Return no error if the disk is not found.
+	DeleteDisk(ctx context.Context, zone, name string) error
+	// CreateDiskSnapshot creates a snapshot of the specified disk. The operation is not awaited, the status of the
+	// snapshot has to be checked with GetSnapshot. Return no error if the snapshot already exists.
+	CreateDiskSnapshot(ctx context.Context, zone, disk, snapshot string) error
+	// GetSnapshot returns the snapshot specified by name.
+	GetSnapshot(ctx context.Context, name string) (*Snapshot, error)
+	// DeleteSnapshot deletes the snapshot specified by name.
+	DeleteSnapshot(ctx context.Context, name string) error
+
+	// GetRegion returns the specified region including its quotas.
+	GetRegion(ctx context.Context, region string) (*Region, error)
 }
 
 type computeClient struct {
@@ -79,14 +156,14 @@ type computeClient struct {
 // the completion of the respective operations before returning.
 // Delete operations will ignore errors when the respective resource can not be found, meaning that the Delete operations will never return HTTP 404 errors.
 // Update operations will ignore errors when the update operation is a no-op, meaning that Update operations will ignore HTTP 304 errors.
-func NewComputeClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (ComputeClient, error) {
-	jwt, err := google.JWTConfigFromJSON(serviceAccount.Raw, compute.ComputeScope)
+func NewComputeClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, opts ...Option) (ComputeClient, error) {
+	options := newOptions(opts...)
+	credentials, err := options.credentials(ctx, serviceAccount, compute.ComputeScope)
 	if err != nil {
 		return nil, err
 	}
 
-	httpClient := oauth2.NewClient(ctx, jwt.TokenSource(ctx))
-	service, err := compute.NewService(ctx, option.WithHTTPClient(httpClient))
+	service, err := compute.NewService(ctx, options.clientOptions(options.httpClient(ctx, credentials.TokenSource), ServiceCompute)...)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +248,33 @@ func (c *computeClient) PatchNetwork(ctx context.Context, id string, n *Network)
 	return c.GetNetwork(ctx, n.Name)
 }
 
+// AddPeering adds the given peering to the network identified by id.
+func (c *computeClient) AddPeering(ctx context.Context, id string, peering *NetworkPeering) error {
+	op, err := c.service.Networks.AddPeering(c.projectID, id, &compute.NetworksAddPeeringRequest{NetworkPeering: peering}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, op)
+}
+
+// UpdatePeering updates the given peering of the network identified by id.
+func (c *computeClient) UpdatePeering(ctx context.Context, id string, peering *NetworkPeering) error {
+	op, err := c.service.Networks.UpdatePeering(c.projectID, id, &compute.NetworksUpdatePeeringRequest{NetworkPeering: peering}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, op)
+}
+
+// RemovePeering removes the peering with the given name from the network identified by id.
+func (c *computeClient) RemovePeering(ctx context.Context, id, name string) error {
+	op, err := c.service.Networks.RemovePeering(c.projectID, id, &compute.NetworksRemovePeeringRequest{Name: name}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, op)
+}
+
 // InsertSubnet creates a Subnetwork with the given specification.
 func (c *computeClient) InsertSubnet(ctx context.Context, region string, subnet *Subnetwork) (*Subnetwork, error) {
 	op, err := c.service.Subnetworks.Insert(c.projectID, region, subnet).Context(ctx).Do()
@@ -240,6 +344,24 @@ func (c *computeClient) ExpandSubnet(ctx context.Context, region, id, cidr strin
 	return c.GetSubnet(ctx, region, id)
 }
 
+// SetSubnetPrivateIpGoogleAccess enables or disables Private Google Access on the subnet.
+func (c *computeClient) SetSubnetPrivateIpGoogleAccess(ctx context.Context, region, id string, enabled bool) (*Subnetwork, error) {
+	op, err := c.service.Subnetworks.SetPrivateIpGoogleAccess(c.projectID, region, id, &compute.SubnetworksSetPrivateIpGoogleAccessRequest{
+		PrivateIpGoogleAccess: enabled,
+		ForceSendFields:       []string{"PrivateIpGoogleAccess"},
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.wait(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetSubnet(ctx, region, id)
+}
+
 // InsertRouter creates a router with the given specification.
 func (c *computeClient) InsertRouter(ctx context.Context, region string, router *Router) (*Router, error) {
 	op, err := c.service.Routers.Insert(c.projectID, region, router).Context(ctx).Do()
@@ -311,6 +433,95 @@ func (c *computeClient) GetAddress(ctx context.Context, region, name string) (*A
 	return a, nil
 }
 
+// InsertAddress reserves an Address with the given specification.
+func (c *computeClient) InsertAddress(ctx context.Context, region string, address *Address) (*Address, error) {
+	op, err := c.service.Addresses.Insert(c.projectID, region, address).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	err = c.wait(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetAddress(ctx, region, address.Name)
+}
+
+// DeleteAddress releases the Address specified by name. Return no error if the address is not found.
+func (c *computeClient) DeleteAddress(ctx context.Context, region, name string) error {
+	op, err := c.service.Addresses.Delete(c.projectID, region, name).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+	return c.wait(ctx, op)
+}
+
+// SetAddressLabels replaces the labels of the given Address.
+func (c *computeClient) SetAddressLabels(ctx context.Context, region string, address *Address, labels map[string]string) error {
+	op, err := c.service.Addresses.SetLabels(c.projectID, region, address.Name, &compute.RegionSetLabelsRequest{
+		Labels:           labels,
+		LabelFingerprint: address.LabelFingerprint,
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, op)
+}
+
+// ListAddresses lists the Addresses in the given region matching the given filter expression.
+func (c *computeClient) ListAddresses(ctx context.Context, region, filter string) ([]*Address, error) {
+	var addresses []*Address
+	if err := c.service.Addresses.List(c.projectID, region).Filter(filter).Pages(ctx, func(resp *compute.AddressList) error {
+		addresses = append(addresses, resp.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// GetForwardingRule returns the ForwardingRule specified by name.
+func (c *computeClient) GetForwardingRule(ctx context.Context, region, name string) (*ForwardingRule, error) {
+	rule, err := c.service.ForwardingRules.Get(c.projectID, region, name).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return rule, nil
+}
+
+// InsertForwardingRule creates a ForwardingRule with the given specification.
+func (c *computeClient) InsertForwardingRule(ctx context.Context, region string, rule *ForwardingRule) (*ForwardingRule, error) {
+	op, err := c.service.ForwardingRules.Insert(c.projectID, region, rule).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	err = c.wait(ctx, op)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetForwardingRule(ctx, region, rule.Name)
+}
+
+// DeleteForwardingRule deletes the ForwardingRule specified by name. Return no error if the rule is not found.
+func (c *computeClient) DeleteForwardingRule(ctx context.Context, region, name string) error {
+	op, err := c.service.ForwardingRules.Delete(c.projectID, region, name).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+	return c.wait(ctx, op)
+}
+
+// ListForwardingRules lists all ForwardingRules in the given region.
+func (c *computeClient) ListForwardingRules(ctx context.Context, region string) ([]*ForwardingRule, error) {
+	var rules []*ForwardingRule
+	if err := c.service.ForwardingRules.List(c.projectID, region).Pages(ctx, func(resp *compute.ForwardingRuleList) error {
+		rules = append(rules, resp.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
 // InsertFirewallRule creates a firewall rule with the given specification.
 func (c *computeClient) InsertFirewallRule(ctx context.Context, firewall *Firewall) (*Firewall, error) {
 	op, err := c.service.Firewalls.Insert(c.projectID, firewall).Context(ctx).Do()
@@ -373,6 +584,139 @@ func (c *computeClient) ListFirewallRules(ctx context.Context) ([]*Firewall, err
 	return fws.Items, nil
 }
 
+// InsertNetworkFirewallPolicy creates a global network firewall policy with the given specification.
+func (c *computeClient) InsertNetworkFirewallPolicy(ctx context.Context, policy *FirewallPolicy) (*FirewallPolicy, error) {
+	op, err := c.service.NetworkFirewallPolicies.Insert(c.projectID, policy).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.wait(ctx, op); err != nil {
+		return nil, err
+	}
+	return c.GetNetworkFirewallPolicy(ctx, policy.Name)
+}
+
+// GetNetworkFirewallPolicy returns the global network firewall policy specified by name.
+func (c *computeClient) GetNetworkFirewallPolicy(ctx context.Context, name string) (*FirewallPolicy, error) {
+	policy, err := c.service.NetworkFirewallPolicies.Get(c.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return policy, nil
+}
+
+// DeleteNetworkFirewallPolicy deletes the global network firewall policy specified by name.
+func (c *computeClient) DeleteNetworkFirewallPolicy(ctx context.Context, name string) error {
+	op, err := c.service.NetworkFirewallPolicies.Delete(c.projectID, name).Context(ctx).Do()
+	if IgnoreNotFoundError(err) != nil {
+		return err
+	}
+	if IsNotFoundError(err) {
+		return nil
+	}
+	return c.wait(ctx, op)
+}
+
+// AddNetworkFirewallPolicyRule adds the given rule to the global network firewall policy specified by name.
+func (c *computeClient) AddNetworkFirewallPolicyRule(ctx context.Context, name string, rule *FirewallPolicyRule) error {
+	op, err := c.service.NetworkFirewallPolicies.AddRule(c.projectID, name, rule).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, op)
+}
+
+// PatchNetworkFirewallPolicyRule updates the rule with the priority of the given rule of the global network firewall
+// policy specified by name.
+func (c *computeClient) PatchNetworkFirewallPolicyRule(ctx context.Context, name string, rule *FirewallPolicyRule) error {
+	op, err := c.service.NetworkFirewallPolicies.PatchRule(c.projectID, name, rule).Priority(rule.Priority).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, op)
+}
+
+// RemoveNetworkFirewallPolicyRule removes the rule with the given priority from the global network firewall policy
+// specified by name.
+func (c *computeClient) RemoveNetworkFirewallPolicyRule(ctx context.Context, name string, priority int64) error {
+	op, err := c.service.NetworkFirewallPolicies.RemoveRule(c.projectID, name).Priority(priority).Context(ctx).Do()
+	if IgnoreNotFoundError(err) != nil {
+		return err
+	}
+	if IsNotFoundError(err) {
+		return nil
+	}
+	return c.wait(ctx, op)
+}
+
+// AddNetworkFirewallPolicyAssociation associates the global network firewall policy specified by name with the target
+// of the given association.
+func (c *computeClient) AddNetworkFirewallPolicyAssociation(ctx context.Context, name string, association *FirewallPolicyAssociation) error {
+	op, err := c.service.NetworkFirewallPolicies.AddAssociation(c.projectID, name, association).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	return c.wait(ctx, op)
+}
+
+// RemoveNetworkFirewallPolicyAssociation removes the association with the given name from the global network firewall
+// policy specified by name.
+func (c *computeClient) RemoveNetworkFirewallPolicyAssociation(ctx context.Context, name, associationName string) error {
+	op, err := c.service.NetworkFirewallPolicies.RemoveAssociation(c.projectID, name).Name(associationName).Context(ctx).Do()
+	if IgnoreNotFoundError(err) != nil {
+		return err
+	}
+	if IsNotFoundError(err) {
+		return nil
+	}
+	return c.wait(ctx, op)
+}
+
+// InsertPacketMirroring creates a packet mirroring policy with the given specification.
+func (c *computeClient) InsertPacketMirroring(ctx context.Context, region string, mirroring *PacketMirroring) (*PacketMirroring, error) {
+	op, err := c.service.PacketMirrorings.Insert(c.projectID, region, mirroring).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.wait(ctx, op); err != nil {
+		return nil, err
+	}
+	return c.GetPacketMirroring(ctx, region, mirroring.Name)
+}
+
+// GetPacketMirroring returns the packet mirroring policy specified by name.
+func (c *computeClient) GetPacketMirroring(ctx context.Context, region, name string) (*PacketMirroring, error) {
+	mirroring, err := c.service.PacketMirrorings.Get(c.projectID, region, name).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return mirroring, nil
+}
+
+// PatchPacketMirroring updates the packet mirroring policy specified by name with the given specification.
+func (c *computeClient) PatchPacketMirroring(ctx context.Context, region, name string, mirroring *PacketMirroring) (*PacketMirroring, error) {
+	op, err := c.service.PacketMirrorings.Patch(c.projectID, region, name, mirroring).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.wait(ctx, op); err != nil {
+		return nil, err
+	}
+	return c.GetPacketMirroring(ctx, region, name)
+}
+
+// DeletePacketMirroring deletes the packet mirroring policy specified by name.
+func (c *computeClient) DeletePacketMirroring(ctx context.Context, region, name string) error {
+	op, err := c.service.PacketMirrorings.Delete(c.projectID, region, name).Context(ctx).Do()
+	if IgnoreNotFoundError(err) != nil {
+		return err
+	}
+	if IsNotFoundError(err) {
+		return nil
+	}
+	return c.wait(ctx, op)
+}
+
 // DeleteRoute deletes the specified route.
 func (c *computeClient) DeleteRoute(ctx context.Context, name string) error {
 	op, err := c.service.Routes.Delete(c.projectID, name).Context(ctx).Do()
@@ -385,3 +729,98 @@ func (c *computeClient) DeleteRoute(ctx context.Context, name string) error {
 
 	return c.wait(ctx, op)
 }
+
+// ListInstances lists the instances of all zones.
+func (c *computeClient) ListInstances(ctx context.Context) ([]*Instance, error) {
+	var instances []*Instance
+	if err := c.service.Instances.AggregatedList(c.projectID).Pages(ctx, func(resp *compute.InstanceAggregatedList) error {
+		for _, scoped := range resp.Items {
+			instances = append(instances, scoped.Instances...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+// GetInstanceSerialPortOutput returns the output of the first serial port of the specified instance.
+func (c *computeClient) GetInstanceSerialPortOutput(ctx context.Context, zone, instance string) (string, error) {
+	output, err := c.service.Instances.GetSerialPortOutput(c.projectID, zone, instance).Context(ctx).Do()
+	if err != nil {
+		return "", err
+	}
+
+	return output.Contents, nil
+}
+
+// GetInstanceScreenshot returns a base64 encoded PNG screenshot of the specified instance.
+func (c *computeClient) GetInstanceScreenshot(ctx context.Context, zone, instance string) (string, error) {
+	screenshot, err := c.service.Instances.GetScreenshot(c.projectID, zone, instance).Context(ctx).Do()
+	if err != nil {
+		return "", err
+	}
+
+	return screenshot.Contents, nil
+}
+
+// SetInstanceDeletionProtection enables or disables the deletion protection of the specified instance.
+func (c *computeClient) SetInstanceDeletionProtection(ctx context.Context, zone, instance string, deletionProtection bool) error {
+	op, err := c.service.Instances.SetDeletionProtection(c.projectID, zone, instance).DeletionProtection(deletionProtection).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+
+	return c.wait(ctx, op)
+}
+
+// ListDisks lists the disks in the given zone matching the given filter expression.
+func (c *computeClient) ListDisks(ctx context.Context, zone, filter string) ([]*Disk, error) {
+	var disks []*Disk
+	if err := c.service.Disks.List(c.projectID, zone).Filter(filter).Pages(ctx, func(resp *compute.DiskList) error {
+		disks = append(disks, resp.Items...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return disks, nil
+}
+
+// DeleteDisk deletes the specified disk. Return no error if the disk is not found.
+func (c *computeClient) DeleteDisk(ctx context.Context, zone, name string) error {
+	op, err := c.service.Disks.Delete(c.projectID, zone, name).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+	return c.wait(ctx, op)
+}
+
+// CreateDiskSnapshot creates a snapshot of the specified disk.
+func (c *computeClient) CreateDiskSnapshot(ctx context.Context, zone, disk, snapshot string) error {
+	_, err := c.service.Disks.CreateSnapshot(c.projectID, zone, disk, &compute.Snapshot{Name: snapshot}).Context(ctx).Do()
+	return IgnoreErrorCodes(err, http.StatusConflict)
+}
+
+// GetSnapshot returns the snapshot specified by name.
+func (c *computeClient) GetSnapshot(ctx context.Context, name string) (*Snapshot, error) {
+	snapshot, err := c.service.Snapshots.Get(c.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return snapshot, nil
+}
+
+// DeleteSnapshot deletes the snapshot specified by name.
+func (c *computeClient) DeleteSnapshot(ctx context.Context, name string) error {
+	op, err := c.service.Snapshots.Delete(c.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+
+	return c.wait(ctx, op)
+}
+
+// GetRegion returns the specified region including its quotas.
+func (c *computeClient) GetRegion(ctx context.Context, region string) (*Region, error) {
+	return c.service.Regions.Get(c.projectID, region).Context(ctx).Do()
+}
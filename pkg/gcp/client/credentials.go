@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iamcredentials/v1"
+	"google.golang.org/api/sts/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// cloudPlatformScope is the scope of the access tokens of external accounts which impersonate a service account.
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	tokenExchangeGrantType   = "urn:ietf:params:oauth:grant-type:token-exchange"
+	accessTokenRequestedType = "urn:ietf:params:oauth:token-type:access_token"
+
+	// tokenExchangeTimeout is the timeout of the requests exchanging subject tokens for access tokens, which are not
+	// bound to the context the credentials were created with, as the access tokens are refreshed beyond it.
+	tokenExchangeTimeout = time.Minute
+)
+
+// credentials returns the credentials of the given service account with the given scopes. Workload identity federation
+// credentials are not passed to the Google client library, as their credential source could make the extension read
+// arbitrary files or URLs. Instead, the subject token stored with the credentials is exchanged by the extension itself.
+func (o *Options) credentials(ctx context.Context, serviceAccount *gcp.ServiceAccount, scopes ...string) (*google.Credentials, error) {
+	if serviceAccount.Type != gcp.ExternalAccountCredentialType {
+		return google.CredentialsFromJSON(ctx, serviceAccount.Raw, scopes...)
+	}
+
+	config, err := gcp.ParseExternalAccountConfig(serviceAccount.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return &google.Credentials{
+		ProjectID: serviceAccount.ProjectID,
+		TokenSource: oauth2.ReuseTokenSource(nil, &externalAccountTokenSource{
+			options:      o,
+			config:       config,
+			subjectToken: serviceAccount.SubjectToken,
+			scopes:       scopes,
+		}),
+	}, nil
+}
+
+// externalAccountTokenSource exchanges the subject token of workload identity federation credentials for access tokens
+// at the Security Token Service and impersonates the configured service account with them.
+type externalAccountTokenSource struct {
+	options      *Options
+	config       *gcp.ExternalAccountConfig
+	subjectToken string
+	scopes       []string
+}
+
+// Token implements oauth2.TokenSource.
+func (e *externalAccountTokenSource) Token() (*oauth2.Token, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenExchangeTimeout)
+	defer cancel()
+
+	serviceAccount := e.config.ImpersonatedServiceAccount()
+
+	scope := strings.Join(e.scopes, " ")
+	if serviceAccount != "" {
+		scope = cloudPlatformScope
+	}
+
+	// The token exchange is not authenticated, as the subject token is the proof of the identity.
+	stsService, err := sts.NewService(ctx, e.options.clientOptions(e.options.unauthenticatedHTTPClient(), ServiceSTS)...)
+	if err != nil {
+		return nil, err
+	}
+	exchanged, err := stsService.V1.Token(&sts.GoogleIdentityStsV1ExchangeTokenRequest{
+		Audience:           e.config.Audience,
+		GrantType:          tokenExchangeGrantType,
+		RequestedTokenType: accessTokenRequestedType,
+		Scope:              scope,
+		SubjectToken:       e.subjectToken,
+		SubjectTokenType:   e.config.SubjectTokenType,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("could not exchange subject token of external account: %w", err)
+	}
+	federatedToken := &oauth2.Token{
+		AccessToken: exchanged.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Duration(exchanged.ExpiresIn) * time.Second),
+	}
+	if serviceAccount == "" {
+		return federatedToken, nil
+	}
+
+	iamCredentialsService, err := iamcredentials.NewService(ctx, e.options.clientOptions(e.options.httpClient(ctx, oauth2.StaticTokenSource(federatedToken)), ServiceIAMCredentials)...)
+	if err != nil {
+		return nil, err
+	}
+	generated, err := iamCredentialsService.Projects.ServiceAccounts.GenerateAccessToken("projects/-/serviceAccounts/"+serviceAccount, &iamcredentials.GenerateAccessTokenRequest{
+		Scope: e.scopes,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("could not impersonate service account %s: %w", serviceAccount, err)
+	}
+	expiry, err := time.Parse(time.RFC3339, generated.ExpireTime)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse expiry of access token of service account %s: %w", serviceAccount, err)
+	}
+	return &oauth2.Token{
+		AccessToken: generated.AccessToken,
+		TokenType:   "Bearer",
+		Expiry:      expiry,
+	}, nil
+}
+
+// unauthenticatedHTTPClient returns an HTTP client which does not authenticate its requests.
+func (o *Options) unauthenticatedHTTPClient() *http.Client {
+	client := &http.Client{Transport: http.DefaultTransport}
+	if o.WrapTransport != nil {
+		client.Transport = o.WrapTransport(client.Transport)
+	}
+	return client
+}
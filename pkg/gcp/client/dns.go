@@ -6,54 +6,86 @@ package client
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"reflect"
 	"strings"
 
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
 	googledns "google.golang.org/api/dns/v1"
-	"google.golang.org/api/option"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
+const managedZoneVisibilityPrivate = "private"
+
 // DNSClient is an interface which must be implemented by GCP DNS clients.
 type DNSClient interface {
 	GetManagedZones(ctx context.Context) (map[string]string, error)
+	ResolveManagedZone(ctx context.Context, managedZone string) (string, error)
+	GetNameServers(ctx context.Context, managedZone string) (string, []string, error)
 	CreateOrUpdateRecordSet(ctx context.Context, managedZone, name, recordType string, rrdatas []string, ttl int64) error
+	CreateOrUpdateRoutingPolicyRecordSet(ctx context.Context, managedZone, name, recordType string, routingPolicy *RoutingPolicy, ttl int64) error
 	DeleteRecordSet(ctx context.Context, managedZone, name, recordType string) error
+	GetManagedZone(ctx context.Context, name string) (*ManagedZone, error)
+	CreateManagedZone(ctx context.Context, zone *ManagedZone) (*ManagedZone, error)
+	PatchManagedZone(ctx context.Context, name string, zone *ManagedZone) error
+	DeleteManagedZone(ctx context.Context, name string) error
 }
 
 type dnsClient struct {
 	service   *googledns.Service
 	projectID string
+	// batcher batches the changes of resource recordsets with the changes of other clients with the same credentials,
+	// which are identified by credentialsKey.
+	batcher        *DNSChangeBatcher
+	credentialsKey string
 }
 
 // NewDNSClient returns a client for GCP's CloudDNS service.
-func NewDNSClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (DNSClient, error) {
-	credentials, err := google.CredentialsFromJSON(ctx, serviceAccount.Raw, googledns.NdevClouddnsReadwriteScope)
+func NewDNSClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, opts ...Option) (DNSClient, error) {
+	options := newOptions(opts...)
+	credentials, err := options.credentials(ctx, serviceAccount, googledns.NdevClouddnsReadwriteScope)
 	if err != nil {
 		return nil, err
 	}
-	client := oauth2.NewClient(ctx, credentials.TokenSource)
-	service, err := googledns.NewService(ctx, option.WithHTTPClient(client))
+	service, err := googledns.NewService(ctx, options.clientOptions(options.httpClient(ctx, credentials.TokenSource), ServiceDNS)...)
 	if err != nil {
 		return nil, err
 	}
 
+	// The project of the service account may differ from the project of the credentials if the DNS managed zones are
+	// hosted in another project.
+	credentialsHash := sha256.Sum256(serviceAccount.Raw)
 	return &dnsClient{
-		service:   service,
-		projectID: credentials.ProjectID,
+		service:        service,
+		projectID:      serviceAccount.ProjectID,
+		batcher:        options.DNSChangeBatcher,
+		credentialsKey: hex.EncodeToString(credentialsHash[:]),
 	}, nil
 }
 
 // GetManagedZones returns a map of all managed zone DNS names mapped to their IDs, composed of the project ID and
-// their user assigned resource names.
+// their user assigned resource names. Public and private zones are returned, while forwarding, peering and service
+// directory zones are skipped, as their records are not served. If a public and a private zone have the same DNS name,
+// the public zone is returned.
 func (s *dnsClient) GetManagedZones(ctx context.Context) (map[string]string, error) {
-	zones := make(map[string]string)
+	var (
+		zones   = make(map[string]string)
+		private = make(map[string]bool)
+	)
 	f := func(resp *googledns.ManagedZonesListResponse) error {
 		for _, zone := range resp.ManagedZones {
-			zones[normalizeZoneName(zone.DnsName)] = s.zoneID(zone.Name)
+			if !canHoldRecords(zone) {
+				continue
+			}
+			dnsName := normalizeZoneName(zone.DnsName)
+			if _, ok := zones[dnsName]; ok && !private[dnsName] {
+				continue
+			}
+			zones[dnsName] = s.zoneID(zone.Name)
+			private[dnsName] = zone.Visibility == managedZoneVisibilityPrivate
 		}
 		return nil
 	}
@@ -64,6 +96,35 @@ func (s *dnsClient) GetManagedZones(ctx context.Context) (map[string]string, err
 	return zones, nil
 }
 
+// ResolveManagedZone returns the ID, composed of the project ID and the user assigned resource name, of the managed
+// zone with the given name or numeric ID, which may be prefixed with the project ID. An error is returned if records
+// cannot be written into the zone.
+func (s *dnsClient) ResolveManagedZone(ctx context.Context, managedZone string) (string, error) {
+	project, managedZone := s.projectAndManagedZone(managedZone)
+	zone, err := s.service.ManagedZones.Get(project, managedZone).Context(ctx).Do()
+	if err != nil {
+		return "", err
+	}
+	if !canHoldRecords(zone) {
+		return "", fmt.Errorf("records cannot be written into managed zone %s, as it is a forwarding, peering or service directory zone", zone.Name)
+	}
+	return project + "/" + zone.Name, nil
+}
+
+// GetNameServers returns the DNS name and the name servers of the managed zone with the given name or ID. No name
+// servers are returned for private zones, as they are only resolvable within their VPC networks.
+func (s *dnsClient) GetNameServers(ctx context.Context, managedZone string) (string, []string, error) {
+	project, managedZone := s.projectAndManagedZone(managedZone)
+	zone, err := s.service.ManagedZones.Get(project, managedZone).Context(ctx).Do()
+	if err != nil {
+		return "", nil, err
+	}
+	if zone.Visibility == managedZoneVisibilityPrivate {
+		return normalizeZoneName(zone.DnsName), nil, nil
+	}
+	return normalizeZoneName(zone.DnsName), zone.NameServers, nil
+}
+
 // CreateOrUpdateRecordSet creates or updates the resource recordset with the given name, record type, rrdatas, and ttl
 // in the managed zone with the given name or ID.
 func (s *dnsClient) CreateOrUpdateRecordSet(ctx context.Context, managedZone, name, recordType string, rrdatas []string, ttl int64) error {
@@ -82,8 +143,33 @@ func (s *dnsClient) CreateOrUpdateRecordSet(ctx context.Context, managedZone, na
 		change.Deletions = append(change.Deletions, rrs)
 	}
 	change.Additions = append(change.Additions, &googledns.ResourceRecordSet{Name: name, Type: recordType, Rrdatas: rrdatas, Ttl: ttl})
-	_, err = s.service.Changes.Create(project, managedZone, change).Context(ctx).Do()
-	return err
+	return s.createChange(ctx, project, managedZone, change)
+}
+
+// CreateOrUpdateRoutingPolicyRecordSet creates or updates the resource recordset with the given name, record type,
+// routing policy, and ttl in the managed zone with the given name or ID. Queries are answered with the rrdatas of the
+// items of the routing policy instead of the rrdatas of the recordset.
+func (s *dnsClient) CreateOrUpdateRoutingPolicyRecordSet(ctx context.Context, managedZone, name, recordType string, routingPolicy *RoutingPolicy, ttl int64) error {
+	project, managedZone := s.projectAndManagedZone(managedZone)
+	name = ensureTrailingDot(name)
+	rrs, err := s.getResourceRecordSet(ctx, project, managedZone, name, recordType)
+	if err != nil {
+		return err
+	}
+	formatRoutingPolicyRrdatas(recordType, routingPolicy)
+	change := &googledns.Change{}
+	if rrs != nil {
+		same, err := isSameRoutingPolicy(rrs.RoutingPolicy, routingPolicy)
+		if err != nil {
+			return err
+		}
+		if same && len(rrs.Rrdatas) == 0 && rrs.Ttl == ttl {
+			return nil
+		}
+		change.Deletions = append(change.Deletions, rrs)
+	}
+	change.Additions = append(change.Additions, &googledns.ResourceRecordSet{Name: name, Type: recordType, RoutingPolicy: routingPolicy, Ttl: ttl})
+	return s.createChange(ctx, project, managedZone, change)
 }
 
 // DeleteRecordSet deletes the resource recordset with the given name and record type
@@ -101,7 +187,63 @@ func (s *dnsClient) DeleteRecordSet(ctx context.Context, managedZone, name, reco
 	change := &googledns.Change{
 		Deletions: []*googledns.ResourceRecordSet{rrs},
 	}
-	_, err = s.service.Changes.Create(project, managedZone, change).Context(ctx).Do()
+	return s.createChange(ctx, project, managedZone, change)
+}
+
+// GetManagedZone returns the managed zone with the given name in the project of the client or nil if it does not
+// exist.
+func (s *dnsClient) GetManagedZone(ctx context.Context, name string) (*ManagedZone, error) {
+	zone, err := s.service.ManagedZones.Get(s.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return zone, nil
+}
+
+// CreateManagedZone creates the given managed zone in the project of the client.
+func (s *dnsClient) CreateManagedZone(ctx context.Context, zone *ManagedZone) (*ManagedZone, error) {
+	return s.service.ManagedZones.Create(s.projectID, zone).Context(ctx).Do()
+}
+
+// PatchManagedZone updates the managed zone with the given name in the project of the client with the given
+// specification.
+func (s *dnsClient) PatchManagedZone(ctx context.Context, name string, zone *ManagedZone) error {
+	_, err := s.service.ManagedZones.Patch(s.projectID, name, zone).Context(ctx).Do()
+	return err
+}
+
+// DeleteManagedZone deletes the managed zone with the given name in the project of the client including its resource
+// recordsets. Returns no error if the zone is not found.
+func (s *dnsClient) DeleteManagedZone(ctx context.Context, name string) error {
+	change := &googledns.Change{}
+	f := func(resp *googledns.ResourceRecordSetsListResponse) error {
+		for _, rrs := range resp.Rrsets {
+			// The SOA and NS recordsets of the zone apex are managed by Cloud DNS and deleted with the zone.
+			if rrs.Type != "SOA" && rrs.Type != "NS" {
+				change.Deletions = append(change.Deletions, rrs)
+			}
+		}
+		return nil
+	}
+	if err := s.service.ResourceRecordSets.List(s.projectID, name).Pages(ctx, f); err != nil {
+		return IgnoreNotFoundError(err)
+	}
+
+	if len(change.Deletions) > 0 {
+		if err := s.createChange(ctx, s.projectID, name, change); err != nil {
+			return err
+		}
+	}
+	return IgnoreNotFoundError(s.service.ManagedZones.Delete(s.projectID, name).Context(ctx).Do())
+}
+
+// createChange applies the given change to the given managed zone. The change is batched with the changes of other
+// clients if a DNSChangeBatcher is configured.
+func (s *dnsClient) createChange(ctx context.Context, project, managedZone string, change *googledns.Change) error {
+	if s.batcher != nil {
+		return s.batcher.submit(ctx, s.service, s.credentialsKey, project, managedZone, change)
+	}
+	_, err := s.service.Changes.Create(project, managedZone, change).Context(ctx).Do()
 	return err
 }
 
@@ -116,6 +258,12 @@ func (s *dnsClient) getResourceRecordSet(ctx context.Context, project, managedZo
 	return nil, nil
 }
 
+// canHoldRecords returns whether the records of the given managed zone are served, i.e. whether it is neither a
+// forwarding, a peering nor a service directory zone.
+func canHoldRecords(zone *googledns.ManagedZone) bool {
+	return zone.ForwardingConfig == nil && zone.PeeringConfig == nil && zone.ServiceDirectoryConfig == nil
+}
+
 func (s *dnsClient) zoneID(managedZone string) string {
 	return s.projectID + "/" + managedZone
 }
@@ -150,6 +298,77 @@ func formatRrdatas(recordType string, values []string) []string {
 	return rrdatas
 }
 
+// formatRoutingPolicyRrdatas formats the rrdatas of all items of the given routing policy.
+func formatRoutingPolicyRrdatas(recordType string, policy *RoutingPolicy) {
+	formatGeoItems := func(geo *googledns.RRSetRoutingPolicyGeoPolicy) {
+		if geo == nil {
+			return
+		}
+		for _, item := range geo.Items {
+			item.Rrdatas = formatRrdatas(recordType, item.Rrdatas)
+		}
+	}
+
+	if policy.Wrr != nil {
+		for _, item := range policy.Wrr.Items {
+			item.Rrdatas = formatRrdatas(recordType, item.Rrdatas)
+		}
+	}
+	formatGeoItems(policy.Geo)
+	if policy.PrimaryBackup != nil {
+		formatGeoItems(policy.PrimaryBackup.BackupGeoTargets)
+	}
+}
+
+// isSameRoutingPolicy returns whether the given routing policies are equal, ignoring the kinds of their objects which
+// are only set by Cloud DNS and zero values which are omitted by Cloud DNS.
+func isSameRoutingPolicy(current, desired *RoutingPolicy) (bool, error) {
+	if current == nil || desired == nil {
+		return current == desired, nil
+	}
+
+	normalize := func(policy *RoutingPolicy) (any, error) {
+		data, err := json.Marshal(policy)
+		if err != nil {
+			return nil, err
+		}
+		var result any
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		return normalizeRoutingPolicy(result), nil
+	}
+
+	c, err := normalize(current)
+	if err != nil {
+		return false, err
+	}
+	d, err := normalize(desired)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(c, d), nil
+}
+
+func normalizeRoutingPolicy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		delete(v, "kind")
+		for key, item := range v {
+			if number, ok := item.(float64); ok && number == 0 {
+				delete(v, key)
+				continue
+			}
+			v[key] = normalizeRoutingPolicy(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeRoutingPolicy(item)
+		}
+	}
+	return value
+}
+
 func ensureTrailingDot(host string) string {
 	if strings.HasSuffix(host, ".") {
 		return host
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	googledns "google.golang.org/api/dns/v1"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// DefaultDNSChangeBatchWindow is the default duration for which changes of the same managed zone are collected
+	// before they are sent in a single Change request.
+	DefaultDNSChangeBatchWindow = 200 * time.Millisecond
+	// DefaultDNSChangeQPS is the default number of Change requests per second and managed zone.
+	DefaultDNSChangeQPS = 2
+	// DefaultDNSChangeBurst is the default number of Change requests per managed zone which are sent without delay.
+	DefaultDNSChangeBurst = 5
+
+	// maxRecordSetsPerChange is the maximum number of resource recordsets which are added or deleted by a batched
+	// Change request, which stays well below the limits of Cloud DNS.
+	maxRecordSetsPerChange = 500
+	// dnsChangeTimeout is the timeout of batched Change requests, which are not bound to the context of a caller.
+	dnsChangeTimeout = time.Minute
+)
+
+// DNSChangeBatcher batches the changes of resource recordsets in the same managed zone, which are requested
+// concurrently by DNS clients, into single Change requests and limits the rate of the Change requests per managed zone.
+// It is shared by all DNS clients created with the WithDNSChangeBatcher option.
+type DNSChangeBatcher struct {
+	window time.Duration
+	qps    float32
+	burst  int
+	// limiterTTL is the duration after which an unused rate limiter is completely refilled, i.e. it is equivalent to a
+	// new one and is removed.
+	limiterTTL time.Duration
+	// createChange sends a Change request, it is replaced in tests.
+	createChange func(service *googledns.Service, project, managedZone string, change *googledns.Change) error
+
+	lock     sync.Mutex
+	batches  map[string]*dnsChangeBatch
+	limiters map[string]*dnsChangeLimiter
+}
+
+type dnsChangeLimiter struct {
+	flowcontrol.RateLimiter
+	// users is the number of batches which currently use the limiter.
+	users    int
+	lastUsed time.Time
+}
+
+type dnsChangeBatch struct {
+	pending []*pendingDNSChange
+}
+
+type pendingDNSChange struct {
+	// ctx is the context of the caller, the change is dropped if it is cancelled before the change is sent.
+	ctx    context.Context
+	change *googledns.Change
+	done   chan error
+}
+
+// NewDNSChangeBatcher returns a new DNSChangeBatcher which collects the changes of a managed zone for the given window
+// and sends at most qps Change requests per second and managed zone with the given burst.
+func NewDNSChangeBatcher(window time.Duration, qps float32, burst int) *DNSChangeBatcher {
+	var limiterTTL time.Duration
+	if qps > 0 {
+		limiterTTL = time.Duration(float64(burst) / float64(qps) * float64(time.Second))
+	}
+
+	return &DNSChangeBatcher{
+		window:       window,
+		qps:          qps,
+		burst:        burst,
+		limiterTTL:   limiterTTL,
+		createChange: createChange,
+		batches:      map[string]*dnsChangeBatch{},
+		limiters:     map[string]*dnsChangeLimiter{},
+	}
+}
+
+// WithDNSChangeBatcher batches the changes of resource recordsets of the DNS clients with the given batcher.
+func WithDNSChangeBatcher(batcher *DNSChangeBatcher) Option {
+	return func(o *Options) {
+		o.DNSChangeBatcher = batcher
+	}
+}
+
+// submit adds the given change of the given managed zone to the next batch and waits until it is applied. Only changes
+// of clients with the same credentials are batched, so that no change is applied with the permissions of another
+// client. If the given context is cancelled before the change is sent, the change is dropped.
+func (b *DNSChangeBatcher) submit(ctx context.Context, service *googledns.Service, credentialsKey, project, managedZone string, change *googledns.Change) error {
+	var (
+		key     = credentialsKey + "/" + project + "/" + managedZone
+		pending = &pendingDNSChange{ctx: ctx, change: change, done: make(chan error, 1)}
+	)
+
+	b.lock.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &dnsChangeBatch{}
+		b.batches[key] = batch
+		go b.run(service, key, project, managedZone, batch)
+	}
+	batch.pending = append(batch.pending, pending)
+	b.lock.Unlock()
+
+	select {
+	case err := <-pending.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run applies the pending changes of the given batch until no changes are pending anymore.
+func (b *DNSChangeBatcher) run(service *googledns.Service, key, project, managedZone string, batch *dnsChangeBatch) {
+	limiter := b.acquireLimiter(project + "/" + managedZone)
+	defer b.releaseLimiter(limiter)
+
+	time.Sleep(b.window)
+	for {
+		b.lock.Lock()
+		changes := batch.take()
+		if len(changes) == 0 {
+			delete(b.batches, key)
+			b.lock.Unlock()
+			return
+		}
+		b.lock.Unlock()
+
+		limiter.Accept()
+		// The callers of changes may have given up while waiting for the rate limiter.
+		if changes = slices.DeleteFunc(changes, (*pendingDNSChange).cancelled); len(changes) == 0 {
+			continue
+		}
+
+		err := b.createChange(service, project, managedZone, mergeChanges(changes))
+		// A single invalid change, e.g. the deletion of a recordset which was changed in the meantime, fails the whole
+		// batch, hence the changes are applied individually.
+		if err != nil && len(changes) > 1 && IsErrorCode(err, http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusPreconditionFailed) {
+			for _, pending := range changes {
+				limiter.Accept()
+				if pending.cancelled() {
+					continue
+				}
+				pending.done <- b.createChange(service, project, managedZone, pending.change)
+			}
+			continue
+		}
+		for _, pending := range changes {
+			pending.done <- err
+		}
+	}
+}
+
+// acquireLimiter returns the rate limiter of the given managed zone. Limiters which have not been used for longer than
+// their refill period are removed, so that only the limiters of recently changed managed zones are kept.
+func (b *DNSChangeBatcher) acquireLimiter(key string) *dnsChangeLimiter {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := time.Now()
+	for k, limiter := range b.limiters {
+		if limiter.users == 0 && now.Sub(limiter.lastUsed) > b.limiterTTL {
+			delete(b.limiters, k)
+		}
+	}
+
+	limiter, ok := b.limiters[key]
+	if !ok {
+		limiter = &dnsChangeLimiter{RateLimiter: flowcontrol.NewTokenBucketRateLimiter(b.qps, b.burst)}
+		b.limiters[key] = limiter
+	}
+	limiter.users++
+	return limiter
+}
+
+func (b *DNSChangeBatcher) releaseLimiter(limiter *dnsChangeLimiter) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	limiter.users--
+	limiter.lastUsed = time.Now()
+}
+
+func (p *pendingDNSChange) cancelled() bool {
+	return p.ctx.Err() != nil
+}
+
+// take removes the pending changes which can be merged into a single Change request from the batch and returns them.
+// Changes of callers which gave up are dropped. Changes of the same resource recordset are kept for the next batch, as
+// Cloud DNS rejects a Change request which adds or deletes a recordset twice. Changes are applied in the order in which
+// they were submitted per resource recordset, hence a change is also kept if it touches a recordset of a kept change.
+func (b *dnsChangeBatch) take() []*pendingDNSChange {
+	var (
+		taken, remaining []*pendingDNSChange
+		recordSets       = map[string]bool{}
+		kept             = map[string]bool{}
+	)
+
+	for _, pending := range b.pending {
+		if pending.cancelled() {
+			continue
+		}
+
+		keys := recordSetKeys(pending.change)
+		if (len(recordSets)+len(keys) > maxRecordSetsPerChange && len(taken) > 0) || containsAny(recordSets, keys) || containsAny(kept, keys) {
+			for _, key := range keys {
+				kept[key] = true
+			}
+			remaining = append(remaining, pending)
+			continue
+		}
+		for _, key := range keys {
+			recordSets[key] = true
+		}
+		taken = append(taken, pending)
+	}
+
+	b.pending = remaining
+	return taken
+}
+
+func recordSetKeys(change *googledns.Change) []string {
+	keys := map[string]bool{}
+	for _, rrs := range append(append([]*googledns.ResourceRecordSet{}, change.Additions...), change.Deletions...) {
+		keys[rrs.Name+" "+rrs.Type] = true
+	}
+
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+	return result
+}
+
+func containsAny(set map[string]bool, keys []string) bool {
+	for _, key := range keys {
+		if set[key] {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeChanges(changes []*pendingDNSChange) *googledns.Change {
+	merged := &googledns.Change{}
+	for _, pending := range changes {
+		merged.Additions = append(merged.Additions, pending.change.Additions...)
+		merged.Deletions = append(merged.Deletions, pending.change.Deletions...)
+	}
+	return merged
+}
+
+func createChange(service *googledns.Service, project, managedZone string, change *googledns.Change) error {
+	ctx, cancel := context.WithTimeout(context.Background(), dnsChangeTimeout)
+	defer cancel()
+
+	_, err := service.Changes.Create(project, managedZone, change).Context(ctx).Do()
+	return err
+}
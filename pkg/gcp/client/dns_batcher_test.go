@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	googledns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/googleapi"
+)
+
+var _ = Describe("DNSChangeBatcher", func() {
+	const (
+		project     = "project"
+		managedZone = "zone"
+		window      = 200 * time.Millisecond
+	)
+
+	var (
+		ctx     context.Context
+		batcher *DNSChangeBatcher
+
+		lock     sync.Mutex
+		requests []*googledns.Change
+		// failRequest is called for every request and returns the error of the request.
+		failRequest func(change *googledns.Change) error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		requests = nil
+		failRequest = func(*googledns.Change) error { return nil }
+
+		batcher = NewDNSChangeBatcher(window, 1000, 10)
+		batcher.createChange = func(_ *googledns.Service, _, _ string, change *googledns.Change) error {
+			lock.Lock()
+			defer lock.Unlock()
+			requests = append(requests, change)
+			return failRequest(change)
+		}
+	})
+
+	recordedRequests := func() []*googledns.Change {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]*googledns.Change{}, requests...)
+	}
+
+	pendingChanges := func(key string) int {
+		batcher.lock.Lock()
+		defer batcher.lock.Unlock()
+		if batch, ok := batcher.batches[key]; ok {
+			return len(batch.pending)
+		}
+		return 0
+	}
+
+	// submit submits the given change asynchronously and waits until it is queued, so that the order of the changes is
+	// deterministic.
+	submit := func(ctx context.Context, credentialsKey string, change *googledns.Change) <-chan error {
+		var (
+			key    = credentialsKey + "/" + project + "/" + managedZone
+			before = pendingChanges(key)
+			result = make(chan error, 1)
+		)
+
+		go func() {
+			defer GinkgoRecover()
+			result <- batcher.submit(ctx, nil, credentialsKey, project, managedZone, change)
+		}()
+		Eventually(func() int { return pendingChanges(key) }).Should(Equal(before + 1))
+		return result
+	}
+
+	addition := func(name string) *googledns.Change {
+		return &googledns.Change{Additions: []*googledns.ResourceRecordSet{{Name: name, Type: "A"}}}
+	}
+
+	names := func(change *googledns.Change) []string {
+		var result []string
+		for _, rrs := range append(append([]*googledns.ResourceRecordSet{}, change.Additions...), change.Deletions...) {
+			result = append(result, rrs.Name)
+		}
+		return result
+	}
+
+	It("should merge concurrent changes of the same managed zone into a single request", func() {
+		results := []<-chan error{
+			submit(ctx, "creds", addition("a.")),
+			submit(ctx, "creds", addition("b.")),
+			submit(ctx, "creds", addition("c.")),
+		}
+
+		for _, result := range results {
+			Eventually(result).Should(Receive(BeNil()))
+		}
+		Expect(recordedRequests()).To(HaveLen(1))
+		Expect(names(recordedRequests()[0])).To(Equal([]string{"a.", "b.", "c."}))
+	})
+
+	It("should not merge changes of clients with different credentials", func() {
+		result1 := submit(ctx, "creds1", addition("a."))
+		result2 := submit(ctx, "creds2", addition("b."))
+
+		Eventually(result1).Should(Receive(BeNil()))
+		Eventually(result2).Should(Receive(BeNil()))
+		Expect(recordedRequests()).To(HaveLen(2))
+	})
+
+	It("should apply changes of the same recordset in consecutive requests in the order of submission", func() {
+		deletion := &googledns.Change{Deletions: []*googledns.ResourceRecordSet{{Name: "a.", Type: "A"}}}
+		both := &googledns.Change{Additions: []*googledns.ResourceRecordSet{{Name: "a.", Type: "A"}, {Name: "b.", Type: "A"}}}
+
+		results := []<-chan error{
+			submit(ctx, "creds", addition("a.")),
+			submit(ctx, "creds", deletion),
+			submit(ctx, "creds", both),
+			submit(ctx, "creds", addition("b.")),
+			submit(ctx, "creds", addition("c.")),
+		}
+
+		for _, result := range results {
+			Eventually(result).Should(Receive(BeNil()))
+		}
+		requests := recordedRequests()
+		Expect(requests).To(HaveLen(4))
+		Expect(names(requests[0])).To(Equal([]string{"a.", "c."}))
+		Expect(names(requests[1])).To(Equal([]string{"a."}))
+		Expect(requests[1].Deletions).To(HaveLen(1))
+		Expect(names(requests[2])).To(Equal([]string{"a.", "b."}))
+		Expect(names(requests[3])).To(Equal([]string{"b."}))
+	})
+
+	It("should split the batch into individual requests if it is rejected", func() {
+		failRequest = func(change *googledns.Change) error {
+			if len(change.Additions) > 1 || change.Additions[0].Name == "b." {
+				return &googleapi.Error{Code: http.StatusConflict}
+			}
+			return nil
+		}
+
+		result1 := submit(ctx, "creds", addition("a."))
+		result2 := submit(ctx, "creds", addition("b."))
+		result3 := submit(ctx, "creds", addition("c."))
+
+		Eventually(result1).Should(Receive(BeNil()))
+		Eventually(result2).Should(Receive(MatchError(ContainSubstring("409"))))
+		Eventually(result3).Should(Receive(BeNil()))
+		Expect(recordedRequests()).To(HaveLen(4))
+	})
+
+	It("should return other errors of a batch to all callers", func() {
+		failRequest = func(*googledns.Change) error { return &googleapi.Error{Code: http.StatusInternalServerError} }
+
+		result1 := submit(ctx, "creds", addition("a."))
+		result2 := submit(ctx, "creds", addition("b."))
+
+		Eventually(result1).Should(Receive(MatchError(ContainSubstring("500"))))
+		Eventually(result2).Should(Receive(MatchError(ContainSubstring("500"))))
+		Expect(recordedRequests()).To(HaveLen(1))
+	})
+
+	It("should drop changes of callers which gave up", func() {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		result1 := submit(cancelCtx, "creds", addition("a."))
+		result2 := submit(ctx, "creds", addition("b."))
+		cancel()
+
+		Eventually(result1).Should(Receive(MatchError(context.Canceled)))
+		Eventually(result2).Should(Receive(BeNil()))
+		Expect(recordedRequests()).To(HaveLen(1))
+		Expect(names(recordedRequests()[0])).To(Equal([]string{"b."}))
+	})
+
+	It("should not send a request if all callers gave up", func() {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		result := submit(cancelCtx, "creds", addition("a."))
+		cancel()
+
+		Eventually(result).Should(Receive(MatchError(context.Canceled)))
+		Eventually(func() int { return pendingChanges("creds/" + project + "/" + managedZone) }).Should(BeZero())
+		Consistently(recordedRequests, 2*window).Should(BeEmpty())
+	})
+
+	It("should remove unused rate limiters", func() {
+		Eventually(submit(ctx, "creds", addition("a."))).Should(Receive(BeNil()))
+		Eventually(func() int {
+			batcher.lock.Lock()
+			defer batcher.lock.Unlock()
+			if limiter, ok := batcher.limiters[project+"/"+managedZone]; ok {
+				return limiter.users
+			}
+			return -1
+		}).Should(BeZero())
+
+		time.Sleep(2 * batcher.limiterTTL)
+		limiter := batcher.acquireLimiter("other/zone")
+		defer batcher.releaseLimiter(limiter)
+
+		batcher.lock.Lock()
+		defer batcher.lock.Unlock()
+		Expect(batcher.limiters).To(HaveLen(1))
+		Expect(batcher.limiters).To(HaveKey("other/zone"))
+	})
+})
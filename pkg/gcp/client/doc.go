@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package client contains the clients of the GCP APIs used by the extension. The clients authenticate with the service
+// account of the cloud provider secret and wait for the completion of asynchronous operations. Other components can
+// reuse them via the Factory, which can be configured with endpoint overrides, a user agent and a wrapper of the HTTP
+// transport, e.g. to record metrics.
+package client
@@ -27,22 +27,28 @@ type Factory interface {
 	Compute(context.Context, client.Client, corev1.SecretReference) (ComputeClient, error)
 	// IAM returns a GCP compute client.
 	IAM(context.Context, client.Client, corev1.SecretReference) (IAMClient, error)
+	// NetworkConnectivity returns a GCP Network Connectivity Center client.
+	NetworkConnectivity(context.Context, client.Client, corev1.SecretReference) (NetworkConnectivityClient, error)
+	// Logging returns a GCP Cloud Logging client.
+	Logging(context.Context, client.Client, corev1.SecretReference) (LoggingClient, error)
 }
 
-type factory struct{}
+type factory struct {
+	opts []Option
+}
 
-// New returns a new instance of Factory.
-func New() Factory {
-	return &factory{}
+// New returns a new instance of Factory. The given options are applied to all clients created by the factory.
+func New(opts ...Option) Factory {
+	return &factory{opts: opts}
 }
 
 // DNS returns a GCP cloud DNS service client.
 func (f factory) DNS(ctx context.Context, c client.Client, sr corev1.SecretReference) (DNSClient, error) {
-	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, c, sr)
+	serviceAccount, err := gcp.GetDNSServiceAccountFromSecretReference(ctx, c, sr)
 	if err != nil {
 		return nil, err
 	}
-	return NewDNSClient(ctx, serviceAccount)
+	return NewDNSClient(ctx, serviceAccount, f.opts...)
 }
 
 // Storage reads the secret from the passed reference and returns a GCP (blob) storage client.
@@ -51,7 +57,7 @@ func (f factory) Storage(ctx context.Context, c client.Client, sr corev1.SecretR
 	if err != nil {
 		return nil, err
 	}
-	return NewStorageClient(ctx, serviceAccount)
+	return NewStorageClient(ctx, serviceAccount, f.opts...)
 }
 
 // Compute reads the secret from the passed reference and returns a GCP compute client.
@@ -60,7 +66,7 @@ func (f factory) Compute(ctx context.Context, c client.Client, sr corev1.SecretR
 	if err != nil {
 		return nil, err
 	}
-	return NewComputeClient(ctx, serviceAccount)
+	return NewComputeClient(ctx, serviceAccount, f.opts...)
 }
 
 // IAM reads the secret from the passed reference and returns a GCP compute client.
@@ -69,5 +75,23 @@ func (f factory) IAM(ctx context.Context, c client.Client, sr corev1.SecretRefer
 	if err != nil {
 		return nil, err
 	}
-	return NewIAMClient(ctx, serviceAccount)
+	return NewIAMClient(ctx, serviceAccount, f.opts...)
+}
+
+// NetworkConnectivity reads the secret from the passed reference and returns a GCP Network Connectivity Center client.
+func (f factory) NetworkConnectivity(ctx context.Context, c client.Client, sr corev1.SecretReference) (NetworkConnectivityClient, error) {
+	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, c, sr)
+	if err != nil {
+		return nil, err
+	}
+	return NewNetworkConnectivityClient(ctx, serviceAccount, f.opts...)
+}
+
+// Logging reads the secret from the passed reference and returns a GCP Cloud Logging client.
+func (f factory) Logging(ctx context.Context, c client.Client, sr corev1.SecretReference) (LoggingClient, error) {
+	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, c, sr)
+	if err != nil {
+		return nil, err
+	}
+	return NewLoggingClient(ctx, serviceAccount, f.opts...)
 }
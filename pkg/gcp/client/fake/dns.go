@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RecordSets returns copies of the resource recordsets of the managed zone with the given path, e.g.
+// projects/foo/managedZones/bar, including the SOA and NS recordsets of the zone apex.
+func (s *Server) RecordSets(zone string) []map[string]any {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var recordSets []map[string]any
+	for _, recordSet := range s.recordSets[zone] {
+		recordSets = append(recordSets, copyResource(recordSet))
+	}
+	return recordSets
+}
+
+func (s *Server) handleDNS(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Managed zones are stored with the path projects/<project>/managedZones/<name>.
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, dnsPrefix+"dns/v1/"), "/")
+	segments := strings.Split(path, "/")
+
+	switch {
+	case len(segments) == 3 && segments[2] == "managedZones":
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]any{"managedZones": s.list(path)})
+		case http.MethodPost:
+			s.createManagedZone(w, r, path)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+
+	case len(segments) == 4 && segments[2] == "managedZones":
+		zone, ok := s.resources[path]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The 'parameters.managedZone' resource named '%s' does not exist.", segments[3]))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, zone)
+		case http.MethodPatch:
+			patch, err := readResource(r)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			for key, value := range patch {
+				if key != "name" && key != "dnsName" && key != "id" {
+					zone[key] = value
+				}
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"kind": "dns#operation", "type": "UPDATE", "status": "DONE"})
+		case http.MethodDelete:
+			if slices.ContainsFunc(s.recordSets[path], func(recordSet map[string]any) bool {
+				return recordSet["type"] != "SOA" && recordSet["type"] != "NS"
+			}) {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("The resource named '%s' cannot be deleted because it is not empty", segments[3]))
+				return
+			}
+			delete(s.resources, path)
+			delete(s.recordSets, path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+
+	case len(segments) == 5 && segments[2] == "managedZones" && segments[4] == "rrsets" && r.Method == http.MethodGet:
+		zone := strings.Join(segments[:4], "/")
+		if _, ok := s.resources[zone]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The 'parameters.managedZone' resource named '%s' does not exist.", segments[3]))
+			return
+		}
+		var (
+			name       = r.URL.Query().Get("name")
+			recordType = r.URL.Query().Get("type")
+			items      = []map[string]any{}
+		)
+		for _, recordSet := range s.recordSets[zone] {
+			if (name == "" || recordSet["name"] == name) && (recordType == "" || recordSet["type"] == recordType) {
+				items = append(items, recordSet)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"kind": "dns#resourceRecordSetsListResponse", "rrsets": items})
+
+	case len(segments) == 5 && segments[2] == "managedZones" && segments[4] == "changes" && r.Method == http.MethodPost:
+		s.createChange(w, r, strings.Join(segments[:4], "/"))
+
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' is not supported by the fake server", path))
+	}
+}
+
+// createManagedZone creates the given managed zone including the SOA and NS recordsets of its apex like the API.
+func (s *Server) createManagedZone(w http.ResponseWriter, r *http.Request, collection string) {
+	zone, err := readResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, _ := zone["name"].(string)
+	dnsName, _ := zone["dnsName"].(string)
+	if name == "" || !strings.HasSuffix(dnsName, ".") {
+		writeError(w, http.StatusBadRequest, "name and a fully qualified dnsName are required")
+		return
+	}
+
+	path := collection + "/" + name
+	if _, ok := s.resources[path]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("The resource 'entity.managedZone' named '%s' already exists", name))
+		return
+	}
+
+	s.ids++
+	zone["kind"] = "dns#managedZone"
+	zone["id"] = fmt.Sprint(s.ids)
+	zone["creationTime"] = time.Now().UTC().Format(time.RFC3339)
+	nameServers := []any{"ns-cloud-a1.googledomains.com.", "ns-cloud-a2.googledomains.com."}
+	if zone["visibility"] == "private" {
+		nameServers = []any{"ns-gcp-private.googledomains.com."}
+	}
+	zone["nameServers"] = nameServers
+
+	s.resources[path] = zone
+	s.recordSets[path] = []map[string]any{
+		{"kind": "dns#resourceRecordSet", "name": dnsName, "type": "NS", "ttl": 21600, "rrdatas": nameServers},
+		{"kind": "dns#resourceRecordSet", "name": dnsName, "type": "SOA", "ttl": 21600, "rrdatas": []any{fmt.Sprintf("%s cloud-dns-hostmaster.google.com. 1 21600 3600 259200 300", nameServers[0])}},
+	}
+	writeJSON(w, http.StatusOK, zone)
+}
+
+// createChange applies the deletions and additions of the given change atomically. Like the API, deleted recordsets
+// have to exist and added ones must not exist after the deletions.
+func (s *Server) createChange(w http.ResponseWriter, r *http.Request, zone string) {
+	if _, ok := s.resources[zone]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The 'parameters.managedZone' resource named '%s' does not exist.", zone[strings.LastIndex(zone, "/")+1:]))
+		return
+	}
+
+	change, err := readResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	recordSets := slices.Clone(s.recordSets[zone])
+	indexOf := func(recordSet map[string]any) int {
+		return slices.IndexFunc(recordSets, func(rs map[string]any) bool {
+			return rs["name"] == recordSet["name"] && rs["type"] == recordSet["type"]
+		})
+	}
+
+	deletions, _ := change["deletions"].([]any)
+	for _, deletion := range deletions {
+		recordSet, _ := deletion.(map[string]any)
+		index := indexOf(recordSet)
+		if index < 0 {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The 'entity.change.deletions[%s]' resource named '%s (%s)' does not exist.", recordSet["name"], recordSet["name"], recordSet["type"]))
+			return
+		}
+		recordSets = slices.Delete(recordSets, index, index+1)
+	}
+
+	additions, _ := change["additions"].([]any)
+	for _, addition := range additions {
+		recordSet, _ := addition.(map[string]any)
+		if indexOf(recordSet) >= 0 {
+			writeError(w, http.StatusConflict, fmt.Sprintf("The resource 'entity.change.additions[%s]' named '%s (%s)' already exists", recordSet["name"], recordSet["name"], recordSet["type"]))
+			return
+		}
+		recordSet["kind"] = "dns#resourceRecordSet"
+		recordSets = append(recordSets, recordSet)
+	}
+
+	s.recordSets[zone] = recordSets
+	s.ids++
+	change["kind"] = "dns#change"
+	change["id"] = fmt.Sprint(s.ids)
+	change["status"] = "done"
+	writeJSON(w, http.StatusOK, change)
+}
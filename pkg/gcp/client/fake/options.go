@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+)
+
+// ClientOptions returns the options which point the clients of the extension to the server.
+func (s *Server) ClientOptions() []gcpclient.Option {
+	return []gcpclient.Option{
+		gcpclient.WithEndpoint(gcpclient.ServiceCompute, s.ComputeEndpoint()),
+		gcpclient.WithEndpoint(gcpclient.ServiceDNS, s.DNSEndpoint()),
+		gcpclient.WithEndpoint(gcpclient.ServiceIAM, s.IAMEndpoint()),
+		gcpclient.WithEndpoint(gcpclient.ServiceResourceManager, s.ResourceManagerEndpoint()),
+		gcpclient.WithEndpoint(gcpclient.ServiceStorage, s.StorageEndpoint()),
+	}
+}
@@ -0,0 +1,709 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake contains a fake server of the GCP APIs, so that the controllers of the extension can be tested without a
+// GCP project.
+package fake
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	computePrefix         = "/compute/v1/"
+	dnsPrefix             = "/dns/"
+	iamPrefix             = "/iam/"
+	resourceManagerPrefix = "/cloudresourcemanager/"
+	storagePrefix         = "/storage/v1/"
+	tokenPath             = "/token"
+)
+
+// computeCollections are the collections of the Compute API which are served by the fake server.
+var computeCollections = []string{"networks", "subnetworks", "routers", "addresses", "firewalls", "routes", "forwardingRules", "instances", "disks"}
+
+// Server is a fake server of the GCP APIs used by the infrastructure reconciliation, i.e. the Compute, Cloud DNS, IAM
+// and Cloud Resource Manager APIs, and of the objects of the Cloud Storage API. It keeps all resources in memory and
+// completes all operations immediately. The clients of the extension can be pointed to the server with the options
+// returned by ClientOptions, and authenticate with the service account returned by ServiceAccountJSON.
+type Server struct {
+	server     *httptest.Server
+	privateKey []byte
+
+	lock       sync.Mutex
+	resources  map[string]map[string]any
+	policies   map[string]map[string]any
+	objects    map[string][]map[string]any
+	recordSets map[string][]map[string]any
+	failures   map[string]int
+	ids        int
+	operations int
+	addresses  int
+}
+
+// NewServer starts a new fake server. It has to be closed by the caller.
+func NewServer() (*Server, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		privateKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}),
+		resources:  map[string]map[string]any{},
+		policies:   map[string]map[string]any{},
+		objects:    map[string][]map[string]any{},
+		recordSets: map[string][]map[string]any{},
+		failures:   map[string]int{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(tokenPath, s.handleToken)
+	mux.HandleFunc(computePrefix, s.handleCompute)
+	mux.HandleFunc(dnsPrefix, s.handleDNS)
+	mux.HandleFunc(iamPrefix, s.handleIAM)
+	mux.HandleFunc(resourceManagerPrefix, s.handleResourceManager)
+	mux.HandleFunc(storagePrefix, s.handleStorage)
+	s.server = httptest.NewServer(s.injectFailures(mux))
+
+	return s, nil
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// ComputeEndpoint returns the endpoint of the fake Compute API.
+func (s *Server) ComputeEndpoint() string {
+	return s.server.URL + computePrefix
+}
+
+// DNSEndpoint returns the endpoint of the fake Cloud DNS API.
+func (s *Server) DNSEndpoint() string {
+	return s.server.URL + dnsPrefix
+}
+
+// IAMEndpoint returns the endpoint of the fake IAM API.
+func (s *Server) IAMEndpoint() string {
+	return s.server.URL + iamPrefix
+}
+
+// ResourceManagerEndpoint returns the endpoint of the fake Cloud Resource Manager API.
+func (s *Server) ResourceManagerEndpoint() string {
+	return s.server.URL + resourceManagerPrefix
+}
+
+// StorageEndpoint returns the endpoint of the fake Cloud Storage API.
+func (s *Server) StorageEndpoint() string {
+	return s.server.URL + storagePrefix
+}
+
+// ServiceAccountJSON returns the JSON of a service account of the given project which authenticates against the server.
+func (s *Server) ServiceAccountJSON(projectID string) []byte {
+	data, _ := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     projectID,
+		"private_key_id": "fake",
+		"private_key":    string(s.privateKey),
+		"client_email":   fmt.Sprintf("fake@%s.iam.gserviceaccount.com", projectID),
+		"client_id":      "fake",
+		"token_uri":      s.server.URL + tokenPath,
+	})
+	return data
+}
+
+// Get returns a copy of the Compute resource or the managed zone with the given path, e.g.
+// projects/foo/global/networks/bar or projects/foo/managedZones/bar.
+func (s *Server) Get(path string) (map[string]any, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource, ok := s.resources[path]
+	if !ok {
+		return nil, false
+	}
+	return copyResource(resource), true
+}
+
+// List returns copies of the Compute resources of the given collection, e.g. projects/foo/global/networks.
+func (s *Server) List(collection string) []map[string]any {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.list(collection)
+}
+
+// Put stores the given Compute resource with the given path, e.g. to prepare resources which are expected to exist.
+func (s *Server) Put(path string, resource map[string]any) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource = copyResource(resource)
+	resource["name"] = path[strings.LastIndex(path, "/")+1:]
+	resource["selfLink"] = s.ComputeEndpoint() + path
+	s.resources[path] = resource
+}
+
+// Delete removes the Compute resource with the given path, e.g. to simulate resources which were deleted manually.
+func (s *Server) Delete(path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.resources, path)
+}
+
+// Policy returns a copy of the IAM policy of the given resource, e.g. projects/foo.
+func (s *Server) Policy(resource string) map[string]any {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.policy(resource, 3)
+}
+
+// PutPolicy stores the given IAM policy of the given resource, e.g. projects/foo.
+func (s *Server) PutPolicy(resource string, policy map[string]any) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	policy = copyResource(policy)
+	policy["etag"] = "BwY="
+	s.policies[resource] = policy
+}
+
+// Fail lets all following requests with the given method and path, e.g. DELETE /storage/v1/b/foo/o/bar, fail with the
+// given HTTP status code.
+func (s *Server) Fail(method, path string, code int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.failures[method+" "+path] = code
+}
+
+// Recover lets the requests with the given method and path, which were failed with Fail, succeed again.
+func (s *Server) Recover(method, path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.failures, method+" "+path)
+}
+
+func (s *Server) injectFailures(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		code, ok := s.failures[r.Method+" "+r.URL.Path]
+		s.lock.Unlock()
+
+		if ok {
+			writeError(w, code, fmt.Sprintf("The request %s %s failed", r.Method, r.URL.Path))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) list(collection string) []map[string]any {
+	var items []map[string]any
+	for path, resource := range s.resources {
+		if strings.HasPrefix(path, collection+"/") && !strings.Contains(strings.TrimPrefix(path, collection+"/"), "/") {
+			items = append(items, copyResource(resource))
+		}
+	}
+	slices.SortFunc(items, func(a, b map[string]any) int {
+		return strings.Compare(fmt.Sprint(a["name"]), fmt.Sprint(b["name"]))
+	})
+	return items
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": "fake",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (s *Server) handleCompute(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, computePrefix), "/")
+	segments := strings.Split(path, "/")
+	last := segments[len(segments)-1]
+
+	switch {
+	case len(segments) >= 2 && segments[len(segments)-2] == "operations":
+		writeJSON(w, http.StatusOK, map[string]any{"name": last, "status": "DONE"})
+
+	case len(segments) == 4 && segments[2] == "regions" && r.Method == http.MethodGet:
+		// Regions without zones and quotas are returned unless they were stored with Put.
+		if region, ok := s.resources[path]; ok {
+			writeJSON(w, http.StatusOK, region)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"name": last, "quotas": []any{}})
+
+	case len(segments) == 4 && segments[2] == "aggregated" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]any{"items": s.aggregatedList(segments[0]+"/"+segments[1], last)})
+
+	case last == "expandIpCidrRange" && r.Method == http.MethodPost:
+		s.expandIPCIDRRange(w, r, strings.TrimSuffix(path, "/"+last))
+
+	case last == "setPrivateIpGoogleAccess" && r.Method == http.MethodPost:
+		s.setPrivateIPGoogleAccess(w, r, strings.TrimSuffix(path, "/"+last))
+
+	case last == "setLabels" && r.Method == http.MethodPost:
+		s.setLabels(w, r, strings.TrimSuffix(path, "/"+last))
+
+	case slices.Contains(computeCollections, last):
+		switch r.Method {
+		case http.MethodGet:
+			items, err := filterResources(s.list(path), r.URL.Query().Get("filter"))
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			writeJSON(w, http.StatusOK, map[string]any{"items": items})
+		case http.MethodPost:
+			s.insert(w, r, path)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+
+	case len(segments) >= 2 && slices.Contains(computeCollections, segments[len(segments)-2]):
+		switch r.Method {
+		case http.MethodGet:
+			resource, ok := s.resources[path]
+			if !ok {
+				writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' was not found", path))
+				return
+			}
+			writeJSON(w, http.StatusOK, resource)
+		case http.MethodPatch:
+			s.patch(w, r, path)
+		case http.MethodDelete:
+			if _, ok := s.resources[path]; !ok {
+				writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' was not found", path))
+				return
+			}
+			delete(s.resources, path)
+			writeJSON(w, http.StatusOK, s.operation(path))
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' is not supported by the fake server", path))
+	}
+}
+
+// aggregatedList returns the resources of the given collection of all zones of the given project, grouped by zone.
+func (s *Server) aggregatedList(project, collection string) map[string]any {
+	items := map[string]any{}
+	for path := range s.resources {
+		segments := strings.Split(strings.TrimPrefix(path, project+"/"), "/")
+		if len(segments) != 4 || segments[0] != "zones" || segments[2] != collection {
+			continue
+		}
+		scope := "zones/" + segments[1]
+		if _, ok := items[scope]; !ok {
+			items[scope] = map[string]any{collection: s.list(project + "/" + scope + "/" + collection)}
+		}
+	}
+	return items
+}
+
+func (s *Server) insert(w http.ResponseWriter, r *http.Request, collection string) {
+	resource, err := readResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, _ := resource["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	path := collection + "/" + name
+	if _, ok := s.resources[path]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("The resource '%s' already exists", path))
+		return
+	}
+
+	resource["selfLink"] = s.ComputeEndpoint() + path
+	s.ids++
+	resource["id"] = fmt.Sprint(s.ids)
+	resource["creationTimestamp"] = time.Now().UTC().Format(time.RFC3339)
+	if strings.HasSuffix(collection, "/addresses") {
+		if _, ok := resource["address"]; !ok {
+			s.addresses++
+			resource["address"] = fmt.Sprintf("203.0.%d.%d", 113+s.addresses/256, s.addresses%256)
+		}
+	}
+
+	s.resources[path] = resource
+	writeJSON(w, http.StatusOK, s.operation(path))
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, path string) {
+	resource, ok := s.resources[path]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' was not found", path))
+		return
+	}
+
+	patch, err := readResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for key, value := range patch {
+		if key == "name" || key == "selfLink" || key == "id" {
+			continue
+		}
+		if value == nil {
+			delete(resource, key)
+			continue
+		}
+		resource[key] = value
+	}
+	writeJSON(w, http.StatusOK, s.operation(path))
+}
+
+func (s *Server) expandIPCIDRRange(w http.ResponseWriter, r *http.Request, path string) {
+	resource, ok := s.resources[path]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' was not found", path))
+		return
+	}
+
+	request, err := readResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	resource["ipCidrRange"] = request["ipCidrRange"]
+	writeJSON(w, http.StatusOK, s.operation(path))
+}
+
+func (s *Server) setPrivateIPGoogleAccess(w http.ResponseWriter, r *http.Request, path string) {
+	resource, ok := s.resources[path]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' was not found", path))
+		return
+	}
+
+	request, err := readResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	resource["privateIpGoogleAccess"] = request["privateIpGoogleAccess"] == true
+	writeJSON(w, http.StatusOK, s.operation(path))
+}
+
+func (s *Server) setLabels(w http.ResponseWriter, r *http.Request, path string) {
+	resource, ok := s.resources[path]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' was not found", path))
+		return
+	}
+
+	request, err := readResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if fingerprint, _ := resource["labelFingerprint"].(string); fingerprint != "" && request["labelFingerprint"] != fingerprint {
+		writeError(w, http.StatusPreconditionFailed, fmt.Sprintf("Labels fingerprint either invalid or resource labels have changed for '%s'", path))
+		return
+	}
+
+	s.ids++
+	resource["labels"] = request["labels"]
+	resource["labelFingerprint"] = fmt.Sprintf("fp%d", s.ids)
+	writeJSON(w, http.StatusOK, s.operation(path))
+}
+
+// operation returns a completed operation of the given resource. The operation is regional or zonal if the resource is.
+func (s *Server) operation(path string) map[string]any {
+	s.operations++
+	operation := map[string]any{
+		"name":       fmt.Sprintf("operation-%d", s.operations),
+		"status":     "DONE",
+		"targetLink": s.ComputeEndpoint() + path,
+	}
+
+	segments := strings.Split(path, "/")
+	if len(segments) >= 4 && segments[2] == "regions" {
+		operation["region"] = s.ComputeEndpoint() + strings.Join(segments[:4], "/")
+	}
+	if len(segments) >= 4 && segments[2] == "zones" {
+		operation["zone"] = s.ComputeEndpoint() + strings.Join(segments[:4], "/")
+	}
+	return operation
+}
+
+func (s *Server) handleIAM(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Service accounts are stored with the path projects/<project>/serviceAccounts/<email>.
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, iamPrefix+"v1/"), "/")
+	segments := strings.Split(path, "/")
+
+	switch {
+	case len(segments) == 3 && segments[2] == "serviceAccounts" && r.Method == http.MethodPost:
+		request, err := readResource(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		project := segments[1]
+		email := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", request["accountId"], project)
+		accountPath := path + "/" + email
+		if _, ok := s.resources[accountPath]; ok {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Service account %s already exists", email))
+			return
+		}
+
+		account := map[string]any{"name": accountPath, "email": email, "projectId": project}
+		if serviceAccount, ok := request["serviceAccount"].(map[string]any); ok {
+			account["displayName"] = serviceAccount["displayName"]
+		}
+		s.resources[accountPath] = account
+		writeJSON(w, http.StatusOK, account)
+
+	case len(segments) == 4 && segments[2] == "serviceAccounts":
+		account, ok := s.resources[path]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Service account %s not found", segments[3]))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, account)
+		case http.MethodDelete:
+			delete(s.resources, path)
+			writeJSON(w, http.StatusOK, map[string]any{})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' is not supported by the fake server", path))
+	}
+}
+
+func (s *Server) handleResourceManager(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, resourceManagerPrefix+"v1/")
+	resource, method, _ := strings.Cut(path, ":")
+
+	switch method {
+	case "getIamPolicy":
+		request, err := readResource(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, s.policy(resource, requestedPolicyVersion(request)))
+
+	case "setIamPolicy":
+		request, err := readResource(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		policy, ok := request["policy"].(map[string]any)
+		if !ok {
+			writeError(w, http.StatusBadRequest, "policy is required")
+			return
+		}
+		current := s.policy(resource, 3)
+		if etag, ok := policy["etag"]; ok && etag != current["etag"] {
+			writeError(w, http.StatusConflict, "There were concurrent policy changes.")
+			return
+		}
+		if version, _ := policy["version"].(float64); version < 3 && (hasConditionalBindings(policy) || hasConditionalBindings(current)) {
+			writeError(w, http.StatusBadRequest, "Policies with conditional role bindings require version 3.")
+			return
+		}
+
+		s.ids++
+		policy["etag"] = fmt.Sprintf("BwY%d", s.ids)
+		s.policies[resource] = policy
+		writeJSON(w, http.StatusOK, policy)
+
+	case "getEffectiveOrgPolicy":
+		writeJSON(w, http.StatusOK, map[string]any{})
+
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The method '%s' is not supported by the fake server", path))
+	}
+}
+
+// policy returns a copy of the IAM policy of the given resource. Like the API, conditional role bindings are only
+// returned as such if version 3 is requested.
+func (s *Server) policy(resource string, version int) map[string]any {
+	policy, ok := s.policies[resource]
+	if !ok {
+		return map[string]any{"version": 1, "bindings": []any{}, "etag": "BwY="}
+	}
+
+	policy = copyResource(policy)
+	if version >= 3 {
+		return policy
+	}
+
+	policy["version"] = 1
+	bindings, _ := policy["bindings"].([]any)
+	for _, binding := range bindings {
+		if b, ok := binding.(map[string]any); ok && b["condition"] != nil {
+			delete(b, "condition")
+			b["role"] = fmt.Sprint(b["role"]) + "_withcond_"
+		}
+	}
+	return policy
+}
+
+func requestedPolicyVersion(request map[string]any) int {
+	options, _ := request["options"].(map[string]any)
+	version, _ := options["requestedPolicyVersion"].(float64)
+	return int(version)
+}
+
+func hasConditionalBindings(policy map[string]any) bool {
+	bindings, _ := policy["bindings"].([]any)
+	for _, binding := range bindings {
+		if b, ok := binding.(map[string]any); ok && b["condition"] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// filterExpression matches the filter expressions of list requests which are supported by the fake server, i.e. a single
+// comparison of a field with a value, e.g. name eq "foo-[0-9]+" or labels.foo="bar".
+var filterExpression = regexp.MustCompile(`^([A-Za-z0-9_.-]+)\s*(=|!=|\beq\b|\bne\b)\s*"?([^"]*)"?$`)
+
+// filterResources returns the given resources which match the given filter expression. Like the API, the values of eq
+// and ne are regular expressions which have to match the whole field.
+func filterResources(resources []map[string]any, filter string) ([]map[string]any, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return resources, nil
+	}
+
+	match := filterExpression.FindStringSubmatch(filter)
+	if match == nil {
+		return nil, fmt.Errorf("invalid value for field 'filter': '%s'", filter)
+	}
+	field, operator, value := match[1], match[2], match[3]
+	pattern, err := regexp.Compile("^(?:" + value + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for field 'filter': '%s'", filter)
+	}
+
+	var items []map[string]any
+	for _, resource := range resources {
+		fieldValue := fieldString(resource, field)
+		var matches bool
+		switch operator {
+		case "=":
+			matches = fieldValue == value
+		case "!=":
+			matches = fieldValue != value
+		case "eq":
+			matches = pattern.MatchString(fieldValue)
+		case "ne":
+			matches = !pattern.MatchString(fieldValue)
+		}
+		if matches {
+			items = append(items, resource)
+		}
+	}
+	return items, nil
+}
+
+// fieldString returns the value of the field with the given dot-separated path of the given resource as string.
+func fieldString(resource map[string]any, field string) string {
+	var value any = resource
+	for _, key := range strings.Split(field, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+		value = object[key]
+	}
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+func readResource(r *http.Request) (map[string]any, error) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := map[string]any{}
+	if len(data) == 0 {
+		return resource, nil
+	}
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return nil, fmt.Errorf("could not decode request: %w", err)
+	}
+	return resource, nil
+}
+
+func copyResource(resource map[string]any) map[string]any {
+	data, _ := json.Marshal(resource)
+	result := map[string]any{}
+	_ = json.Unmarshal(data, &result)
+	return result
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]any{
+		"error": map[string]any{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake_test
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("Server", func() {
+	const (
+		project = "project"
+		region  = "europe-west1"
+	)
+
+	var (
+		ctx    = context.Background()
+		server *Server
+
+		computeClient gcpclient.ComputeClient
+		dnsClient     gcpclient.DNSClient
+		iamClient     gcpclient.IAMClient
+	)
+
+	BeforeEach(func() {
+		var err error
+		server, err = NewServer()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(server.Close)
+
+		serviceAccount, err := gcp.GetServiceAccountFromJSON(server.ServiceAccountJSON(project))
+		Expect(err).NotTo(HaveOccurred())
+
+		computeClient, err = gcpclient.NewComputeClient(ctx, serviceAccount, server.ClientOptions()...)
+		Expect(err).NotTo(HaveOccurred())
+		dnsClient, err = gcpclient.NewDNSClient(ctx, serviceAccount, server.ClientOptions()...)
+		Expect(err).NotTo(HaveOccurred())
+		iamClient, err = gcpclient.NewIAMClient(ctx, serviceAccount, server.ClientOptions()...)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should manage networks and subnets", func() {
+		network, err := computeClient.InsertNetwork(ctx, &gcpclient.Network{Name: "vpc"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(network.SelfLink).To(Equal(server.ComputeEndpoint() + "projects/project/global/networks/vpc"))
+
+		_, err = computeClient.InsertSubnet(ctx, region, &gcpclient.Subnetwork{
+			Name:        "nodes",
+			Network:     network.SelfLink,
+			IpCidrRange: "10.250.0.0/19",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = computeClient.ExpandSubnet(ctx, region, "nodes", "10.250.0.0/16")
+		Expect(err).NotTo(HaveOccurred())
+
+		subnet, err := computeClient.SetSubnetPrivateIpGoogleAccess(ctx, region, "nodes", true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subnet.PrivateIpGoogleAccess).To(BeTrue())
+
+		subnet, err = computeClient.GetSubnet(ctx, region, "nodes")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subnet.IpCidrRange).To(Equal("10.250.0.0/16"))
+		Expect(subnet.Network).To(Equal(network.SelfLink))
+		Expect(subnet.PrivateIpGoogleAccess).To(BeTrue())
+
+		Expect(computeClient.DeleteSubnet(ctx, region, "nodes")).To(Succeed())
+		Expect(computeClient.DeleteSubnet(ctx, region, "nodes")).To(Succeed())
+
+		subnet, err = computeClient.GetSubnet(ctx, region, "nodes")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subnet).To(BeNil())
+	})
+
+	It("should assign IPs to addresses", func() {
+		address, err := computeClient.InsertAddress(ctx, region, &gcpclient.Address{Name: "nat"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(address.Address).NotTo(BeEmpty())
+
+		_, ok := server.Get("projects/project/regions/europe-west1/addresses/nat")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should replace the labels of addresses", func() {
+		address, err := computeClient.InsertAddress(ctx, region, &gcpclient.Address{Name: "nat", Labels: map[string]string{"foo": "bar"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(computeClient.SetAddressLabels(ctx, region, address, map[string]string{"bar": "baz"})).To(Succeed())
+
+		address, err = computeClient.GetAddress(ctx, region, "nat")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(address.Labels).To(Equal(map[string]string{"bar": "baz"}))
+		Expect(address.LabelFingerprint).NotTo(BeEmpty())
+	})
+
+	It("should filter the listed addresses", func() {
+		for _, name := range []string{"nat-ip-0", "nat-ip-10", "nat-ip-foo"} {
+			_, err := computeClient.InsertAddress(ctx, region, &gcpclient.Address{Name: name, Labels: map[string]string{"name": name}})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		addresses, err := computeClient.ListAddresses(ctx, region, `name eq "nat-ip-[0-9]+"`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addresses).To(ConsistOf(HaveField("Name", "nat-ip-0"), HaveField("Name", "nat-ip-10")))
+
+		addresses, err = computeClient.ListAddresses(ctx, region, `labels.name="nat-ip-foo"`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addresses).To(ConsistOf(HaveField("Name", "nat-ip-foo")))
+
+		addresses, err = computeClient.ListAddresses(ctx, region, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addresses).To(HaveLen(3))
+
+		_, err = computeClient.ListAddresses(ctx, region, "name in (nat-ip-0)")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should list the instances of all zones", func() {
+		server.Put("projects/project/zones/europe-west1-b/instances/b", map[string]any{"tags": map[string]any{"items": []any{"shoot"}}})
+		server.Put("projects/project/zones/europe-west1-c/instances/c", map[string]any{})
+		server.Put("projects/other/zones/europe-west1-b/instances/other", map[string]any{})
+
+		instances, err := computeClient.ListInstances(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instances).To(ConsistOf(
+			HaveField("Name", "b"),
+			HaveField("Name", "c"),
+		))
+	})
+
+	It("should return the zones of stored regions", func() {
+		computeRegion, err := computeClient.GetRegion(ctx, region)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(computeRegion.Zones).To(BeEmpty())
+
+		server.Put("projects/project/regions/europe-west1", map[string]any{
+			"zones": []any{server.ComputeEndpoint() + "projects/project/zones/europe-west1-b"},
+		})
+		computeRegion, err = computeClient.GetRegion(ctx, region)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(computeRegion.Zones).To(ConsistOf(server.ComputeEndpoint() + "projects/project/zones/europe-west1-b"))
+	})
+
+	It("should list and delete the disks of a zone", func() {
+		server.Put("projects/project/zones/europe-west1-b/disks/shoot", map[string]any{"labels": map[string]any{"k8s-cluster-name": "shoot"}})
+		server.Put("projects/project/zones/europe-west1-b/disks/other", map[string]any{"labels": map[string]any{"k8s-cluster-name": "other"}})
+
+		disks, err := computeClient.ListDisks(ctx, "europe-west1-b", `labels.k8s-cluster-name="shoot"`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(disks).To(ConsistOf(HaveField("Name", "shoot")))
+
+		Expect(computeClient.DeleteDisk(ctx, "europe-west1-b", "shoot")).To(Succeed())
+		Expect(server.List("projects/project/zones/europe-west1-b/disks")).To(ConsistOf(HaveKeyWithValue("name", "other")))
+		Expect(computeClient.DeleteDisk(ctx, "europe-west1-b", "shoot")).To(Succeed())
+	})
+
+	It("should manage managed zones and their recordsets", func() {
+		_, err := dnsClient.CreateManagedZone(ctx, &gcpclient.ManagedZone{Name: "zone", DnsName: "example.com.", Visibility: "private"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = dnsClient.CreateManagedZone(ctx, &gcpclient.ManagedZone{Name: "zone", DnsName: "example.com."})
+		Expect(gcpclient.IsErrorCode(err, http.StatusConflict)).To(BeTrue())
+
+		zone, err := dnsClient.GetManagedZone(ctx, "zone")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(zone.DnsName).To(Equal("example.com."))
+		Expect(server.RecordSets("projects/project/managedZones/zone")).To(ConsistOf(
+			HaveKeyWithValue("type", "NS"),
+			HaveKeyWithValue("type", "SOA"),
+		))
+
+		Expect(dnsClient.CreateOrUpdateRecordSet(ctx, "zone", "api.example.com", "A", []string{"10.250.0.10"}, 120)).To(Succeed())
+		Expect(dnsClient.CreateOrUpdateRecordSet(ctx, "zone", "api.example.com", "A", []string{"10.250.0.11"}, 120)).To(Succeed())
+		Expect(server.RecordSets("projects/project/managedZones/zone")).To(ContainElement(And(
+			HaveKeyWithValue("name", "api.example.com."),
+			HaveKeyWithValue("type", "A"),
+			HaveKeyWithValue("rrdatas", []any{"10.250.0.11"}),
+		)))
+
+		Expect(dnsClient.DeleteRecordSet(ctx, "zone", "api.example.com", "A")).To(Succeed())
+		Expect(server.RecordSets("projects/project/managedZones/zone")).To(HaveLen(2))
+
+		Expect(dnsClient.CreateOrUpdateRecordSet(ctx, "zone", "api.example.com", "A", []string{"10.250.0.10"}, 120)).To(Succeed())
+		Expect(dnsClient.DeleteManagedZone(ctx, "zone")).To(Succeed())
+		_, ok := server.Get("projects/project/managedZones/zone")
+		Expect(ok).To(BeFalse())
+		Expect(dnsClient.DeleteManagedZone(ctx, "zone")).To(Succeed())
+	})
+
+	It("should manage service accounts and their role bindings", func() {
+		serviceAccount, err := iamClient.CreateServiceAccount(ctx, "shoot")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceAccount.Email).To(Equal("shoot@project.iam.gserviceaccount.com"))
+
+		Expect(iamClient.UpdateProjectRoleBindings(ctx, "serviceAccount:"+serviceAccount.Email, []string{"roles/logging.logWriter"}, nil)).To(Succeed())
+
+		serviceAccount, err = iamClient.GetServiceAccount(ctx, "shoot")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceAccount).NotTo(BeNil())
+
+		Expect(iamClient.DeleteServiceAccount(ctx, "shoot")).To(Succeed())
+		serviceAccount, err = iamClient.GetServiceAccount(ctx, "shoot")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceAccount).To(BeNil())
+	})
+
+	It("should keep conditional role bindings of the project", func() {
+		condition := map[string]any{"title": "temporary", "expression": "request.time < timestamp('2030-01-01T00:00:00Z')"}
+		server.PutPolicy("projects/project", map[string]any{
+			"version": 3,
+			"bindings": []any{
+				map[string]any{"role": "roles/viewer", "members": []any{"user:foo@example.com"}, "condition": condition},
+			},
+		})
+
+		Expect(iamClient.UpdateProjectRoleBindings(ctx, "serviceAccount:shoot@project.iam.gserviceaccount.com", []string{"roles/logging.logWriter", "roles/monitoring.viewer"}, nil)).To(Succeed())
+
+		policy := server.Policy("projects/project")
+		Expect(policy).To(HaveKeyWithValue("version", BeNumerically("==", 3)))
+		Expect(policy["bindings"]).To(ConsistOf(
+			map[string]any{"role": "roles/viewer", "members": []any{"user:foo@example.com"}, "condition": condition},
+			map[string]any{"role": "roles/logging.logWriter", "members": []any{"serviceAccount:shoot@project.iam.gserviceaccount.com"}},
+			map[string]any{"role": "roles/monitoring.viewer", "members": []any{"serviceAccount:shoot@project.iam.gserviceaccount.com"}},
+		))
+
+		Expect(iamClient.UpdateProjectRoleBindings(ctx, "serviceAccount:shoot@project.iam.gserviceaccount.com", nil, []string{"roles/logging.logWriter"})).To(Succeed())
+
+		policy = server.Policy("projects/project")
+		Expect(policy["bindings"]).To(ConsistOf(
+			map[string]any{"role": "roles/viewer", "members": []any{"user:foo@example.com"}, "condition": condition},
+			map[string]any{"role": "roles/monitoring.viewer", "members": []any{"serviceAccount:shoot@project.iam.gserviceaccount.com"}},
+		))
+	})
+})
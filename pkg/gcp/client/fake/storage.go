@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// PutObject stores a new generation of the object with the given name in the given bucket. The given attributes are
+// added to the object, e.g. temporaryHold.
+func (s *Server) PutObject(bucket, name string, attrs map[string]any) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.ids++
+	object := map[string]any{}
+	if attrs != nil {
+		object = copyResource(attrs)
+	}
+	object["kind"] = "storage#object"
+	object["bucket"] = bucket
+	object["name"] = name
+	object["generation"] = strconv.Itoa(s.ids)
+	object["metageneration"] = "1"
+
+	s.objects[bucket] = append(s.objects[bucket], object)
+	slices.SortStableFunc(s.objects[bucket], compareObjects)
+}
+
+// Objects returns the names of all generations of the objects in the given bucket in lexicographic order.
+func (s *Server) Objects(bucket string) []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var names []string
+	for _, object := range s.objects[bucket] {
+		names = append(names, object["name"].(string))
+	}
+	return names
+}
+
+func (s *Server) handleStorage(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// Objects have the path b/<bucket>/o/<name>, where the name may contain slashes.
+	path := strings.TrimPrefix(r.URL.Path, storagePrefix)
+	segments := strings.SplitN(path, "/", 4)
+
+	switch {
+	case len(segments) == 3 && segments[0] == "b" && segments[2] == "o" && r.Method == http.MethodGet:
+		s.listObjects(w, r, segments[1])
+
+	case len(segments) == 4 && segments[0] == "b" && segments[2] == "o":
+		bucket, name := segments[1], segments[3]
+		index := slices.IndexFunc(s.objects[bucket], func(object map[string]any) bool {
+			generation := r.URL.Query().Get("generation")
+			return object["name"] == name && (generation == "" || object["generation"] == generation)
+		})
+		if index < 0 {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("No such object: %s/%s", bucket, name))
+			return
+		}
+		object := s.objects[bucket][index]
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, object)
+		case http.MethodPatch:
+			request, err := readResource(r)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			for key, value := range request {
+				object[key] = value
+			}
+			writeJSON(w, http.StatusOK, object)
+		case http.MethodDelete:
+			if object["temporaryHold"] == true || object["eventBasedHold"] == true {
+				writeError(w, http.StatusForbidden, fmt.Sprintf("Object '%s/%s' is under active Event-Based hold or Temporary hold and cannot be deleted, overwritten or archived until hold is removed.", bucket, name))
+				return
+			}
+			s.objects[bucket] = slices.Delete(s.objects[bucket], index, index+1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The resource '%s' is not supported by the fake server", path))
+	}
+}
+
+// listObjects lists the objects of the given bucket. Like the API, only the live generations are listed unless all
+// versions are requested, and the list is paginated with the given maximum number of results.
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	var (
+		query       = r.URL.Query()
+		prefix      = query.Get("prefix")
+		startOffset = query.Get("startOffset")
+		endOffset   = query.Get("endOffset")
+		versions    = query.Get("versions") == "true"
+		pageToken   = query.Get("pageToken")
+		maxResults  = 1000
+		items       []map[string]any
+	)
+	if value := query.Get("maxResults"); value != "" {
+		maxResults, _ = strconv.Atoi(value)
+	}
+	start := ""
+	if pageToken != "" {
+		data, err := base64.StdEncoding.DecodeString(pageToken)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid page token")
+			return
+		}
+		start = string(data)
+	}
+
+	objects := s.objects[bucket]
+	for i, object := range objects {
+		name := object["name"].(string)
+		switch {
+		case !strings.HasPrefix(name, prefix),
+			startOffset != "" && name < startOffset,
+			endOffset != "" && name >= endOffset,
+			!versions && i+1 < len(objects) && objects[i+1]["name"] == name,
+			objectKey(object) < start:
+			continue
+		}
+
+		if len(items) == maxResults {
+			writeJSON(w, http.StatusOK, map[string]any{
+				"kind":          "storage#objects",
+				"items":         items,
+				"nextPageToken": base64.StdEncoding.EncodeToString([]byte(objectKey(object))),
+			})
+			return
+		}
+		items = append(items, object)
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"kind": "storage#objects", "items": items})
+}
+
+// objectKey returns a key of the given object generation which is ordered like the objects are listed.
+func objectKey(object map[string]any) string {
+	generation, _ := strconv.Atoi(object["generation"].(string))
+	return fmt.Sprintf("%s\x00%020d", object["name"], generation)
+}
+
+func compareObjects(a, b map[string]any) int {
+	return strings.Compare(objectKey(a), objectKey(b))
+}
@@ -7,15 +7,22 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
+	"slices"
 
-	"golang.org/x/oauth2/google"
+	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
 	iam "google.golang.org/api/iam/v1"
-	"google.golang.org/api/option"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
+// iamPolicyVersion is the version of the IAM policies which are read and written. Version 3 is required to preserve
+// conditional role bindings.
+const iamPolicyVersion = 3
+
 var (
 	serviceAccountIDRegex           = regexp.MustCompile(`^projects/.*/serviceAccounts/.*@.*\.iam\.gserviceaccount\.com$`)
 	_                     IAMClient = &iamClient{}
@@ -26,28 +33,44 @@ type IAMClient interface {
 	GetServiceAccount(ctx context.Context, name string) (*ServiceAccount, error)
 	CreateServiceAccount(ctx context.Context, accountID string) (*ServiceAccount, error)
 	DeleteServiceAccount(context.Context, string) error
+	// UpdateProjectRoleBindings binds the given member to the roles to add and removes its (unconditional) bindings to
+	// the roles to remove in the project. Bindings of the member to other roles are kept.
+	UpdateProjectRoleBindings(ctx context.Context, member string, add, remove []string) error
+	// IsBooleanConstraintEnforced returns whether the given boolean constraint of the organization policy is enforced
+	// for the project.
+	IsBooleanConstraintEnforced(ctx context.Context, constraint string) (bool, error)
 }
 
 type iamClient struct {
-	service   *iam.Service
-	projectID string
+	service         *iam.Service
+	resourceManager *cloudresourcemanager.Service
+	projectID       string
 }
 
 // NewIAMClient returns a new IAM client.
-func NewIAMClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (IAMClient, error) {
-	credentials, err := google.CredentialsFromJSON(ctx, serviceAccount.Raw, iam.CloudPlatformScope)
+func NewIAMClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, opts ...Option) (IAMClient, error) {
+	options := newOptions(opts...)
+	credentials, err := options.credentials(ctx, serviceAccount, iam.CloudPlatformScope)
 	if err != nil {
 		return nil, err
 	}
 
-	service, err := iam.NewService(ctx, option.WithCredentials(credentials))
+	httpClient := options.httpClient(ctx, credentials.TokenSource)
+
+	service, err := iam.NewService(ctx, options.clientOptions(httpClient, ServiceIAM)...)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceManager, err := cloudresourcemanager.NewService(ctx, options.clientOptions(httpClient, ServiceResourceManager)...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &iamClient{
-		service:   service,
-		projectID: credentials.ProjectID,
+		service:         service,
+		resourceManager: resourceManager,
+		projectID:       credentials.ProjectID,
 	}, nil
 }
 
@@ -87,3 +110,77 @@ func (i *iamClient) DeleteServiceAccount(ctx context.Context, name string) error
 	_, err := i.service.Projects.ServiceAccounts.Delete(accountID).Context(ctx).Do()
 	return IgnoreNotFoundError(err)
 }
+
+func (i *iamClient) UpdateProjectRoleBindings(ctx context.Context, member string, add, remove []string) error {
+	// The policy is written with the etag of the read policy, hence concurrent modifications are rejected with a conflict
+	// and the modification is retried with the current policy.
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return IsErrorCode(err, http.StatusConflict)
+	}, func() error {
+		return i.updateProjectRoleBindings(ctx, member, add, remove)
+	})
+}
+
+func (i *iamClient) updateProjectRoleBindings(ctx context.Context, member string, add, remove []string) error {
+	policy, err := i.resourceManager.Projects.GetIamPolicy(i.projectID, &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{RequestedPolicyVersion: iamPolicyVersion},
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	var (
+		missingRoles = sets.New(add...)
+		removedRoles = sets.New(remove...)
+		modified     = false
+	)
+
+	for _, binding := range policy.Bindings {
+		// conditional bindings are never managed by us.
+		if binding.Condition != nil {
+			continue
+		}
+
+		hasMember := slices.Contains(binding.Members, member)
+		switch {
+		case missingRoles.Has(binding.Role) && !hasMember:
+			binding.Members = append(binding.Members, member)
+			modified = true
+		case removedRoles.Has(binding.Role) && hasMember:
+			binding.Members = slices.DeleteFunc(binding.Members, func(m string) bool { return m == member })
+			modified = true
+		}
+		missingRoles.Delete(binding.Role)
+	}
+
+	for _, role := range sets.List(missingRoles) {
+		policy.Bindings = append(policy.Bindings, &cloudresourcemanager.Binding{
+			Role:    role,
+			Members: []string{member},
+		})
+		modified = true
+	}
+
+	if !modified {
+		return nil
+	}
+
+	// bindings without any members are rejected by the API.
+	policy.Bindings = slices.DeleteFunc(policy.Bindings, func(b *cloudresourcemanager.Binding) bool { return len(b.Members) == 0 })
+	// policies with conditional bindings must be written with version 3, otherwise they are rejected.
+	policy.Version = iamPolicyVersion
+
+	_, err = i.resourceManager.Projects.SetIamPolicy(i.projectID, &cloudresourcemanager.SetIamPolicyRequest{Policy: policy}).Context(ctx).Do()
+	return err
+}
+
+func (i *iamClient) IsBooleanConstraintEnforced(ctx context.Context, constraint string) (bool, error) {
+	policy, err := i.resourceManager.Projects.GetEffectiveOrgPolicy("projects/"+i.projectID, &cloudresourcemanager.GetEffectiveOrgPolicyRequest{
+		Constraint: constraint,
+	}).Context(ctx).Do()
+	if err != nil {
+		return false, err
+	}
+
+	return policy.BooleanPolicy != nil && policy.BooleanPolicy.Enforced, nil
+}
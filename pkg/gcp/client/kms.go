@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/cloudkms/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// roleCryptoKeyEncrypterDecrypter is the role which allows using a key for encryption and decryption.
+const roleCryptoKeyEncrypterDecrypter = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+
+// KMSClient is an interface which must be implemented by GCP Cloud KMS clients.
+type KMSClient interface {
+	IsKeyUsableBy(ctx context.Context, keyName, member string) (bool, error)
+}
+
+type kmsClient struct {
+	service *cloudkms.Service
+}
+
+// NewKMSClient returns a client for GCP's Cloud KMS service.
+func NewKMSClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, opts ...Option) (KMSClient, error) {
+	options := newOptions(opts...)
+	credentials, err := options.credentials(ctx, serviceAccount, cloudkms.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	service, err := cloudkms.NewService(ctx, options.clientOptions(options.httpClient(ctx, credentials.TokenSource), ServiceKMS)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kmsClient{
+		service: service,
+	}, nil
+}
+
+// IsKeyUsableBy returns whether the given member is allowed to encrypt and decrypt data with the key with the given
+// resource name, i.e. whether it was granted the `roles/cloudkms.cryptoKeyEncrypterDecrypter` role on the key or on its
+// key ring. Roles granted on the project are not considered.
+func (k *kmsClient) IsKeyUsableBy(ctx context.Context, keyName, member string) (bool, error) {
+	keyPolicy, err := k.service.Projects.Locations.KeyRings.CryptoKeys.GetIamPolicy(keyName).Context(ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	if hasKMSRole(keyPolicy, member, roleCryptoKeyEncrypterDecrypter) {
+		return true, nil
+	}
+
+	keyRingName, _, _ := strings.Cut(keyName, "/cryptoKeys/")
+	keyRingPolicy, err := k.service.Projects.Locations.KeyRings.GetIamPolicy(keyRingName).Context(ctx).Do()
+	if err != nil {
+		return false, err
+	}
+	return hasKMSRole(keyRingPolicy, member, roleCryptoKeyEncrypterDecrypter), nil
+}
+
+func hasKMSRole(policy *cloudkms.Policy, member, role string) bool {
+	return slices.ContainsFunc(policy.Bindings, func(binding *cloudkms.Binding) bool {
+		return binding.Role == role && binding.Condition == nil && slices.Contains(binding.Members, member)
+	})
+}
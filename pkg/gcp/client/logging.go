@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/logging/v2"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// LoggingClient is an interface which must be implemented by GCP Cloud Logging clients.
+type LoggingClient interface {
+	GetSink(ctx context.Context, id string) (*LogSink, error)
+	CreateSink(ctx context.Context, sink *LogSink) (*LogSink, error)
+	UpdateSink(ctx context.Context, id string, sink *LogSink) (*LogSink, error)
+	DeleteSink(ctx context.Context, id string) error
+}
+
+type loggingClient struct {
+	service   *logging.Service
+	projectID string
+}
+
+// NewLoggingClient returns a client for GCP's Cloud Logging service.
+func NewLoggingClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, opts ...Option) (LoggingClient, error) {
+	options := newOptions(opts...)
+	credentials, err := options.credentials(ctx, serviceAccount, logging.LoggingAdminScope)
+	if err != nil {
+		return nil, err
+	}
+	service, err := logging.NewService(ctx, options.clientOptions(options.httpClient(ctx, credentials.TokenSource), ServiceLogging)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loggingClient{
+		service:   service,
+		projectID: credentials.ProjectID,
+	}, nil
+}
+
+// GetSink returns the sink with the given ID in the project of the client or nil if it does not exist.
+func (l *loggingClient) GetSink(ctx context.Context, id string) (*LogSink, error) {
+	sink, err := l.service.Projects.Sinks.Get(l.sinkName(id)).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return sink, nil
+}
+
+// CreateSink creates the given sink in the project of the client. The sink writes to its destination with a dedicated
+// service account, which is returned as writer identity.
+func (l *loggingClient) CreateSink(ctx context.Context, sink *LogSink) (*LogSink, error) {
+	return l.service.Projects.Sinks.Create(l.project(), sink).UniqueWriterIdentity(true).Context(ctx).Do()
+}
+
+// UpdateSink updates the destination, the filter and the description of the sink with the given ID in the project of
+// the client.
+func (l *loggingClient) UpdateSink(ctx context.Context, id string, sink *LogSink) (*LogSink, error) {
+	return l.service.Projects.Sinks.Update(l.sinkName(id), sink).UniqueWriterIdentity(true).UpdateMask("destination,filter,description").Context(ctx).Do()
+}
+
+// DeleteSink deletes the sink with the given ID in the project of the client. Returns no error if the sink is not found.
+func (l *loggingClient) DeleteSink(ctx context.Context, id string) error {
+	_, err := l.service.Projects.Sinks.Delete(l.sinkName(id)).Context(ctx).Do()
+	return IgnoreNotFoundError(err)
+}
+
+func (l *loggingClient) project() string {
+	return fmt.Sprintf("projects/%s", l.projectID)
+}
+
+func (l *loggingClient) sinkName(id string) string {
+	return fmt.Sprintf("%s/sinks/%s", l.project(), id)
+}
@@ -16,6 +16,7 @@ import (
 	client "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
 	gomock "go.uber.org/mock/gomock"
 	compute "google.golang.org/api/compute/v1"
+	dns "google.golang.org/api/dns/v1"
 	v1 "k8s.io/api/core/v1"
 	client0 "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -88,6 +89,36 @@ func (mr *MockFactoryMockRecorder) IAM(arg0, arg1, arg2 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IAM", reflect.TypeOf((*MockFactory)(nil).IAM), arg0, arg1, arg2)
 }
 
+// Logging mocks base method.
+func (m *MockFactory) Logging(arg0 context.Context, arg1 client0.Client, arg2 v1.SecretReference) (client.LoggingClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logging", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.LoggingClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logging indicates an expected call of Logging.
+func (mr *MockFactoryMockRecorder) Logging(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logging", reflect.TypeOf((*MockFactory)(nil).Logging), arg0, arg1, arg2)
+}
+
+// NetworkConnectivity mocks base method.
+func (m *MockFactory) NetworkConnectivity(arg0 context.Context, arg1 client0.Client, arg2 v1.SecretReference) (client.NetworkConnectivityClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkConnectivity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(client.NetworkConnectivityClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NetworkConnectivity indicates an expected call of NetworkConnectivity.
+func (mr *MockFactoryMockRecorder) NetworkConnectivity(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkConnectivity", reflect.TypeOf((*MockFactory)(nil).NetworkConnectivity), arg0, arg1, arg2)
+}
+
 // Storage mocks base method.
 func (m *MockFactory) Storage(arg0 context.Context, arg1 client0.Client, arg2 v1.SecretReference) (client.StorageClient, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +157,21 @@ func (m *MockDNSClient) EXPECT() *MockDNSClientMockRecorder {
 	return m.recorder
 }
 
+// CreateManagedZone mocks base method.
+func (m *MockDNSClient) CreateManagedZone(arg0 context.Context, arg1 *dns.ManagedZone) (*dns.ManagedZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManagedZone", arg0, arg1)
+	ret0, _ := ret[0].(*dns.ManagedZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManagedZone indicates an expected call of CreateManagedZone.
+func (mr *MockDNSClientMockRecorder) CreateManagedZone(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagedZone", reflect.TypeOf((*MockDNSClient)(nil).CreateManagedZone), arg0, arg1)
+}
+
 // CreateOrUpdateRecordSet mocks base method.
 func (m *MockDNSClient) CreateOrUpdateRecordSet(arg0 context.Context, arg1, arg2, arg3 string, arg4 []string, arg5 int64) error {
 	m.ctrl.T.Helper()
@@ -140,6 +186,34 @@ func (mr *MockDNSClientMockRecorder) CreateOrUpdateRecordSet(arg0, arg1, arg2, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRecordSet", reflect.TypeOf((*MockDNSClient)(nil).CreateOrUpdateRecordSet), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// CreateOrUpdateRoutingPolicyRecordSet mocks base method.
+func (m *MockDNSClient) CreateOrUpdateRoutingPolicyRecordSet(arg0 context.Context, arg1, arg2, arg3 string, arg4 *dns.RRSetRoutingPolicy, arg5 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateRoutingPolicyRecordSet", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateRoutingPolicyRecordSet indicates an expected call of CreateOrUpdateRoutingPolicyRecordSet.
+func (mr *MockDNSClientMockRecorder) CreateOrUpdateRoutingPolicyRecordSet(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateRoutingPolicyRecordSet", reflect.TypeOf((*MockDNSClient)(nil).CreateOrUpdateRoutingPolicyRecordSet), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// DeleteManagedZone mocks base method.
+func (m *MockDNSClient) DeleteManagedZone(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManagedZone", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteManagedZone indicates an expected call of DeleteManagedZone.
+func (mr *MockDNSClientMockRecorder) DeleteManagedZone(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagedZone", reflect.TypeOf((*MockDNSClient)(nil).DeleteManagedZone), arg0, arg1)
+}
+
 // DeleteRecordSet mocks base method.
 func (m *MockDNSClient) DeleteRecordSet(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -154,6 +228,21 @@ func (mr *MockDNSClientMockRecorder) DeleteRecordSet(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecordSet", reflect.TypeOf((*MockDNSClient)(nil).DeleteRecordSet), arg0, arg1, arg2, arg3)
 }
 
+// GetManagedZone mocks base method.
+func (m *MockDNSClient) GetManagedZone(arg0 context.Context, arg1 string) (*dns.ManagedZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagedZone", arg0, arg1)
+	ret0, _ := ret[0].(*dns.ManagedZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagedZone indicates an expected call of GetManagedZone.
+func (mr *MockDNSClientMockRecorder) GetManagedZone(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedZone", reflect.TypeOf((*MockDNSClient)(nil).GetManagedZone), arg0, arg1)
+}
+
 // GetManagedZones mocks base method.
 func (m *MockDNSClient) GetManagedZones(arg0 context.Context) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +258,51 @@ func (mr *MockDNSClientMockRecorder) GetManagedZones(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedZones", reflect.TypeOf((*MockDNSClient)(nil).GetManagedZones), arg0)
 }
 
+// GetNameServers mocks base method.
+func (m *MockDNSClient) GetNameServers(arg0 context.Context, arg1 string) (string, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNameServers", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNameServers indicates an expected call of GetNameServers.
+func (mr *MockDNSClientMockRecorder) GetNameServers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNameServers", reflect.TypeOf((*MockDNSClient)(nil).GetNameServers), arg0, arg1)
+}
+
+// PatchManagedZone mocks base method.
+func (m *MockDNSClient) PatchManagedZone(arg0 context.Context, arg1 string, arg2 *dns.ManagedZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchManagedZone", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchManagedZone indicates an expected call of PatchManagedZone.
+func (mr *MockDNSClientMockRecorder) PatchManagedZone(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchManagedZone", reflect.TypeOf((*MockDNSClient)(nil).PatchManagedZone), arg0, arg1, arg2)
+}
+
+// ResolveManagedZone mocks base method.
+func (m *MockDNSClient) ResolveManagedZone(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveManagedZone", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveManagedZone indicates an expected call of ResolveManagedZone.
+func (mr *MockDNSClientMockRecorder) ResolveManagedZone(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveManagedZone", reflect.TypeOf((*MockDNSClient)(nil).ResolveManagedZone), arg0, arg1)
+}
+
 // MockComputeClient is a mock of ComputeClient interface.
 type MockComputeClient struct {
 	ctrl     *gomock.Controller
@@ -192,6 +326,90 @@ func (m *MockComputeClient) EXPECT() *MockComputeClientMockRecorder {
 	return m.recorder
 }
 
+// AddNetworkFirewallPolicyAssociation mocks base method.
+func (m *MockComputeClient) AddNetworkFirewallPolicyAssociation(arg0 context.Context, arg1 string, arg2 *compute.FirewallPolicyAssociation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetworkFirewallPolicyAssociation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNetworkFirewallPolicyAssociation indicates an expected call of AddNetworkFirewallPolicyAssociation.
+func (mr *MockComputeClientMockRecorder) AddNetworkFirewallPolicyAssociation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkFirewallPolicyAssociation", reflect.TypeOf((*MockComputeClient)(nil).AddNetworkFirewallPolicyAssociation), arg0, arg1, arg2)
+}
+
+// AddNetworkFirewallPolicyRule mocks base method.
+func (m *MockComputeClient) AddNetworkFirewallPolicyRule(arg0 context.Context, arg1 string, arg2 *compute.FirewallPolicyRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetworkFirewallPolicyRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNetworkFirewallPolicyRule indicates an expected call of AddNetworkFirewallPolicyRule.
+func (mr *MockComputeClientMockRecorder) AddNetworkFirewallPolicyRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkFirewallPolicyRule", reflect.TypeOf((*MockComputeClient)(nil).AddNetworkFirewallPolicyRule), arg0, arg1, arg2)
+}
+
+// AddPeering mocks base method.
+func (m *MockComputeClient) AddPeering(arg0 context.Context, arg1 string, arg2 *compute.NetworkPeering) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPeering", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPeering indicates an expected call of AddPeering.
+func (mr *MockComputeClientMockRecorder) AddPeering(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPeering", reflect.TypeOf((*MockComputeClient)(nil).AddPeering), arg0, arg1, arg2)
+}
+
+// CreateDiskSnapshot mocks base method.
+func (m *MockComputeClient) CreateDiskSnapshot(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDiskSnapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDiskSnapshot indicates an expected call of CreateDiskSnapshot.
+func (mr *MockComputeClientMockRecorder) CreateDiskSnapshot(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiskSnapshot", reflect.TypeOf((*MockComputeClient)(nil).CreateDiskSnapshot), arg0, arg1, arg2, arg3)
+}
+
+// DeleteAddress mocks base method.
+func (m *MockComputeClient) DeleteAddress(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAddress indicates an expected call of DeleteAddress.
+func (mr *MockComputeClientMockRecorder) DeleteAddress(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddress", reflect.TypeOf((*MockComputeClient)(nil).DeleteAddress), arg0, arg1, arg2)
+}
+
+// DeleteDisk mocks base method.
+func (m *MockComputeClient) DeleteDisk(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDisk", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDisk indicates an expected call of DeleteDisk.
+func (mr *MockComputeClientMockRecorder) DeleteDisk(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDisk", reflect.TypeOf((*MockComputeClient)(nil).DeleteDisk), arg0, arg1, arg2)
+}
+
 // DeleteFirewallRule mocks base method.
 func (m *MockComputeClient) DeleteFirewallRule(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -206,6 +424,20 @@ func (mr *MockComputeClientMockRecorder) DeleteFirewallRule(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockComputeClient)(nil).DeleteFirewallRule), arg0, arg1)
 }
 
+// DeleteForwardingRule mocks base method.
+func (m *MockComputeClient) DeleteForwardingRule(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForwardingRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteForwardingRule indicates an expected call of DeleteForwardingRule.
+func (mr *MockComputeClientMockRecorder) DeleteForwardingRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForwardingRule", reflect.TypeOf((*MockComputeClient)(nil).DeleteForwardingRule), arg0, arg1, arg2)
+}
+
 // DeleteNetwork mocks base method.
 func (m *MockComputeClient) DeleteNetwork(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -220,6 +452,34 @@ func (mr *MockComputeClientMockRecorder) DeleteNetwork(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockComputeClient)(nil).DeleteNetwork), arg0, arg1)
 }
 
+// DeleteNetworkFirewallPolicy mocks base method.
+func (m *MockComputeClient) DeleteNetworkFirewallPolicy(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkFirewallPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetworkFirewallPolicy indicates an expected call of DeleteNetworkFirewallPolicy.
+func (mr *MockComputeClientMockRecorder) DeleteNetworkFirewallPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkFirewallPolicy", reflect.TypeOf((*MockComputeClient)(nil).DeleteNetworkFirewallPolicy), arg0, arg1)
+}
+
+// DeletePacketMirroring mocks base method.
+func (m *MockComputeClient) DeletePacketMirroring(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePacketMirroring", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePacketMirroring indicates an expected call of DeletePacketMirroring.
+func (mr *MockComputeClientMockRecorder) DeletePacketMirroring(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePacketMirroring", reflect.TypeOf((*MockComputeClient)(nil).DeletePacketMirroring), arg0, arg1, arg2)
+}
+
 // DeleteRoute mocks base method.
 func (m *MockComputeClient) DeleteRoute(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -248,6 +508,20 @@ func (mr *MockComputeClientMockRecorder) DeleteRouter(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRouter", reflect.TypeOf((*MockComputeClient)(nil).DeleteRouter), arg0, arg1, arg2)
 }
 
+// DeleteSnapshot mocks base method.
+func (m *MockComputeClient) DeleteSnapshot(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockComputeClientMockRecorder) DeleteSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockComputeClient)(nil).DeleteSnapshot), arg0, arg1)
+}
+
 // DeleteSubnet mocks base method.
 func (m *MockComputeClient) DeleteSubnet(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -322,6 +596,51 @@ func (mr *MockComputeClientMockRecorder) GetFirewallRule(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRule", reflect.TypeOf((*MockComputeClient)(nil).GetFirewallRule), arg0, arg1)
 }
 
+// GetForwardingRule mocks base method.
+func (m *MockComputeClient) GetForwardingRule(arg0 context.Context, arg1, arg2 string) (*compute.ForwardingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForwardingRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*compute.ForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForwardingRule indicates an expected call of GetForwardingRule.
+func (mr *MockComputeClientMockRecorder) GetForwardingRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForwardingRule", reflect.TypeOf((*MockComputeClient)(nil).GetForwardingRule), arg0, arg1, arg2)
+}
+
+// GetInstanceScreenshot mocks base method.
+func (m *MockComputeClient) GetInstanceScreenshot(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceScreenshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceScreenshot indicates an expected call of GetInstanceScreenshot.
+func (mr *MockComputeClientMockRecorder) GetInstanceScreenshot(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceScreenshot", reflect.TypeOf((*MockComputeClient)(nil).GetInstanceScreenshot), arg0, arg1, arg2)
+}
+
+// GetInstanceSerialPortOutput mocks base method.
+func (m *MockComputeClient) GetInstanceSerialPortOutput(arg0 context.Context, arg1, arg2 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceSerialPortOutput", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceSerialPortOutput indicates an expected call of GetInstanceSerialPortOutput.
+func (mr *MockComputeClientMockRecorder) GetInstanceSerialPortOutput(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceSerialPortOutput", reflect.TypeOf((*MockComputeClient)(nil).GetInstanceSerialPortOutput), arg0, arg1, arg2)
+}
+
 // GetNetwork mocks base method.
 func (m *MockComputeClient) GetNetwork(arg0 context.Context, arg1 string) (*compute.Network, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +656,51 @@ func (mr *MockComputeClientMockRecorder) GetNetwork(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetwork", reflect.TypeOf((*MockComputeClient)(nil).GetNetwork), arg0, arg1)
 }
 
+// GetNetworkFirewallPolicy mocks base method.
+func (m *MockComputeClient) GetNetworkFirewallPolicy(arg0 context.Context, arg1 string) (*compute.FirewallPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkFirewallPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*compute.FirewallPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkFirewallPolicy indicates an expected call of GetNetworkFirewallPolicy.
+func (mr *MockComputeClientMockRecorder) GetNetworkFirewallPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkFirewallPolicy", reflect.TypeOf((*MockComputeClient)(nil).GetNetworkFirewallPolicy), arg0, arg1)
+}
+
+// GetPacketMirroring mocks base method.
+func (m *MockComputeClient) GetPacketMirroring(arg0 context.Context, arg1, arg2 string) (*compute.PacketMirroring, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPacketMirroring", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*compute.PacketMirroring)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPacketMirroring indicates an expected call of GetPacketMirroring.
+func (mr *MockComputeClientMockRecorder) GetPacketMirroring(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPacketMirroring", reflect.TypeOf((*MockComputeClient)(nil).GetPacketMirroring), arg0, arg1, arg2)
+}
+
+// GetRegion mocks base method.
+func (m *MockComputeClient) GetRegion(arg0 context.Context, arg1 string) (*compute.Region, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegion", arg0, arg1)
+	ret0, _ := ret[0].(*compute.Region)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegion indicates an expected call of GetRegion.
+func (mr *MockComputeClientMockRecorder) GetRegion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegion", reflect.TypeOf((*MockComputeClient)(nil).GetRegion), arg0, arg1)
+}
+
 // GetRouter mocks base method.
 func (m *MockComputeClient) GetRouter(arg0 context.Context, arg1, arg2 string) (*compute.Router, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +716,21 @@ func (mr *MockComputeClientMockRecorder) GetRouter(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouter", reflect.TypeOf((*MockComputeClient)(nil).GetRouter), arg0, arg1, arg2)
 }
 
+// GetSnapshot mocks base method.
+func (m *MockComputeClient) GetSnapshot(arg0 context.Context, arg1 string) (*compute.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*compute.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockComputeClientMockRecorder) GetSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockComputeClient)(nil).GetSnapshot), arg0, arg1)
+}
+
 // GetSubnet mocks base method.
 func (m *MockComputeClient) GetSubnet(arg0 context.Context, arg1, arg2 string) (*compute.Subnetwork, error) {
 	m.ctrl.T.Helper()
@@ -367,6 +746,21 @@ func (mr *MockComputeClientMockRecorder) GetSubnet(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnet", reflect.TypeOf((*MockComputeClient)(nil).GetSubnet), arg0, arg1, arg2)
 }
 
+// InsertAddress mocks base method.
+func (m *MockComputeClient) InsertAddress(arg0 context.Context, arg1 string, arg2 *compute.Address) (*compute.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*compute.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAddress indicates an expected call of InsertAddress.
+func (mr *MockComputeClientMockRecorder) InsertAddress(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAddress", reflect.TypeOf((*MockComputeClient)(nil).InsertAddress), arg0, arg1, arg2)
+}
+
 // InsertFirewallRule mocks base method.
 func (m *MockComputeClient) InsertFirewallRule(arg0 context.Context, arg1 *compute.Firewall) (*compute.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -382,6 +776,21 @@ func (mr *MockComputeClientMockRecorder) InsertFirewallRule(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertFirewallRule", reflect.TypeOf((*MockComputeClient)(nil).InsertFirewallRule), arg0, arg1)
 }
 
+// InsertForwardingRule mocks base method.
+func (m *MockComputeClient) InsertForwardingRule(arg0 context.Context, arg1 string, arg2 *compute.ForwardingRule) (*compute.ForwardingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertForwardingRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*compute.ForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertForwardingRule indicates an expected call of InsertForwardingRule.
+func (mr *MockComputeClientMockRecorder) InsertForwardingRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertForwardingRule", reflect.TypeOf((*MockComputeClient)(nil).InsertForwardingRule), arg0, arg1, arg2)
+}
+
 // InsertNetwork mocks base method.
 func (m *MockComputeClient) InsertNetwork(arg0 context.Context, arg1 *compute.Network) (*compute.Network, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +806,36 @@ func (mr *MockComputeClientMockRecorder) InsertNetwork(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertNetwork", reflect.TypeOf((*MockComputeClient)(nil).InsertNetwork), arg0, arg1)
 }
 
+// InsertNetworkFirewallPolicy mocks base method.
+func (m *MockComputeClient) InsertNetworkFirewallPolicy(arg0 context.Context, arg1 *compute.FirewallPolicy) (*compute.FirewallPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertNetworkFirewallPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*compute.FirewallPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertNetworkFirewallPolicy indicates an expected call of InsertNetworkFirewallPolicy.
+func (mr *MockComputeClientMockRecorder) InsertNetworkFirewallPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertNetworkFirewallPolicy", reflect.TypeOf((*MockComputeClient)(nil).InsertNetworkFirewallPolicy), arg0, arg1)
+}
+
+// InsertPacketMirroring mocks base method.
+func (m *MockComputeClient) InsertPacketMirroring(arg0 context.Context, arg1 string, arg2 *compute.PacketMirroring) (*compute.PacketMirroring, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPacketMirroring", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*compute.PacketMirroring)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertPacketMirroring indicates an expected call of InsertPacketMirroring.
+func (mr *MockComputeClientMockRecorder) InsertPacketMirroring(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPacketMirroring", reflect.TypeOf((*MockComputeClient)(nil).InsertPacketMirroring), arg0, arg1, arg2)
+}
+
 // InsertRouter mocks base method.
 func (m *MockComputeClient) InsertRouter(arg0 context.Context, arg1 string, arg2 *compute.Router) (*compute.Router, error) {
 	m.ctrl.T.Helper()
@@ -427,6 +866,36 @@ func (mr *MockComputeClientMockRecorder) InsertSubnet(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSubnet", reflect.TypeOf((*MockComputeClient)(nil).InsertSubnet), arg0, arg1, arg2)
 }
 
+// ListAddresses mocks base method.
+func (m *MockComputeClient) ListAddresses(arg0 context.Context, arg1, arg2 string) ([]*compute.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAddresses", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*compute.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddresses indicates an expected call of ListAddresses.
+func (mr *MockComputeClientMockRecorder) ListAddresses(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddresses", reflect.TypeOf((*MockComputeClient)(nil).ListAddresses), arg0, arg1, arg2)
+}
+
+// ListDisks mocks base method.
+func (m *MockComputeClient) ListDisks(arg0 context.Context, arg1, arg2 string) ([]*compute.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisks", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*compute.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisks indicates an expected call of ListDisks.
+func (mr *MockComputeClientMockRecorder) ListDisks(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisks", reflect.TypeOf((*MockComputeClient)(nil).ListDisks), arg0, arg1, arg2)
+}
+
 // ListFirewallRules mocks base method.
 func (m *MockComputeClient) ListFirewallRules(arg0 context.Context) ([]*compute.Firewall, error) {
 	m.ctrl.T.Helper()
@@ -442,6 +911,36 @@ func (mr *MockComputeClientMockRecorder) ListFirewallRules(arg0 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRules", reflect.TypeOf((*MockComputeClient)(nil).ListFirewallRules), arg0)
 }
 
+// ListForwardingRules mocks base method.
+func (m *MockComputeClient) ListForwardingRules(arg0 context.Context, arg1 string) ([]*compute.ForwardingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForwardingRules", arg0, arg1)
+	ret0, _ := ret[0].([]*compute.ForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForwardingRules indicates an expected call of ListForwardingRules.
+func (mr *MockComputeClientMockRecorder) ListForwardingRules(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForwardingRules", reflect.TypeOf((*MockComputeClient)(nil).ListForwardingRules), arg0, arg1)
+}
+
+// ListInstances mocks base method.
+func (m *MockComputeClient) ListInstances(arg0 context.Context) ([]*compute.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstances", arg0)
+	ret0, _ := ret[0].([]*compute.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstances indicates an expected call of ListInstances.
+func (mr *MockComputeClientMockRecorder) ListInstances(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstances", reflect.TypeOf((*MockComputeClient)(nil).ListInstances), arg0)
+}
+
 // ListRoutes mocks base method.
 func (m *MockComputeClient) ListRoutes(arg0 context.Context) ([]*compute.Route, error) {
 	m.ctrl.T.Helper()
@@ -487,6 +986,35 @@ func (mr *MockComputeClientMockRecorder) PatchNetwork(arg0, arg1, arg2 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchNetwork", reflect.TypeOf((*MockComputeClient)(nil).PatchNetwork), arg0, arg1, arg2)
 }
 
+// PatchNetworkFirewallPolicyRule mocks base method.
+func (m *MockComputeClient) PatchNetworkFirewallPolicyRule(arg0 context.Context, arg1 string, arg2 *compute.FirewallPolicyRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchNetworkFirewallPolicyRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchNetworkFirewallPolicyRule indicates an expected call of PatchNetworkFirewallPolicyRule.
+func (mr *MockComputeClientMockRecorder) PatchNetworkFirewallPolicyRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchNetworkFirewallPolicyRule", reflect.TypeOf((*MockComputeClient)(nil).PatchNetworkFirewallPolicyRule), arg0, arg1, arg2)
+}
+
+// PatchPacketMirroring mocks base method.
+func (m *MockComputeClient) PatchPacketMirroring(arg0 context.Context, arg1, arg2 string, arg3 *compute.PacketMirroring) (*compute.PacketMirroring, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchPacketMirroring", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*compute.PacketMirroring)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchPacketMirroring indicates an expected call of PatchPacketMirroring.
+func (mr *MockComputeClientMockRecorder) PatchPacketMirroring(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchPacketMirroring", reflect.TypeOf((*MockComputeClient)(nil).PatchPacketMirroring), arg0, arg1, arg2, arg3)
+}
+
 // PatchRouter mocks base method.
 func (m *MockComputeClient) PatchRouter(arg0 context.Context, arg1, arg2 string, arg3 *compute.Router) (*compute.Router, error) {
 	m.ctrl.T.Helper()
@@ -516,3 +1044,102 @@ func (mr *MockComputeClientMockRecorder) PatchSubnet(arg0, arg1, arg2, arg3 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSubnet", reflect.TypeOf((*MockComputeClient)(nil).PatchSubnet), arg0, arg1, arg2, arg3)
 }
+
+// RemoveNetworkFirewallPolicyAssociation mocks base method.
+func (m *MockComputeClient) RemoveNetworkFirewallPolicyAssociation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetworkFirewallPolicyAssociation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetworkFirewallPolicyAssociation indicates an expected call of RemoveNetworkFirewallPolicyAssociation.
+func (mr *MockComputeClientMockRecorder) RemoveNetworkFirewallPolicyAssociation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetworkFirewallPolicyAssociation", reflect.TypeOf((*MockComputeClient)(nil).RemoveNetworkFirewallPolicyAssociation), arg0, arg1, arg2)
+}
+
+// RemoveNetworkFirewallPolicyRule mocks base method.
+func (m *MockComputeClient) RemoveNetworkFirewallPolicyRule(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetworkFirewallPolicyRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetworkFirewallPolicyRule indicates an expected call of RemoveNetworkFirewallPolicyRule.
+func (mr *MockComputeClientMockRecorder) RemoveNetworkFirewallPolicyRule(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetworkFirewallPolicyRule", reflect.TypeOf((*MockComputeClient)(nil).RemoveNetworkFirewallPolicyRule), arg0, arg1, arg2)
+}
+
+// RemovePeering mocks base method.
+func (m *MockComputeClient) RemovePeering(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePeering", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePeering indicates an expected call of RemovePeering.
+func (mr *MockComputeClientMockRecorder) RemovePeering(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePeering", reflect.TypeOf((*MockComputeClient)(nil).RemovePeering), arg0, arg1, arg2)
+}
+
+// SetAddressLabels mocks base method.
+func (m *MockComputeClient) SetAddressLabels(arg0 context.Context, arg1 string, arg2 *compute.Address, arg3 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAddressLabels", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAddressLabels indicates an expected call of SetAddressLabels.
+func (mr *MockComputeClientMockRecorder) SetAddressLabels(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAddressLabels", reflect.TypeOf((*MockComputeClient)(nil).SetAddressLabels), arg0, arg1, arg2, arg3)
+}
+
+// SetInstanceDeletionProtection mocks base method.
+func (m *MockComputeClient) SetInstanceDeletionProtection(arg0 context.Context, arg1, arg2 string, arg3 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInstanceDeletionProtection", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInstanceDeletionProtection indicates an expected call of SetInstanceDeletionProtection.
+func (mr *MockComputeClientMockRecorder) SetInstanceDeletionProtection(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstanceDeletionProtection", reflect.TypeOf((*MockComputeClient)(nil).SetInstanceDeletionProtection), arg0, arg1, arg2, arg3)
+}
+
+// SetSubnetPrivateIpGoogleAccess mocks base method.
+func (m *MockComputeClient) SetSubnetPrivateIpGoogleAccess(arg0 context.Context, arg1, arg2 string, arg3 bool) (*compute.Subnetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSubnetPrivateIpGoogleAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*compute.Subnetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSubnetPrivateIpGoogleAccess indicates an expected call of SetSubnetPrivateIpGoogleAccess.
+func (mr *MockComputeClientMockRecorder) SetSubnetPrivateIpGoogleAccess(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetPrivateIpGoogleAccess", reflect.TypeOf((*MockComputeClient)(nil).SetSubnetPrivateIpGoogleAccess), arg0, arg1, arg2, arg3)
+}
+
+// UpdatePeering mocks base method.
+func (m *MockComputeClient) UpdatePeering(arg0 context.Context, arg1 string, arg2 *compute.NetworkPeering) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePeering", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePeering indicates an expected call of UpdatePeering.
+func (mr *MockComputeClientMockRecorder) UpdatePeering(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePeering", reflect.TypeOf((*MockComputeClient)(nil).UpdatePeering), arg0, arg1, arg2)
+}
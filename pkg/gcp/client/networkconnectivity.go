@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/api/networkconnectivity/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// NetworkConnectivityClient is an interface which must be implemented by GCP Network Connectivity Center clients.
+type NetworkConnectivityClient interface {
+	GetSpoke(ctx context.Context, id string) (*Spoke, error)
+	CreateSpoke(ctx context.Context, id string, spoke *Spoke) (*Spoke, error)
+	DeleteSpoke(ctx context.Context, id string) error
+}
+
+type networkConnectivityClient struct {
+	service   *networkconnectivity.Service
+	projectID string
+}
+
+// NewNetworkConnectivityClient returns a client for GCP's Network Connectivity Center service.
+func NewNetworkConnectivityClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, opts ...Option) (NetworkConnectivityClient, error) {
+	options := newOptions(opts...)
+	credentials, err := options.credentials(ctx, serviceAccount, networkconnectivity.CloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+	service, err := networkconnectivity.NewService(ctx, options.clientOptions(options.httpClient(ctx, credentials.TokenSource), ServiceNetworkConnectivity)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkConnectivityClient{
+		service:   service,
+		projectID: credentials.ProjectID,
+	}, nil
+}
+
+// GetSpoke returns the global spoke with the given ID in the project of the client or nil if it does not exist.
+func (n *networkConnectivityClient) GetSpoke(ctx context.Context, id string) (*Spoke, error) {
+	spoke, err := n.service.Projects.Locations.Spokes.Get(n.spokeName(id)).Context(ctx).Do()
+	if err != nil {
+		return nil, IgnoreNotFoundError(err)
+	}
+	return spoke, nil
+}
+
+// CreateSpoke creates the given global spoke with the given ID in the project of the client, waits until it is
+// attached to its hub and returns it.
+func (n *networkConnectivityClient) CreateSpoke(ctx context.Context, id string, spoke *Spoke) (*Spoke, error) {
+	op, err := n.service.Projects.Locations.Spokes.Create(n.globalLocation(), spoke).SpokeId(id).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if err := n.wait(ctx, op); err != nil {
+		return nil, err
+	}
+	return n.service.Projects.Locations.Spokes.Get(n.spokeName(id)).Context(ctx).Do()
+}
+
+// DeleteSpoke deletes the global spoke with the given ID in the project of the client, i.e. detaches it from its hub.
+// Returns no error if the spoke is not found.
+func (n *networkConnectivityClient) DeleteSpoke(ctx context.Context, id string) error {
+	op, err := n.service.Projects.Locations.Spokes.Delete(n.spokeName(id)).Context(ctx).Do()
+	if err != nil {
+		return IgnoreNotFoundError(err)
+	}
+	return n.wait(ctx, op)
+}
+
+func (n *networkConnectivityClient) globalLocation() string {
+	return fmt.Sprintf("projects/%s/locations/global", n.projectID)
+}
+
+func (n *networkConnectivityClient) spokeName(id string) string {
+	return fmt.Sprintf("%s/spokes/%s", n.globalLocation(), id)
+}
+
+func (n *networkConnectivityClient) wait(ctx context.Context, op *networkconnectivity.GoogleLongrunningOperation) error {
+	return wait.PollUntilContextCancel(ctx, pollInterval, true, func(ctx context.Context) (bool, error) {
+		if !op.Done {
+			result, err := n.service.Projects.Locations.Operations.Get(op.Name).Context(ctx).Do()
+			if err != nil {
+				return false, fmt.Errorf("failed to query operation [Name=%s]: %w", op.Name, err)
+			}
+			op = result
+		}
+		if !op.Done {
+			return false, nil
+		}
+		if op.Error != nil {
+			return false, fmt.Errorf("operation %q failed with error: %s", op.Name, op.Error.Message)
+		}
+		return true, nil
+	})
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/option"
+)
+
+// Service is a GCP service the clients of this package talk to.
+type Service string
+
+const (
+	// ServiceCompute is the Compute Engine API.
+	ServiceCompute Service = "compute"
+	// ServiceDNS is the Cloud DNS API.
+	ServiceDNS Service = "dns"
+	// ServiceIAM is the IAM API.
+	ServiceIAM Service = "iam"
+	// ServiceIAMCredentials is the IAM Service Account Credentials API.
+	ServiceIAMCredentials Service = "iamcredentials"
+	// ServiceKMS is the Cloud KMS API.
+	ServiceKMS Service = "cloudkms"
+	// ServiceLogging is the Cloud Logging API.
+	ServiceLogging Service = "logging"
+	// ServiceNetworkConnectivity is the Network Connectivity API.
+	ServiceNetworkConnectivity Service = "networkconnectivity"
+	// ServiceResourceManager is the Cloud Resource Manager API.
+	ServiceResourceManager Service = "cloudresourcemanager"
+	// ServiceStorage is the Cloud Storage API.
+	ServiceStorage Service = "storage"
+	// ServiceSTS is the Security Token Service API.
+	ServiceSTS Service = "sts"
+)
+
+// Options are the options of the clients created by this package.
+type Options struct {
+	// Endpoints overrides the endpoints of the given services, e.g. to use private service connect endpoints.
+	Endpoints map[Service]string
+	// WrapTransport wraps the authenticated HTTP transport of the clients, e.g. to record metrics of the requests.
+	WrapTransport func(http.RoundTripper) http.RoundTripper
+	// UserAgent is the user agent sent with the requests.
+	UserAgent string
+	// DNSChangeBatcher batches the changes of resource recordsets of the DNS clients, if it is set.
+	DNSChangeBatcher *DNSChangeBatcher
+}
+
+// Option modifies the Options of clients.
+type Option func(*Options)
+
+// WithEndpoint overrides the endpoint of the given service.
+func WithEndpoint(service Service, endpoint string) Option {
+	return func(o *Options) {
+		if o.Endpoints == nil {
+			o.Endpoints = map[Service]string{}
+		}
+		o.Endpoints[service] = endpoint
+	}
+}
+
+// WithTransportWrapper wraps the authenticated HTTP transport of the clients with the given function. Multiple
+// wrappers are applied in the given order, i.e. the last wrapper sees the requests first.
+func WithTransportWrapper(wrap func(http.RoundTripper) http.RoundTripper) Option {
+	return func(o *Options) {
+		if previous := o.WrapTransport; previous != nil {
+			o.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+				return wrap(previous(rt))
+			}
+			return
+		}
+		o.WrapTransport = wrap
+	}
+}
+
+// WithUserAgent sets the user agent sent with the requests.
+func WithUserAgent(userAgent string) Option {
+	return func(o *Options) {
+		o.UserAgent = userAgent
+	}
+}
+
+func newOptions(opts ...Option) *Options {
+	o := &Options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// httpClient returns the HTTP client authenticating with the given token source.
+func (o *Options) httpClient(ctx context.Context, tokenSource oauth2.TokenSource) *http.Client {
+	client := oauth2.NewClient(ctx, tokenSource)
+	if o.WrapTransport != nil {
+		client.Transport = o.WrapTransport(client.Transport)
+	}
+	return client
+}
+
+// clientOptions returns the options of the API client of the given service.
+func (o *Options) clientOptions(httpClient *http.Client, service Service) []option.ClientOption {
+	opts := []option.ClientOption{option.WithHTTPClient(httpClient)}
+	if endpoint, ok := o.Endpoints[service]; ok {
+		opts = append(opts, option.WithEndpoint(endpoint))
+	}
+	if o.UserAgent != "" {
+		opts = append(opts, option.WithUserAgent(o.UserAgent))
+	}
+	return opts
+}
@@ -6,11 +6,16 @@ package client
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
-	"google.golang.org/api/option"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -19,14 +24,42 @@ import (
 
 const (
 	errCodeBucketAlreadyOwnedByYou = 409
+
+	// DefaultDeleteParallelism is the default number of objects which are deleted concurrently.
+	DefaultDeleteParallelism = 32
+
+	// deletePageSize is the number of objects which are listed and deleted per page.
+	deletePageSize = 1000
 )
 
 // StorageClient is an interface which must be implemented by GCS clients.
 type StorageClient interface {
 	// GCS wrappers
-	CreateBucketIfNotExists(ctx context.Context, bucketName, region string) error
+	CreateBucketIfNotExists(ctx context.Context, bucketName, location string) error
 	DeleteBucketIfExists(ctx context.Context, bucketName string) error
-	DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error
+	SetRetentionPolicy(ctx context.Context, bucketName string, retentionPeriod time.Duration, locked bool) error
+	SetDefaultEventBasedHold(ctx context.Context, bucketName string, enabled bool) error
+	SetTurboReplication(ctx context.Context, bucketName string, enabled bool) error
+	SetDefaultKMSKey(ctx context.Context, bucketName, kmsKeyName string) error
+	SetLifecycleRules(ctx context.Context, bucketName string, rules []LifecycleRule) error
+	SetVersioning(ctx context.Context, bucketName string, enabled bool) error
+	SetStorageClass(ctx context.Context, bucketName, storageClass string) error
+	GetServiceAgent(ctx context.Context) (string, error)
+	DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string, opts DeleteObjectsOptions) error
+}
+
+// DeleteObjectsOptions are options for deleting the objects with a prefix.
+type DeleteObjectsOptions struct {
+	// Parallelism is the maximum number of objects which are deleted concurrently. DefaultDeleteParallelism is used if
+	// it is not positive.
+	Parallelism int
+	// StartOffset is the checkpoint of a previous deletion, where the deletion is resumed. Objects whose names are
+	// lexicographically before it are only listed again at the end, as only objects with a temporary hold should be left
+	// there.
+	StartOffset string
+	// Checkpoint is called after all objects of a page were deleted with the name of the last object of the page,
+	// which can be passed as StartOffset to resume the deletion.
+	Checkpoint func(ctx context.Context, startOffset string) error
 }
 
 type storageClient struct {
@@ -35,8 +68,14 @@ type storageClient struct {
 }
 
 // NewStorageClient creates a new storage client from the given  serviceAccount.
-func NewStorageClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (StorageClient, error) {
-	client, err := storage.NewClient(ctx, option.WithCredentialsJSON(serviceAccount.Raw), option.WithScopes(storage.ScopeFullControl))
+func NewStorageClient(ctx context.Context, serviceAccount *gcp.ServiceAccount, opts ...Option) (StorageClient, error) {
+	options := newOptions(opts...)
+	credentials, err := options.credentials(ctx, serviceAccount, storage.ScopeFullControl)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := storage.NewClient(ctx, options.clientOptions(options.httpClient(ctx, credentials.TokenSource), ServiceStorage)...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,19 +86,19 @@ func NewStorageClient(ctx context.Context, serviceAccount *gcp.ServiceAccount) (
 }
 
 // NewStorageClientFromSecretRef creates a new storage client from the given <secretRef>.
-func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (StorageClient, error) {
+func NewStorageClientFromSecretRef(ctx context.Context, c client.Client, secretRef corev1.SecretReference, opts ...Option) (StorageClient, error) {
 	serviceAccount, err := gcp.GetServiceAccountFromSecretReference(ctx, c, secretRef)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStorageClient(ctx, serviceAccount)
+	return NewStorageClient(ctx, serviceAccount, opts...)
 }
 
-func (s *storageClient) CreateBucketIfNotExists(ctx context.Context, bucketName, region string) error {
+func (s *storageClient) CreateBucketIfNotExists(ctx context.Context, bucketName, location string) error {
 	if err := s.client.Bucket(bucketName).Create(ctx, s.serviceAccount.ProjectID, &storage.BucketAttrs{
 		Name:     bucketName,
-		Location: region,
+		Location: location,
 		UniformBucketLevelAccess: storage.UniformBucketLevelAccess{
 			Enabled: true,
 		},
@@ -77,19 +116,293 @@ func (s *storageClient) DeleteBucketIfExists(ctx context.Context, bucketName str
 	return IgnoreNotFoundError(err)
 }
 
-func (s *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string) error {
+// SetRetentionPolicy sets the retention period of the objects of the given bucket. A retention period of zero removes
+// the retention policy. If locked is true, the retention policy is locked afterwards, which cannot be undone, i.e. the
+// policy cannot be removed and its retention period cannot be reduced anymore.
+func (s *storageClient) SetRetentionPolicy(ctx context.Context, bucketName string, retentionPeriod time.Duration, locked bool) error {
+	bucketHandle := s.client.Bucket(bucketName)
+	attrs, err := bucketHandle.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var current storage.RetentionPolicy
+	if attrs.RetentionPolicy != nil {
+		current = *attrs.RetentionPolicy
+	}
+	if current.IsLocked && retentionPeriod < current.RetentionPeriod {
+		return fmt.Errorf("the retention period of bucket %s must not be reduced below %s, as its retention policy is locked", bucketName, current.RetentionPeriod)
+	}
+
+	if current.RetentionPeriod != retentionPeriod {
+		if attrs, err = bucketHandle.Update(ctx, storage.BucketAttrsToUpdate{
+			RetentionPolicy: &storage.RetentionPolicy{RetentionPeriod: retentionPeriod},
+		}); err != nil {
+			return fmt.Errorf("could not update retention policy of bucket %s: %w", bucketName, err)
+		}
+	}
+
+	if locked && retentionPeriod > 0 && !current.IsLocked {
+		// The retention policy is only locked if the bucket was not modified in the meantime.
+		if err := bucketHandle.If(storage.BucketConditions{MetagenerationMatch: attrs.MetaGeneration}).LockRetentionPolicy(ctx); err != nil {
+			return fmt.Errorf("could not lock retention policy of bucket %s: %w", bucketName, err)
+		}
+	}
+	return nil
+}
+
+// SetDefaultEventBasedHold sets whether an event-based hold is placed on all new objects of the given bucket.
+func (s *storageClient) SetDefaultEventBasedHold(ctx context.Context, bucketName string, enabled bool) error {
+	bucketHandle := s.client.Bucket(bucketName)
+	attrs, err := bucketHandle.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+	if attrs.DefaultEventBasedHold == enabled {
+		return nil
+	}
+
+	if _, err := bucketHandle.Update(ctx, storage.BucketAttrsToUpdate{DefaultEventBasedHold: enabled}); err != nil {
+		return fmt.Errorf("could not update default event-based hold of bucket %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// SetTurboReplication sets whether the turbo replication is enabled for the given dual-region bucket.
+func (s *storageClient) SetTurboReplication(ctx context.Context, bucketName string, enabled bool) error {
+	bucketHandle := s.client.Bucket(bucketName)
+	attrs, err := bucketHandle.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	rpo := storage.RPODefault
+	if enabled {
+		rpo = storage.RPOAsyncTurbo
+	}
+	// The recovery point objective is not set for buckets which are not located in dual-regions.
+	if attrs.RPO == rpo || (attrs.RPO == storage.RPOUnknown && !enabled) {
+		return nil
+	}
+
+	if _, err := bucketHandle.Update(ctx, storage.BucketAttrsToUpdate{RPO: rpo}); err != nil {
+		return fmt.Errorf("could not update turbo replication of bucket %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// SetDefaultKMSKey sets the customer-managed key with which new objects of the given bucket are encrypted. An empty key
+// name restores the encryption with keys managed by Google.
+func (s *storageClient) SetDefaultKMSKey(ctx context.Context, bucketName, kmsKeyName string) error {
+	bucketHandle := s.client.Bucket(bucketName)
+	attrs, err := bucketHandle.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var current string
+	if attrs.Encryption != nil {
+		current = attrs.Encryption.DefaultKMSKeyName
+	}
+	if current == kmsKeyName {
+		return nil
+	}
+
+	if _, err := bucketHandle.Update(ctx, storage.BucketAttrsToUpdate{
+		Encryption: &storage.BucketEncryption{DefaultKMSKeyName: kmsKeyName},
+	}); err != nil {
+		return fmt.Errorf("could not update default KMS key of bucket %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// SetLifecycleRules replaces the lifecycle rules of the given bucket with the given rules. The bucket is only updated if
+// the rules differ.
+func (s *storageClient) SetLifecycleRules(ctx context.Context, bucketName string, rules []LifecycleRule) error {
 	bucketHandle := s.client.Bucket(bucketName)
-	itr := bucketHandle.Objects(ctx, &storage.Query{Prefix: prefix})
+	attrs, err := bucketHandle.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+	if slices.EqualFunc(attrs.Lifecycle.Rules, rules, func(a, b LifecycleRule) bool {
+		return a.Action == b.Action && a.Condition.AgeInDays == b.Condition.AgeInDays &&
+			a.Condition.DaysSinceNoncurrentTime == b.Condition.DaysSinceNoncurrentTime
+	}) {
+		return nil
+	}
+
+	if _, err := bucketHandle.Update(ctx, storage.BucketAttrsToUpdate{
+		Lifecycle: &storage.Lifecycle{Rules: rules},
+	}); err != nil {
+		return fmt.Errorf("could not update lifecycle rules of bucket %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// SetVersioning sets whether the object versioning is enabled for the given bucket.
+func (s *storageClient) SetVersioning(ctx context.Context, bucketName string, enabled bool) error {
+	bucketHandle := s.client.Bucket(bucketName)
+	attrs, err := bucketHandle.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+	if attrs.VersioningEnabled == enabled {
+		return nil
+	}
+
+	if _, err := bucketHandle.Update(ctx, storage.BucketAttrsToUpdate{VersioningEnabled: enabled}); err != nil {
+		return fmt.Errorf("could not update versioning of bucket %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// SetStorageClass sets the default storage class of the objects of the given bucket. Existing objects keep their
+// storage class.
+func (s *storageClient) SetStorageClass(ctx context.Context, bucketName, storageClass string) error {
+	bucketHandle := s.client.Bucket(bucketName)
+	attrs, err := bucketHandle.Attrs(ctx)
+	if err != nil {
+		return err
+	}
+	if attrs.StorageClass == storageClass {
+		return nil
+	}
+
+	if _, err := bucketHandle.Update(ctx, storage.BucketAttrsToUpdate{StorageClass: storageClass}); err != nil {
+		return fmt.Errorf("could not update storage class of bucket %s: %w", bucketName, err)
+	}
+	return nil
+}
+
+// GetServiceAgent returns the email address of the Cloud Storage service agent of the project of the client, which
+// encrypts and decrypts the objects of buckets with customer-managed keys.
+func (s *storageClient) GetServiceAgent(ctx context.Context) (string, error) {
+	return s.client.ServiceAccount(ctx, s.serviceAccount.ProjectID)
+}
+
+// DeleteObjectsWithPrefix deletes the objects of the given bucket with the given prefix including their noncurrent
+// versions. The objects are listed in pages, the objects of a page are deleted concurrently and a checkpoint is passed
+// to opts.Checkpoint after each page, so that the deletion of large buckets can be resumed. Event-based holds of the
+// objects are released before, while objects with a temporary hold are not deleted, as these holds are placed
+// manually.
+func (s *storageClient) DeleteObjectsWithPrefix(ctx context.Context, bucketName, prefix string, opts DeleteObjectsOptions) error {
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultDeleteParallelism
+	}
+	bucketHandle := s.client.Bucket(bucketName)
+
+	held, err := deleteObjectsInRange(ctx, bucketHandle, &storage.Query{Prefix: prefix, StartOffset: opts.StartOffset}, parallelism, opts.Checkpoint)
+	if err != nil {
+		return fmt.Errorf("could not delete objects in bucket %s: %w", bucketName, err)
+	}
+
+	// The objects before the checkpoint of a previous deletion were either deleted or have a temporary hold, hence they
+	// are listed again, so that objects with a temporary hold are also found if the deletion was resumed.
+	if opts.StartOffset != "" {
+		heldBefore, err := deleteObjectsInRange(ctx, bucketHandle, &storage.Query{Prefix: prefix, EndOffset: opts.StartOffset}, parallelism, nil)
+		if err != nil {
+			return fmt.Errorf("could not delete objects in bucket %s: %w", bucketName, err)
+		}
+		held = append(heldBefore, held...)
+	}
+
+	if len(held) > 0 {
+		return fmt.Errorf("objects in bucket %s cannot be deleted as they have a temporary hold: %s", bucketName, strings.Join(held, ", "))
+	}
+	return nil
+}
+
+// deleteObjectsInRange deletes the objects matching the given query page by page and returns the names of the objects
+// with a temporary hold, which are skipped. The name of the last object of each page is passed to the given checkpoint
+// function, if it is set.
+func deleteObjectsInRange(
+	ctx context.Context,
+	bucketHandle *storage.BucketHandle,
+	query *storage.Query,
+	parallelism int,
+	checkpoint func(ctx context.Context, startOffset string) error,
+) ([]string, error) {
+	query.Versions = true
+	if err := query.SetAttrSelection([]string{"Name", "Generation", "EventBasedHold", "TemporaryHold"}); err != nil {
+		return nil, err
+	}
+
+	var (
+		held  []string
+		pager = iterator.NewPager(bucketHandle.Objects(ctx, query), deletePageSize, "")
+	)
 	for {
-		attr, err := itr.Next()
+		var page []*storage.ObjectAttrs
+		pageToken, err := pager.NextPage(&page)
 		if err != nil {
-			if err == iterator.Done {
-				return nil
+			return nil, err
+		}
+
+		var objects []*storage.ObjectAttrs
+		for _, attr := range page {
+			if attr.TemporaryHold {
+				held = append(held, attr.Name)
+				continue
 			}
-			return err
+			objects = append(objects, attr)
 		}
-		if err := bucketHandle.Object(attr.Name).Delete(ctx); err != nil && err != storage.ErrObjectNotExist {
-			return err
+		if err := deleteObjects(ctx, bucketHandle, objects, parallelism); err != nil {
+			return nil, err
 		}
+
+		// Objects with a temporary hold are tracked separately, hence the checkpoint also advances beyond them.
+		if len(page) > 0 && checkpoint != nil {
+			if err := checkpoint(ctx, page[len(page)-1].Name); err != nil {
+				return nil, err
+			}
+		}
+		if pageToken == "" {
+			return held, nil
+		}
+	}
+}
+
+// deleteObjects deletes the given objects with at most the given number of concurrent requests.
+func deleteObjects(ctx context.Context, bucketHandle *storage.BucketHandle, objects []*storage.ObjectAttrs, parallelism int) error {
+	var (
+		wg        sync.WaitGroup
+		mutex     sync.Mutex
+		errs      []error
+		semaphore = make(chan struct{}, parallelism)
+	)
+
+	for _, attr := range objects {
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := deleteObject(ctx, bucketHandle, attr); err != nil {
+				mutex.Lock()
+				errs = append(errs, err)
+				mutex.Unlock()
+			}
+		}()
 	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func deleteObject(ctx context.Context, bucketHandle *storage.BucketHandle, attr *storage.ObjectAttrs) error {
+	// Without generation, deleting an object of a bucket with object versioning only makes it a noncurrent version.
+	objectHandle := bucketHandle.Object(attr.Name).Generation(attr.Generation)
+	if attr.EventBasedHold {
+		if _, err := objectHandle.Update(ctx, storage.ObjectAttrsToUpdate{EventBasedHold: false}); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return fmt.Errorf("could not release event-based hold of object %s: %w", attr.Name, err)
+		}
+	}
+	if err := objectHandle.Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("could not delete object %s: %w", attr.Name, err)
+	}
+	return nil
 }
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
+)
+
+var _ = Describe("StorageClient", func() {
+	const (
+		project = "project"
+		bucket  = "bucket"
+		prefix  = "entry/"
+	)
+
+	var (
+		ctx    = context.Background()
+		server *fake.Server
+
+		storageClient gcpclient.StorageClient
+		checkpoints   []string
+		opts          gcpclient.DeleteObjectsOptions
+	)
+
+	BeforeEach(func() {
+		var err error
+		server, err = fake.NewServer()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(server.Close)
+
+		serviceAccount, err := gcp.GetServiceAccountFromJSON(server.ServiceAccountJSON(project))
+		Expect(err).NotTo(HaveOccurred())
+		storageClient, err = gcpclient.NewStorageClient(ctx, serviceAccount, server.ClientOptions()...)
+		Expect(err).NotTo(HaveOccurred())
+
+		checkpoints = nil
+		opts = gcpclient.DeleteObjectsOptions{
+			Checkpoint: func(_ context.Context, checkpoint string) error {
+				checkpoints = append(checkpoints, checkpoint)
+				return nil
+			},
+		}
+	})
+
+	objectName := func(i int) string {
+		return fmt.Sprintf("%s%04d", prefix, i)
+	}
+
+	Describe("#DeleteObjectsWithPrefix", func() {
+		It("should delete all objects with the prefix page by page", func() {
+			for i := range 2500 {
+				server.PutObject(bucket, objectName(i), nil)
+			}
+			server.PutObject(bucket, "other/0000", nil)
+
+			Expect(storageClient.DeleteObjectsWithPrefix(ctx, bucket, prefix, opts)).To(Succeed())
+			Expect(server.Objects(bucket)).To(Equal([]string{"other/0000"}))
+			Expect(checkpoints).To(Equal([]string{objectName(999), objectName(1999), objectName(2499)}))
+		})
+
+		It("should delete noncurrent versions and release event-based holds", func() {
+			server.PutObject(bucket, objectName(0), nil)
+			server.PutObject(bucket, objectName(0), nil)
+			server.PutObject(bucket, objectName(1), map[string]any{"eventBasedHold": true})
+
+			Expect(storageClient.DeleteObjectsWithPrefix(ctx, bucket, prefix, opts)).To(Succeed())
+			Expect(server.Objects(bucket)).To(BeEmpty())
+		})
+
+		It("should skip objects with a temporary hold and advance the checkpoint beyond them", func() {
+			for i := range 1500 {
+				server.PutObject(bucket, objectName(i), map[string]any{"temporaryHold": i == 10})
+			}
+
+			err := storageClient.DeleteObjectsWithPrefix(ctx, bucket, prefix, opts)
+			Expect(err).To(MatchError(ContainSubstring("temporary hold: " + objectName(10))))
+			Expect(server.Objects(bucket)).To(Equal([]string{objectName(10)}))
+			Expect(checkpoints).To(Equal([]string{objectName(999), objectName(1499)}))
+		})
+
+		It("should resume the deletion at the checkpoint and find objects with a temporary hold before it", func() {
+			server.PutObject(bucket, objectName(0), map[string]any{"temporaryHold": true})
+			for i := 5; i < 10; i++ {
+				server.PutObject(bucket, objectName(i), nil)
+			}
+
+			opts.StartOffset = objectName(5)
+			err := storageClient.DeleteObjectsWithPrefix(ctx, bucket, prefix, opts)
+			Expect(err).To(MatchError(ContainSubstring("temporary hold: " + objectName(0))))
+			Expect(server.Objects(bucket)).To(Equal([]string{objectName(0)}))
+			Expect(checkpoints).To(Equal([]string{objectName(9)}))
+		})
+
+		It("should delete remaining objects before the checkpoint when resuming", func() {
+			server.PutObject(bucket, objectName(0), nil)
+			server.PutObject(bucket, objectName(5), nil)
+
+			opts.StartOffset = objectName(5)
+			Expect(storageClient.DeleteObjectsWithPrefix(ctx, bucket, prefix, opts)).To(Succeed())
+			Expect(server.Objects(bucket)).To(BeEmpty())
+			Expect(checkpoints).To(Equal([]string{objectName(5)}))
+		})
+
+		It("should return the errors of all objects of a page and not advance the checkpoint", func() {
+			for i := range 5 {
+				server.PutObject(bucket, objectName(i), nil)
+			}
+			server.Fail(http.MethodDelete, "/storage/v1/b/"+bucket+"/o/"+objectName(1), http.StatusForbidden)
+			server.Fail(http.MethodDelete, "/storage/v1/b/"+bucket+"/o/"+objectName(3), http.StatusForbidden)
+
+			err := storageClient.DeleteObjectsWithPrefix(ctx, bucket, prefix, opts)
+			Expect(err).To(MatchError(And(
+				ContainSubstring("could not delete object "+objectName(1)),
+				ContainSubstring("could not delete object "+objectName(3)),
+			)))
+			Expect(server.Objects(bucket)).To(Equal([]string{objectName(1), objectName(3)}))
+			Expect(checkpoints).To(BeEmpty())
+		})
+
+		It("should return the error of the checkpoint", func() {
+			server.PutObject(bucket, objectName(0), nil)
+			opts.Checkpoint = func(context.Context, string) error { return fmt.Errorf("checkpoint failed") }
+
+			Expect(storageClient.DeleteObjectsWithPrefix(ctx, bucket, prefix, opts)).To(MatchError(ContainSubstring("checkpoint failed")))
+		})
+	})
+})
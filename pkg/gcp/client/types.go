@@ -6,13 +6,20 @@
 package client
 
 import (
+	"cloud.google.com/go/storage"
 	compute "google.golang.org/api/compute/v1"
+	dns "google.golang.org/api/dns/v1"
 	iam "google.golang.org/api/iam/v1"
+	"google.golang.org/api/logging/v2"
+	"google.golang.org/api/networkconnectivity/v1"
 )
 
 // Network is a type alias for the GCP client type.
 type Network = compute.Network
 
+// NetworkPeering is a type alias for the GCP client type.
+type NetworkPeering = compute.NetworkPeering
+
 // Subnetwork is a type alias for the GCP client type.
 type Subnetwork = compute.Subnetwork
 
@@ -28,8 +35,53 @@ type RouterNat = compute.RouterNat
 // Firewall is a type alias for the GCP client type.
 type Firewall = compute.Firewall
 
+// FirewallPolicy is a type alias for the GCP client type.
+type FirewallPolicy = compute.FirewallPolicy
+
+// FirewallPolicyRule is a type alias for the GCP client type.
+type FirewallPolicyRule = compute.FirewallPolicyRule
+
+// FirewallPolicyAssociation is a type alias for the GCP client type.
+type FirewallPolicyAssociation = compute.FirewallPolicyAssociation
+
+// PacketMirroring is a type alias for the GCP client type.
+type PacketMirroring = compute.PacketMirroring
+
 // Address is a type alias for the GCP client type.
 type Address = compute.Address
 
+// ForwardingRule is a type alias for the GCP client type.
+type ForwardingRule = compute.ForwardingRule
+
+// Instance is a type alias for the GCP client type.
+type Instance = compute.Instance
+
+// Disk is a type alias for the GCP client type.
+type Disk = compute.Disk
+
+// Snapshot is a type alias for the GCP client type.
+type Snapshot = compute.Snapshot
+
+// Region is a type alias for the GCP client type.
+type Region = compute.Region
+
+// Quota is a type alias for the GCP client type.
+type Quota = compute.Quota
+
 // ServiceAccount is a type alias for the GCP client type.
 type ServiceAccount = iam.ServiceAccount
+
+// ManagedZone is a type alias for the GCP client type.
+type ManagedZone = dns.ManagedZone
+
+// RoutingPolicy is a type alias for the GCP client type.
+type RoutingPolicy = dns.RRSetRoutingPolicy
+
+// Spoke is a type alias for the GCP client type.
+type Spoke = networkconnectivity.Spoke
+
+// LogSink is a type alias for the GCP client type.
+type LogSink = logging.LogSink
+
+// LifecycleRule is a type alias for the GCP client type.
+type LifecycleRule = storage.LifecycleRule
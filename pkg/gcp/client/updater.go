@@ -52,11 +52,18 @@ func (u *updater) VPC(ctx context.Context, compute ComputeClient, desired, curre
 	if desired.AutoCreateSubnetworks != current.AutoCreateSubnetworks {
 		return nil, NewInvalidUpdateError("AutoCreateSubnetworks")
 	}
+	if desired.Mtu != 0 && desired.Mtu != current.Mtu {
+		return nil, NewInvalidUpdateError("Mtu")
+	}
 	// dismiss non-functional changes that may block update
 	desired.Description = ""
 
 	modified := false
-	if desired.RoutingConfig != current.RoutingConfig {
+	if routingMode(desired) != routingMode(current) {
+		modified = true
+	}
+	// the ULA internal IPv6 range can only be enabled, but never disabled again
+	if desired.EnableUlaInternalIpv6 && !current.EnableUlaInternalIpv6 {
 		modified = true
 	}
 
@@ -68,6 +75,13 @@ func (u *updater) VPC(ctx context.Context, compute ComputeClient, desired, curre
 	return compute.PatchNetwork(ctx, current.Name, desired)
 }
 
+func routingMode(network *compute.Network) string {
+	if network.RoutingConfig == nil {
+		return ""
+	}
+	return network.RoutingConfig.RoutingMode
+}
+
 func (u *updater) Subnet(ctx context.Context, client ComputeClient, region string, desired, current *compute.Subnetwork) (*compute.Subnetwork, error) {
 	var (
 		err error
@@ -106,14 +120,35 @@ func (u *updater) Subnet(ctx context.Context, client ComputeClient, region strin
 		}
 	}
 
+	// Private Google Access can only be changed by a dedicated call.
+	if desired.PrivateIpGoogleAccess != current.PrivateIpGoogleAccess {
+		u.log.Info("updating subnet Private Google Access", "Name", current.Name)
+		current, err = client.SetSubnetPrivateIpGoogleAccess(ctx, region, current.Name, desired.PrivateIpGoogleAccess)
+		if err != nil {
+			u.log.Error(err, "failed subnet Private Google Access update")
+			return nil, err
+		}
+	}
+
 	modified := false
-	if desired.LogConfig != current.LogConfig {
+	if !subnetLogConfigEqual(desired.LogConfig, current.LogConfig) {
 		modified = true
 		if desired.LogConfig == nil {
 			desired.NullFields = []string{"LogConfig"}
 		}
 	}
 
+	if desired.StackType != "" && desired.StackType != current.StackType {
+		modified = true
+	}
+
+	if !secondaryRangesEqual(desired.SecondaryIpRanges, current.SecondaryIpRanges) {
+		modified = true
+		if len(desired.SecondaryIpRanges) == 0 {
+			desired.ForceSendFields = append(desired.ForceSendFields, "SecondaryIpRanges")
+		}
+	}
+
 	if !modified {
 		return current, nil
 	}
@@ -124,6 +159,33 @@ func (u *updater) Subnet(ctx context.Context, client ComputeClient, region strin
 	return client.PatchSubnet(ctx, region, current.Name, desired)
 }
 
+// secondaryRangesEqual compares the secondary ranges of two subnets by their name and CIDR, ignoring their order.
+func secondaryRangesEqual(a, b []*compute.SubnetworkSecondaryRange) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	ranges := make(map[string]string, len(a))
+	for _, r := range a {
+		ranges[r.RangeName] = r.IpCidrRange
+	}
+	for _, r := range b {
+		if cidr, ok := ranges[r.RangeName]; !ok || cidr != r.IpCidrRange {
+			return false
+		}
+	}
+
+	return true
+}
+
+// subnetLogConfigEqual compares the flow log settings managed by the extension.
+func subnetLogConfigEqual(a, b *compute.SubnetworkLogConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.AggregationInterval == b.AggregationInterval && a.FlowSampling == b.FlowSampling && a.Metadata == b.Metadata
+}
+
 func (u *updater) Router(ctx context.Context, client ComputeClient, region string, desired, current *compute.Router) (*compute.Router, error) {
 	// While Nats can and should be updated via the router API, we want to handle Nats as a separate resource and allow
 	// updates to Nats only via the specialized methods. Therefor, we will deny updates to Nats via this function call.
@@ -136,6 +198,9 @@ func (u *updater) Router(ctx context.Context, client ComputeClient, region strin
 		modified = true
 		if desired.Bgp == nil {
 			nullFields = append(nullFields, "Bgp")
+		} else {
+			// empty lists are omitted by default, hence they are sent explicitly to remove previously advertised routes.
+			desired.Bgp.ForceSendFields = append(desired.Bgp.ForceSendFields, "AdvertisedGroups", "AdvertisedIpRanges")
 		}
 	}
 	if !reflect.DeepEqual(desired.BgpPeers, current.BgpPeers) {
@@ -148,7 +213,7 @@ func (u *updater) Router(ctx context.Context, client ComputeClient, region strin
 	if modified {
 		desired.ForceSendFields = forceSendFields
 		desired.NullFields = nullFields
-		return client.PatchRouter(ctx, region, current.Name, current)
+		return client.PatchRouter(ctx, region, current.Name, desired)
 	}
 	return current, nil
 }
@@ -188,6 +253,9 @@ func (u *updater) NAT(ctx context.Context, client ComputeClient, region string,
 	if desired.UdpIdleTimeoutSec == 0 {
 		desired.ForceSendFields = append(desired.ForceSendFields, "UdpIdleTimeoutSec")
 	}
+	if desired.LogConfig != nil && !desired.LogConfig.Enable {
+		desired.LogConfig.ForceSendFields = append(desired.LogConfig.ForceSendFields, "Enable")
+	}
 	if len(desired.NatIps) == 0 {
 		desired.NullFields = append(desired.NullFields, "NatIps")
 	}
@@ -195,6 +263,10 @@ func (u *updater) NAT(ctx context.Context, client ComputeClient, region string,
 	index := slices.IndexFunc(router.Nats, func(nat *compute.RouterNat) bool {
 		return nat.Name == desired.Name
 	})
+	// drained IPs which are not drained anymore have to be removed explicitly
+	if len(desired.DrainNatIps) == 0 && index >= 0 && len(router.Nats[index].DrainNatIps) > 0 {
+		desired.NullFields = append(desired.NullFields, "DrainNatIps")
+	}
 
 	modified := false
 	// not found case
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// STSTokenURL is the token URL of the Security Token Service, which exchanges the subject tokens of workload identity
+// federation credentials for access tokens.
+const STSTokenURL = "https://sts.googleapis.com/v1/token"
+
+// impersonationURLRegex matches the URLs of the impersonation of service accounts by external accounts.
+var impersonationURLRegex = regexp.MustCompile(`^https://iamcredentials\.googleapis\.com/v1/projects/-/serviceAccounts/([^/:]+):generateAccessToken$`)
+
+// ExternalAccountConfig is the configuration of workload identity federation credentials of type `external_account`.
+// The token URL and the credential source of the credentials are not part of the configuration, as they could make the
+// extension send the subject token to or read it from arbitrary locations. The subject token is stored next to the
+// credentials in the secret instead.
+type ExternalAccountConfig struct {
+	// Audience is the audience of the workload identity pool provider.
+	Audience string `json:"audience"`
+	// SubjectTokenType is the type of the subject token.
+	SubjectTokenType string `json:"subject_token_type"`
+	// ServiceAccountImpersonationURL is the URL of the impersonation of the service account, if the external account
+	// impersonates a service account instead of accessing resources directly.
+	ServiceAccountImpersonationURL string `json:"service_account_impersonation_url,omitempty"`
+}
+
+// ParseExternalAccountConfig parses the configuration of the given workload identity federation credentials.
+func ParseExternalAccountConfig(data []byte) (*ExternalAccountConfig, error) {
+	config := &ExternalAccountConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+
+	if config.Audience == "" {
+		return nil, fmt.Errorf("the audience of the external account must be set")
+	}
+	if config.SubjectTokenType == "" {
+		return nil, fmt.Errorf("the subject token type of the external account must be set")
+	}
+	if config.ServiceAccountImpersonationURL != "" && !impersonationURLRegex.MatchString(config.ServiceAccountImpersonationURL) {
+		return nil, fmt.Errorf("service account impersonation URL %q is not allowed, it must match %q", config.ServiceAccountImpersonationURL, impersonationURLRegex)
+	}
+	return config, nil
+}
+
+// ImpersonatedServiceAccount returns the email of the service account impersonated by the external account or an empty
+// string if no service account is impersonated.
+func (c *ExternalAccountConfig) ImpersonatedServiceAccount() string {
+	if match := impersonationURLRegex.FindStringSubmatch(c.ServiceAccountImpersonationURL); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// ETCDCredentials returns the workload identity federation credentials for etcd-backup-restore, which read the subject
+// token from the backup secret mounted into the etcd pods.
+func (c *ExternalAccountConfig) ETCDCredentials() ([]byte, error) {
+	credentials := map[string]any{
+		"type":               ExternalAccountCredentialType,
+		"audience":           c.Audience,
+		"subject_token_type": c.SubjectTokenType,
+		"token_url":          STSTokenURL,
+		"credential_source": map[string]any{
+			"file": ETCDBackupSecretMountPath + WorkloadIdentityTokenField,
+		},
+	}
+	if c.ServiceAccountImpersonationURL != "" {
+		credentials["service_account_impersonation_url"] = c.ServiceAccountImpersonationURL
+	}
+	return json.Marshal(credentials)
+}
+
+// SubjectTokenExpiry returns the expiration time of the given subject token if it is a JWT. The signature of the token
+// is not verified, as the expiration time is only used to schedule the rotation of the token. A zero time is returned
+// if the token is no JWT or has no expiration time.
+func SubjectTokenExpiry(token []byte) time.Time {
+	segments := strings.Split(strings.TrimSpace(string(token)), ".")
+	if len(segments) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		ExpiresAt int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.ExpiresAt, 0)
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	"encoding/base64"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("External account", func() {
+	const impersonationURL = "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/backup@project.iam.gserviceaccount.com:generateAccessToken"
+
+	Describe("#ParseExternalAccountConfig", func() {
+		It("should parse the configuration and the impersonated service account", func() {
+			config, err := ParseExternalAccountConfig([]byte(`{
+"type": "external_account",
+"audience": "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider",
+"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+"token_url": "https://example.com/token",
+"credential_source": {"file": "/etc/passwd"},
+"service_account_impersonation_url": "` + impersonationURL + `"
+}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(&ExternalAccountConfig{
+				Audience:                       "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider",
+				SubjectTokenType:               "urn:ietf:params:oauth:token-type:jwt",
+				ServiceAccountImpersonationURL: impersonationURL,
+			}))
+			Expect(config.ImpersonatedServiceAccount()).To(Equal("backup@project.iam.gserviceaccount.com"))
+		})
+
+		It("should forbid impersonation URLs of other hosts", func() {
+			_, err := ParseExternalAccountConfig([]byte(`{"audience": "aud", "subject_token_type": "jwt", "service_account_impersonation_url": "https://example.com/v1/projects/-/serviceAccounts/foo:generateAccessToken"}`))
+			Expect(err).To(MatchError(ContainSubstring("is not allowed")))
+		})
+
+		It("should require the audience", func() {
+			_, err := ParseExternalAccountConfig([]byte(`{"subject_token_type": "jwt"}`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#ETCDCredentials", func() {
+		It("should read the subject token from the mounted backup secret", func() {
+			config := &ExternalAccountConfig{
+				Audience:                       "aud",
+				SubjectTokenType:               "urn:ietf:params:oauth:token-type:jwt",
+				ServiceAccountImpersonationURL: impersonationURL,
+			}
+
+			credentials, err := config.ETCDCredentials()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials).To(MatchJSON(`{
+"type": "external_account",
+"audience": "aud",
+"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+"token_url": "https://sts.googleapis.com/v1/token",
+"credential_source": {"file": "/var/.gcp/token"},
+"service_account_impersonation_url": "` + impersonationURL + `"
+}`))
+		})
+	})
+
+	Describe("#SubjectTokenExpiry", func() {
+		jwt := func(payload string) []byte {
+			return []byte("eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature")
+		}
+
+		It("should return the expiration time of a JWT", func() {
+			Expect(SubjectTokenExpiry(jwt(`{"sub": "seed", "exp": 1700000000}`))).To(Equal(time.Unix(1700000000, 0)))
+		})
+
+		It("should return the zero time for a JWT without expiration time", func() {
+			Expect(SubjectTokenExpiry(jwt(`{"sub": "seed"}`))).To(BeZero())
+		})
+
+		It("should return the zero time for opaque tokens", func() {
+			Expect(SubjectTokenExpiry([]byte("opaque-token"))).To(BeZero())
+		})
+	})
+})
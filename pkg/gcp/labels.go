@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	"maps"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// LabelKeyClusterName is the key of the label of GCP resources which holds the technical ID of the shoot.
+	LabelKeyClusterName = "k8s-cluster-name"
+	// LabelKeyShootName is the key of the label of GCP resources which holds the name of the shoot.
+	LabelKeyShootName = "gardener-shoot"
+	// LabelKeyProjectName is the key of the label of GCP resources which holds the name of the project of the shoot.
+	LabelKeyProjectName = "gardener-project"
+)
+
+// ShootLabels returns the labels which identify the given shoot on its GCP resources, i.e. the names of the shoot and
+// of its project. It returns nil if the shoot is unknown.
+func ShootLabels(shoot *gardencorev1beta1.Shoot) map[string]string {
+	if shoot == nil || shoot.Name == "" {
+		return nil
+	}
+
+	// Shoots are created in the namespace `garden-<project>` of their project, except for the shoots of the garden
+	// project.
+	project := shoot.Namespace
+	if project != "garden" {
+		project = strings.TrimPrefix(project, "garden-")
+	}
+
+	return map[string]string{
+		LabelKeyShootName:   shoot.Name,
+		LabelKeyProjectName: project,
+	}
+}
+
+// ResourceLabels returns the labels of the GCP resources of the infrastructure of a shoot with the given technical ID,
+// i.e. the given resource labels and the labels identifying the shoot, which take precedence.
+func ResourceLabels(technicalID string, shoot *gardencorev1beta1.Shoot, resourceLabels map[string]string) map[string]string {
+	labels := maps.Clone(resourceLabels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	maps.Copy(labels, ShootLabels(shoot))
+	labels[LabelKeyClusterName] = technicalID
+	return labels
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Labels", func() {
+	Describe("#ShootLabels", func() {
+		It("should return the names of the shoot and its project", func() {
+			shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "foo"}}
+			Expect(ShootLabels(shoot)).To(Equal(map[string]string{"gardener-shoot": "foo", "gardener-project": "dev"}))
+		})
+
+		It("should return the garden project for shoots in the garden namespace", func() {
+			shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "foo"}}
+			Expect(ShootLabels(shoot)).To(HaveKeyWithValue("gardener-project", "garden"))
+		})
+
+		It("should return nil for unknown shoots", func() {
+			Expect(ShootLabels(nil)).To(BeNil())
+			Expect(ShootLabels(&gardencorev1beta1.Shoot{})).To(BeNil())
+		})
+	})
+
+	Describe("#ResourceLabels", func() {
+		It("should add the labels identifying the shoot to the resource labels", func() {
+			shoot := &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "foo"}}
+			resourceLabels := map[string]string{"cost-center": "cc-1234", "gardener-shoot": "bar"}
+
+			Expect(ResourceLabels("shoot--dev--foo", shoot, resourceLabels)).To(Equal(map[string]string{
+				"cost-center":      "cc-1234",
+				"gardener-shoot":   "foo",
+				"gardener-project": "dev",
+				"k8s-cluster-name": "shoot--dev--foo",
+			}))
+			Expect(resourceLabels).To(HaveKeyWithValue("gardener-shoot", "bar"))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// MetadataServerIPv6 is the IPv6 address of the GCE metadata server which is reachable from IPv6-only instances.
+	MetadataServerIPv6 = "fd20:ce::254"
+
+	// StackTypeIPv4IPv6 is the stack type of dual-stack subnets.
+	StackTypeIPv4IPv6 = "IPV4_IPV6"
+	// StackTypeIPv6Only is the stack type of IPv6-only network interfaces.
+	StackTypeIPv6Only = "IPV6_ONLY"
+	// IPv6AccessTypeExternal is the IPv6 access type of subnets with an external IPv6 range.
+	IPv6AccessTypeExternal = "EXTERNAL"
+	// IPv6AccessTypeInternal is the IPv6 access type of subnets with an internal IPv6 range.
+	IPv6AccessTypeInternal = "INTERNAL"
+	// SubnetPurposeRegionalManagedProxy is the purpose of proxy-only subnets used by regional Envoy-based load balancers.
+	SubnetPurposeRegionalManagedProxy = "REGIONAL_MANAGED_PROXY"
+	// SubnetRoleActive is the role of the proxy-only subnet which is currently used in a region.
+	SubnetRoleActive = "ACTIVE"
+)
+
+// IsIPv6SingleStack returns true if the given networking configuration only uses the IPv6 IP family.
+func IsIPv6SingleStack(networking *gardencorev1beta1.Networking) bool {
+	return networking != nil &&
+		len(networking.IPFamilies) == 1 &&
+		networking.IPFamilies[0] == gardencorev1beta1.IPFamilyIPv6
+}
+
+// IsDualStack returns true if the given networking configuration uses the IPv4 and the IPv6 IP family. Only IPv4 is
+// supported as primary IP family of dual-stack shoots.
+func IsDualStack(networking *gardencorev1beta1.Networking) bool {
+	return networking != nil &&
+		len(networking.IPFamilies) == 2 &&
+		networking.IPFamilies[0] == gardencorev1beta1.IPFamilyIPv4 &&
+		networking.IPFamilies[1] == gardencorev1beta1.IPFamilyIPv6
+}
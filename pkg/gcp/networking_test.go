@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Networking", func() {
+	DescribeTable("#IsIPv6SingleStack",
+		func(networking *gardencorev1beta1.Networking, expected bool) {
+			Expect(IsIPv6SingleStack(networking)).To(Equal(expected))
+		},
+		Entry("no networking", nil, false),
+		Entry("no IP families", &gardencorev1beta1.Networking{}, false),
+		Entry("IPv4", &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}}, false),
+		Entry("IPv6", &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}}, true),
+		Entry("dual-stack", &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}}, false),
+	)
+
+	DescribeTable("#IsDualStack",
+		func(networking *gardencorev1beta1.Networking, expected bool) {
+			Expect(IsDualStack(networking)).To(Equal(expected))
+		},
+		Entry("no networking", nil, false),
+		Entry("IPv4", &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}}, false),
+		Entry("IPv6", &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}}, false),
+		Entry("dual-stack", &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}}, true),
+		Entry("dual-stack with IPv6 as primary IP family", &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6, gardencorev1beta1.IPFamilyIPv4}}, false),
+	)
+})
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProviderIDPrefix is the prefix of the provider IDs of GCP machines.
+const ProviderIDPrefix = "gce://"
+
+// ParseProviderID parses provider IDs of the form gce://<project>/<zone>/<instance> and returns the zone and the
+// instance name.
+func ParseProviderID(providerID string) (string, string, error) {
+	if !strings.HasPrefix(providerID, ProviderIDPrefix) {
+		return "", "", fmt.Errorf("provider ID %q does not have prefix %q", providerID, ProviderIDPrefix)
+	}
+
+	parts := strings.Split(strings.TrimLeft(strings.TrimPrefix(providerID, ProviderIDPrefix), "/"), "/")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("provider ID %q is not of the form %s<project>/<zone>/<instance>", providerID, ProviderIDPrefix)
+	}
+
+	return parts[1], parts[2], nil
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gcp
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ProviderID", func() {
+	DescribeTable("#ParseProviderID",
+		func(providerID, expectedZone, expectedInstance string, expectErr bool) {
+			zone, instance, err := ParseProviderID(providerID)
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zone).To(Equal(expectedZone))
+			Expect(instance).To(Equal(expectedInstance))
+		},
+		Entry("valid provider ID", "gce://project/europe-west1-b/instance", "europe-west1-b", "instance", false),
+		Entry("valid provider ID with additional slash", "gce:///project/europe-west1-b/instance", "europe-west1-b", "instance", false),
+		Entry("wrong prefix", "aws:///eu-west-1a/i-123", "", "", true),
+		Entry("missing instance", "gce://project/europe-west1-b", "", "", true),
+		Entry("empty instance", "gce://project/europe-west1-b/", "", "", true),
+	)
+})
@@ -24,6 +24,8 @@ type ServiceAccount struct {
 	Email string
 	// Type is the type of credentials.
 	Type string
+	// SubjectToken is the token of workload identity federation credentials, which is exchanged for access tokens.
+	SubjectToken string
 }
 
 // GetServiceAccountFromSecretReference retrieves the ServiceAccount from the secret with the given secret reference.
@@ -36,27 +38,101 @@ func GetServiceAccountFromSecretReference(ctx context.Context, c client.Client,
 	return GetServiceAccountFromSecret(secret)
 }
 
-// GetServiceAccountFromSecret retrieves the ServiceAccount from the secret.
+// GetServiceAccountFromSecret retrieves the ServiceAccount from the secret. The project ID is taken from the secret if
+// the credentials of an external account do not contain one.
 func GetServiceAccountFromSecret(secret *corev1.Secret) (*ServiceAccount, error) {
 	data, ok := secret.Data[ServiceAccountJSONField]
 	if !ok {
 		return nil, fmt.Errorf("secret %s/%s doesn't have a service account json (expected field: %q)", secret.Namespace, secret.Name, ServiceAccountJSONField)
 	}
 
-	return GetServiceAccountFromJSON(data)
+	serviceAccount, err := getServiceAccountFromJSON(data, string(secret.Data[ProjectIDField]))
+	if err != nil {
+		return nil, err
+	}
+
+	if serviceAccount.Type == ExternalAccountCredentialType {
+		token, ok := secret.Data[WorkloadIdentityTokenField]
+		if !ok || len(token) == 0 {
+			return nil, fmt.Errorf("secret %s/%s doesn't have a token of the external account (expected field: %q)", secret.Namespace, secret.Name, WorkloadIdentityTokenField)
+		}
+		serviceAccount.SubjectToken = string(token)
+	}
+	return serviceAccount, nil
+}
+
+// GetDNSServiceAccountFromSecretReference retrieves the ServiceAccount for Cloud DNS from the secret with the given
+// secret reference.
+func GetDNSServiceAccountFromSecretReference(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (*ServiceAccount, error) {
+	secret, err := extensionscontroller.GetSecretByReference(ctx, c, &secretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetDNSServiceAccountFromSecret(secret)
+}
+
+// GetDNSServiceAccountFromSecret retrieves the ServiceAccount for Cloud DNS from the secret. The credentials of a
+// dedicated DNS service account are used if the secret contains them, and the project ID is replaced by the project of
+// the DNS managed zones if the secret contains it, so that the zones can be hosted in another project.
+func GetDNSServiceAccountFromSecret(secret *corev1.Secret) (*ServiceAccount, error) {
+	dnsProjectID := string(secret.Data[DNSProjectIDField])
+
+	var (
+		serviceAccount *ServiceAccount
+		err            error
+	)
+	if data, ok := secret.Data[DNSServiceAccountJSONField]; ok {
+		if serviceAccount, err = getServiceAccountFromJSON(data, dnsProjectID); err != nil {
+			return nil, err
+		}
+		if serviceAccount.Type == ExternalAccountCredentialType {
+			return nil, fmt.Errorf("secret %s/%s must not contain credentials of an external account in field %q", secret.Namespace, secret.Name, DNSServiceAccountJSONField)
+		}
+	} else if serviceAccount, err = GetServiceAccountFromSecret(secret); err != nil {
+		return nil, err
+	}
+
+	if dnsProjectID != "" {
+		serviceAccount.ProjectID = dnsProjectID
+	}
+	return serviceAccount, nil
 }
 
 // GetServiceAccountFromJSON returns a ServiceAccount from the given
 func GetServiceAccountFromJSON(data []byte) (*ServiceAccount, error) {
+	return getServiceAccountFromJSON(data, "")
+}
+
+func getServiceAccountFromJSON(data []byte, projectID string) (*ServiceAccount, error) {
 	var serviceAccount struct {
-		ProjectID string `json:"project_id"`
-		Email     string `json:"client_email"`
-		Type      string `json:"type"`
+		ProjectID      string `json:"project_id"`
+		QuotaProjectID string `json:"quota_project_id"`
+		Email          string `json:"client_email"`
+		Type           string `json:"type"`
 	}
 
 	if err := json.Unmarshal(data, &serviceAccount); err != nil {
 		return nil, err
 	}
+
+	// Only the credentials of external accounts, which are rewritten for etcd-backup-restore, may lack the project.
+	// Credentials of other types are read as they are by other components, e.g. machine-controller-manager.
+	if serviceAccount.ProjectID == "" && serviceAccount.Type == ExternalAccountCredentialType {
+		if projectID == "" {
+			projectID = serviceAccount.QuotaProjectID
+		}
+		if projectID != "" {
+			var err error
+			if data, err = withProjectID(data, projectID); err != nil {
+				return nil, err
+			}
+			serviceAccount.ProjectID = projectID
+		}
+	}
+	if serviceAccount.ProjectID == "" && (serviceAccount.Type == AuthorizedUserCredentialType || serviceAccount.Type == ExternalAccountAuthorizedUserCredentialType) {
+		return nil, fmt.Errorf("credentials of type %q must contain the project (expected field: %q)", serviceAccount.Type, "project_id")
+	}
 	if serviceAccount.ProjectID == "" {
 		return nil, fmt.Errorf("no service account specified")
 	}
@@ -69,6 +145,17 @@ func GetServiceAccountFromJSON(data []byte) (*ServiceAccount, error) {
 	}, nil
 }
 
+// withProjectID adds the given project ID to the given credentials, so that the project is known to the clients created
+// from the credentials.
+func withProjectID(data []byte, projectID string) ([]byte, error) {
+	credentials := map[string]any{}
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return nil, err
+	}
+	credentials["project_id"] = projectID
+	return json.Marshal(credentials)
+}
+
 // readServiceAccountSecret reads the ServiceAccount from the given secret.
 func readServiceAccountSecret(secret *corev1.Secret) ([]byte, error) {
 	data, ok := secret.Data[ServiceAccountJSONField]
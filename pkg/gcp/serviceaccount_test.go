@@ -75,6 +75,112 @@ var _ = Describe("Service Account", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(actual.Raw).To(Equal(serviceAccountData))
 		})
+
+		It("should accept the credentials of a user containing the project", func() {
+			data := []byte(`{"type": "authorized_user", "client_id": "id", "refresh_token": "token", "project_id": "project"}`)
+			secret := &corev1.Secret{Data: map[string][]byte{ServiceAccountJSONField: data}}
+
+			actual, err := GetServiceAccountFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.ProjectID).To(Equal(projectID))
+			Expect(actual.Type).To(Equal(AuthorizedUserCredentialType))
+			Expect(actual.Raw).To(Equal(data))
+		})
+
+		It("should not take the project of the credentials of a user from elsewhere", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				ServiceAccountJSONField: []byte(`{"type": "external_account_authorized_user", "audience": "aud", "quota_project_id": "quota"}`),
+				ProjectIDField:          []byte(projectID),
+			}}
+
+			_, err := GetServiceAccountFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring("project_id")))
+		})
+
+		It("should add the project ID of the secret to the credentials of an external account", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				ServiceAccountJSONField:    []byte(`{"type": "external_account", "audience": "aud", "quota_project_id": "quota"}`),
+				ProjectIDField:             []byte(projectID),
+				WorkloadIdentityTokenField: []byte("subject-token"),
+			}}
+
+			actual, err := GetServiceAccountFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.ProjectID).To(Equal(projectID))
+			Expect(actual.Raw).To(MatchJSON(`{"type": "external_account", "audience": "aud", "quota_project_id": "quota", "project_id": "project"}`))
+		})
+
+		It("should read the subject token of an external account", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				ServiceAccountJSONField:    []byte(`{"type": "external_account", "audience": "aud", "subject_token_type": "urn:ietf:params:oauth:token-type:jwt"}`),
+				ProjectIDField:             []byte(projectID),
+				WorkloadIdentityTokenField: []byte("subject-token"),
+			}}
+
+			actual, err := GetServiceAccountFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.ProjectID).To(Equal(projectID))
+			Expect(actual.Type).To(Equal(ExternalAccountCredentialType))
+			Expect(actual.SubjectToken).To(Equal("subject-token"))
+		})
+
+		It("should fail if the subject token of an external account is missing", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				ServiceAccountJSONField: []byte(`{"type": "external_account", "audience": "aud", "subject_token_type": "urn:ietf:params:oauth:token-type:jwt"}`),
+				ProjectIDField:          []byte(projectID),
+			}}
+
+			_, err := GetServiceAccountFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring(WorkloadIdentityTokenField)))
+		})
+
+		It("should not take the project ID of the secret for service accounts", func() {
+			secret := &corev1.Secret{Data: map[string][]byte{
+				ServiceAccountJSONField: []byte(`{"type": "service_account"}`),
+				ProjectIDField:          []byte(projectID),
+			}}
+
+			_, err := GetServiceAccountFromSecret(secret)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#GetDNSServiceAccountFromSecret", func() {
+		It("should use the service account of the secret", func() {
+			actual, err := GetDNSServiceAccountFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(serviceAccount))
+		})
+
+		It("should use the project of the DNS managed zones", func() {
+			secret.Data[DNSProjectIDField] = []byte("dns-project")
+
+			actual, err := GetDNSServiceAccountFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual.ProjectID).To(Equal("dns-project"))
+			Expect(actual.Raw).To(Equal(serviceAccountData))
+		})
+
+		It("should use the credentials of the dedicated DNS service account", func() {
+			dnsServiceAccountData := []byte(`{"project_id": "dns-project", "client_email": "dns@dns-project.iam.gserviceaccount.com", "type": "service_account"}`)
+			secret.Data[DNSServiceAccountJSONField] = dnsServiceAccountData
+
+			actual, err := GetDNSServiceAccountFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(&ServiceAccount{
+				Raw:       dnsServiceAccountData,
+				ProjectID: "dns-project",
+				Email:     "dns@dns-project.iam.gserviceaccount.com",
+				Type:      ServiceAccountCredentialType,
+			}))
+		})
+
+		It("should forbid the credentials of an external account as dedicated DNS service account", func() {
+			secret.Data[DNSServiceAccountJSONField] = []byte(`{"project_id": "dns-project", "type": "external_account"}`)
+
+			_, err := GetDNSServiceAccountFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring(DNSServiceAccountJSONField)))
+		})
 	})
 
 	Describe("#GetServiceAccountData", func() {
@@ -16,6 +16,8 @@ const (
 	CloudControllerManagerImageName = "cloud-controller-manager"
 	// CSIDriverImageName is the name of the csi-driver image.
 	CSIDriverImageName = "csi-driver"
+	// CSIDriverLatestImageName is the name of the csi-driver image of the latest channel.
+	CSIDriverLatestImageName = "csi-driver-latest"
 	// CSIProvisionerImageName is the name of the csi-provisioner image.
 	CSIProvisionerImageName = "csi-provisioner"
 	// CSIAttacherImageName is the name of the csi-attacher image.
@@ -34,14 +36,39 @@ const (
 	CSILivenessProbeImageName = "csi-liveness-probe"
 	// CSISnapshotValidationWebhookImageName is the name of the csi-snapshot-validation-webhook image.
 	CSISnapshotValidationWebhookImageName = "csi-snapshot-validation-webhook"
+	// CSIFilestoreDriverImageName is the name of the csi-driver-filestore image.
+	CSIFilestoreDriverImageName = "csi-driver-filestore"
 	// MachineControllerManagerProviderGCPImageName is the name of the MachineController GCP image.
 	MachineControllerManagerProviderGCPImageName = "machine-controller-manager-provider-gcp"
+	// FluentBitImageName is the name of the fluent-bit image, which exports the audit logs to Cloud Logging.
+	FluentBitImageName = "fluent-bit"
 
 	// ServiceAccountJSONField is the field in a secret where the service account JSON is stored at.
 	ServiceAccountJSONField = "serviceaccount.json"
 
+	// ProjectIDField is the optional field in a secret where the project ID is stored at if the credentials of an
+	// external account in the service account JSON do not contain a project ID.
+	ProjectIDField = "projectID"
+	// DNSProjectIDField is the optional field in a secret where the ID of the project of the DNS managed zones is
+	// stored at if it is not the project of the credentials.
+	DNSProjectIDField = "dnsProjectID"
+	// DNSServiceAccountJSONField is the optional field in a secret where the service account JSON of a dedicated
+	// service account for Cloud DNS is stored at.
+	DNSServiceAccountJSONField = "dnsServiceAccount.json"
+
 	// ServiceAccountCredentialType is the type of the credentials contained in the serviceaccount.json file.
 	ServiceAccountCredentialType = "service_account"
+	// AuthorizedUserCredentialType is the type of the application default credentials of a user created by gcloud.
+	AuthorizedUserCredentialType = "authorized_user"
+	// ExternalAccountAuthorizedUserCredentialType is the type of the credentials of a user of a workforce identity pool.
+	ExternalAccountAuthorizedUserCredentialType = "external_account_authorized_user"
+	// ExternalAccountCredentialType is the type of the workload identity federation credentials of an external workload.
+	ExternalAccountCredentialType = "external_account"
+	// WorkloadIdentityTokenField is the field in a secret where the subject token of workload identity federation
+	// credentials is stored at.
+	WorkloadIdentityTokenField = "token"
+	// ETCDBackupSecretMountPath is the path at which the backup secret is mounted into the etcd pods by etcd-druid.
+	ETCDBackupSecretMountPath = "/var/.gcp/"
 
 	// CloudControllerManagerName is a constant for the name of the CloudController deployed by the worker controller.
 	CloudControllerManagerName = "cloud-controller-manager"
@@ -71,6 +98,24 @@ const (
 	CSILivenessProbeName = "csi-liveness-probe"
 	// CSISnapshotValidationName is the constant for the name of the csi-snapshot-validation-webhook component.
 	CSISnapshotValidationName = "csi-snapshot-validation"
+	// CSIFilestoreControllerName is a constant for the name of the Filestore CSI controller deployment in the seed.
+	CSIFilestoreControllerName = "csi-driver-filestore-controller"
+	// CSIFilestoreControllerConfigName is a constant for the name of the Filestore CSI controller config in the seed.
+	CSIFilestoreControllerConfigName = "csi-driver-filestore-controller-config"
+	// CSIFilestoreNodeName is a constant for the name of the Filestore CSI node deployment in the shoot.
+	CSIFilestoreNodeName = "csi-driver-filestore-node"
+	// CSIFilestoreProvisioner is the name of the provisioner of the Filestore CSI driver.
+	CSIFilestoreProvisioner = "filestore.csi.storage.gke.io"
+	// InternalLoadBalancerConfigMapName is the name of the ConfigMap in the kube-system namespace of the shoot which
+	// contains the defaults of internal load balancers applied by the shoot webhook.
+	InternalLoadBalancerConfigMapName = "gcp-internal-load-balancer-defaults"
+
+	// AnnotationKeyLoadBalancerType is the annotation of Services of type LoadBalancer which selects internal load
+	// balancers with the value "Internal".
+	AnnotationKeyLoadBalancerType = "networking.gke.io/load-balancer-type"
+	// AnnotationKeyInternalLoadBalancerGlobalAccess is the annotation of Services of type LoadBalancer which controls
+	// if an internal load balancer can be accessed from all regions.
+	AnnotationKeyInternalLoadBalancerGlobalAccess = "networking.gke.io/internal-load-balancer-allow-global-access"
 
 	// AnnotationKeyUseFlow marks how the infrastructure should be reconciled. When this is used reconciliation with flow
 	// will take place. Otherwrise, Terraformer will be used.
@@ -80,6 +125,37 @@ const (
 	SeedLabelKeyUseFlow = AnnotationKeyUseFlow
 	// SeedLabelUseFlowValueNew is the value to restrict flow reconciliation to new shoot clusters
 	SeedLabelUseFlowValueNew = "new"
+
+	// AnnotationKeyInternalLoadBalancerIP is the annotation on the namespace of an istio ingress gateway which holds the
+	// IP address allocated for the internal load balancer of the gateway.
+	AnnotationKeyInternalLoadBalancerIP = "gcp.provider.extensions.gardener.cloud/internal-load-balancer-ip"
+
+	// AnnotationKeyRotateNatIPs is the annotation on the Infrastructure or Shoot which requests a gradual rotation of
+	// the static NAT IPs. A new rotation is started whenever the value of the annotation changes.
+	AnnotationKeyRotateNatIPs = "gcp.provider.extensions.gardener.cloud/rotate-nat-ips"
+
+	// AnnotationKeyReconcile is the annotation on the Infrastructure which restricts the next reconciliation to the given
+	// comma-separated subsystems, e.g. `firewalls`. The annotation is removed after a successful reconciliation.
+	AnnotationKeyReconcile = "gcp.provider.extensions.gardener.cloud/reconcile"
+
+	// AnnotationKeyDeleteOrphanedResources is the annotation on the Infrastructure or Shoot which lets the extension
+	// delete the orphaned resources of the shoot found by the infrastructure reconciliation instead of only reporting them.
+	AnnotationKeyDeleteOrphanedResources = "gcp.provider.extensions.gardener.cloud/delete-orphaned-resources"
+
+	// AnnotationKeyPlan is the annotation on the Infrastructure which puts its reconciliation into plan mode, i.e. the
+	// operations which the reconciliation would perform are published in the provider status instead of being performed.
+	AnnotationKeyPlan = "gcp.provider.extensions.gardener.cloud/plan"
+
+	// AnnotationKeyMigrateToFlow is the annotation on the Infrastructure or the Shoot which requests the migration of
+	// an infrastructure reconciled by Terraformer to the flow reconciliation. The value "verify" only reports the
+	// discrepancies between the Terraformer state and the resources in GCP without switching the reconciliation.
+	AnnotationKeyMigrateToFlow = "gcp.provider.extensions.gardener.cloud/migrate-to-flow"
+	// MigrateToFlowVerify is the value of the AnnotationKeyMigrateToFlow annotation which only verifies the migration.
+	MigrateToFlowVerify = "verify"
+
+	// AnnotationKeyDeletionCheckpoint is the annotation on the BackupEntry which holds the name of the last object
+	// which was deleted from the backup bucket, so that the deletion is resumed there after a restart of the controller.
+	AnnotationKeyDeletionCheckpoint = "gcp.provider.extensions.gardener.cloud/deletion-checkpoint"
 )
 
 var (
@@ -94,12 +94,12 @@ type imagesListCall struct {
 
 // NewFromServiceAccount creates a new client from the given service account.
 func NewFromServiceAccount(ctx context.Context, serviceAccount []byte) (Interface, error) {
-	jwt, err := google.JWTConfigFromJSON(serviceAccount, compute.CloudPlatformScope)
+	credentials, err := google.CredentialsFromJSON(ctx, serviceAccount, compute.CloudPlatformScope)
 	if err != nil {
 		return nil, err
 	}
 
-	httpClient := oauth2.NewClient(ctx, jwt.TokenSource(ctx))
+	httpClient := oauth2.NewClient(ctx, credentials.TokenSource)
 	service, err := compute.NewService(ctx, option.WithHTTPClient(httpClient))
 	if err != nil {
 		return nil, err
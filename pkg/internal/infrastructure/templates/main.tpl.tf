@@ -13,6 +13,14 @@ resource "google_service_account" "serviceaccount" {
   account_id   = "{{ .clusterName }}"
   display_name = "{{ .clusterName }}"
 }
+{{- range $index, $role := .serviceAccountRoles }}
+
+resource "google_project_iam_member" "serviceaccount-role-{{ $index }}" {
+  project = "{{ $.google.project }}"
+  role    = "{{ $role }}"
+  member  = "serviceAccount:${google_service_account.serviceaccount.email}"
+}
+{{- end }}
 {{- end }}
 
 //=====================================================================
@@ -37,6 +45,15 @@ resource "google_compute_subnetwork" "subnetwork-nodes" {
   ip_cidr_range = "{{ .networks.workers }}"
   network       = {{ .vpc.name }}
   region        = "{{ .google.region }}"
+{{- if .networks.privateGoogleAccess }}
+  private_ip_google_access = true
+{{- end }}
+{{- range $index, $secondaryRange := .networks.secondaryRanges }}
+  secondary_ip_range {
+    range_name    = "{{ $secondaryRange.name }}"
+    ip_cidr_range = "{{ $secondaryRange.cidr }}"
+  }
+{{- end }}
 {{- if .networks.flowLogs }}
   log_config {
     {{ if .networks.flowLogs.aggregationInterval }}aggregation_interval = "{{ .networks.flowLogs.aggregationInterval }}"{{ end }}
@@ -68,7 +85,7 @@ resource "google_compute_router" "router" {
 
 {{ if or  .create.cloudRouter .vpc.cloudRouter -}}
 resource "google_compute_router_nat" "nat" {
-  name                               = "{{ .clusterName }}-cloud-nat"
+  name                               = "{{ if .networks.cloudNAT.name }}{{ .networks.cloudNAT.name }}{{ else }}{{ .clusterName }}-cloud-nat{{ end }}"
   {{  if .vpc.cloudRouter -}}
   router                             = "{{ .vpc.cloudRouter.name }}"
   {{ else -}}
@@ -99,8 +116,8 @@ resource "google_compute_router_nat" "nat" {
   udp_idle_timeout_sec = "{{ .networks.cloudNAT.udpIdleTimeoutSec }}"
 
   log_config {
-    enable = true
-    filter = "ERRORS_ONLY"
+    enable = {{ .networks.cloudNAT.enableNatLogging }}
+    filter = "{{ .networks.cloudNAT.natLoggingFilter }}"
   }
 
   timeouts {
@@ -143,9 +160,9 @@ resource "google_compute_firewall" "rule-allow-internal-access" {
   name          = "{{ .clusterName }}-allow-internal-access"
   network       = {{ .vpc.name }}
   {{ if .networks.internal -}}
-  source_ranges = ["{{ .networks.workers }}", "{{ .networks.internal }}", "{{ .podCIDR }}"]
+  source_ranges = ["{{ .networks.workers }}", "{{ .networks.internal }}", "{{ .podCIDR }}"{{ range .networks.secondaryRanges }}, "{{ .cidr }}"{{ end }}]
   {{ else -}}
-  source_ranges = ["{{ .networks.workers }}", "{{ .podCIDR }}"]
+  source_ranges = ["{{ .networks.workers }}", "{{ .podCIDR }}"{{ range .networks.secondaryRanges }}, "{{ .cidr }}"{{ end }}]
   {{ end -}}
 
   allow {
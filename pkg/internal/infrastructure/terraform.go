@@ -14,8 +14,10 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/terraformer"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
@@ -72,6 +74,8 @@ func ComputeTerraformerTemplateValues(
 			"tcpTimeWaitTimeoutSec":            int32(120),
 			"tcpTransitoryIdleTimeoutSec":      int32(30),
 			"udpIdleTimeoutSec":                int32(30),
+			"enableNatLogging":                 true,
+			"natLoggingFilter":                 "ERRORS_ONLY",
 		}
 	)
 
@@ -86,6 +90,9 @@ func ComputeTerraformerTemplateValues(
 	}
 
 	if config.Networks.CloudNAT != nil {
+		if config.Networks.CloudNAT.Name != nil {
+			cN["name"] = *config.Networks.CloudNAT.Name
+		}
 		if config.Networks.CloudNAT.MinPortsPerVM != nil {
 			cN["minPortsPerVM"] = *config.Networks.CloudNAT.MinPortsPerVM
 		}
@@ -124,6 +131,15 @@ func ComputeTerraformerTemplateValues(
 		if config.Networks.CloudNAT.UdpIdleTimeoutSec != nil {
 			cN["udpIdleTimeoutSec"] = *config.Networks.CloudNAT.UdpIdleTimeoutSec
 		}
+
+		if natLogging := config.Networks.CloudNAT.NatLogging; natLogging != nil {
+			if natLogging.Enabled != nil {
+				cN["enableNatLogging"] = *natLogging.Enabled
+			}
+			if natLogging.Filter != nil {
+				cN["natLoggingFilter"] = *natLogging.Filter
+			}
+		}
 	}
 
 	vpc := map[string]interface{}{
@@ -175,6 +191,10 @@ func ComputeTerraformerTemplateValues(
 		"outputKeys": outputKeys,
 	}
 
+	if ptr.Deref(config.Networks.PrivateGoogleAccess, false) {
+		values["networks"].(map[string]interface{})["privateGoogleAccess"] = true
+	}
+
 	if config.Networks.FlowLogs != nil {
 		fl := make(map[string]interface{})
 
@@ -193,6 +213,22 @@ func ComputeTerraformerTemplateValues(
 		values["networks"].(map[string]interface{})["flowLogs"] = fl
 	}
 
+	if createSA && config.NodeServiceAccount != nil {
+		values["serviceAccountRoles"] = helper.NodeServiceAccountRoles(config.NodeServiceAccount)
+	}
+
+	if len(config.Networks.SecondaryRanges) > 0 {
+		secondaryRanges := make([]map[string]interface{}, 0, len(config.Networks.SecondaryRanges))
+		for _, secondaryRange := range config.Networks.SecondaryRanges {
+			secondaryRanges = append(secondaryRanges, map[string]interface{}{
+				"name": secondaryRange.Name,
+				"cidr": secondaryRange.CIDR,
+			})
+		}
+
+		values["networks"].(map[string]interface{})["secondaryRanges"] = secondaryRanges
+	}
+
 	return values, nil
 }
 
@@ -337,6 +373,12 @@ func StatusFromTerraformState(state *TerraformState) *apiv1alpha1.Infrastructure
 		}
 	}
 
+	if len(state.CloudNATName) > 0 {
+		status.Networks.CloudNAT = &apiv1alpha1.CloudNATStatus{
+			Name: state.CloudNATName,
+		}
+	}
+
 	if state.NatIPs != nil {
 		status.Networks.NatIPs = state.NatIPs
 	}
@@ -358,7 +400,16 @@ func ComputeStatus(ctx context.Context, tf terraformer.Terraformer, config *api.
 		return nil, err
 	}
 
-	return StatusFromTerraformState(state), nil
+	status := StatusFromTerraformState(state)
+	// The secondary ranges of the nodes subnet are created as configured.
+	for _, secondaryRange := range config.Networks.SecondaryRanges {
+		status.Networks.Subnets[0].SecondaryRanges = append(status.Networks.Subnets[0].SecondaryRanges, apiv1alpha1.SecondaryRange{
+			Name:    secondaryRange.Name,
+			CIDR:    secondaryRange.CIDR,
+			Purpose: (*apiv1alpha1.SecondaryRangePurpose)(secondaryRange.Purpose),
+		})
+	}
+	return status, nil
 }
 
 func manualNatIPsSet(config *api.InfrastructureConfig) bool {
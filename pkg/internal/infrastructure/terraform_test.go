@@ -17,6 +17,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
@@ -52,6 +53,8 @@ var _ = Describe("Terraform", func() {
 			"tcpTimeWaitTimeoutSec":            tcpTimeWaitTimeoutSec,
 			"tcpTransitoryIdleTimeoutSec":      tcpTransitoryIdleTimeoutSec,
 			"udpIdleTimeoutSec":                udpIdleTimeoutSec,
+			"enableNatLogging":                 true,
+			"natLoggingFilter":                 "ERRORS_ONLY",
 		}
 
 		ctrl *gomock.Controller
@@ -347,6 +350,8 @@ var _ = Describe("Terraform", func() {
 						"tcpTimeWaitTimeoutSec":        tcpTimeWaitTimeoutSec,
 						"tcpTransitoryIdleTimeoutSec":  tcpTransitoryIdleTimeoutSec,
 						"udpIdleTimeoutSec":            udpIdleTimeoutSec,
+						"enableNatLogging":             true,
+						"natLoggingFilter":             "ERRORS_ONLY",
 					},
 				},
 				"podCIDR": podCIDR,
@@ -362,6 +367,43 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should correctly compute the terraformer chart values with a custom CloudNAT name", func() {
+			config.Networks.CloudNAT = &api.CloudNAT{
+				Name: ptr.To("legacy-nat"),
+			}
+
+			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, true)
+			Expect(err).To(BeNil())
+			Expect(values["networks"]).To(HaveKeyWithValue("cloudNAT", HaveKeyWithValue("name", "legacy-nat")))
+		})
+
+		It("should correctly compute the terraformer chart values with NAT logging", func() {
+			config.Networks.CloudNAT = &api.CloudNAT{
+				NatLogging: &api.NatLogging{
+					Filter: ptr.To("ALL"),
+				},
+			}
+
+			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, true)
+			Expect(err).To(BeNil())
+			Expect(values["networks"]).To(HaveKeyWithValue("cloudNAT", And(
+				HaveKeyWithValue("enableNatLogging", true),
+				HaveKeyWithValue("natLoggingFilter", "ALL"),
+			)))
+		})
+
+		It("should correctly compute the terraformer chart values with disabled NAT logging", func() {
+			config.Networks.CloudNAT = &api.CloudNAT{
+				NatLogging: &api.NatLogging{
+					Enabled: ptr.To(false),
+				},
+			}
+
+			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, true)
+			Expect(err).To(BeNil())
+			Expect(values["networks"]).To(HaveKeyWithValue("cloudNAT", HaveKeyWithValue("enableNatLogging", false)))
+		})
+
 		It("should correctly compute the terraformer chart values with vpc flow logs", func() {
 			internalCIDR := "192.168.0.0/16"
 			aggregationInterval := "INTERVAL_30_SEC"
@@ -426,6 +468,14 @@ var _ = Describe("Terraform", func() {
 			}))
 		})
 
+		It("should correctly compute the terraformer chart values with private google access", func() {
+			config.Networks.PrivateGoogleAccess = ptr.To(true)
+
+			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, true)
+			Expect(err).To(BeNil())
+			Expect(values["networks"]).To(HaveKeyWithValue("privateGoogleAccess", true))
+		})
+
 		It("should correctly compute the terraformer chart values with vpc creation", func() {
 			config.Networks.VPC = nil
 			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, true)
@@ -460,6 +510,39 @@ var _ = Describe("Terraform", func() {
 				},
 			}))
 		})
+
+		It("should correctly compute the terraformer chart values with a node service account", func() {
+			config.NodeServiceAccount = &api.NodeServiceAccount{}
+
+			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, true)
+			Expect(err).To(BeNil())
+			Expect(values).To(HaveKeyWithValue("serviceAccountRoles", []string{
+				"roles/logging.logWriter",
+				"roles/monitoring.metricWriter",
+				"roles/monitoring.viewer",
+				"roles/stackdriver.resourceMetadata.writer",
+			}))
+		})
+
+		It("should correctly compute the terraformer chart values with secondary ranges", func() {
+			config.Networks.VPC = nil
+			config.Networks.SecondaryRanges = []api.SecondaryRange{
+				{Name: "alias-a", CIDR: "10.251.0.0/16"},
+				{Name: "alias-b", CIDR: "10.252.0.0/16"},
+			}
+
+			values, err := ComputeTerraformerTemplateValues(infra, serviceAccount, config, &podCIDR, true)
+			Expect(err).To(BeNil())
+			Expect(values["networks"]).To(Equal(map[string]interface{}{
+				"workers":  config.Networks.Workers,
+				"internal": config.Networks.Internal,
+				"cloudNAT": cloudNatDefaults,
+				"secondaryRanges": []map[string]interface{}{
+					{"name": "alias-a", "cidr": "10.251.0.0/16"},
+					{"name": "alias-b", "cidr": "10.252.0.0/16"},
+				},
+			}))
+		})
 	})
 
 	Describe("#StatusFromTerraformState", func() {
@@ -502,6 +585,7 @@ var _ = Describe("Terraform", func() {
 						Name:        vpcName,
 						CloudRouter: &apiv1alpha1.CloudRouter{Name: cloudRouterName},
 					},
+					CloudNAT: &apiv1alpha1.CloudNATStatus{Name: cloudNATName},
 					Subnets: []apiv1alpha1.Subnet{
 						{
 							Purpose: apiv1alpha1.PurposeNodes,
@@ -528,6 +612,7 @@ var _ = Describe("Terraform", func() {
 						Name:        vpcName,
 						CloudRouter: &apiv1alpha1.CloudRouter{Name: cloudRouterName},
 					},
+					CloudNAT: &apiv1alpha1.CloudNATStatus{Name: cloudNATName},
 					Subnets: []apiv1alpha1.Subnet{
 						{
 							Purpose: apiv1alpha1.PurposeNodes,
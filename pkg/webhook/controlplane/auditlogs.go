@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"path/filepath"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	auditLogVolumeName       = "audit-log-export"
+	auditLogVolumeMountPath  = "/var/lib/audit-log-export"
+	auditLogCredentialsName  = "audit-log-export-credentials"
+	auditLogCredentialsPath  = "/srv/audit-log-export/credentials"
+	auditLogFileName         = "audit.log"
+	auditLogExporterDatabase = "fluent-bit.db"
+)
+
+// ensureAuditLogExporter lets the kube-apiserver write its audit logs to a file which is shared with a fluent-bit
+// sidecar, which writes them to a log in Cloud Logging with the credentials of the shoot. The log is routed to the
+// configured destination by a Cloud Logging sink created by the control plane controller.
+func ensureAuditLogExporter(deployment *appsv1.Deployment) error {
+	image, err := ImageVector.FindImage(gcp.FluentBitImageName)
+	if err != nil {
+		return err
+	}
+
+	var (
+		template = &deployment.Spec.Template
+		ps       = &template.Spec
+		logFile  = filepath.Join(auditLogVolumeMountPath, auditLogFileName)
+		logMount = corev1.VolumeMount{Name: auditLogVolumeName, MountPath: auditLogVolumeMountPath}
+	)
+
+	if c := extensionswebhook.ContainerWithName(ps.Containers, "kube-apiserver"); c != nil {
+		c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--audit-log-path=", logFile)
+		c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--audit-log-format=", "json")
+		c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--audit-log-maxsize=", "100")
+		c.Command = extensionswebhook.EnsureStringWithPrefix(c.Command, "--audit-log-maxbackup=", "1")
+		c.VolumeMounts = extensionswebhook.EnsureVolumeMountWithName(c.VolumeMounts, logMount)
+	}
+
+	ps.Containers = extensionswebhook.EnsureContainerWithName(ps.Containers, corev1.Container{
+		Name:            gcp.AuditLogExporterName,
+		Image:           image.String(),
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command: []string{
+			"/fluent-bit/bin/fluent-bit",
+			"--parser=/fluent-bit/etc/parsers.conf",
+			"--input=tail",
+			"--prop=path=" + logFile,
+			"--prop=db=" + filepath.Join(auditLogVolumeMountPath, auditLogExporterDatabase),
+			"--prop=parser=json",
+			"--prop=tag=" + gcp.AuditLogID(deployment.Namespace),
+			"--output=stackdriver",
+			"--match=*",
+			"--prop=google_service_credentials=" + filepath.Join(auditLogCredentialsPath, gcp.ServiceAccountJSONField),
+			"--prop=resource=global",
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			logMount,
+			{Name: auditLogCredentialsName, MountPath: auditLogCredentialsPath, ReadOnly: true},
+		},
+	})
+
+	ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, corev1.Volume{
+		Name:         auditLogVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, corev1.Volume{
+		Name: auditLogCredentialsName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: v1beta1constants.SecretNameCloudProvider,
+				Items:      []corev1.KeyToPath{{Key: gcp.ServiceAccountJSONField, Path: gcp.ServiceAccountJSONField}},
+			},
+		},
+	})
+
+	// The sidecar sends the audit logs to the Cloud Logging API.
+	metav1.SetMetaDataLabel(&template.ObjectMeta, v1beta1constants.LabelNetworkPolicyToPublicNetworks, v1beta1constants.LabelNetworkPolicyAllowed)
+	return nil
+}
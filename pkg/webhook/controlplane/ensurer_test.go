@@ -17,6 +17,7 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/test"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gutil "github.com/gardener/gardener/pkg/utils/gardener"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	testutils "github.com/gardener/gardener/pkg/utils/test"
 	"github.com/gardener/gardener/pkg/utils/version"
@@ -27,10 +28,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 )
 
 const namespace = "test"
@@ -53,6 +57,9 @@ var _ = Describe("Ensurer", func() {
 						Kubernetes: gardencorev1beta1.Kubernetes{
 							Version: "1.26.0",
 						},
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+						},
 					},
 				},
 			},
@@ -64,6 +71,9 @@ var _ = Describe("Ensurer", func() {
 						Kubernetes: gardencorev1beta1.Kubernetes{
 							Version: "1.27.1",
 						},
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+						},
 					},
 				},
 			},
@@ -75,6 +85,9 @@ var _ = Describe("Ensurer", func() {
 						Kubernetes: gardencorev1beta1.Kubernetes{
 							Version: "1.28.2",
 						},
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{{Name: "worker"}},
+						},
 					},
 				},
 			},
@@ -141,6 +154,75 @@ var _ = Describe("Ensurer", func() {
 			checkKubeAPIServerDeployment(dep, "1.28.2")
 		})
 
+		It("should allow traffic to the CSI snapshot validation webhook only for shoots with workers", func() {
+			eContextWorkerless := gcontext.NewInternalGardenContext(
+				&extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Kubernetes: gardencorev1beta1.Kubernetes{
+								Version: "1.28.2",
+							},
+						},
+					},
+				},
+			)
+			networkPolicyLabel := gutil.NetworkPolicyLabel(gcp.CSISnapshotValidationName, 443)
+
+			Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextK8s128, dep, nil)).To(Succeed())
+			Expect(dep.Spec.Template.Labels).To(HaveKeyWithValue(networkPolicyLabel, v1beta1constants.LabelNetworkPolicyAllowed))
+
+			dep.Spec.Template.Labels = nil
+			Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWorkerless, dep, nil)).To(Succeed())
+			Expect(dep.Spec.Template.Labels).NotTo(HaveKey(networkPolicyLabel))
+		})
+
+		It("should add the exporter of the audit logs if it is configured", func() {
+			DeferCleanup(testutils.WithVar(&ImageVector, imagevectorutils.ImageVector{{
+				Name:       "fluent-bit",
+				Repository: "foo",
+				Tag:        ptr.To("bar"),
+			}}))
+			eContextWithAuditLogExport := gcontext.NewInternalGardenContext(
+				&extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Kubernetes: gardencorev1beta1.Kubernetes{
+								Version: "1.28.2",
+							},
+							Provider: gardencorev1beta1.Provider{
+								ControlPlaneConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"gcp.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","auditLogExport":{"destination":"pubsub.googleapis.com/projects/foo/topics/audit"}}`)},
+							},
+						},
+					},
+				},
+			)
+
+			Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithAuditLogExport, dep, nil)).To(Succeed())
+
+			c := extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "kube-apiserver")
+			Expect(c).NotTo(BeNil())
+			Expect(c.Command).To(ContainElements(
+				"--audit-log-path=/var/lib/audit-log-export/audit.log",
+				"--audit-log-format=json",
+			))
+			Expect(c.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "audit-log-export", MountPath: "/var/lib/audit-log-export"}))
+
+			exporter := extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "audit-log-exporter")
+			Expect(exporter).NotTo(BeNil())
+			Expect(exporter.Image).To(Equal("foo:bar"))
+			Expect(exporter.Command).To(ContainElements(
+				"--prop=tag="+namespace+"-kube-apiserver-audit",
+				"--prop=google_service_credentials=/srv/audit-log-export/credentials/serviceaccount.json",
+			))
+			Expect(dep.Spec.Template.Spec.Volumes).To(HaveLen(2))
+			Expect(dep.Spec.Template.Labels).To(HaveKeyWithValue(v1beta1constants.LabelNetworkPolicyToPublicNetworks, v1beta1constants.LabelNetworkPolicyAllowed))
+		})
+
+		It("should not add the exporter of the audit logs if it is not configured", func() {
+			Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextK8s128, dep, nil)).To(Succeed())
+			Expect(extensionswebhook.ContainerWithName(dep.Spec.Template.Spec.Containers, "audit-log-exporter")).To(BeNil())
+		})
+
 		It("should modify existing elements of kube-apiserver deployment", func() {
 			var (
 				dep = &appsv1.Deployment{
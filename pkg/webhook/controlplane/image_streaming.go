@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/helper"
+)
+
+const (
+	// containerdRegistryConfigDir is the directory containing the registry host configurations of containerd.
+	containerdRegistryConfigDir = "/etc/containerd/certs.d"
+	// containerdImageStreamingConfigPath is the path of the containerd configuration drop-in for lazy pulling.
+	containerdImageStreamingConfigPath = "/etc/containerd/conf.d/gcp-image-streaming.toml"
+	// stargzSnapshotterUnitName is the name of the systemd unit of the stargz snapshotter provided by the machine image.
+	stargzSnapshotterUnitName = "stargz-snapshotter.service"
+
+	containerdImageStreamingConfig = `version = 2
+
+[proxy_plugins]
+  [proxy_plugins.stargz]
+    type = "snapshot"
+    address = "/run/containerd-stargz-grpc/containerd-stargz-grpc.sock"
+
+[plugins."io.containerd.grpc.v1.cri".containerd]
+  snapshotter = "stargz"
+  disable_snapshot_annotations = false
+`
+)
+
+// imageStreamingMutator adds the containerd configuration for image streaming to the OperatingSystemConfigs of the
+// worker pools enabling it in their WorkerConfig. The ensurer of the generic mutator does not know the worker pool of
+// an OperatingSystemConfig, hence the configuration is added after the generic mutation.
+type imageStreamingMutator struct {
+	extensionswebhook.Mutator
+	client client.Client
+}
+
+func newImageStreamingMutator(mutator extensionswebhook.Mutator, c client.Client) extensionswebhook.Mutator {
+	return &imageStreamingMutator{
+		Mutator: mutator,
+		client:  c,
+	}
+}
+
+// Mutate mutates the given object with the generic mutator and adds the image streaming configuration to
+// OperatingSystemConfigs.
+func (m *imageStreamingMutator) Mutate(ctx context.Context, new, old client.Object) error {
+	if err := m.Mutator.Mutate(ctx, new, old); err != nil {
+		return err
+	}
+
+	osc, ok := new.(*extensionsv1alpha1.OperatingSystemConfig)
+	if !ok || osc.DeletionTimestamp != nil || osc.Spec.Purpose != extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+		return nil
+	}
+
+	poolName := osc.Labels[v1beta1constants.LabelWorkerPool]
+	if poolName == "" {
+		return nil
+	}
+
+	cluster, err := gcontext.NewGardenContext(m.client, new).GetCluster(ctx)
+	if err != nil {
+		return err
+	}
+	if cluster.Shoot == nil {
+		return nil
+	}
+
+	for _, pool := range cluster.Shoot.Spec.Provider.Workers {
+		if pool.Name != poolName {
+			continue
+		}
+
+		workerConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
+		if err != nil {
+			return fmt.Errorf("could not decode provider config of worker pool %q: %w", poolName, err)
+		}
+		ensureImageStreamingConfig(osc, workerConfig.ImageStreaming)
+	}
+
+	return nil
+}
+
+// ensureImageStreamingConfig adds the containerd registry host configurations of the mirrors and, if lazy pulling is
+// enabled, the configuration of the stargz snapshotter to the given OperatingSystemConfig.
+func ensureImageStreamingConfig(osc *extensionsv1alpha1.OperatingSystemConfig, imageStreaming *apisgcp.ImageStreaming) {
+	if imageStreaming == nil {
+		return
+	}
+
+	for _, mirror := range imageStreaming.Mirrors {
+		osc.Spec.Files = extensionswebhook.EnsureFileWithPath(osc.Spec.Files, inlineFile(
+			filepath.Join(containerdRegistryConfigDir, mirror.Upstream, "hosts.toml"),
+			registryHostsConfig(mirror),
+		))
+	}
+
+	if imageStreaming.LazyPulling {
+		osc.Spec.Files = extensionswebhook.EnsureFileWithPath(osc.Spec.Files, inlineFile(containerdImageStreamingConfigPath, containerdImageStreamingConfig))
+		osc.Spec.Units = extensionswebhook.EnsureUnitWithName(osc.Spec.Units, extensionsv1alpha1.Unit{
+			Name:    stargzSnapshotterUnitName,
+			Command: ptr.To(extensionsv1alpha1.CommandStart),
+			Enable:  ptr.To(true),
+		})
+	}
+}
+
+// registryHostsConfig returns the containerd registry host configuration which pulls the images of the upstream
+// registry of the given mirror from its Artifact Registry remote repository. The path of the repository replaces the
+// path of the upstream registry, and the upstream registry is used as fallback.
+func registryHostsConfig(mirror apisgcp.RegistryMirror) string {
+	return fmt.Sprintf(`server = "%s"
+
+[host."%s"]
+  capabilities = ["pull", "resolve"]
+  override_path = true
+`, upstreamServer(mirror.Upstream), mirror.Repository)
+}
+
+// upstreamServer returns the URL of the given upstream registry host.
+func upstreamServer(upstream string) string {
+	if upstream == "docker.io" {
+		return "https://registry-1.docker.io"
+	}
+	return "https://" + upstream
+}
+
+func inlineFile(path, data string) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        path,
+		Permissions: ptr.To[int32](0644),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data: data,
+			},
+		},
+	}
+}
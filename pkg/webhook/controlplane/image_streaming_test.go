@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+)
+
+var _ = Describe("Image streaming", func() {
+	var osc *extensionsv1alpha1.OperatingSystemConfig
+
+	BeforeEach(func() {
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Purpose: extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
+				Files:   []extensionsv1alpha1.File{{Path: "/etc/foo"}},
+			},
+		}
+	})
+
+	It("should not change the OperatingSystemConfig if image streaming is not configured", func() {
+		expected := osc.DeepCopy()
+
+		ensureImageStreamingConfig(osc, nil)
+
+		Expect(osc).To(Equal(expected))
+	})
+
+	It("should add the registry host configurations of the mirrors", func() {
+		ensureImageStreamingConfig(osc, &apisgcp.ImageStreaming{
+			Mirrors: []apisgcp.RegistryMirror{
+				{Upstream: "docker.io", Repository: "https://europe-docker.pkg.dev/v2/my-project/docker-hub"},
+				{Upstream: "ghcr.io", Repository: "https://europe-docker.pkg.dev/v2/my-project/ghcr"},
+			},
+		})
+
+		Expect(osc.Spec.Files).To(ConsistOf(
+			extensionsv1alpha1.File{Path: "/etc/foo"},
+			inlineFile("/etc/containerd/certs.d/docker.io/hosts.toml", `server = "https://registry-1.docker.io"
+
+[host."https://europe-docker.pkg.dev/v2/my-project/docker-hub"]
+  capabilities = ["pull", "resolve"]
+  override_path = true
+`),
+			inlineFile("/etc/containerd/certs.d/ghcr.io/hosts.toml", `server = "https://ghcr.io"
+
+[host."https://europe-docker.pkg.dev/v2/my-project/ghcr"]
+  capabilities = ["pull", "resolve"]
+  override_path = true
+`),
+		))
+		Expect(osc.Spec.Units).To(BeEmpty())
+	})
+
+	It("should configure the stargz snapshotter for lazy pulling", func() {
+		ensureImageStreamingConfig(osc, &apisgcp.ImageStreaming{LazyPulling: true})
+		// ensuring the configuration again must not add duplicates
+		ensureImageStreamingConfig(osc, &apisgcp.ImageStreaming{LazyPulling: true})
+
+		Expect(osc.Spec.Files).To(ConsistOf(
+			extensionsv1alpha1.File{Path: "/etc/foo"},
+			inlineFile("/etc/containerd/conf.d/gcp-image-streaming.toml", containerdImageStreamingConfig),
+		))
+		Expect(osc.Spec.Units).To(ConsistOf(extensionsv1alpha1.Unit{
+			Name:    "stargz-snapshotter.service",
+			Command: ptr.To(extensionsv1alpha1.CommandStart),
+			Enable:  ptr.To(true),
+		}))
+	})
+})
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internalloadbalancer
+
+import (
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+const (
+	// WebhookName is the name of the webhook pinning the IP addresses of internal load balancers.
+	WebhookName = "internal-load-balancer"
+	webhookPath = "internal-load-balancer"
+)
+
+var (
+	// DefaultAddOptions are the default AddOptions for AddToManager.
+	DefaultAddOptions = AddOptions{}
+)
+
+// AddOptions are options to apply when adding the internal load balancer webhook to the manager.
+type AddOptions struct {
+	// LoadBalancers are the internal load balancers exposing kube-apiservers.
+	LoadBalancers []config.APIServerInternalLoadBalancer
+}
+
+var logger = log.Log.WithName("internal-load-balancer-webhook")
+
+// AddToManagerWithOptions creates a webhook with the given options and adds it to the manager.
+func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding webhook to manager")
+
+	types := []extensionswebhook.Type{
+		{Obj: &corev1.Service{}},
+	}
+
+	handler, err := extensionswebhook.NewBuilder(mgr, logger).WithMutator(NewMutator(mgr.GetClient(), opts.LoadBalancers), types...).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Creating webhook")
+	return &extensionswebhook.Webhook{
+		Name:              WebhookName,
+		Target:            extensionswebhook.TargetSeed,
+		Provider:          gcp.Type,
+		Types:             types,
+		Webhook:           &admission.Webhook{Handler: handler, RecoverPanic: true},
+		Path:              webhookPath,
+		NamespaceSelector: buildSelector(opts.LoadBalancers),
+	}, nil
+}
+
+// AddToManager creates a webhook with the default options and adds it to the manager.
+func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	return AddToManagerWithOptions(mgr, DefaultAddOptions)
+}
+
+func buildSelector(loadBalancers []config.APIServerInternalLoadBalancer) *metav1.LabelSelector {
+	if len(loadBalancers) == 0 {
+		// Every namespace has the name label, hence the webhook does not match any namespace.
+		return &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpDoesNotExist},
+			},
+		}
+	}
+
+	namespaces := make([]string, 0, len(loadBalancers))
+	for _, loadBalancer := range loadBalancers {
+		namespaces = append(namespaces, loadBalancer.Namespace)
+	}
+
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: namespaces},
+		},
+	}
+}
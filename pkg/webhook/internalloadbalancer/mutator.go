@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internalloadbalancer
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+type mutator struct {
+	client        client.Client
+	loadBalancers map[string]config.APIServerInternalLoadBalancer
+}
+
+// NewMutator returns a new mutator which pins the IP addresses of the istio ingress gateway services exposed via the
+// given internal load balancers.
+func NewMutator(c client.Client, loadBalancers []config.APIServerInternalLoadBalancer) extensionswebhook.Mutator {
+	m := &mutator{
+		client:        c,
+		loadBalancers: make(map[string]config.APIServerInternalLoadBalancer, len(loadBalancers)),
+	}
+	for _, loadBalancer := range loadBalancers {
+		m.loadBalancers[loadBalancer.Namespace] = loadBalancer
+	}
+	return m
+}
+
+// Mutate sets the load balancer IP of istio ingress gateway services to the configured reserved IP address or, if none
+// is configured, to the IP address previously allocated for the load balancer.
+func (m *mutator) Mutate(ctx context.Context, new, _ client.Object) error {
+	if new.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	service, ok := new.(*corev1.Service)
+	if !ok {
+		return fmt.Errorf("could not mutate: object is not of type Service")
+	}
+
+	loadBalancer, ok := m.loadBalancers[service.Namespace]
+	if !ok || service.Name != v1beta1constants.DefaultSNIIngressServiceName || service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+
+	ip := ptr.Deref(loadBalancer.IP, "")
+	if ip == "" {
+		namespace := &corev1.Namespace{}
+		if err := m.client.Get(ctx, client.ObjectKey{Name: service.Namespace}, namespace); err != nil {
+			return fmt.Errorf("could not get namespace %q: %w", service.Namespace, err)
+		}
+		ip = namespace.Annotations[gcp.AnnotationKeyInternalLoadBalancerIP]
+	}
+
+	if ip != "" {
+		service.Spec.LoadBalancerIP = ip
+	}
+
+	return nil
+}
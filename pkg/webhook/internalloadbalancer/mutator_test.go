@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internalloadbalancer_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/internalloadbalancer"
+)
+
+var _ = Describe("Mutator", func() {
+	const (
+		pinnedNamespace    = "istio-ingress-handler-pinned"
+		persistedNamespace = "istio-ingress-handler-persisted"
+	)
+
+	var (
+		ctx     = context.TODO()
+		c       client.Client
+		mutator extensionswebhook.Mutator
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		mutator = NewMutator(c, []config.APIServerInternalLoadBalancer{
+			{Namespace: pinnedNamespace, IP: ptr.To("10.0.0.10")},
+			{Namespace: persistedNamespace},
+		})
+	})
+
+	newService := func(namespace string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "istio-ingressgateway", Namespace: namespace},
+			Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+	}
+
+	It("should pin the configured IP address", func() {
+		service := newService(pinnedNamespace)
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Spec.LoadBalancerIP).To(Equal("10.0.0.10"))
+	})
+
+	It("should pin the persisted IP address", func() {
+		Expect(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        persistedNamespace,
+			Annotations: map[string]string{gcp.AnnotationKeyInternalLoadBalancerIP: "10.0.0.20"},
+		}})).To(Succeed())
+		service := newService(persistedNamespace)
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Spec.LoadBalancerIP).To(Equal("10.0.0.20"))
+	})
+
+	It("should not pin an IP address if none has been persisted yet", func() {
+		Expect(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: persistedNamespace}})).To(Succeed())
+		service := newService(persistedNamespace)
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Spec.LoadBalancerIP).To(BeEmpty())
+	})
+
+	It("should not mutate services in other namespaces", func() {
+		service := newService("istio-ingress")
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Spec.LoadBalancerIP).To(BeEmpty())
+	})
+
+	It("should not mutate other services", func() {
+		service := newService(pinnedNamespace)
+		service.Name = "foo"
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Spec.LoadBalancerIP).To(BeEmpty())
+	})
+})
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package location
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+)
+
+type validator struct {
+	allowedLocations config.AllowedLocations
+}
+
+// NewValidator returns a new validator which rejects Infrastructures and Workers in regions or zones which are not
+// allowed. Existing resources are only validated if their region or zones change, so that they can still be
+// reconciled and deleted after the allowed locations have been restricted.
+func NewValidator(allowedLocations config.AllowedLocations) extensionswebhook.Validator {
+	return &validator{allowedLocations: allowedLocations}
+}
+
+// Validate validates the region and zones of the given Infrastructure or Worker.
+func (v *validator) Validate(_ context.Context, newObj, oldObj client.Object) error {
+	if newObj.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	switch obj := newObj.(type) {
+	case *extensionsv1alpha1.Infrastructure:
+		if old, ok := oldObj.(*extensionsv1alpha1.Infrastructure); ok && old != nil && old.Spec.Region == obj.Spec.Region {
+			return nil
+		}
+		return v.validateRegion(obj.Spec.Region)
+
+	case *extensionsv1alpha1.Worker:
+		oldZones := sets.New[string]()
+		if old, ok := oldObj.(*extensionsv1alpha1.Worker); ok && old != nil {
+			if old.Spec.Region != obj.Spec.Region {
+				if err := v.validateRegion(obj.Spec.Region); err != nil {
+					return err
+				}
+			}
+			for _, pool := range old.Spec.Pools {
+				oldZones.Insert(pool.Zones...)
+			}
+		} else if err := v.validateRegion(obj.Spec.Region); err != nil {
+			return err
+		}
+
+		for _, pool := range obj.Spec.Pools {
+			for _, zone := range pool.Zones {
+				if !oldZones.Has(zone) && !v.isZoneAllowed(zone) {
+					return fmt.Errorf("zone %q of worker pool %q is not allowed, allowed zones are %v", zone, pool.Name, v.allowedLocations.Zones)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateRegion(region string) error {
+	if len(v.allowedLocations.Regions) > 0 && !slices.Contains(v.allowedLocations.Regions, region) {
+		return fmt.Errorf("region %q is not allowed, allowed regions are %v", region, v.allowedLocations.Regions)
+	}
+	return nil
+}
+
+func (v *validator) isZoneAllowed(zone string) bool {
+	return len(v.allowedLocations.Zones) == 0 || slices.Contains(v.allowedLocations.Zones, zone)
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package location_test
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/config"
+	. "github.com/gardener/gardener-extension-provider-gcp/pkg/webhook/location"
+)
+
+var _ = Describe("Validator", func() {
+	var (
+		ctx       = context.TODO()
+		validator extensionswebhook.Validator
+	)
+
+	BeforeEach(func() {
+		validator = NewValidator(config.AllowedLocations{
+			Regions: []string{"europe-west1"},
+			Zones:   []string{"europe-west1-b", "europe-west1-c"},
+		})
+	})
+
+	newInfrastructure := func(region string) *extensionsv1alpha1.Infrastructure {
+		return &extensionsv1alpha1.Infrastructure{Spec: extensionsv1alpha1.InfrastructureSpec{Region: region}}
+	}
+
+	newWorker := func(region string, zones ...string) *extensionsv1alpha1.Worker {
+		return &extensionsv1alpha1.Worker{Spec: extensionsv1alpha1.WorkerSpec{
+			Region: region,
+			Pools:  []extensionsv1alpha1.WorkerPool{{Name: "pool", Zones: zones}},
+		}}
+	}
+
+	Context("Infrastructure", func() {
+		It("should allow an allowed region", func() {
+			Expect(validator.Validate(ctx, newInfrastructure("europe-west1"), nil)).To(Succeed())
+		})
+
+		It("should reject a region which is not allowed", func() {
+			Expect(validator.Validate(ctx, newInfrastructure("us-east1"), nil)).To(MatchError(ContainSubstring(`region "us-east1" is not allowed`)))
+		})
+
+		It("should allow updates of existing infrastructures in regions which are not allowed", func() {
+			Expect(validator.Validate(ctx, newInfrastructure("us-east1"), newInfrastructure("us-east1"))).To(Succeed())
+		})
+
+		It("should allow infrastructures which are being deleted", func() {
+			infra := newInfrastructure("us-east1")
+			infra.DeletionTimestamp = &metav1.Time{}
+
+			Expect(validator.Validate(ctx, infra, newInfrastructure("europe-west1"))).To(Succeed())
+		})
+	})
+
+	Context("Worker", func() {
+		It("should allow allowed zones", func() {
+			Expect(validator.Validate(ctx, newWorker("europe-west1", "europe-west1-b", "europe-west1-c"), nil)).To(Succeed())
+		})
+
+		It("should reject a region which is not allowed", func() {
+			Expect(validator.Validate(ctx, newWorker("us-east1", "europe-west1-b"), nil)).To(MatchError(ContainSubstring(`region "us-east1" is not allowed`)))
+		})
+
+		It("should reject a zone which is not allowed", func() {
+			Expect(validator.Validate(ctx, newWorker("europe-west1", "europe-west1-d"), nil)).To(MatchError(ContainSubstring(`zone "europe-west1-d" of worker pool "pool" is not allowed`)))
+		})
+
+		It("should only reject zones which are newly added", func() {
+			oldWorker := newWorker("europe-west1", "europe-west1-d")
+
+			Expect(validator.Validate(ctx, newWorker("europe-west1", "europe-west1-d", "europe-west1-b"), oldWorker)).To(Succeed())
+			Expect(validator.Validate(ctx, newWorker("europe-west1", "europe-west1-d", "europe-west1-a"), oldWorker)).To(HaveOccurred())
+		})
+
+		It("should allow all zones of the allowed regions if no zones are configured", func() {
+			validator = NewValidator(config.AllowedLocations{Regions: []string{"europe-west1"}})
+
+			Expect(validator.Validate(ctx, newWorker("europe-west1", "europe-west1-d"), nil)).To(Succeed())
+		})
+	})
+})
@@ -11,6 +11,8 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 )
 
 var (
@@ -23,14 +25,34 @@ type AddOptions struct{}
 
 var logger = log.Log.WithName("gcp-shoot-webhook")
 
-// AddToManagerWithOptions creates a webhook with the given options and adds it to the manager.
+// AddToManagerWithOptions creates a webhook with the given options and adds it to the manager. If the VolumeCloning
+// feature gate is enabled, the webhook additionally validates clones of PersistentVolumeClaims, and if the
+// InternalLoadBalancerGlobalAccess feature gate is enabled, it defaults the global access of internal load balancers.
+// Both require a client for the shoot.
 func AddToManagerWithOptions(mgr manager.Manager, _ AddOptions) (*extensionswebhook.Webhook, error) {
 	logger.Info("Adding webhook to manager")
+
+	types := []extensionswebhook.Type{
+		{Obj: &corev1.Node{}, Subresource: ptr.To("status")},
+	}
+	var typesWithShootClient []extensionswebhook.Type
+	if features.ExtensionFeatureGate.Enabled(features.VolumeCloning) {
+		typesWithShootClient = append(typesWithShootClient, extensionswebhook.Type{Obj: &corev1.PersistentVolumeClaim{}})
+	}
+	if features.ExtensionFeatureGate.Enabled(features.InternalLoadBalancerGlobalAccess) {
+		typesWithShootClient = append(typesWithShootClient, extensionswebhook.Type{Obj: &corev1.Service{}})
+	}
+
+	if len(typesWithShootClient) == 0 {
+		return shoot.New(mgr, shoot.Args{
+			Types:   types,
+			Mutator: NewMutator(),
+		})
+	}
+
 	return shoot.New(mgr, shoot.Args{
-		Types: []extensionswebhook.Type{
-			{Obj: &corev1.Node{}, Subresource: ptr.To("status")},
-		},
-		Mutator: NewMutator(),
+		Types:                  append(types, typesWithShootClient...),
+		MutatorWithShootClient: NewMutatorWithShootClient(),
 	})
 }
 
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
+)
+
+// dataKeyGlobalAccess is the key of the default of the global access of internal load balancers in the ConfigMap
+// deployed to the shoot.
+const dataKeyGlobalAccess = "globalAccess"
+
+// defaultInternalLoadBalancerGlobalAccess enables the global access of the internal load balancer of the given Service
+// if it is enabled by default for the shoot, i.e. in the ConfigMap deployed by the extension, and the Service does not
+// set the annotation controlling the global access itself.
+func defaultInternalLoadBalancerGlobalAccess(ctx context.Context, c client.Client, service *corev1.Service) error {
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer || !strings.EqualFold(service.Annotations[gcp.AnnotationKeyLoadBalancerType], "Internal") {
+		return nil
+	}
+	if _, ok := service.Annotations[gcp.AnnotationKeyInternalLoadBalancerGlobalAccess]; ok {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceSystem, Name: gcp.InternalLoadBalancerConfigMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get ConfigMap %s: %w", gcp.InternalLoadBalancerConfigMapName, err)
+	}
+	if configMap.Data[dataKeyGlobalAccess] != "true" {
+		return nil
+	}
+
+	metav1.SetMetaDataAnnotation(&service.ObjectMeta, gcp.AnnotationKeyInternalLoadBalancerGlobalAccess, "true")
+	return nil
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("InternalLoadBalancer", func() {
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		configMap *corev1.ConfigMap
+		service   *corev1.Service
+	)
+
+	BeforeEach(func() {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "gcp-internal-load-balancer-defaults", Namespace: "kube-system"},
+			Data:       map[string]string{"globalAccess": "true"},
+		}
+		service = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "internal",
+				Namespace:   "default",
+				Annotations: map[string]string{"networking.gke.io/load-balancer-type": "Internal"},
+			},
+			Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(configMap).Build()
+	})
+
+	It("should enable the global access of internal load balancers by default", func() {
+		Expect(defaultInternalLoadBalancerGlobalAccess(ctx, c, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue("networking.gke.io/internal-load-balancer-allow-global-access", "true"))
+	})
+
+	It("should not override the global access set by the Service", func() {
+		service.Annotations["networking.gke.io/internal-load-balancer-allow-global-access"] = "false"
+
+		Expect(defaultInternalLoadBalancerGlobalAccess(ctx, c, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue("networking.gke.io/internal-load-balancer-allow-global-access", "false"))
+	})
+
+	It("should not change external load balancers", func() {
+		delete(service.Annotations, "networking.gke.io/load-balancer-type")
+
+		Expect(defaultInternalLoadBalancerGlobalAccess(ctx, c, service)).To(Succeed())
+		Expect(service.Annotations).NotTo(HaveKey("networking.gke.io/internal-load-balancer-allow-global-access"))
+	})
+
+	It("should not change the Service if the global access is not enabled by default", func() {
+		Expect(c.Delete(ctx, configMap)).To(Succeed())
+
+		Expect(defaultInternalLoadBalancerGlobalAccess(ctx, c, service)).To(Succeed())
+		Expect(service.Annotations).NotTo(HaveKey("networking.gke.io/internal-load-balancer-allow-global-access"))
+	})
+})
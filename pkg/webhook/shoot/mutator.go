@@ -49,3 +49,36 @@ func (m *mutator) Mutate(ctx context.Context, new, old client.Object) error {
 	}
 	return nil
 }
+
+type mutatorWithShootClient struct {
+	mutator *mutator
+}
+
+// NewMutatorWithShootClient creates a new MutatorWithShootClient that mutates resources in the shoot cluster like the
+// Mutator and additionally validates clones of PersistentVolumeClaims and defaults the global access of internal load
+// balancers.
+func NewMutatorWithShootClient() extensionswebhook.MutatorWithShootClient {
+	return &mutatorWithShootClient{
+		mutator: &mutator{
+			logger: log.Log.WithName("shoot-mutator"),
+		},
+	}
+}
+
+// Mutate mutates resources and rejects PersistentVolumeClaims which cannot be cloned from their data source.
+func (m *mutatorWithShootClient) Mutate(ctx context.Context, new, old client.Object, shootClient client.Client) error {
+	switch x := new.(type) {
+	case *corev1.PersistentVolumeClaim:
+		if x.DeletionTimestamp != nil {
+			return nil
+		}
+		y, _ := old.(*corev1.PersistentVolumeClaim)
+		return validateVolumeClone(ctx, shootClient, x, y)
+	case *corev1.Service:
+		if x.DeletionTimestamp != nil {
+			return nil
+		}
+		return defaultInternalLoadBalancerGlobalAccess(ctx, shootClient, x)
+	}
+	return m.mutator.Mutate(ctx, new, old)
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	csiDriverName = "pd.csi.storage.gke.io"
+
+	parameterDiskType        = "type"
+	parameterReplicationType = "replication-type"
+	defaultDiskType          = "pd-standard"
+	defaultReplicationType   = "none"
+
+	// annotationSelectedNode is set by the scheduler on PersistentVolumeClaims with delayed binding to the node of the
+	// first pod using the claim.
+	annotationSelectedNode = "volume.kubernetes.io/selected-node"
+	labelTopologyZoneCSI   = "topology.gke.io/zone"
+)
+
+// validateVolumeClone validates that a PersistentVolumeClaim cloned from another PersistentVolumeClaim can be
+// provisioned by the GCP PD CSI driver, i.e. that the clone uses the same disk type and replication type as the source,
+// is not smaller than the source and is provisioned in a zone of the source disk. Otherwise, the provisioning of the
+// clone fails with errors of the GCP API which are hard to understand.
+func validateVolumeClone(ctx context.Context, c client.Client, pvc, oldPVC *corev1.PersistentVolumeClaim) error {
+	sourceName := cloneSourceName(pvc)
+	if sourceName == "" || pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return nil
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	if err := c.Get(ctx, client.ObjectKey{Name: *pvc.Spec.StorageClassName}, storageClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get StorageClass %s: %w", *pvc.Spec.StorageClassName, err)
+	}
+	if storageClass.Provisioner != csiDriverName {
+		return nil
+	}
+
+	source := &corev1.PersistentVolumeClaim{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: pvc.Namespace, Name: sourceName}, source); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("source PersistentVolumeClaim %s of the clone does not exist", sourceName)
+		}
+		return fmt.Errorf("could not get source PersistentVolumeClaim %s: %w", sourceName, err)
+	}
+	if source.Spec.VolumeName == "" {
+		return fmt.Errorf("source PersistentVolumeClaim %s of the clone is not bound yet", sourceName)
+	}
+	sourceVolume := &corev1.PersistentVolume{}
+	if err := c.Get(ctx, client.ObjectKey{Name: source.Spec.VolumeName}, sourceVolume); err != nil {
+		return fmt.Errorf("could not get PersistentVolume %s of source PersistentVolumeClaim %s: %w", source.Spec.VolumeName, sourceName, err)
+	}
+	if sourceVolume.Spec.CSI == nil || sourceVolume.Spec.CSI.Driver != csiDriverName {
+		return fmt.Errorf("source PersistentVolumeClaim %s of the clone is not provisioned by the %s CSI driver", sourceName, csiDriverName)
+	}
+	sourceZones := volumeZones(sourceVolume)
+
+	// The scheduler selects the node of the first pod using the clone, whose zone must be a zone of the source disk.
+	if selectedNode := pvc.Annotations[annotationSelectedNode]; selectedNode != "" && (oldPVC == nil || oldPVC.Annotations[annotationSelectedNode] != selectedNode) {
+		if err := validateSelectedNode(ctx, c, selectedNode, sourceName, sourceZones); err != nil {
+			return err
+		}
+	}
+
+	// The remaining fields are immutable, hence they are only validated when the clone is created.
+	if oldPVC != nil {
+		return nil
+	}
+
+	if source.Spec.StorageClassName != nil && *source.Spec.StorageClassName != storageClass.Name {
+		sourceStorageClass := &storagev1.StorageClass{}
+		if err := c.Get(ctx, client.ObjectKey{Name: *source.Spec.StorageClassName}, sourceStorageClass); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("could not get StorageClass %s of source PersistentVolumeClaim %s: %w", *source.Spec.StorageClassName, sourceName, err)
+			}
+		} else {
+			if sourceType, cloneType := diskParameter(sourceStorageClass, parameterDiskType, defaultDiskType), diskParameter(storageClass, parameterDiskType, defaultDiskType); sourceType != cloneType {
+				return fmt.Errorf("clone of PersistentVolumeClaim %s must have the same disk type %s as the source, but StorageClass %s has disk type %s", sourceName, sourceType, storageClass.Name, cloneType)
+			}
+			if sourceType, cloneType := diskParameter(sourceStorageClass, parameterReplicationType, defaultReplicationType), diskParameter(storageClass, parameterReplicationType, defaultReplicationType); sourceType != cloneType {
+				return fmt.Errorf("clone of PersistentVolumeClaim %s must have the same replication type %s as the source, but StorageClass %s has replication type %s", sourceName, sourceType, storageClass.Name, cloneType)
+			}
+		}
+	}
+
+	if sourceSize, ok := source.Status.Capacity[corev1.ResourceStorage]; ok {
+		if size, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok && size.Cmp(sourceSize) < 0 {
+			return fmt.Errorf("clone of PersistentVolumeClaim %s must request at least the capacity %s of the source", sourceName, sourceSize.String())
+		}
+	}
+
+	if zones := allowedZones(storageClass); sourceZones.Len() > 0 && zones.Len() > 0 && !zones.HasAny(sourceZones.UnsortedList()...) {
+		return fmt.Errorf("clone of PersistentVolumeClaim %s must be provisioned in a zone of the source %v, but StorageClass %s only allows zones %v", sourceName, sets.List(sourceZones), storageClass.Name, sets.List(zones))
+	}
+
+	return nil
+}
+
+// cloneSourceName returns the name of the PersistentVolumeClaim the given claim is cloned from, if any.
+func cloneSourceName(pvc *corev1.PersistentVolumeClaim) string {
+	if ref := pvc.Spec.DataSourceRef; ref != nil {
+		if ref.Kind == "PersistentVolumeClaim" && ptr.Deref(ref.APIGroup, "") == "" {
+			return ref.Name
+		}
+		return ""
+	}
+	if ref := pvc.Spec.DataSource; ref != nil && ref.Kind == "PersistentVolumeClaim" && ptr.Deref(ref.APIGroup, "") == "" {
+		return ref.Name
+	}
+	return ""
+}
+
+func validateSelectedNode(ctx context.Context, c client.Client, nodeName, sourceName string, sourceZones sets.Set[string]) error {
+	if sourceZones.Len() == 0 {
+		return nil
+	}
+
+	node := &corev1.Node{}
+	if err := c.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("could not get selected node %s: %w", nodeName, err)
+	}
+	zone := node.Labels[corev1.LabelTopologyZone]
+	if zone != "" && !sourceZones.Has(zone) {
+		return fmt.Errorf("clone of PersistentVolumeClaim %s can only be used by pods in the zones %v of the source, but node %s is in zone %s", sourceName, sets.List(sourceZones), nodeName, zone)
+	}
+	return nil
+}
+
+// volumeZones returns the zones of the disk of the given volume, i.e. one zone for zonal and two zones for regional
+// disks.
+func volumeZones(volume *corev1.PersistentVolume) sets.Set[string] {
+	zones := sets.New[string]()
+	if volume.Spec.NodeAffinity == nil || volume.Spec.NodeAffinity.Required == nil {
+		return zones
+	}
+	for _, term := range volume.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for _, expression := range term.MatchExpressions {
+			if isZoneKey(expression.Key) && expression.Operator == corev1.NodeSelectorOpIn {
+				zones.Insert(expression.Values...)
+			}
+		}
+	}
+	return zones
+}
+
+// allowedZones returns the zones the given StorageClass restricts the provisioning to, if any.
+func allowedZones(storageClass *storagev1.StorageClass) sets.Set[string] {
+	zones := sets.New[string]()
+	for _, term := range storageClass.AllowedTopologies {
+		for _, expression := range term.MatchLabelExpressions {
+			if isZoneKey(expression.Key) {
+				zones.Insert(expression.Values...)
+			}
+		}
+	}
+	return zones
+}
+
+func isZoneKey(key string) bool {
+	return slices.Contains([]string{labelTopologyZoneCSI, corev1.LabelTopologyZone}, key)
+}
+
+func diskParameter(storageClass *storagev1.StorageClass, key, defaultValue string) string {
+	for k, v := range storageClass.Parameters {
+		// The parameters are case-insensitive for the GCP PD CSI driver.
+		if strings.EqualFold(k, key) {
+			return strings.ToLower(v)
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("VolumeCloning", func() {
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		standard *storagev1.StorageClass
+		balanced *storagev1.StorageClass
+		source   *corev1.PersistentVolumeClaim
+		volume   *corev1.PersistentVolume
+		node     *corev1.Node
+		clone    *corev1.PersistentVolumeClaim
+	)
+
+	BeforeEach(func() {
+		standard = &storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: "default"},
+			Provisioner: csiDriverName,
+			Parameters:  map[string]string{"type": "pd-standard"},
+		}
+		balanced = &storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: "balanced"},
+			Provisioner: csiDriverName,
+			Parameters:  map[string]string{"type": "pd-balanced"},
+		}
+		source = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: "default"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: ptr.To("default"),
+				VolumeName:       "pv-source",
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		}
+		volume = &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-source"},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					CSI: &corev1.CSIPersistentVolumeSource{Driver: csiDriverName, VolumeHandle: "projects/foo/zones/europe-west1-b/disks/pv-source"},
+				},
+				NodeAffinity: &corev1.VolumeNodeAffinity{Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{
+						{Key: "topology.gke.io/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"europe-west1-b"}},
+					}}},
+				}},
+			},
+		}
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-c", Labels: map[string]string{corev1.LabelTopologyZone: "europe-west1-c"}},
+		}
+		clone = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "clone", Namespace: "default"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: ptr.To("default"),
+				DataSource:       &corev1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: "source"},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(standard, balanced, source, volume, node).Build()
+	})
+
+	It("should allow a valid clone", func() {
+		Expect(validateVolumeClone(ctx, c, clone, nil)).To(Succeed())
+	})
+
+	It("should ignore claims which are not cloned", func() {
+		clone.Spec.DataSource = &corev1.TypedLocalObjectReference{APIGroup: ptr.To("snapshot.storage.k8s.io"), Kind: "VolumeSnapshot", Name: "source"}
+
+		Expect(validateVolumeClone(ctx, c, clone, nil)).To(Succeed())
+	})
+
+	It("should reject a clone of a missing source", func() {
+		clone.Spec.DataSource.Name = "missing"
+
+		Expect(validateVolumeClone(ctx, c, clone, nil)).To(MatchError(ContainSubstring("source PersistentVolumeClaim missing of the clone does not exist")))
+	})
+
+	It("should reject a clone with another disk type", func() {
+		clone.Spec.StorageClassName = ptr.To("balanced")
+
+		Expect(validateVolumeClone(ctx, c, clone, nil)).To(MatchError(ContainSubstring("must have the same disk type pd-standard as the source")))
+	})
+
+	It("should reject a clone smaller than the source", func() {
+		clone.Spec.Resources.Requests[corev1.ResourceStorage] = resource.MustParse("5Gi")
+
+		Expect(validateVolumeClone(ctx, c, clone, nil)).To(MatchError(ContainSubstring("must request at least the capacity 10Gi of the source")))
+	})
+
+	It("should reject a clone restricted to other zones than the source", func() {
+		standard.AllowedTopologies = []corev1.TopologySelectorTerm{{MatchLabelExpressions: []corev1.TopologySelectorLabelRequirement{
+			{Key: "topology.gke.io/zone", Values: []string{"europe-west1-c", "europe-west1-d"}},
+		}}}
+		Expect(c.Update(ctx, standard)).To(Succeed())
+
+		Expect(validateVolumeClone(ctx, c, clone, nil)).To(MatchError(ContainSubstring("must be provisioned in a zone of the source [europe-west1-b]")))
+	})
+
+	It("should reject the selection of a node in another zone than the source", func() {
+		old := clone.DeepCopy()
+		clone.Annotations = map[string]string{annotationSelectedNode: "node-c"}
+
+		Expect(validateVolumeClone(ctx, c, clone, old)).To(MatchError(ContainSubstring("node node-c is in zone europe-west1-c")))
+	})
+
+	It("should allow the selection of a node in the zone of the source", func() {
+		node.Labels[corev1.LabelTopologyZone] = "europe-west1-b"
+		Expect(c.Update(ctx, node)).To(Succeed())
+		old := clone.DeepCopy()
+		clone.Annotations = map[string]string{annotationSelectedNode: "node-c"}
+
+		Expect(validateVolumeClone(ctx, c, clone, old)).To(Succeed())
+	})
+})
@@ -45,6 +45,7 @@ import (
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/features"
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp"
 	gcpclient "github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client"
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/gcp/client/fake"
 	. "github.com/gardener/gardener-extension-provider-gcp/test/integration/infrastructure"
 )
 
@@ -63,9 +64,13 @@ const (
 var (
 	serviceAccount = flag.String("service-account", "", "Service account containing credentials for the GCP API")
 	region         = flag.String("region", "", "GCP region")
+	useFakeGCP     = flag.Bool("fake-gcp", false, "Run the tests against a fake GCP server and a local control plane instead of a real GCP project and cluster")
 )
 
 func validateFlags() {
+	if *useFakeGCP {
+		return
+	}
 	if len(*serviceAccount) == 0 {
 		panic("--service-account flag is not specified")
 	}
@@ -86,6 +91,7 @@ var (
 	project        string
 	computeService *computev1.Service
 	iamService     *iamv1.Service
+	fakeServer     *fake.Server
 )
 
 var _ = BeforeSuite(func() {
@@ -94,6 +100,21 @@ var _ = BeforeSuite(func() {
 
 	repoRoot := filepath.Join("..", "..", "..")
 
+	var clientOptions []option.ClientOption
+	if *useFakeGCP {
+		By("starting fake GCP server")
+		var err error
+		fakeServer, err = fake.NewServer()
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(fakeServer.Close)
+
+		*serviceAccount = string(fakeServer.ServiceAccountJSON("fake-project"))
+		if len(*region) == 0 {
+			*region = "europe-west1"
+		}
+		clientOptions = append(clientOptions, option.WithEndpoint(fakeServer.ComputeEndpoint()))
+	}
+
 	DeferCleanup(func() {
 		defer func() {
 			By("stopping manager")
@@ -112,7 +133,7 @@ var _ = BeforeSuite(func() {
 
 	By("starting test environment")
 	testEnv = &envtest.Environment{
-		UseExistingCluster: ptr.To(true),
+		UseExistingCluster: ptr.To(!*useFakeGCP),
 		CRDInstallOptions: envtest.CRDInstallOptions{
 			Paths: []string{
 				filepath.Join(repoRoot, "example", "20-crd-extensions.gardener.cloud_clusters.yaml"),
@@ -136,11 +157,15 @@ var _ = BeforeSuite(func() {
 	Expect(extensionsv1alpha1.AddToScheme(mgr.GetScheme())).To(Succeed())
 	Expect(gcpinstall.AddToScheme(mgr.GetScheme())).To(Succeed())
 
-	Expect(infrastructure.AddToManagerWithOptions(ctx, mgr, infrastructure.AddOptions{
+	addOptions := infrastructure.AddOptions{
 		// During testing in testmachinery cluster, there is no gardener-resource-manager to inject the volume mount.
 		// Hence, we need to run without projected token mount.
 		DisableProjectedTokenMount: true,
-	})).To(Succeed())
+	}
+	if fakeServer != nil {
+		addOptions.ClientOptions = fakeServer.ClientOptions()
+	}
+	Expect(infrastructure.AddToManagerWithOptions(ctx, mgr, addOptions)).To(Succeed())
 
 	var mgrContext context.Context
 	mgrContext, mgrCancel = context.WithCancel(ctx)
@@ -157,9 +182,13 @@ var _ = BeforeSuite(func() {
 	sa, err := gcp.GetServiceAccountFromJSON([]byte(*serviceAccount))
 	project = sa.ProjectID
 	Expect(err).NotTo(HaveOccurred())
-	computeService, err = computev1.NewService(ctx, option.WithCredentialsJSON([]byte(*serviceAccount)), option.WithScopes(computev1.CloudPlatformScope))
+	computeService, err = computev1.NewService(ctx, append(clientOptions, option.WithCredentialsJSON([]byte(*serviceAccount)), option.WithScopes(computev1.CloudPlatformScope))...)
 	Expect(err).NotTo(HaveOccurred())
-	iamService, err = iamv1.NewService(ctx, option.WithCredentialsJSON([]byte(*serviceAccount)))
+	iamOptions := []option.ClientOption{option.WithCredentialsJSON([]byte(*serviceAccount))}
+	if fakeServer != nil {
+		iamOptions = append(iamOptions, option.WithEndpoint(fakeServer.IAMEndpoint()))
+	}
+	iamService, err = iamv1.NewService(ctx, iamOptions...)
 	Expect(err).NotTo(HaveOccurred())
 })
 
@@ -170,6 +199,8 @@ var _ = Describe("Infrastructure tests", func() {
 		})
 
 		DescribeTable("should successfully create and delete", func(flowType int) {
+			skipIfTerraformWithFakeGCP(flowType)
+
 			providerConfig := newProviderConfig(nil, nil)
 
 			namespace, err := generateNamespaceName()
@@ -190,6 +221,8 @@ var _ = Describe("Infrastructure tests", func() {
 		})
 
 		DescribeTable("should successfully create and delete", func(flowType int) {
+			skipIfTerraformWithFakeGCP(flowType)
+
 			namespace, err := generateNamespaceName()
 			Expect(err).NotTo(HaveOccurred())
 
@@ -238,6 +271,14 @@ var _ = Describe("Infrastructure tests", func() {
 	})
 })
 
+// skipIfTerraformWithFakeGCP skips tests using Terraform when running against the fake GCP server, as the terraformer
+// talks to the real GCP APIs.
+func skipIfTerraformWithFakeGCP(flowType int) {
+	if fakeServer != nil && flowType != useFlow {
+		Skip("terraform is not supported with the fake GCP server")
+	}
+}
+
 func runTest(
 	ctx context.Context,
 	c client.Client,